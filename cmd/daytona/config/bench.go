@@ -0,0 +1,104 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Number of benchmark results kept per profile
+const maxBenchHistory = 20
+
+// BenchResult is the result of a connection benchmark to the server of a profile. Throughputs are in Mbit/s,
+// zero if they were not measured
+type BenchResult struct {
+	Time         time.Time `json:"time"`
+	LatencyMs    float64   `json:"latencyMs"`
+	LatencyMaxMs float64   `json:"latencyMaxMs"`
+	DownloadMbps float64   `json:"downloadMbps"`
+	UploadMbps   float64   `json:"uploadMbps,omitempty"`
+	StreamMbps   float64   `json:"streamMbps"`
+	SshMbps      float64   `json:"sshMbps,omitempty"`
+}
+
+func getBenchHistoryPath(profileId string) (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "bench", profileId+".json"), nil
+}
+
+// GetBenchHistory returns the benchmark results of the profile, oldest first
+func GetBenchHistory(profileId string) ([]BenchResult, error) {
+	path, err := getBenchHistoryPath(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []BenchResult{}, nil
+		}
+		return nil, err
+	}
+
+	history := []BenchResult{}
+	err = json.Unmarshal(content, &history)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// SaveBenchResult appends the result to the benchmark history of the profile and drops the oldest results
+func SaveBenchResult(profileId string, result BenchResult) error {
+	history, err := GetBenchHistory(profileId)
+	if err != nil {
+		return err
+	}
+
+	history = append(history, result)
+	if len(history) > maxBenchHistory {
+		history = history[len(history)-maxBenchHistory:]
+	}
+
+	path, err := getBenchHistoryPath(profileId)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0644)
+}
+
+// DeleteBenchHistory removes the benchmark history of the profile
+func DeleteBenchHistory(profileId string) error {
+	path, err := getBenchHistoryPath(profileId)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
@@ -24,14 +24,74 @@ type Profile struct {
 	Id   string    `json:"id"`
 	Name string    `json:"name"`
 	Api  ServerApi `json:"api"`
+	// Encrypt toolbox requests end-to-end between the client and project agents
+	E2EEncryption bool `json:"e2eEncryption,omitempty"`
+	// Overrides the global default IDE for this profile
+	DefaultIdeId string `json:"defaultIde,omitempty"`
+	// Target used for new workspaces if none is specified. Overrides the default target of the server
+	DefaultTarget string `json:"defaultTarget,omitempty"`
+	// Bastion host used to reach the Daytona Server, in the OpenSSH ProxyJump format
+	ProxyJump string `json:"proxyJump,omitempty"`
+	// Private key used to authenticate with the jump host. If empty, the SSH config and agent of the user apply
+	ProxyJumpIdentityFile string `json:"proxyJumpIdentityFile,omitempty"`
+	// HTTP(S) or SOCKS5 proxy URL used to reach the Daytona Server. If empty, the standard proxy environment variables apply
+	Proxy string `json:"proxy,omitempty"`
+	// Restricted profiles can only run commands that don't modify resources, e.g. on shared machines.
+	// Should be used with a read-only API key so that the restriction is also enforced by the server
+	Restricted bool `json:"restricted,omitempty"`
+	// Docker daemon address the CLI uses to reach project containers directly, e.g. tcp://10.0.0.5:2376
+	DockerHost string `json:"dockerHost,omitempty"`
+	// Docker CLI context to use instead of the docker host
+	DockerContext string `json:"dockerContext,omitempty"`
+	// How the CLI connects to the Daytona Server. Automatically selected if empty
+	Transport ServerTransport `json:"transport,omitempty"`
+	// Unix socket of the Daytona Server API. Defaults to the socket of the local server
+	SocketPath string `json:"socketPath,omitempty"`
 }
 
+type ServerTransport string
+
+const (
+	// Uses the local unix socket if the server runs on this machine and falls back to TCP
+	ServerTransportAuto ServerTransport = "auto"
+	ServerTransportUnix ServerTransport = "unix"
+	// Direct HTTP(S) connection to the API URL, through the proxy or jump host of the profile if set
+	ServerTransportTcp ServerTransport = "tcp"
+	// Connects to the unix socket of the server on the jump host of the profile over SSH
+	ServerTransportSsh ServerTransport = "ssh"
+)
+
+var ServerTransports = []ServerTransport{ServerTransportAuto, ServerTransportUnix, ServerTransportTcp, ServerTransportSsh}
+
 type Config struct {
 	Id               string    `json:"id"`
 	ActiveProfileId  string    `json:"activeProfile"`
 	DefaultIdeId     string    `json:"defaultIde"`
 	Profiles         []Profile `json:"profiles"`
 	TelemetryEnabled bool      `json:"telemetryEnabled"`
+	Theme            string    `json:"theme,omitempty"`
+	// Colors of the custom theme by color name (e.g. green: "#00ff00")
+	CustomTheme map[string]string `json:"customTheme,omitempty"`
+	// Set when the first-run setup wizard was skipped so that it is not shown again
+	SetupSkipped bool `json:"setupSkipped,omitempty"`
+	// Docker credential helper that stores the API keys of profiles instead of the config file, e.g. osxkeychain
+	CredentialsStore string `json:"credentialsStore,omitempty"`
+	// Forwarding of the ports that start listening in a project while an SSH or IDE connection to it is open
+	PortsAutoForward PortsAutoForward `json:"portsAutoForward"`
+
+	// Errors of reading API keys from the credentials store by profile id
+	credentialsErrors map[string]error
+}
+
+type PortsAutoForward struct {
+	// If set, only the ports declared on projects are forwarded
+	Disabled bool `json:"disabled,omitempty"`
+	// Ports or port ranges, e.g. 3000 or 8000-8999. If not empty, only the detected ports in the list are forwarded
+	Allow []string `json:"allow,omitempty"`
+	// Ports or port ranges that are not forwarded when detected. Takes precedence over the allow list
+	Ignore []string `json:"ignore,omitempty"`
+	// Open the detected ports in the default browser once they are forwarded
+	OpenBrowser bool `json:"openBrowser,omitempty"`
 }
 
 type Ide struct {
@@ -51,6 +111,15 @@ func GetConfig() (*Config, error) {
 	}
 
 	_, err = os.Stat(configFilePath)
+	if os.IsNotExist(err) && HasEnvProfile() {
+		// The CLI is driven through environment variables so the config file is not created
+		return &Config{
+			Id:               uuid.NewString(),
+			DefaultIdeId:     getInitialDefaultIde(),
+			TelemetryEnabled: true,
+		}, nil
+	}
+
 	if os.IsNotExist(err) {
 		// Setup autocompletion when adding initial config
 		_ = autocomplete.DetectShellAndSetupAutocompletion(autocomplete.AutoCompleteCmd.Root())
@@ -78,6 +147,8 @@ func GetConfig() (*Config, error) {
 		return nil, err
 	}
 
+	c.loadApiKeys()
+
 	if c.Id == "" {
 		c.Id = uuid.NewString()
 		err := c.Save()
@@ -91,13 +162,41 @@ func GetConfig() (*Config, error) {
 
 var ErrNoProfilesFound = errors.New("no profiles found. Run `daytona serve` to create a default profile or `daytona profile add` to connect to a remote server")
 
+// GetActiveProfile returns the profile selected with DAYTONA_PROFILE or the active profile from the config file,
+// with the server API values overridden by environment variables
 func (c *Config) GetActiveProfile() (Profile, error) {
+	profile, err := c.getActiveProfile()
+	if err != nil {
+		return Profile{}, err
+	}
+
+	err = applyProfileEnvOverrides(&profile)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	return profile, nil
+}
+
+func (c *Config) getActiveProfile() (Profile, error) {
+	envProfile, err := getEnvProfile(c)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	if envProfile != nil {
+		return *envProfile, nil
+	}
+
 	if len(c.Profiles) == 0 {
 		return Profile{}, ErrNoProfilesFound
 	}
 
 	for _, profile := range c.Profiles {
 		if profile.Id == c.ActiveProfileId {
+			if err := c.credentialsErrors[profile.Id]; err != nil {
+				return Profile{}, fmt.Errorf("failed to read the API key of profile %s from the credentials store: %w", profile.Name, err)
+			}
 			return profile, nil
 		}
 	}
@@ -105,6 +204,20 @@ func (c *Config) GetActiveProfile() (Profile, error) {
 	return Profile{}, errors.New("active profile not found. Set an active profile with `daytona profile use`")
 }
 
+// GetDefaultIdeId returns the IDE set with DAYTONA_DEFAULT_IDE, the default IDE of the profile if one is set,
+// otherwise the global default IDE
+func (c *Config) GetDefaultIdeId(profile Profile) string {
+	if ideId := os.Getenv(DEFAULT_IDE_ENV_VAR); ideId != "" {
+		return ideId
+	}
+
+	if profile.DefaultIdeId != "" {
+		return profile.DefaultIdeId
+	}
+
+	return c.DefaultIdeId
+}
+
 func (c *Config) Save() error {
 	configFilePath, err := getConfigPath()
 	if err != nil {
@@ -116,7 +229,12 @@ func (c *Config) Save() error {
 		return err
 	}
 
-	configContent, err := json.MarshalIndent(c, "", "  ")
+	fileConfig, err := c.storeApiKeys()
+	if err != nil {
+		return err
+	}
+
+	configContent, err := json.MarshalIndent(fileConfig, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -124,6 +242,95 @@ func (c *Config) Save() error {
 	return os.WriteFile(configFilePath, configContent, 0644)
 }
 
+// SetCredentialsStore moves the API keys of all profiles to the credential helper with the given name.
+// With an empty name, the API keys are moved back to the config file
+func (c *Config) SetCredentialsStore(name string) error {
+	if name != "" {
+		err := ValidateCredentialsStore(name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, profile := range c.Profiles {
+		if err := c.credentialsErrors[profile.Id]; err != nil {
+			return fmt.Errorf("failed to read the API key of profile %s from the current credentials store: %w", profile.Name, err)
+		}
+	}
+
+	oldStore := c.CredentialsStore
+	c.CredentialsStore = name
+
+	err := c.Save()
+	if err != nil {
+		return err
+	}
+
+	if oldStore == "" || oldStore == name {
+		return nil
+	}
+
+	store := credentialsStore{helper: oldStore}
+	for _, profile := range c.Profiles {
+		err = store.eraseApiKey(profile.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadApiKeys reads the API keys that are not in the config file from the credentials store
+func (c *Config) loadApiKeys() {
+	if c.CredentialsStore == "" {
+		return
+	}
+
+	store := credentialsStore{helper: c.CredentialsStore}
+	c.credentialsErrors = map[string]error{}
+
+	for i, profile := range c.Profiles {
+		if profile.Api.Key != "" {
+			continue
+		}
+
+		key, err := store.getApiKey(profile.Id)
+		if err != nil {
+			c.credentialsErrors[profile.Id] = err
+			continue
+		}
+		c.Profiles[i].Api.Key = key
+	}
+}
+
+// storeApiKeys saves the changed API keys to the credentials store and returns the config to write to the file
+func (c *Config) storeApiKeys() (*Config, error) {
+	if c.CredentialsStore == "" {
+		return c, nil
+	}
+
+	store := credentialsStore{helper: c.CredentialsStore}
+
+	fileConfig := *c
+	fileConfig.Profiles = make([]Profile, len(c.Profiles))
+
+	for i, profile := range c.Profiles {
+		if profile.Api.Key != "" && credentialsCache[profile.Id] != profile.Api.Key {
+			err := store.storeApiKey(profile)
+			if err != nil {
+				return nil, err
+			}
+			delete(c.credentialsErrors, profile.Id)
+		}
+
+		profile.Api.Key = ""
+		fileConfig.Profiles[i] = profile
+	}
+
+	return &fileConfig, nil
+}
+
 func (c *Config) AddProfile(profile Profile) error {
 	c.Profiles = append(c.Profiles, profile)
 	c.ActiveProfileId = profile.Id
@@ -161,6 +368,14 @@ func (c *Config) RemoveProfile(profileId string) error {
 
 	c.Profiles = profiles
 
+	if c.CredentialsStore != "" {
+		store := credentialsStore{helper: c.CredentialsStore}
+		err := store.eraseApiKey(profileId)
+		if err != nil {
+			return err
+		}
+	}
+
 	return c.Save()
 }
 
@@ -209,6 +424,16 @@ func GetConfigDir() (string, error) {
 	return filepath.Join(userConfigDir, "daytona"), nil
 }
 
+// GetServerSocketPath returns the unix socket the local Daytona Server API listens on
+func GetServerSocketPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "server", "api.sock"), nil
+}
+
 func DeleteConfigDir() error {
 	configDir, err := GetConfigDir()
 	if err != nil {
@@ -224,7 +449,7 @@ func DeleteConfigDir() error {
 }
 
 func TelemetryEnabled() bool {
-	telemetryEnabled := os.Getenv("DAYTONA_TELEMETRY_ENABLED")
+	telemetryEnabled := os.Getenv(TELEMETRY_ENV_VAR)
 	if telemetryEnabled != "" {
 		return telemetryEnabled == "true"
 	}
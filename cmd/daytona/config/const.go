@@ -55,6 +55,7 @@ func GetSupportedGitProviders() []GitProvider {
 		{"bitbucket-server", "Bitbucket Server"},
 		{"codeberg", "Codeberg"},
 		{"gitea", "Gitea"},
+		{"forgejo", "Forgejo"},
 		{"gitness", "Gitness"},
 		{"azure-devops", "Azure DevOps"},
 		{"aws-codecommit", "AWS CodeCommit"},
@@ -81,6 +82,8 @@ func GetDocsLinkFromGitProvider(providerId string) string {
 		return "https://docs.codeberg.org/advanced/access-token/"
 	case "gitea":
 		return "https://docs.gitea.com/1.21/development/api-usage#generating-and-listing-api-tokens"
+	case "forgejo":
+		return "https://forgejo.org/docs/latest/user/api-usage/#generating-and-listing-api-tokens"
 	case "gitness":
 		return "https://docs.gitness.com/administration/user-management#generate-user-token"
 	case "azure-devops":
@@ -130,6 +133,8 @@ func GetRequiredScopesFromGitProviderId(providerId string) string {
 	case "codeberg":
 		fallthrough
 	case "gitea":
+		fallthrough
+	case "forgejo":
 		return "read:organization,write:repository,read:user"
 	case "gitness":
 		return "/"
@@ -177,6 +182,10 @@ func GetWebhookEventHeaderKeyFromGitProvider(providerId string) string {
 		return "X-Event-Key"
 	case "gitea":
 		return "X-Gitea-Event"
+	case "codeberg":
+		fallthrough
+	case "forgejo":
+		return "X-Forgejo-Event"
 	case "azure-devops":
 		return "X-AzureDevops-Event"
 	case "gitness":
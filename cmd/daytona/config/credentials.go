@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Credentials stores keep the API keys of profiles out of the config file. They use the Docker credential helper
+// protocol, so any docker-credential-<name> program works, e.g. osxkeychain, wincred, secretservice or pass
+type credentialsStore struct {
+	helper string
+}
+
+type credentials struct {
+	ServerURL string
+	Username  string
+	Secret    string
+}
+
+// The credential helpers report missing credentials with this message
+const credentialsNotFoundMessage = "credentials not found in native keychain"
+
+var errCredentialsNotFound = errors.New("credentials not found")
+
+// API keys read from the credentials store by profile id, so that the helper only runs once per profile
+var credentialsCache = map[string]string{}
+
+// ValidateCredentialsStore returns an error if the credential helper of the store is not installed
+func ValidateCredentialsStore(name string) error {
+	_, err := exec.LookPath(credentialsHelperBinary(name))
+	if err != nil {
+		return fmt.Errorf("credential helper %s not found in PATH", credentialsHelperBinary(name))
+	}
+
+	return nil
+}
+
+func credentialsHelperBinary(name string) string {
+	return "docker-credential-" + name
+}
+
+// Credentials of each profile are saved under a URL with the profile id as the host
+func profileCredentialsUrl(profileId string) string {
+	return fmt.Sprintf("daytona://%s", profileId)
+}
+
+func (s *credentialsStore) getApiKey(profileId string) (string, error) {
+	if key, ok := credentialsCache[profileId]; ok {
+		return key, nil
+	}
+
+	out, err := s.run("get", profileCredentialsUrl(profileId))
+	if err != nil {
+		return "", err
+	}
+
+	var c credentials
+	err = json.Unmarshal(out, &c)
+	if err != nil {
+		return "", fmt.Errorf("invalid response from %s: %w", credentialsHelperBinary(s.helper), err)
+	}
+
+	credentialsCache[profileId] = c.Secret
+	return c.Secret, nil
+}
+
+func (s *credentialsStore) storeApiKey(profile Profile) error {
+	input, err := json.Marshal(credentials{
+		ServerURL: profileCredentialsUrl(profile.Id),
+		Username:  profile.Name,
+		Secret:    profile.Api.Key,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.run("store", string(input))
+	if err != nil {
+		return err
+	}
+
+	credentialsCache[profile.Id] = profile.Api.Key
+	return nil
+}
+
+func (s *credentialsStore) eraseApiKey(profileId string) error {
+	delete(credentialsCache, profileId)
+
+	_, err := s.run("erase", profileCredentialsUrl(profileId))
+	if errors.Is(err, errCredentialsNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *credentialsStore) run(action, input string) ([]byte, error) {
+	cmd := exec.Command(credentialsHelperBinary(s.helper), action)
+	cmd.Stdin = strings.NewReader(input)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stdout.String() + stderr.String())
+		if strings.Contains(message, credentialsNotFoundMessage) {
+			return nil, errCredentialsNotFound
+		}
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, fmt.Errorf("%s %s failed: %s", credentialsHelperBinary(s.helper), action, message)
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Public keys of project agents trusted for end-to-end encryption, indexed by profile, workspace and project
+type e2eKnownKeys map[string]string
+
+func getE2EKnownKeysPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "e2e_known_keys.json"), nil
+}
+
+func readE2EKnownKeys() (e2eKnownKeys, error) {
+	path, err := getE2EKnownKeysPath()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return e2eKnownKeys{}, nil
+		}
+		return nil, err
+	}
+
+	knownKeys := e2eKnownKeys{}
+	err = json.Unmarshal(content, &knownKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return knownKeys, nil
+}
+
+// GetKnownE2EKey returns the pinned agent public key of a project or an empty string if none is pinned
+func GetKnownE2EKey(profileId, workspaceId, projectName string) (string, error) {
+	knownKeys, err := readE2EKnownKeys()
+	if err != nil {
+		return "", err
+	}
+
+	return knownKeys[getE2EKnownKeyId(profileId, workspaceId, projectName)], nil
+}
+
+func SaveKnownE2EKey(profileId, workspaceId, projectName, publicKey string) error {
+	knownKeys, err := readE2EKnownKeys()
+	if err != nil {
+		return err
+	}
+
+	knownKeys[getE2EKnownKeyId(profileId, workspaceId, projectName)] = publicKey
+
+	return saveE2EKnownKeys(knownKeys)
+}
+
+// RemoveProfileE2EKeys removes the pinned agent public keys of all projects reached through the profile
+func RemoveProfileE2EKeys(profileId string) error {
+	knownKeys, err := readE2EKnownKeys()
+	if err != nil {
+		return err
+	}
+
+	removed := false
+	for id := range knownKeys {
+		if strings.HasPrefix(id, profileId+"/") {
+			delete(knownKeys, id)
+			removed = true
+		}
+	}
+
+	if !removed {
+		return nil
+	}
+
+	return saveE2EKnownKeys(knownKeys)
+}
+
+func saveE2EKnownKeys(knownKeys e2eKnownKeys) error {
+	content, err := json.MarshalIndent(knownKeys, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path, err := getE2EKnownKeysPath()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0600)
+}
+
+func getE2EKnownKeyId(profileId, workspaceId, projectName string) string {
+	return profileId + "/" + workspaceId + "/" + projectName
+}
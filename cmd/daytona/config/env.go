@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Environment variables that override values from the config file.
+// Command flags take precedence over environment variables, which take precedence over the config file.
+// Overrides are never written to the config file.
+const (
+	PROFILE_ENV_VAR        = "DAYTONA_PROFILE"
+	API_URL_ENV_VAR        = "DAYTONA_API_URL"
+	API_KEY_ENV_VAR        = "DAYTONA_API_KEY"
+	DEFAULT_IDE_ENV_VAR    = "DAYTONA_DEFAULT_IDE"
+	E2E_ENCRYPTION_ENV_VAR = "DAYTONA_E2E_ENCRYPTION"
+	PROXY_JUMP_ENV_VAR     = "DAYTONA_PROXY_JUMP"
+	TELEMETRY_ENV_VAR      = "DAYTONA_TELEMETRY_ENABLED"
+)
+
+// ENV_PROFILE_ID is the id of the profile used when the server API is configured
+// only through environment variables and the config file has no profiles
+const ENV_PROFILE_ID = "env"
+
+// HasEnvProfile returns true if the server API is configured through environment variables
+func HasEnvProfile() bool {
+	return os.Getenv(API_URL_ENV_VAR) != ""
+}
+
+func getEnvProfile(c *Config) (*Profile, error) {
+	profileIdOrName := os.Getenv(PROFILE_ENV_VAR)
+	if profileIdOrName != "" {
+		for _, profile := range c.Profiles {
+			if profile.Id == profileIdOrName || profile.Name == profileIdOrName {
+				return &profile, nil
+			}
+		}
+
+		return nil, fmt.Errorf("profile %s set by %s not found", profileIdOrName, PROFILE_ENV_VAR)
+	}
+
+	if len(c.Profiles) == 0 && HasEnvProfile() {
+		return &Profile{
+			Id:   ENV_PROFILE_ID,
+			Name: ENV_PROFILE_ID,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func applyProfileEnvOverrides(profile *Profile) error {
+	if apiUrl := os.Getenv(API_URL_ENV_VAR); apiUrl != "" {
+		profile.Api.Url = apiUrl
+	}
+
+	if apiKey := os.Getenv(API_KEY_ENV_VAR); apiKey != "" {
+		profile.Api.Key = apiKey
+	}
+
+	if proxyJump := os.Getenv(PROXY_JUMP_ENV_VAR); proxyJump != "" {
+		profile.ProxyJump = proxyJump
+	}
+
+	if e2eEncryption := os.Getenv(E2E_ENCRYPTION_ENV_VAR); e2eEncryption != "" {
+		enabled, err := strconv.ParseBool(e2eEncryption)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %s", E2E_ENCRYPTION_ENV_VAR, e2eEncryption)
+		}
+		profile.E2EEncryption = enabled
+	}
+
+	return nil
+}
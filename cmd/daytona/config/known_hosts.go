@@ -0,0 +1,100 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// GetKnownHostsPath returns the known_hosts file with the pinned host keys of workspace projects.
+// The SSH config entries of the projects reference it so that the user's known_hosts file is not changed
+func GetKnownHostsPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "known_hosts"), nil
+}
+
+// PinProjectHostKey saves the host key reported by the project agent, replacing a previously pinned key.
+// The key is in the known_hosts format without the host name, e.g. "ssh-ed25519 AAAA..."
+func PinProjectHostKey(profileId, workspaceId, projectName, hostKey string) error {
+	hostname := GetProjectHostname(profileId, workspaceId, projectName)
+	line := hostname + " " + strings.TrimSpace(hostKey)
+
+	return updateKnownHosts(func(lines []string) []string {
+		updated := []string{}
+		for _, l := range lines {
+			if getKnownHostsLineHost(l) != hostname {
+				updated = append(updated, l)
+			}
+		}
+		return append(updated, line)
+	})
+}
+
+// removeHostKeys removes the pinned host keys of the hosts
+func removeHostKeys(hostnames ...string) error {
+	if len(hostnames) == 0 {
+		return nil
+	}
+
+	return updateKnownHosts(func(lines []string) []string {
+		updated := []string{}
+		for _, l := range lines {
+			if !slices.Contains(hostnames, getKnownHostsLineHost(l)) {
+				updated = append(updated, l)
+			}
+		}
+		return updated
+	})
+}
+
+func updateKnownHosts(update func(lines []string) []string) error {
+	path, err := GetKnownHostsPath()
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	lines := []string{}
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	updated := update(lines)
+	if strings.Join(updated, "\n") == strings.Join(lines, "\n") {
+		return nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	newContent := strings.Join(updated, "\n")
+	if newContent != "" {
+		newContent += "\n"
+	}
+
+	return os.WriteFile(path, []byte(newContent), 0600)
+}
+
+func getKnownHostsLineHost(line string) string {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
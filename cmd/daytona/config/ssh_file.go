@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -18,87 +19,152 @@ import (
 
 var SshHomeDir string
 
-func ensureSshFilesLinked() error {
-	// Make sure ~/.ssh/config file exists if not create it
-	sshDir := filepath.Join(SshHomeDir, ".ssh")
-	configPath := filepath.Join(sshDir, "config")
+// Name of the SSH config file with the workspace hosts in the Daytona config directory
+const sshConfigFileName = "ssh_config"
 
-	_, err := os.Stat(configPath)
-	if os.IsNotExist(err) {
-		err := os.MkdirAll(sshDir, 0700)
-		if err != nil {
-			return err
+// Workspace hosts were written to ~/.ssh/daytona_config before the file was moved to the Daytona config directory
+const legacySshConfigFileName = "daytona_config"
+
+// Marks the Include line of the Daytona SSH config in the user's SSH config
+const sshConfigIncludeComment = "# Added by Daytona, the workspace hosts are managed in the included file"
+
+// GetSshConfigPath returns the SSH config file with the workspace hosts. It is included from ~/.ssh/config
+func GetSshConfigPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, sshConfigFileName), nil
+}
+
+func getSshConfigIncludeLine(configPath string) string {
+	return "Include " + quoteSshConfigPath(configPath)
+}
+
+// quoteSshConfigPath quotes paths with spaces, e.g. in Windows user directories
+func quoteSshConfigPath(path string) string {
+	if strings.ContainsAny(path, " \t") {
+		return fmt.Sprintf("\"%s\"", path)
+	}
+	return path
+}
+
+// removeSshConfigInclude removes the Include line managed by Daytona and the legacy daytona_config include
+func removeSshConfigInclude(content string) string {
+	lines := strings.Split(content, "\n")
+	kept := []string{}
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == sshConfigIncludeComment || trimmed == "Include "+legacySshConfigFileName {
+			continue
 		}
-		err = os.WriteFile(configPath, []byte{}, 0600)
-		if err != nil {
-			return err
+		if i > 0 && strings.TrimSpace(lines[i-1]) == sshConfigIncludeComment && strings.HasPrefix(trimmed, "Include ") {
+			continue
 		}
+		kept = append(kept, line)
 	}
 
-	// Make sure daytona_config file exists
-	daytonaConfigPath := filepath.Join(sshDir, "daytona_config")
+	return strings.TrimLeft(strings.Join(kept, "\n"), "\n")
+}
 
-	_, err = os.Stat(daytonaConfigPath)
-	if os.IsNotExist(err) {
-		err := os.WriteFile(daytonaConfigPath, []byte{}, 0600)
-		if err != nil {
-			return err
+// migrateLegacySshConfig moves the entries of ~/.ssh/daytona_config to the Daytona SSH config
+func migrateLegacySshConfig(configPath string) error {
+	legacyPath := filepath.Join(SshHomeDir, ".ssh", legacySshConfigFileName)
+
+	content, err := os.ReadFile(legacyPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
 		}
+		return err
 	}
 
-	// Make sure daytona_config is included
-	configFile := filepath.Join(sshDir, "config")
-	_, err = os.Stat(configFile)
+	_, err = os.Stat(configPath)
 	if os.IsNotExist(err) {
-		err := os.WriteFile(configFile, []byte("Include daytona_config\n\n"), 0600)
-		if err != nil {
-			return err
-		}
-	} else {
-		content, err := os.ReadFile(configFile)
+		err = os.WriteFile(configPath, content, 0600)
 		if err != nil {
 			return err
 		}
+	}
+
+	return os.Remove(legacyPath)
+}
+
+// ensureSshFilesLinked makes sure the Daytona SSH config exists and that it is included at the top of ~/.ssh/config.
+// The user's SSH config is only written if the Include line is missing or not the first line
+func ensureSshFilesLinked() error {
+	configPath, err := GetSshConfigPath()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(configPath), 0700)
+	if err != nil {
+		return err
+	}
+
+	err = migrateLegacySshConfig(configPath)
+	if err != nil {
+		return err
+	}
 
-		newContent := strings.ReplaceAll(string(content), "Include daytona_config\n\n", "")
-		newContent = strings.ReplaceAll(string(newContent), "Include daytona_config\n", "")
-		newContent = strings.ReplaceAll(string(newContent), "Include daytona_config", "")
-		newContent = "Include daytona_config\n\n" + newContent
-		err = os.WriteFile(configFile, []byte(newContent), 0600)
+	_, err = os.Stat(configPath)
+	if os.IsNotExist(err) {
+		err := os.WriteFile(configPath, []byte{}, 0600)
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
+	sshDir := filepath.Join(SshHomeDir, ".ssh")
+	userConfigPath := filepath.Join(sshDir, "config")
+
+	content, err := ReadSshConfig(userConfigPath)
+	if err != nil {
+		return err
+	}
+
+	// The include has to come before any Host block to apply to all hosts
+	include := sshConfigIncludeComment + "\n" + getSshConfigIncludeLine(configPath) + "\n"
+	if strings.HasPrefix(content, include) {
+		return nil
+	}
+
+	err = os.MkdirAll(sshDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(userConfigPath, []byte(include+"\n"+removeSshConfigInclude(content)), 0600)
 }
 
+// UnlinkSshFiles removes the Include line from ~/.ssh/config and the Daytona SSH config
 func UnlinkSshFiles() error {
-	sshDirPath := filepath.Join(SshHomeDir, ".ssh")
-	sshConfigPath := filepath.Join(sshDirPath, "config")
-	daytonaConfigPath := filepath.Join(sshDirPath, "daytona_config")
-
-	// Remove the include line from the config file
-	_, err := os.Stat(sshConfigPath)
-	if os.IsExist(err) {
-		content, err := os.ReadFile(sshConfigPath)
-		if err != nil {
-			return err
-		}
+	userConfigPath := filepath.Join(SshHomeDir, ".ssh", "config")
+
+	content, err := ReadSshConfig(userConfigPath)
+	if err != nil {
+		return err
+	}
 
-		newContent := strings.ReplaceAll(string(content), "Include daytona_config\n\n", "")
-		newContent = strings.ReplaceAll(string(newContent), "Include daytona_config", "")
-		err = os.WriteFile(sshConfigPath, []byte(newContent), 0600)
+	newContent := removeSshConfigInclude(content)
+	if newContent != content {
+		err = os.WriteFile(userConfigPath, []byte(newContent), 0600)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Remove the daytona_config file
-	_, err = os.Stat(daytonaConfigPath)
-	if os.IsExist(err) {
-		err = os.Remove(daytonaConfigPath)
-		if err != nil {
+	configPath, err := GetSshConfigPath()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range []string{configPath, filepath.Join(SshHomeDir, ".ssh", legacySshConfigFileName)} {
+		err = os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
 			return err
 		}
 	}
@@ -114,15 +180,22 @@ func generateSshConfigEntry(profileId, workspaceId, projectName, knownHostsPath
 		return "", err
 	}
 
+	sshKeyFile, err := getSshKeyFile()
+	if err != nil {
+		return "", err
+	}
+
 	tab := "\t"
 	projectHostname := GetProjectHostname(profileId, workspaceId, projectName)
 
 	config := fmt.Sprintf("Host %s\n"+
 		tab+"User daytona\n"+
-		tab+"StrictHostKeyChecking no\n"+
+		tab+"StrictHostKeyChecking accept-new\n"+
 		tab+"UserKnownHostsFile %s\n"+
+		tab+"IdentityFile %s\n"+
+		tab+"IdentitiesOnly yes\n"+
 		tab+"ProxyCommand \"%s\" ssh-proxy %s %s %s\n"+
-		tab+"ForwardAgent yes\n", projectHostname, knownHostsPath, daytonaPath, profileId, workspaceId, projectName)
+		tab+"ForwardAgent yes\n", projectHostname, knownHostsPath, sshKeyFile, daytonaPath, profileId, workspaceId, projectName)
 
 	if gpgForward {
 		localSocket, err := getLocalGPGSocket()
@@ -153,10 +226,15 @@ func EnsureSshConfigEntryAdded(profileId, workspaceName, projectName string, gpg
 		return err
 	}
 
-	sshDir := filepath.Join(SshHomeDir, ".ssh")
-	configPath := filepath.Join(sshDir, "daytona_config")
+	configPath, err := GetSshConfigPath()
+	if err != nil {
+		return err
+	}
 
-	knownHostsFile := getKnownHostsFile()
+	knownHostsFile, err := getKnownHostsFile()
+	if err != nil {
+		return err
+	}
 
 	existingContent, err := ReadSshConfig(configPath)
 	if err != nil {
@@ -191,7 +269,7 @@ func EnsureSshConfigEntryAdded(profileId, workspaceName, projectName string, gpg
 	}
 
 	if !configGenerated {
-		updatedContent, err := regenerateProxyCommand(existingContent, profileId, workspaceName, projectName)
+		updatedContent, err := regenerateProxyCommand(existingContent, profileId, workspaceName, projectName, knownHostsFile)
 		if err != nil {
 			return err
 		}
@@ -204,7 +282,7 @@ func EnsureSshConfigEntryAdded(profileId, workspaceName, projectName string, gpg
 	return nil
 }
 
-func regenerateProxyCommand(existingContent, profileId, workspaceId, projectName string) (string, error) {
+func regenerateProxyCommand(existingContent, profileId, workspaceId, projectName, knownHostsFile string) (string, error) {
 	daytonaPath, err := os.Executable()
 	if err != nil {
 		return "", err
@@ -220,14 +298,33 @@ func regenerateProxyCommand(existingContent, profileId, workspaceId, projectName
 	re := regexp.MustCompile(`(?m)^\s*ProxyCommand\s+.*$`)
 	updatedContent := re.ReplaceAllString(matchedEntry, fmt.Sprintf("\tProxyCommand \"%s\" ssh-proxy %s %s %s", daytonaPath, profileId, workspaceId, projectName))
 
+	// Entries written before host keys were pinned didn't check the host key
+	legacyHostKeyRegex := regexp.MustCompile(`(?m)^\tStrictHostKeyChecking no\n\tUserKnownHostsFile (?:/dev/null|NUL)$`)
+	updatedContent = legacyHostKeyRegex.ReplaceAllLiteralString(updatedContent, "\tStrictHostKeyChecking accept-new\n\tUserKnownHostsFile "+knownHostsFile)
+
+	// Entries written before the SSH servers authenticated clients didn't set the key
+	if !regexp.MustCompile(`(?m)^\tIdentityFile `).MatchString(updatedContent) {
+		sshKeyFile, err := getSshKeyFile()
+		if err != nil {
+			return "", err
+		}
+
+		proxyCommandRegex := regexp.MustCompile(`(?m)^\tProxyCommand `)
+		updatedContent = proxyCommandRegex.ReplaceAllLiteralString(updatedContent, "\tIdentityFile "+sshKeyFile+"\n\tIdentitiesOnly yes\n\tProxyCommand ")
+	}
+
 	return updatedContent, nil
 }
 
-func getKnownHostsFile() string {
-	if runtime.GOOS == "windows" {
-		return "NUL"
+// getKnownHostsFile returns the known_hosts file with the pinned host keys to reference in SSH config entries.
+// Hosts without a pinned key are added on the first connection
+func getKnownHostsFile() (string, error) {
+	path, err := GetKnownHostsPath()
+	if err != nil {
+		return "", err
 	}
-	return "/dev/null"
+
+	return quoteSshConfigPath(path), nil
 }
 
 func appendSshConfigEntry(configPath, profileId, workspaceId, projectName, knownHostsFile string, gpgForward bool, existingContent string) (string, error) {
@@ -322,8 +419,10 @@ func writeSshConfig(configPath, newContent string) error {
 }
 
 func RemoveWorkspaceSshEntries(profileId, workspaceId, projectName string) error {
-	sshDir := filepath.Join(SshHomeDir, ".ssh")
-	configPath := filepath.Join(sshDir, "daytona_config")
+	configPath, err := GetSshConfigPath()
+	if err != nil {
+		return err
+	}
 
 	// Read existing content from the SSH config file
 	existingContent, err := ReadSshConfig(configPath)
@@ -331,7 +430,14 @@ func RemoveWorkspaceSshEntries(profileId, workspaceId, projectName string) error
 		return err
 	}
 
-	hostLine := fmt.Sprintf("Host %s", GetProjectHostname(profileId, workspaceId, projectName))
+	projectHostname := GetProjectHostname(profileId, workspaceId, projectName)
+
+	err = removeHostKeys(projectHostname)
+	if err != nil {
+		return err
+	}
+
+	hostLine := fmt.Sprintf("Host %s", projectHostname)
 	regex := regexp.MustCompile(fmt.Sprintf(`%s\s*\n(?:\t.*\n?)*`, hostLine))
 	contentToDelete := regex.FindString(existingContent)
 	if contentToDelete == "" {
@@ -350,9 +456,125 @@ func RemoveWorkspaceSshEntries(profileId, workspaceId, projectName string) error
 	return nil
 }
 
+// RemoveProfileSshEntries removes the SSH config entries of all projects reached through the profile
+func RemoveProfileSshEntries(profileId string) error {
+	configPath, err := GetSshConfigPath()
+	if err != nil {
+		return err
+	}
+
+	existingContent, err := ReadSshConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	// Entries are matched by their proxy command because profile IDs can be prefixes of other host names
+	proxyCommandRegex := regexp.MustCompile(fmt.Sprintf(`ProxyCommand\s+.*ssh-proxy %s \S+ \S+`, regexp.QuoteMeta(profileId)))
+	entryRegex := regexp.MustCompile(`Host (\S+)\s*\n(?:\t.*\n?)*`)
+
+	removedHosts := []string{}
+	newContent := entryRegex.ReplaceAllStringFunc(existingContent, func(entry string) string {
+		if proxyCommandRegex.MatchString(entry) {
+			removedHosts = append(removedHosts, entryRegex.FindStringSubmatch(entry)[1])
+			return ""
+		}
+		return entry
+	})
+
+	err = removeHostKeys(removedHosts...)
+	if err != nil {
+		return err
+	}
+
+	if newContent == existingContent {
+		return nil
+	}
+
+	return writeSshConfig(configPath, strings.TrimSpace(newContent))
+}
+
+// SshConfigProject identifies a project to write an SSH config entry for
+type SshConfigProject struct {
+	WorkspaceId string
+	ProjectName string
+}
+
+// RegenerateProfileSshEntries replaces the SSH config entries of the profile with new entries for the projects.
+// GPG forwarding is kept for the hosts it was set up for, other changes to the entries are discarded
+func RegenerateProfileSshEntries(profileId string, projects []SshConfigProject) error {
+	err := ensureSshFilesLinked()
+	if err != nil {
+		return err
+	}
+
+	configPath, err := GetSshConfigPath()
+	if err != nil {
+		return err
+	}
+
+	existingContent, err := ReadSshConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	proxyCommandRegex := regexp.MustCompile(fmt.Sprintf(`ProxyCommand\s+.*ssh-proxy %s \S+ \S+`, regexp.QuoteMeta(profileId)))
+	entryRegex := regexp.MustCompile(`Host (\S+)\s*\n(?:\t.*\n?)*`)
+	gpgForwardRegex := regexp.MustCompile(`(?m)^\tStreamLocalBindUnlink\s+yes\s*\n\tRemoteForward\s+.+$`)
+
+	gpgForwards := map[string]string{}
+	removedHosts := []string{}
+	otherEntries := entryRegex.ReplaceAllStringFunc(existingContent, func(entry string) string {
+		if !proxyCommandRegex.MatchString(entry) {
+			return entry
+		}
+
+		host := entryRegex.FindStringSubmatch(entry)[1]
+		removedHosts = append(removedHosts, host)
+		if gpgForward := gpgForwardRegex.FindString(entry); gpgForward != "" {
+			gpgForwards[host] = gpgForward
+		}
+		return ""
+	})
+
+	// The pinned keys of the hosts that get a new entry are kept
+	removedHosts = slices.DeleteFunc(removedHosts, func(host string) bool {
+		return slices.ContainsFunc(projects, func(project SshConfigProject) bool {
+			return GetProjectHostname(profileId, project.WorkspaceId, project.ProjectName) == host
+		})
+	})
+
+	err = removeHostKeys(removedHosts...)
+	if err != nil {
+		return err
+	}
+
+	knownHostsFile, err := getKnownHostsFile()
+	if err != nil {
+		return err
+	}
+
+	newContent := ""
+	for _, project := range projects {
+		entry, err := generateSshConfigEntry(profileId, project.WorkspaceId, project.ProjectName, knownHostsFile, false)
+		if err != nil {
+			return err
+		}
+
+		if gpgForward, ok := gpgForwards[GetProjectHostname(profileId, project.WorkspaceId, project.ProjectName)]; ok {
+			entry = strings.TrimSuffix(entry, "\n") + gpgForward + "\n\n"
+		}
+
+		newContent += entry
+	}
+
+	return writeSshConfig(configPath, strings.TrimSpace(newContent+strings.TrimSpace(otherEntries)))
+}
+
 func UpdateWorkspaceSshEntry(profileId, workspaceId, projectName, updatedContent string) error {
-	sshDir := filepath.Join(SshHomeDir, ".ssh")
-	configPath := filepath.Join(sshDir, "daytona_config")
+	configPath, err := GetSshConfigPath()
+	if err != nil {
+		return err
+	}
 
 	existingContent, err := ReadSshConfig(configPath)
 	if err != nil {
@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/ssh"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// GetSshKeyPath returns the private key the CLI authenticates to the SSH servers of workspace projects with.
+// The key is only accepted after the Daytona Server granted it access to the workspace, which ssh-proxy does before every connection
+func GetSshKeyPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "id_ed25519"), nil
+}
+
+// LoadOrCreateSshKey reads the SSH key of the CLI or generates it if it doesn't exist yet
+func LoadOrCreateSshKey() (gossh.Signer, error) {
+	path, err := GetSshKeyPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return ssh.LoadOrCreatePrivateKey(path)
+}
+
+// getSshKeyFile returns the SSH key to reference in SSH config entries. The key is generated if it doesn't exist
+// so that the ssh command can read it
+func getSshKeyFile() (string, error) {
+	_, err := LoadOrCreateSshKey()
+	if err != nil {
+		return "", err
+	}
+
+	path, err := GetSshKeyPath()
+	if err != nil {
+		return "", err
+	}
+
+	return quoteSshConfigPath(path), nil
+}
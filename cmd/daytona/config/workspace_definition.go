@@ -0,0 +1,187 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// WorkspaceDefinition declares a workspace in a file (e.g. daytona.yaml) so that it can be created
+// with the same configuration by everyone
+type WorkspaceDefinition struct {
+	Name     string              `yaml:"name,omitempty"`
+	Target   string              `yaml:"target,omitempty"`
+	Group    string              `yaml:"group,omitempty"`
+	Labels   map[string]string   `yaml:"labels,omitempty"`
+	AutoStop string              `yaml:"autoStop,omitempty"`
+	Projects []ProjectDefinition `yaml:"projects"`
+	// Absolute path and SHA-256 of the file the definition was loaded from
+	Path   string `yaml:"-"`
+	Sha256 string `yaml:"-"`
+}
+
+type ProjectDefinition struct {
+	Repository string `yaml:"repository"`
+	Name       string `yaml:"name,omitempty"`
+	Branch     string `yaml:"branch,omitempty"`
+	// Image and user of the project. The image is built automatically if neither an image nor a devcontainer is set
+	Image        string            `yaml:"image,omitempty"`
+	User         string            `yaml:"user,omitempty"`
+	Devcontainer string            `yaml:"devcontainer,omitempty"`
+	Env          map[string]string `yaml:"env,omitempty"`
+	Ports        []uint16          `yaml:"ports,omitempty"`
+	// Shell commands run once after the project is created
+	OnCreate []string `yaml:"onCreate,omitempty"`
+	// Shell commands run every time the project is started
+	PostStart []string `yaml:"postStart,omitempty"`
+}
+
+// LoadWorkspaceDefinition reads and validates the workspace definition file at the path
+func LoadWorkspaceDefinition(path string) (*WorkspaceDefinition, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition WorkspaceDefinition
+	err = yaml.UnmarshalStrict(content, &definition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	err = definition.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid workspace definition %s: %w", path, err)
+	}
+
+	definition.Path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	definition.Sha256 = fmt.Sprintf("%x", sha256.Sum256(content))
+
+	return &definition, nil
+}
+
+// GetWorkspaceDefinitionSha256 returns the SHA-256 of the workspace definition file at the path,
+// e.g. to check whether it changed since a workspace was created from it
+func GetWorkspaceDefinitionSha256(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(content)), nil
+}
+
+// LoadWorkspaceDefinitionsFromDir reads the workspace definition files (*.yaml and *.yml) in the directory.
+// Definitions without a name are named after their file
+func LoadWorkspaceDefinitionsFromDir(dir string) ([]*WorkspaceDefinition, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := []*WorkspaceDefinition{}
+	files := map[string]string{}
+
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		definition, err := LoadWorkspaceDefinition(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if definition.Name == "" {
+			definition.Name = strings.TrimSuffix(entry.Name(), ext)
+		}
+
+		if file, ok := files[definition.Name]; ok {
+			return nil, fmt.Errorf("workspace %s is defined in both %s and %s", definition.Name, file, entry.Name())
+		}
+		files[definition.Name] = entry.Name()
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, nil
+}
+
+func (d *WorkspaceDefinition) Validate() error {
+	if len(d.Projects) == 0 {
+		return errors.New("at least one project is required")
+	}
+
+	_, err := d.GetAutoStop()
+	if err != nil {
+		return err
+	}
+
+	for i, project := range d.Projects {
+		err := project.Validate()
+		if err != nil {
+			return fmt.Errorf("project %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// GetAutoStop returns the parsed auto-stop timeout. Zero means auto-stop is disabled
+func (d *WorkspaceDefinition) GetAutoStop() (time.Duration, error) {
+	if d.AutoStop == "" {
+		return 0, nil
+	}
+
+	autoStop, err := time.ParseDuration(d.AutoStop)
+	if err != nil {
+		return 0, fmt.Errorf("invalid autoStop %s: %w", d.AutoStop, err)
+	}
+
+	if autoStop < time.Minute {
+		return 0, errors.New("autoStop must be at least one minute")
+	}
+
+	return autoStop, nil
+}
+
+func (p *ProjectDefinition) Validate() error {
+	if p.Repository == "" {
+		return errors.New("repository is required")
+	}
+
+	if (p.Image == "") != (p.User == "") {
+		return errors.New("image and user must be set together")
+	}
+
+	if p.Image != "" && p.Devcontainer != "" {
+		return errors.New("image and devcontainer can not be set together")
+	}
+
+	for key := range p.Env {
+		if key == "" {
+			return errors.New("environment variable names can not be empty")
+		}
+	}
+
+	for _, port := range p.Ports {
+		if port == 0 {
+			return errors.New("ports must be between 1 and 65535")
+		}
+	}
+
+	return nil
+}
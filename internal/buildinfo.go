@@ -5,4 +5,7 @@ package internal
 
 var (
 	Version = "v0.0.0-dev"
+	// Base64 encoded ed25519 key used to verify the signature of releases downloaded by daytona update.
+	// Set at build time with -ldflags. Builds without it can't install updates
+	ReleasePublicKey = ""
 )
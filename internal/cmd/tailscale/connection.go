@@ -6,6 +6,7 @@ package tailscale
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"path/filepath"
 	"strings"
 
@@ -20,6 +21,9 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// ErrNoNetworkAccess is returned when the API key of the profile is not allowed to join the network of the server
+var ErrNoNetworkAccess = errors.New("the API key is not allowed to join the network of the server")
+
 func GetConnection(profile *config.Profile) (*tsnet.Server, error) {
 	apiClient, err := apiclient_util.GetApiClient(profile)
 	if err != nil {
@@ -38,11 +42,19 @@ func GetConnection(profile *config.Profile) (*tsnet.Server, error) {
 
 	networkKey, res, err := apiClient.ServerAPI.GenerateNetworkKeyExecute(apiclient.ApiGenerateNetworkKeyRequest{})
 	if err != nil {
+		if res != nil && res.StatusCode == http.StatusForbidden {
+			return nil, ErrNoNetworkAccess
+		}
 		return nil, apiclient_util.HandleErrorResponse(res, err)
 	}
 
 	cliId := uuid.New().String()
 
+	err = setProxyEnv(profile)
+	if err != nil {
+		return nil, err
+	}
+
 	var controlURL string
 	if strings.Contains(profile.Api.Url, "localhost") || strings.Contains(profile.Api.Url, "0.0.0.0") || strings.Contains(profile.Api.Url, "127.0.0.1") {
 		controlURL = fmt.Sprintf("http://localhost:%d", serverConfig.HeadscalePort)
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// DialFunc connects to a port of a project
+type DialFunc func(ctx context.Context, port uint16) (net.Conn, error)
+
+// GetProjectDialer returns a function that connects to the ports of the project. Profiles with keys that are
+// allowed to join the network of the server connect directly, others through the tunnel of the server,
+// which checks their access to the workspace
+func GetProjectDialer(profile *config.Profile, workspaceId, projectName string) (DialFunc, error) {
+	tsConn, err := GetConnection(profile)
+	if err == nil {
+		return func(ctx context.Context, port uint16) (net.Conn, error) {
+			return tsConn.Dial(ctx, "tcp", fmt.Sprintf("%s:%d", project.GetProjectHostname(workspaceId, projectName), port))
+		}, nil
+	}
+
+	if !errors.Is(err, ErrNoNetworkAccess) {
+		return nil, err
+	}
+
+	return func(ctx context.Context, port uint16) (net.Conn, error) {
+		ws, res, err := apiclient_util.GetWebsocketConn(ctx, fmt.Sprintf("/workspace/%s/%s/tunnel/%d", workspaceId, projectName, port), profile, nil)
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return util.NewWebsocketConn(ws), nil
+	}, nil
+}
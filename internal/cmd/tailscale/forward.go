@@ -11,13 +11,21 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/pkg/ports"
-	"github.com/daytonaio/daytona/pkg/workspace/project"
-	"tailscale.com/tsnet"
 )
 
+// Forwarded ports only accept connections from the local machine unless another host is specified
+const DefaultForwardHost = "127.0.0.1"
+
 func ForwardPort(workspaceId, projectName string, targetPort uint16, profile config.Profile) (*uint16, chan error) {
+	return ForwardPortOnHost(workspaceId, projectName, targetPort, profile, DefaultForwardHost)
+}
+
+// ForwardPortOnHost forwards the project port to a local port that listens on the host address,
+// e.g. 0.0.0.0 to accept connections from the local network
+func ForwardPortOnHost(workspaceId, projectName string, targetPort uint16, profile config.Profile, host string) (*uint16, chan error) {
 	hostPort := targetPort
-	errChan := make(chan error)
+	// Buffered so that setup errors can be returned without a receiver waiting on the channel
+	errChan := make(chan error, 1)
 	var err error
 	if !ports.IsPortAvailable(targetPort) {
 		hostPort, err = ports.GetAvailableEphemeralPort()
@@ -27,13 +35,13 @@ func ForwardPort(workspaceId, projectName string, targetPort uint16, profile con
 		}
 	}
 
-	tsConn, err := GetConnection(&profile)
+	dial, err := GetProjectDialer(&profile, workspaceId, projectName)
 	if err != nil {
 		errChan <- err
 		return nil, errChan
 	}
 
-	netListener, err := net.Listen("tcp", fmt.Sprintf(":%d", hostPort))
+	netListener, err := net.Listen("tcp", net.JoinHostPort(host, fmt.Sprint(hostPort)))
 	if err != nil {
 		errChan <- err
 		return nil, errChan
@@ -47,17 +55,15 @@ func ForwardPort(workspaceId, projectName string, targetPort uint16, profile con
 				return
 			}
 
-			targetUrl := fmt.Sprintf("%s:%d", project.GetProjectHostname(workspaceId, projectName), targetPort)
-
-			go handlePortConnection(conn, tsConn, targetUrl, errChan)
+			go handlePortConnection(conn, dial, targetPort, errChan)
 		}
 	}()
 
 	return &hostPort, errChan
 }
 
-func handlePortConnection(conn net.Conn, tsConn *tsnet.Server, targetUrl string, errChan chan error) {
-	dialConn, err := tsConn.Dial(context.Background(), "tcp", targetUrl)
+func handlePortConnection(conn net.Conn, dial DialFunc, targetPort uint16, errChan chan error) {
+	dialConn, err := dial(context.Background(), targetPort)
 	if err != nil {
 		errChan <- err
 		return
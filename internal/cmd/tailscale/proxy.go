@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util/localproxy"
+	"github.com/daytonaio/daytona/internal/util/proxyjump"
+	"golang.org/x/net/proxy"
+)
+
+// setProxyEnv routes the tailscale control and DERP connections through the proxy or the jump host of the profile.
+// Both clients read the proxy from the environment variables and only support HTTP proxies,
+// so SOCKS5 proxies and jump hosts are served through a local HTTP proxy.
+func setProxyEnv(profile *config.Profile) error {
+	var proxyUrl string
+
+	switch {
+	case profile.ProxyJump != "":
+		localProxyUrl, err := localproxy.Listen(proxyjump.NewDialer(profile.ProxyJump, profile.ProxyJumpIdentityFile).DialContext)
+		if err != nil {
+			return err
+		}
+		proxyUrl = localProxyUrl
+	case profile.Proxy != "":
+		parsedUrl, err := url.Parse(profile.Proxy)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL %s: %w", profile.Proxy, err)
+		}
+
+		if parsedUrl.Scheme != "socks5" && parsedUrl.Scheme != "socks5h" {
+			proxyUrl = profile.Proxy
+			break
+		}
+
+		dialer, err := proxy.FromURL(parsedUrl, proxy.Direct)
+		if err != nil {
+			return err
+		}
+
+		contextDialer, ok := dialer.(proxy.ContextDialer)
+		if !ok {
+			return errors.New("SOCKS5 dialer does not support contexts")
+		}
+
+		localProxyUrl, err := localproxy.Listen(contextDialer.DialContext)
+		if err != nil {
+			return err
+		}
+		proxyUrl = localProxyUrl
+	default:
+		return nil
+	}
+
+	for _, envVar := range []string{"HTTP_PROXY", "HTTPS_PROXY"} {
+		err := os.Setenv(envVar, proxyUrl)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
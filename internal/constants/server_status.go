@@ -0,0 +1,10 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// Set on responses to requests that were rejected because the server is shutting down
+const SERVER_STATUS_HEADER = "X-Server-Status"
+
+// The server is shutting down and clients should retry once it is back
+const SERVER_STATUS_RESTARTING = "restarting"
@@ -0,0 +1,123 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+)
+
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+var (
+	locale       string
+	translations map[string]string
+	loadOnce     sync.Once
+)
+
+// T returns the translation of a message for the current locale, falling back to the message itself.
+// If args are provided, the translated message is used as a format string.
+func T(message string, args ...any) string {
+	loadOnce.Do(func() {
+		load(DetectLocale())
+	})
+
+	if translated, ok := translations[message]; ok && translated != "" {
+		message = translated
+	}
+
+	if len(args) == 0 {
+		return message
+	}
+
+	return fmt.Sprintf(message, args...)
+}
+
+// SetLocale overrides the detected locale. Unsupported locales fall back to English.
+func SetLocale(l string) {
+	loadOnce.Do(func() {})
+	load(normalizeLocale(l))
+}
+
+func GetLocale() string {
+	loadOnce.Do(func() {
+		load(DetectLocale())
+	})
+
+	return locale
+}
+
+func GetSupportedLocales() []string {
+	locales := []string{DefaultLocale}
+
+	entries, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		return locales
+	}
+
+	for _, entry := range entries {
+		locales = append(locales, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+
+	return locales
+}
+
+// DetectLocale reads the locale from DAYTONA_LANG or the standard POSIX locale environment variables
+func DetectLocale() string {
+	for _, envVar := range []string{"DAYTONA_LANG", "LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := os.Getenv(envVar)
+		if value == "" {
+			continue
+		}
+
+		return normalizeLocale(value)
+	}
+
+	return DefaultLocale
+}
+
+// normalizeLocale turns values like "es_ES.UTF-8" or "pt-BR" into a language code
+func normalizeLocale(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	value, _, _ = strings.Cut(value, ".")
+	value, _, _ = strings.Cut(value, "@")
+	value = strings.ReplaceAll(value, "-", "_")
+	value, _, _ = strings.Cut(value, "_")
+
+	if value == "" || value == "c" || value == "posix" {
+		return DefaultLocale
+	}
+
+	return value
+}
+
+func load(l string) {
+	locale = DefaultLocale
+	translations = map[string]string{}
+
+	if l == DefaultLocale {
+		return
+	}
+
+	content, err := localeFiles.ReadFile(path.Join("locales", l+".json"))
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(content, &translations)
+	if err != nil {
+		translations = map[string]string{}
+		return
+	}
+
+	locale = l
+}
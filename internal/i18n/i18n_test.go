@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package i18n
+
+import (
+	"encoding/json"
+	"path"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := map[string]string{
+		"es_ES.UTF-8": "es",
+		"pt-BR":       "pt",
+		"de_DE@euro":  "de",
+		"C":           DefaultLocale,
+		"POSIX":       DefaultLocale,
+		"":            DefaultLocale,
+	}
+
+	for input, expected := range tests {
+		require.Equal(t, expected, normalizeLocale(input), input)
+	}
+}
+
+func TestDetectLocale(t *testing.T) {
+	t.Setenv("DAYTONA_LANG", "")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "es_ES.UTF-8")
+	require.Equal(t, "es", DetectLocale())
+
+	t.Setenv("DAYTONA_LANG", "en")
+	require.Equal(t, "en", DetectLocale())
+}
+
+func TestTranslate(t *testing.T) {
+	SetLocale("es")
+	require.Equal(t, "es", GetLocale())
+	require.Equal(t, "No se encontraron workspaces", T("No workspaces found"))
+	require.Equal(t, "Perfil activo: default", T("Active profile: %s", "default"))
+	require.Equal(t, "Untranslated message", T("Untranslated message"))
+
+	SetLocale("xx")
+	require.Equal(t, DefaultLocale, GetLocale())
+	require.Equal(t, "No workspaces found", T("No workspaces found"))
+
+	SetLocale("en")
+}
+
+func TestTranslationsKeepFormatVerbs(t *testing.T) {
+	verbs := regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z%]`)
+
+	for _, l := range GetSupportedLocales()[1:] {
+		content, err := localeFiles.ReadFile(path.Join("locales", l+".json"))
+		require.NoError(t, err)
+
+		var messages map[string]string
+		require.NoError(t, json.Unmarshal(content, &messages), l)
+
+		for message, translated := range messages {
+			require.Equal(t, verbs.FindAllString(message, -1), verbs.FindAllString(translated, -1), "%s: %q", l, message)
+		}
+	}
+}
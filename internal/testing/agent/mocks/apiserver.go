@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/gin-gonic/gin"
 )
@@ -42,6 +43,9 @@ func NewMockRestServer(t *testing.T, workspace *workspace.Workspace) *httptest.S
 		workspaceController.GET("/:workspaceId", func(ctx *gin.Context) {
 			ctx.JSON(http.StatusOK, workspace)
 		})
+		workspaceController.GET("/:workspaceId/:projectId/secrets", func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, []secrets.ProjectSecret{})
+		})
 	}
 
 	gitproviderController := router.Group("/gitprovider")
@@ -7,6 +7,7 @@ package mocks
 
 import (
 	"errors"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -22,6 +23,15 @@ func (m *mockSshServer) Start() error {
 	return args.Error(0)
 }
 
+// IdleTime reports an open connection so that the agent never stops the workspace in tests
+func (m *mockSshServer) IdleTime() time.Duration {
+	return 0
+}
+
+func (m *mockSshServer) HostPublicKey() string {
+	return ""
+}
+
 func NewMockSshServer() *mockSshServer {
 	mockSshServer := new(mockSshServer)
 	mockSshServer.On("Start").Return(SshServerStartError)
@@ -7,6 +7,7 @@ package build
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/daytonaio/daytona/pkg/build"
 )
@@ -132,6 +133,13 @@ func (s *InMemoryBuildStore) processFilters(filter *build.Filter) ([]*build.Buil
 				}
 			}
 		}
+		if filter.CacheNamespaces != nil && len(*filter.CacheNamespaces) > 0 {
+			for _, b := range filteredBuilds {
+				if !slices.Contains(*filter.CacheNamespaces, b.CacheNamespace) {
+					delete(filteredBuilds, b.Id)
+				}
+			}
+		}
 		if filter.EnvVars != nil {
 			for _, b := range filteredBuilds {
 				if b.EnvVars == nil {
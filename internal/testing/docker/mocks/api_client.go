@@ -13,6 +13,7 @@ import (
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/system"
 	"github.com/docker/docker/client"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/mock"
@@ -47,6 +48,16 @@ func (m *MockApiClient) ImageList(ctx context.Context, options image.ListOptions
 	return args.Get(0).([]image.Summary), args.Error(1)
 }
 
+func (m *MockApiClient) ImageInspectWithRaw(ctx context.Context, image string) (types.ImageInspect, []byte, error) {
+	args := m.Called(ctx, image)
+	return args.Get(0).(types.ImageInspect), args.Get(1).([]byte), args.Error(2)
+}
+
+func (m *MockApiClient) ImageRemove(ctx context.Context, imageID string, options image.RemoveOptions) ([]image.DeleteResponse, error) {
+	args := m.Called(ctx, imageID, options)
+	return args.Get(0).([]image.DeleteResponse), args.Error(1)
+}
+
 func (m *MockApiClient) ImagePull(ctx context.Context, ref string, options image.PullOptions) (io.ReadCloser, error) {
 	args := m.Called(ctx, ref, options)
 	return args.Get(0).(io.ReadCloser), args.Error(1)
@@ -62,6 +73,11 @@ func (m *MockApiClient) ContainerCreate(ctx context.Context, config *container.C
 	return args.Get(0).(container.CreateResponse), args.Error(1)
 }
 
+func (m *MockApiClient) ContainerRename(ctx context.Context, container, newContainerName string) error {
+	args := m.Called(ctx, container, newContainerName)
+	return args.Error(0)
+}
+
 func (m *MockApiClient) ContainerRemove(ctx context.Context, container string, options container.RemoveOptions) error {
 	args := m.Called(ctx, container, options)
 	return args.Error(0)
@@ -77,6 +93,11 @@ func (m *MockApiClient) ContainerStop(ctx context.Context, container string, sto
 	return args.Error(0)
 }
 
+func (m *MockApiClient) ContainerCommit(ctx context.Context, container string, options container.CommitOptions) (types.IDResponse, error) {
+	args := m.Called(ctx, container, options)
+	return args.Get(0).(types.IDResponse), args.Error(1)
+}
+
 func (m *MockApiClient) ContainerExecCreate(ctx context.Context, container string, config container.ExecOptions) (types.IDResponse, error) {
 	args := m.Called(ctx, container, config)
 	return args.Get(0).(types.IDResponse), args.Error(1)
@@ -102,6 +123,11 @@ func (m *MockApiClient) ContainerInspect(ctx context.Context, container string)
 	return args.Get(0).(types.ContainerJSON), args.Error(1)
 }
 
+func (m *MockApiClient) ContainerStatsOneShot(ctx context.Context, containerID string) (container.StatsResponseReader, error) {
+	args := m.Called(ctx, containerID)
+	return args.Get(0).(container.StatsResponseReader), args.Error(1)
+}
+
 func (m *MockApiClient) ContainerList(ctx context.Context, options container.ListOptions) ([]types.Container, error) {
 	args := m.Called(ctx, options)
 	return args.Get(0).([]types.Container), args.Error(1)
@@ -111,3 +137,8 @@ func (m *MockApiClient) VolumeRemove(ctx context.Context, volume string, force b
 	args := m.Called(ctx, volume, force)
 	return args.Error(0)
 }
+
+func (m *MockApiClient) Info(ctx context.Context) (system.Info, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(system.Info), args.Error(1)
+}
@@ -0,0 +1,52 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package egress
+
+import (
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/workspace/egress"
+)
+
+type InMemoryEgressUsageStore struct {
+	usages map[string]*egress.Usage
+}
+
+func NewInMemoryEgressUsageStore() egress.Store {
+	return &InMemoryEgressUsageStore{
+		usages: make(map[string]*egress.Usage),
+	}
+}
+
+func (s *InMemoryEgressUsageStore) List(filter *egress.Filter) ([]*egress.Usage, error) {
+	result := []*egress.Usage{}
+
+	for _, u := range s.usages {
+		if filter != nil {
+			if filter.WorkspaceId != nil && u.WorkspaceId != *filter.WorkspaceId {
+				continue
+			}
+			if filter.ProjectName != nil && u.ProjectName != *filter.ProjectName {
+				continue
+			}
+			if filter.Month != nil && u.Month != *filter.Month {
+				continue
+			}
+		}
+		result = append(result, u)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Month > result[j].Month
+	})
+
+	return result, nil
+}
+
+func (s *InMemoryEgressUsageStore) Save(u *egress.Usage) error {
+	s.usages[u.WorkspaceId+"/"+u.ProjectName+"/"+u.Month] = u
+	return nil
+}
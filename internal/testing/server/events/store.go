@@ -0,0 +1,47 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+)
+
+type InMemoryEventStore struct {
+	events map[string]*events.Event
+}
+
+func NewInMemoryEventStore() events.Store {
+	return &InMemoryEventStore{
+		events: make(map[string]*events.Event),
+	}
+}
+
+func (s *InMemoryEventStore) List(filter *events.Filter) ([]*events.Event, error) {
+	result := []*events.Event{}
+
+	for _, e := range s.events {
+		if filter != nil && filter.Workspace != nil && e.WorkspaceId != *filter.Workspace && e.WorkspaceName != *filter.Workspace {
+			continue
+		}
+		if filter != nil && filter.Since != nil && !e.CreatedAt.After(*filter.Since) {
+			continue
+		}
+		result = append(result, e)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+
+	return result, nil
+}
+
+func (s *InMemoryEventStore) Save(e *events.Event) error {
+	s.events[e.Id] = e
+	return nil
+}
@@ -0,0 +1,44 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package failures
+
+import (
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/workspace/failures"
+)
+
+type InMemoryFailureStore struct {
+	failures map[string]*failures.Failure
+}
+
+func NewInMemoryFailureStore() failures.Store {
+	return &InMemoryFailureStore{
+		failures: make(map[string]*failures.Failure),
+	}
+}
+
+func (s *InMemoryFailureStore) List(filter *failures.Filter) ([]*failures.Failure, error) {
+	result := []*failures.Failure{}
+
+	for _, f := range s.failures {
+		if filter != nil && filter.Since != nil && f.CreatedAt.Before(*filter.Since) {
+			continue
+		}
+		result = append(result, f)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+
+	return result, nil
+}
+
+func (s *InMemoryFailureStore) Save(f *failures.Failure) error {
+	s.failures[f.Id] = f
+	return nil
+}
@@ -0,0 +1,43 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package invites
+
+import (
+	"github.com/daytonaio/daytona/pkg/invite"
+)
+
+type InMemoryInviteStore struct {
+	invites map[string]*invite.Invite
+}
+
+func NewInMemoryInviteStore() invite.Store {
+	return &InMemoryInviteStore{
+		invites: make(map[string]*invite.Invite),
+	}
+}
+
+func (s *InMemoryInviteStore) Find(codeHash string) (*invite.Invite, error) {
+	inv, ok := s.invites[codeHash]
+	if !ok {
+		return nil, invite.ErrInviteNotFound
+	}
+
+	return inv, nil
+}
+
+func (s *InMemoryInviteStore) Save(inv *invite.Invite) error {
+	s.invites[inv.CodeHash] = inv
+	return nil
+}
+
+func (s *InMemoryInviteStore) Delete(inv *invite.Invite) error {
+	if _, ok := s.invites[inv.CodeHash]; !ok {
+		return invite.ErrInviteNotFound
+	}
+
+	delete(s.invites, inv.CodeHash)
+	return nil
+}
@@ -6,8 +6,6 @@
 package projectconfig
 
 import (
-	"fmt"
-
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 )
 
@@ -60,7 +58,7 @@ func (s *InMemoryProjectConfigStore) processFilters(filter *config.ProjectConfig
 			if ok {
 				return []*config.ProjectConfig{projectConfig}, nil
 			} else {
-				return []*config.ProjectConfig{}, fmt.Errorf("project config with name %s not found", *filter.Name)
+				return []*config.ProjectConfig{}, config.ErrProjectConfigNotFound
 			}
 		}
 		if filter.Url != nil {
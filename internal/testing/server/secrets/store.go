@@ -0,0 +1,78 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type InMemorySecretStore struct {
+	secrets map[string]*secret.Secret
+}
+
+func NewInMemorySecretStore() secret.Store {
+	return &InMemorySecretStore{
+		secrets: make(map[string]*secret.Secret),
+	}
+}
+
+func (s *InMemorySecretStore) List(filter *secret.Filter) ([]*secret.Secret, error) {
+	return s.processFilters(filter), nil
+}
+
+func (s *InMemorySecretStore) Find(filter *secret.Filter) (*secret.Secret, error) {
+	secrets := s.processFilters(filter)
+	if len(secrets) == 0 {
+		return nil, secret.ErrSecretNotFound
+	}
+
+	return secrets[0], nil
+}
+
+func (s *InMemorySecretStore) Save(sec *secret.Secret) error {
+	s.secrets[getKey(sec)] = sec
+	return nil
+}
+
+func (s *InMemorySecretStore) Delete(sec *secret.Secret) error {
+	if _, ok := s.secrets[getKey(sec)]; !ok {
+		return secret.ErrSecretNotFound
+	}
+
+	delete(s.secrets, getKey(sec))
+	return nil
+}
+
+func (s *InMemorySecretStore) processFilters(filter *secret.Filter) []*secret.Secret {
+	result := []*secret.Secret{}
+
+	for _, sec := range s.secrets {
+		if filter != nil {
+			if filter.Name != nil && sec.Name != *filter.Name {
+				continue
+			}
+			if filter.WorkspaceId != nil && sec.WorkspaceId != *filter.WorkspaceId {
+				continue
+			}
+			if filter.ProjectName != nil && sec.ProjectName != *filter.ProjectName {
+				continue
+			}
+		}
+		result = append(result, sec)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+func getKey(sec *secret.Secret) string {
+	return sec.WorkspaceId + "/" + sec.ProjectName + "/" + sec.Name
+}
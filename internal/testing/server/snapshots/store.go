@@ -0,0 +1,70 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshots
+
+import (
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/workspace/snapshot"
+)
+
+type InMemorySnapshotStore struct {
+	snapshots map[string]*snapshot.Snapshot
+}
+
+func NewInMemorySnapshotStore() snapshot.Store {
+	return &InMemorySnapshotStore{
+		snapshots: make(map[string]*snapshot.Snapshot),
+	}
+}
+
+func (s *InMemorySnapshotStore) List(filter *snapshot.Filter) ([]*snapshot.Snapshot, error) {
+	return s.processFilters(filter), nil
+}
+
+func (s *InMemorySnapshotStore) Find(filter *snapshot.Filter) (*snapshot.Snapshot, error) {
+	snapshots := s.processFilters(filter)
+	if len(snapshots) == 0 {
+		return nil, snapshot.ErrSnapshotNotFound
+	}
+
+	return snapshots[0], nil
+}
+
+func (s *InMemorySnapshotStore) Save(sn *snapshot.Snapshot) error {
+	s.snapshots[sn.Id] = sn
+	return nil
+}
+
+func (s *InMemorySnapshotStore) Delete(sn *snapshot.Snapshot) error {
+	delete(s.snapshots, sn.Id)
+	return nil
+}
+
+func (s *InMemorySnapshotStore) processFilters(filter *snapshot.Filter) []*snapshot.Snapshot {
+	result := []*snapshot.Snapshot{}
+
+	for _, sn := range s.snapshots {
+		if filter != nil {
+			if filter.IdOrName != nil && sn.Id != *filter.IdOrName && sn.Name != *filter.IdOrName {
+				continue
+			}
+			if filter.WorkspaceId != nil && sn.WorkspaceId != *filter.WorkspaceId {
+				continue
+			}
+			if filter.ProjectName != nil && sn.ProjectName != *filter.ProjectName {
+				continue
+			}
+		}
+		result = append(result, sn)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+
+	return result
+}
@@ -0,0 +1,47 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package timings
+
+import (
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/workspace/timings"
+)
+
+type InMemoryCreationTimingStore struct {
+	creationTimings map[string]*timings.CreationTiming
+}
+
+func NewInMemoryCreationTimingStore() timings.Store {
+	return &InMemoryCreationTimingStore{
+		creationTimings: make(map[string]*timings.CreationTiming),
+	}
+}
+
+func (s *InMemoryCreationTimingStore) List(filter *timings.Filter) ([]*timings.CreationTiming, error) {
+	result := []*timings.CreationTiming{}
+
+	for _, t := range s.creationTimings {
+		if filter != nil && filter.WorkspaceId != nil && t.WorkspaceId != *filter.WorkspaceId {
+			continue
+		}
+		if filter != nil && filter.Target != nil && t.Target != *filter.Target {
+			continue
+		}
+		result = append(result, t)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+
+	return result, nil
+}
+
+func (s *InMemoryCreationTimingStore) Save(t *timings.CreationTiming) error {
+	s.creationTimings[t.Id] = t
+	return nil
+}
@@ -6,6 +6,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/stretchr/testify/mock"
 )
@@ -23,11 +25,56 @@ func (s *mockApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (st
 	return args.String(0), args.Error(1)
 }
 
+func (s *mockApiKeyService) GenerateReadOnly(name string) (string, error) {
+	args := s.Called(name)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) GenerateAdmin(name string) (string, error) {
+	args := s.Called(name)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) GenerateWorkspaceAccess(name, workspaceId string, expiresAt time.Time) (string, error) {
+	args := s.Called(name, workspaceId, expiresAt)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) GetApiKeyName(apiKey string) (string, error) {
+	args := s.Called(apiKey)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) GrantAdmin(apiKey string) error {
+	args := s.Called(apiKey)
+	return args.Error(0)
+}
+
+func (s *mockApiKeyService) GetApiKeyWorkspaceId(apiKey string) string {
+	args := s.Called(apiKey)
+	return args.String(0)
+}
+
+func (s *mockApiKeyService) Import(keyType apikey.ApiKeyType, name string, key string) error {
+	args := s.Called(keyType, name, key)
+	return args.Error(0)
+}
+
+func (s *mockApiKeyService) IsAdminApiKey(apiKey string) bool {
+	args := s.Called(apiKey)
+	return args.Bool(0)
+}
+
 func (s *mockApiKeyService) IsProjectApiKey(apiKey string) bool {
 	args := s.Called(apiKey)
 	return args.Bool(0)
 }
 
+func (s *mockApiKeyService) IsReadOnlyApiKey(apiKey string) bool {
+	args := s.Called(apiKey)
+	return args.Bool(0)
+}
+
 func (s *mockApiKeyService) IsValidApiKey(apiKey string) bool {
 	args := s.Called(apiKey)
 	return args.Bool(0)
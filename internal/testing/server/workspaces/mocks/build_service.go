@@ -42,6 +42,11 @@ func (m *MockBuildService) MarkForDeletion(filter *build.Filter, force bool) []e
 	return args.Get(0).([]error)
 }
 
+func (m *MockBuildService) Cancel(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
 func (m *MockBuildService) Delete(id string) error {
 	args := m.Called(id)
 	return args.Error(0)
@@ -65,6 +65,21 @@ func (m *mockProjectConfigService) DeletePrebuild(projectConfigName string, id s
 	return args.Get(0).([]error)
 }
 
+func (m *mockProjectConfigService) TriggerPrebuild(projectConfigName string, prebuildId string) (string, error) {
+	args := m.Called(projectConfigName, prebuildId)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockProjectConfigService) StartPrebuildPoller() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *mockProjectConfigService) PollPrebuilds() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
 func (m *mockProjectConfigService) StartRetentionPoller() error {
 	args := m.Called()
 	return args.Error(0)
@@ -23,8 +23,13 @@ func NewMockProvisioner() *mockProvisioner {
 	return &mockProvisioner{}
 }
 
-func (p *mockProvisioner) CreateProject(params provisioner.ProjectParams) error {
+func (p *mockProvisioner) CreateProject(params provisioner.ProjectParams) (*provider.ProjectCreationTimings, error) {
 	args := p.Called(params)
+	return args.Get(0).(*provider.ProjectCreationTimings), args.Error(1)
+}
+
+func (p *mockProvisioner) CreateProjectSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotImage string) error {
+	args := p.Called(proj, target, snapshotImage)
 	return args.Error(0)
 }
 
@@ -33,6 +38,11 @@ func (p *mockProvisioner) CreateWorkspace(workspace *workspace.Workspace, target
 	return args.Error(0)
 }
 
+func (p *mockProvisioner) DeleteProjectSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotImage string) error {
+	args := p.Called(proj, target, snapshotImage)
+	return args.Error(0)
+}
+
 func (p *mockProvisioner) DestroyProject(proj *project.Project, target *provider.ProviderTarget) error {
 	args := p.Called(proj, target)
 	return args.Error(0)
@@ -48,11 +58,21 @@ func (p *mockProvisioner) GetWorkspaceInfo(ctx context.Context, w *workspace.Wor
 	return args.Get(0).(*workspace.WorkspaceInfo), args.Error(1)
 }
 
-func (p *mockProvisioner) StartProject(params provisioner.ProjectParams) error {
-	args := p.Called(params)
+func (p *mockProvisioner) HibernateProject(proj *project.Project, target *provider.ProviderTarget) error {
+	args := p.Called(proj, target)
 	return args.Error(0)
 }
 
+func (p *mockProvisioner) RestoreProjectSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotImage string) error {
+	args := p.Called(proj, target, snapshotImage)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) StartProject(params provisioner.ProjectParams) (*provider.ProjectCreationTimings, error) {
+	args := p.Called(params)
+	return args.Get(0).(*provider.ProjectCreationTimings), args.Error(1)
+}
+
 func (p *mockProvisioner) StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
 	args := p.Called(workspace, target)
 	return args.Error(0)
@@ -67,3 +87,8 @@ func (p *mockProvisioner) StopWorkspace(workspace *workspace.Workspace, target *
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
+
+func (p *mockProvisioner) WakeProject(proj *project.Project, target *provider.ProviderTarget) error {
+	args := p.Called(proj, target)
+	return args.Error(0)
+}
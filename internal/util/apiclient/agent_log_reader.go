@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// ReadAgentLogs writes the log of the project agent to w. The log is read through the project toolbox,
+// so it is end-to-end encrypted if the profile of the client enables it
+func ReadAgentLogs(ctx context.Context, apiClient *apiclient.APIClient, workspaceId, projectName string, follow bool, w io.Writer) error {
+	cfg := apiClient.GetConfig()
+
+	serverUrl, err := cfg.ServerURL(0, nil)
+	if err != nil {
+		return err
+	}
+
+	logsUrl, err := url.JoinPath(serverUrl, "workspace", workspaceId, projectName, "toolbox", "logs")
+	if err != nil {
+		return err
+	}
+
+	if follow {
+		logsUrl += "?follow=true"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logsUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range cfg.DefaultHeader {
+		req.Header.Set(key, value)
+	}
+
+	res, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return HandleErrorResponse(res, errors.New(res.Status))
+	}
+	defer res.Body.Close()
+
+	_, err = io.Copy(w, res.Body)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	return nil
+}
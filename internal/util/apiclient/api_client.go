@@ -13,8 +13,12 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal"
 	"github.com/daytonaio/daytona/internal/constants"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/internal/util/endpoint"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+
+	log "github.com/sirupsen/logrus"
 )
 
 const CLIENT_VERSION_HEADER = "X-Client-Version"
@@ -26,8 +30,6 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 		return apiClient, nil
 	}
 
-	var newApiClient *apiclient.APIClient
-
 	c, err := config.GetConfig()
 	if err != nil {
 		return nil, err
@@ -44,6 +46,18 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 		activeProfile = *profile
 	}
 
+	newApiClient, err := NewApiClient(activeProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient = newApiClient
+	return apiClient, nil
+}
+
+// NewApiClient creates a client for the server of the profile and checks that the server is reachable.
+// Unlike GetApiClient, the client is not reused by subsequent calls
+func NewApiClient(activeProfile config.Profile) (*apiclient.APIClient, error) {
 	serverUrl := activeProfile.Api.Url
 	apiKey := activeProfile.Api.Key
 
@@ -57,7 +71,7 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 	clientConfig.AddDefaultHeader("Authorization", fmt.Sprintf("Bearer %s", apiKey))
 	clientConfig.AddDefaultHeader(CLIENT_VERSION_HEADER, internal.Version)
 
-	if c.TelemetryEnabled {
+	if config.TelemetryEnabled() {
 		clientConfig.AddDefaultHeader(telemetry.ENABLED_HEADER, "true")
 		clientConfig.AddDefaultHeader(telemetry.SESSION_ID_HEADER, internal.SESSION_ID)
 		clientConfig.AddDefaultHeader(telemetry.CLIENT_ID_HEADER, config.GetClientId())
@@ -68,24 +82,55 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 		}
 	}
 
-	newApiClient = apiclient.NewAPIClient(clientConfig)
-
-	newApiClient.GetConfig().HTTPClient = &http.Client{
-		Transport: http.DefaultTransport,
-	}
-
-	healthUrl, err := url.JoinPath(serverUrl, constants.HEALTH_CHECK_ROUTE)
+	endpoints, err := endpoint.Resolve(activeProfile)
 	if err != nil {
 		return nil, err
 	}
 
-	_, _, err = newApiClient.DefaultAPI.HealthCheck(context.Background()).Execute()
-	if err != nil {
-		return nil, ErrHealthCheckFailed(healthUrl)
+	newApiClient := apiclient.NewAPIClient(clientConfig)
+
+	// The endpoints after the first are fallbacks of the auto transport
+	for i, e := range endpoints {
+		var transport http.RoundTripper = newRetryTransport(e.HttpTransport)
+		if activeProfile.E2EEncryption {
+			transport = newE2ETransport(activeProfile.Id, transport)
+		}
+		if dryRun {
+			// Wraps the other transports so that requests are printed before they are encrypted
+			transport = newDryRunTransport(transport)
+		}
+
+		newApiClient.GetConfig().Servers = apiclient.ServerConfigurations{
+			{
+				URL: e.ServerUrl,
+			},
+		}
+		newApiClient.GetConfig().HTTPClient = &http.Client{
+			Transport: transport,
+		}
+
+		_, _, err = newApiClient.DefaultAPI.HealthCheck(context.Background()).Execute()
+		if err == nil {
+			return newApiClient, nil
+		}
+
+		if i < len(endpoints)-1 {
+			log.Debugf("Failed to connect to the server over %s, trying the next transport: %s", e.Description, err)
+			continue
+		}
+
+		healthUrl, joinErr := url.JoinPath(serverUrl, constants.HEALTH_CHECK_ROUTE)
+		if joinErr != nil {
+			return nil, joinErr
+		}
+
+		if e.Transport == config.ServerTransportTcp {
+			return nil, ErrHealthCheckFailed(healthUrl)
+		}
+		return nil, ErrTransportHealthCheckFailed(healthUrl, e.Description, err)
 	}
 
-	apiClient = newApiClient
-	return apiClient, nil
+	return nil, errors.New(i18n.T("no transport available for the server"))
 }
 
 func GetAgentApiClient(apiUrl, apiKey, clientId string, telemetryEnabled bool) (*apiclient.APIClient, error) {
@@ -109,7 +154,7 @@ func GetAgentApiClient(apiUrl, apiKey, clientId string, telemetryEnabled bool) (
 	apiClient = apiclient.NewAPIClient(clientConfig)
 
 	apiClient.GetConfig().HTTPClient = &http.Client{
-		Transport: http.DefaultTransport,
+		Transport: newRetryTransport(http.DefaultTransport),
 	}
 
 	return apiClient, nil
@@ -146,7 +191,7 @@ func GetFirstWorkspaceProjectName(workspaceId string, projectName string, profil
 
 	if projectName == "" {
 		if len(wsInfo.Projects) == 0 {
-			return "", errors.New("no projects found in workspace")
+			return "", errors.New(i18n.T("no projects found in workspace"))
 		}
 
 		return wsInfo.Projects[0].Name, nil
@@ -158,5 +203,5 @@ func GetFirstWorkspaceProjectName(workspaceId string, projectName string, profil
 		}
 	}
 
-	return "", errors.New("project not found in workspace")
+	return "", errors.New(i18n.T("project not found in workspace"))
 }
@@ -0,0 +1,102 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Number of stream disconnects within the failure window after which the connection is considered unstable
+	streamFailureThreshold = 3
+	streamFailureWindow    = time.Minute
+	// Number of consecutive successful polls after which streaming is tried again
+	pollSuccessThreshold = 10
+	pollInterval         = 2 * time.Second
+	maxPollInterval      = 30 * time.Second
+)
+
+// ConnectionMonitor tracks the stability of the connection to the server and decides whether
+// watch modes should stream or poll. Streams that keep disconnecting switch the monitor to polling
+// and a series of successful polls switches it back to streaming
+type ConnectionMonitor struct {
+	mu             sync.Mutex
+	streamFailures []time.Time
+	polling        bool
+	pollSuccesses  int
+	pollFailures   int
+}
+
+func NewConnectionMonitor() *ConnectionMonitor {
+	return &ConnectionMonitor{}
+}
+
+// Polling returns true if the connection is unstable and the caller should poll instead of streaming
+func (m *ConnectionMonitor) Polling() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.polling
+}
+
+// StreamFailed records an unexpected disconnect of a stream
+func (m *ConnectionMonitor) StreamFailed() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	failures := []time.Time{}
+	for _, failure := range m.streamFailures {
+		if now.Sub(failure) < streamFailureWindow {
+			failures = append(failures, failure)
+		}
+	}
+	m.streamFailures = append(failures, now)
+
+	if !m.polling && len(m.streamFailures) >= streamFailureThreshold {
+		log.Debug("Connection is unstable, switching to polling")
+		m.polling = true
+		m.pollSuccesses = 0
+		m.pollFailures = 0
+	}
+}
+
+// PollSucceeded records a successful poll. Returns true if the connection is stable again
+// and the caller should switch back to streaming
+func (m *ConnectionMonitor) PollSucceeded() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pollFailures = 0
+	m.pollSuccesses++
+
+	if m.polling && m.pollSuccesses >= pollSuccessThreshold {
+		log.Debug("Connection is stable, switching to streaming")
+		m.polling = false
+		m.streamFailures = nil
+	}
+
+	return !m.polling
+}
+
+// PollFailed records a failed poll
+func (m *ConnectionMonitor) PollFailed() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pollSuccesses = 0
+	m.pollFailures++
+}
+
+// PollInterval returns the delay before the next poll. The interval backs off while polls keep failing
+func (m *ConnectionMonitor) PollInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return util.GetJitteredBackoff(pollInterval, maxPollInterval, m.pollFailures)
+}
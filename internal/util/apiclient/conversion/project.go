@@ -36,6 +36,10 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 			UpdatedAt: projectDTO.State.UpdatedAt,
 			Uptime:    uint64(uptime),
 			GitStatus: ToGitStatus(projectDTO.State.GitStatus),
+			HostKey:   projectDTO.State.GetHostKey(),
+		}
+		if projectDTO.State.IdleTime != nil {
+			projectState.IdleTime = uint64(*projectDTO.State.IdleTime)
 		}
 	}
 
@@ -66,9 +70,37 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 		project.Repository.PrNumber = &prNumber
 	}
 
+	project.Resources = ToResourceLimits(projectDTO.Resources)
+	project.OnCreateCommands = projectDTO.OnCreateCommands
+	project.PostStartCommands = projectDTO.PostStartCommands
+	project.Runtimes = projectDTO.Runtimes
+
+	for _, port := range projectDTO.ForwardPorts {
+		project.ForwardPorts = append(project.ForwardPorts, uint16(port))
+	}
+
 	return project
 }
 
+func ToResourceLimits(resourcesDTO *apiclient.ResourceLimits) *project.ResourceLimits {
+	if resourcesDTO == nil {
+		return nil
+	}
+
+	resources := &project.ResourceLimits{}
+	if resourcesDTO.Cpus != nil {
+		resources.Cpus = *resourcesDTO.Cpus
+	}
+	if resourcesDTO.Memory != nil {
+		resources.Memory = uint32(*resourcesDTO.Memory)
+	}
+	if resourcesDTO.Disk != nil {
+		resources.Disk = uint32(*resourcesDTO.Disk)
+	}
+
+	return resources
+}
+
 func ToGitStatus(gitStatusDTO *apiclient.GitStatus) *project.GitStatus {
 	if gitStatusDTO == nil {
 		return nil
@@ -157,6 +189,7 @@ func ToProjectConfig(createProjectConfigDto pc_dto.CreateProjectConfigDTO) *conf
 		BuildConfig:         createProjectConfigDto.BuildConfig,
 		EnvVars:             createProjectConfigDto.EnvVars,
 		GitProviderConfigId: createProjectConfigDto.GitProviderConfigId,
+		ForwardPorts:        createProjectConfigDto.ForwardPorts,
 	}
 
 	result.RepositoryUrl = createProjectConfigDto.RepositoryUrl
@@ -174,11 +207,17 @@ func ToProjectConfig(createProjectConfigDto pc_dto.CreateProjectConfigDTO) *conf
 
 func CreateDtoToProject(createProjectDto project_dto.CreateProjectDTO) *project.Project {
 	p := &project.Project{
-		Name:                createProjectDto.Name,
-		BuildConfig:         createProjectDto.BuildConfig,
-		Repository:          createProjectDto.Source.Repository,
-		EnvVars:             createProjectDto.EnvVars,
-		GitProviderConfigId: createProjectDto.GitProviderConfigId,
+		Name:                 createProjectDto.Name,
+		BuildConfig:          createProjectDto.BuildConfig,
+		Repository:           createProjectDto.Source.Repository,
+		EnvVars:              createProjectDto.EnvVars,
+		GitProviderConfigId:  createProjectDto.GitProviderConfigId,
+		Resources:            createProjectDto.Resources,
+		ProjectConfigName:    createProjectDto.ProjectConfigName,
+		ProjectConfigVersion: createProjectDto.ProjectConfigVersion,
+		OnCreateCommands:     createProjectDto.OnCreateCommands,
+		PostStartCommands:    createProjectDto.PostStartCommands,
+		ForwardPorts:         createProjectDto.ForwardPorts,
 	}
 
 	if createProjectDto.Image != nil {
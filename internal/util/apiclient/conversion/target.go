@@ -13,5 +13,6 @@ func ToProviderTarget(createProviderTargetDto dto.CreateProviderTargetDTO) *prov
 		Name:         createProviderTargetDto.Name,
 		ProviderInfo: createProviderTargetDto.ProviderInfo,
 		Options:      createProviderTargetDto.Options,
+		Labels:       createProviderTargetDto.Labels,
 	}
 }
@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+var dryRun bool
+
+// EnableDryRun makes API clients print the requests that change the server state instead of sending them
+func EnableDryRun() {
+	dryRun = true
+}
+
+func IsDryRun() bool {
+	return dryRun
+}
+
+type dryRunTransport struct {
+	base http.RoundTripper
+}
+
+func newDryRunTransport(base http.RoundTripper) http.RoundTripper {
+	return &dryRunTransport{base: base}
+}
+
+// RoundTrip sends read-only requests. Other requests are printed and answered with an empty response
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions {
+		return t.base.RoundTrip(req)
+	}
+
+	fmt.Fprintf(os.Stdout, "[dry-run] %s %s\n", req.Method, req.URL.Redacted())
+
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(body) > 0 {
+			var indented bytes.Buffer
+			if json.Indent(&indented, body, "", "  ") == nil {
+				body = indented.Bytes()
+			}
+			fmt.Fprintf(os.Stdout, "%s\n", body)
+		}
+	}
+
+	return &http.Response{
+		Status:     "204 No Content",
+		StatusCode: http.StatusNoContent,
+		Proto:      req.Proto,
+		ProtoMajor: req.ProtoMajor,
+		ProtoMinor: req.ProtoMinor,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"crypto/ecdh"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/e2e"
+	"github.com/daytonaio/daytona/pkg/views/profile"
+)
+
+var toolboxPathRegex = regexp.MustCompile(`^((.*/workspace/[^/]+)/([^/]+))/toolbox/(.*)$`)
+
+// e2eKeyResolver fetches and pins the public keys of project agents on first use
+type e2eKeyResolver struct {
+	profileId string
+	base      http.RoundTripper
+	keys      sync.Map
+	// Serializes key lookups so the user is asked to verify a key only once
+	mu sync.Mutex
+}
+
+func newE2ETransport(profileId string, base http.RoundTripper) http.RoundTripper {
+	resolver := &e2eKeyResolver{
+		profileId: profileId,
+		base:      base,
+	}
+
+	return &e2e.Transport{
+		Base:        base,
+		GetAgentKey: resolver.getAgentKey,
+	}
+}
+
+func (r *e2eKeyResolver) getAgentKey(req *http.Request) (*ecdh.PublicKey, error) {
+	matches := toolboxPathRegex.FindStringSubmatch(req.URL.Path)
+	if matches == nil || matches[4] == "e2e/public-key" {
+		return nil, nil
+	}
+
+	projectPath, workspacePath, projectName := matches[1], matches[2], matches[3]
+
+	if key, ok := r.keys.Load(projectPath); ok {
+		return key.(*ecdh.PublicKey), nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if key, ok := r.keys.Load(projectPath); ok {
+		return key.(*ecdh.PublicKey), nil
+	}
+
+	key, err := r.fetchAgentKey(req, projectPath+"/toolbox/e2e/public-key")
+	if err != nil {
+		return nil, err
+	}
+
+	// Keys are pinned by workspace id so a recreated workspace with the same name is not mistaken for the old one
+	workspaceId, err := r.fetchWorkspaceId(req, workspacePath)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedKey := e2e.EncodePublicKey(key)
+
+	knownKey, err := config.GetKnownE2EKey(r.profileId, workspaceId, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if knownKey == "" {
+		// The key is received through the Daytona Server, so it is only pinned after the user verified it out of band
+		trusted, err := profile.TrustKeyPrompt("end-to-end encryption key", projectName, e2e.Fingerprint(key))
+		if err != nil {
+			return nil, fmt.Errorf("end-to-end encryption key %s of project %s is not trusted: %w", e2e.Fingerprint(key), projectName, err)
+		}
+		if !trusted {
+			return nil, fmt.Errorf("end-to-end encryption key %s of project %s was not trusted", e2e.Fingerprint(key), projectName)
+		}
+
+		err = config.SaveKnownE2EKey(r.profileId, workspaceId, projectName, encodedKey)
+		if err != nil {
+			return nil, err
+		}
+	} else if knownKey != encodedKey {
+		return nil, fmt.Errorf("end-to-end encryption key of project %s changed to %s. If the project was recreated, remove its entry from %s", projectName, e2e.Fingerprint(key), "e2e_known_keys.json")
+	}
+
+	r.keys.Store(projectPath, key)
+
+	return key, nil
+}
+
+func (r *e2eKeyResolver) fetchAgentKey(req *http.Request, path string) (*ecdh.PublicKey, error) {
+	var response struct {
+		PublicKey string `json:"publicKey"`
+	}
+
+	res, err := r.get(req, path, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, errors.New("the project agent does not support end-to-end encryption")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get end-to-end encryption key: %s", res.Status)
+	}
+
+	return e2e.DecodePublicKey(response.PublicKey)
+}
+
+func (r *e2eKeyResolver) fetchWorkspaceId(req *http.Request, path string) (string, error) {
+	var workspace struct {
+		Id string `json:"id"`
+	}
+
+	res, err := r.get(req, path, &workspace)
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get workspace: %s", res.Status)
+	}
+
+	return workspace.Id, nil
+}
+
+// get sends a GET request with the headers of the original request and decodes successful JSON responses into v
+func (r *e2eKeyResolver) get(req *http.Request, path string, v interface{}) (*http.Response, error) {
+	reqUrl := url.URL{
+		Scheme: req.URL.Scheme,
+		Host:   req.URL.Host,
+		Path:   path,
+	}
+
+	getReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, reqUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range req.Header {
+		if strings.EqualFold(key, "Content-Type") || strings.EqualFold(key, "Content-Length") {
+			continue
+		}
+		getReq.Header[key] = values
+	}
+
+	res, err := r.base.RoundTrip(getReq)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		err = json.NewDecoder(res.Body).Decode(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
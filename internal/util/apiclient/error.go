@@ -4,14 +4,46 @@
 package apiclient
 
 import (
-	"fmt"
+	"errors"
 	"strings"
+
+	"github.com/daytonaio/daytona/internal/i18n"
 )
 
+// healthCheckError is a failed health check of the server. It is detected by type because its message is translated
+type healthCheckError struct {
+	message string
+	err     error
+}
+
+func (e *healthCheckError) Error() string {
+	return e.message
+}
+
+func (e *healthCheckError) Unwrap() error {
+	return e.err
+}
+
 func ErrHealthCheckFailed(healthUrl string) error {
-	return fmt.Errorf("failed to check server health at: %s. Make sure Daytona is running on the appropriate port", healthUrl)
+	return &healthCheckError{
+		message: i18n.T("failed to check server health at: %s. Make sure Daytona is running on the appropriate port", healthUrl),
+	}
+}
+
+// ErrTransportHealthCheckFailed is returned when the server can not be reached over a non-TCP transport of the profile
+func ErrTransportHealthCheckFailed(healthUrl, transport string, err error) error {
+	return &healthCheckError{
+		message: i18n.T("failed to check server health at: %s over %s: %s", healthUrl, transport, err),
+		err:     err,
+	}
 }
 
 func IsHealthCheckFailed(err error) bool {
+	var healthCheckErr *healthCheckError
+	if errors.As(err, &healthCheckErr) {
+		return true
+	}
+
+	// Errors relayed by the server are not translated
 	return strings.HasPrefix(err.Error(), "failed to check server health at:")
 }
@@ -15,6 +15,7 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	log "github.com/sirupsen/logrus"
 )
@@ -48,7 +49,7 @@ func HandleErrorResponse(res *http.Response, requestErr error) error {
 			return errors.New(string(body))
 		}
 
-		return fmt.Errorf("an error occurred and an html page was returned. You can check the page at %s", fileName)
+		return errors.New(i18n.T("an error occurred and an html page was returned. You can check the page at %s", fileName))
 	}
 
 	var errResponse ApiErrorResponse
@@ -68,6 +69,6 @@ func HandleErrorResponse(res *http.Response, requestErr error) error {
 func checkVersionsMismatch(res *http.Response) {
 	serverVersion := res.Header.Get(middlewares.SERVER_VERSION_HEADER)
 	if internal.Version != serverVersion {
-		log.Warn(fmt.Sprintf("Version mismatch detected. CLI is on version %s, Daytona Server is on version %s. To ensure maximum compatibility, please make sure the versions are aligned.", internal.Version, serverVersion))
+		log.Warn(i18n.T("Version mismatch detected. CLI is on version %s, Daytona Server is on version %s. To ensure maximum compatibility, please make sure the versions are aligned.", internal.Version, serverVersion))
 	}
 }
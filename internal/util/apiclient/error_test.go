@@ -0,0 +1,26 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsHealthCheckFailed(t *testing.T) {
+	i18n.SetLocale("es")
+	defer i18n.SetLocale(i18n.DefaultLocale)
+
+	transportErr := errors.New("connection refused")
+
+	require.True(t, IsHealthCheckFailed(ErrHealthCheckFailed("http://localhost:3986/health")))
+	require.True(t, IsHealthCheckFailed(fmt.Errorf("connect: %w", ErrTransportHealthCheckFailed("http://localhost:3986/health", "ssh", transportErr))))
+	require.ErrorIs(t, ErrTransportHealthCheckFailed("http://localhost:3986/health", "ssh", transportErr), transportErr)
+	require.True(t, IsHealthCheckFailed(errors.New("failed to check server health at: http://localhost:3986/health")))
+	require.False(t, IsHealthCheckFailed(errors.New("workspace not found")))
+}
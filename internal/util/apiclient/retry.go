@@ -0,0 +1,149 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/client"
+	log "github.com/sirupsen/logrus"
+)
+
+type RetryConfig struct {
+	// Maximum number of times a failed request is retried
+	MaxRetries int
+	// Backoff before the first retry, doubled on each subsequent retry
+	InitialBackoff time.Duration
+	// Upper bound for the backoff between two retries
+	MaxBackoff time.Duration
+}
+
+var DefaultRetryConfig = RetryConfig{
+	MaxRetries:     3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+var retryConfig = DefaultRetryConfig
+
+var commandTimeout time.Duration
+
+// Deadline for all requests made by the current command. Zero value means no deadline.
+var commandDeadline time.Time
+
+func SetRetryConfig(config RetryConfig) {
+	retryConfig = config
+}
+
+// SetTimeout sets a deadline for the current command. Requests to the Daytona Server
+// (including retries) fail once the timeout has passed. A zero timeout disables the deadline.
+func SetTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		commandTimeout = 0
+		commandDeadline = time.Time{}
+		return
+	}
+
+	commandTimeout = timeout
+	commandDeadline = time.Now().Add(timeout)
+}
+
+func ErrCommandTimeout(timeout time.Duration) error {
+	return fmt.Errorf("command timed out after %s", timeout.Round(time.Millisecond))
+}
+
+type retryTransport struct {
+	base http.RoundTripper
+}
+
+func newRetryTransport(base http.RoundTripper) http.RoundTripper {
+	return &retryTransport{base: base}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var cancel context.CancelFunc = func() {}
+	if !commandDeadline.IsZero() {
+		var ctx context.Context
+		ctx, cancel = context.WithDeadline(req.Context(), commandDeadline)
+		req = req.WithContext(ctx)
+	}
+
+	var restartDeadline time.Time
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.base.RoundTrip(req)
+
+		backoff := getBackoff(attempt, res)
+		deadlineReached := !commandDeadline.IsZero() && time.Now().Add(backoff).After(commandDeadline)
+
+		// Requests are retried until the server is back, unless the command deadline is reached first
+		if client.IsServerRestarting(res) && restartDeadline.IsZero() {
+			log.Warn("The Daytona Server is restarting, waiting for it to come back...")
+			restartDeadline = time.Now().Add(client.ServerRestartTimeout)
+		}
+		restarting := time.Now().Before(restartDeadline)
+
+		retry := client.IsRetryable(req, res, err)
+
+		if !retry || (attempt >= retryConfig.MaxRetries && !restarting) || deadlineReached {
+			if err != nil {
+				cancel()
+				if errors.Is(err, context.DeadlineExceeded) || (retry && deadlineReached) {
+					return nil, ErrCommandTimeout(commandTimeout)
+				}
+				return nil, err
+			}
+
+			res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+			return res, nil
+		}
+
+		if err != nil {
+			log.Debugf("Request to %s failed: %v. Retrying in %s", req.URL.Redacted(), err, backoff)
+		} else {
+			log.Debugf("Request to %s returned %s. Retrying in %s", req.URL.Redacted(), res.Status, backoff)
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			cancel()
+			return nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+
+		if req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// getBackoff returns the time to wait before the next attempt. A Retry-After sent by the server
+// takes precedence over the exponential backoff if it is longer
+func getBackoff(attempt int, res *http.Response) time.Duration {
+	return max(util.GetJitteredBackoff(retryConfig.InitialBackoff, retryConfig.MaxBackoff, attempt), client.RetryAfter(res))
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,203 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/constants"
+	"github.com/daytonaio/daytona/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryable(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}
+	resetErr := &net.OpError{Op: "read", Err: syscall.ECONNRESET}
+
+	restarting := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	restarting.Header.Set(constants.SERVER_STATUS_HEADER, constants.SERVER_STATUS_RESTARTING)
+
+	tests := []struct {
+		name   string
+		method string
+		status int
+		res    *http.Response
+		err    error
+		retry  bool
+	}{
+		{"GET 502", http.MethodGet, http.StatusBadGateway, nil, nil, true},
+		{"GET 503", http.MethodGet, http.StatusServiceUnavailable, nil, nil, true},
+		{"GET 504", http.MethodGet, http.StatusGatewayTimeout, nil, nil, true},
+		{"GET 500", http.MethodGet, http.StatusInternalServerError, nil, nil, false},
+		{"GET 404", http.MethodGet, http.StatusNotFound, nil, nil, false},
+		{"GET 200", http.MethodGet, http.StatusOK, nil, nil, false},
+		{"PUT 503", http.MethodPut, http.StatusServiceUnavailable, nil, nil, true},
+		{"DELETE 502", http.MethodDelete, http.StatusBadGateway, nil, nil, true},
+		{"POST 503", http.MethodPost, http.StatusServiceUnavailable, nil, nil, false},
+		{"PATCH 502", http.MethodPatch, http.StatusBadGateway, nil, nil, false},
+		{"GET 429", http.MethodGet, http.StatusTooManyRequests, nil, nil, true},
+		{"POST 429", http.MethodPost, http.StatusTooManyRequests, nil, nil, true},
+		{"POST restarting", http.MethodPost, 0, restarting, nil, true},
+		{"GET dial error", http.MethodGet, 0, nil, dialErr, true},
+		{"POST dial error", http.MethodPost, 0, nil, dialErr, true},
+		{"GET connection reset", http.MethodGet, 0, nil, resetErr, true},
+		{"POST connection reset", http.MethodPost, 0, nil, resetErr, false},
+		{"GET unexpected EOF", http.MethodGet, 0, nil, io.ErrUnexpectedEOF, true},
+		{"GET canceled", http.MethodGet, 0, nil, context.Canceled, false},
+		{"GET deadline exceeded", http.MethodGet, 0, nil, context.DeadlineExceeded, false},
+		{"GET unknown error", http.MethodGet, 0, nil, errors.New("unknown"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, "http://localhost/workspace", nil)
+			require.NoError(t, err)
+
+			res := tt.res
+			if res == nil && tt.err == nil {
+				res = &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			}
+
+			require.Equal(t, tt.retry, client.IsRetryable(req, res, tt.err))
+		})
+	}
+}
+
+func TestIsRetryable_BodyWithoutGetBody(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPut, "http://localhost/workspace", io.NopCloser(strings.NewReader("body")))
+	require.NoError(t, err)
+
+	res := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+
+	require.False(t, client.IsRetryable(req, res, nil))
+}
+
+func TestGetBackoff(t *testing.T) {
+	defer SetRetryConfig(DefaultRetryConfig)
+	SetRetryConfig(RetryConfig{
+		MaxRetries:     3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	})
+
+	retryAfter := func(value string) *http.Response {
+		res := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		res.Header.Set("Retry-After", value)
+		return res
+	}
+
+	tests := []struct {
+		name    string
+		attempt int
+		res     *http.Response
+		min     time.Duration
+		max     time.Duration
+	}{
+		{"first attempt", 0, nil, 100 * time.Millisecond, 120 * time.Millisecond},
+		{"third attempt", 2, nil, 400 * time.Millisecond, 480 * time.Millisecond},
+		{"capped", 10, nil, time.Second, 1200 * time.Millisecond},
+		{"overflow", 100, nil, time.Second, 1200 * time.Millisecond},
+		{"retry after seconds", 0, retryAfter("3"), 3 * time.Second, 3 * time.Second},
+		{"retry after shorter than backoff", 10, retryAfter("0"), time.Second, 1200 * time.Millisecond},
+		{"retry after date", 0, retryAfter(time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)), 8 * time.Second, 10 * time.Second},
+		{"retry after past date", 0, retryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)), 100 * time.Millisecond, 120 * time.Millisecond},
+		{"retry after invalid", 0, retryAfter("soon"), 100 * time.Millisecond, 120 * time.Millisecond},
+		{"retry after capped", 0, retryAfter("86400"), client.ServerRestartTimeout, client.ServerRestartTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backoff := getBackoff(tt.attempt, tt.res)
+			require.GreaterOrEqual(t, backoff, tt.min)
+			require.LessOrEqual(t, backoff, tt.max)
+		})
+	}
+}
+
+func TestRetryTransport_ReplaysBody(t *testing.T) {
+	defer SetRetryConfig(DefaultRetryConfig)
+	SetRetryConfig(RetryConfig{
+		MaxRetries:     2,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	})
+
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+
+		if len(bodies) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"test"}`))
+	require.NoError(t, err)
+
+	res, err := newRetryTransport(http.DefaultTransport).RoundTrip(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, []string{`{"name":"test"}`, `{"name":"test"}`, `{"name":"test"}`}, bodies)
+}
+
+func TestRetryTransport_MaxRetries(t *testing.T) {
+	defer SetRetryConfig(DefaultRetryConfig)
+	SetRetryConfig(RetryConfig{
+		MaxRetries:     2,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	})
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	res, err := newRetryTransport(http.DefaultTransport).RoundTrip(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusBadGateway, res.StatusCode)
+	require.Equal(t, 3, requests)
+}
+
+func TestRetryTransport_NonIdempotent(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("{}"))
+	require.NoError(t, err)
+
+	res, err := newRetryTransport(http.DefaultTransport).RoundTrip(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	require.Equal(t, 1, requests)
+}
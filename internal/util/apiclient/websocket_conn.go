@@ -12,6 +12,7 @@ import (
 	"regexp"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util/endpoint"
 	"github.com/gorilla/websocket"
 )
 
@@ -21,7 +22,6 @@ func GetWebsocketConn(ctx context.Context, path string, profile *config.Profile,
 		return nil, nil, err
 	}
 
-	var serverUrl string
 	var apiKey string
 
 	var activeProfile config.Profile
@@ -35,26 +35,42 @@ func GetWebsocketConn(ctx context.Context, path string, profile *config.Profile,
 		activeProfile = *profile
 	}
 
-	serverUrl = activeProfile.Api.Url
 	apiKey = activeProfile.Api.Key
 
-	url, err := url.JoinPath(serverUrl, path)
+	endpoints, err := endpoint.Resolve(activeProfile)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	wsUrl, err := GetWebSocketUrl(url)
-	if err != nil {
-		return nil, nil, err
-	}
+	var conn *websocket.Conn
+	var res *http.Response
+	var dialErr error
+
+	for _, e := range endpoints {
+		url, err := url.JoinPath(e.ServerUrl, path)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	if query != nil {
-		wsUrl = fmt.Sprintf("%s?%s", wsUrl, *query)
+		wsUrl, err := GetWebSocketUrl(url)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if query != nil {
+			wsUrl = fmt.Sprintf("%s?%s", wsUrl, *query)
+		}
+
+		conn, res, dialErr = e.WebsocketDialer().DialContext(ctx, wsUrl, http.Header{
+			"Authorization": []string{fmt.Sprintf("Bearer %s", apiKey)},
+		})
+		// The next transport is only tried if the server could not be reached
+		if dialErr == nil || res != nil {
+			return conn, res, dialErr
+		}
 	}
 
-	return websocket.DefaultDialer.DialContext(ctx, wsUrl, http.Header{
-		"Authorization": []string{fmt.Sprintf("Bearer %s", apiKey)},
-	})
+	return conn, res, dialErr
 }
 
 func GetWebSocketUrl(apiUrl string) (string, error) {
@@ -6,24 +6,45 @@ package apiclient
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/logs"
 	logs_view "github.com/daytonaio/daytona/pkg/views/logs"
 	"github.com/gorilla/websocket"
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	// A stream that receives no pong within this period is considered disconnected
+	pongWait   = 30 * time.Second
+	pingPeriod = 10 * time.Second
+	// Delay before reconnecting a stream that was closed because the server is restarting
+	restartReconnectDelay = 2 * time.Second
+)
+
 var workspaceLogsStarted bool
 
+type logEntryHandler func(logEntry logs.LogEntry, index int)
+
 func ReadWorkspaceLogs(ctx context.Context, activeProfile config.Profile, workspaceId string, projectNames []string, follow, showWorkspaceLogs bool, from *time.Time) {
+	readWorkspaceLogs(ctx, activeProfile, workspaceId, projectNames, follow, showWorkspaceLogs, from, logs_view.DisplayLogEntry)
+}
+
+// FollowWorkspaceLogs passes the workspace and project log entries, including steps, to handleEntry instead of displaying them
+func FollowWorkspaceLogs(ctx context.Context, activeProfile config.Profile, workspaceId string, projectNames []string, from *time.Time, handleEntry func(logs.LogEntry)) {
+	readWorkspaceLogs(ctx, activeProfile, workspaceId, projectNames, true, true, from, func(logEntry logs.LogEntry, _ int) {
+		handleEntry(logEntry)
+	})
+}
+
+func readWorkspaceLogs(ctx context.Context, activeProfile config.Profile, workspaceId string, projectNames []string, follow, showWorkspaceLogs bool, from *time.Time, handleEntry logEntryHandler) {
 	var wg sync.WaitGroup
-	query := ""
-	if follow {
-		query = "follow=true"
-	}
+	monitor := NewConnectionMonitor()
 
 	if !showWorkspaceLogs {
 		workspaceLogsStarted = true
@@ -35,42 +56,20 @@ func ReadWorkspaceLogs(ctx context.Context, activeProfile config.Profile, worksp
 		go func(projectName string, from *time.Time) {
 			defer wg.Done()
 
-			for {
-				// Make sure workspace logs started before showing any project logs
-				if !workspaceLogsStarted {
-					time.Sleep(250 * time.Millisecond)
-					continue
-				}
-
-				ws, res, err := GetWebsocketConn(ctx, fmt.Sprintf("/log/workspace/%s/%s", workspaceId, projectName), &activeProfile, &query)
-				// We want to retry getting the logs if it fails
-				if err != nil {
-					log.Trace(HandleErrorResponse(res, err))
-					time.Sleep(500 * time.Millisecond)
-					continue
+			// Make sure workspace logs started before showing any project logs
+			for !workspaceLogsStarted {
+				if ctx.Err() != nil {
+					return
 				}
-
-				readJSONLog(ctx, ws, index, from)
-				ws.Close()
-				break
+				time.Sleep(250 * time.Millisecond)
 			}
+
+			readLogStream(ctx, activeProfile, fmt.Sprintf("/log/workspace/%s/%s", workspaceId, projectName), url.Values{}, follow, index, from, monitor, handleEntry)
 		}(projectName, from)
 	}
 
 	if showWorkspaceLogs {
-		for {
-			ws, res, err := GetWebsocketConn(ctx, fmt.Sprintf("/log/workspace/%s", workspaceId), &activeProfile, &query)
-			// We want to retry getting the logs if it fails
-			if err != nil {
-				log.Trace(HandleErrorResponse(res, err))
-				time.Sleep(250 * time.Millisecond)
-				continue
-			}
-
-			readJSONLog(ctx, ws, logs_view.STATIC_INDEX, from)
-			ws.Close()
-			break
-		}
+		readLogStream(ctx, activeProfile, fmt.Sprintf("/log/workspace/%s", workspaceId), url.Values{}, follow, logs_view.STATIC_INDEX, from, monitor, handleEntry)
 	}
 
 	wg.Wait()
@@ -79,24 +78,138 @@ func ReadWorkspaceLogs(ctx context.Context, activeProfile config.Profile, worksp
 func ReadBuildLogs(ctx context.Context, activeProfile config.Profile, buildId string, query string) {
 	logs_view.CalculateLongestPrefixLength([]string{buildId})
 
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		log.Trace(err)
+		values = url.Values{}
+	}
+
+	follow := values.Get("follow") == "true"
+	values.Del("follow")
+
+	readLogStream(ctx, activeProfile, fmt.Sprintf("/log/build/%s", buildId), values, follow, logs_view.FIRST_PROJECT_INDEX, nil, NewConnectionMonitor(), logs_view.DisplayLogEntry)
+}
+
+// readLogStream displays the log at the given path. When following, the stream is reconnected
+// if it drops and, if the connection is unstable, the log is polled until the connection recovers.
+// Reconnected streams resume at the offset of the last displayed entry. Servers that do not support
+// offsets send the log from the beginning so entries that were already displayed are skipped
+func readLogStream(ctx context.Context, activeProfile config.Profile, path string, values url.Values, follow bool, index int, from *time.Time, monitor *ConnectionMonitor, handleEntry logEntryHandler) {
+	displayed := 0
+	connectFailures := 0
+
 	for {
-		ws, res, err := GetWebsocketConn(ctx, fmt.Sprintf("/log/build/%s", buildId), &activeProfile, &query)
+		if ctx.Err() != nil {
+			return
+		}
+
+		polling := follow && monitor.Polling()
+
+		query := url.Values{}
+		for key, value := range values {
+			query[key] = value
+		}
+		if follow && !polling {
+			query.Set("follow", "true")
+		}
+		if displayed > 0 {
+			query.Set("offset", strconv.Itoa(displayed))
+		}
+		encodedQuery := query.Encode()
+
+		ws, res, err := GetWebsocketConn(ctx, path, &activeProfile, &encodedQuery)
 		// We want to retry getting the logs if it fails
 		if err != nil {
 			log.Trace(HandleErrorResponse(res, err))
-			time.Sleep(250 * time.Millisecond)
+			if polling {
+				monitor.PollFailed()
+			}
+			sleep(ctx, util.GetJitteredBackoff(250*time.Millisecond, 5*time.Second, connectFailures))
+			connectFailures++
 			continue
 		}
+		connectFailures = 0
+
+		offset := 0
+		if res != nil && res.Header.Get(logs.LogOffsetHeader) != "" {
+			offset = displayed
+		}
 
-		readJSONLog(ctx, ws, logs_view.FIRST_PROJECT_INDEX, nil)
+		read, err := readJSONLog(ctx, ws, index, from, displayed-offset, handleEntry)
 		ws.Close()
-		break
+		if offset+read > displayed {
+			displayed = offset + read
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		// The stream is resumed at the offset once the server is back
+		if websocket.IsCloseError(err, websocket.CloseServiceRestart) {
+			log.Warn("The Daytona Server is restarting, reconnecting...")
+			sleep(ctx, restartReconnectDelay)
+			continue
+		}
+
+		if !follow {
+			if err != nil {
+				log.Error(err)
+			}
+			return
+		}
+
+		if polling {
+			if err != nil {
+				log.Debug(err)
+				monitor.PollFailed()
+			} else if monitor.PollSucceeded() {
+				continue
+			}
+			sleep(ctx, monitor.PollInterval())
+			continue
+		}
+
+		// A followed stream is only closed by the server on failure
+		if err != nil {
+			log.Debug(err)
+		}
+		monitor.StreamFailed()
+		sleep(ctx, util.GetJitteredBackoff(250*time.Millisecond, 5*time.Second, 0))
 	}
 }
 
-func readJSONLog(ctx context.Context, ws *websocket.Conn, index int, from *time.Time) {
+// readJSONLog passes log entries read from the websocket to handleEntry until it is closed, skipping the given
+// number of entries. Returns the number of entries read and an error if the connection was not closed normally
+func readJSONLog(ctx context.Context, ws *websocket.Conn, index int, from *time.Time, skip int, handleEntry logEntryHandler) (int, error) {
 	logEntriesChan := make(chan logs.LogEntry)
-	readErr := make(chan error)
+	readErr := make(chan error, 1)
+	done := make(chan struct{})
+	defer close(done)
+
+	// Pings detect connections that dropped without being closed
+	ws.SetReadDeadline(time.Now().Add(pongWait)) // nolint:errcheck
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	go func() {
+		ticker := time.NewTicker(pingPeriod)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second))
+				if err != nil {
+					log.Trace(err)
+				}
+			}
+		}
+	}()
+
 	go func() {
 		for {
 			var logEntry logs.LogEntry
@@ -106,24 +219,32 @@ func readJSONLog(ctx context.Context, ws *websocket.Conn, index int, from *time.
 			// An empty entry will be sent from the server on close/EOF
 			// We don't want to print that
 			if logEntry != (logs.LogEntry{}) {
-				logEntriesChan <- logEntry
+				select {
+				case logEntriesChan <- logEntry:
+				case <-done:
+					return
+				}
 			}
 
 			if err != nil {
-				if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure) {
-					log.Error(err)
-				}
 				readErr <- err
 				return
 			}
 		}
 	}()
 
+	read := 0
+
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return read, nil
 		case logEntry := <-logEntriesChan:
+			read++
+			if read <= skip {
+				break
+			}
+
 			if from != nil {
 				parsedTime, err := time.Parse(time.RFC3339Nano, logEntry.Time)
 				if err != nil {
@@ -131,21 +252,24 @@ func readJSONLog(ctx context.Context, ws *websocket.Conn, index int, from *time.
 				}
 
 				if parsedTime.After(*from) || parsedTime.Equal(*from) {
-					logs_view.DisplayLogEntry(logEntry, index)
+					handleEntry(logEntry, index)
 				}
 			} else {
-				logs_view.DisplayLogEntry(logEntry, index)
+				handleEntry(logEntry, index)
 			}
 
 		case err := <-readErr:
-			if err != nil {
-				err := ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
-				if err != nil {
-					log.Trace(err)
-				}
-				ws.Close()
-				return
+			closeErr := ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+			if closeErr != nil {
+				log.Trace(closeErr)
 			}
+			ws.Close()
+
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return read, nil
+			}
+
+			return read, err
 		}
 
 		if !workspaceLogsStarted && index == logs_view.STATIC_INDEX {
@@ -153,3 +277,10 @@ func readJSONLog(ctx context.Context, ws *websocket.Conn, index int, from *time.
 		}
 	}
 }
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"math/rand"
+	"time"
+)
+
+// GetJitteredBackoff returns the delay before the next attempt after the given number of consecutive
+// failures. The delay doubles with each failure up to max and up to 20% jitter is added so that
+// clients don't retry in lockstep
+func GetJitteredBackoff(base, max time.Duration, failures int) time.Duration {
+	backoff := base << failures
+	if failures > 30 || backoff <= 0 || backoff > max {
+		backoff = max
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(backoff)/5 + 1))
+
+	return backoff + jitter
+}
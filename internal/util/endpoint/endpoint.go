@@ -0,0 +1,241 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util/proxyjump"
+	"github.com/gorilla/websocket"
+)
+
+// Endpoint is a way to connect to the Daytona Server of a profile
+type Endpoint struct {
+	Transport config.ServerTransport
+	// Where the endpoint connects to, used in messages, e.g. unix socket /home/user/.config/daytona/server/api.sock
+	Description string
+	// URL that requests are sent to. It is the API URL of the profile, with the http scheme for socket transports
+	ServerUrl     string
+	HttpTransport *http.Transport
+}
+
+// WebsocketDialer returns a dialer that connects the same way as the HTTP transport of the endpoint
+func (e Endpoint) WebsocketDialer() *websocket.Dialer {
+	dialer := *websocket.DefaultDialer
+	dialer.Proxy = e.HttpTransport.Proxy
+	dialer.NetDialContext = e.HttpTransport.DialContext
+
+	return &dialer
+}
+
+// Resolve returns the endpoints of the profile in the order they should be tried.
+// Only the auto transport returns more than one endpoint: the unix socket of the server is preferred
+// if the API URL points to this machine and the socket exists, with a fallback to TCP
+func Resolve(profile config.Profile) ([]Endpoint, error) {
+	if profile.ProxyJump != "" && profile.Proxy != "" {
+		return nil, errors.New("a profile can not have both a proxy and a jump host")
+	}
+
+	switch profile.Transport {
+	case config.ServerTransportUnix:
+		path, err := getSocketPath(profile)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("the Daytona Server API socket %s is not available. Make sure the server is running on this machine or change the transport of the profile: %w", path, err)
+		}
+
+		unixEndpoint, err := getUnixEndpoint(profile, path)
+		if err != nil {
+			return nil, err
+		}
+		return []Endpoint{unixEndpoint}, nil
+	case config.ServerTransportSsh:
+		sshEndpoint, err := getSshEndpoint(profile)
+		if err != nil {
+			return nil, err
+		}
+		return []Endpoint{sshEndpoint}, nil
+	case config.ServerTransportTcp:
+		tcpEndpoint, err := getTcpEndpoint(profile)
+		if err != nil {
+			return nil, err
+		}
+		return []Endpoint{tcpEndpoint}, nil
+	case "", config.ServerTransportAuto:
+	default:
+		return nil, ErrInvalidTransport(string(profile.Transport))
+	}
+
+	tcpEndpoint, err := getTcpEndpoint(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Proxies and jump hosts are only used with TCP
+	if profile.Proxy != "" || profile.ProxyJump != "" || !isLoopbackUrl(profile.Api.Url) {
+		return []Endpoint{tcpEndpoint}, nil
+	}
+
+	path, err := getSocketPath(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return []Endpoint{tcpEndpoint}, nil
+	}
+
+	unixEndpoint, err := getUnixEndpoint(profile, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Endpoint{unixEndpoint, tcpEndpoint}, nil
+}
+
+func ErrInvalidTransport(transport string) error {
+	transports := []string{}
+	for _, t := range config.ServerTransports {
+		transports = append(transports, string(t))
+	}
+
+	return fmt.Errorf("invalid transport %s, must be one of: %s", transport, strings.Join(transports, ", "))
+}
+
+// IsValidTransport returns true if the transport is empty or one of the supported transports
+func IsValidTransport(transport string) bool {
+	return transport == "" || slices.Contains(config.ServerTransports, config.ServerTransport(transport))
+}
+
+// getTcpEndpoint connects to the API URL directly, through the proxy or through the jump host of the profile.
+// TLS is used for https URLs
+func getTcpEndpoint(profile config.Profile) (Endpoint, error) {
+	endpoint := Endpoint{
+		Transport:   config.ServerTransportTcp,
+		Description: profile.Api.Url,
+		ServerUrl:   profile.Api.Url,
+	}
+
+	if profile.ProxyJump != "" {
+		endpoint.Description = fmt.Sprintf("%s through jump host %s", profile.Api.Url, profile.ProxyJump)
+		endpoint.HttpTransport = proxyjump.NewDialer(profile.ProxyJump, profile.ProxyJumpIdentityFile).Transport()
+		return endpoint, nil
+	}
+
+	endpoint.HttpTransport = http.DefaultTransport.(*http.Transport).Clone()
+
+	if profile.Proxy != "" {
+		proxyUrl, err := url.Parse(profile.Proxy)
+		if err != nil {
+			return Endpoint{}, fmt.Errorf("invalid proxy URL %s: %w", profile.Proxy, err)
+		}
+
+		endpoint.Description = fmt.Sprintf("%s through proxy %s", profile.Api.Url, proxyUrl.Redacted())
+		endpoint.HttpTransport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	return endpoint, nil
+}
+
+func getSocketPath(profile config.Profile) (string, error) {
+	if profile.SocketPath != "" {
+		return profile.SocketPath, nil
+	}
+
+	return config.GetServerSocketPath()
+}
+
+func getUnixEndpoint(profile config.Profile, path string) (Endpoint, error) {
+	serverUrl, err := socketServerUrl(profile.Api.Url)
+	if err != nil {
+		return Endpoint{}, err
+	}
+
+	return newSocketEndpoint(config.ServerTransportUnix, "unix socket "+path, serverUrl, func(ctx context.Context) (net.Conn, error) {
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, "unix", path)
+	}), nil
+}
+
+// getSshEndpoint connects to the API socket of the server on the jump host of the profile.
+// The SocketPath of the profile is a path on the jump host in this case
+func getSshEndpoint(profile config.Profile) (Endpoint, error) {
+	if profile.ProxyJump == "" {
+		return Endpoint{}, errors.New("the ssh transport requires the SSH host of the server, set it with the --proxy-jump flag of the profile")
+	}
+
+	serverUrl, err := socketServerUrl(profile.Api.Url)
+	if err != nil {
+		return Endpoint{}, err
+	}
+
+	command := "daytona server dial-stdio"
+	if profile.SocketPath != "" {
+		command += fmt.Sprintf(" --socket %q", profile.SocketPath)
+	}
+
+	dialer := proxyjump.NewDialer(profile.ProxyJump, profile.ProxyJumpIdentityFile)
+
+	return newSocketEndpoint(config.ServerTransportSsh, "ssh://"+profile.ProxyJump, serverUrl, func(ctx context.Context) (net.Conn, error) {
+		return dialer.DialCommand(ctx, command)
+	}), nil
+}
+
+// newSocketEndpoint returns an endpoint that opens all connections with dial, regardless of the requested address
+func newSocketEndpoint(transport config.ServerTransport, description, serverUrl string, dial func(ctx context.Context) (net.Conn, error)) Endpoint {
+	httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+	httpTransport.Proxy = nil
+	httpTransport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return dial(ctx)
+	}
+
+	return Endpoint{
+		Transport:     transport,
+		Description:   description,
+		ServerUrl:     serverUrl,
+		HttpTransport: httpTransport,
+	}
+}
+
+// socketServerUrl returns the API URL with the http scheme since the API socket is not served over TLS
+func socketServerUrl(apiUrl string) (string, error) {
+	serverUrl, err := url.Parse(apiUrl)
+	if err != nil {
+		return "", fmt.Errorf("invalid API URL %s: %w", apiUrl, err)
+	}
+
+	serverUrl.Scheme = "http"
+	if serverUrl.Host == "" {
+		serverUrl.Host = "localhost"
+	}
+
+	return serverUrl.String(), nil
+}
+
+func isLoopbackUrl(apiUrl string) bool {
+	serverUrl, err := url.Parse(apiUrl)
+	if err != nil {
+		return false
+	}
+
+	if serverUrl.Hostname() == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(serverUrl.Hostname())
+	return ip != nil && ip.IsLoopback()
+}
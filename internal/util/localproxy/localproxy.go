@@ -0,0 +1,128 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package localproxy
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type DialContextFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// Username of the credentials the proxy requires
+const proxyUsername = "daytona"
+
+// Listen starts a local HTTP proxy that forwards requests, including CONNECT tunnels,
+// over connections opened with dialContext. It returns the URL of the proxy.
+// It is used to route clients that only support HTTP proxies, e.g. the tailscale control and DERP clients.
+// The proxy requires random credentials that are part of the URL so that other users of the machine can't
+// connect through the jump host or proxy of the profile
+func Listen(dialContext DialContextFunc) (string, error) {
+	password := make([]byte, 32)
+	_, err := rand.Read(password)
+	if err != nil {
+		return "", err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialContext
+
+	proxyUrl := &url.URL{
+		Scheme: "http",
+		User:   url.UserPassword(proxyUsername, hex.EncodeToString(password)),
+		Host:   listener.Addr().String(),
+	}
+
+	server := &http.Server{
+		Handler: &httpProxy{
+			dialContext:   dialContext,
+			reverseProxy:  &httputil.ReverseProxy{Director: func(*http.Request) {}, Transport: transport},
+			authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte(proxyUrl.User.String())),
+		},
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error(err)
+		}
+	}()
+
+	return proxyUrl.String(), nil
+}
+
+type httpProxy struct {
+	dialContext  DialContextFunc
+	reverseProxy *httputil.ReverseProxy
+	// Expected value of the Proxy-Authorization header
+	authorization string
+}
+
+func (p *httpProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Proxy-Authorization")), []byte(p.authorization)) != 1 {
+		w.Header().Set("Proxy-Authenticate", `Basic realm="Daytona"`)
+		http.Error(w, "proxy authentication required", http.StatusProxyAuthRequired)
+		return
+	}
+
+	if r.Method != http.MethodConnect {
+		p.reverseProxy.ServeHTTP(w, r)
+		return
+	}
+
+	targetConn, err := p.dialContext(r.Context(), "tcp", r.Host)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		targetConn.Close()
+		http.Error(w, "hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+
+	clientConn, _, err := hijacker.Hijack()
+	if err != nil {
+		targetConn.Close()
+		return
+	}
+
+	_, err = clientConn.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n"))
+	if err != nil {
+		clientConn.Close()
+		targetConn.Close()
+		return
+	}
+
+	go func() {
+		_, _ = io.Copy(targetConn, clientConn)
+		targetConn.Close()
+	}()
+
+	go func() {
+		_, _ = io.Copy(clientConn, targetConn)
+		clientConn.Close()
+	}()
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package localproxy
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListen_RequiresCredentials(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+
+	dialer := &net.Dialer{}
+	proxyUrl, err := Listen(func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return dialer.DialContext(ctx, network, addr)
+	})
+	require.NoError(t, err)
+
+	parsedUrl, err := url.Parse(proxyUrl)
+	require.NoError(t, err)
+
+	get := func(proxy *url.URL) int {
+		client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxy)}}
+		res, err := client.Get(target.URL)
+		require.NoError(t, err)
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	require.Equal(t, http.StatusOK, get(parsedUrl))
+
+	withoutCredentials := *parsedUrl
+	withoutCredentials.User = nil
+	require.Equal(t, http.StatusProxyAuthRequired, get(&withoutCredentials))
+
+	withWrongPassword := *parsedUrl
+	withWrongPassword.User = url.UserPassword(proxyUsername, "wrong")
+	require.Equal(t, http.StatusProxyAuthRequired, get(&withWrongPassword))
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/logs"
 
@@ -26,7 +27,7 @@ func ReadLog(ctx context.Context, logReader io.Reader, follow bool, c chan []byt
 			return
 		default:
 			bytes := make([]byte, 1024)
-			_, err := reader.Read(bytes)
+			n, err := reader.Read(bytes)
 			if err != nil {
 				if err != io.EOF {
 					errChan <- err
@@ -36,7 +37,7 @@ func ReadLog(ctx context.Context, logReader io.Reader, follow bool, c chan []byt
 				}
 				continue
 			}
-			c <- bytes
+			c <- bytes[:n]
 		}
 	}
 }
@@ -50,15 +51,7 @@ func ReadJSONLog(ctx context.Context, logReader io.Reader, follow bool, c chan i
 		default:
 			line, readErr := reader.ReadString('\n')
 			if line != "" {
-				stripped := strings.TrimSuffix(line, logs.LogDelimiter)
-				var logEntry logs.LogEntry
-
-				err := json.Unmarshal([]byte(stripped), &logEntry)
-				if err != nil {
-					log.Trace("Failed to parse log entry: ", err, string(stripped))
-				}
-
-				c <- logEntry
+				c <- parseLogEntry(line)
 			}
 			if readErr != nil {
 				if readErr != io.EOF {
@@ -74,6 +67,77 @@ func ReadJSONLog(ctx context.Context, logReader io.Reader, follow bool, c chan i
 	}
 }
 
+// SkipLog discards the first offset bytes of the log. When following, waits for the log to reach the offset
+func SkipLog(ctx context.Context, logReader io.Reader, offset int, follow bool) (io.Reader, error) {
+	reader := bufio.NewReader(logReader)
+
+	for skipped := 0; skipped < offset; {
+		n, err := reader.Discard(offset - skipped)
+		skipped += n
+		if err != nil {
+			if err != io.EOF || !follow {
+				return reader, err
+			}
+			err = waitForLog(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return reader, nil
+}
+
+// SkipJSONLog discards the first offset entries of the log. Empty entries are not counted since they are not
+// displayed by clients. When following, waits for the log to reach the offset
+func SkipJSONLog(ctx context.Context, logReader io.Reader, offset int, follow bool) (io.Reader, error) {
+	reader := bufio.NewReader(logReader)
+	line := ""
+
+	for skipped := 0; skipped < offset; {
+		part, err := reader.ReadString('\n')
+		line += part
+		if err != nil {
+			if err != io.EOF || !follow {
+				return reader, err
+			}
+			err = waitForLog(ctx)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if parseLogEntry(line) != (logs.LogEntry{}) {
+			skipped++
+		}
+		line = ""
+	}
+
+	return reader, nil
+}
+
+func parseLogEntry(line string) logs.LogEntry {
+	stripped := strings.TrimSuffix(line, logs.LogDelimiter)
+	var logEntry logs.LogEntry
+
+	err := json.Unmarshal([]byte(stripped), &logEntry)
+	if err != nil {
+		log.Trace("Failed to parse log entry: ", err, string(stripped))
+	}
+
+	return logEntry
+}
+
+func waitForLog(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(100 * time.Millisecond):
+		return nil
+	}
+}
+
 func ReadCompressedFile(filePath string) (io.Reader, error) {
 	zipFile, err := zip.OpenReader(filePath)
 	if err != nil {
@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import "sync"
+
+// ArrayMapParallel is ArrayMap with at most limit calls of f running at the same time.
+// The results are returned in the order of data
+func ArrayMapParallel[T, U any](data []T, limit int, f func(T) U) []U {
+	res := make([]U, len(data))
+	if limit < 1 {
+		limit = 1
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < min(limit, len(data)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				res[i] = f(data[i])
+			}
+		}()
+	}
+
+	for i := range data {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return res
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package proxyjump
+
+import (
+	"io"
+	"net"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// conn is a net.Conn over the standard streams of an `ssh -W` process.
+// Deadlines are not supported.
+type conn struct {
+	cmd       *exec.Cmd
+	stdin     io.WriteCloser
+	stdout    io.ReadCloser
+	local     net.Addr
+	remote    net.Addr
+	closeOnce sync.Once
+}
+
+func (c *conn) Read(b []byte) (int, error) {
+	return c.stdout.Read(b)
+}
+
+func (c *conn) Write(b []byte) (int, error) {
+	return c.stdin.Write(b)
+}
+
+func (c *conn) Close() error {
+	c.closeOnce.Do(func() {
+		c.stdin.Close()
+		if c.cmd.Process != nil {
+			_ = c.cmd.Process.Kill()
+		}
+		_ = c.cmd.Wait()
+	})
+
+	return nil
+}
+
+func (c *conn) LocalAddr() net.Addr {
+	return c.local
+}
+
+func (c *conn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+func (c *conn) SetDeadline(t time.Time) error {
+	return nil
+}
+
+func (c *conn) SetReadDeadline(t time.Time) error {
+	return nil
+}
+
+func (c *conn) SetWriteDeadline(t time.Time) error {
+	return nil
+}
+
+type jumpAddr struct {
+	network string
+	address string
+}
+
+func (a *jumpAddr) Network() string {
+	return a.network
+}
+
+func (a *jumpAddr) String() string {
+	return a.address
+}
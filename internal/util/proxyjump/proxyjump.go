@@ -0,0 +1,193 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package proxyjump
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Dialer opens TCP connections through a bastion host with the system SSH client,
+// the same way the OpenSSH ProxyJump option does. The jump host is in the
+// [user@]host[:port] format and can be a host alias from the user's SSH config.
+type Dialer struct {
+	JumpHost string
+	// If set, only this private key is offered to the jump host
+	IdentityFile string
+}
+
+func NewDialer(jumpHost, identityFile string) *Dialer {
+	return &Dialer{JumpHost: jumpHost, IdentityFile: identityFile}
+}
+
+func (d *Dialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if network != "tcp" && network != "tcp4" && network != "tcp6" {
+		return nil, fmt.Errorf("unsupported network %s", network)
+	}
+
+	log.Debugf("Connecting to %s through jump host %s", addr, d.JumpHost)
+
+	conn, err := d.dial(ctx, []string{"-W", addr}, &jumpAddr{network: network, address: addr})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s through %s: %w", addr, d.JumpHost, err)
+	}
+
+	return conn, nil
+}
+
+// DialCommand runs the command on the jump host and returns a connection to its standard streams,
+// e.g. to reach a unix socket that is only accessible on the jump host
+func (d *Dialer) DialCommand(ctx context.Context, command string) (net.Conn, error) {
+	log.Debugf("Running %s on %s", command, d.JumpHost)
+
+	conn, err := d.dial(ctx, []string{"-T"}, &jumpAddr{network: "ssh", address: command}, command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run %s on %s: %w", command, d.JumpHost, err)
+	}
+
+	return conn, nil
+}
+
+func (d *Dialer) dial(ctx context.Context, args []string, remote net.Addr, command ...string) (net.Conn, error) {
+	sshPath, err := exec.LookPath("ssh")
+	if err != nil {
+		return nil, errors.New("ssh client not found in PATH, it is required to connect through the jump host")
+	}
+
+	args, err = d.sshCommandArgs(args, command...)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(sshPath, args...)
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	return &conn{
+		cmd:    cmd,
+		stdin:  stdin,
+		stdout: stdout,
+		local:  &jumpAddr{network: "ssh", address: d.JumpHost},
+		remote: remote,
+	}, nil
+}
+
+// Transport returns an HTTP transport that dials all connections through the jump host
+func (d *Dialer) Transport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = d.DialContext
+
+	return transport
+}
+
+// SshArgs returns the options that make the system SSH client connect to a host through the jump host
+func (d *Dialer) SshArgs() ([]string, error) {
+	destination, port, err := parseJumpHost(d.JumpHost)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyCommand := "ssh"
+	if d.IdentityFile != "" {
+		proxyCommand += fmt.Sprintf(" -i %q -o IdentitiesOnly=yes", d.IdentityFile)
+	}
+	if port != "" {
+		proxyCommand += " -p " + port
+	}
+	proxyCommand += fmt.Sprintf(" -W %%h:%%p -- %s", destination)
+
+	return []string{"-o", "ProxyCommand=" + proxyCommand}, nil
+}
+
+// sshCommandArgs returns the arguments of the system SSH client that connects to the jump host
+// with the options in args and runs the command on it
+func (d *Dialer) sshCommandArgs(args []string, command ...string) ([]string, error) {
+	destination, port, err := parseJumpHost(d.JumpHost)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.IdentityFile != "" {
+		args = append(args, "-i", d.IdentityFile, "-o", "IdentitiesOnly=yes")
+	}
+	if port != "" {
+		args = append(args, "-p", port)
+	}
+
+	// The destination can't be mistaken for an option after --
+	args = append(args, "--", destination)
+
+	return append(args, command...), nil
+}
+
+// parseJumpHost splits a jump host in the [user@]host[:port] format into the SSH destination and port.
+// IPv6 addresses with a port are enclosed in brackets, e.g. [::1]:2222
+func parseJumpHost(jumpHost string) (destination string, port string, err error) {
+	invalidErr := fmt.Errorf("invalid jump host %q, expected [user@]host[:port]", jumpHost)
+
+	if jumpHost == "" || strings.HasPrefix(jumpHost, "-") || strings.ContainsAny(jumpHost, " \t\r\n\"'") {
+		return "", "", invalidErr
+	}
+
+	user, host := "", jumpHost
+	if i := strings.LastIndex(jumpHost, "@"); i >= 0 {
+		user, host = jumpHost[:i+1], jumpHost[i+1:]
+	}
+
+	switch {
+	case strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]"):
+		host = strings.Trim(host, "[]")
+	case strings.HasPrefix(host, "["):
+		host, port, err = net.SplitHostPort(host)
+		if err != nil {
+			return "", "", invalidErr
+		}
+	case strings.Count(host, ":") == 1:
+		host, port, _ = strings.Cut(host, ":")
+		if port == "" {
+			return "", "", invalidErr
+		}
+	}
+
+	if host == "" || strings.HasPrefix(host, "-") {
+		return "", "", invalidErr
+	}
+
+	if port != "" {
+		portNumber, err := strconv.ParseUint(port, 10, 16)
+		if err != nil || portNumber == 0 {
+			return "", "", invalidErr
+		}
+	}
+
+	return user + host, port, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package proxyjump
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJumpHost(t *testing.T) {
+	tests := []struct {
+		jumpHost    string
+		destination string
+		port        string
+		valid       bool
+	}{
+		{"bastion", "bastion", "", true},
+		{"bastion:2222", "bastion", "2222", true},
+		{"admin@bastion", "admin@bastion", "", true},
+		{"admin@bastion:2222", "admin@bastion", "2222", true},
+		{"admin@10.0.0.1:22", "admin@10.0.0.1", "22", true},
+		{"user@corp@bastion:2222", "user@corp@bastion", "2222", true},
+		{"::1", "::1", "", true},
+		{"[::1]", "::1", "", true},
+		{"admin@[::1]:2222", "admin@::1", "2222", true},
+		{"", "", "", false},
+		{"-oProxyCommand=sh", "", "", false},
+		{"admin@-oProxyCommand=sh", "", "", false},
+		{"bastion:", "", "", false},
+		{"bastion:port", "", "", false},
+		{"bastion:0", "", "", false},
+		{"bastion:65536", "", "", false},
+		{"admin@", "", "", false},
+		{"[::1]:port", "", "", false},
+		{"bastion host", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.jumpHost, func(t *testing.T) {
+			destination, port, err := parseJumpHost(tt.jumpHost)
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.destination, destination)
+			require.Equal(t, tt.port, port)
+		})
+	}
+}
+
+func TestSshCommandArgs(t *testing.T) {
+	dialer := NewDialer("admin@bastion:2222", "/keys/id_ed25519")
+
+	args, err := dialer.sshCommandArgs([]string{"-W", "10.0.0.2:3986"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"-W", "10.0.0.2:3986", "-i", "/keys/id_ed25519", "-o", "IdentitiesOnly=yes", "-p", "2222", "--", "admin@bastion"}, args)
+
+	args, err = NewDialer("bastion", "").sshCommandArgs([]string{"-T"}, "nc -U /var/run/docker.sock")
+	require.NoError(t, err)
+	require.Equal(t, []string{"-T", "--", "bastion", "nc -U /var/run/docker.sock"}, args)
+
+	_, err = NewDialer("-oProxyCommand=sh", "").sshCommandArgs([]string{"-T"})
+	require.Error(t, err)
+}
+
+func TestSshArgs(t *testing.T) {
+	args, err := NewDialer("admin@bastion:2222", "").SshArgs()
+	require.NoError(t, err)
+	require.Equal(t, []string{"-o", "ProxyCommand=ssh -p 2222 -W %h:%p -- admin@bastion"}, args)
+}
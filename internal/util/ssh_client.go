@@ -0,0 +1,120 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/ssh"
+	profile_view "github.com/daytonaio/daytona/pkg/views/profile"
+
+	crypto_ssh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// GetProjectSshClient connects to the SSH server of the project with the built-in SSH client,
+// through the same ssh-proxy command that the generated SSH config uses
+func GetProjectSshClient(profileId, workspaceId, projectName string) (*ssh.Client, error) {
+	daytonaPath, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+
+	hostKeyCallback, err := getProjectHostKeyCallback(profileId, workspaceId, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := config.LoadOrCreateSshKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var proxyStderr bytes.Buffer
+	proxyCommand := exec.Command(daytonaPath, "ssh-proxy", profileId, workspaceId, projectName)
+	proxyCommand.Stderr = &proxyStderr
+
+	hostname := config.GetProjectHostname(profileId, workspaceId, projectName)
+
+	client, err := ssh.NewClientFromProxyCommand(proxyCommand, "daytona", hostname, hostKeyCallback, signer)
+	if err != nil {
+		if proxyStderr.Len() > 0 {
+			return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(proxyStderr.String()))
+		}
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// getProjectHostKeyCallback verifies the host key of the project against the pinned host keys.
+// Like StrictHostKeyChecking accept-new in the generated SSH config, the key of a project
+// without a pinned key is pinned on the first connection. Profiles with end-to-end encryption
+// don't trust the Daytona Server, so the user has to verify the key fingerprint before it is pinned
+func getProjectHostKeyCallback(profileId, workspaceId, projectName string) (crypto_ssh.HostKeyCallback, error) {
+	c, err := config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := c.GetProfile(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := config.GetKnownHostsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	// knownhosts fails to read a file that doesn't exist
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	file.Close()
+
+	callback, err := knownhosts.New(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(hostname string, remote net.Addr, key crypto_ssh.PublicKey) error {
+		err := callback(hostname, remote, key)
+
+		var keyErr *knownhosts.KeyError
+		if errors.As(err, &keyErr) {
+			if len(keyErr.Want) > 0 {
+				return fmt.Errorf("host key verification failed for project %s, the key differs from the pinned key: %w", projectName, err)
+			}
+
+			if profile.E2EEncryption {
+				trusted, err := profile_view.TrustKeyPrompt("SSH host key", projectName, crypto_ssh.FingerprintSHA256(key))
+				if err != nil {
+					return fmt.Errorf("SSH host key of project %s is not trusted: %w", projectName, err)
+				}
+				if !trusted {
+					return fmt.Errorf("SSH host key of project %s was not trusted", projectName)
+				}
+			}
+
+			return config.PinProjectHostKey(profileId, workspaceId, projectName, string(crypto_ssh.MarshalAuthorizedKey(key)))
+		}
+
+		return err
+	}, nil
+}
@@ -7,9 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"os/exec"
+	"strings"
 	"syscall"
 	"time"
 
@@ -19,6 +21,7 @@ import (
 	agent_config "github.com/daytonaio/daytona/pkg/agent/config"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/runtimes"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	log "github.com/sirupsen/logrus"
@@ -62,6 +65,13 @@ func (a *Agent) Start() error {
 	}()
 
 	log.Info("Daytona Agent started")
+
+	if a.Config.E2ERequired {
+		// The agent output is forwarded to the Daytona Server by the provider. From here on
+		// the log is only written to the log file which clients read through the encrypted toolbox
+		log.SetOutput(io.Discard)
+	}
+
 	return <-errChan
 }
 
@@ -71,6 +81,11 @@ func (a *Agent) startProjectMode() error {
 		return err
 	}
 
+	err = a.injectSecrets()
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to inject secrets: %s", err))
+	}
+
 	if a.Config.SkipClone == "" {
 		project, err := a.getProject()
 		if err != nil {
@@ -141,6 +156,19 @@ func (a *Agent) startProjectMode() error {
 		if err != nil {
 			log.Error(fmt.Sprintf("failed to set git config: %s", err))
 		}
+
+		// Runtimes installed with daytona install are installed again in case the project was recreated
+		if len(project.Runtimes) > 0 {
+			go func() {
+				log.Info(fmt.Sprintf("Installing runtimes %s...", strings.Join(project.Runtimes, ", ")))
+				err := runtimes.Install(project.Runtimes, io.Discard)
+				if err != nil {
+					log.Error(fmt.Sprintf("failed to install runtimes: %s", err))
+				} else {
+					log.Info("Runtimes installed")
+				}
+			}()
+		}
 	}
 
 	go func() {
@@ -154,6 +182,17 @@ func (a *Agent) startProjectMode() error {
 		}
 	}()
 
+	go func() {
+		for {
+			time.Sleep(time.Minute)
+
+			err := a.stopIfIdle()
+			if err != nil {
+				log.Error(fmt.Sprintf("failed to check workspace auto-stop: %s", err))
+			}
+		}
+	}()
+
 	return nil
 }
 
@@ -264,10 +303,18 @@ func (a *Agent) updateProjectState() error {
 	}
 
 	uptime := a.uptime()
-	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(apiclient.SetProjectState{
+	idleTime := int32(a.Ssh.IdleTime().Seconds())
+	state := apiclient.SetProjectState{
 		Uptime:    uptime,
+		IdleTime:  &idleTime,
 		GitStatus: conversion.ToGitStatusDTO(gitStatus),
-	}).Execute()
+	}
+
+	if hostKey := a.Ssh.HostPublicKey(); hostKey != "" {
+		state.HostKey = &hostKey
+	}
+
+	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(state).Execute()
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
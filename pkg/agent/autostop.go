@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	log "github.com/sirupsen/logrus"
+)
+
+// stopIfIdle stops the workspace if it has an auto-stop timeout and none of its
+// projects had SSH activity for longer than the timeout
+func (a *Agent) stopIfIdle() error {
+	idleTime := a.Ssh.IdleTime()
+	if idleTime == 0 {
+		return nil
+	}
+
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey, a.Config.ClientId, a.TelemetryEnabled)
+	if err != nil {
+		return err
+	}
+
+	ws, res, err := apiClient.WorkspaceAPI.GetWorkspace(context.Background(), a.Config.WorkspaceId).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if ws.AutoStop == nil || *ws.AutoStop <= 0 {
+		return nil
+	}
+
+	timeout := time.Duration(*ws.AutoStop) * time.Minute
+	if !isWorkspaceIdle(ws, a.Config.ProjectName, idleTime, timeout) {
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("No SSH activity for %s. Stopping the workspace...", timeout))
+
+	res, err = apiClient.WorkspaceAPI.StopWorkspace(context.Background(), ws.Id).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+// isWorkspaceIdle checks the idle time of the agent's own project and the last reported idle time of the other running projects
+func isWorkspaceIdle(ws *apiclient.WorkspaceDTO, projectName string, idleTime, timeout time.Duration) bool {
+	if idleTime < timeout {
+		return false
+	}
+
+	for _, project := range ws.Projects {
+		if project.Name == projectName || project.State == nil {
+			continue
+		}
+
+		if project.State.IdleTime == nil || time.Duration(*project.State.IdleTime)*time.Second < timeout {
+			return false
+		}
+	}
+
+	return true
+}
@@ -30,6 +30,9 @@ type Config struct {
 	Mode        Mode
 
 	SkipClone string `envconfig:"DAYTONA_SKIP_CLONE"`
+	// Set on projects created with an end-to-end encrypted profile. Toolbox requests that are not
+	// encrypted are rejected and agent logs are only served through the encrypted toolbox
+	E2ERequired bool `envconfig:"DAYTONA_E2E_REQUIRED"`
 }
 
 type Mode string
@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	log "github.com/sirupsen/logrus"
+)
+
+// Directory on the in-memory file system of the container that holds the secret files
+const secretsTmpfsDir = "/dev/shm/daytona-secrets"
+
+// injectSecrets fetches the project secrets from the server. Environment variable secrets are set
+// on the agent process so that they are inherited by SSH sessions and file secrets are written to disk
+func (a *Agent) injectSecrets() error {
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey, a.Config.ClientId, a.TelemetryEnabled)
+	if err != nil {
+		return err
+	}
+
+	secrets, res, err := apiClient.SecretAPI.GetProjectSecrets(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for _, secret := range secrets {
+		if secret.FilePath == nil || *secret.FilePath == "" {
+			err = os.Setenv(secret.Name, secret.Value)
+			if err != nil {
+				log.Error(fmt.Sprintf("failed to set secret %s: %s", secret.Name, err))
+			}
+			continue
+		}
+
+		err = writeSecretFile(*secret.FilePath, secret.Value, secret.GetFileMode())
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to write secret %s: %s", secret.Name, err))
+		}
+	}
+
+	return nil
+}
+
+// writeSecretFile writes the secret to the in-memory file system and links it at the declared path so that
+// the value is never written to the project disk. The file is written to the path directly if there is no
+// in-memory file system
+func writeSecretFile(secretPath, value, fileMode string) error {
+	mode := os.FileMode(0600)
+	if fileMode != "" {
+		m, err := strconv.ParseUint(fileMode, 8, 32)
+		if err != nil {
+			return fmt.Errorf("invalid file mode %s: %w", fileMode, err)
+		}
+		mode = os.FileMode(m)
+	}
+
+	err := os.MkdirAll(filepath.Dir(secretPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(secretsTmpfsDir, 0700)
+	if err != nil {
+		log.Warn(fmt.Sprintf("in-memory file system not available, writing secret file %s to disk: %s", secretPath, err))
+		return writeFileWithMode(secretPath, value, mode)
+	}
+
+	tmpfsPath := filepath.Join(secretsTmpfsDir, fmt.Sprintf("%x", sha256.Sum256([]byte(secretPath))))
+	err = writeFileWithMode(tmpfsPath, value, mode)
+	if err != nil {
+		return err
+	}
+
+	if target, err := os.Readlink(secretPath); err == nil && target == tmpfsPath {
+		return nil
+	}
+
+	// Replaces files written by previous versions or links to removed secrets
+	err = os.Remove(secretPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return os.Symlink(tmpfsPath, secretPath)
+}
+
+func writeFileWithMode(path, value string, mode os.FileMode) error {
+	err := os.WriteFile(path, []byte(value), mode)
+	if err != nil {
+		return err
+	}
+
+	// WriteFile keeps the mode of existing files
+	return os.Chmod(path, mode)
+}
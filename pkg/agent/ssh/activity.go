@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// activityTracker counts the open SSH connections and remembers when the last one was closed
+type activityTracker struct {
+	mu              sync.Mutex
+	openConnections int
+	lastActivity    time.Time
+}
+
+func (t *activityTracker) touch() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lastActivity = time.Now()
+}
+
+func (t *activityTracker) trackConnection(conn net.Conn) net.Conn {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.openConnections++
+	t.lastActivity = time.Now()
+
+	return &trackedConn{Conn: conn, onClose: t.connectionClosed}
+}
+
+func (t *activityTracker) connectionClosed() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.openConnections--
+	t.lastActivity = time.Now()
+}
+
+func (t *activityTracker) idleTime() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.openConnections > 0 || t.lastActivity.IsZero() {
+		return 0
+	}
+
+	return time.Since(t.lastActivity)
+}
+
+type trackedConn struct {
+	net.Conn
+	closeOnce sync.Once
+	onClose   func()
+}
+
+func (c *trackedConn) Close() error {
+	c.closeOnce.Do(c.onClose)
+	return c.Conn.Close()
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	gossh "golang.org/x/crypto/ssh"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ServerKeyAuthorizer accepts the public keys that the Daytona Server granted access to the workspace.
+// The server only grants access to the users that can access the workspace
+type ServerKeyAuthorizer struct {
+	Server           config.DaytonaServerConfig
+	WorkspaceId      string
+	ClientId         string
+	TelemetryEnabled bool
+}
+
+func (a *ServerKeyAuthorizer) IsAuthorized(key gossh.PublicKey) bool {
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Server.ApiUrl, a.Server.ApiKey, a.ClientId, a.TelemetryEnabled)
+	if err != nil {
+		log.Errorf("Failed to verify SSH access: %s", err)
+		return false
+	}
+
+	res, err := apiClient.WorkspaceAPI.VerifySshAccess(context.Background(), a.WorkspaceId).Access(apiclient.SshAccess{
+		PublicKey: string(gossh.MarshalAuthorizedKey(key)),
+	}).Execute()
+	if err != nil {
+		log.Warnf("SSH key %s was rejected: %s", gossh.FingerprintSHA256(key), apiclient_util.HandleErrorResponse(res, err))
+		return false
+	}
+
+	return true
+}
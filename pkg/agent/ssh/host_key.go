@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/ssh"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// LoadOrCreateHostKey reads the host key of the SSH server from the path or generates an ed25519 key there.
+// The key is kept across agent restarts so that clients can pin it
+func LoadOrCreateHostKey(path string) (gossh.Signer, error) {
+	return ssh.LoadOrCreatePrivateKey(path)
+}
+
+// HostPublicKey returns the public host key in the known_hosts format without the host name, e.g. "ssh-ed25519 AAAA...".
+// It is empty if the server generates a new host key on every start
+func (s *Server) HostPublicKey() string {
+	if s.HostKey == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(gossh.MarshalAuthorizedKey(s.HostKey.PublicKey())))
+}
@@ -6,16 +6,19 @@ package ssh
 import (
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"os/exec"
 	"strings"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/creack/pty"
 	"github.com/daytonaio/daytona/pkg/agent/ssh/config"
 	"github.com/gliderlabs/ssh"
 	"github.com/pkg/sftp"
+	gossh "golang.org/x/crypto/ssh"
 	"golang.org/x/sys/unix"
 
 	log "github.com/sirupsen/logrus"
@@ -24,9 +27,17 @@ import (
 type Server struct {
 	ProjectDir        string
 	DefaultProjectDir string
+	// A new host key is generated on every start if not set
+	HostKey gossh.Signer
+	// Checks the public keys of clients. All clients are rejected if not set
+	IsAuthorizedKey func(key gossh.PublicKey) bool
+	activity        activityTracker
 }
 
 func (s *Server) Start() error {
+	// The agent start counts as activity so that a new project isn't stopped before anyone connects
+	s.activity.touch()
+
 	forwardedTCPHandler := &ssh.ForwardedTCPHandler{}
 	unixForwardHandler := newForwardedUnixHandler()
 
@@ -74,12 +85,27 @@ func (s *Server) Start() error {
 		SessionRequestCallback: func(sess ssh.Session, requestType string) bool {
 			return true
 		},
+		PublicKeyHandler: func(ctx ssh.Context, key ssh.PublicKey) bool {
+			return s.IsAuthorizedKey != nil && s.IsAuthorizedKey(key)
+		},
+		ConnCallback: func(ctx ssh.Context, conn net.Conn) net.Conn {
+			return s.activity.trackConnection(conn)
+		},
+	}
+
+	if s.HostKey != nil {
+		sshServer.AddHostKey(s.HostKey)
 	}
 
 	log.Printf("Starting ssh server on port %d...\n", config.SSH_PORT)
 	return sshServer.ListenAndServe()
 }
 
+// IdleTime returns the time since the last SSH connection was closed or zero if there are open connections
+func (s *Server) IdleTime() time.Duration {
+	return s.activity.idleTime()
+}
+
 func (s *Server) handlePty(session ssh.Session, ptyReq ssh.Pty, winCh <-chan ssh.Window) {
 	shell := s.getShell()
 	cmd := exec.Command(shell)
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/gin-gonic/gin"
+)
+
+func RefreshRepository(c *gin.Context) {
+	var req GitRefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	gitService := git.Service{
+		ProjectDir: req.Path,
+	}
+
+	if (req.ResetHard || req.Pull) && !req.Force {
+		dirty, err := gitService.HasUncommittedChanges()
+		if err != nil {
+			c.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+
+		if dirty {
+			c.AbortWithError(http.StatusConflict, git.ErrUncommittedChanges)
+			return
+		}
+	}
+
+	err := gitService.Fetch()
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	// Resetting to the upstream would discard commits that were never pushed
+	if req.ResetHard && !req.Force {
+		unpushed, err := gitService.HasUnpushedCommits()
+		if err != nil {
+			c.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+
+		if unpushed {
+			c.AbortWithError(http.StatusConflict, git.ErrUnpushedCommits)
+			return
+		}
+	}
+
+	if req.ResetHard {
+		err = gitService.ResetToUpstream()
+	} else if req.Pull {
+		err = gitService.PullFastForward()
+	}
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	status, err := gitService.GetGitStatus()
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
@@ -43,3 +43,13 @@ type GitRepoRequest struct {
 	Username *string `json:"username,omitempty" validate:"optional"`
 	Password *string `json:"password,omitempty" validate:"optional"`
 } // @name GitRepoRequest
+
+type GitRefreshRequest struct {
+	Path string `json:"path" validate:"required"`
+	// Reset the current branch to its upstream, discarding local changes and commits
+	ResetHard bool `json:"resetHard" validate:"optional"`
+	// Fast-forward the current branch to its upstream
+	Pull bool `json:"pull" validate:"optional"`
+	// Skip the uncommitted changes check
+	Force bool `json:"force" validate:"optional"`
+} // @name GitRefreshRequest
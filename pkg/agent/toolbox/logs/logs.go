@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const followInterval = 500 * time.Millisecond
+
+// ReadAgentLog writes the agent log file to the response. If the follow query parameter is set,
+// content appended to the log is streamed until the client disconnects
+func ReadAgentLog(c *gin.Context) {
+	logFilePath := config.GetLogFilePath()
+	if logFilePath == nil {
+		c.AbortWithError(http.StatusNotFound, errors.New("agent log file path not set"))
+		return
+	}
+
+	file, err := os.Open(*logFilePath)
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	defer file.Close()
+
+	follow := c.Query("follow") == "true"
+
+	c.Header("Content-Type", "text/plain; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			_, writeErr := c.Writer.Write(buf[:n])
+			if writeErr != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+
+		if err == io.EOF {
+			if !follow {
+				return
+			}
+
+			select {
+			case <-c.Request.Context().Done():
+				return
+			case <-time.After(followInterval):
+			}
+			continue
+		}
+
+		if err != nil {
+			log.Error(err)
+			return
+		}
+	}
+}
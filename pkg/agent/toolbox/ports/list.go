@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"net/http"
+	"slices"
+
+	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/gin-gonic/gin"
+)
+
+// Ports of the SSH server and the toolbox of the agent are not reported
+var agentPorts = []uint16{ssh_config.SSH_PORT, config.TOOLBOX_API_PORT}
+
+func ListPorts(c *gin.Context) {
+	projectPorts, err := getProjectPorts()
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ListPortsResponse{Ports: projectPorts})
+}
+
+func getProjectPorts() ([]uint16, error) {
+	listeningPorts, err := ports.GetListeningPorts()
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(listeningPorts, func(port uint16) bool {
+		return slices.Contains(agentPorts, port)
+	}), nil
+}
@@ -0,0 +1,17 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+type ListPortsResponse struct {
+	// Sorted TCP ports with a listening socket in the project, without the ports of the agent
+	Ports []uint16 `json:"ports" validate:"required"`
+} // @name ListPortsResponse
+
+// PortsEvent is sent by the watch endpoint when ports start or stop listening in the project
+type PortsEvent struct {
+	// Ports that started listening. The first event lists all ports that are listening when the client connects
+	Opened []uint16 `json:"opened"`
+	// Ports that stopped listening
+	Closed []uint16 `json:"closed"`
+}
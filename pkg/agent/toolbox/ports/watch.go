@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval of checking the socket tables for ports that started or stopped listening
+const watchInterval = time.Second
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// WatchPorts streams a JSON encoded PortsEvent over a websocket whenever ports start or stop listening
+// in the project, until the client disconnects
+func WatchPorts(c *gin.Context) {
+	ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer ws.Close()
+
+	// The client doesn't send messages, reading only detects that it disconnected
+	disconnected := make(chan struct{})
+	go func() {
+		defer close(disconnected)
+		for {
+			if _, _, err := ws.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	var previous []uint16
+	for {
+		current, err := getProjectPorts()
+		if err != nil {
+			log.Error(err)
+		} else if event, changed := getPortsEvent(previous, current); changed {
+			err = ws.WriteJSON(event)
+			if err != nil {
+				log.Trace(err)
+				return
+			}
+			previous = current
+		}
+
+		select {
+		case <-disconnected:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// getPortsEvent compares the listening ports with the previously sent ones. The first event is
+// always sent, even if no ports are listening
+func getPortsEvent(previous, current []uint16) (PortsEvent, bool) {
+	event := PortsEvent{Opened: []uint16{}, Closed: []uint16{}}
+
+	for _, port := range current {
+		if !slices.Contains(previous, port) {
+			event.Opened = append(event.Opened, port)
+		}
+	}
+
+	for _, port := range previous {
+		if !slices.Contains(current, port) {
+			event.Closed = append(event.Closed, port)
+		}
+	}
+
+	return event, previous == nil || len(event.Opened) > 0 || len(event.Closed) > 0
+}
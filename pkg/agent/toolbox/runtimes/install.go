@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimes
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/runtimes"
+	"github.com/gin-gonic/gin"
+)
+
+func Install(c *gin.Context) {
+	var req InstallRuntimesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	installed, err := runtimes.ParseAll(req.Runtimes)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	var output bytes.Buffer
+	err = runtimes.Install(installed, &output)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, fmt.Errorf("%w\n%s", err, output.String()))
+		return
+	}
+
+	c.JSON(http.StatusOK, InstallRuntimesResponse{
+		Runtimes: installed,
+		Output:   output.String(),
+	})
+}
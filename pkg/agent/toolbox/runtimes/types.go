@@ -0,0 +1,14 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimes
+
+type InstallRuntimesRequest struct {
+	// Runtimes in the tool@version format, e.g. node@20
+	Runtimes []string `json:"runtimes" validate:"required"`
+} // @name InstallRuntimesRequest
+
+type InstallRuntimesResponse struct {
+	Runtimes []string `json:"runtimes" validate:"required"`
+	Output   string   `json:"output" validate:"required"`
+} // @name InstallRuntimesResponse
@@ -4,6 +4,8 @@
 package toolbox
 
 import (
+	"crypto/ecdh"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -11,22 +13,34 @@ import (
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/fs"
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/git"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/logs"
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/lsp"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/ports"
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/process"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/runtimes"
 	"github.com/daytonaio/daytona/pkg/api"
 	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/e2e"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 )
 
 type Server struct {
 	ProjectDir string
+	// Key used for end-to-end encryption of toolbox requests. Encryption is not available if nil.
+	E2EKey *ecdh.PrivateKey
+	// If set, requests that are not end-to-end encrypted are rejected
+	E2ERequired bool
 }
 
 type ProjectDirResponse struct {
 	Dir string `json:"dir"`
 } // @name ProjectDirResponse
 
+type E2EPublicKeyResponse struct {
+	PublicKey string `json:"publicKey"`
+} // @name E2EPublicKeyResponse
+
 func (s *Server) GetProjectDir(ctx *gin.Context) {
 	projectDir := ProjectDirResponse{
 		Dir: s.ProjectDir,
@@ -35,13 +49,37 @@ func (s *Server) GetProjectDir(ctx *gin.Context) {
 	ctx.JSON(200, projectDir)
 }
 
+func (s *Server) GetE2EPublicKey(ctx *gin.Context) {
+	if s.E2EKey == nil {
+		ctx.AbortWithError(http.StatusNotFound, errors.New("end-to-end encryption is not available"))
+		return
+	}
+
+	ctx.JSON(200, E2EPublicKeyResponse{
+		PublicKey: e2e.EncodePublicKey(s.E2EKey.PublicKey()),
+	})
+}
+
 func (s *Server) Start() error {
 	r := gin.New()
 	r.Use(gin.Recovery())
 	r.Use(middlewares.LoggingMiddleware())
 	binding.Validator = new(api.DefaultValidator)
 
+	// Routes registered before the end-to-end encryption middleware are never encrypted.
+	// The ports watch websocket is opened by clients directly over the tailnet and only reports port numbers
+	r.GET("/e2e/public-key", s.GetE2EPublicKey)
+	r.GET("/ports/watch", ports.WatchPorts)
+
+	if s.E2EKey != nil {
+		r.Use(e2e.Middleware(s.E2EKey, s.E2ERequired))
+	} else if s.E2ERequired {
+		return errors.New("end-to-end encryption is required but no encryption key is available")
+	}
+
 	r.GET("/project-dir", s.GetProjectDir)
+	r.GET("/ports", ports.ListPorts)
+	r.GET("/logs", logs.ReadAgentLog)
 
 	fsController := r.Group("/files")
 	{
@@ -80,6 +118,12 @@ func (s *Server) Start() error {
 		gitController.POST("/commit", git.CommitChanges)
 		gitController.POST("/pull", git.PullChanges)
 		gitController.POST("/push", git.PushChanges)
+		gitController.POST("/refresh", git.RefreshRepository)
+	}
+
+	runtimesController := r.Group("/runtimes")
+	{
+		runtimesController.POST("/install", runtimes.Install)
 	}
 
 	lspController := r.Group("/lsp")
@@ -13,6 +13,10 @@ import (
 
 type SshServer interface {
 	Start() error
+	// Time since the last SSH connection was closed. Zero while a connection is open
+	IdleTime() time.Duration
+	// Public host key in the known_hosts format without the host name. Empty if the host key is not persisted
+	HostPublicKey() string
 }
 
 type TailscaleServer interface {
@@ -4,8 +4,10 @@
 package apikey
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
@@ -19,6 +21,7 @@ import (
 //	@Description	Generate an API key
 //	@Produce		plain
 //	@Param			apiKeyName	path		string	true	"API key name"
+//	@Param			readOnly	query		bool	false	"Generate a read-only key"
 //	@Success		200			{string}	apiKey
 //	@Router			/apikey/{apiKeyName} [post]
 //
@@ -26,9 +29,25 @@ import (
 func GenerateApiKey(ctx *gin.Context) {
 	apiKeyName := ctx.Param("apiKeyName")
 
+	readOnly := false
+	if readOnlyQuery := ctx.Query("readOnly"); readOnlyQuery != "" {
+		var err error
+		readOnly, err = strconv.ParseBool(readOnlyQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for readOnly flag"))
+			return
+		}
+	}
+
 	server := server.GetInstance(nil)
 
-	response, err := server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, apiKeyName)
+	var response string
+	var err error
+	if readOnly {
+		response, err = server.ApiKeyService.GenerateReadOnly(apiKeyName)
+	} else {
+		response, err = server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, apiKeyName)
+	}
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get API keys: %w", err))
 		return
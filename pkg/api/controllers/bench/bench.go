@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package bench
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultSize = 10 * 1024 * 1024
+	// Upper limit of the data transferred by a single benchmark request
+	maxSize = 256 * 1024 * 1024
+)
+
+// Random data so that compressing proxies don't skew the throughput
+var chunk = make([]byte, 32*1024)
+
+func init() {
+	_, _ = rand.Read(chunk)
+}
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// Download sends the requested number of bytes to measure the download throughput of the client
+func Download(ctx *gin.Context) {
+	size, err := getSize(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.Header("Content-Length", strconv.FormatInt(size, 10))
+	ctx.Status(http.StatusOK)
+
+	for written := int64(0); written < size; {
+		n := min(int64(len(chunk)), size-written)
+		_, err := ctx.Writer.Write(chunk[:n])
+		if err != nil {
+			return
+		}
+		written += n
+	}
+}
+
+// Upload discards the request body to measure the upload throughput of the client
+func Upload(ctx *gin.Context) {
+	_, err := io.Copy(io.Discard, io.LimitReader(ctx.Request.Body, maxSize))
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to read the request body: %w", err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// Stream sends the requested number of bytes over a websocket, the same transport that the log streams use
+func Stream(ctx *gin.Context) {
+	size, err := getSize(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ws, err := upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer ws.Close()
+
+	for written := int64(0); written < size; {
+		n := min(int64(len(chunk)), size-written)
+		err := ws.WriteMessage(websocket.BinaryMessage, chunk[:n])
+		if err != nil {
+			return
+		}
+		written += n
+	}
+
+	_ = ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+func getSize(ctx *gin.Context) (int64, error) {
+	sizeQuery := ctx.Query("size")
+	if sizeQuery == "" {
+		return defaultSize, nil
+	}
+
+	size, err := strconv.ParseInt(sizeQuery, 10, 64)
+	if err != nil || size <= 0 {
+		return 0, errors.New("invalid size")
+	}
+
+	if size > maxSize {
+		return 0, fmt.Errorf("size can not exceed %d bytes", maxSize)
+	}
+
+	return size, nil
+}
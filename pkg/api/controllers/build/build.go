@@ -10,9 +10,11 @@ import (
 	"strconv"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/build/dto"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/builds"
 	builds_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 	"github.com/gin-gonic/gin"
@@ -74,6 +76,13 @@ func CreateBuild(ctx *gin.Context) {
 		newBuildDto.PrebuildId = *createBuildDto.PrebuildId
 	}
 
+	// Builds requested with different API keys don't share their caches
+	newBuildDto.CacheNamespace, err = s.ApiKeyService.GetApiKeyName(middlewares.ExtractToken(ctx.GetHeader("Authorization")))
+	if err != nil {
+		ctx.AbortWithError(http.StatusUnauthorized, errors.New("unauthorized"))
+		return
+	}
+
 	buildId, err := s.BuildService.Create(newBuildDto)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create build: %s", err.Error()))
@@ -198,6 +207,12 @@ func DeleteBuild(ctx *gin.Context) {
 		}
 	}
 
+	status, err := checkBuildAccess(ctx, buildId)
+	if err != nil {
+		ctx.AbortWithError(status, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
 	errs := server.BuildService.MarkForDeletion(&build.Filter{
@@ -214,6 +229,42 @@ func DeleteBuild(ctx *gin.Context) {
 	ctx.Status(204)
 }
 
+// CancelBuild godoc
+//
+//	@Tags			build
+//	@Summary		Cancel build
+//	@Description	Cancel a pending or running build
+//	@Param			buildId	path	string	true	"Build ID"
+//	@Success		204
+//	@Router			/build/{buildId}/cancel [post]
+//
+//	@id				CancelBuild
+func CancelBuild(ctx *gin.Context) {
+	buildId := ctx.Param("buildId")
+
+	status, err := checkBuildAccess(ctx, buildId)
+	if err != nil {
+		ctx.AbortWithError(status, err)
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.BuildService.Cancel(buildId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if build.IsBuildNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, builds.ErrBuildNotCancelable) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to cancel build: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
 // DeleteBuildsFromPrebuild godoc
 //
 //	@Tags			build
@@ -263,3 +314,27 @@ func DeleteBuildsFromPrebuild(ctx *gin.Context) {
 
 	ctx.Status(204)
 }
+
+// checkBuildAccess returns an error and its status if the API key of the request can't change the build.
+// Users can change the builds they requested, admins can change all builds
+func checkBuildAccess(ctx *gin.Context, buildId string) (int, error) {
+	b, err := server.GetInstance(nil).BuildService.Find(&build.Filter{
+		Id: &buildId,
+	})
+	if err != nil {
+		if build.IsBuildNotFound(err) {
+			return http.StatusNotFound, fmt.Errorf("failed to find build: %w", err)
+		}
+		return http.StatusInternalServerError, fmt.Errorf("failed to find build: %w", err)
+	}
+
+	if ctx.GetBool("apiKeyAdmin") {
+		return 0, nil
+	}
+
+	if user := ctx.GetString("apiKeyName"); user != "" && b.CacheNamespace == user {
+		return 0, nil
+	}
+
+	return http.StatusForbidden, fmt.Errorf("build %s was requested by another user", buildId)
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "time"
+
+type CreateInviteDTO struct {
+	// Time in minutes the invite can be redeemed and the granted access is valid
+	Ttl int `json:"ttl" validate:"required"`
+} // @name CreateInviteDTO
+
+type InviteDTO struct {
+	// One-time code, only returned when the invite is created
+	Code      string    `json:"code" validate:"required"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+} // @name InviteDTO
+
+type RedeemInviteDTO struct {
+	Code string `json:"code" validate:"required"`
+} // @name RedeemInviteDTO
+
+type InviteAccessDTO struct {
+	// Read-only API key restricted to the workspace
+	ApiKey        string    `json:"apiKey" validate:"required"`
+	WorkspaceId   string    `json:"workspaceId" validate:"required"`
+	WorkspaceName string    `json:"workspaceName" validate:"required"`
+	ExpiresAt     time.Time `json:"expiresAt" validate:"required"`
+} // @name InviteAccessDTO
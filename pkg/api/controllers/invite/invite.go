@@ -0,0 +1,105 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package invite
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/invite/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/invites"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateInvite 			godoc
+//
+//	@Tags			invite
+//	@Summary		Create a workspace invite
+//	@Description	Create a one-time code that grants temporary read-only access to the workspace when it is redeemed
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceId	path		string			true	"Workspace ID or Name"
+//	@Param			invite		body		CreateInviteDTO	true	"Invite"
+//	@Success		200			{object}	InviteDTO
+//	@Router			/workspace/{workspaceId}/invite [post]
+//
+//	@id				CreateInvite
+func CreateInvite(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.CreateInviteDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to get workspace: %w", err))
+		return
+	}
+
+	code, inv, err := server.InviteService.Create(w.Id, time.Duration(req.Ttl)*time.Minute)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to create invite: %w", err))
+		return
+	}
+
+	ctx.JSON(200, dto.InviteDTO{
+		Code:      code,
+		ExpiresAt: inv.ExpiresAt,
+	})
+}
+
+// RedeemInvite 			godoc
+//
+//	@Tags			invite
+//	@Summary		Redeem a workspace invite
+//	@Description	Redeem a one-time invite code for a read-only API key restricted to the workspace. The key expires with the invite
+//	@Accept			json
+//	@Produce		json
+//	@Param			invite	body		RedeemInviteDTO	true	"Invite code"
+//	@Success		200		{object}	InviteAccessDTO
+//	@Router			/invite/redeem [post]
+//
+//	@id				RedeemInvite
+func RedeemInvite(ctx *gin.Context) {
+	var req dto.RedeemInviteDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	access, err := server.InviteService.Redeem(req.Code)
+	if err != nil {
+		if errors.Is(err, invites.ErrInvalidInvite) {
+			ctx.AbortWithError(http.StatusForbidden, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to redeem invite: %w", err))
+		return
+	}
+
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), access.WorkspaceId, false)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to get workspace: %w", err))
+		return
+	}
+
+	ctx.JSON(200, dto.InviteAccessDTO{
+		ApiKey:        access.ApiKey,
+		WorkspaceId:   w.Id,
+		WorkspaceName: w.Name,
+		ExpiresAt:     access.ExpiresAt,
+	})
+}
@@ -6,11 +6,14 @@ package log
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -45,21 +48,40 @@ func writeJSONToWs(ws *websocket.Conn, c chan interface{}, errChan chan error) {
 	}
 }
 
-// readLog reads from the logReader and writes to the websocket.
-// T is the type of the message to be read from the logReader
-func readLog[T any](ginCtx *gin.Context, logReader io.Reader, readFunc func(context.Context, io.Reader, bool, chan T, chan error), wsWriteFunc func(*websocket.Conn, chan T, chan error)) {
+type skipLogFunc func(context.Context, io.Reader, int, bool) (io.Reader, error)
+
+// readLog reads from the logReader and writes to the websocket, starting at the offset query parameter
+// so clients can resume interrupted streams. T is the type of the message to be read from the logReader
+func readLog[T any](ginCtx *gin.Context, logReader io.Reader, skipFunc skipLogFunc, readFunc func(context.Context, io.Reader, bool, chan T, chan error), wsWriteFunc func(*websocket.Conn, chan T, chan error)) {
 	followQuery := ginCtx.Query("follow")
 	follow := followQuery == "true"
 
-	ws, err := upgrader.Upgrade(ginCtx.Writer, ginCtx.Request, nil)
+	offset := 0
+	if offsetQuery := ginCtx.Query("offset"); offsetQuery != "" {
+		var err error
+		offset, err = strconv.Atoi(offsetQuery)
+		if err != nil || offset < 0 {
+			ginCtx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid offset: %s", offsetQuery))
+			return
+		}
+	}
+
+	ws, err := upgrader.Upgrade(ginCtx.Writer, ginCtx.Request, http.Header{
+		logs.LogOffsetHeader: []string{strconv.Itoa(offset)},
+	})
 	if err != nil {
 		log.Error(err)
 		return
 	}
 
+	restarting := false
+
 	defer func() {
 		closeErr := websocket.CloseNormalClosure
-		if !errors.Is(err, io.EOF) {
+		if restarting {
+			// Clients reconnect once the server is back and resume at their offset
+			closeErr = websocket.CloseServiceRestart
+		} else if !errors.Is(err, io.EOF) {
 			closeErr = websocket.CloseInternalServerErr
 		}
 		err := ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(closeErr, ""), time.Now().Add(time.Second))
@@ -74,7 +96,17 @@ func readLog[T any](ginCtx *gin.Context, logReader io.Reader, readFunc func(cont
 	ctx, cancel := context.WithCancel(ginCtx.Request.Context())
 
 	defer cancel()
-	go readFunc(ctx, logReader, follow, msgChannel, errChannel)
+	go func() {
+		reader, err := skipFunc(ctx, logReader, offset, follow)
+		if err != nil {
+			select {
+			case errChannel <- err:
+			case <-ctx.Done():
+			}
+			return
+		}
+		readFunc(ctx, reader, follow, msgChannel, errChannel)
+	}()
 	go wsWriteFunc(ws, msgChannel, errChannel)
 
 	readErr := make(chan error)
@@ -85,10 +117,15 @@ func readLog[T any](ginCtx *gin.Context, logReader io.Reader, readFunc func(cont
 		}
 	}()
 
+	shutdown := server.GetInstance(nil).ShutdownNotify()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-shutdown:
+			restarting = true
+			return
 		case err = <-errChannel:
 			if err != nil {
 				if !errors.Is(err, io.EOF) {
@@ -123,7 +160,7 @@ func ReadServerLog(ginCtx *gin.Context) {
 				return
 			}
 			if err == nil {
-				readLog(ginCtx, reader, util.ReadLog, writeToWs)
+				readLog(ginCtx, reader, util.SkipLog, util.ReadLog, writeToWs)
 				return
 			}
 			time.Sleep(TIMEOUT)
@@ -140,7 +177,7 @@ func ReadServerLog(ginCtx *gin.Context) {
 		return
 	}
 
-	readLog(ginCtx, reader, util.ReadLog, writeToWs)
+	readLog(ginCtx, reader, util.SkipLog, util.ReadLog, writeToWs)
 }
 
 func ReadWorkspaceLog(ginCtx *gin.Context) {
@@ -154,7 +191,7 @@ func ReadWorkspaceLog(ginCtx *gin.Context) {
 		for {
 			wsLogReader, err := server.WorkspaceService.GetWorkspaceLogReader(workspaceId)
 			if err == nil {
-				readLog(ginCtx, wsLogReader, util.ReadJSONLog, writeJSONToWs)
+				readLog(ginCtx, wsLogReader, util.SkipJSONLog, util.ReadJSONLog, writeJSONToWs)
 				return
 			}
 			time.Sleep(TIMEOUT)
@@ -167,7 +204,7 @@ func ReadWorkspaceLog(ginCtx *gin.Context) {
 		return
 	}
 
-	readLog(ginCtx, wsLogReader, util.ReadJSONLog, writeJSONToWs)
+	readLog(ginCtx, wsLogReader, util.SkipJSONLog, util.ReadJSONLog, writeJSONToWs)
 }
 
 func ReadProjectLog(ginCtx *gin.Context) {
@@ -182,7 +219,7 @@ func ReadProjectLog(ginCtx *gin.Context) {
 		for {
 			projectLogReader, err := server.WorkspaceService.GetProjectLogReader(workspaceId, projectName)
 			if err == nil {
-				readLog(ginCtx, projectLogReader, util.ReadJSONLog, writeJSONToWs)
+				readLog(ginCtx, projectLogReader, util.SkipJSONLog, util.ReadJSONLog, writeJSONToWs)
 				return
 			}
 			time.Sleep(TIMEOUT)
@@ -195,7 +232,7 @@ func ReadProjectLog(ginCtx *gin.Context) {
 		return
 	}
 
-	readLog(ginCtx, projectLogReader, util.ReadJSONLog, writeJSONToWs)
+	readLog(ginCtx, projectLogReader, util.SkipJSONLog, util.ReadJSONLog, writeJSONToWs)
 }
 
 func ReadBuildLog(ginCtx *gin.Context) {
@@ -210,7 +247,7 @@ func ReadBuildLog(ginCtx *gin.Context) {
 			buildLogReader, err := server.BuildService.GetBuildLogReader(buildId)
 
 			if err == nil {
-				readLog(ginCtx, buildLogReader, util.ReadJSONLog, writeJSONToWs)
+				readLog(ginCtx, buildLogReader, util.SkipJSONLog, util.ReadJSONLog, writeJSONToWs)
 				return
 			}
 			time.Sleep(TIMEOUT)
@@ -223,5 +260,5 @@ func ReadBuildLog(ginCtx *gin.Context) {
 		return
 	}
 
-	readLog(ginCtx, buildLogReader, util.ReadJSONLog, writeJSONToWs)
+	readLog(ginCtx, buildLogReader, util.SkipJSONLog, util.ReadJSONLog, writeJSONToWs)
 }
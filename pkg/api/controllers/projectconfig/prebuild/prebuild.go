@@ -179,3 +179,33 @@ func DeletePrebuild(ctx *gin.Context) {
 
 	ctx.Status(204)
 }
+
+// TriggerPrebuild godoc
+//
+//	@Tags			prebuild
+//	@Summary		Trigger prebuild
+//	@Description	Trigger a build for the latest commit on the prebuild branch
+//	@Accept			json
+//	@Param			configName	path		string	true	"Project config name"
+//	@Param			prebuildId	path		string	true	"Prebuild ID"
+//	@Success		201			{string}	buildId
+//	@Router			/project-config/{configName}/prebuild/{prebuildId}/trigger [post]
+//
+//	@id				TriggerPrebuild
+func TriggerPrebuild(ctx *gin.Context) {
+	configName := ctx.Param("configName")
+	prebuildId := ctx.Param("prebuildId")
+
+	server := server.GetInstance(nil)
+	buildId, err := server.ProjectConfigService.TriggerPrebuild(configName, prebuildId)
+	if err != nil {
+		if config.IsPrebuildNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, errors.New("prebuild not found"))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to trigger prebuild: %s", err.Error()))
+		return
+	}
+
+	ctx.String(201, buildId)
+}
@@ -0,0 +1,17 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type SetSecretDTO struct {
+	Name  string `json:"name" validate:"required"`
+	Value string `json:"value" validate:"required"`
+	// Workspace ID or name, secrets without a workspace are available to all workspaces
+	WorkspaceId string `json:"workspaceId" validate:"optional"`
+	// Project of the workspace, secrets without a project are available to all projects of the workspace
+	ProjectName string `json:"projectName" validate:"optional"`
+	// Absolute path the secret is written to inside the project, secrets without a path are injected as environment variables
+	FilePath string `json:"filePath" validate:"optional"`
+	// Octal permissions of the secret file, defaults to 0600
+	FileMode string `json:"fileMode" validate:"optional"`
+} // @name SetSecretDTO
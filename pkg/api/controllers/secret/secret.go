@@ -0,0 +1,230 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/secret/dto"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+)
+
+// ListSecrets 			godoc
+//
+//	@Tags			secret
+//	@Summary		List secrets
+//	@Description	List secrets without their values
+//	@Produce		json
+//	@Param			workspaceId	query	string	false	"Workspace ID or Name"
+//	@Success		200			{array}	Secret
+//	@Router			/secret [get]
+//
+//	@id				ListSecrets
+func ListSecrets(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	var workspaceId *string
+	if ctx.Query("workspaceId") != "" {
+		w, ok := getAccessibleWorkspace(ctx, ctx.Query("workspaceId"))
+		if !ok {
+			return
+		}
+		workspaceId = &w.Id
+	}
+
+	secrets, err := server.SecretService.List(workspaceId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list secrets: %w", err))
+		return
+	}
+
+	// Users only list the secrets of the workspaces they can access
+	if workspaceId == nil && !ctx.GetBool("apiKeyAdmin") {
+		accessible := map[string]bool{}
+		secrets = slices.DeleteFunc(secrets, func(sec *secret.Secret) bool {
+			if sec.WorkspaceId == "" {
+				return false
+			}
+
+			if _, ok := accessible[sec.WorkspaceId]; !ok {
+				w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), sec.WorkspaceId, false)
+				accessible[sec.WorkspaceId] = err == nil && isAccessible(ctx, &w.Workspace)
+			}
+
+			return !accessible[sec.WorkspaceId]
+		})
+	}
+
+	ctx.JSON(200, secrets)
+}
+
+// SetSecret 			godoc
+//
+//	@Tags			secret
+//	@Summary		Set a secret
+//	@Description	Create or update a secret
+//	@Accept			json
+//	@Param			secret	body	SetSecretDTO	true	"Secret"
+//	@Success		201
+//	@Router			/secret [put]
+//
+//	@id				SetSecret
+func SetSecret(ctx *gin.Context) {
+	var req dto.SetSecretDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	workspaceId := ""
+	if req.WorkspaceId != "" {
+		w, ok := getAccessibleWorkspace(ctx, req.WorkspaceId)
+		if !ok {
+			return
+		}
+		workspaceId = w.Id
+
+		if req.ProjectName != "" && !slices.ContainsFunc(w.Projects, func(p *project.Project) bool { return p.Name == req.ProjectName }) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("project %s not found in workspace %s", req.ProjectName, w.Name))
+			return
+		}
+	} else if !ctx.GetBool("apiKeyAdmin") {
+		ctx.AbortWithError(http.StatusForbidden, errProfileSecretsAdminOnly)
+		return
+	}
+
+	_, err = server.SecretService.Set(secrets.SetSecretParams{
+		Name:        req.Name,
+		Value:       req.Value,
+		WorkspaceId: workspaceId,
+		ProjectName: req.ProjectName,
+		FilePath:    req.FilePath,
+		FileMode:    req.FileMode,
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to set secret: %w", err))
+		return
+	}
+
+	ctx.Status(201)
+}
+
+// DeleteSecret 			godoc
+//
+//	@Tags			secret
+//	@Summary		Delete a secret
+//	@Description	Delete a secret
+//	@Param			secretName	path	string	true	"Secret name"
+//	@Param			workspaceId	query	string	false	"Workspace ID or Name"
+//	@Param			projectName	query	string	false	"Project name"
+//	@Success		204
+//	@Router			/secret/{secretName} [delete]
+//
+//	@id				DeleteSecret
+func DeleteSecret(ctx *gin.Context) {
+	secretName := ctx.Param("secretName")
+
+	server := server.GetInstance(nil)
+
+	workspaceId := ""
+	if ctx.Query("workspaceId") != "" {
+		w, ok := getAccessibleWorkspace(ctx, ctx.Query("workspaceId"))
+		if !ok {
+			return
+		}
+		workspaceId = w.Id
+	} else if !ctx.GetBool("apiKeyAdmin") {
+		ctx.AbortWithError(http.StatusForbidden, errProfileSecretsAdminOnly)
+		return
+	}
+
+	err := server.SecretService.Delete(secretName, workspaceId, ctx.Query("projectName"))
+	if err != nil {
+		if secret.IsSecretNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to delete secret: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// GetProjectSecrets 			godoc
+//
+//	@Tags			secret
+//	@Summary		Get project secrets
+//	@Description	Get the decrypted secrets of a project. Only available to the project itself
+//	@Produce		json
+//	@Param			workspaceId	path	string	true	"Workspace ID"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200			{array}	ProjectSecret
+//	@Router			/workspace/{workspaceId}/{projectId}/secrets [get]
+//
+//	@id				GetProjectSecrets
+func GetProjectSecrets(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	keyName, err := server.ApiKeyService.GetApiKeyName(middlewares.ExtractToken(ctx.GetHeader("Authorization")))
+	if err != nil {
+		ctx.AbortWithError(http.StatusUnauthorized, errors.New("unauthorized"))
+		return
+	}
+
+	// Secrets are only returned to the workspace or project they belong to
+	if keyName != workspaceId && keyName != fmt.Sprintf("%s/%s", workspaceId, projectId) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("forbidden"))
+		return
+	}
+
+	projectSecrets, err := server.SecretService.GetProjectSecrets(workspaceId, projectId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get project secrets: %w", err))
+		return
+	}
+
+	ctx.JSON(200, projectSecrets)
+}
+
+// Secrets without a workspace are injected into the workspaces of all users
+var errProfileSecretsAdminOnly = errors.New("only admins can manage secrets that apply to all workspaces")
+
+// getAccessibleWorkspace returns the workspace referenced in the request body or query if the API key can access it.
+// Otherwise the request is aborted
+func getAccessibleWorkspace(ctx *gin.Context, workspaceIdOrName string) (*workspace.Workspace, bool) {
+	w, err := server.GetInstance(nil).WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceIdOrName, false)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to get workspace: %w", err))
+		return nil, false
+	}
+
+	status, err := middlewares.CheckWorkspaceAccess(ctx, &w.Workspace)
+	if err != nil {
+		ctx.AbortWithError(status, err)
+		return nil, false
+	}
+
+	return &w.Workspace, true
+}
+
+func isAccessible(ctx *gin.Context, w *workspace.Workspace) bool {
+	_, err := middlewares.CheckWorkspaceAccess(ctx, w)
+	return err == nil
+}
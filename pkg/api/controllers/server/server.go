@@ -4,9 +4,12 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -60,6 +63,28 @@ func SetConfig(ctx *gin.Context) {
 	ctx.JSON(200, c)
 }
 
+// ReloadConfig 			godoc
+//
+//	@Tags			server
+//	@Summary		Reload the server configuration
+//	@Description	Read the server configuration file again and apply the changes that don't require a restart
+//	@Produce		json
+//	@Success		200	{array}	ConfigChange
+//	@Router			/server/config/reload [post]
+//
+//	@id				ReloadConfig
+func ReloadConfig(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	changes, err := server.ReloadConfig()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to reload config: %w", err))
+		return
+	}
+
+	ctx.JSON(200, changes)
+}
+
 // GenerateNetworkKey 		godoc
 //
 //	@Tags			server
@@ -71,6 +96,14 @@ func SetConfig(ctx *gin.Context) {
 //
 //	@id				GenerateNetworkKey
 func GenerateNetworkKey(ctx *gin.Context) {
+	// The network key reaches every project, so users that are not admins connect through the tunnel of the
+	// server, which checks their access to the workspace
+	apiKeyType, ok := ctx.Get("apiKeyType")
+	if !ok || (apiKeyType == apikey.ApiKeyTypeClient && !ctx.GetBool("apiKeyAdmin")) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only admins and the agents of workspaces can join the network"))
+		return
+	}
+
 	s := server.GetInstance(nil)
 
 	authKey, err := s.TailscaleServer.CreateAuthKey()
@@ -103,3 +136,78 @@ func GetServerLogFiles(ctx *gin.Context) {
 
 	ctx.JSON(200, logFiles)
 }
+
+// GetFailureStats 			godoc
+//
+//	@Tags			server
+//	@Summary		Get provisioning failure stats
+//	@Description	Get the provisioning failures aggregated by category
+//	@Produce		json
+//	@Param			since	query		string	false	"Only include failures after this time (RFC 3339). Defaults to 30 days ago"
+//	@Success		200		{object}	FailureStats
+//	@Router			/server/stats/failures [get]
+//
+//	@id				GetFailureStats
+func GetFailureStats(ctx *gin.Context) {
+	since := time.Now().AddDate(0, 0, -30)
+
+	if sinceQuery := ctx.Query("since"); sinceQuery != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339, sinceQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid since: %w", err))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	stats, err := server.WorkspaceService.GetFailureStats(since)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get failure stats: %w", err))
+		return
+	}
+
+	ctx.JSON(200, stats)
+}
+
+// GetHostInfo 			godoc
+//
+//	@Tags			server
+//	@Summary		Get the server host info
+//	@Description	Get the kernel features of the server host that advanced workspace features depend on
+//	@Produce		json
+//	@Success		200	{object}	HostInfo
+//	@Router			/server/host-info [get]
+//
+//	@id				GetHostInfo
+func GetHostInfo(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	ctx.JSON(200, server.HostInfo)
+}
+
+// CheckUpgrade 			godoc
+//
+//	@Tags			server
+//	@Summary		Check if the server can be upgraded
+//	@Description	Pre-flight check run before the server is upgraded to the target version
+//	@Produce		json
+//	@Param			version	query		string	true	"Target version"
+//	@Success		200		{object}	UpgradeCheck
+//	@Router			/server/upgrade/check [get]
+//
+//	@id				CheckUpgrade
+func CheckUpgrade(ctx *gin.Context) {
+	version := ctx.Query("version")
+
+	server := server.GetInstance(nil)
+
+	check, err := server.CheckUpgrade(version)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to check upgrade: %w", err))
+		return
+	}
+
+	ctx.JSON(200, check)
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// filterAccessible removes the items of workspaces that the API key of the request can't access, for lists that are
+// filtered by a workspace in the query instead of the path. The owners of removed workspaces are unknown, so their
+// items are only listed for admins
+func filterAccessible[T any](ctx *gin.Context, items []T, getWorkspaceId func(T) string) []T {
+	if ctx.GetBool("apiKeyAdmin") {
+		return items
+	}
+
+	server := server.GetInstance(nil)
+
+	accessible := map[string]bool{}
+	return slices.DeleteFunc(items, func(item T) bool {
+		workspaceId := getWorkspaceId(item)
+
+		if _, ok := accessible[workspaceId]; !ok {
+			w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+			if err == nil {
+				_, err = middlewares.CheckWorkspaceAccess(ctx, &w.Workspace)
+			}
+			accessible[workspaceId] = err == nil
+		}
+
+		return !accessible[workspaceId]
+	})
+}
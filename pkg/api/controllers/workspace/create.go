@@ -32,6 +32,9 @@ func CreateWorkspace(ctx *gin.Context) {
 		return
 	}
 
+	// Workspaces created with client keys are owned by the user of the key
+	createWorkspaceReq.Owner = ctx.GetString("apiKeyName")
+
 	server := server.GetInstance(nil)
 
 	w, err := server.WorkspaceService.CreateWorkspace(ctx.Request.Context(), createWorkspaceReq)
@@ -40,6 +43,10 @@ func CreateWorkspace(ctx *gin.Context) {
 			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("workspace already exists: %w", err))
 			return
 		}
+		if workspaces.IsInvalidRetries(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create workspace: %w", err))
 		return
 	}
@@ -4,10 +4,36 @@
 package dto
 
 import (
+	"github.com/daytonaio/daytona/pkg/workspace/events"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 type SetProjectState struct {
 	Uptime    uint64             `json:"uptime" validate:"required"`
+	IdleTime  uint64             `json:"idleTime,omitempty" validate:"optional"`
 	GitStatus *project.GitStatus `json:"gitStatus,omitempty" validate:"optional"`
+	HostKey   string             `json:"hostKey,omitempty" validate:"optional"`
 } // @name SetProjectState
+
+type RenameWorkspace struct {
+	Name string `json:"name" validate:"required"`
+} // @name RenameWorkspace
+
+type AddProjectRuntimes struct {
+	// Runtimes in the tool@version format, e.g. node@20
+	Runtimes []string `json:"runtimes" validate:"required"`
+} // @name AddProjectRuntimes
+
+type CreateSnapshot struct {
+	Name string `json:"name" validate:"optional"`
+} // @name CreateSnapshot
+
+type RecordEvent struct {
+	Type        events.EventType `json:"type" validate:"required"`
+	ProjectName string           `json:"projectName,omitempty" validate:"optional"`
+} // @name RecordEvent
+
+type SshAccess struct {
+	// Public key in the authorized_keys format
+	PublicKey string `json:"publicKey" validate:"required"`
+} // @name SshAccess
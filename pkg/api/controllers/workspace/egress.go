@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/workspace/egress"
+	"github.com/gin-gonic/gin"
+)
+
+// ListEgressUsage 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List egress usage
+//	@Description	List the outbound traffic of projects by month
+//	@Produce		json
+//	@Param			workspaceId	query	string	false	"Workspace ID"
+//	@Param			month		query	string	false	"Month in the YYYY-MM format"
+//	@Success		200			{array}	EgressUsage
+//	@Router			/workspace/egress [get]
+//
+//	@id				ListEgressUsage
+func ListEgressUsage(ctx *gin.Context) {
+	workspaceId := ctx.Query("workspaceId")
+	month := ctx.Query("month")
+
+	filter := &egress.Filter{}
+	if workspaceId != "" {
+		filter.WorkspaceId = &workspaceId
+	}
+	if month != "" {
+		filter.Month = &month
+	}
+
+	server := server.GetInstance(nil)
+
+	usages, err := server.WorkspaceService.ListEgressUsage(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list egress usage: %w", err))
+		return
+	}
+
+	usages = filterAccessible(ctx, usages, func(usage *egress.Usage) string {
+		return usage.WorkspaceId
+	})
+
+	ctx.JSON(200, usages)
+}
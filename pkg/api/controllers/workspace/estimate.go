@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// EstimateWorkspaceCreation 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Estimate the creation time of a workspace
+//	@Description	Estimate how long the creation of a workspace takes from the previous creations on its target, the available prebuilds and the cached images
+//	@Param			workspace	body	CreateWorkspaceDTO	true	"Workspace to create"
+//	@Produce		json
+//	@Success		200	{object}	CreationEstimate
+//	@Router			/workspace/estimate [post]
+//
+//	@id				EstimateWorkspaceCreation
+func EstimateWorkspaceCreation(ctx *gin.Context) {
+	var createWorkspaceReq dto.CreateWorkspaceDTO
+	err := ctx.BindJSON(&createWorkspaceReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	// Only the builds of the user are considered
+	createWorkspaceReq.Owner = ctx.GetString("apiKeyName")
+
+	server := server.GetInstance(nil)
+
+	estimate, err := server.WorkspaceService.EstimateWorkspaceCreation(ctx.Request.Context(), createWorkspaceReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to estimate workspace creation: %w", err))
+		return
+	}
+
+	ctx.JSON(200, estimate)
+}
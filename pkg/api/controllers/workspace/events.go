@@ -0,0 +1,99 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+	"github.com/gin-gonic/gin"
+)
+
+// ListEvents 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List workspace events
+//	@Description	List lifecycle events of workspaces, oldest first
+//	@Produce		json
+//	@Param			workspace	query	string	false	"Workspace ID or Name"
+//	@Param			since		query	string	false	"Only include events created after this time (RFC3339)"
+//	@Success		200			{array}	WorkspaceEvent
+//	@Router			/workspace/events [get]
+//
+//	@id				ListEvents
+func ListEvents(ctx *gin.Context) {
+	filter := &events.Filter{}
+
+	workspace := ctx.Query("workspace")
+	if workspace != "" {
+		filter.Workspace = &workspace
+	}
+
+	if sinceQuery := ctx.Query("since"); sinceQuery != "" {
+		since, err := time.Parse(time.RFC3339Nano, sinceQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid since time: %w", err))
+			return
+		}
+		filter.Since = &since
+	}
+
+	server := server.GetInstance(nil)
+
+	workspaceEvents, err := server.WorkspaceService.ListEvents(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list events: %w", err))
+		return
+	}
+
+	workspaceEvents = filterAccessible(ctx, workspaceEvents, func(event *events.Event) string {
+		return event.WorkspaceId
+	})
+
+	ctx.JSON(200, workspaceEvents)
+}
+
+// RecordEvent 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Record workspace event
+//	@Description	Record an event reported by a client. Only ssh-connected events are accepted
+//	@Param			workspaceId	path	string		true	"Workspace ID or Name"
+//	@Param			event		body	RecordEvent	true	"Event"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/events [post]
+//
+//	@id				RecordEvent
+func RecordEvent(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.RecordEvent
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.RecordEvent(workspaceId, req.ProjectName, req.Type)
+	if err != nil {
+		switch {
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to record event: %w", err))
+		case err == workspaces.ErrInvalidEventType:
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to record event: %w", err))
+		default:
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to record event: %w", err))
+		}
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -4,14 +4,19 @@
 package workspace
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // SetProjectState 			godoc
@@ -41,8 +46,10 @@ func SetProjectState(ctx *gin.Context) {
 
 	_, err = server.WorkspaceService.SetProjectState(workspaceId, projectId, &project.ProjectState{
 		Uptime:    setProjectStateDTO.Uptime,
+		IdleTime:  setProjectStateDTO.IdleTime,
 		UpdatedAt: time.Now().Format(time.RFC1123),
 		GitStatus: setProjectStateDTO.GitStatus,
+		HostKey:   setProjectStateDTO.HostKey,
 	})
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
@@ -51,3 +58,58 @@ func SetProjectState(ctx *gin.Context) {
 
 	ctx.Status(200)
 }
+
+// RemoveProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Remove project
+//	@Description	Remove a single project from a workspace
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Param			force		query	bool	false	"Force"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId} [delete]
+//
+//	@id				RemoveProject
+func RemoveProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+	forceQuery := ctx.Query("force")
+	var err error
+	force := false
+
+	if forceQuery != "" {
+		force, err = strconv.ParseBool(forceQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for force flag"))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err == nil {
+		workspaceId = w.Id
+	}
+
+	err = server.WorkspaceService.RemoveProject(ctx.Request.Context(), workspaceId, projectId, force)
+	if err != nil {
+		switch {
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to remove project: %w", err))
+		case err == workspaces.ErrLastProject:
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to remove project: %w", err))
+		default:
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to remove project: %w", err))
+		}
+		return
+	}
+
+	err = server.SecretService.DeleteProjectSecrets(workspaceId, projectId)
+	if err != nil {
+		log.Errorf("failed to delete secrets of project %s in workspace %s: %v", projectId, workspaceId, err)
+	}
+
+	ctx.Status(200)
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// RemoveWorkspaceStream removes the workspace like RemoveWorkspace and streams the teardown steps over a websocket.
+// Each message is a JSON encoded workspace.TeardownProgress and the last message has the done step with the result
+func RemoveWorkspaceStream(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	force := false
+
+	if forceQuery := ctx.Query("force"); forceQuery != "" {
+		var err error
+		force, err = strconv.ParseBool(forceQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for force flag"))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get workspace: %w", err))
+		return
+	}
+
+	ws, err := upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer ws.Close()
+
+	// The removal continues if the client disconnects
+	sendProgress := func(progress workspace.TeardownProgress) {
+		err := ws.WriteJSON(progress)
+		if err != nil {
+			log.Trace(err)
+		}
+	}
+
+	err = server.WorkspaceService.RemoveWorkspaceWithProgress(ctx.Request.Context(), w.Id, force, sendProgress)
+	if err == nil {
+		secretsErr := server.SecretService.DeleteWorkspaceSecrets(w.Id)
+		if secretsErr != nil {
+			log.Errorf("failed to delete secrets of workspace %s: %v", w.Id, secretsErr)
+		}
+	}
+
+	result := workspace.TeardownProgress{Step: workspace.TeardownStepDone, Status: workspace.TeardownStatusCompleted}
+	if err != nil {
+		result.Status = workspace.TeardownStatusFailed
+		result.Error = err.Error()
+	}
+	sendProgress(result)
+
+	err = ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	if err != nil {
+		log.Trace(err)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// RenameWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Rename workspace
+//	@Description	Rename workspace
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			rename		body	RenameWorkspace	true	"Rename workspace"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/rename [post]
+//
+//	@id				RenameWorkspace
+func RenameWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.RenameWorkspace
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	_, err = server.WorkspaceService.RenameWorkspace(ctx.Request.Context(), workspaceId, req.Name)
+	if err != nil {
+		switch {
+		case workspaces.IsWorkspaceNotFound(err):
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to rename workspace %s: %w", workspaceId, err))
+		case workspaces.IsWorkspaceAlreadyExists(err):
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to rename workspace %s: %w", workspaceId, err))
+		case workspaces.IsInvalidWorkspaceName(err):
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to rename workspace %s: %w", workspaceId, err))
+		default:
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to rename workspace %s: %w", workspaceId, err))
+		}
+		return
+	}
+
+	ctx.Status(200)
+}
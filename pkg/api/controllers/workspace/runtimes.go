@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// AddProjectRuntimes 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Add project runtimes
+//	@Description	Record the runtimes installed in a project
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			projectId	path	string				true	"Project ID"
+//	@Param			runtimes	body	AddProjectRuntimes	true	"Installed runtimes"
+//	@Produce		json
+//	@Success		200	{object}	Project
+//	@Router			/workspace/{workspaceId}/{projectId}/runtimes [post]
+//
+//	@id				AddProjectRuntimes
+func AddProjectRuntimes(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.AddProjectRuntimes
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	p, err := server.WorkspaceService.AddProjectRuntimes(ctx.Request.Context(), workspaceId, projectId, req.Runtimes)
+	if err != nil {
+		switch {
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to add project runtimes: %w", err))
+		default:
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to add project runtimes: %w", err))
+		}
+		return
+	}
+
+	ctx.JSON(200, p)
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateWorkspaceSettings 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Update workspace settings
+//	@Description	Replace the group, labels and auto-stop timeout of a workspace
+//	@Param			workspaceId	path	string						true	"Workspace ID or Name"
+//	@Param			settings	body	UpdateWorkspaceSettingsDTO	true	"Workspace settings"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Router			/workspace/{workspaceId}/settings [post]
+//
+//	@id				UpdateWorkspaceSettings
+func UpdateWorkspaceSettings(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.UpdateWorkspaceSettingsDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.UpdateWorkspaceSettings(ctx.Request.Context(), workspaceId, req)
+	if err != nil {
+		switch {
+		case workspaces.IsWorkspaceNotFound(err):
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to update workspace %s: %w", workspaceId, err))
+		case workspaces.IsInvalidGroupName(err):
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to update workspace %s: %w", workspaceId, err))
+		default:
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to update workspace %s: %w", workspaceId, err))
+		}
+		return
+	}
+
+	ctx.JSON(200, w)
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ShareWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Share workspace
+//	@Description	Replace the users a workspace is shared with. Users can view shared workspaces and connect to them
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			share		body	ShareWorkspaceDTO	true	"Users to share the workspace with"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Router			/workspace/{workspaceId}/share [post]
+//
+//	@id				ShareWorkspace
+func ShareWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.ShareWorkspaceDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.ShareWorkspace(ctx.Request.Context(), workspaceId, req.SharedWith)
+	if err != nil {
+		switch {
+		case workspaces.IsWorkspaceNotFound(err):
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to share workspace %s: %w", workspaceId, err))
+		case workspaces.IsUserNotFound(err):
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to share workspace %s: %w", workspaceId, err))
+		default:
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to share workspace %s: %w", workspaceId, err))
+		}
+		return
+	}
+
+	ctx.JSON(200, w)
+}
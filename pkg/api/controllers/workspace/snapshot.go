@@ -0,0 +1,117 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateSnapshot 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Create project snapshot
+//	@Description	Checkpoint the project container filesystem
+//	@Produce		json
+//	@Param			workspaceId	path		string			true	"Workspace ID or Name"
+//	@Param			projectId	path		string			true	"Project ID"
+//	@Param			snapshot	body		CreateSnapshot	true	"Create snapshot"
+//	@Success		200			{object}	Snapshot
+//	@Router			/workspace/{workspaceId}/{projectId}/snapshot [post]
+//
+//	@id				CreateSnapshot
+func CreateSnapshot(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.CreateSnapshot
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	sn, err := server.WorkspaceService.CreateSnapshot(ctx.Request.Context(), workspaceId, projectId, req.Name)
+	if err != nil {
+		switch {
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to create snapshot: %w", err))
+		case err == workspaces.ErrSnapshotAlreadyExists:
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to create snapshot: %w", err))
+		case err == workspaces.ErrInvalidSnapshotName:
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to create snapshot: %w", err))
+		default:
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create snapshot: %w", err))
+		}
+		return
+	}
+
+	ctx.JSON(200, sn)
+}
+
+// ListSnapshots 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List workspace snapshots
+//	@Description	List workspace snapshots
+//	@Produce		json
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Success		200			{array}	Snapshot
+//	@Router			/workspace/{workspaceId}/snapshot [get]
+//
+//	@id				ListSnapshots
+func ListSnapshots(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	snapshots, err := server.WorkspaceService.ListSnapshots(ctx.Request.Context(), workspaceId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to list snapshots: %w", err))
+		return
+	}
+
+	ctx.JSON(200, snapshots)
+}
+
+// RestoreSnapshot 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Restore project snapshot
+//	@Description	Roll the project container filesystem back to a snapshot
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			snapshotId	path	string	true	"Snapshot ID or Name"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/snapshot/{snapshotId}/restore [post]
+//
+//	@id				RestoreSnapshot
+func RestoreSnapshot(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	snapshotId := ctx.Param("snapshotId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.RestoreSnapshot(ctx.Request.Context(), workspaceId, snapshotId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsSnapshotNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to restore snapshot %s: %w", snapshotId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// GrantSshAccess 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Grant SSH access
+//	@Description	Let a public key authenticate to the SSH servers of the workspace projects for a minute
+//	@Param			workspaceId	path	string		true	"Workspace ID or Name"
+//	@Param			access		body	SshAccess	true	"SSH access"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/ssh-access [post]
+//
+//	@id				GrantSshAccess
+func GrantSshAccess(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.SshAccess
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.GrantSshAccess(workspaceId, req.PublicKey)
+	if err != nil {
+		switch {
+		case workspaces.IsWorkspaceNotFound(err):
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to grant SSH access: %w", err))
+		case workspaces.IsInvalidPublicKey(err):
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to grant SSH access: %w", err))
+		default:
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to grant SSH access: %w", err))
+		}
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// VerifySshAccess 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Verify SSH access
+//	@Description	Check that a public key was granted access to the workspace. Only the agents of the workspace can verify access
+//	@Param			workspaceId	path	string		true	"Workspace ID or Name"
+//	@Param			access		body	SshAccess	true	"SSH access"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/ssh-access/verify [post]
+//
+//	@id				VerifySshAccess
+func VerifySshAccess(ctx *gin.Context) {
+	apiKeyType, ok := ctx.Get("apiKeyType")
+	if !ok || apiKeyType == apikey.ApiKeyTypeClient {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only the agents of the workspace can verify SSH access"))
+		return
+	}
+
+	var req dto.SshAccess
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	// Agent keys are restricted to their workspace, which is checked by the auth middleware
+	if !server.WorkspaceService.IsSshAccessGranted(ctx.GetString("apiKeyWorkspaceId"), req.PublicKey) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("the public key was not granted access to the workspace"))
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/workspace/timings"
+	"github.com/gin-gonic/gin"
+)
+
+// ListCreationTimings 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List creation timings
+//	@Description	List the phase timings of project creations
+//	@Produce		json
+//	@Param			workspaceId	query	string	false	"Workspace ID"
+//	@Success		200			{array}	CreationTiming
+//	@Router			/workspace/timings [get]
+//
+//	@id				ListCreationTimings
+func ListCreationTimings(ctx *gin.Context) {
+	workspaceId := ctx.Query("workspaceId")
+
+	var filter *timings.Filter
+	if workspaceId != "" {
+		filter = &timings.Filter{WorkspaceId: &workspaceId}
+	}
+
+	server := server.GetInstance(nil)
+
+	creationTimings, err := server.WorkspaceService.ListCreationTimings(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list creation timings: %w", err))
+		return
+	}
+
+	creationTimings = filterAccessible(ctx, creationTimings, func(timing *timings.CreationTiming) string {
+		return timing.WorkspaceId
+	})
+
+	ctx.JSON(200, creationTimings)
+}
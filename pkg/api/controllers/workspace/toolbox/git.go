@@ -141,6 +141,23 @@ func GitPushChanges(ctx *gin.Context) {
 	forwardRequestToToolbox(ctx)
 }
 
+// GitRefreshRepository			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Refresh repository
+//	@Description	Fetch from origin and optionally reset or fast-forward the git repository inside workspace project
+//	@Produce		json
+//	@Param			workspaceId	path		string				true	"Workspace ID or Name"
+//	@Param			projectId	path		string				true	"Project ID"
+//	@Param			params		body		GitRefreshRequest	true	"Git refresh request"
+//	@Success		200			{object}	GitStatus
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/git/refresh [post]
+//
+//	@id				GitRefreshRepository
+func GitRefreshRepository(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
 // GitStatus			godoc
 //
 //	@Tags			workspace toolbox
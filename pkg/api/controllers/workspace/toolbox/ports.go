@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import "github.com/gin-gonic/gin"
+
+// PortsListPorts 			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		List ports
+//	@Description	List the TCP ports that are listening inside the workspace project
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	ListPortsResponse
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/ports [get]
+//
+//	@id				PortsListPorts
+func PortsListPorts(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
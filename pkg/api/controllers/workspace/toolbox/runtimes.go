@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import "github.com/gin-gonic/gin"
+
+// RuntimesInstall			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Install runtimes
+//	@Description	Install language runtimes and tools inside the workspace project
+//	@Produce		json
+//	@Param			workspaceId	path		string					true	"Workspace ID or Name"
+//	@Param			projectId	path		string					true	"Project ID"
+//	@Param			params		body		InstallRuntimesRequest	true	"InstallRuntimesRequest"
+//	@Success		200			{object}	InstallRuntimesResponse
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/runtimes/install [post]
+//
+//	@id				RuntimesInstall
+func RuntimesInstall(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
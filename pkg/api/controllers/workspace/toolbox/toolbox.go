@@ -7,12 +7,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/e2e"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -35,6 +37,28 @@ func GetProjectDir(ctx *gin.Context) {
 	forwardRequestToToolbox(ctx)
 }
 
+// GetE2EPublicKey 			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Get end-to-end encryption public key
+//	@Description	Get the public key of the project agent used for end-to-end encryption of toolbox requests
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	E2EPublicKeyResponse
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/e2e/public-key [get]
+//
+//	@id				GetE2EPublicKey
+func GetE2EPublicKey(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// ReadAgentLog forwards the agent log of the project, followed if the follow query parameter is set.
+// The response is streamed so the route is not part of the API spec
+func ReadAgentLog(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
 func forwardRequestToToolbox(ctx *gin.Context) {
 	workspaceId := ctx.Param("workspaceId")
 	projectId := ctx.Param("projectId")
@@ -105,6 +129,34 @@ func forwardRequestToToolbox(ctx *gin.Context) {
 		ctx.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
+	defer resp.Body.Close()
+
+	var extraHeaders map[string]string
+	if encrypted := resp.Header.Get(e2e.ENCRYPTED_HEADER); encrypted != "" {
+		extraHeaders = map[string]string{e2e.ENCRYPTED_HEADER: encrypted}
+	}
+
+	if resp.ContentLength >= 0 {
+		ctx.DataFromReader(resp.StatusCode, resp.ContentLength, resp.Header.Get("Content-Type"), resp.Body, extraHeaders)
+		return
+	}
 
-	ctx.DataFromReader(resp.StatusCode, resp.ContentLength, resp.Header.Get("Content-Type"), resp.Body, nil)
+	// Responses without a length, e.g. followed logs and encrypted bodies, are flushed as they arrive
+	for key, value := range extraHeaders {
+		ctx.Header(key, value)
+	}
+	ctx.Header("Content-Type", resp.Header.Get("Content-Type"))
+	ctx.Status(resp.StatusCode)
+
+	buf := make([]byte, 32*1024)
+	ctx.Stream(func(w io.Writer) bool {
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			_, writeErr := w.Write(buf[:n])
+			if writeErr != nil {
+				return false
+			}
+		}
+		return err == nil
+	})
 }
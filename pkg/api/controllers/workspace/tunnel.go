@@ -0,0 +1,90 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	toolbox_config "github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// TunnelProjectPort connects a websocket to a port of the project over the network of the server, for clients
+// that are not allowed to join the network themselves. The data is sent in binary messages, so the route is not
+// part of the API spec. The toolbox is only reachable through the toolbox routes, which check write access
+func TunnelProjectPort(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	port, err := strconv.ParseUint(ctx.Param("port"), 10, 16)
+	if err != nil || port == 0 {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid port"))
+		return
+	}
+
+	if port == toolbox_config.TOOLBOX_API_PORT {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("the toolbox can't be reached through a tunnel"))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to get workspace: %w", err))
+		return
+	}
+
+	_, err = w.GetProject(projectId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to get project: %w", err))
+		return
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	address := net.JoinHostPort(project.GetProjectHostname(w.Id, projectId), strconv.FormatUint(port, 10))
+	projectConn, err := server.TailscaleServer.Dial(dialCtx, "tcp", address)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadGateway, fmt.Errorf("failed to connect to port %d of project %s: %w", port, projectId, err))
+		return
+	}
+	defer projectConn.Close()
+
+	ws, err := upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	clientConn := util.NewWebsocketConn(ws)
+	defer clientConn.Close()
+
+	// Both connections are closed when either side is done
+	go func() {
+		_, err := io.Copy(projectConn, clientConn)
+		if err != nil {
+			log.Trace(err)
+		}
+		projectConn.Close()
+	}()
+
+	_, err = io.Copy(clientConn, projectConn)
+	if err != nil {
+		log.Trace(err)
+	}
+}
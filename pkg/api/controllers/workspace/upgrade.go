@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// UpgradeProjectConfig 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Upgrade project config
+//	@Description	Rebuild the project with the latest version of its project config
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/upgrade-config [post]
+//
+//	@id				UpgradeProjectConfig
+func UpgradeProjectConfig(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.UpgradeProjectConfig(ctx.Request.Context(), workspaceId, projectId)
+	if err != nil {
+		switch {
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to upgrade project: %w", err))
+		case err == workspaces.ErrNoProjectConfig, err == workspaces.ErrProjectConfigUpToDate:
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to upgrade project: %w", err))
+		default:
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to upgrade project: %w", err))
+		}
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -7,10 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // GetWorkspace 			godoc
@@ -59,6 +63,7 @@ func GetWorkspace(ctx *gin.Context) {
 //	@Success		200	{array}	WorkspaceDTO
 //	@Router			/workspace [get]
 //	@Param			verbose	query	bool	false	"Verbose"
+//	@Param			all		query	bool	false	"List the workspaces of all users. Requires an admin API key"
 //
 //	@id				ListWorkspaces
 func ListWorkspaces(ctx *gin.Context) {
@@ -74,6 +79,20 @@ func ListWorkspaces(ctx *gin.Context) {
 		}
 	}
 
+	all := false
+	if allQuery := ctx.Query("all"); allQuery != "" {
+		all, err = strconv.ParseBool(allQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for all flag"))
+			return
+		}
+	}
+
+	if all && !ctx.GetBool("apiKeyAdmin") {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only admins can list the workspaces of all users"))
+		return
+	}
+
 	server := server.GetInstance(nil)
 
 	workspaceList, err := server.WorkspaceService.ListWorkspaces(ctx.Request.Context(), verbose)
@@ -82,6 +101,20 @@ func ListWorkspaces(ctx *gin.Context) {
 		return
 	}
 
+	// Keys restricted to a workspace only list that workspace
+	if workspaceId := ctx.GetString("apiKeyWorkspaceId"); workspaceId != "" {
+		workspaceList = slices.DeleteFunc(workspaceList, func(w dto.WorkspaceDTO) bool {
+			return w.Id != workspaceId
+		})
+	}
+
+	// Users only list the workspaces they own and the workspaces shared with them
+	if user := ctx.GetString("apiKeyName"); user != "" && !all {
+		workspaceList = slices.DeleteFunc(workspaceList, func(w dto.WorkspaceDTO) bool {
+			return !w.IsAccessibleBy(user)
+		})
+	}
+
 	ctx.JSON(200, workspaceList)
 }
 
@@ -112,6 +145,11 @@ func RemoveWorkspace(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err == nil {
+		workspaceId = w.Id
+	}
+
 	if force {
 		err = server.WorkspaceService.ForceRemoveWorkspace(ctx.Request.Context(), workspaceId)
 	} else {
@@ -123,5 +161,10 @@ func RemoveWorkspace(ctx *gin.Context) {
 		return
 	}
 
+	err = server.SecretService.DeleteWorkspaceSecrets(workspaceId)
+	if err != nil {
+		log.Errorf("failed to delete secrets of workspace %s: %v", workspaceId, err)
+	}
+
 	ctx.Status(200)
 }
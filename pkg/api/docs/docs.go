@@ -57,6 +57,12 @@ const docTemplate = `{
                         "name": "apiKeyName",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Generate a read-only key",
+                        "name": "readOnly",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -254,6 +260,30 @@ const docTemplate = `{
                 }
             }
         },
+        "/build/{buildId}/cancel": {
+            "post": {
+                "description": "Cancel a pending or running build",
+                "tags": [
+                    "build"
+                ],
+                "summary": "Cancel build",
+                "operationId": "CancelBuild",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
         "/container-registry": {
             "get": {
                 "description": "List container registries",
@@ -860,6 +890,41 @@ const docTemplate = `{
                 }
             }
         },
+        "/invite/redeem": {
+            "post": {
+                "description": "Redeem a one-time invite code for a read-only API key restricted to the workspace. The key expires with the invite",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invite"
+                ],
+                "summary": "Redeem a workspace invite",
+                "operationId": "RedeemInvite",
+                "parameters": [
+                    {
+                        "description": "Invite code",
+                        "name": "invite",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RedeemInviteDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/InviteAccessDTO"
+                        }
+                    }
+                }
+            }
+        },
         "/profile": {
             "get": {
                 "description": "Get profile data",
@@ -1255,6 +1320,43 @@ const docTemplate = `{
                 }
             }
         },
+        "/project-config/{configName}/prebuild/{prebuildId}/trigger": {
+            "post": {
+                "description": "Trigger a build for the latest commit on the prebuild branch",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "prebuild"
+                ],
+                "summary": "Trigger prebuild",
+                "operationId": "TriggerPrebuild",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project config name",
+                        "name": "configName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Prebuild ID",
+                        "name": "prebuildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/project-config/{configName}/set-default": {
             "patch": {
                 "description": "Set project config to default",
@@ -1410,6 +1512,101 @@ const docTemplate = `{
                 }
             }
         },
+        "/secret": {
+            "get": {
+                "description": "List secrets without their values",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "secret"
+                ],
+                "summary": "List secrets",
+                "operationId": "ListSecrets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Secret"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Create or update a secret",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "secret"
+                ],
+                "summary": "Set a secret",
+                "operationId": "SetSecret",
+                "parameters": [
+                    {
+                        "description": "Secret",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SetSecretDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    }
+                }
+            }
+        },
+        "/secret/{secretName}": {
+            "delete": {
+                "description": "Delete a secret",
+                "tags": [
+                    "secret"
+                ],
+                "summary": "Delete a secret",
+                "operationId": "DeleteSecret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Secret name",
+                        "name": "secretName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project name",
+                        "name": "projectName",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
         "/server/config": {
             "get": {
                 "description": "Get the server configuration",
@@ -1464,6 +1661,51 @@ const docTemplate = `{
                 }
             }
         },
+        "/server/config/reload": {
+            "post": {
+                "description": "Read the server configuration file again and apply the changes that don't require a restart",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Reload the server configuration",
+                "operationId": "ReloadConfig",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ConfigChange"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/server/host-info": {
+            "get": {
+                "description": "Get the kernel features of the server host that advanced workspace features depend on",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Get the server host info",
+                "operationId": "GetHostInfo",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/HostInfo"
+                        }
+                    }
+                }
+            }
+        },
         "/server/logs": {
             "get": {
                 "description": "List server log files",
@@ -1509,6 +1751,65 @@ const docTemplate = `{
                 }
             }
         },
+        "/server/stats/failures": {
+            "get": {
+                "description": "Get the provisioning failures aggregated by category",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Get provisioning failure stats",
+                "operationId": "GetFailureStats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only include failures after this time (RFC 3339). Defaults to 30 days ago",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/FailureStats"
+                        }
+                    }
+                }
+            }
+        },
+        "/server/upgrade/check": {
+            "get": {
+                "description": "Pre-flight check run before the server is upgraded to the target version",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Check if the server can be upgraded",
+                "operationId": "CheckUpgrade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target version",
+                        "name": "version",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UpgradeCheck"
+                        }
+                    }
+                }
+            }
+        },
         "/target": {
             "get": {
                 "description": "List targets",
@@ -1622,6 +1923,12 @@ const docTemplate = `{
                         "description": "Verbose",
                         "name": "verbose",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "List the workspaces of all users. Requires an admin API key",
+                        "name": "all",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1667,29 +1974,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspaceId}": {
+        "/workspace/egress": {
             "get": {
-                "description": "Get workspace info",
+                "description": "List the outbound traffic of projects by month",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workspace"
                 ],
-                "summary": "Get workspace info",
-                "operationId": "GetWorkspace",
+                "summary": "List egress usage",
+                "operationId": "ListEgressUsage",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace ID or Name",
+                        "description": "Workspace ID",
                         "name": "workspaceId",
-                        "in": "path",
-                        "required": true
+                        "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Verbose",
-                        "name": "verbose",
+                        "type": "string",
+                        "description": "Month in the YYYY-MM format",
+                        "name": "month",
                         "in": "query"
                     }
                 ],
@@ -1697,9 +2003,150 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/WorkspaceDTO"
-                        }
-                    }
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/EgressUsage"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/estimate": {
+            "post": {
+                "description": "Estimate how long the creation of a workspace takes from the previous creations on its target, the available prebuilds and the cached images",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Estimate the creation time of a workspace",
+                "operationId": "EstimateWorkspaceCreation",
+                "parameters": [
+                    {
+                        "description": "Workspace to create",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWorkspaceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CreationEstimate"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/events": {
+            "get": {
+                "description": "List lifecycle events of workspaces, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "List workspace events",
+                "operationId": "ListEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include events created after this time (RFC3339)",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WorkspaceEvent"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/timings": {
+            "get": {
+                "description": "List the phase timings of project creations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "List creation timings",
+                "operationId": "ListCreationTimings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/CreationTiming"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}": {
+            "get": {
+                "description": "Get workspace info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get workspace info",
+                "operationId": "GetWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Verbose",
+                        "name": "verbose",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WorkspaceDTO"
+                        }
+                    }
                 }
             },
             "delete": {
@@ -1731,38 +2178,504 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/events": {
+            "post": {
+                "description": "Record an event reported by a client. Only ssh-connected events are accepted",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Record workspace event",
+                "operationId": "RecordEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RecordEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/invite": {
+            "post": {
+                "description": "Create a one-time code that grants temporary read-only access to the workspace when it is redeemed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invite"
+                ],
+                "summary": "Create a workspace invite",
+                "operationId": "CreateInvite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invite",
+                        "name": "invite",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateInviteDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/InviteDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/rename": {
+            "post": {
+                "description": "Rename workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Rename workspace",
+                "operationId": "RenameWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rename workspace",
+                        "name": "rename",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RenameWorkspace"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/settings": {
+            "post": {
+                "description": "Replace the group, labels and auto-stop timeout of a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Update workspace settings",
+                "operationId": "UpdateWorkspaceSettings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Workspace settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateWorkspaceSettingsDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/share": {
+            "post": {
+                "description": "Replace the users a workspace is shared with. Users can view shared workspaces and connect to them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Share workspace",
+                "operationId": "ShareWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Users to share the workspace with",
+                        "name": "share",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ShareWorkspaceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/snapshot": {
+            "get": {
+                "description": "List workspace snapshots",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "List workspace snapshots",
+                "operationId": "ListSnapshots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Snapshot"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/snapshot/{snapshotId}/restore": {
+            "post": {
+                "description": "Roll the project container filesystem back to a snapshot",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Restore project snapshot",
+                "operationId": "RestoreSnapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snapshot ID or Name",
+                        "name": "snapshotId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/ssh-access": {
+            "post": {
+                "description": "Let a public key authenticate to the SSH servers of the workspace projects for a minute",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Grant SSH access",
+                "operationId": "GrantSshAccess",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SSH access",
+                        "name": "access",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SshAccess"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/ssh-access/verify": {
+            "post": {
+                "description": "Check that a public key was granted access to the workspace. Only the agents of the workspace can verify access",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Verify SSH access",
+                "operationId": "VerifySshAccess",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SSH access",
+                        "name": "access",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SshAccess"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/start": {
             "post": {
                 "description": "Start workspace",
                 "tags": [
-                    "workspace"
+                    "workspace"
+                ],
+                "summary": "Start workspace",
+                "operationId": "StartWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/stop": {
+            "post": {
+                "description": "Stop workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Stop workspace",
+                "operationId": "StopWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}": {
+            "delete": {
+                "description": "Remove a single project from a workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Remove project",
+                "operationId": "RemoveProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Force",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/runtimes": {
+            "post": {
+                "description": "Record the runtimes installed in a project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Add project runtimes",
+                "operationId": "AddProjectRuntimes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Installed runtimes",
+                        "name": "runtimes",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/AddProjectRuntimes"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Project"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/secrets": {
+            "get": {
+                "description": "Get the decrypted secrets of a project. Only available to the project itself",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "secret"
                 ],
-                "summary": "Start workspace",
-                "operationId": "StartWorkspace",
+                "summary": "Get project secrets",
+                "operationId": "GetProjectSecrets",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace ID or Name",
+                        "description": "Workspace ID",
                         "name": "workspaceId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ProjectSecret"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}/stop": {
+        "/workspace/{workspaceId}/{projectId}/snapshot": {
             "post": {
-                "description": "Stop workspace",
+                "description": "Checkpoint the project container filesystem",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "workspace"
                 ],
-                "summary": "Stop workspace",
-                "operationId": "StopWorkspace",
+                "summary": "Create project snapshot",
+                "operationId": "CreateSnapshot",
                 "parameters": [
                     {
                         "type": "string",
@@ -1770,11 +2683,30 @@ const docTemplate = `{
                         "name": "workspaceId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create snapshot",
+                        "name": "snapshot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateSnapshot"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Snapshot"
+                        }
                     }
                 }
             }
@@ -1881,6 +2813,43 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/{projectId}/toolbox/e2e/public-key": {
+            "get": {
+                "description": "Get the public key of the project agent used for end-to-end encryption of toolbox requests",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Get end-to-end encryption public key",
+                "operationId": "GetE2EPublicKey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/E2EPublicKeyResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/{projectId}/toolbox/files": {
             "get": {
                 "description": "List files inside workspace project",
@@ -2761,6 +3730,52 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/{projectId}/toolbox/git/refresh": {
+            "post": {
+                "description": "Fetch from origin and optionally reset or fast-forward the git repository inside workspace project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Refresh repository",
+                "operationId": "GitRefreshRepository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Git refresh request",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitRefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GitStatus"
+                        }
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/{projectId}/toolbox/git/status": {
             "get": {
                 "description": "Get status from git repository inside workspace project",
@@ -3068,33 +4083,177 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "LspServerRequest",
+                        "description": "LspServerRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/LspServerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/lsp/workspace-symbols": {
+            "get": {
+                "description": "The workspace symbol request is sent from the client to the server to list project-wide symbols matching the query string.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Call Lsp WorkspaceSymbols",
+                "operationId": "LspWorkspaceSymbols",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language ID",
+                        "name": "languageId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path to project",
+                        "name": "pathToProject",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Symbol Query",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/LspSymbol"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/ports": {
+            "get": {
+                "description": "List the TCP ports that are listening inside the workspace project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "List ports",
+                "operationId": "PortsListPorts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ListPortsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/process/execute": {
+            "post": {
+                "description": "Execute command synchronously inside workspace project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Execute command",
+                "operationId": "ProcessExecuteCommand",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Execute command request",
                         "name": "params",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/LspServerRequest"
+                            "$ref": "#/definitions/ExecuteRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ExecuteResponse"
+                        }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}/{projectId}/toolbox/lsp/workspace-symbols": {
+        "/workspace/{workspaceId}/{projectId}/toolbox/project-dir": {
             "get": {
-                "description": "The workspace symbol request is sent from the client to the server to list project-wide symbols matching the query string.",
+                "description": "Get project directory",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workspace toolbox"
                 ],
-                "summary": "Call Lsp WorkspaceSymbols",
-                "operationId": "LspWorkspaceSymbols",
+                "summary": "Get project dir",
+                "operationId": "GetProjectDir",
                 "parameters": [
                     {
                         "type": "string",
@@ -3109,53 +4268,29 @@ const docTemplate = `{
                         "name": "projectId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Language ID",
-                        "name": "languageId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Path to project",
-                        "name": "pathToProject",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Symbol Query",
-                        "name": "query",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/LspSymbol"
-                            }
+                            "$ref": "#/definitions/ProjectDirResponse"
                         }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}/{projectId}/toolbox/process/execute": {
+        "/workspace/{workspaceId}/{projectId}/toolbox/runtimes/install": {
             "post": {
-                "description": "Execute command synchronously inside workspace project",
+                "description": "Install language runtimes and tools inside the workspace project",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workspace toolbox"
                 ],
-                "summary": "Execute command",
-                "operationId": "ProcessExecuteCommand",
+                "summary": "Install runtimes",
+                "operationId": "RuntimesInstall",
                 "parameters": [
                     {
                         "type": "string",
@@ -3172,12 +4307,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Execute command request",
+                        "description": "InstallRuntimesRequest",
                         "name": "params",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ExecuteRequest"
+                            "$ref": "#/definitions/InstallRuntimesRequest"
                         }
                     }
                 ],
@@ -3185,23 +4320,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ExecuteResponse"
+                            "$ref": "#/definitions/InstallRuntimesResponse"
                         }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}/{projectId}/toolbox/project-dir": {
-            "get": {
-                "description": "Get project directory",
-                "produces": [
-                    "application/json"
-                ],
+        "/workspace/{workspaceId}/{projectId}/upgrade-config": {
+            "post": {
+                "description": "Rebuild the project with the latest version of its project config",
                 "tags": [
-                    "workspace toolbox"
+                    "workspace"
                 ],
-                "summary": "Get project dir",
-                "operationId": "GetProjectDir",
+                "summary": "Upgrade project config",
+                "operationId": "UpgradeProjectConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -3220,16 +4352,28 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/ProjectDirResponse"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         }
     },
     "definitions": {
+        "AddProjectRuntimes": {
+            "type": "object",
+            "required": [
+                "runtimes"
+            ],
+            "properties": {
+                "runtimes": {
+                    "description": "Runtimes in the tool@version format, e.g. node@20",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "ApiKey": {
             "type": "object",
             "required": [
@@ -3238,6 +4382,14 @@ const docTemplate = `{
                 "type"
             ],
             "properties": {
+                "admin": {
+                    "description": "Admin client keys can access the workspaces of all users",
+                    "type": "boolean"
+                },
+                "expiresAt": {
+                    "description": "Keys without an expiry time are valid until they are revoked",
+                    "type": "string"
+                },
                 "keyHash": {
                     "type": "string"
                 },
@@ -3245,8 +4397,16 @@ const docTemplate = `{
                     "description": "Project or client name",
                     "type": "string"
                 },
+                "readOnly": {
+                    "description": "Read-only client keys can only be used to view workspaces and connect to them",
+                    "type": "boolean"
+                },
                 "type": {
                     "$ref": "#/definitions/apikey.ApiKeyType"
+                },
+                "workspaceId": {
+                    "description": "Workspace a client key is restricted to. Keys without a workspace can access all workspaces",
+                    "type": "string"
                 }
             }
         },
@@ -3266,6 +4426,10 @@ const docTemplate = `{
                 "buildConfig": {
                     "$ref": "#/definitions/BuildConfig"
                 },
+                "cacheNamespace": {
+                    "description": "Builds only share the BuildKit cache and cached images with builds in the same namespace",
+                    "type": "string"
+                },
                 "containerConfig": {
                     "$ref": "#/definitions/ContainerConfig"
                 },
@@ -3312,6 +4476,19 @@ const docTemplate = `{
                 }
             }
         },
+        "BuildLimitsConfig": {
+            "type": "object",
+            "properties": {
+                "cpus": {
+                    "description": "0 means no limit",
+                    "type": "number"
+                },
+                "memoryMb": {
+                    "description": "0 means no limit",
+                    "type": "integer"
+                }
+            }
+        },
         "CachedBuild": {
             "type": "object",
             "required": [
@@ -3397,6 +4574,30 @@ const docTemplate = `{
                 }
             }
         },
+        "ConfigChange": {
+            "type": "object",
+            "required": [
+                "field",
+                "newValue",
+                "oldValue",
+                "requiresRestart"
+            ],
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "newValue": {
+                    "type": "string"
+                },
+                "oldValue": {
+                    "type": "string"
+                },
+                "requiresRestart": {
+                    "description": "True if the change is saved but only takes effect after the server is restarted",
+                    "type": "boolean"
+                }
+            }
+        },
         "ContainerConfig": {
             "type": "object",
             "required": [
@@ -3456,6 +4657,18 @@ const docTemplate = `{
                 }
             }
         },
+        "CreateInviteDTO": {
+            "type": "object",
+            "required": [
+                "ttl"
+            ],
+            "properties": {
+                "ttl": {
+                    "description": "Time in minutes the invite can be redeemed and the granted access is valid",
+                    "type": "integer"
+                }
+            }
+        },
         "CreatePrebuildDTO": {
             "type": "object",
             "required": [
@@ -3499,6 +4712,12 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "forwardPorts": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "gitProviderConfigId": {
                     "type": "string"
                 },
@@ -3533,6 +4752,12 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "forwardPorts": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "gitProviderConfigId": {
                     "type": "string"
                 },
@@ -3542,6 +4767,28 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "onCreateCommands": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "postStartCommands": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "projectConfigName": {
+                    "description": "Name and version of the project config the project is created from",
+                    "type": "string"
+                },
+                "projectConfigVersion": {
+                    "type": "integer"
+                },
+                "resources": {
+                    "$ref": "#/definitions/ResourceLimits"
+                },
                 "source": {
                     "$ref": "#/definitions/CreateProjectSourceDTO"
                 },
@@ -3569,6 +4816,12 @@ const docTemplate = `{
                 "providerInfo"
             ],
             "properties": {
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
@@ -3580,6 +4833,14 @@ const docTemplate = `{
                 }
             }
         },
+        "CreateSnapshot": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "CreateWorkspaceDTO": {
             "type": "object",
             "required": [
@@ -3589,20 +4850,140 @@ const docTemplate = `{
                 "target"
             ],
             "properties": {
+                "autoStop": {
+                    "description": "Minutes without SSH activity after which the workspace is stopped. Zero disables auto-stop",
+                    "type": "integer"
+                },
+                "group": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "keepPartial": {
+                    "description": "Keep the partially created workspace when provisioning fails instead of removing it",
+                    "type": "boolean"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
+                "origin": {
+                    "$ref": "#/definitions/WorkspaceOrigin"
+                },
+                "placement": {
+                    "description": "Labels the target must have for the workspace to be created on it",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "projects": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/CreateProjectDTO"
                     }
                 },
+                "retries": {
+                    "description": "Number of times provisioning is retried after a failure",
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 0
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreationEstimate": {
+            "type": "object",
+            "required": [
+                "projects",
+                "total"
+            ],
+            "properties": {
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectCreationEstimate"
+                    }
+                },
+                "total": {
+                    "description": "Expected duration in milliseconds. Projects are created one after another so their durations add up",
+                    "type": "integer"
+                }
+            }
+        },
+        "CreationTiming": {
+            "type": "object",
+            "required": [
+                "build",
+                "clone",
+                "createdAt",
+                "hooks",
+                "id",
+                "projectName",
+                "pull",
+                "queueWait",
+                "target",
+                "total",
+                "workspaceId",
+                "workspaceName"
+            ],
+            "properties": {
+                "build": {
+                    "type": "integer"
+                },
+                "clone": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "hooks": {
+                    "description": "Time spent running the lifecycle hooks that block the project creation and start",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "description": "Image the project was created from. Empty for projects built from a devcontainer without a prebuild",
+                    "type": "string"
+                },
+                "prebuilt": {
+                    "description": "True if the project was created from a prebuild instead of being built",
+                    "type": "boolean"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "pull": {
+                    "type": "integer"
+                },
+                "queueWait": {
+                    "description": "Time between the creation request and the start of the workspace provisioning",
+                    "type": "integer"
+                },
+                "repositoryUrl": {
+                    "type": "string"
+                },
                 "target": {
                     "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "workspaceId": {
+                    "type": "string"
+                },
+                "workspaceName": {
+                    "type": "string"
                 }
             }
         },
@@ -3617,6 +4998,53 @@ const docTemplate = `{
                 }
             }
         },
+        "E2EPublicKeyResponse": {
+            "type": "object",
+            "properties": {
+                "publicKey": {
+                    "type": "string"
+                }
+            }
+        },
+        "EgressUsage": {
+            "type": "object",
+            "required": [
+                "bytes",
+                "lastCounter",
+                "month",
+                "projectName",
+                "updatedAt",
+                "workspaceId",
+                "workspaceName"
+            ],
+            "properties": {
+                "bytes": {
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "lastCounter": {
+                    "description": "Last egress counter reported by the provider. Counters start from zero when a project is restarted",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "month": {
+                    "description": "Month in the YYYY-MM format",
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                },
+                "workspaceName": {
+                    "type": "string"
+                }
+            }
+        },
         "ExecuteRequest": {
             "type": "object",
             "required": [
@@ -3666,6 +5094,75 @@ const docTemplate = `{
                 }
             }
         },
+        "FailureCategory": {
+            "type": "string",
+            "enum": [
+                "image-pull",
+                "clone-auth",
+                "oom",
+                "disk",
+                "network",
+                "other"
+            ],
+            "x-enum-varnames": [
+                "CategoryImagePull",
+                "CategoryCloneAuth",
+                "CategoryOOM",
+                "CategoryDisk",
+                "CategoryNetwork",
+                "CategoryOther"
+            ]
+        },
+        "FailureCategoryStats": {
+            "type": "object",
+            "required": [
+                "category",
+                "count",
+                "lastOccurred",
+                "providers"
+            ],
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/FailureCategory"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "lastOccurred": {
+                    "type": "string"
+                },
+                "providers": {
+                    "description": "Number of failures by provider",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "FailureStats": {
+            "type": "object",
+            "required": [
+                "categories",
+                "since",
+                "total"
+            ],
+            "properties": {
+                "categories": {
+                    "description": "Categories sorted by the number of failures",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/FailureCategoryStats"
+                    }
+                },
+                "since": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "FileInfo": {
             "type": "object",
             "required": [
@@ -3986,6 +5483,29 @@ const docTemplate = `{
                 }
             }
         },
+        "GitRefreshRequest": {
+            "type": "object",
+            "required": [
+                "path"
+            ],
+            "properties": {
+                "force": {
+                    "description": "Skip the uncommitted changes check",
+                    "type": "boolean"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "pull": {
+                    "description": "Fast-forward the current branch to its upstream",
+                    "type": "boolean"
+                },
+                "resetHard": {
+                    "description": "Reset the current branch to its upstream, discarding local changes and commits",
+                    "type": "boolean"
+                }
+            }
+        },
         "GitRepoRequest": {
             "type": "object",
             "required": [
@@ -4097,6 +5617,52 @@ const docTemplate = `{
                 }
             }
         },
+        "HostInfo": {
+            "type": "object",
+            "required": [
+                "arch",
+                "cgroupsV2",
+                "criu",
+                "docker",
+                "gpus",
+                "nestedVirtualization",
+                "os",
+                "userNamespaces"
+            ],
+            "properties": {
+                "arch": {
+                    "type": "string"
+                },
+                "cgroupsV2": {
+                    "type": "boolean"
+                },
+                "criu": {
+                    "description": "CRIU is required to checkpoint and restore running containers",
+                    "type": "boolean"
+                },
+                "docker": {
+                    "description": "The Docker or Podman socket is available. Daemons on other hosts set with DOCKER_HOST are assumed to be available",
+                    "type": "boolean"
+                },
+                "gpus": {
+                    "type": "integer"
+                },
+                "kernelVersion": {
+                    "type": "string"
+                },
+                "nestedVirtualization": {
+                    "description": "The host exposes KVM so that projects can run virtual machines",
+                    "type": "boolean"
+                },
+                "os": {
+                    "type": "string"
+                },
+                "userNamespaces": {
+                    "description": "Unprivileged user namespaces are required for rootless containers, e.g. Docker in Docker",
+                    "type": "boolean"
+                }
+            }
+        },
         "InstallProviderRequest": {
             "type": "object",
             "required": [
@@ -4115,6 +5681,79 @@ const docTemplate = `{
                 }
             }
         },
+        "InstallRuntimesRequest": {
+            "type": "object",
+            "required": [
+                "runtimes"
+            ],
+            "properties": {
+                "runtimes": {
+                    "description": "Runtimes in the tool@version format, e.g. node@20",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "InstallRuntimesResponse": {
+            "type": "object",
+            "required": [
+                "output",
+                "runtimes"
+            ],
+            "properties": {
+                "output": {
+                    "type": "string"
+                },
+                "runtimes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "InviteAccessDTO": {
+            "type": "object",
+            "required": [
+                "apiKey",
+                "expiresAt",
+                "workspaceId",
+                "workspaceName"
+            ],
+            "properties": {
+                "apiKey": {
+                    "description": "Read-only API key restricted to the workspace",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                },
+                "workspaceName": {
+                    "type": "string"
+                }
+            }
+        },
+        "InviteDTO": {
+            "type": "object",
+            "required": [
+                "code",
+                "expiresAt"
+            ],
+            "properties": {
+                "code": {
+                    "description": "One-time code, only returned when the invite is created",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                }
+            }
+        },
         "ListBranchResponse": {
             "type": "object",
             "required": [
@@ -4129,6 +5768,21 @@ const docTemplate = `{
                 }
             }
         },
+        "ListPortsResponse": {
+            "type": "object",
+            "required": [
+                "ports"
+            ],
+            "properties": {
+                "ports": {
+                    "description": "Sorted TCP ports with a listening socket in the project, without the ports of the agent",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "LogFileConfig": {
             "type": "object",
             "required": [
@@ -4424,6 +6078,13 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "forwardPorts": {
+                    "description": "Ports of the project that are meant to be forwarded to clients",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "gitProviderConfigId": {
                     "type": "string"
                 },
@@ -4433,9 +6094,40 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "onCreateCommands": {
+                    "description": "Shell commands run once after the project container is created, in order",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "postStartCommands": {
+                    "description": "Shell commands run every time the project is started, in order",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "projectConfigName": {
+                    "description": "Name and version of the project config the project was created from",
+                    "type": "string"
+                },
+                "projectConfigVersion": {
+                    "type": "integer"
+                },
                 "repository": {
                     "$ref": "#/definitions/GitRepository"
                 },
+                "resources": {
+                    "$ref": "#/definitions/ResourceLimits"
+                },
+                "runtimes": {
+                    "description": "Runtimes installed in the project with daytona install, in the tool@version format",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "state": {
                     "$ref": "#/definitions/ProjectState"
                 },
@@ -4473,6 +6165,13 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "forwardPorts": {
+                    "description": "Ports forwarded to the client machine while an SSH or IDE connection to the project is open",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "gitProviderConfigId": {
                     "type": "string"
                 },
@@ -4493,6 +6192,61 @@ const docTemplate = `{
                 },
                 "user": {
                     "type": "string"
+                },
+                "version": {
+                    "description": "Incremented every time the image, user, build configuration, repository or environment variables change",
+                    "type": "integer"
+                }
+            }
+        },
+        "ProjectCreationEstimate": {
+            "type": "object",
+            "required": [
+                "build",
+                "clone",
+                "hooks",
+                "imageCached",
+                "prebuildAvailable",
+                "projectName",
+                "pull",
+                "requiresBuild",
+                "samples",
+                "total"
+            ],
+            "properties": {
+                "build": {
+                    "type": "integer"
+                },
+                "clone": {
+                    "type": "integer"
+                },
+                "hooks": {
+                    "type": "integer"
+                },
+                "imageCached": {
+                    "description": "True if a previous creation on the target used the image, so it is likely not pulled again",
+                    "type": "boolean"
+                },
+                "prebuildAvailable": {
+                    "description": "True if a published build matches the project configuration, so the project is not built again",
+                    "type": "boolean"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "pull": {
+                    "type": "integer"
+                },
+                "requiresBuild": {
+                    "description": "True if the project is built from its devcontainer configuration",
+                    "type": "boolean"
+                },
+                "samples": {
+                    "description": "Number of previous creations the estimate is based on. Durations are 0 if there are none",
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
@@ -4513,12 +6267,26 @@ const docTemplate = `{
                 "workspaceId"
             ],
             "properties": {
+                "cpuUsage": {
+                    "description": "Average CPU usage of the project since it was last started in percent of a single CPU. Zero if the provider does not report it",
+                    "type": "number"
+                },
                 "created": {
                     "type": "string"
                 },
+                "egressBytes": {
+                    "description": "Bytes sent by the project since it was last started. Zero if the provider does not report it",
+                    "type": "integer",
+                    "format": "int64"
+                },
                 "isRunning": {
                     "type": "boolean"
                 },
+                "memoryUsage": {
+                    "description": "Memory used by the project in bytes. Zero if the provider does not report it",
+                    "type": "integer",
+                    "format": "int64"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -4530,6 +6298,27 @@ const docTemplate = `{
                 }
             }
         },
+        "ProjectSecret": {
+            "type": "object",
+            "required": [
+                "name",
+                "value"
+            ],
+            "properties": {
+                "fileMode": {
+                    "type": "string"
+                },
+                "filePath": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
         "ProjectState": {
             "type": "object",
             "required": [
@@ -4540,6 +6329,14 @@ const docTemplate = `{
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
+                "hostKey": {
+                    "description": "Public key of the SSH server of the project in the known_hosts format, e.g. \"ssh-ed25519 AAAA...\"",
+                    "type": "string"
+                },
+                "idleTime": {
+                    "description": "Seconds since the last SSH session of the project was closed. Zero while a session is open",
+                    "type": "integer"
+                },
                 "updatedAt": {
                     "type": "string"
                 },
@@ -4578,22 +6375,65 @@ const docTemplate = `{
                 "isDefault": {
                     "type": "boolean"
                 },
+                "labels": {
+                    "description": "Labels of the target host, matched against the placement constraints of new workspaces",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
                 "options": {
                     "description": "JSON encoded map of options",
                     "type": "string"
-                },
-                "providerInfo": {
-                    "$ref": "#/definitions/provider.ProviderInfo"
+                },
+                "providerInfo": {
+                    "$ref": "#/definitions/provider.ProviderInfo"
+                }
+            }
+        },
+        "ProviderTargetManifest": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#/definitions/provider.ProviderTargetProperty"
+            }
+        },
+        "RecordEvent": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "projectName": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/WorkspaceEventType"
+                }
+            }
+        },
+        "RedeemInviteDTO": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
                 }
             }
         },
-        "ProviderTargetManifest": {
+        "RenameWorkspace": {
             "type": "object",
-            "additionalProperties": {
-                "$ref": "#/definitions/provider.ProviderTargetProperty"
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
             }
         },
         "ReplaceRequest": {
@@ -4643,6 +6483,23 @@ const docTemplate = `{
                 }
             }
         },
+        "ResourceLimits": {
+            "type": "object",
+            "properties": {
+                "cpus": {
+                    "description": "Number of CPUs, fractions are allowed",
+                    "type": "number"
+                },
+                "disk": {
+                    "description": "Size of the container filesystem in GiB",
+                    "type": "integer"
+                },
+                "memory": {
+                    "description": "Memory in MiB",
+                    "type": "integer"
+                }
+            }
+        },
         "Sample": {
             "type": "object",
             "required": [
@@ -4676,6 +6533,37 @@ const docTemplate = `{
                 }
             }
         },
+        "Secret": {
+            "type": "object",
+            "required": [
+                "name",
+                "updatedAt"
+            ],
+            "properties": {
+                "fileMode": {
+                    "description": "Octal permissions of the secret file, e.g. 0400",
+                    "type": "string"
+                },
+                "filePath": {
+                    "description": "Path the secret is written to inside the project container. Secrets without a path are injected as environment variables",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "description": "Project of the workspace the secret is scoped to. Secrets without a project are available to all projects of the workspace",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "description": "Workspace the secret is scoped to. Secrets without a workspace are available to all workspaces of the profile",
+                    "type": "string"
+                }
+            }
+        },
         "ServerConfig": {
             "type": "object",
             "required": [
@@ -4704,6 +6592,9 @@ const docTemplate = `{
                 "buildImageNamespace": {
                     "type": "string"
                 },
+                "buildLimits": {
+                    "$ref": "#/definitions/BuildLimitsConfig"
+                },
                 "builderImage": {
                     "type": "string"
                 },
@@ -4716,12 +6607,32 @@ const docTemplate = `{
                 "defaultProjectUser": {
                     "type": "string"
                 },
+                "dockerContext": {
+                    "description": "Docker CLI context to use instead of the docker host",
+                    "type": "string"
+                },
+                "dockerHost": {
+                    "description": "Docker daemon address used for builds and passed to providers, e.g. tcp://10.0.0.5:2376",
+                    "type": "string"
+                },
+                "egressQuotaMb": {
+                    "description": "Outbound traffic in MB a workspace may send per month before it is stopped. 0 disables the quota",
+                    "type": "integer"
+                },
                 "frps": {
                     "$ref": "#/definitions/FRPSConfig"
                 },
                 "headscalePort": {
                     "type": "integer"
                 },
+                "hibernateAfterMinutes": {
+                    "description": "Minutes a workspace has to be stopped before its project data is archived to disk. 0 disables hibernation",
+                    "type": "integer"
+                },
+                "hibernationRetentionDays": {
+                    "description": "Days a hibernated workspace is kept before it is removed. 0 keeps hibernated workspaces",
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -4790,11 +6701,62 @@ const docTemplate = `{
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
+                "hostKey": {
+                    "type": "string"
+                },
+                "idleTime": {
+                    "type": "integer"
+                },
                 "uptime": {
                     "type": "integer"
                 }
             }
         },
+        "SetSecretDTO": {
+            "type": "object",
+            "required": [
+                "name",
+                "value"
+            ],
+            "properties": {
+                "fileMode": {
+                    "description": "Octal permissions of the secret file, defaults to 0600",
+                    "type": "string"
+                },
+                "filePath": {
+                    "description": "Absolute path the secret is written to inside the project, secrets without a path are injected as environment variables",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "description": "Project of the workspace, secrets without a project are available to all projects of the workspace",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "description": "Workspace ID or name, secrets without a workspace are available to all workspaces",
+                    "type": "string"
+                }
+            }
+        },
+        "ShareWorkspaceDTO": {
+            "type": "object",
+            "required": [
+                "sharedWith"
+            ],
+            "properties": {
+                "sharedWith": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "SigningMethod": {
             "type": "string",
             "enum": [
@@ -4806,6 +6768,50 @@ const docTemplate = `{
                 "SigningMethodGPG"
             ]
         },
+        "Snapshot": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "image",
+                "name",
+                "projectName",
+                "workspaceId"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "description": "Image the project container filesystem was committed to",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "SshAccess": {
+            "type": "object",
+            "required": [
+                "publicKey"
+            ],
+            "properties": {
+                "publicKey": {
+                    "description": "Public key in the authorized_keys format",
+                    "type": "string"
+                }
+            }
+        },
         "Status": {
             "type": "string",
             "enum": [
@@ -4829,6 +6835,73 @@ const docTemplate = `{
                 "UpdatedButUnmerged"
             ]
         },
+        "UpdateWorkspaceSettingsDTO": {
+            "type": "object",
+            "required": [
+                "autoStop",
+                "group",
+                "labels"
+            ],
+            "properties": {
+                "autoStop": {
+                    "description": "Minutes without SSH activity after which the workspace is stopped. Zero disables auto-stop",
+                    "type": "integer"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "UpgradeCheck": {
+            "type": "object",
+            "required": [
+                "arch",
+                "compatible",
+                "currentVersion",
+                "installScriptUrl",
+                "os",
+                "targetVersion",
+                "warnings"
+            ],
+            "properties": {
+                "arch": {
+                    "type": "string"
+                },
+                "compatible": {
+                    "description": "False if the server can't be upgraded to the target version",
+                    "type": "boolean"
+                },
+                "currentVersion": {
+                    "type": "string"
+                },
+                "installScriptUrl": {
+                    "description": "URL of the script that installs Daytona on the server host",
+                    "type": "string"
+                },
+                "os": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "targetVersion": {
+                    "type": "string"
+                },
+                "warnings": {
+                    "description": "Problems that don't block the upgrade",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "Workspace": {
             "type": "object",
             "required": [
@@ -4838,18 +6911,49 @@ const docTemplate = `{
                 "target"
             ],
             "properties": {
+                "autoStop": {
+                    "description": "Minutes without SSH activity after which the workspace is stopped. Zero disables auto-stop",
+                    "type": "integer"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "hibernatedAt": {
+                    "description": "Time the project data of the stopped workspace was archived to disk. Nil if the workspace is not hibernated",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
+                "origin": {
+                    "$ref": "#/definitions/WorkspaceOrigin"
+                },
+                "owner": {
+                    "description": "Name of the API key of the user that created the workspace. Workspaces without an owner were created\nbefore ownership was tracked and are accessible by all users",
+                    "type": "string"
+                },
                 "projects": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/Project"
                     }
                 },
+                "sharedWith": {
+                    "description": "Users that can view the workspace and connect to it",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "target": {
                     "type": "string"
                 }
@@ -4864,26 +6968,111 @@ const docTemplate = `{
                 "target"
             ],
             "properties": {
+                "autoStop": {
+                    "description": "Minutes without SSH activity after which the workspace is stopped. Zero disables auto-stop",
+                    "type": "integer"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "hibernatedAt": {
+                    "description": "Time the project data of the stopped workspace was archived to disk. Nil if the workspace is not hibernated",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "info": {
                     "$ref": "#/definitions/WorkspaceInfo"
                 },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
+                "origin": {
+                    "$ref": "#/definitions/WorkspaceOrigin"
+                },
+                "owner": {
+                    "description": "Name of the API key of the user that created the workspace. Workspaces without an owner were created\nbefore ownership was tracked and are accessible by all users",
+                    "type": "string"
+                },
                 "projects": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/Project"
                     }
                 },
+                "sharedWith": {
+                    "description": "Users that can view the workspace and connect to it",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "target": {
                     "type": "string"
                 }
             }
         },
+        "WorkspaceEvent": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "type",
+                "workspaceId",
+                "workspaceName"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "description": "Empty if the event concerns the whole workspace",
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/WorkspaceEventType"
+                },
+                "workspaceId": {
+                    "type": "string"
+                },
+                "workspaceName": {
+                    "type": "string"
+                }
+            }
+        },
+        "WorkspaceEventType": {
+            "type": "string",
+            "enum": [
+                "created",
+                "started",
+                "stopped",
+                "ssh-connected",
+                "removed",
+                "egress-quota-exceeded",
+                "hibernated",
+                "woken"
+            ],
+            "x-enum-varnames": [
+                "EventTypeCreated",
+                "EventTypeStarted",
+                "EventTypeStopped",
+                "EventTypeSshConnected",
+                "EventTypeRemoved",
+                "EventTypeEgressQuotaExceeded",
+                "EventTypeHibernated",
+                "EventTypeWoken"
+            ]
+        },
         "WorkspaceInfo": {
             "type": "object",
             "required": [
@@ -4905,6 +7094,27 @@ const docTemplate = `{
                 }
             }
         },
+        "WorkspaceOrigin": {
+            "type": "object",
+            "required": [
+                "definitionFile",
+                "definitionSha256"
+            ],
+            "properties": {
+                "definitionCommit": {
+                    "description": "Commit of the git repository that contains the definition file, if any",
+                    "type": "string"
+                },
+                "definitionFile": {
+                    "description": "Absolute path of the definition file on the client that created the workspace",
+                    "type": "string"
+                },
+                "definitionSha256": {
+                    "description": "SHA-256 of the definition file contents",
+                    "type": "string"
+                }
+            }
+        },
         "apikey.ApiKeyType": {
             "type": "string",
             "enum": [
@@ -4928,7 +7138,9 @@ const docTemplate = `{
                 "published",
                 "pending-delete",
                 "pending-forced-delete",
-                "deleting"
+                "deleting",
+                "pending-cancel",
+                "canceled"
             ],
             "x-enum-varnames": [
                 "BuildStatePendingRun",
@@ -4938,7 +7150,9 @@ const docTemplate = `{
                 "BuildStatePublished",
                 "BuildStatePendingDelete",
                 "BuildStatePendingForcedDelete",
-                "BuildStateDeleting"
+                "BuildStateDeleting",
+                "BuildStatePendingCancel",
+                "BuildStateCanceled"
             ]
         },
         "provider.ProviderInfo": {
@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAuthMiddleware only lets requests with admin client keys through. It relies on AuthMiddleware running first
+func AdminAuthMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !ctx.GetBool("apiKeyAdmin") {
+			ctx.AbortWithError(403, errors.New("the request requires an admin API key"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -5,10 +5,14 @@ package middlewares
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/gin-gonic/gin"
 )
 
@@ -41,11 +45,158 @@ func AuthMiddleware() gin.HandlerFunc {
 			apiKeyType = apikey.ApiKeyTypeProject
 		}
 
+		if server.ApiKeyService.IsReadOnlyApiKey(token) && !isReadOnlyRequest(ctx) {
+			ctx.AbortWithError(403, errors.New("the API key is read-only"))
+			return
+		}
+
+		workspaceId := server.ApiKeyService.GetApiKeyWorkspaceId(token)
+		if workspaceId == "" && apiKeyType != apikey.ApiKeyTypeClient {
+			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
+		}
+
+		if workspaceId != "" {
+			// Keys of workspaces and projects, which are readable inside their containers, and keys restricted to
+			// a workspace, e.g. from invites, can only access their workspace
+			allowedRoutes := workspaceKeyRoutes
+			if apiKeyType != apikey.ApiKeyTypeClient {
+				allowedRoutes = agentKeyRoutes
+			}
+
+			if !isWorkspaceRequest(ctx, workspaceId, allowedRoutes) {
+				ctx.AbortWithError(403, errors.New("the API key can only access its workspace"))
+				return
+			}
+			ctx.Set("apiKeyWorkspaceId", workspaceId)
+		} else {
+			// Client keys identify the users that own workspaces
+			user, err := server.ApiKeyService.GetApiKeyName(token)
+			if err != nil {
+				ctx.AbortWithError(401, errors.New("unauthorized"))
+				return
+			}
+
+			ctx.Set("apiKeyName", user)
+			ctx.Set("apiKeyAdmin", server.ApiKeyService.IsAdminApiKey(token))
+
+			status, err := checkWorkspaceParamAccess(ctx)
+			if err != nil {
+				ctx.AbortWithError(status, err)
+				return
+			}
+		}
+
 		ctx.Set("apiKeyType", apiKeyType)
 		ctx.Next()
 	}
 }
 
+// Routes without a workspace that are needed to connect to a workspace with a key restricted to it.
+// Workspaces are filtered by the list route
+var workspaceKeyRoutes = []string{
+	"/workspace/",
+}
+
+// Routes without a workspace that the agents in workspaces and projects need in addition to the routes for connecting.
+// The network key lets its holder reach every project, so clients with keys restricted to a workspace connect
+// through the tunnel of the server instead
+var agentKeyRoutes = append([]string{
+	"/server/network-key",
+	"/binary/script",
+	"/binary/:version/:binaryName",
+	"/gitprovider/:gitProviderId",
+	"/gitprovider/:gitProviderId/user",
+	"/gitprovider/for-url/:url",
+}, workspaceKeyRoutes...)
+
+func isWorkspaceRequest(ctx *gin.Context, workspaceId string, allowedRoutes []string) bool {
+	workspaceIdParam := ctx.Param("workspaceId")
+	if workspaceIdParam == "" {
+		return slices.Contains(allowedRoutes, ctx.FullPath())
+	}
+
+	if workspaceIdParam == workspaceId {
+		return true
+	}
+
+	// The workspace can also be referenced by name
+	w, err := server.GetInstance(nil).WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceIdParam, false)
+	return err == nil && w.Id == workspaceId
+}
+
+// checkWorkspaceParamAccess checks the access to the workspace in the path of the request, if any
+func checkWorkspaceParamAccess(ctx *gin.Context) (int, error) {
+	workspaceId := ctx.Param("workspaceId")
+	if workspaceId == "" {
+		return 0, nil
+	}
+
+	w, err := server.GetInstance(nil).WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err != nil {
+		// Missing workspaces are reported by the handlers
+		return 0, nil
+	}
+
+	return CheckWorkspaceAccess(ctx, &w.Workspace)
+}
+
+// CheckWorkspaceAccess returns an error and its status if the API key of the request can't access the workspace.
+// Users can access the workspaces they own and, read-only, the workspaces that are shared with them. Admins can
+// access all workspaces. Handlers that get the workspace from the request body or query must call it themselves
+func CheckWorkspaceAccess(ctx *gin.Context, w *workspace.Workspace) (int, error) {
+	if workspaceId := ctx.GetString("apiKeyWorkspaceId"); workspaceId != "" {
+		if w.Id != workspaceId {
+			return http.StatusForbidden, errors.New("the API key can only access its workspace")
+		}
+		return 0, nil
+	}
+
+	if ctx.GetBool("apiKeyAdmin") {
+		return 0, nil
+	}
+
+	user := ctx.GetString("apiKeyName")
+	if user == "" {
+		return http.StatusUnauthorized, errors.New("unauthorized")
+	}
+
+	if w.IsOwnedBy(user) {
+		return 0, nil
+	}
+
+	if !w.IsAccessibleBy(user) {
+		return http.StatusNotFound, errors.New("workspace not found")
+	}
+
+	if !isReadOnlyRequest(ctx) {
+		return http.StatusForbidden, fmt.Errorf("workspace %s is shared with you read-only", w.Name)
+	}
+
+	return 0, nil
+}
+
+// Routes that modify state on the server but are needed to connect to workspaces with a read-only key,
+// and routes that don't modify state despite their method
+var readOnlyRoutes = []string{
+	"/workspace/:workspaceId/events",
+	"/workspace/:workspaceId/ssh-access",
+	"/bench/upload",
+}
+
+// GET routes that modify state on the server. Websockets can only be opened with GET requests
+var writeGetRoutes = []string{
+	"/workspace/:workspaceId/remove/stream",
+}
+
+func isReadOnlyRequest(ctx *gin.Context) bool {
+	if ctx.Request.Method == http.MethodGet || ctx.Request.Method == http.MethodHead {
+		return !slices.Contains(writeGetRoutes, ctx.FullPath())
+	}
+
+	return slices.Contains(readOnlyRoutes, ctx.FullPath())
+}
+
 func ExtractToken(bearerToken string) string {
 	if !strings.HasPrefix(bearerToken, "Bearer ") {
 		return ""
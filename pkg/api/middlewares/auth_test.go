@@ -0,0 +1,412 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	t_build "github.com/daytonaio/daytona/internal/testing/build"
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_secrets "github.com/daytonaio/daytona/internal/testing/server/secrets"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	build_controller "github.com/daytonaio/daytona/pkg/api/controllers/build"
+	"github.com/daytonaio/daytona/pkg/api/controllers/secret"
+	secret_dto "github.com/daytonaio/daytona/pkg/api/controllers/secret/dto"
+	server_controller "github.com/daytonaio/daytona/pkg/api/controllers/server"
+	workspace_controller "github.com/daytonaio/daytona/pkg/api/controllers/workspace"
+	workspace_dto "github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/ssh"
+)
+
+var ownedWorkspace = &workspace.Workspace{
+	Id:         "ws1",
+	Name:       "owned",
+	Owner:      "owner",
+	SharedWith: []string{"teammate"},
+	Projects:   []*project.Project{{Name: "p1", WorkspaceId: "ws1"}},
+}
+
+var otherWorkspace = &workspace.Workspace{
+	Id:       "ws2",
+	Name:     "other",
+	Owner:    "other",
+	Projects: []*project.Project{{Name: "p1", WorkspaceId: "ws2"}},
+}
+
+// The network key is the only part of the tailscale server the tests need
+type testTailscaleServer struct {
+	server.TailscaleServer
+}
+
+func (s *testTailscaleServer) CreateAuthKey() (string, error) {
+	return "network-key", nil
+}
+
+type AuthMiddlewareTestSuite struct {
+	suite.Suite
+	router *gin.Engine
+	keys   map[string]string
+}
+
+func (s *AuthMiddlewareTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+
+	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	for _, w := range []*workspace.Workspace{ownedWorkspace, otherWorkspace} {
+		s.Require().Nil(workspaceStore.Save(w))
+	}
+
+	buildStore := t_build.NewInMemoryBuildStore()
+	for _, b := range []*build.Build{
+		{Id: "owner-build", State: build.BuildStateRunning, CacheNamespace: "owner"},
+		{Id: "stranger-build", State: build.BuildStateRunning, CacheNamespace: "stranger"},
+		{Id: "prebuild", State: build.BuildStateRunning},
+	} {
+		s.Require().Nil(buildStore.Save(b))
+	}
+
+	server.GetInstance(&server.ServerInstanceConfig{
+		ApiKeyService:   apiKeyService,
+		BuildService:    builds.NewBuildService(builds.BuildServiceConfig{BuildStore: buildStore}),
+		TailscaleServer: &testTailscaleServer{},
+		WorkspaceService: workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+			WorkspaceStore: workspaceStore,
+		}),
+		SecretService: secrets.NewSecretService(secrets.SecretServiceConfig{
+			SecretStore:   t_secrets.NewInMemorySecretStore(),
+			EncryptionKey: bytes.Repeat([]byte{1}, 32),
+		}),
+	})
+
+	s.keys = map[string]string{}
+	generate := func(name string, generateKey func() (string, error)) {
+		key, err := generateKey()
+		s.Require().Nil(err)
+		s.keys[name] = key
+	}
+
+	generate("owner", func() (string, error) { return apiKeyService.Generate(apikey.ApiKeyTypeClient, "owner") })
+	generate("teammate", func() (string, error) { return apiKeyService.Generate(apikey.ApiKeyTypeClient, "teammate") })
+	generate("readonly", func() (string, error) { return apiKeyService.GenerateReadOnly("owner") })
+	generate("stranger", func() (string, error) { return apiKeyService.Generate(apikey.ApiKeyTypeClient, "stranger") })
+	generate("admin", func() (string, error) { return apiKeyService.GenerateAdmin("admin") })
+	generate("default", func() (string, error) {
+		return apiKeyService.Generate(apikey.ApiKeyTypeClient, apikeys.DefaultProfileKeyName)
+	})
+	generate("workspace", func() (string, error) { return apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, "ws1") })
+	generate("project", func() (string, error) { return apiKeyService.Generate(apikey.ApiKeyTypeProject, "ws1/p1") })
+	generate("invite", func() (string, error) {
+		return apiKeyService.GenerateWorkspaceAccess("invite", "ws1", time.Now().Add(time.Hour))
+	})
+
+	ok := func(ctx *gin.Context) { ctx.Status(http.StatusOK) }
+
+	s.router = gin.New()
+	protected := s.router.Group("/")
+	protected.Use(middlewares.AuthMiddleware())
+	{
+		protected.GET("/workspace/", ok)
+		protected.GET("/workspace/:workspaceId", ok)
+		protected.POST("/workspace/:workspaceId/stop", ok)
+		protected.GET("/workspace/:workspaceId/remove/stream", ok)
+		protected.POST("/workspace/:workspaceId/ssh-access", workspace_controller.GrantSshAccess)
+		protected.POST("/workspace/:workspaceId/ssh-access/verify", workspace_controller.VerifySshAccess)
+		protected.GET("/workspace/:workspaceId/:projectId/tunnel/:port", ok)
+		protected.GET("/binary/script", ok)
+		protected.POST("/server/network-key", server_controller.GenerateNetworkKey)
+		protected.PUT("/secret/", secret.SetSecret)
+		protected.DELETE("/build/:buildId", build_controller.DeleteBuild)
+		protected.POST("/build/:buildId/cancel", build_controller.CancelBuild)
+	}
+
+	apiKeyController := protected.Group("/apikey")
+	apiKeyController.Use(middlewares.AdminAuthMiddleware())
+	{
+		apiKeyController.POST("/:apiKeyName", ok)
+	}
+
+	adminOnly := middlewares.AdminAuthMiddleware()
+	for _, route := range adminRoutes {
+		protected.Handle(route.method, route.path, adminOnly, ok)
+	}
+	protected.GET("/target/", ok)
+	protected.GET("/gitprovider/:gitProviderId", ok)
+}
+
+// Routes that change settings of the server that apply to all users
+var adminRoutes = []struct {
+	method string
+	path   string
+}{
+	{http.MethodPost, "/server/config"},
+	{http.MethodPost, "/server/config/reload"},
+	{http.MethodPost, "/provider/install"},
+	{http.MethodPost, "/provider/:provider/uninstall"},
+	{http.MethodPut, "/container-registry/:server"},
+	{http.MethodDelete, "/container-registry/:server"},
+	{http.MethodDelete, "/build/"},
+	{http.MethodPut, "/target/"},
+	{http.MethodPatch, "/target/:target/set-default"},
+	{http.MethodDelete, "/target/:target"},
+	{http.MethodPut, "/gitprovider/"},
+	{http.MethodDelete, "/gitprovider/:gitProviderId"},
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	suite.Run(t, &AuthMiddlewareTestSuite{})
+}
+
+func (s *AuthMiddlewareTestSuite) TestWorkspaceAccess() {
+	tests := []struct {
+		name           string
+		key            string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{"owner views", "owner", http.MethodGet, "/workspace/ws1", http.StatusOK},
+		{"owner views by name", "owner", http.MethodGet, "/workspace/owned", http.StatusOK},
+		{"owner stops", "owner", http.MethodPost, "/workspace/ws1/stop", http.StatusOK},
+		{"owner views other", "owner", http.MethodGet, "/workspace/ws2", http.StatusNotFound},
+		{"shared views", "teammate", http.MethodGet, "/workspace/ws1", http.StatusOK},
+		{"shared stops", "teammate", http.MethodPost, "/workspace/ws1/stop", http.StatusForbidden},
+		{"stranger views", "stranger", http.MethodGet, "/workspace/ws1", http.StatusNotFound},
+		{"admin views", "admin", http.MethodGet, "/workspace/ws1", http.StatusOK},
+		{"admin stops", "admin", http.MethodPost, "/workspace/ws2/stop", http.StatusOK},
+		{"default name is no admin", "default", http.MethodGet, "/workspace/ws1", http.StatusNotFound},
+		{"project key views its workspace", "project", http.MethodGet, "/workspace/ws1", http.StatusOK},
+		{"project key stops its workspace", "project", http.MethodPost, "/workspace/owned/stop", http.StatusOK},
+		{"project key views other", "project", http.MethodGet, "/workspace/ws2", http.StatusForbidden},
+		{"project key stops other", "project", http.MethodPost, "/workspace/ws2/stop", http.StatusForbidden},
+		{"project key lists", "project", http.MethodGet, "/workspace/", http.StatusOK},
+		{"project key downloads binary", "project", http.MethodGet, "/binary/script", http.StatusOK},
+		{"workspace key views its workspace", "workspace", http.MethodGet, "/workspace/ws1", http.StatusOK},
+		{"workspace key views other", "workspace", http.MethodGet, "/workspace/ws2", http.StatusForbidden},
+		{"workspace key downloads binary", "workspace", http.MethodGet, "/binary/script", http.StatusOK},
+		{"invite key views its workspace", "invite", http.MethodGet, "/workspace/ws1", http.StatusOK},
+		{"invite key stops its workspace", "invite", http.MethodPost, "/workspace/ws1/stop", http.StatusForbidden},
+		{"invite key downloads binary", "invite", http.MethodGet, "/binary/script", http.StatusForbidden},
+		{"owner removes", "owner", http.MethodGet, "/workspace/ws1/remove/stream", http.StatusOK},
+		{"read-only key views", "readonly", http.MethodGet, "/workspace/ws1", http.StatusOK},
+		{"read-only key stops", "readonly", http.MethodPost, "/workspace/ws1/stop", http.StatusForbidden},
+		{"read-only key removes", "readonly", http.MethodGet, "/workspace/ws1/remove/stream", http.StatusForbidden},
+		{"shared removes", "teammate", http.MethodGet, "/workspace/ws1/remove/stream", http.StatusForbidden},
+		{"invite key removes its workspace", "invite", http.MethodGet, "/workspace/ws1/remove/stream", http.StatusForbidden},
+		{"owner tunnels", "owner", http.MethodGet, "/workspace/ws1/p1/tunnel/2222", http.StatusOK},
+		{"stranger tunnels", "stranger", http.MethodGet, "/workspace/ws1/p1/tunnel/2222", http.StatusNotFound},
+		{"read-only key tunnels", "readonly", http.MethodGet, "/workspace/ws1/p1/tunnel/2222", http.StatusOK},
+		{"invite key tunnels to its workspace", "invite", http.MethodGet, "/workspace/ws1/p1/tunnel/2222", http.StatusOK},
+		{"invite key tunnels to other", "invite", http.MethodGet, "/workspace/ws2/p1/tunnel/2222", http.StatusForbidden},
+		{"project key gets network key", "project", http.MethodPost, "/server/network-key", http.StatusOK},
+		{"workspace key gets network key", "workspace", http.MethodPost, "/server/network-key", http.StatusOK},
+		{"admin gets network key", "admin", http.MethodPost, "/server/network-key", http.StatusOK},
+		{"owner gets network key", "owner", http.MethodPost, "/server/network-key", http.StatusForbidden},
+		{"shared gets network key", "teammate", http.MethodPost, "/server/network-key", http.StatusForbidden},
+		{"invite key gets network key", "invite", http.MethodPost, "/server/network-key", http.StatusForbidden},
+		{"read-only key gets network key", "readonly", http.MethodPost, "/server/network-key", http.StatusForbidden},
+		{"unknown key", "unknown", http.MethodGet, "/workspace/ws1", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Require().Equal(tt.expectedStatus, s.request(tt.key, tt.method, tt.path, nil))
+		})
+	}
+}
+
+func (s *AuthMiddlewareTestSuite) TestApiKeyRoutes() {
+	tests := []struct {
+		key            string
+		expectedStatus int
+	}{
+		{"owner", http.StatusForbidden},
+		{"default", http.StatusForbidden},
+		{"project", http.StatusForbidden},
+		{"workspace", http.StatusForbidden},
+		{"admin", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.key, func() {
+			s.Require().Equal(tt.expectedStatus, s.request(tt.key, http.MethodPost, "/apikey/default", nil))
+		})
+	}
+}
+
+func (s *AuthMiddlewareTestSuite) TestAdminRoutes() {
+	for _, route := range adminRoutes {
+		path := strings.NewReplacer(":provider", "docker-provider", ":server", "registry", ":target", "local", ":gitProviderId", "github").Replace(route.path)
+
+		s.Run(route.method+" "+route.path, func() {
+			s.Require().Equal(http.StatusForbidden, s.request("owner", route.method, path, nil))
+			s.Require().Equal(http.StatusForbidden, s.request("default", route.method, path, nil))
+			s.Require().Equal(http.StatusForbidden, s.request("project", route.method, path, nil))
+			s.Require().Equal(http.StatusOK, s.request("admin", route.method, path, nil))
+		})
+	}
+
+	s.Run("users read settings", func() {
+		s.Require().Equal(http.StatusOK, s.request("owner", http.MethodGet, "/target/", nil))
+		s.Require().Equal(http.StatusOK, s.request("project", http.MethodGet, "/gitprovider/github", nil))
+	})
+}
+
+func (s *AuthMiddlewareTestSuite) TestBuildAccess() {
+	tests := []struct {
+		name           string
+		key            string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{"owner cancels own build", "owner", http.MethodPost, "/build/owner-build/cancel", http.StatusNoContent},
+		{"owner cancels build of other", "owner", http.MethodPost, "/build/stranger-build/cancel", http.StatusForbidden},
+		{"owner cancels prebuild", "owner", http.MethodPost, "/build/prebuild/cancel", http.StatusForbidden},
+		{"owner deletes build of other", "owner", http.MethodDelete, "/build/stranger-build", http.StatusForbidden},
+		{"admin cancels prebuild", "admin", http.MethodPost, "/build/prebuild/cancel", http.StatusNoContent},
+		{"admin deletes build of other", "admin", http.MethodDelete, "/build/stranger-build", http.StatusNoContent},
+		{"owner deletes own build", "owner", http.MethodDelete, "/build/owner-build", http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Require().Equal(tt.expectedStatus, s.request(tt.key, tt.method, tt.path, nil))
+		})
+	}
+}
+
+func (s *AuthMiddlewareTestSuite) TestSecretWorkspaceAccess() {
+	tests := []struct {
+		name           string
+		key            string
+		workspaceId    string
+		expectedStatus int
+	}{
+		{"owner", "owner", "ws1", http.StatusCreated},
+		{"owner by name", "owner", "owned", http.StatusCreated},
+		{"shared", "teammate", "ws1", http.StatusForbidden},
+		{"stranger", "stranger", "ws1", http.StatusNotFound},
+		{"admin", "admin", "ws2", http.StatusCreated},
+		{"project key", "project", "ws1", http.StatusForbidden},
+		{"workspace key", "workspace", "ws1", http.StatusForbidden},
+		{"owner without workspace", "owner", "", http.StatusForbidden},
+		{"admin without workspace", "admin", "", http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			body, err := json.Marshal(secret_dto.SetSecretDTO{
+				Name:        "TOKEN",
+				Value:       "value",
+				WorkspaceId: tt.workspaceId,
+			})
+			s.Require().Nil(err)
+
+			s.Require().Equal(tt.expectedStatus, s.request(tt.key, http.MethodPut, "/secret/", body))
+		})
+	}
+}
+
+func (s *AuthMiddlewareTestSuite) TestSshAccess() {
+	newPublicKey := func() []byte {
+		publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+		s.Require().Nil(err)
+
+		sshPublicKey, err := ssh.NewPublicKey(publicKey)
+		s.Require().Nil(err)
+
+		body, err := json.Marshal(workspace_dto.SshAccess{PublicKey: string(ssh.MarshalAuthorizedKey(sshPublicKey))})
+		s.Require().Nil(err)
+
+		return body
+	}
+
+	grants := []struct {
+		name           string
+		key            string
+		workspaceId    string
+		expectedStatus int
+	}{
+		{"owner", "owner", "ws1", http.StatusOK},
+		{"owner by name", "owner", "owned", http.StatusOK},
+		{"shared", "teammate", "ws1", http.StatusOK},
+		{"read-only key", "readonly", "ws1", http.StatusOK},
+		{"invite key", "invite", "ws1", http.StatusOK},
+		{"admin", "admin", "ws2", http.StatusOK},
+		{"stranger", "stranger", "ws1", http.StatusNotFound},
+		{"owner of other", "owner", "ws2", http.StatusNotFound},
+		{"invite key to other", "invite", "ws2", http.StatusForbidden},
+	}
+
+	for _, tt := range grants {
+		s.Run("grant by "+tt.name, func() {
+			body := newPublicKey()
+			s.Require().Equal(tt.expectedStatus, s.request(tt.key, http.MethodPost, "/workspace/"+tt.workspaceId+"/ssh-access", body))
+
+			expectedStatus := http.StatusForbidden
+			if tt.expectedStatus == http.StatusOK && tt.workspaceId != "ws2" {
+				expectedStatus = http.StatusOK
+			}
+
+			// Only the agents of the workspace the key was granted access to accept it
+			s.Require().Equal(expectedStatus, s.request("project", http.MethodPost, "/workspace/ws1/ssh-access/verify", body))
+			s.Require().Equal(expectedStatus, s.request("workspace", http.MethodPost, "/workspace/ws1/ssh-access/verify", body))
+		})
+	}
+
+	s.Run("verify by client keys", func() {
+		body := newPublicKey()
+		s.Require().Equal(http.StatusOK, s.request("owner", http.MethodPost, "/workspace/ws1/ssh-access", body))
+
+		for _, key := range []string{"owner", "admin", "invite"} {
+			s.Require().Equal(http.StatusForbidden, s.request(key, http.MethodPost, "/workspace/ws1/ssh-access/verify", body))
+		}
+	})
+
+	s.Run("invalid public key", func() {
+		body, err := json.Marshal(workspace_dto.SshAccess{PublicKey: "ssh-ed25519 invalid"})
+		s.Require().Nil(err)
+
+		s.Require().Equal(http.StatusBadRequest, s.request("owner", http.MethodPost, "/workspace/ws1/ssh-access", body))
+	})
+}
+
+func (s *AuthMiddlewareTestSuite) request(keyName, method, path string, body []byte) int {
+	key, ok := s.keys[keyName]
+	if !ok {
+		key = keyName
+	}
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+key)
+
+	recorder := httptest.NewRecorder()
+	s.router.ServeHTTP(recorder, req)
+
+	return recorder.Code
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/daytonaio/daytona/internal/constants"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// Seconds after which clients should retry requests rejected during a shutdown
+const restartRetryAfter = "5"
+
+// ShutdownMiddleware rejects new requests with the restarting status once the server is shutting down
+// and tracks the requests in progress so that the shutdown can wait for them to finish
+func ShutdownMiddleware(inProgress *sync.WaitGroup) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if server.GetInstance(nil).IsShuttingDown() {
+			ctx.Header(constants.SERVER_STATUS_HEADER, constants.SERVER_STATUS_RESTARTING)
+			ctx.Header("Retry-After", restartRetryAfter)
+			ctx.AbortWithError(http.StatusServiceUnavailable, errors.New("the Daytona Server is restarting"))
+			return
+		}
+
+		inProgress.Add(1)
+		defer inProgress.Done()
+
+		ctx.Next()
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/api/docs"
@@ -33,17 +34,20 @@ import (
 	"github.com/gin-contrib/cors"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/apikey"
+	"github.com/daytonaio/daytona/pkg/api/controllers/bench"
 	"github.com/daytonaio/daytona/pkg/api/controllers/binary"
 	"github.com/daytonaio/daytona/pkg/api/controllers/build"
 	"github.com/daytonaio/daytona/pkg/api/controllers/containerregistry"
 	"github.com/daytonaio/daytona/pkg/api/controllers/gitprovider"
 	"github.com/daytonaio/daytona/pkg/api/controllers/health"
+	"github.com/daytonaio/daytona/pkg/api/controllers/invite"
 	log_controller "github.com/daytonaio/daytona/pkg/api/controllers/log"
 	"github.com/daytonaio/daytona/pkg/api/controllers/profiledata"
 	"github.com/daytonaio/daytona/pkg/api/controllers/projectconfig"
 	"github.com/daytonaio/daytona/pkg/api/controllers/projectconfig/prebuild"
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider"
 	"github.com/daytonaio/daytona/pkg/api/controllers/sample"
+	"github.com/daytonaio/daytona/pkg/api/controllers/secret"
 	"github.com/daytonaio/daytona/pkg/api/controllers/server"
 	"github.com/daytonaio/daytona/pkg/api/controllers/target"
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace"
@@ -65,6 +69,8 @@ type ApiServerConfig struct {
 	TelemetryService telemetry.TelemetryService
 	Frps             *daytonaServer.FRPSConfig
 	ServerId         string
+	// If set, the API is also served on this unix socket for local clients
+	SocketPath string
 }
 
 func NewApiServer(config ApiServerConfig) *ApiServer {
@@ -74,6 +80,7 @@ func NewApiServer(config ApiServerConfig) *ApiServer {
 		version:          config.Version,
 		frps:             config.Frps,
 		serverId:         config.ServerId,
+		socketPath:       config.SocketPath,
 	}
 }
 
@@ -85,8 +92,14 @@ type ApiServer struct {
 	version          string
 	frps             *daytonaServer.FRPSConfig
 	serverId         string
+	socketPath       string
+	// Requests in progress, waited for when the server is stopped
+	requests sync.WaitGroup
 }
 
+// Time the server waits for requests in progress, e.g. workspace creations, to finish when it is stopped
+const shutdownTimeout = 60 * time.Second
+
 func (a *ApiServer) Start() error {
 	docs.SwaggerInfo.Version = a.version
 	docs.SwaggerInfo.BasePath = "/"
@@ -114,6 +127,7 @@ func (a *ApiServer) Start() error {
 	a.router.Use(middlewares.TelemetryMiddleware(a.telemetryService))
 	a.router.Use(middlewares.LoggingMiddleware())
 	a.router.Use(middlewares.SetVersionMiddleware(a.version))
+	a.router.Use(middlewares.ShutdownMiddleware(&a.requests))
 
 	public := a.router.Group("/")
 	public.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
@@ -126,12 +140,20 @@ func (a *ApiServer) Start() error {
 	protected := a.router.Group("/")
 	protected.Use(middlewares.AuthMiddleware())
 
+	// Settings that apply to all users of the server, e.g. providers, targets and git providers, can only be
+	// changed by admins
+	adminOnly := middlewares.AdminAuthMiddleware()
+
 	serverController := protected.Group("/server")
 	{
 		serverController.GET("/config", server.GetConfig)
-		serverController.POST("/config", server.SetConfig)
+		serverController.POST("/config", adminOnly, server.SetConfig)
+		serverController.POST("/config/reload", adminOnly, server.ReloadConfig)
 		serverController.POST("/network-key", server.GenerateNetworkKey)
 		serverController.GET("/logs", server.GetServerLogFiles)
+		serverController.GET("/stats/failures", server.GetFailureStats)
+		serverController.GET("/host-info", server.GetHostInfo)
+		serverController.GET("/upgrade/check", server.CheckUpgrade)
 	}
 
 	binaryController := protected.Group("/binary")
@@ -144,16 +166,38 @@ func (a *ApiServer) Start() error {
 	{
 		workspaceController.GET("/:workspaceId", workspace.GetWorkspace)
 		workspaceController.GET("/", workspace.ListWorkspaces)
+		workspaceController.GET("/timings", workspace.ListCreationTimings)
+		workspaceController.GET("/egress", workspace.ListEgressUsage)
+		workspaceController.GET("/events", workspace.ListEvents)
 		workspaceController.POST("/", workspace.CreateWorkspace)
+		workspaceController.POST("/estimate", workspace.EstimateWorkspaceCreation)
 		workspaceController.POST("/:workspaceId/start", workspace.StartWorkspace)
 		workspaceController.POST("/:workspaceId/stop", workspace.StopWorkspace)
+		workspaceController.POST("/:workspaceId/rename", workspace.RenameWorkspace)
+		workspaceController.POST("/:workspaceId/settings", workspace.UpdateWorkspaceSettings)
+		workspaceController.POST("/:workspaceId/share", workspace.ShareWorkspace)
+		workspaceController.POST("/:workspaceId/events", workspace.RecordEvent)
+		workspaceController.POST("/:workspaceId/ssh-access", workspace.GrantSshAccess)
+		workspaceController.POST("/:workspaceId/ssh-access/verify", workspace.VerifySshAccess)
+		workspaceController.POST("/:workspaceId/invite", invite.CreateInvite)
 		workspaceController.DELETE("/:workspaceId", workspace.RemoveWorkspace)
+		workspaceController.GET("/:workspaceId/remove/stream", workspace.RemoveWorkspaceStream)
+		workspaceController.DELETE("/:workspaceId/:projectId", workspace.RemoveProject)
 		workspaceController.POST("/:workspaceId/:projectId/start", workspace.StartProject)
 		workspaceController.POST("/:workspaceId/:projectId/stop", workspace.StopProject)
+		workspaceController.POST("/:workspaceId/:projectId/upgrade-config", workspace.UpgradeProjectConfig)
+		workspaceController.POST("/:workspaceId/:projectId/runtimes", workspace.AddProjectRuntimes)
+		workspaceController.GET("/:workspaceId/:projectId/tunnel/:port", workspace.TunnelProjectPort)
+		workspaceController.GET("/:workspaceId/snapshot", workspace.ListSnapshots)
+		workspaceController.POST("/:workspaceId/:projectId/snapshot", workspace.CreateSnapshot)
+		workspaceController.POST("/:workspaceId/snapshot/:snapshotId/restore", workspace.RestoreSnapshot)
 
 		toolboxController := workspaceController.Group("/:workspaceId/:projectId/toolbox")
 		{
 			toolboxController.GET("/project-dir", toolbox.GetProjectDir)
+			toolboxController.GET("/e2e/public-key", toolbox.GetE2EPublicKey)
+			toolboxController.GET("/logs", toolbox.ReadAgentLog)
+			toolboxController.GET("/ports", toolbox.PortsListPorts)
 
 			toolboxController.POST("/process/execute", toolbox.ProcessExecuteCommand)
 
@@ -186,6 +230,12 @@ func (a *ApiServer) Start() error {
 				gitController.POST("/commit", toolbox.GitCommitChanges)
 				gitController.POST("/pull", toolbox.GitPushChanges)
 				gitController.POST("/push", toolbox.GitPushChanges)
+				gitController.POST("/refresh", toolbox.GitRefreshRepository)
+			}
+
+			runtimesController := toolboxController.Group("/runtimes")
+			{
+				runtimesController.POST("/install", toolbox.RuntimesInstall)
 			}
 
 			lspController := toolboxController.Group("/lsp")
@@ -217,6 +267,7 @@ func (a *ApiServer) Start() error {
 			projectConfigNameGroup.GET(prebuildRoutePath+"/", prebuild.ListPrebuildsForProjectConfig)
 			projectConfigNameGroup.GET(prebuildRoutePath+"/:prebuildId", prebuild.GetPrebuild)
 			projectConfigNameGroup.DELETE(prebuildRoutePath+"/:prebuildId", prebuild.DeletePrebuild)
+			projectConfigNameGroup.POST(prebuildRoutePath+"/:prebuildId/trigger", prebuild.TriggerPrebuild)
 
 			projectConfigNameGroup.GET("/", projectconfig.GetProjectConfig)
 			projectConfigNameGroup.PATCH("/set-default", projectconfig.SetDefaultProjectConfig)
@@ -230,11 +281,14 @@ func (a *ApiServer) Start() error {
 
 	public.POST(constants.WEBHOOK_EVENT_ROUTE, prebuild.ProcessGitEvent)
 
+	// Invites are redeemed by teammates that don't have an API key yet
+	public.POST("/invite/redeem", invite.RedeemInvite)
+
 	providerController := protected.Group("/provider")
 	{
-		providerController.POST("/install", provider.InstallProvider)
+		providerController.POST("/install", adminOnly, provider.InstallProvider)
 		providerController.GET("/", provider.ListProviders)
-		providerController.POST("/:provider/uninstall", provider.UninstallProvider)
+		providerController.POST("/:provider/uninstall", adminOnly, provider.UninstallProvider)
 		providerController.GET("/:provider/target-manifest", provider.GetTargetManifest)
 	}
 
@@ -242,8 +296,8 @@ func (a *ApiServer) Start() error {
 	{
 		containerRegistryController.GET("/", containerregistry.ListContainerRegistries)
 		containerRegistryController.GET("/:server", containerregistry.GetContainerRegistry)
-		containerRegistryController.PUT("/:server", containerregistry.SetContainerRegistry)
-		containerRegistryController.DELETE("/:server", containerregistry.RemoveContainerRegistry)
+		containerRegistryController.PUT("/:server", adminOnly, containerregistry.SetContainerRegistry)
+		containerRegistryController.DELETE("/:server", adminOnly, containerregistry.RemoveContainerRegistry)
 	}
 
 	buildController := protected.Group("/build")
@@ -251,17 +305,25 @@ func (a *ApiServer) Start() error {
 		buildController.POST("/", build.CreateBuild)
 		buildController.GET("/:buildId", build.GetBuild)
 		buildController.GET("/", build.ListBuilds)
-		buildController.DELETE("/", build.DeleteAllBuilds)
+		buildController.DELETE("/", adminOnly, build.DeleteAllBuilds)
 		buildController.DELETE("/:buildId", build.DeleteBuild)
+		buildController.POST("/:buildId/cancel", build.CancelBuild)
 		buildController.DELETE("/prebuild/:prebuildId", build.DeleteBuildsFromPrebuild)
 	}
 
 	targetController := protected.Group("/target")
 	{
 		targetController.GET("/", target.ListTargets)
-		targetController.PUT("/", target.SetTarget)
-		targetController.PATCH("/:target/set-default", target.SetDefaultTarget)
-		targetController.DELETE("/:target", target.RemoveTarget)
+		targetController.PUT("/", adminOnly, target.SetTarget)
+		targetController.PATCH("/:target/set-default", adminOnly, target.SetDefaultTarget)
+		targetController.DELETE("/:target", adminOnly, target.RemoveTarget)
+	}
+
+	benchController := protected.Group("/bench")
+	{
+		benchController.GET("/download", bench.Download)
+		benchController.POST("/upload", bench.Upload)
+		benchController.GET("/stream", bench.Stream)
 	}
 
 	logController := protected.Group("/log")
@@ -275,8 +337,8 @@ func (a *ApiServer) Start() error {
 	gitProviderController := protected.Group("/gitprovider")
 	{
 		gitProviderController.GET("/", gitprovider.ListGitProviders)
-		gitProviderController.PUT("/", gitprovider.SetGitProvider)
-		gitProviderController.DELETE("/:gitProviderId", gitprovider.RemoveGitProvider)
+		gitProviderController.PUT("/", adminOnly, gitprovider.SetGitProvider)
+		gitProviderController.DELETE("/:gitProviderId", adminOnly, gitprovider.RemoveGitProvider)
 		gitProviderController.GET("/:gitProviderId/user", gitprovider.GetGitUser)
 		gitProviderController.GET("/:gitProviderId/namespaces", gitprovider.GetNamespaces)
 		gitProviderController.GET("/:gitProviderId/:namespaceId/repositories", gitprovider.GetRepositories)
@@ -289,7 +351,9 @@ func (a *ApiServer) Start() error {
 		gitProviderController.GET("/:gitProviderId", gitprovider.GetGitProvider)
 	}
 
+	// API key names identify users, so only admins can create or revoke keys
 	apiKeyController := protected.Group("/apikey")
+	apiKeyController.Use(middlewares.AdminAuthMiddleware())
 	{
 		apiKeyController.GET("/", apikey.ListClientApiKeys)
 		apiKeyController.POST("/:apiKeyName", apikey.GenerateApiKey)
@@ -303,6 +367,13 @@ func (a *ApiServer) Start() error {
 		profileDataController.DELETE("/", profiledata.DeleteProfileData)
 	}
 
+	secretController := protected.Group("/secret")
+	{
+		secretController.GET("/", secret.ListSecrets)
+		secretController.PUT("/", secret.SetSecret)
+		secretController.DELETE("/:secretName", secret.DeleteSecret)
+	}
+
 	samplesController := protected.Group("/sample")
 	{
 		samplesController.GET("/", sample.ListSamples)
@@ -312,6 +383,7 @@ func (a *ApiServer) Start() error {
 	projectGroup.Use(middlewares.ProjectAuthMiddleware())
 	{
 		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/state", workspace.SetProjectState)
+		projectGroup.GET(workspaceController.BasePath()+"/:workspaceId/:projectId/secrets", secret.GetProjectSecrets)
 	}
 
 	a.httpServer = &http.Server{
@@ -329,6 +401,17 @@ func (a *ApiServer) Start() error {
 		errChan <- a.httpServer.Serve(listener)
 	}()
 
+	if a.socketPath != "" {
+		socketListener, err := listenUnix(a.socketPath)
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			errChan <- a.httpServer.Serve(socketListener)
+		}()
+	}
+
 	if a.frps == nil {
 		return <-errChan
 	}
@@ -366,6 +449,28 @@ func (a *ApiServer) Start() error {
 	return <-errChan
 }
 
+// listenUnix listens on the socket path, replacing a socket left over by a server that was not stopped cleanly.
+// Only the user running the server can connect to the socket
+func listenUnix(socketPath string) (net.Listener, error) {
+	err := os.Remove(socketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to remove stale API socket %s: %w", socketPath, err)
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on API socket %s: %w", socketPath, err)
+	}
+
+	err = os.Chmod(socketPath, 0600)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
 func (a *ApiServer) HealthCheck() error {
 	resp, err := http.Get(fmt.Sprintf("http://localhost:%d%s", a.apiPort, constants.HEALTH_CHECK_ROUTE))
 	if err != nil {
@@ -379,7 +484,21 @@ func (a *ApiServer) HealthCheck() error {
 	return nil
 }
 
+// Stop waits for the requests in progress to finish and stops the server. Requests that started after the server
+// was marked as shutting down were already rejected with the restarting status
 func (a *ApiServer) Stop() {
+	done := make(chan struct{})
+	go func() {
+		a.requests.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		log.Warnf("Requests still in progress after %s, stopping the API server", shutdownTimeout)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := a.httpServer.Shutdown(ctx); err != nil {
@@ -26,6 +26,13 @@ type ApiGenerateApiKeyRequest struct {
 	ctx        context.Context
 	ApiService *ApiKeyAPIService
 	apiKeyName string
+	readOnly   *bool
+}
+
+// Generate a read-only key
+func (r ApiGenerateApiKeyRequest) ReadOnly(readOnly bool) ApiGenerateApiKeyRequest {
+	r.readOnly = &readOnly
+	return r
 }
 
 func (r ApiGenerateApiKeyRequest) Execute() (string, *http.Response, error) {
@@ -72,6 +79,9 @@ func (a *ApiKeyAPIService) GenerateApiKeyExecute(r ApiGenerateApiKeyRequest) (st
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.readOnly != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "readOnly", r.readOnly, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
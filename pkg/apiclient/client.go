@@ -58,6 +58,8 @@ type APIClient struct {
 
 	GitProviderAPI *GitProviderAPIService
 
+	InviteAPI *InviteAPIService
+
 	PrebuildAPI *PrebuildAPIService
 
 	ProfileAPI *ProfileAPIService
@@ -68,6 +70,8 @@ type APIClient struct {
 
 	SampleAPI *SampleAPIService
 
+	SecretAPI *SecretAPIService
+
 	ServerAPI *ServerAPIService
 
 	TargetAPI *TargetAPIService
@@ -98,11 +102,13 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.ContainerRegistryAPI = (*ContainerRegistryAPIService)(&c.common)
 	c.DefaultAPI = (*DefaultAPIService)(&c.common)
 	c.GitProviderAPI = (*GitProviderAPIService)(&c.common)
+	c.InviteAPI = (*InviteAPIService)(&c.common)
 	c.PrebuildAPI = (*PrebuildAPIService)(&c.common)
 	c.ProfileAPI = (*ProfileAPIService)(&c.common)
 	c.ProjectConfigAPI = (*ProjectConfigAPIService)(&c.common)
 	c.ProviderAPI = (*ProviderAPIService)(&c.common)
 	c.SampleAPI = (*SampleAPIService)(&c.common)
+	c.SecretAPI = (*SecretAPIService)(&c.common)
 	c.ServerAPI = (*ServerAPIService)(&c.common)
 	c.TargetAPI = (*TargetAPIService)(&c.common)
 	c.WorkspaceAPI = (*WorkspaceAPIService)(&c.common)
@@ -21,10 +21,18 @@ var _ MappedNullable = &ApiKey{}
 
 // ApiKey struct for ApiKey
 type ApiKey struct {
-	KeyHash string `json:"keyHash"`
+	// Admin client keys can access the workspaces of all users
+	Admin *bool `json:"admin,omitempty"`
+	// Keys without an expiry time are valid until they are revoked
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+	KeyHash   string  `json:"keyHash"`
 	// Project or client name
-	Name string           `json:"name"`
-	Type ApikeyApiKeyType `json:"type"`
+	Name string `json:"name"`
+	// Read-only client keys can only be used to view workspaces and connect to them
+	ReadOnly *bool            `json:"readOnly,omitempty"`
+	Type     ApikeyApiKeyType `json:"type"`
+	// Workspace a client key is restricted to. Keys without a workspace can access all workspaces
+	WorkspaceId *string `json:"workspaceId,omitempty"`
 }
 
 type _ApiKey ApiKey
@@ -49,6 +57,70 @@ func NewApiKeyWithDefaults() *ApiKey {
 	return &this
 }
 
+// GetAdmin returns the Admin field value if set, zero value otherwise.
+func (o *ApiKey) GetAdmin() bool {
+	if o == nil || IsNil(o.Admin) {
+		var ret bool
+		return ret
+	}
+	return *o.Admin
+}
+
+// GetAdminOk returns a tuple with the Admin field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetAdminOk() (*bool, bool) {
+	if o == nil || IsNil(o.Admin) {
+		return nil, false
+	}
+	return o.Admin, true
+}
+
+// HasAdmin returns a boolean if a field has been set.
+func (o *ApiKey) HasAdmin() bool {
+	if o != nil && !IsNil(o.Admin) {
+		return true
+	}
+
+	return false
+}
+
+// SetAdmin gets a reference to the given bool and assigns it to the Admin field.
+func (o *ApiKey) SetAdmin(v bool) {
+	o.Admin = &v
+}
+
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *ApiKey) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *ApiKey) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *ApiKey) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetKeyHash returns the KeyHash field value
 func (o *ApiKey) GetKeyHash() string {
 	if o == nil {
@@ -97,6 +169,38 @@ func (o *ApiKey) SetName(v string) {
 	o.Name = v
 }
 
+// GetReadOnly returns the ReadOnly field value if set, zero value otherwise.
+func (o *ApiKey) GetReadOnly() bool {
+	if o == nil || IsNil(o.ReadOnly) {
+		var ret bool
+		return ret
+	}
+	return *o.ReadOnly
+}
+
+// GetReadOnlyOk returns a tuple with the ReadOnly field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetReadOnlyOk() (*bool, bool) {
+	if o == nil || IsNil(o.ReadOnly) {
+		return nil, false
+	}
+	return o.ReadOnly, true
+}
+
+// HasReadOnly returns a boolean if a field has been set.
+func (o *ApiKey) HasReadOnly() bool {
+	if o != nil && !IsNil(o.ReadOnly) {
+		return true
+	}
+
+	return false
+}
+
+// SetReadOnly gets a reference to the given bool and assigns it to the ReadOnly field.
+func (o *ApiKey) SetReadOnly(v bool) {
+	o.ReadOnly = &v
+}
+
 // GetType returns the Type field value
 func (o *ApiKey) GetType() ApikeyApiKeyType {
 	if o == nil {
@@ -121,6 +225,38 @@ func (o *ApiKey) SetType(v ApikeyApiKeyType) {
 	o.Type = v
 }
 
+// GetWorkspaceId returns the WorkspaceId field value if set, zero value otherwise.
+func (o *ApiKey) GetWorkspaceId() string {
+	if o == nil || IsNil(o.WorkspaceId) {
+		var ret string
+		return ret
+	}
+	return *o.WorkspaceId
+}
+
+// GetWorkspaceIdOk returns a tuple with the WorkspaceId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetWorkspaceIdOk() (*string, bool) {
+	if o == nil || IsNil(o.WorkspaceId) {
+		return nil, false
+	}
+	return o.WorkspaceId, true
+}
+
+// HasWorkspaceId returns a boolean if a field has been set.
+func (o *ApiKey) HasWorkspaceId() bool {
+	if o != nil && !IsNil(o.WorkspaceId) {
+		return true
+	}
+
+	return false
+}
+
+// SetWorkspaceId gets a reference to the given string and assigns it to the WorkspaceId field.
+func (o *ApiKey) SetWorkspaceId(v string) {
+	o.WorkspaceId = &v
+}
+
 func (o ApiKey) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -131,9 +267,21 @@ func (o ApiKey) MarshalJSON() ([]byte, error) {
 
 func (o ApiKey) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Admin) {
+		toSerialize["admin"] = o.Admin
+	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["keyHash"] = o.KeyHash
 	toSerialize["name"] = o.Name
+	if !IsNil(o.ReadOnly) {
+		toSerialize["readOnly"] = o.ReadOnly
+	}
 	toSerialize["type"] = o.Type
+	if !IsNil(o.WorkspaceId) {
+		toSerialize["workspaceId"] = o.WorkspaceId
+	}
 	return toSerialize, nil
 }
 
@@ -21,7 +21,9 @@ var _ MappedNullable = &Build{}
 
 // Build struct for Build
 type Build struct {
-	BuildConfig     *BuildConfig      `json:"buildConfig,omitempty"`
+	BuildConfig *BuildConfig `json:"buildConfig,omitempty"`
+	// Builds only share the BuildKit cache and cached images with builds in the same namespace
+	CacheNamespace  *string           `json:"cacheNamespace,omitempty"`
 	ContainerConfig ContainerConfig   `json:"containerConfig"`
 	CreatedAt       string            `json:"createdAt"`
 	EnvVars         map[string]string `json:"envVars"`
@@ -93,6 +95,38 @@ func (o *Build) SetBuildConfig(v BuildConfig) {
 	o.BuildConfig = &v
 }
 
+// GetCacheNamespace returns the CacheNamespace field value if set, zero value otherwise.
+func (o *Build) GetCacheNamespace() string {
+	if o == nil || IsNil(o.CacheNamespace) {
+		var ret string
+		return ret
+	}
+	return *o.CacheNamespace
+}
+
+// GetCacheNamespaceOk returns a tuple with the CacheNamespace field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetCacheNamespaceOk() (*string, bool) {
+	if o == nil || IsNil(o.CacheNamespace) {
+		return nil, false
+	}
+	return o.CacheNamespace, true
+}
+
+// HasCacheNamespace returns a boolean if a field has been set.
+func (o *Build) HasCacheNamespace() bool {
+	if o != nil && !IsNil(o.CacheNamespace) {
+		return true
+	}
+
+	return false
+}
+
+// SetCacheNamespace gets a reference to the given string and assigns it to the CacheNamespace field.
+func (o *Build) SetCacheNamespace(v string) {
+	o.CacheNamespace = &v
+}
+
 // GetContainerConfig returns the ContainerConfig field value
 func (o *Build) GetContainerConfig() ContainerConfig {
 	if o == nil {
@@ -362,6 +396,9 @@ func (o Build) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
+	if !IsNil(o.CacheNamespace) {
+		toSerialize["cacheNamespace"] = o.CacheNamespace
+	}
 	toSerialize["containerConfig"] = o.ContainerConfig
 	toSerialize["createdAt"] = o.CreatedAt
 	toSerialize["envVars"] = o.EnvVars
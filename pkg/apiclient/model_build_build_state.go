@@ -28,6 +28,8 @@ const (
 	BuildStatePendingDelete       BuildBuildState = "pending-delete"
 	BuildStatePendingForcedDelete BuildBuildState = "pending-forced-delete"
 	BuildStateDeleting            BuildBuildState = "deleting"
+	BuildStatePendingCancel       BuildBuildState = "pending-cancel"
+	BuildStateCanceled            BuildBuildState = "canceled"
 )
 
 // All allowed values of BuildBuildState enum
@@ -40,6 +42,8 @@ var AllowedBuildBuildStateEnumValues = []BuildBuildState{
 	"pending-delete",
 	"pending-forced-delete",
 	"deleting",
+	"pending-cancel",
+	"canceled",
 }
 
 func (v *BuildBuildState) UnmarshalJSON(src []byte) error {
@@ -23,6 +23,7 @@ var _ MappedNullable = &CreateProjectConfigDTO{}
 type CreateProjectConfigDTO struct {
 	BuildConfig         *BuildConfig      `json:"buildConfig,omitempty"`
 	EnvVars             map[string]string `json:"envVars"`
+	ForwardPorts        []int32           `json:"forwardPorts,omitempty"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
 	Image               *string           `json:"image,omitempty"`
 	Name                string            `json:"name"`
@@ -108,6 +109,38 @@ func (o *CreateProjectConfigDTO) SetEnvVars(v map[string]string) {
 	o.EnvVars = v
 }
 
+// GetForwardPorts returns the ForwardPorts field value if set, zero value otherwise.
+func (o *CreateProjectConfigDTO) GetForwardPorts() []int32 {
+	if o == nil || IsNil(o.ForwardPorts) {
+		var ret []int32
+		return ret
+	}
+	return o.ForwardPorts
+}
+
+// GetForwardPortsOk returns a tuple with the ForwardPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectConfigDTO) GetForwardPortsOk() ([]int32, bool) {
+	if o == nil || IsNil(o.ForwardPorts) {
+		return nil, false
+	}
+	return o.ForwardPorts, true
+}
+
+// HasForwardPorts returns a boolean if a field has been set.
+func (o *CreateProjectConfigDTO) HasForwardPorts() bool {
+	if o != nil && !IsNil(o.ForwardPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetForwardPorts gets a reference to the given []int32 and assigns it to the ForwardPorts field.
+func (o *CreateProjectConfigDTO) SetForwardPorts(v []int32) {
+	o.ForwardPorts = v
+}
+
 // GetGitProviderConfigId returns the GitProviderConfigId field value if set, zero value otherwise.
 func (o *CreateProjectConfigDTO) GetGitProviderConfigId() string {
 	if o == nil || IsNil(o.GitProviderConfigId) {
@@ -266,6 +299,9 @@ func (o CreateProjectConfigDTO) ToMap() (map[string]interface{}, error) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
 	toSerialize["envVars"] = o.EnvVars
+	if !IsNil(o.ForwardPorts) {
+		toSerialize["forwardPorts"] = o.ForwardPorts
+	}
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
 	}
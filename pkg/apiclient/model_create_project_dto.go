@@ -21,13 +21,20 @@ var _ MappedNullable = &CreateProjectDTO{}
 
 // CreateProjectDTO struct for CreateProjectDTO
 type CreateProjectDTO struct {
-	BuildConfig         *BuildConfig           `json:"buildConfig,omitempty"`
-	EnvVars             map[string]string      `json:"envVars"`
-	GitProviderConfigId *string                `json:"gitProviderConfigId,omitempty"`
-	Image               *string                `json:"image,omitempty"`
-	Name                string                 `json:"name"`
-	Source              CreateProjectSourceDTO `json:"source"`
-	User                *string                `json:"user,omitempty"`
+	BuildConfig         *BuildConfig      `json:"buildConfig,omitempty"`
+	EnvVars             map[string]string `json:"envVars"`
+	ForwardPorts        []int32           `json:"forwardPorts,omitempty"`
+	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
+	Image               *string           `json:"image,omitempty"`
+	Name                string            `json:"name"`
+	OnCreateCommands    []string          `json:"onCreateCommands,omitempty"`
+	PostStartCommands   []string          `json:"postStartCommands,omitempty"`
+	// Name and version of the project config the project is created from
+	ProjectConfigName    *string                `json:"projectConfigName,omitempty"`
+	ProjectConfigVersion *int32                 `json:"projectConfigVersion,omitempty"`
+	Resources            *ResourceLimits        `json:"resources,omitempty"`
+	Source               CreateProjectSourceDTO `json:"source"`
+	User                 *string                `json:"user,omitempty"`
 }
 
 type _CreateProjectDTO CreateProjectDTO
@@ -108,6 +115,38 @@ func (o *CreateProjectDTO) SetEnvVars(v map[string]string) {
 	o.EnvVars = v
 }
 
+// GetForwardPorts returns the ForwardPorts field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetForwardPorts() []int32 {
+	if o == nil || IsNil(o.ForwardPorts) {
+		var ret []int32
+		return ret
+	}
+	return o.ForwardPorts
+}
+
+// GetForwardPortsOk returns a tuple with the ForwardPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetForwardPortsOk() ([]int32, bool) {
+	if o == nil || IsNil(o.ForwardPorts) {
+		return nil, false
+	}
+	return o.ForwardPorts, true
+}
+
+// HasForwardPorts returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasForwardPorts() bool {
+	if o != nil && !IsNil(o.ForwardPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetForwardPorts gets a reference to the given []int32 and assigns it to the ForwardPorts field.
+func (o *CreateProjectDTO) SetForwardPorts(v []int32) {
+	o.ForwardPorts = v
+}
+
 // GetGitProviderConfigId returns the GitProviderConfigId field value if set, zero value otherwise.
 func (o *CreateProjectDTO) GetGitProviderConfigId() string {
 	if o == nil || IsNil(o.GitProviderConfigId) {
@@ -196,6 +235,166 @@ func (o *CreateProjectDTO) SetName(v string) {
 	o.Name = v
 }
 
+// GetOnCreateCommands returns the OnCreateCommands field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetOnCreateCommands() []string {
+	if o == nil || IsNil(o.OnCreateCommands) {
+		var ret []string
+		return ret
+	}
+	return o.OnCreateCommands
+}
+
+// GetOnCreateCommandsOk returns a tuple with the OnCreateCommands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetOnCreateCommandsOk() ([]string, bool) {
+	if o == nil || IsNil(o.OnCreateCommands) {
+		return nil, false
+	}
+	return o.OnCreateCommands, true
+}
+
+// HasOnCreateCommands returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasOnCreateCommands() bool {
+	if o != nil && !IsNil(o.OnCreateCommands) {
+		return true
+	}
+
+	return false
+}
+
+// SetOnCreateCommands gets a reference to the given []string and assigns it to the OnCreateCommands field.
+func (o *CreateProjectDTO) SetOnCreateCommands(v []string) {
+	o.OnCreateCommands = v
+}
+
+// GetPostStartCommands returns the PostStartCommands field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetPostStartCommands() []string {
+	if o == nil || IsNil(o.PostStartCommands) {
+		var ret []string
+		return ret
+	}
+	return o.PostStartCommands
+}
+
+// GetPostStartCommandsOk returns a tuple with the PostStartCommands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetPostStartCommandsOk() ([]string, bool) {
+	if o == nil || IsNil(o.PostStartCommands) {
+		return nil, false
+	}
+	return o.PostStartCommands, true
+}
+
+// HasPostStartCommands returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasPostStartCommands() bool {
+	if o != nil && !IsNil(o.PostStartCommands) {
+		return true
+	}
+
+	return false
+}
+
+// SetPostStartCommands gets a reference to the given []string and assigns it to the PostStartCommands field.
+func (o *CreateProjectDTO) SetPostStartCommands(v []string) {
+	o.PostStartCommands = v
+}
+
+// GetProjectConfigName returns the ProjectConfigName field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetProjectConfigName() string {
+	if o == nil || IsNil(o.ProjectConfigName) {
+		var ret string
+		return ret
+	}
+	return *o.ProjectConfigName
+}
+
+// GetProjectConfigNameOk returns a tuple with the ProjectConfigName field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetProjectConfigNameOk() (*string, bool) {
+	if o == nil || IsNil(o.ProjectConfigName) {
+		return nil, false
+	}
+	return o.ProjectConfigName, true
+}
+
+// HasProjectConfigName returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasProjectConfigName() bool {
+	if o != nil && !IsNil(o.ProjectConfigName) {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectConfigName gets a reference to the given string and assigns it to the ProjectConfigName field.
+func (o *CreateProjectDTO) SetProjectConfigName(v string) {
+	o.ProjectConfigName = &v
+}
+
+// GetProjectConfigVersion returns the ProjectConfigVersion field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetProjectConfigVersion() int32 {
+	if o == nil || IsNil(o.ProjectConfigVersion) {
+		var ret int32
+		return ret
+	}
+	return *o.ProjectConfigVersion
+}
+
+// GetProjectConfigVersionOk returns a tuple with the ProjectConfigVersion field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetProjectConfigVersionOk() (*int32, bool) {
+	if o == nil || IsNil(o.ProjectConfigVersion) {
+		return nil, false
+	}
+	return o.ProjectConfigVersion, true
+}
+
+// HasProjectConfigVersion returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasProjectConfigVersion() bool {
+	if o != nil && !IsNil(o.ProjectConfigVersion) {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectConfigVersion gets a reference to the given int32 and assigns it to the ProjectConfigVersion field.
+func (o *CreateProjectDTO) SetProjectConfigVersion(v int32) {
+	o.ProjectConfigVersion = &v
+}
+
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetResources() ResourceLimits {
+	if o == nil || IsNil(o.Resources) {
+		var ret ResourceLimits
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetResourcesOk() (*ResourceLimits, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ResourceLimits and assigns it to the Resources field.
+func (o *CreateProjectDTO) SetResources(v ResourceLimits) {
+	o.Resources = &v
+}
+
 // GetSource returns the Source field value
 func (o *CreateProjectDTO) GetSource() CreateProjectSourceDTO {
 	if o == nil {
@@ -266,6 +465,9 @@ func (o CreateProjectDTO) ToMap() (map[string]interface{}, error) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
 	toSerialize["envVars"] = o.EnvVars
+	if !IsNil(o.ForwardPorts) {
+		toSerialize["forwardPorts"] = o.ForwardPorts
+	}
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
 	}
@@ -273,6 +475,21 @@ func (o CreateProjectDTO) ToMap() (map[string]interface{}, error) {
 		toSerialize["image"] = o.Image
 	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.OnCreateCommands) {
+		toSerialize["onCreateCommands"] = o.OnCreateCommands
+	}
+	if !IsNil(o.PostStartCommands) {
+		toSerialize["postStartCommands"] = o.PostStartCommands
+	}
+	if !IsNil(o.ProjectConfigName) {
+		toSerialize["projectConfigName"] = o.ProjectConfigName
+	}
+	if !IsNil(o.ProjectConfigVersion) {
+		toSerialize["projectConfigVersion"] = o.ProjectConfigVersion
+	}
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
 	toSerialize["source"] = o.Source
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
@@ -21,6 +21,7 @@ var _ MappedNullable = &CreateProviderTargetDTO{}
 
 // CreateProviderTargetDTO struct for CreateProviderTargetDTO
 type CreateProviderTargetDTO struct {
+	Labels       map[string]string    `json:"labels,omitempty"`
 	Name         string               `json:"name"`
 	Options      string               `json:"options"`
 	ProviderInfo ProviderProviderInfo `json:"providerInfo"`
@@ -48,6 +49,38 @@ func NewCreateProviderTargetDTOWithDefaults() *CreateProviderTargetDTO {
 	return &this
 }
 
+// GetLabels returns the Labels field value if set, zero value otherwise.
+func (o *CreateProviderTargetDTO) GetLabels() map[string]string {
+	if o == nil || IsNil(o.Labels) {
+		var ret map[string]string
+		return ret
+	}
+	return o.Labels
+}
+
+// GetLabelsOk returns a tuple with the Labels field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProviderTargetDTO) GetLabelsOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.Labels) {
+		return map[string]string{}, false
+	}
+	return o.Labels, true
+}
+
+// HasLabels returns a boolean if a field has been set.
+func (o *CreateProviderTargetDTO) HasLabels() bool {
+	if o != nil && !IsNil(o.Labels) {
+		return true
+	}
+
+	return false
+}
+
+// SetLabels gets a reference to the given map[string]string and assigns it to the Labels field.
+func (o *CreateProviderTargetDTO) SetLabels(v map[string]string) {
+	o.Labels = v
+}
+
 // GetName returns the Name field value
 func (o *CreateProviderTargetDTO) GetName() string {
 	if o == nil {
@@ -130,6 +163,9 @@ func (o CreateProviderTargetDTO) MarshalJSON() ([]byte, error) {
 
 func (o CreateProviderTargetDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Labels) {
+		toSerialize["labels"] = o.Labels
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["options"] = o.Options
 	toSerialize["providerInfo"] = o.ProviderInfo
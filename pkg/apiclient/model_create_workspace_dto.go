@@ -21,10 +21,21 @@ var _ MappedNullable = &CreateWorkspaceDTO{}
 
 // CreateWorkspaceDTO struct for CreateWorkspaceDTO
 type CreateWorkspaceDTO struct {
-	Id       string             `json:"id"`
-	Name     string             `json:"name"`
-	Projects []CreateProjectDTO `json:"projects"`
-	Target   string             `json:"target"`
+	// Minutes without SSH activity after which the workspace is stopped. Zero disables auto-stop
+	AutoStop *int32  `json:"autoStop,omitempty"`
+	Group    *string `json:"group,omitempty"`
+	Id       string  `json:"id"`
+	// Keep the partially created workspace when provisioning fails instead of removing it
+	KeepPartial *bool             `json:"keepPartial,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Name        string            `json:"name"`
+	Origin      *WorkspaceOrigin  `json:"origin,omitempty"`
+	// Labels the target must have for the workspace to be created on it
+	Placement map[string]string  `json:"placement,omitempty"`
+	Projects  []CreateProjectDTO `json:"projects"`
+	// Number of times provisioning is retried after a failure
+	Retries *int32 `json:"retries,omitempty"`
+	Target  string `json:"target"`
 }
 
 type _CreateWorkspaceDTO CreateWorkspaceDTO
@@ -50,6 +61,70 @@ func NewCreateWorkspaceDTOWithDefaults() *CreateWorkspaceDTO {
 	return &this
 }
 
+// GetAutoStop returns the AutoStop field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetAutoStop() int32 {
+	if o == nil || IsNil(o.AutoStop) {
+		var ret int32
+		return ret
+	}
+	return *o.AutoStop
+}
+
+// GetAutoStopOk returns a tuple with the AutoStop field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetAutoStopOk() (*int32, bool) {
+	if o == nil || IsNil(o.AutoStop) {
+		return nil, false
+	}
+	return o.AutoStop, true
+}
+
+// HasAutoStop returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasAutoStop() bool {
+	if o != nil && !IsNil(o.AutoStop) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoStop gets a reference to the given int32 and assigns it to the AutoStop field.
+func (o *CreateWorkspaceDTO) SetAutoStop(v int32) {
+	o.AutoStop = &v
+}
+
+// GetGroup returns the Group field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetGroup() string {
+	if o == nil || IsNil(o.Group) {
+		var ret string
+		return ret
+	}
+	return *o.Group
+}
+
+// GetGroupOk returns a tuple with the Group field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetGroupOk() (*string, bool) {
+	if o == nil || IsNil(o.Group) {
+		return nil, false
+	}
+	return o.Group, true
+}
+
+// HasGroup returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasGroup() bool {
+	if o != nil && !IsNil(o.Group) {
+		return true
+	}
+
+	return false
+}
+
+// SetGroup gets a reference to the given string and assigns it to the Group field.
+func (o *CreateWorkspaceDTO) SetGroup(v string) {
+	o.Group = &v
+}
+
 // GetId returns the Id field value
 func (o *CreateWorkspaceDTO) GetId() string {
 	if o == nil {
@@ -74,6 +149,70 @@ func (o *CreateWorkspaceDTO) SetId(v string) {
 	o.Id = v
 }
 
+// GetKeepPartial returns the KeepPartial field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetKeepPartial() bool {
+	if o == nil || IsNil(o.KeepPartial) {
+		var ret bool
+		return ret
+	}
+	return *o.KeepPartial
+}
+
+// GetKeepPartialOk returns a tuple with the KeepPartial field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetKeepPartialOk() (*bool, bool) {
+	if o == nil || IsNil(o.KeepPartial) {
+		return nil, false
+	}
+	return o.KeepPartial, true
+}
+
+// HasKeepPartial returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasKeepPartial() bool {
+	if o != nil && !IsNil(o.KeepPartial) {
+		return true
+	}
+
+	return false
+}
+
+// SetKeepPartial gets a reference to the given bool and assigns it to the KeepPartial field.
+func (o *CreateWorkspaceDTO) SetKeepPartial(v bool) {
+	o.KeepPartial = &v
+}
+
+// GetLabels returns the Labels field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetLabels() map[string]string {
+	if o == nil || IsNil(o.Labels) {
+		var ret map[string]string
+		return ret
+	}
+	return o.Labels
+}
+
+// GetLabelsOk returns a tuple with the Labels field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetLabelsOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.Labels) {
+		return map[string]string{}, false
+	}
+	return o.Labels, true
+}
+
+// HasLabels returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasLabels() bool {
+	if o != nil && !IsNil(o.Labels) {
+		return true
+	}
+
+	return false
+}
+
+// SetLabels gets a reference to the given map[string]string and assigns it to the Labels field.
+func (o *CreateWorkspaceDTO) SetLabels(v map[string]string) {
+	o.Labels = v
+}
+
 // GetName returns the Name field value
 func (o *CreateWorkspaceDTO) GetName() string {
 	if o == nil {
@@ -98,6 +237,70 @@ func (o *CreateWorkspaceDTO) SetName(v string) {
 	o.Name = v
 }
 
+// GetOrigin returns the Origin field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetOrigin() WorkspaceOrigin {
+	if o == nil || IsNil(o.Origin) {
+		var ret WorkspaceOrigin
+		return ret
+	}
+	return *o.Origin
+}
+
+// GetOriginOk returns a tuple with the Origin field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetOriginOk() (*WorkspaceOrigin, bool) {
+	if o == nil || IsNil(o.Origin) {
+		return nil, false
+	}
+	return o.Origin, true
+}
+
+// HasOrigin returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasOrigin() bool {
+	if o != nil && !IsNil(o.Origin) {
+		return true
+	}
+
+	return false
+}
+
+// SetOrigin gets a reference to the given WorkspaceOrigin and assigns it to the Origin field.
+func (o *CreateWorkspaceDTO) SetOrigin(v WorkspaceOrigin) {
+	o.Origin = &v
+}
+
+// GetPlacement returns the Placement field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetPlacement() map[string]string {
+	if o == nil || IsNil(o.Placement) {
+		var ret map[string]string
+		return ret
+	}
+	return o.Placement
+}
+
+// GetPlacementOk returns a tuple with the Placement field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetPlacementOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.Placement) {
+		return map[string]string{}, false
+	}
+	return o.Placement, true
+}
+
+// HasPlacement returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasPlacement() bool {
+	if o != nil && !IsNil(o.Placement) {
+		return true
+	}
+
+	return false
+}
+
+// SetPlacement gets a reference to the given map[string]string and assigns it to the Placement field.
+func (o *CreateWorkspaceDTO) SetPlacement(v map[string]string) {
+	o.Placement = v
+}
+
 // GetProjects returns the Projects field value
 func (o *CreateWorkspaceDTO) GetProjects() []CreateProjectDTO {
 	if o == nil {
@@ -122,6 +325,38 @@ func (o *CreateWorkspaceDTO) SetProjects(v []CreateProjectDTO) {
 	o.Projects = v
 }
 
+// GetRetries returns the Retries field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetRetries() int32 {
+	if o == nil || IsNil(o.Retries) {
+		var ret int32
+		return ret
+	}
+	return *o.Retries
+}
+
+// GetRetriesOk returns a tuple with the Retries field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetRetriesOk() (*int32, bool) {
+	if o == nil || IsNil(o.Retries) {
+		return nil, false
+	}
+	return o.Retries, true
+}
+
+// HasRetries returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasRetries() bool {
+	if o != nil && !IsNil(o.Retries) {
+		return true
+	}
+
+	return false
+}
+
+// SetRetries gets a reference to the given int32 and assigns it to the Retries field.
+func (o *CreateWorkspaceDTO) SetRetries(v int32) {
+	o.Retries = &v
+}
+
 // GetTarget returns the Target field value
 func (o *CreateWorkspaceDTO) GetTarget() string {
 	if o == nil {
@@ -156,9 +391,30 @@ func (o CreateWorkspaceDTO) MarshalJSON() ([]byte, error) {
 
 func (o CreateWorkspaceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AutoStop) {
+		toSerialize["autoStop"] = o.AutoStop
+	}
+	if !IsNil(o.Group) {
+		toSerialize["group"] = o.Group
+	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.KeepPartial) {
+		toSerialize["keepPartial"] = o.KeepPartial
+	}
+	if !IsNil(o.Labels) {
+		toSerialize["labels"] = o.Labels
+	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.Origin) {
+		toSerialize["origin"] = o.Origin
+	}
+	if !IsNil(o.Placement) {
+		toSerialize["placement"] = o.Placement
+	}
 	toSerialize["projects"] = o.Projects
+	if !IsNil(o.Retries) {
+		toSerialize["retries"] = o.Retries
+	}
 	toSerialize["target"] = o.Target
 	return toSerialize, nil
 }
@@ -21,16 +21,28 @@ var _ MappedNullable = &Project{}
 
 // Project struct for Project
 type Project struct {
-	BuildConfig         *BuildConfig      `json:"buildConfig,omitempty"`
-	EnvVars             map[string]string `json:"envVars"`
-	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
-	Image               string            `json:"image"`
-	Name                string            `json:"name"`
-	Repository          GitRepository     `json:"repository"`
-	State               *ProjectState     `json:"state,omitempty"`
-	Target              string            `json:"target"`
-	User                string            `json:"user"`
-	WorkspaceId         string            `json:"workspaceId"`
+	BuildConfig *BuildConfig      `json:"buildConfig,omitempty"`
+	EnvVars     map[string]string `json:"envVars"`
+	// Ports of the project that are meant to be forwarded to clients
+	ForwardPorts        []int32 `json:"forwardPorts,omitempty"`
+	GitProviderConfigId *string `json:"gitProviderConfigId,omitempty"`
+	Image               string  `json:"image"`
+	Name                string  `json:"name"`
+	// Shell commands run once after the project container is created, in order
+	OnCreateCommands []string `json:"onCreateCommands,omitempty"`
+	// Shell commands run every time the project is started, in order
+	PostStartCommands []string `json:"postStartCommands,omitempty"`
+	// Name and version of the project config the project was created from
+	ProjectConfigName    *string         `json:"projectConfigName,omitempty"`
+	ProjectConfigVersion *int32          `json:"projectConfigVersion,omitempty"`
+	Repository           GitRepository   `json:"repository"`
+	Resources            *ResourceLimits `json:"resources,omitempty"`
+	// Runtimes installed in the project with daytona install, in the tool@version format
+	Runtimes    []string      `json:"runtimes,omitempty"`
+	State       *ProjectState `json:"state,omitempty"`
+	Target      string        `json:"target"`
+	User        string        `json:"user"`
+	WorkspaceId string        `json:"workspaceId"`
 }
 
 type _Project Project
@@ -115,6 +127,38 @@ func (o *Project) SetEnvVars(v map[string]string) {
 	o.EnvVars = v
 }
 
+// GetForwardPorts returns the ForwardPorts field value if set, zero value otherwise.
+func (o *Project) GetForwardPorts() []int32 {
+	if o == nil || IsNil(o.ForwardPorts) {
+		var ret []int32
+		return ret
+	}
+	return o.ForwardPorts
+}
+
+// GetForwardPortsOk returns a tuple with the ForwardPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetForwardPortsOk() ([]int32, bool) {
+	if o == nil || IsNil(o.ForwardPorts) {
+		return nil, false
+	}
+	return o.ForwardPorts, true
+}
+
+// HasForwardPorts returns a boolean if a field has been set.
+func (o *Project) HasForwardPorts() bool {
+	if o != nil && !IsNil(o.ForwardPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetForwardPorts gets a reference to the given []int32 and assigns it to the ForwardPorts field.
+func (o *Project) SetForwardPorts(v []int32) {
+	o.ForwardPorts = v
+}
+
 // GetGitProviderConfigId returns the GitProviderConfigId field value if set, zero value otherwise.
 func (o *Project) GetGitProviderConfigId() string {
 	if o == nil || IsNil(o.GitProviderConfigId) {
@@ -195,6 +239,134 @@ func (o *Project) SetName(v string) {
 	o.Name = v
 }
 
+// GetOnCreateCommands returns the OnCreateCommands field value if set, zero value otherwise.
+func (o *Project) GetOnCreateCommands() []string {
+	if o == nil || IsNil(o.OnCreateCommands) {
+		var ret []string
+		return ret
+	}
+	return o.OnCreateCommands
+}
+
+// GetOnCreateCommandsOk returns a tuple with the OnCreateCommands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetOnCreateCommandsOk() ([]string, bool) {
+	if o == nil || IsNil(o.OnCreateCommands) {
+		return nil, false
+	}
+	return o.OnCreateCommands, true
+}
+
+// HasOnCreateCommands returns a boolean if a field has been set.
+func (o *Project) HasOnCreateCommands() bool {
+	if o != nil && !IsNil(o.OnCreateCommands) {
+		return true
+	}
+
+	return false
+}
+
+// SetOnCreateCommands gets a reference to the given []string and assigns it to the OnCreateCommands field.
+func (o *Project) SetOnCreateCommands(v []string) {
+	o.OnCreateCommands = v
+}
+
+// GetPostStartCommands returns the PostStartCommands field value if set, zero value otherwise.
+func (o *Project) GetPostStartCommands() []string {
+	if o == nil || IsNil(o.PostStartCommands) {
+		var ret []string
+		return ret
+	}
+	return o.PostStartCommands
+}
+
+// GetPostStartCommandsOk returns a tuple with the PostStartCommands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetPostStartCommandsOk() ([]string, bool) {
+	if o == nil || IsNil(o.PostStartCommands) {
+		return nil, false
+	}
+	return o.PostStartCommands, true
+}
+
+// HasPostStartCommands returns a boolean if a field has been set.
+func (o *Project) HasPostStartCommands() bool {
+	if o != nil && !IsNil(o.PostStartCommands) {
+		return true
+	}
+
+	return false
+}
+
+// SetPostStartCommands gets a reference to the given []string and assigns it to the PostStartCommands field.
+func (o *Project) SetPostStartCommands(v []string) {
+	o.PostStartCommands = v
+}
+
+// GetProjectConfigName returns the ProjectConfigName field value if set, zero value otherwise.
+func (o *Project) GetProjectConfigName() string {
+	if o == nil || IsNil(o.ProjectConfigName) {
+		var ret string
+		return ret
+	}
+	return *o.ProjectConfigName
+}
+
+// GetProjectConfigNameOk returns a tuple with the ProjectConfigName field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetProjectConfigNameOk() (*string, bool) {
+	if o == nil || IsNil(o.ProjectConfigName) {
+		return nil, false
+	}
+	return o.ProjectConfigName, true
+}
+
+// HasProjectConfigName returns a boolean if a field has been set.
+func (o *Project) HasProjectConfigName() bool {
+	if o != nil && !IsNil(o.ProjectConfigName) {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectConfigName gets a reference to the given string and assigns it to the ProjectConfigName field.
+func (o *Project) SetProjectConfigName(v string) {
+	o.ProjectConfigName = &v
+}
+
+// GetProjectConfigVersion returns the ProjectConfigVersion field value if set, zero value otherwise.
+func (o *Project) GetProjectConfigVersion() int32 {
+	if o == nil || IsNil(o.ProjectConfigVersion) {
+		var ret int32
+		return ret
+	}
+	return *o.ProjectConfigVersion
+}
+
+// GetProjectConfigVersionOk returns a tuple with the ProjectConfigVersion field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetProjectConfigVersionOk() (*int32, bool) {
+	if o == nil || IsNil(o.ProjectConfigVersion) {
+		return nil, false
+	}
+	return o.ProjectConfigVersion, true
+}
+
+// HasProjectConfigVersion returns a boolean if a field has been set.
+func (o *Project) HasProjectConfigVersion() bool {
+	if o != nil && !IsNil(o.ProjectConfigVersion) {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectConfigVersion gets a reference to the given int32 and assigns it to the ProjectConfigVersion field.
+func (o *Project) SetProjectConfigVersion(v int32) {
+	o.ProjectConfigVersion = &v
+}
+
 // GetRepository returns the Repository field value
 func (o *Project) GetRepository() GitRepository {
 	if o == nil {
@@ -219,6 +391,70 @@ func (o *Project) SetRepository(v GitRepository) {
 	o.Repository = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *Project) GetResources() ResourceLimits {
+	if o == nil || IsNil(o.Resources) {
+		var ret ResourceLimits
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetResourcesOk() (*ResourceLimits, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *Project) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ResourceLimits and assigns it to the Resources field.
+func (o *Project) SetResources(v ResourceLimits) {
+	o.Resources = &v
+}
+
+// GetRuntimes returns the Runtimes field value if set, zero value otherwise.
+func (o *Project) GetRuntimes() []string {
+	if o == nil || IsNil(o.Runtimes) {
+		var ret []string
+		return ret
+	}
+	return o.Runtimes
+}
+
+// GetRuntimesOk returns a tuple with the Runtimes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetRuntimesOk() ([]string, bool) {
+	if o == nil || IsNil(o.Runtimes) {
+		return nil, false
+	}
+	return o.Runtimes, true
+}
+
+// HasRuntimes returns a boolean if a field has been set.
+func (o *Project) HasRuntimes() bool {
+	if o != nil && !IsNil(o.Runtimes) {
+		return true
+	}
+
+	return false
+}
+
+// SetRuntimes gets a reference to the given []string and assigns it to the Runtimes field.
+func (o *Project) SetRuntimes(v []string) {
+	o.Runtimes = v
+}
+
 // GetState returns the State field value if set, zero value otherwise.
 func (o *Project) GetState() ProjectState {
 	if o == nil || IsNil(o.State) {
@@ -337,12 +573,33 @@ func (o Project) ToMap() (map[string]interface{}, error) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
 	toSerialize["envVars"] = o.EnvVars
+	if !IsNil(o.ForwardPorts) {
+		toSerialize["forwardPorts"] = o.ForwardPorts
+	}
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
 	}
 	toSerialize["image"] = o.Image
 	toSerialize["name"] = o.Name
+	if !IsNil(o.OnCreateCommands) {
+		toSerialize["onCreateCommands"] = o.OnCreateCommands
+	}
+	if !IsNil(o.PostStartCommands) {
+		toSerialize["postStartCommands"] = o.PostStartCommands
+	}
+	if !IsNil(o.ProjectConfigName) {
+		toSerialize["projectConfigName"] = o.ProjectConfigName
+	}
+	if !IsNil(o.ProjectConfigVersion) {
+		toSerialize["projectConfigVersion"] = o.ProjectConfigVersion
+	}
 	toSerialize["repository"] = o.Repository
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
+	if !IsNil(o.Runtimes) {
+		toSerialize["runtimes"] = o.Runtimes
+	}
 	if !IsNil(o.State) {
 		toSerialize["state"] = o.State
 	}
@@ -21,15 +21,19 @@ var _ MappedNullable = &ProjectConfig{}
 
 // ProjectConfig struct for ProjectConfig
 type ProjectConfig struct {
-	BuildConfig         *BuildConfig      `json:"buildConfig,omitempty"`
-	Default             bool              `json:"default"`
-	EnvVars             map[string]string `json:"envVars"`
-	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
-	Image               string            `json:"image"`
-	Name                string            `json:"name"`
-	Prebuilds           []PrebuildConfig  `json:"prebuilds,omitempty"`
-	RepositoryUrl       string            `json:"repositoryUrl"`
-	User                string            `json:"user"`
+	BuildConfig *BuildConfig      `json:"buildConfig,omitempty"`
+	Default     bool              `json:"default"`
+	EnvVars     map[string]string `json:"envVars"`
+	// Ports forwarded to the client machine while an SSH or IDE connection to the project is open
+	ForwardPorts        []int32          `json:"forwardPorts,omitempty"`
+	GitProviderConfigId *string          `json:"gitProviderConfigId,omitempty"`
+	Image               string           `json:"image"`
+	Name                string           `json:"name"`
+	Prebuilds           []PrebuildConfig `json:"prebuilds,omitempty"`
+	RepositoryUrl       string           `json:"repositoryUrl"`
+	User                string           `json:"user"`
+	// Incremented every time the image, user, build configuration, repository or environment variables change
+	Version *int32 `json:"version,omitempty"`
 }
 
 type _ProjectConfig ProjectConfig
@@ -137,6 +141,38 @@ func (o *ProjectConfig) SetEnvVars(v map[string]string) {
 	o.EnvVars = v
 }
 
+// GetForwardPorts returns the ForwardPorts field value if set, zero value otherwise.
+func (o *ProjectConfig) GetForwardPorts() []int32 {
+	if o == nil || IsNil(o.ForwardPorts) {
+		var ret []int32
+		return ret
+	}
+	return o.ForwardPorts
+}
+
+// GetForwardPortsOk returns a tuple with the ForwardPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetForwardPortsOk() ([]int32, bool) {
+	if o == nil || IsNil(o.ForwardPorts) {
+		return nil, false
+	}
+	return o.ForwardPorts, true
+}
+
+// HasForwardPorts returns a boolean if a field has been set.
+func (o *ProjectConfig) HasForwardPorts() bool {
+	if o != nil && !IsNil(o.ForwardPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetForwardPorts gets a reference to the given []int32 and assigns it to the ForwardPorts field.
+func (o *ProjectConfig) SetForwardPorts(v []int32) {
+	o.ForwardPorts = v
+}
+
 // GetGitProviderConfigId returns the GitProviderConfigId field value if set, zero value otherwise.
 func (o *ProjectConfig) GetGitProviderConfigId() string {
 	if o == nil || IsNil(o.GitProviderConfigId) {
@@ -297,6 +333,38 @@ func (o *ProjectConfig) SetUser(v string) {
 	o.User = v
 }
 
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *ProjectConfig) GetVersion() int32 {
+	if o == nil || IsNil(o.Version) {
+		var ret int32
+		return ret
+	}
+	return *o.Version
+}
+
+// GetVersionOk returns a tuple with the Version field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetVersionOk() (*int32, bool) {
+	if o == nil || IsNil(o.Version) {
+		return nil, false
+	}
+	return o.Version, true
+}
+
+// HasVersion returns a boolean if a field has been set.
+func (o *ProjectConfig) HasVersion() bool {
+	if o != nil && !IsNil(o.Version) {
+		return true
+	}
+
+	return false
+}
+
+// SetVersion gets a reference to the given int32 and assigns it to the Version field.
+func (o *ProjectConfig) SetVersion(v int32) {
+	o.Version = &v
+}
+
 func (o ProjectConfig) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -312,6 +380,9 @@ func (o ProjectConfig) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["default"] = o.Default
 	toSerialize["envVars"] = o.EnvVars
+	if !IsNil(o.ForwardPorts) {
+		toSerialize["forwardPorts"] = o.ForwardPorts
+	}
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
 	}
@@ -322,6 +393,9 @@ func (o ProjectConfig) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["repositoryUrl"] = o.RepositoryUrl
 	toSerialize["user"] = o.User
+	if !IsNil(o.Version) {
+		toSerialize["version"] = o.Version
+	}
 	return toSerialize, nil
 }
 
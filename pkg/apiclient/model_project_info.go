@@ -21,8 +21,14 @@ var _ MappedNullable = &ProjectInfo{}
 
 // ProjectInfo struct for ProjectInfo
 type ProjectInfo struct {
-	Created          string  `json:"created"`
-	IsRunning        bool    `json:"isRunning"`
+	// Average CPU usage of the project since it was last started in percent of a single CPU. Zero if the provider does not report it
+	CpuUsage *float32 `json:"cpuUsage,omitempty"`
+	Created  string   `json:"created"`
+	// Bytes sent by the project since it was last started. Zero if the provider does not report it
+	EgressBytes *int64 `json:"egressBytes,omitempty"`
+	IsRunning   bool   `json:"isRunning"`
+	// Memory used by the project in bytes. Zero if the provider does not report it
+	MemoryUsage      *int64  `json:"memoryUsage,omitempty"`
 	Name             string  `json:"name"`
 	ProviderMetadata *string `json:"providerMetadata,omitempty"`
 	WorkspaceId      string  `json:"workspaceId"`
@@ -51,6 +57,38 @@ func NewProjectInfoWithDefaults() *ProjectInfo {
 	return &this
 }
 
+// GetCpuUsage returns the CpuUsage field value if set, zero value otherwise.
+func (o *ProjectInfo) GetCpuUsage() float32 {
+	if o == nil || IsNil(o.CpuUsage) {
+		var ret float32
+		return ret
+	}
+	return *o.CpuUsage
+}
+
+// GetCpuUsageOk returns a tuple with the CpuUsage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectInfo) GetCpuUsageOk() (*float32, bool) {
+	if o == nil || IsNil(o.CpuUsage) {
+		return nil, false
+	}
+	return o.CpuUsage, true
+}
+
+// HasCpuUsage returns a boolean if a field has been set.
+func (o *ProjectInfo) HasCpuUsage() bool {
+	if o != nil && !IsNil(o.CpuUsage) {
+		return true
+	}
+
+	return false
+}
+
+// SetCpuUsage gets a reference to the given float32 and assigns it to the CpuUsage field.
+func (o *ProjectInfo) SetCpuUsage(v float32) {
+	o.CpuUsage = &v
+}
+
 // GetCreated returns the Created field value
 func (o *ProjectInfo) GetCreated() string {
 	if o == nil {
@@ -75,6 +113,38 @@ func (o *ProjectInfo) SetCreated(v string) {
 	o.Created = v
 }
 
+// GetEgressBytes returns the EgressBytes field value if set, zero value otherwise.
+func (o *ProjectInfo) GetEgressBytes() int64 {
+	if o == nil || IsNil(o.EgressBytes) {
+		var ret int64
+		return ret
+	}
+	return *o.EgressBytes
+}
+
+// GetEgressBytesOk returns a tuple with the EgressBytes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectInfo) GetEgressBytesOk() (*int64, bool) {
+	if o == nil || IsNil(o.EgressBytes) {
+		return nil, false
+	}
+	return o.EgressBytes, true
+}
+
+// HasEgressBytes returns a boolean if a field has been set.
+func (o *ProjectInfo) HasEgressBytes() bool {
+	if o != nil && !IsNil(o.EgressBytes) {
+		return true
+	}
+
+	return false
+}
+
+// SetEgressBytes gets a reference to the given int64 and assigns it to the EgressBytes field.
+func (o *ProjectInfo) SetEgressBytes(v int64) {
+	o.EgressBytes = &v
+}
+
 // GetIsRunning returns the IsRunning field value
 func (o *ProjectInfo) GetIsRunning() bool {
 	if o == nil {
@@ -99,6 +169,38 @@ func (o *ProjectInfo) SetIsRunning(v bool) {
 	o.IsRunning = v
 }
 
+// GetMemoryUsage returns the MemoryUsage field value if set, zero value otherwise.
+func (o *ProjectInfo) GetMemoryUsage() int64 {
+	if o == nil || IsNil(o.MemoryUsage) {
+		var ret int64
+		return ret
+	}
+	return *o.MemoryUsage
+}
+
+// GetMemoryUsageOk returns a tuple with the MemoryUsage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectInfo) GetMemoryUsageOk() (*int64, bool) {
+	if o == nil || IsNil(o.MemoryUsage) {
+		return nil, false
+	}
+	return o.MemoryUsage, true
+}
+
+// HasMemoryUsage returns a boolean if a field has been set.
+func (o *ProjectInfo) HasMemoryUsage() bool {
+	if o != nil && !IsNil(o.MemoryUsage) {
+		return true
+	}
+
+	return false
+}
+
+// SetMemoryUsage gets a reference to the given int64 and assigns it to the MemoryUsage field.
+func (o *ProjectInfo) SetMemoryUsage(v int64) {
+	o.MemoryUsage = &v
+}
+
 // GetName returns the Name field value
 func (o *ProjectInfo) GetName() string {
 	if o == nil {
@@ -189,8 +291,17 @@ func (o ProjectInfo) MarshalJSON() ([]byte, error) {
 
 func (o ProjectInfo) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.CpuUsage) {
+		toSerialize["cpuUsage"] = o.CpuUsage
+	}
 	toSerialize["created"] = o.Created
+	if !IsNil(o.EgressBytes) {
+		toSerialize["egressBytes"] = o.EgressBytes
+	}
 	toSerialize["isRunning"] = o.IsRunning
+	if !IsNil(o.MemoryUsage) {
+		toSerialize["memoryUsage"] = o.MemoryUsage
+	}
 	toSerialize["name"] = o.Name
 	if !IsNil(o.ProviderMetadata) {
 		toSerialize["providerMetadata"] = o.ProviderMetadata
@@ -22,8 +22,12 @@ var _ MappedNullable = &ProjectState{}
 // ProjectState struct for ProjectState
 type ProjectState struct {
 	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	UpdatedAt string     `json:"updatedAt"`
-	Uptime    int32      `json:"uptime"`
+	// Public key of the SSH server of the project in the known_hosts format, e.g. "ssh-ed25519 AAAA..."
+	HostKey *string `json:"hostKey,omitempty"`
+	// Seconds since the last SSH session of the project was closed. Zero while a session is open
+	IdleTime  *int32 `json:"idleTime,omitempty"`
+	UpdatedAt string `json:"updatedAt"`
+	Uptime    int32  `json:"uptime"`
 }
 
 type _ProjectState ProjectState
@@ -79,6 +83,70 @@ func (o *ProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetHostKey returns the HostKey field value if set, zero value otherwise.
+func (o *ProjectState) GetHostKey() string {
+	if o == nil || IsNil(o.HostKey) {
+		var ret string
+		return ret
+	}
+	return *o.HostKey
+}
+
+// GetHostKeyOk returns a tuple with the HostKey field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetHostKeyOk() (*string, bool) {
+	if o == nil || IsNil(o.HostKey) {
+		return nil, false
+	}
+	return o.HostKey, true
+}
+
+// HasHostKey returns a boolean if a field has been set.
+func (o *ProjectState) HasHostKey() bool {
+	if o != nil && !IsNil(o.HostKey) {
+		return true
+	}
+
+	return false
+}
+
+// SetHostKey gets a reference to the given string and assigns it to the HostKey field.
+func (o *ProjectState) SetHostKey(v string) {
+	o.HostKey = &v
+}
+
+// GetIdleTime returns the IdleTime field value if set, zero value otherwise.
+func (o *ProjectState) GetIdleTime() int32 {
+	if o == nil || IsNil(o.IdleTime) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTime
+}
+
+// GetIdleTimeOk returns a tuple with the IdleTime field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetIdleTimeOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTime) {
+		return nil, false
+	}
+	return o.IdleTime, true
+}
+
+// HasIdleTime returns a boolean if a field has been set.
+func (o *ProjectState) HasIdleTime() bool {
+	if o != nil && !IsNil(o.IdleTime) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTime gets a reference to the given int32 and assigns it to the IdleTime field.
+func (o *ProjectState) SetIdleTime(v int32) {
+	o.IdleTime = &v
+}
+
 // GetUpdatedAt returns the UpdatedAt field value
 func (o *ProjectState) GetUpdatedAt() string {
 	if o == nil {
@@ -140,6 +208,12 @@ func (o ProjectState) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.HostKey) {
+		toSerialize["hostKey"] = o.HostKey
+	}
+	if !IsNil(o.IdleTime) {
+		toSerialize["idleTime"] = o.IdleTime
+	}
 	toSerialize["updatedAt"] = o.UpdatedAt
 	toSerialize["uptime"] = o.Uptime
 	return toSerialize, nil
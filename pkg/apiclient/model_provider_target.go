@@ -21,8 +21,10 @@ var _ MappedNullable = &ProviderTarget{}
 
 // ProviderTarget struct for ProviderTarget
 type ProviderTarget struct {
-	IsDefault bool   `json:"isDefault"`
-	Name      string `json:"name"`
+	IsDefault bool `json:"isDefault"`
+	// Labels of the target host, matched against the placement constraints of new workspaces
+	Labels map[string]string `json:"labels,omitempty"`
+	Name   string            `json:"name"`
 	// JSON encoded map of options
 	Options      string               `json:"options"`
 	ProviderInfo ProviderProviderInfo `json:"providerInfo"`
@@ -75,6 +77,38 @@ func (o *ProviderTarget) SetIsDefault(v bool) {
 	o.IsDefault = v
 }
 
+// GetLabels returns the Labels field value if set, zero value otherwise.
+func (o *ProviderTarget) GetLabels() map[string]string {
+	if o == nil || IsNil(o.Labels) {
+		var ret map[string]string
+		return ret
+	}
+	return o.Labels
+}
+
+// GetLabelsOk returns a tuple with the Labels field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetLabelsOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.Labels) {
+		return map[string]string{}, false
+	}
+	return o.Labels, true
+}
+
+// HasLabels returns a boolean if a field has been set.
+func (o *ProviderTarget) HasLabels() bool {
+	if o != nil && !IsNil(o.Labels) {
+		return true
+	}
+
+	return false
+}
+
+// SetLabels gets a reference to the given map[string]string and assigns it to the Labels field.
+func (o *ProviderTarget) SetLabels(v map[string]string) {
+	o.Labels = v
+}
+
 // GetName returns the Name field value
 func (o *ProviderTarget) GetName() string {
 	if o == nil {
@@ -158,6 +192,9 @@ func (o ProviderTarget) MarshalJSON() ([]byte, error) {
 func (o ProviderTarget) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["isDefault"] = o.IsDefault
+	if !IsNil(o.Labels) {
+		toSerialize["labels"] = o.Labels
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["options"] = o.Options
 	toSerialize["providerInfo"] = o.ProviderInfo
@@ -21,15 +21,26 @@ var _ MappedNullable = &ServerConfig{}
 
 // ServerConfig struct for ServerConfig
 type ServerConfig struct {
-	ApiPort                   int32         `json:"apiPort"`
-	BinariesPath              string        `json:"binariesPath"`
-	BuildImageNamespace       *string       `json:"buildImageNamespace,omitempty"`
-	BuilderImage              string        `json:"builderImage"`
-	BuilderRegistryServer     string        `json:"builderRegistryServer"`
-	DefaultProjectImage       string        `json:"defaultProjectImage"`
-	DefaultProjectUser        string        `json:"defaultProjectUser"`
-	Frps                      *FRPSConfig   `json:"frps,omitempty"`
-	HeadscalePort             int32         `json:"headscalePort"`
+	ApiPort               int32              `json:"apiPort"`
+	BinariesPath          string             `json:"binariesPath"`
+	BuildImageNamespace   *string            `json:"buildImageNamespace,omitempty"`
+	BuildLimits           *BuildLimitsConfig `json:"buildLimits,omitempty"`
+	BuilderImage          string             `json:"builderImage"`
+	BuilderRegistryServer string             `json:"builderRegistryServer"`
+	DefaultProjectImage   string             `json:"defaultProjectImage"`
+	DefaultProjectUser    string             `json:"defaultProjectUser"`
+	// Docker CLI context to use instead of the docker host
+	DockerContext *string `json:"dockerContext,omitempty"`
+	// Docker daemon address used for builds and passed to providers, e.g. tcp://10.0.0.5:2376
+	DockerHost *string `json:"dockerHost,omitempty"`
+	// Outbound traffic in MB a workspace may send per month before it is stopped. 0 disables the quota
+	EgressQuotaMb *int32      `json:"egressQuotaMb,omitempty"`
+	Frps          *FRPSConfig `json:"frps,omitempty"`
+	HeadscalePort int32       `json:"headscalePort"`
+	// Minutes a workspace has to be stopped before its project data is archived to disk. 0 disables hibernation
+	HibernateAfterMinutes *int32 `json:"hibernateAfterMinutes,omitempty"`
+	// Days a hibernated workspace is kept before it is removed. 0 keeps hibernated workspaces
+	HibernationRetentionDays  *int32        `json:"hibernationRetentionDays,omitempty"`
 	Id                        string        `json:"id"`
 	LocalBuilderRegistryImage string        `json:"localBuilderRegistryImage"`
 	LocalBuilderRegistryPort  int32         `json:"localBuilderRegistryPort"`
@@ -153,6 +164,38 @@ func (o *ServerConfig) SetBuildImageNamespace(v string) {
 	o.BuildImageNamespace = &v
 }
 
+// GetBuildLimits returns the BuildLimits field value if set, zero value otherwise.
+func (o *ServerConfig) GetBuildLimits() BuildLimitsConfig {
+	if o == nil || IsNil(o.BuildLimits) {
+		var ret BuildLimitsConfig
+		return ret
+	}
+	return *o.BuildLimits
+}
+
+// GetBuildLimitsOk returns a tuple with the BuildLimits field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetBuildLimitsOk() (*BuildLimitsConfig, bool) {
+	if o == nil || IsNil(o.BuildLimits) {
+		return nil, false
+	}
+	return o.BuildLimits, true
+}
+
+// HasBuildLimits returns a boolean if a field has been set.
+func (o *ServerConfig) HasBuildLimits() bool {
+	if o != nil && !IsNil(o.BuildLimits) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildLimits gets a reference to the given BuildLimitsConfig and assigns it to the BuildLimits field.
+func (o *ServerConfig) SetBuildLimits(v BuildLimitsConfig) {
+	o.BuildLimits = &v
+}
+
 // GetBuilderImage returns the BuilderImage field value
 func (o *ServerConfig) GetBuilderImage() string {
 	if o == nil {
@@ -249,6 +292,102 @@ func (o *ServerConfig) SetDefaultProjectUser(v string) {
 	o.DefaultProjectUser = v
 }
 
+// GetDockerContext returns the DockerContext field value if set, zero value otherwise.
+func (o *ServerConfig) GetDockerContext() string {
+	if o == nil || IsNil(o.DockerContext) {
+		var ret string
+		return ret
+	}
+	return *o.DockerContext
+}
+
+// GetDockerContextOk returns a tuple with the DockerContext field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetDockerContextOk() (*string, bool) {
+	if o == nil || IsNil(o.DockerContext) {
+		return nil, false
+	}
+	return o.DockerContext, true
+}
+
+// HasDockerContext returns a boolean if a field has been set.
+func (o *ServerConfig) HasDockerContext() bool {
+	if o != nil && !IsNil(o.DockerContext) {
+		return true
+	}
+
+	return false
+}
+
+// SetDockerContext gets a reference to the given string and assigns it to the DockerContext field.
+func (o *ServerConfig) SetDockerContext(v string) {
+	o.DockerContext = &v
+}
+
+// GetDockerHost returns the DockerHost field value if set, zero value otherwise.
+func (o *ServerConfig) GetDockerHost() string {
+	if o == nil || IsNil(o.DockerHost) {
+		var ret string
+		return ret
+	}
+	return *o.DockerHost
+}
+
+// GetDockerHostOk returns a tuple with the DockerHost field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetDockerHostOk() (*string, bool) {
+	if o == nil || IsNil(o.DockerHost) {
+		return nil, false
+	}
+	return o.DockerHost, true
+}
+
+// HasDockerHost returns a boolean if a field has been set.
+func (o *ServerConfig) HasDockerHost() bool {
+	if o != nil && !IsNil(o.DockerHost) {
+		return true
+	}
+
+	return false
+}
+
+// SetDockerHost gets a reference to the given string and assigns it to the DockerHost field.
+func (o *ServerConfig) SetDockerHost(v string) {
+	o.DockerHost = &v
+}
+
+// GetEgressQuotaMb returns the EgressQuotaMb field value if set, zero value otherwise.
+func (o *ServerConfig) GetEgressQuotaMb() int32 {
+	if o == nil || IsNil(o.EgressQuotaMb) {
+		var ret int32
+		return ret
+	}
+	return *o.EgressQuotaMb
+}
+
+// GetEgressQuotaMbOk returns a tuple with the EgressQuotaMb field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetEgressQuotaMbOk() (*int32, bool) {
+	if o == nil || IsNil(o.EgressQuotaMb) {
+		return nil, false
+	}
+	return o.EgressQuotaMb, true
+}
+
+// HasEgressQuotaMb returns a boolean if a field has been set.
+func (o *ServerConfig) HasEgressQuotaMb() bool {
+	if o != nil && !IsNil(o.EgressQuotaMb) {
+		return true
+	}
+
+	return false
+}
+
+// SetEgressQuotaMb gets a reference to the given int32 and assigns it to the EgressQuotaMb field.
+func (o *ServerConfig) SetEgressQuotaMb(v int32) {
+	o.EgressQuotaMb = &v
+}
+
 // GetFrps returns the Frps field value if set, zero value otherwise.
 func (o *ServerConfig) GetFrps() FRPSConfig {
 	if o == nil || IsNil(o.Frps) {
@@ -305,6 +444,70 @@ func (o *ServerConfig) SetHeadscalePort(v int32) {
 	o.HeadscalePort = v
 }
 
+// GetHibernateAfterMinutes returns the HibernateAfterMinutes field value if set, zero value otherwise.
+func (o *ServerConfig) GetHibernateAfterMinutes() int32 {
+	if o == nil || IsNil(o.HibernateAfterMinutes) {
+		var ret int32
+		return ret
+	}
+	return *o.HibernateAfterMinutes
+}
+
+// GetHibernateAfterMinutesOk returns a tuple with the HibernateAfterMinutes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetHibernateAfterMinutesOk() (*int32, bool) {
+	if o == nil || IsNil(o.HibernateAfterMinutes) {
+		return nil, false
+	}
+	return o.HibernateAfterMinutes, true
+}
+
+// HasHibernateAfterMinutes returns a boolean if a field has been set.
+func (o *ServerConfig) HasHibernateAfterMinutes() bool {
+	if o != nil && !IsNil(o.HibernateAfterMinutes) {
+		return true
+	}
+
+	return false
+}
+
+// SetHibernateAfterMinutes gets a reference to the given int32 and assigns it to the HibernateAfterMinutes field.
+func (o *ServerConfig) SetHibernateAfterMinutes(v int32) {
+	o.HibernateAfterMinutes = &v
+}
+
+// GetHibernationRetentionDays returns the HibernationRetentionDays field value if set, zero value otherwise.
+func (o *ServerConfig) GetHibernationRetentionDays() int32 {
+	if o == nil || IsNil(o.HibernationRetentionDays) {
+		var ret int32
+		return ret
+	}
+	return *o.HibernationRetentionDays
+}
+
+// GetHibernationRetentionDaysOk returns a tuple with the HibernationRetentionDays field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetHibernationRetentionDaysOk() (*int32, bool) {
+	if o == nil || IsNil(o.HibernationRetentionDays) {
+		return nil, false
+	}
+	return o.HibernationRetentionDays, true
+}
+
+// HasHibernationRetentionDays returns a boolean if a field has been set.
+func (o *ServerConfig) HasHibernationRetentionDays() bool {
+	if o != nil && !IsNil(o.HibernationRetentionDays) {
+		return true
+	}
+
+	return false
+}
+
+// SetHibernationRetentionDays gets a reference to the given int32 and assigns it to the HibernationRetentionDays field.
+func (o *ServerConfig) SetHibernationRetentionDays(v int32) {
+	o.HibernationRetentionDays = &v
+}
+
 // GetId returns the Id field value
 func (o *ServerConfig) GetId() string {
 	if o == nil {
@@ -520,14 +723,32 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuildImageNamespace) {
 		toSerialize["buildImageNamespace"] = o.BuildImageNamespace
 	}
+	if !IsNil(o.BuildLimits) {
+		toSerialize["buildLimits"] = o.BuildLimits
+	}
 	toSerialize["builderImage"] = o.BuilderImage
 	toSerialize["builderRegistryServer"] = o.BuilderRegistryServer
 	toSerialize["defaultProjectImage"] = o.DefaultProjectImage
 	toSerialize["defaultProjectUser"] = o.DefaultProjectUser
+	if !IsNil(o.DockerContext) {
+		toSerialize["dockerContext"] = o.DockerContext
+	}
+	if !IsNil(o.DockerHost) {
+		toSerialize["dockerHost"] = o.DockerHost
+	}
+	if !IsNil(o.EgressQuotaMb) {
+		toSerialize["egressQuotaMb"] = o.EgressQuotaMb
+	}
 	if !IsNil(o.Frps) {
 		toSerialize["frps"] = o.Frps
 	}
 	toSerialize["headscalePort"] = o.HeadscalePort
+	if !IsNil(o.HibernateAfterMinutes) {
+		toSerialize["hibernateAfterMinutes"] = o.HibernateAfterMinutes
+	}
+	if !IsNil(o.HibernationRetentionDays) {
+		toSerialize["hibernationRetentionDays"] = o.HibernationRetentionDays
+	}
 	toSerialize["id"] = o.Id
 	toSerialize["localBuilderRegistryImage"] = o.LocalBuilderRegistryImage
 	toSerialize["localBuilderRegistryPort"] = o.LocalBuilderRegistryPort
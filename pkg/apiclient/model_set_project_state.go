@@ -22,6 +22,8 @@ var _ MappedNullable = &SetProjectState{}
 // SetProjectState struct for SetProjectState
 type SetProjectState struct {
 	GitStatus *GitStatus `json:"gitStatus,omitempty"`
+	HostKey   *string    `json:"hostKey,omitempty"`
+	IdleTime  *int32     `json:"idleTime,omitempty"`
 	Uptime    int32      `json:"uptime"`
 }
 
@@ -77,6 +79,70 @@ func (o *SetProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetHostKey returns the HostKey field value if set, zero value otherwise.
+func (o *SetProjectState) GetHostKey() string {
+	if o == nil || IsNil(o.HostKey) {
+		var ret string
+		return ret
+	}
+	return *o.HostKey
+}
+
+// GetHostKeyOk returns a tuple with the HostKey field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetHostKeyOk() (*string, bool) {
+	if o == nil || IsNil(o.HostKey) {
+		return nil, false
+	}
+	return o.HostKey, true
+}
+
+// HasHostKey returns a boolean if a field has been set.
+func (o *SetProjectState) HasHostKey() bool {
+	if o != nil && !IsNil(o.HostKey) {
+		return true
+	}
+
+	return false
+}
+
+// SetHostKey gets a reference to the given string and assigns it to the HostKey field.
+func (o *SetProjectState) SetHostKey(v string) {
+	o.HostKey = &v
+}
+
+// GetIdleTime returns the IdleTime field value if set, zero value otherwise.
+func (o *SetProjectState) GetIdleTime() int32 {
+	if o == nil || IsNil(o.IdleTime) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTime
+}
+
+// GetIdleTimeOk returns a tuple with the IdleTime field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetIdleTimeOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTime) {
+		return nil, false
+	}
+	return o.IdleTime, true
+}
+
+// HasIdleTime returns a boolean if a field has been set.
+func (o *SetProjectState) HasIdleTime() bool {
+	if o != nil && !IsNil(o.IdleTime) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTime gets a reference to the given int32 and assigns it to the IdleTime field.
+func (o *SetProjectState) SetIdleTime(v int32) {
+	o.IdleTime = &v
+}
+
 // GetUptime returns the Uptime field value
 func (o *SetProjectState) GetUptime() int32 {
 	if o == nil {
@@ -114,6 +180,12 @@ func (o SetProjectState) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.HostKey) {
+		toSerialize["hostKey"] = o.HostKey
+	}
+	if !IsNil(o.IdleTime) {
+		toSerialize["idleTime"] = o.IdleTime
+	}
 	toSerialize["uptime"] = o.Uptime
 	return toSerialize, nil
 }
@@ -21,10 +21,21 @@ var _ MappedNullable = &Workspace{}
 
 // Workspace struct for Workspace
 type Workspace struct {
-	Id       string    `json:"id"`
-	Name     string    `json:"name"`
+	// Minutes without SSH activity after which the workspace is stopped. Zero disables auto-stop
+	AutoStop *int32  `json:"autoStop,omitempty"`
+	Group    *string `json:"group,omitempty"`
+	// Time the project data of the stopped workspace was archived to disk. Nil if the workspace is not hibernated
+	HibernatedAt *string           `json:"hibernatedAt,omitempty"`
+	Id           string            `json:"id"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Name         string            `json:"name"`
+	Origin       *WorkspaceOrigin  `json:"origin,omitempty"`
+	// Name of the API key of the user that created the workspace. Workspaces without an owner were created before ownership was tracked and are accessible by all users
+	Owner    *string   `json:"owner,omitempty"`
 	Projects []Project `json:"projects"`
-	Target   string    `json:"target"`
+	// Users that can view the workspace and connect to it
+	SharedWith []string `json:"sharedWith,omitempty"`
+	Target     string   `json:"target"`
 }
 
 type _Workspace Workspace
@@ -50,6 +61,102 @@ func NewWorkspaceWithDefaults() *Workspace {
 	return &this
 }
 
+// GetAutoStop returns the AutoStop field value if set, zero value otherwise.
+func (o *Workspace) GetAutoStop() int32 {
+	if o == nil || IsNil(o.AutoStop) {
+		var ret int32
+		return ret
+	}
+	return *o.AutoStop
+}
+
+// GetAutoStopOk returns a tuple with the AutoStop field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetAutoStopOk() (*int32, bool) {
+	if o == nil || IsNil(o.AutoStop) {
+		return nil, false
+	}
+	return o.AutoStop, true
+}
+
+// HasAutoStop returns a boolean if a field has been set.
+func (o *Workspace) HasAutoStop() bool {
+	if o != nil && !IsNil(o.AutoStop) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoStop gets a reference to the given int32 and assigns it to the AutoStop field.
+func (o *Workspace) SetAutoStop(v int32) {
+	o.AutoStop = &v
+}
+
+// GetGroup returns the Group field value if set, zero value otherwise.
+func (o *Workspace) GetGroup() string {
+	if o == nil || IsNil(o.Group) {
+		var ret string
+		return ret
+	}
+	return *o.Group
+}
+
+// GetGroupOk returns a tuple with the Group field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetGroupOk() (*string, bool) {
+	if o == nil || IsNil(o.Group) {
+		return nil, false
+	}
+	return o.Group, true
+}
+
+// HasGroup returns a boolean if a field has been set.
+func (o *Workspace) HasGroup() bool {
+	if o != nil && !IsNil(o.Group) {
+		return true
+	}
+
+	return false
+}
+
+// SetGroup gets a reference to the given string and assigns it to the Group field.
+func (o *Workspace) SetGroup(v string) {
+	o.Group = &v
+}
+
+// GetHibernatedAt returns the HibernatedAt field value if set, zero value otherwise.
+func (o *Workspace) GetHibernatedAt() string {
+	if o == nil || IsNil(o.HibernatedAt) {
+		var ret string
+		return ret
+	}
+	return *o.HibernatedAt
+}
+
+// GetHibernatedAtOk returns a tuple with the HibernatedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetHibernatedAtOk() (*string, bool) {
+	if o == nil || IsNil(o.HibernatedAt) {
+		return nil, false
+	}
+	return o.HibernatedAt, true
+}
+
+// HasHibernatedAt returns a boolean if a field has been set.
+func (o *Workspace) HasHibernatedAt() bool {
+	if o != nil && !IsNil(o.HibernatedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetHibernatedAt gets a reference to the given string and assigns it to the HibernatedAt field.
+func (o *Workspace) SetHibernatedAt(v string) {
+	o.HibernatedAt = &v
+}
+
 // GetId returns the Id field value
 func (o *Workspace) GetId() string {
 	if o == nil {
@@ -74,6 +181,38 @@ func (o *Workspace) SetId(v string) {
 	o.Id = v
 }
 
+// GetLabels returns the Labels field value if set, zero value otherwise.
+func (o *Workspace) GetLabels() map[string]string {
+	if o == nil || IsNil(o.Labels) {
+		var ret map[string]string
+		return ret
+	}
+	return o.Labels
+}
+
+// GetLabelsOk returns a tuple with the Labels field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetLabelsOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.Labels) {
+		return map[string]string{}, false
+	}
+	return o.Labels, true
+}
+
+// HasLabels returns a boolean if a field has been set.
+func (o *Workspace) HasLabels() bool {
+	if o != nil && !IsNil(o.Labels) {
+		return true
+	}
+
+	return false
+}
+
+// SetLabels gets a reference to the given map[string]string and assigns it to the Labels field.
+func (o *Workspace) SetLabels(v map[string]string) {
+	o.Labels = v
+}
+
 // GetName returns the Name field value
 func (o *Workspace) GetName() string {
 	if o == nil {
@@ -98,6 +237,70 @@ func (o *Workspace) SetName(v string) {
 	o.Name = v
 }
 
+// GetOrigin returns the Origin field value if set, zero value otherwise.
+func (o *Workspace) GetOrigin() WorkspaceOrigin {
+	if o == nil || IsNil(o.Origin) {
+		var ret WorkspaceOrigin
+		return ret
+	}
+	return *o.Origin
+}
+
+// GetOriginOk returns a tuple with the Origin field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetOriginOk() (*WorkspaceOrigin, bool) {
+	if o == nil || IsNil(o.Origin) {
+		return nil, false
+	}
+	return o.Origin, true
+}
+
+// HasOrigin returns a boolean if a field has been set.
+func (o *Workspace) HasOrigin() bool {
+	if o != nil && !IsNil(o.Origin) {
+		return true
+	}
+
+	return false
+}
+
+// SetOrigin gets a reference to the given WorkspaceOrigin and assigns it to the Origin field.
+func (o *Workspace) SetOrigin(v WorkspaceOrigin) {
+	o.Origin = &v
+}
+
+// GetOwner returns the Owner field value if set, zero value otherwise.
+func (o *Workspace) GetOwner() string {
+	if o == nil || IsNil(o.Owner) {
+		var ret string
+		return ret
+	}
+	return *o.Owner
+}
+
+// GetOwnerOk returns a tuple with the Owner field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetOwnerOk() (*string, bool) {
+	if o == nil || IsNil(o.Owner) {
+		return nil, false
+	}
+	return o.Owner, true
+}
+
+// HasOwner returns a boolean if a field has been set.
+func (o *Workspace) HasOwner() bool {
+	if o != nil && !IsNil(o.Owner) {
+		return true
+	}
+
+	return false
+}
+
+// SetOwner gets a reference to the given string and assigns it to the Owner field.
+func (o *Workspace) SetOwner(v string) {
+	o.Owner = &v
+}
+
 // GetProjects returns the Projects field value
 func (o *Workspace) GetProjects() []Project {
 	if o == nil {
@@ -122,6 +325,38 @@ func (o *Workspace) SetProjects(v []Project) {
 	o.Projects = v
 }
 
+// GetSharedWith returns the SharedWith field value if set, zero value otherwise.
+func (o *Workspace) GetSharedWith() []string {
+	if o == nil || IsNil(o.SharedWith) {
+		var ret []string
+		return ret
+	}
+	return o.SharedWith
+}
+
+// GetSharedWithOk returns a tuple with the SharedWith field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetSharedWithOk() ([]string, bool) {
+	if o == nil || IsNil(o.SharedWith) {
+		return nil, false
+	}
+	return o.SharedWith, true
+}
+
+// HasSharedWith returns a boolean if a field has been set.
+func (o *Workspace) HasSharedWith() bool {
+	if o != nil && !IsNil(o.SharedWith) {
+		return true
+	}
+
+	return false
+}
+
+// SetSharedWith gets a reference to the given []string and assigns it to the SharedWith field.
+func (o *Workspace) SetSharedWith(v []string) {
+	o.SharedWith = v
+}
+
 // GetTarget returns the Target field value
 func (o *Workspace) GetTarget() string {
 	if o == nil {
@@ -156,9 +391,30 @@ func (o Workspace) MarshalJSON() ([]byte, error) {
 
 func (o Workspace) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AutoStop) {
+		toSerialize["autoStop"] = o.AutoStop
+	}
+	if !IsNil(o.Group) {
+		toSerialize["group"] = o.Group
+	}
+	if !IsNil(o.HibernatedAt) {
+		toSerialize["hibernatedAt"] = o.HibernatedAt
+	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.Labels) {
+		toSerialize["labels"] = o.Labels
+	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.Origin) {
+		toSerialize["origin"] = o.Origin
+	}
+	if !IsNil(o.Owner) {
+		toSerialize["owner"] = o.Owner
+	}
 	toSerialize["projects"] = o.Projects
+	if !IsNil(o.SharedWith) {
+		toSerialize["sharedWith"] = o.SharedWith
+	}
 	toSerialize["target"] = o.Target
 	return toSerialize, nil
 }
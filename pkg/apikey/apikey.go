@@ -3,6 +3,8 @@
 
 package apikey
 
+import "time"
+
 type ApiKeyType string
 
 const (
@@ -16,4 +18,17 @@ type ApiKey struct {
 	Type    ApiKeyType `json:"type" validate:"required"`
 	// Project or client name
 	Name string `json:"name" validate:"required"`
+	// Read-only client keys can only be used to view workspaces and connect to them
+	ReadOnly bool `json:"readOnly,omitempty" validate:"optional"`
+	// Admin client keys can access the workspaces of all users
+	Admin bool `json:"admin,omitempty" validate:"optional"`
+	// Workspace a client key is restricted to. Keys without a workspace can access all workspaces
+	WorkspaceId string `json:"workspaceId,omitempty" validate:"optional"`
+	// Keys without an expiry time are valid until they are revoked
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" validate:"optional"`
 } // @name ApiKey
+
+// IsExpired returns true if the key has an expiry time that has passed
+func (k *ApiKey) IsExpired() bool {
+	return k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt)
+}
@@ -24,6 +24,8 @@ const (
 	BuildStatePendingDelete       BuildState = "pending-delete"
 	BuildStatePendingForcedDelete BuildState = "pending-forced-delete"
 	BuildStateDeleting            BuildState = "deleting"
+	BuildStatePendingCancel       BuildState = "pending-cancel"
+	BuildStateCanceled            BuildState = "canceled"
 )
 
 type Build struct {
@@ -36,8 +38,10 @@ type Build struct {
 	Repository      *gitprovider.GitRepository      `json:"repository" validate:"required"`
 	EnvVars         map[string]string               `json:"envVars" validate:"required"`
 	PrebuildId      string                          `json:"prebuildId" validate:"required"`
-	CreatedAt       time.Time                       `json:"createdAt" validate:"required"`
-	UpdatedAt       time.Time                       `json:"updatedAt" validate:"required"`
+	// Builds only share the BuildKit cache and cached images with builds in the same namespace
+	CacheNamespace string    `json:"cacheNamespace,omitempty" validate:"optional"`
+	CreatedAt      time.Time `json:"createdAt" validate:"required"`
+	UpdatedAt      time.Time `json:"updatedAt" validate:"required"`
 } // @name Build
 
 func (b *Build) Compare(other *Build) (bool, error) {
@@ -111,7 +115,7 @@ func GetCachedBuild(build *Build, builds []*Build) *buildconfig.CachedBuild {
 		if err != nil {
 			continue
 		}
-		if !equal || existingBuild.State != BuildStatePublished {
+		if !equal || existingBuild.State != BuildStatePublished || existingBuild.CacheNamespace != build.CacheNamespace {
 			continue
 		}
 		if cachedBuild == nil {
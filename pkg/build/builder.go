@@ -30,6 +30,7 @@ type Builder struct {
 	loggerFactory               logs.LoggerFactory
 	defaultProjectImage         string
 	defaultProjectUser          string
+	buildKitConfig              BuildKitConfig
 }
 
 func (b *Builder) GetImageName(build Build) (string, error) {
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Label of the containers that run a build, used to stop them when the build is canceled
+const BuildExecLabel = "daytona.build.exec.id"
+
+// BuildKitConfig limits the resources of the BuildKit builders. Every cache namespace gets its own builder,
+// so the limits apply to the builds of each namespace separately
+type BuildKitConfig struct {
+	// 0 means no limit
+	Cpus float64
+	// 0 means no limit
+	MemoryMb int
+}
+
+var invalidBuilderNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// GetBuildKitBuilderName returns the name of the BuildKit builder of the cache namespace.
+// The hash keeps the names of namespaces that only differ in invalid characters apart
+func GetBuildKitBuilderName(cacheNamespace string) string {
+	if cacheNamespace == "" {
+		return "daytona-default"
+	}
+
+	name := invalidBuilderNameChars.ReplaceAllString(strings.ToLower(cacheNamespace), "-")
+	name = strings.Trim(name, "-")
+	if len(name) > 32 {
+		name = name[:32]
+	}
+
+	hash := sha256.Sum256([]byte(cacheNamespace))
+
+	return fmt.Sprintf("daytona-%s-%s", name, hex.EncodeToString(hash[:])[:8])
+}
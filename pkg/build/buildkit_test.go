@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBuildKitBuilderName(t *testing.T) {
+	require.Equal(t, "daytona-default", build.GetBuildKitBuilderName(""))
+
+	validName := regexp.MustCompile(`^[a-z0-9-]+$`)
+	for _, namespace := range []string{"alice", "Alice's laptop", "ci/prebuilds", "a very long api key name that exceeds the limit"} {
+		require.Regexp(t, validName, build.GetBuildKitBuilderName(namespace), namespace)
+	}
+
+	require.NotEqual(t, build.GetBuildKitBuilderName("ci/prebuilds"), build.GetBuildKitBuilderName("ci prebuilds"))
+	require.Equal(t, build.GetBuildKitBuilderName("alice"), build.GetBuildKitBuilderName("alice"))
+}
+
+func TestGetCachedBuildFromSameNamespace(t *testing.T) {
+	repository := &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona", Branch: "main"}
+
+	newBuild := &build.Build{Repository: repository, CacheNamespace: "alice"}
+	otherNamespaceBuild := &build.Build{
+		Repository:     repository,
+		State:          build.BuildStatePublished,
+		Image:          util.Pointer("bob-image"),
+		User:           util.Pointer("daytona"),
+		CacheNamespace: "bob",
+	}
+
+	require.Nil(t, build.GetCachedBuild(newBuild, []*build.Build{otherNamespaceBuild}))
+
+	sameNamespaceBuild := *otherNamespaceBuild
+	sameNamespaceBuild.Image = util.Pointer("alice-image")
+	sameNamespaceBuild.CacheNamespace = "alice"
+
+	cachedBuild := build.GetCachedBuild(newBuild, []*build.Build{otherNamespaceBuild, &sameNamespaceBuild})
+	require.NotNil(t, cachedBuild)
+	require.Equal(t, "alice-image", cachedBuild.Image)
+}
@@ -92,9 +92,17 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		IdLabels: map[string]string{
 			"daytona.build.id": build.Id,
 		},
+		ExecLabels: map[string]string{
+			BuildExecLabel: build.Id,
+		},
 		ProjectDir: b.projectDir,
 		LogWriter:  buildLogger,
 		EnvVars:    build.EnvVars,
+		BuildxBuilder: &docker.BuildxBuilder{
+			Name:     GetBuildKitBuilderName(build.CacheNamespace),
+			Cpus:     b.buildKitConfig.Cpus,
+			MemoryMb: b.buildKitConfig.MemoryMb,
+		},
 	})
 	if err != nil {
 		return b.defaultProjectImage, b.defaultProjectUser, err
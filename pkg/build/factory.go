@@ -27,6 +27,7 @@ type BuilderFactory struct {
 	image                       string
 	defaultProjectImage         string
 	defaultProjectUser          string
+	buildKitConfig              BuildKitConfig
 }
 
 type BuilderFactoryConfig struct {
@@ -38,6 +39,7 @@ type BuilderFactoryConfig struct {
 	LoggerFactory               logs.LoggerFactory
 	DefaultProjectImage         string
 	DefaultProjectUser          string
+	BuildKitConfig              BuildKitConfig
 }
 
 func NewBuilderFactory(config BuilderFactoryConfig) IBuilderFactory {
@@ -50,6 +52,7 @@ func NewBuilderFactory(config BuilderFactoryConfig) IBuilderFactory {
 		loggerFactory:               config.LoggerFactory,
 		defaultProjectImage:         config.DefaultProjectImage,
 		defaultProjectUser:          config.DefaultProjectUser,
+		buildKitConfig:              config.BuildKitConfig,
 	}
 }
 
@@ -98,6 +101,7 @@ func (f *BuilderFactory) newDevcontainerBuilder(projectDir string) (*Devcontaine
 			loggerFactory:               f.loggerFactory,
 			defaultProjectImage:         f.defaultProjectImage,
 			defaultProjectUser:          f.defaultProjectUser,
+			buildKitConfig:              f.buildKitConfig,
 		},
 		builderDockerPort: builderDockerPort,
 	}, nil
@@ -17,6 +17,8 @@ import (
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/scheduler"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	log "github.com/sirupsen/logrus"
@@ -82,7 +84,12 @@ func NewBuildRunner(config BuildRunnerInstanceConfig) *BuildRunner {
 }
 
 func (r *BuildRunner) Start() error {
-	err := r.scheduler.AddFunc(r.runInterval, func() { r.RunBuilds() })
+	err := r.requeueInterruptedBuilds()
+	if err != nil {
+		return err
+	}
+
+	err = r.scheduler.AddFunc(r.runInterval, func() { r.RunBuilds() })
 	if err != nil {
 		return err
 	}
@@ -90,6 +97,10 @@ func (r *BuildRunner) Start() error {
 	if err != nil {
 		return err
 	}
+	err = r.scheduler.AddFunc(r.runInterval, func() { r.CancelBuilds() })
+	if err != nil {
+		return err
+	}
 
 	r.scheduler.Start()
 	return nil
@@ -99,6 +110,33 @@ func (r *BuildRunner) Stop() {
 	r.scheduler.Stop()
 }
 
+// requeueInterruptedBuilds marks the builds that were in progress when the server stopped as pending,
+// so that they are run or deleted again
+func (r *BuildRunner) requeueInterruptedBuilds() error {
+	builds, err := r.buildStore.List(&Filter{
+		States: &[]BuildState{BuildStateRunning, BuildStateSuccess, BuildStateDeleting},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, b := range builds {
+		if b.State == BuildStateDeleting {
+			b.State = BuildStatePendingDelete
+		} else {
+			b.State = BuildStatePendingRun
+		}
+
+		log.Infof("Requeuing interrupted build %s", b.Id)
+		err = r.buildStore.Save(b)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *BuildRunner) RunBuilds() {
 	builds, err := r.buildStore.List(&Filter{
 		States: &[]BuildState{BuildStatePendingRun, BuildStatePublished},
@@ -228,6 +266,50 @@ func (r *BuildRunner) DeleteBuilds() {
 	wg.Wait()
 }
 
+// CancelBuilds stops the containers of the builds marked for cancellation. The build processes
+// then fail and mark the builds as canceled
+func (r *BuildRunner) CancelBuilds() {
+	builds, err := r.buildStore.List(&Filter{
+		States: &[]BuildState{BuildStatePendingCancel},
+	})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	if len(builds) == 0 {
+		return
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	for _, b := range builds {
+		containers, err := cli.ContainerList(context.Background(), container.ListOptions{
+			All:     true,
+			Filters: filters.NewArgs(filters.Arg("label", fmt.Sprintf("%s=%s", BuildExecLabel, b.Id))),
+		})
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		for _, c := range containers {
+			err = dockerClient.RemoveContainer(c.ID)
+			if err != nil {
+				log.Errorf("failed to stop build %s: %s", b.Id, err)
+			}
+		}
+	}
+}
+
 func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 	if config.Wg != nil {
 		defer config.Wg.Done()
@@ -259,12 +341,22 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 		return
 	}
 
+	if r.isCanceled(config.Build.Id) {
+		r.handleBuildCanceled(*config.Build, config.Builder, config.BuildLogger)
+		return
+	}
+
 	image, user, err := config.Builder.Build(*config.Build)
 	if err != nil {
 		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
 		return
 	}
 
+	if r.isCanceled(config.Build.Id) {
+		r.handleBuildCanceled(*config.Build, config.Builder, config.BuildLogger)
+		return
+	}
+
 	config.Build.Image = &image
 	config.Build.User = &user
 	config.Build.State = BuildStateSuccess
@@ -301,6 +393,12 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 }
 
 func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, buildLogger logs.Logger) {
+	// Canceled builds fail because their containers are stopped
+	if r.isCanceled(b.Id) {
+		r.handleBuildCanceled(b, builder, buildLogger)
+		return
+	}
+
 	var errMsg string
 	errMsg += "################################################\n"
 	errMsg += fmt.Sprintf("#### BUILD FAILED FOR %s: %s\n", b.Id, err.Error())
@@ -326,6 +424,33 @@ func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, bui
 	}
 }
 
+func (r *BuildRunner) handleBuildCanceled(b Build, builder IBuilder, buildLogger logs.Logger) {
+	b.State = BuildStateCanceled
+	err := r.buildStore.Save(&b)
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Error saving build: %s\n", err.Error())))
+	}
+
+	if builder != nil {
+		cleanupErr := builder.CleanUp()
+		if cleanupErr != nil {
+			buildLogger.Write([]byte(fmt.Sprintf("Error cleaning up build: %s\n", cleanupErr.Error())))
+		}
+	}
+
+	buildLogger.Write([]byte("\n \n" + lipgloss.NewStyle().Bold(true).Render("Build canceled") + "\n"))
+}
+
+// isCanceled returns true if the build was marked for cancellation since it was loaded
+func (r *BuildRunner) isCanceled(buildId string) bool {
+	b, err := r.buildStore.Find(&Filter{Id: &buildId})
+	if err != nil {
+		return false
+	}
+
+	return b.State == BuildStatePendingCancel || b.State == BuildStateCanceled
+}
+
 func (r *BuildRunner) logTelemetry(ctx context.Context, b Build, err error) {
 	telemetryProps := telemetry.NewBuildRunnerEventProps(ctx, b.Id, string(b.State))
 	event := telemetry.BuildRunnerEventRunBuild
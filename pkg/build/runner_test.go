@@ -88,6 +88,25 @@ func (s *BuildRunnerTestSuite) TestStart() {
 	s.mockScheduler.AssertExpectations(s.T())
 }
 
+func (s *BuildRunnerTestSuite) TestStartRequeuesInterruptedBuilds() {
+	s.mockScheduler.On("AddFunc", mock.Anything, mock.Anything).Return(nil)
+	s.mockScheduler.On("Start").Return()
+
+	require := s.Require()
+
+	runningBuild := *mocks.MockBuild
+	runningBuild.State = build.BuildStateRunning
+	err := s.mockBuildStore.Save(&runningBuild)
+	require.NoError(err)
+
+	err = s.Runner.Start()
+	require.NoError(err)
+
+	b, err := s.mockBuildStore.Find(&build.Filter{Id: &runningBuild.Id})
+	require.NoError(err)
+	require.Equal(build.BuildStatePendingRun, b.State)
+}
+
 func (s *BuildRunnerTestSuite) TestStop() {
 	s.mockScheduler.On("Stop").Return()
 
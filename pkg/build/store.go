@@ -33,6 +33,8 @@ type Filter struct {
 	RepositoryUrl *string
 	Branch        *string
 	EnvVars       *map[string]string
+	// Builds requested with other API keys are excluded
+	CacheNamespaces *[]string
 }
 
 func (f *Filter) StatesToInterface() []interface{} {
@@ -0,0 +1,139 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package client is a Go SDK for the Daytona Server API. It wraps the generated API client with
+// authentication, retries of transient failures and helpers for reading log streams, so tools can
+// manage Daytona programmatically without depending on the CLI.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/internal/util/endpoint"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+const USER_AGENT = "daytona-go-sdk"
+
+type RetryConfig struct {
+	// Maximum number of times a failed request is retried
+	MaxRetries int
+	// Backoff before the first retry, doubled on each subsequent retry
+	InitialBackoff time.Duration
+	// Upper bound for the backoff between two retries
+	MaxBackoff time.Duration
+}
+
+var DefaultRetryConfig = RetryConfig{
+	MaxRetries:     3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+type Config struct {
+	// URL of the Daytona Server API, e.g. http://localhost:3986
+	ServerUrl string
+	ApiKey    string
+	// Retries of failed requests. DefaultRetryConfig is used if nil
+	Retry *RetryConfig
+	// Transport used to reach the server. http.DefaultTransport is used if nil
+	Transport http.RoundTripper
+	// Sent to the server in the User-Agent header. USER_AGENT is used if empty
+	UserAgent string
+}
+
+type Client struct {
+	// Typed access to the Daytona Server API, e.g. client.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+	*apiclient.APIClient
+	config Config
+}
+
+// New creates a client for the Daytona Server. The server is not contacted, use Ping to check that it is reachable
+func New(config Config) (*Client, error) {
+	serverUrl, err := url.Parse(config.ServerUrl)
+	if err != nil || (serverUrl.Scheme != "http" && serverUrl.Scheme != "https") || serverUrl.Host == "" {
+		return nil, fmt.Errorf("invalid server URL: %s", config.ServerUrl)
+	}
+
+	if config.ApiKey == "" {
+		return nil, errors.New("API key is required")
+	}
+
+	if config.Retry == nil {
+		config.Retry = &DefaultRetryConfig
+	}
+
+	if config.Transport == nil {
+		config.Transport = http.DefaultTransport
+	}
+
+	if config.UserAgent == "" {
+		config.UserAgent = USER_AGENT
+	}
+
+	clientConfig := apiclient.NewConfiguration()
+	clientConfig.Servers = apiclient.ServerConfigurations{
+		{
+			URL: config.ServerUrl,
+		},
+	}
+	clientConfig.UserAgent = config.UserAgent
+	clientConfig.AddDefaultHeader("Authorization", fmt.Sprintf("Bearer %s", config.ApiKey))
+	clientConfig.AddDefaultHeader("X-Client-Version", internal.Version)
+	clientConfig.HTTPClient = &http.Client{
+		Transport: newRetryTransport(config.Transport, *config.Retry),
+	}
+
+	return &Client{
+		APIClient: apiclient.NewAPIClient(clientConfig),
+		config:    config,
+	}, nil
+}
+
+// NewFromProfile creates a client for the server of a CLI profile, including its transport, proxy or jump host
+func NewFromProfile(profile config.Profile) (*Client, error) {
+	if profile.E2EEncryption {
+		return nil, fmt.Errorf("profile '%s' uses end-to-end encryption, which is not supported by the SDK", profile.Name)
+	}
+
+	// Without the health check of the CLI, the first endpoint of the auto transport is used
+	endpoints, err := endpoint.Resolve(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(Config{
+		ServerUrl: endpoints[0].ServerUrl,
+		ApiKey:    profile.Api.Key,
+		Transport: endpoints[0].HttpTransport,
+	})
+}
+
+// NewFromActiveProfile creates a client for the server of the active CLI profile.
+// The DAYTONA_PROFILE, DAYTONA_API_URL and DAYTONA_API_KEY environment variables are respected
+func NewFromActiveProfile() (*Client, error) {
+	c, err := config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFromProfile(activeProfile)
+}
+
+// Ping checks that the server is reachable
+func (c *Client) Ping(ctx context.Context) error {
+	_, res, err := c.DefaultAPI.HealthCheck(ctx).Execute()
+	return ParseError(res, err)
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/constants"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/client"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryConfig = client.RetryConfig{
+	MaxRetries:     2,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     time.Millisecond,
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := client.New(client.Config{
+		ServerUrl: server.URL,
+		ApiKey:    "test-key",
+		Retry:     &testRetryConfig,
+	})
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestNew(t *testing.T) {
+	_, err := client.New(client.Config{ServerUrl: "localhost:3986", ApiKey: "key"})
+	require.Error(t, err)
+
+	_, err = client.New(client.Config{ServerUrl: "http://localhost:3986"})
+	require.Error(t, err)
+
+	_, err = client.New(client.Config{ServerUrl: "http://localhost:3986", ApiKey: "key"})
+	require.NoError(t, err)
+}
+
+func TestRetries(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"version":"v0.0.0"}`)) // nolint:errcheck
+	})
+
+	require.NoError(t, c.Ping(context.Background()))
+	require.Equal(t, 3, requests)
+}
+
+func TestRetriesWhileServerRestarts(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.Equal(t, http.MethodPost, r.Method)
+		// More rejected requests than the configured retries
+		if requests <= 3 {
+			w.Header().Set(constants.SERVER_STATUS_HEADER, constants.SERVER_STATUS_RESTARTING)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"workspace-1","name":"workspace-1","projects":[],"target":"local"}`)) // nolint:errcheck
+	})
+
+	_, _, err := c.WorkspaceAPI.CreateWorkspace(context.Background()).Workspace(apiclient.CreateWorkspaceDTO{
+		Id:       "workspace-1",
+		Name:     "workspace-1",
+		Target:   "local",
+		Projects: []apiclient.CreateProjectDTO{},
+	}).Execute()
+
+	require.NoError(t, err)
+	require.Equal(t, 4, requests)
+}
+
+func TestParseError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"workspace not found"}`)) // nolint:errcheck
+	})
+
+	_, res, err := c.WorkspaceAPI.GetWorkspace(context.Background(), "missing").Execute()
+	err = client.ParseError(res, err)
+
+	require.Equal(t, &client.Error{StatusCode: http.StatusNotFound, Message: "workspace not found"}, err)
+	require.True(t, client.IsNotFound(err))
+}
+
+func TestReadBuildLogsResumesAtOffset(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	connections := 0
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/log/build/build-1", r.URL.Path)
+		connections++
+
+		ws, err := upgrader.Upgrade(w, r, http.Header{logs.LogOffsetHeader: []string{r.URL.Query().Get("offset")}})
+		require.NoError(t, err)
+		defer ws.Close()
+
+		if connections == 1 {
+			require.Empty(t, r.URL.Query().Get("offset"))
+			require.NoError(t, ws.WriteJSON(logs.LogEntry{Msg: "first"}))
+			require.NoError(t, ws.WriteJSON(logs.LogEntry{Msg: "second"}))
+			// Drop the connection without closing the stream
+			return
+		}
+
+		require.Equal(t, "2", r.URL.Query().Get("offset"))
+		require.NoError(t, ws.WriteJSON(logs.LogEntry{Msg: "third"}))
+		require.NoError(t, ws.WriteJSON(logs.LogEntry{}))
+		require.NoError(t, ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+	})
+
+	messages := []string{}
+	err := c.ReadBuildLogs(context.Background(), "build-1", false, func(entry logs.LogEntry) {
+		messages = append(messages, entry.Msg)
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second", "third"}, messages)
+	require.Equal(t, 2, connections)
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Error is returned for requests that the Daytona Server responded to with an error status
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (status %d)", e.Message, e.StatusCode)
+}
+
+// ParseError converts the response and error returned by an API call to an *Error with the message of the server.
+// Returns requestErr if the server did not respond
+func ParseError(res *http.Response, requestErr error) error {
+	if requestErr == nil {
+		return nil
+	}
+
+	if res == nil {
+		return requestErr
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return requestErr
+	}
+
+	errResponse := struct {
+		Error string `json:"error"`
+	}{}
+
+	message := string(body)
+	if json.Unmarshal(body, &errResponse) == nil && errResponse.Error != "" {
+		message = errResponse.Error
+	}
+	if message == "" {
+		message = http.StatusText(res.StatusCode)
+	}
+
+	return &Error{StatusCode: res.StatusCode, Message: message}
+}
+
+// IsNotFound returns true if the server responded that the requested resource does not exist
+func IsNotFound(err error) bool {
+	var clientErr *Error
+	return errors.As(err, &clientErr) && clientErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,189 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// A stream that receives no pong within this period is considered disconnected
+	pongWait   = 30 * time.Second
+	pingPeriod = 10 * time.Second
+)
+
+// ReadWorkspaceLogs passes the log entries of the workspace, or of one of its projects if projectName is set, to
+// handleEntry. If follow is true, reads until the context is canceled. Interrupted streams are reconnected and
+// resume at the last received entry
+func (c *Client) ReadWorkspaceLogs(ctx context.Context, workspaceId, projectName string, follow bool, handleEntry func(logs.LogEntry)) error {
+	path := fmt.Sprintf("/log/workspace/%s", url.PathEscape(workspaceId))
+	if projectName != "" {
+		path += "/" + url.PathEscape(projectName)
+	}
+
+	return c.readLogStream(ctx, path, follow, handleEntry)
+}
+
+// ReadBuildLogs passes the log entries of the build to handleEntry. If follow is true, reads until the context
+// is canceled. Interrupted streams are reconnected and resume at the last received entry
+func (c *Client) ReadBuildLogs(ctx context.Context, buildId string, follow bool, handleEntry func(logs.LogEntry)) error {
+	return c.readLogStream(ctx, fmt.Sprintf("/log/build/%s", url.PathEscape(buildId)), follow, handleEntry)
+}
+
+func (c *Client) readLogStream(ctx context.Context, path string, follow bool, handleEntry func(logs.LogEntry)) error {
+	received := 0
+	failures := 0
+	// Set when the server reports that it is restarting, until then the stream is reconnected regardless of failures
+	var restartDeadline time.Time
+
+	for {
+		query := url.Values{}
+		if follow {
+			query.Set("follow", "true")
+		}
+		if received > 0 {
+			query.Set("offset", strconv.Itoa(received))
+		}
+
+		ws, res, err := c.dialWebsocket(ctx, path, query)
+		if err == nil {
+			// Servers that do not support offsets send the log from the beginning
+			offset := 0
+			if res.Header.Get(logs.LogOffsetHeader) != "" {
+				offset = received
+			}
+
+			var read int
+			read, err = readLogEntries(ctx, ws, received-offset, handleEntry)
+			if offset+read > received {
+				received = offset + read
+			}
+
+			if err == nil {
+				return nil
+			}
+			if read > 0 {
+				failures = 0
+			}
+		} else if res != nil && res.StatusCode >= http.StatusBadRequest && res.StatusCode < http.StatusInternalServerError {
+			return ParseError(res, err)
+		}
+
+		if (isServiceRestart(err) || IsServerRestarting(res)) && restartDeadline.IsZero() {
+			restartDeadline = time.Now().Add(ServerRestartTimeout)
+		}
+
+		if ctx.Err() != nil {
+			if follow {
+				return nil
+			}
+			return ctx.Err()
+		}
+
+		if !follow && failures >= c.config.Retry.MaxRetries && !time.Now().Before(restartDeadline) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(util.GetJitteredBackoff(c.config.Retry.InitialBackoff, c.config.Retry.MaxBackoff, failures)):
+		}
+		failures++
+	}
+}
+
+func (c *Client) dialWebsocket(ctx context.Context, path string, query url.Values) (*websocket.Conn, *http.Response, error) {
+	wsUrl, err := url.JoinPath(c.config.ServerUrl, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if strings.HasPrefix(wsUrl, "https://") {
+		wsUrl = "wss://" + strings.TrimPrefix(wsUrl, "https://")
+	} else {
+		wsUrl = "ws://" + strings.TrimPrefix(wsUrl, "http://")
+	}
+
+	dialer := *websocket.DefaultDialer
+	if transport, ok := c.config.Transport.(*http.Transport); ok {
+		dialer.Proxy = transport.Proxy
+		dialer.NetDialContext = transport.DialContext
+		dialer.TLSClientConfig = transport.TLSClientConfig
+	}
+
+	return dialer.DialContext(ctx, wsUrl+"?"+query.Encode(), http.Header{
+		"Authorization": []string{fmt.Sprintf("Bearer %s", c.config.ApiKey)},
+		"User-Agent":    []string{c.config.UserAgent},
+	})
+}
+
+// readLogEntries passes the entries read from the websocket to handleEntry, skipping the given number of entries,
+// and closes the websocket. Returns the number of entries read and nil if the stream was closed normally
+func readLogEntries(ctx context.Context, ws *websocket.Conn, skip int, handleEntry func(logs.LogEntry)) (int, error) {
+	done := make(chan struct{})
+	defer close(done)
+	defer ws.Close()
+
+	// Pings detect connections that dropped without being closed
+	ws.SetReadDeadline(time.Now().Add(pongWait)) // nolint:errcheck
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	go func() {
+		ticker := time.NewTicker(pingPeriod)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				ws.Close()
+				return
+			case <-ticker.C:
+				_ = ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second))
+			}
+		}
+	}()
+
+	read := 0
+
+	for {
+		var logEntry logs.LogEntry
+		err := ws.ReadJSON(&logEntry)
+
+		// The server sends an empty entry when the end of the log is reached
+		if logEntry != (logs.LogEntry{}) {
+			read++
+			if read > skip {
+				handleEntry(logEntry)
+			}
+		}
+
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return read, nil
+			}
+			return read, fmt.Errorf("log stream interrupted: %w", err)
+		}
+	}
+}
+
+// isServiceRestart returns true if the stream was closed because the server is restarting
+func isServiceRestart(err error) bool {
+	var closeErr *websocket.CloseError
+	return errors.As(err, &closeErr) && closeErr.Code == websocket.CloseServiceRestart
+}
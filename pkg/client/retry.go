@@ -0,0 +1,157 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/constants"
+	"github.com/daytonaio/daytona/internal/util"
+)
+
+// Time requests keep being retried after the server reported that it is restarting
+const ServerRestartTimeout = 2 * time.Minute
+
+type retryTransport struct {
+	base   http.RoundTripper
+	config RetryConfig
+}
+
+func newRetryTransport(base http.RoundTripper, config RetryConfig) http.RoundTripper {
+	return &retryTransport{base: base, config: config}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var restartDeadline time.Time
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.base.RoundTrip(req)
+
+		if IsServerRestarting(res) && restartDeadline.IsZero() {
+			restartDeadline = time.Now().Add(ServerRestartTimeout)
+		}
+		restarting := time.Now().Before(restartDeadline)
+
+		if !IsRetryable(req, res, err) || (attempt >= t.config.MaxRetries && !restarting) {
+			return res, err
+		}
+
+		if res != nil {
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(max(util.GetJitteredBackoff(t.config.InitialBackoff, t.config.MaxBackoff, attempt), RetryAfter(res))):
+		}
+
+		if req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// IsRetryable returns true if the request failed with a transient error and can be sent again safely.
+// Requests that never reached the server are always retryable, others only if they are idempotent
+func IsRetryable(req *http.Request, res *http.Response, err error) bool {
+	if req.Body != nil && req.GetBody == nil {
+		// The request body can't be replayed
+		return false
+	}
+
+	if IsServerRestarting(res) {
+		return true
+	}
+
+	// Rate limited requests were not processed so they can be retried regardless of the method
+	if res != nil && res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+
+		if !isIdempotent(req.Method) {
+			return false
+		}
+
+		return errors.Is(err, syscall.ECONNREFUSED) ||
+			errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, io.ErrUnexpectedEOF) ||
+			errors.Is(err, io.EOF)
+	}
+
+	if !isIdempotent(req.Method) {
+		return false
+	}
+
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// IsServerRestarting returns true if the request was rejected because the server is shutting down.
+// Rejected requests were not processed so they can be retried regardless of the method
+func IsServerRestarting(res *http.Response) bool {
+	return res != nil && res.StatusCode == http.StatusServiceUnavailable && res.Header.Get(constants.SERVER_STATUS_HEADER) == constants.SERVER_STATUS_RESTARTING
+}
+
+// RetryAfter returns the delay requested by the server in the Retry-After header of the response.
+// Zero is returned if the header is missing or invalid. The delay is capped at ServerRestartTimeout
+func RetryAfter(res *http.Response) time.Duration {
+	if res == nil {
+		return 0
+	}
+
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(header); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		delay = time.Until(date)
+	}
+
+	if delay < 0 {
+		return 0
+	}
+
+	return min(delay, ServerRestartTimeout)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
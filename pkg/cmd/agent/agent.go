@@ -16,10 +16,12 @@ import (
 	"github.com/daytonaio/daytona/pkg/agent/ssh"
 	"github.com/daytonaio/daytona/pkg/agent/tailscale"
 	"github.com/daytonaio/daytona/pkg/agent/toolbox"
+	"github.com/daytonaio/daytona/pkg/e2e"
 	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	gossh "golang.org/x/crypto/ssh"
 )
 
 var hostModeFlag bool
@@ -43,6 +45,10 @@ var AgentCmd = &cobra.Command{
 		}
 		c.ProjectDir = filepath.Join(os.Getenv("HOME"), c.ProjectName)
 
+		if !hostModeFlag {
+			setupAutocompletion(cmd.Root())
+		}
+
 		if projectDir := os.Getenv("DAYTONA_PROJECT_DIR"); projectDir != "" {
 			c.ProjectDir = projectDir
 		}
@@ -71,9 +77,27 @@ var AgentCmd = &cobra.Command{
 			LogWriter:         gitLogWriter,
 		}
 
+		telemetryEnabled := os.Getenv("DAYTONA_TELEMETRY_ENABLED") == "true"
+
+		keyAuthorizer := &ssh.ServerKeyAuthorizer{
+			Server:           c.Server,
+			WorkspaceId:      c.WorkspaceId,
+			ClientId:         c.ClientId,
+			TelemetryEnabled: telemetryEnabled,
+		}
+
 		sshServer := &ssh.Server{
 			ProjectDir:        c.ProjectDir,
 			DefaultProjectDir: os.Getenv("HOME"),
+			IsAuthorizedKey:   keyAuthorizer.IsAuthorized,
+		}
+
+		hostKey, err := ssh.LoadOrCreateHostKey(filepath.Join(os.Getenv("HOME"), ".daytona", "ssh_host_ed25519_key"))
+		if err != nil {
+			log.Warnf("SSH host key can't be pinned by clients: %s", err)
+		} else {
+			sshServer.HostKey = hostKey
+			log.Infof("SSH host key fingerprint: %s", gossh.FingerprintSHA256(hostKey.PublicKey()))
 		}
 
 		tailscaleHostname := project.GetProjectHostname(c.WorkspaceId, c.ProjectName)
@@ -81,11 +105,18 @@ var AgentCmd = &cobra.Command{
 			tailscaleHostname = c.WorkspaceId
 		}
 
-		toolBoxServer := &toolbox.Server{
-			ProjectDir: c.ProjectDir,
+		e2eKey, err := e2e.LoadOrCreateKey(filepath.Join(os.Getenv("HOME"), ".daytona", "e2e_key"))
+		if err != nil {
+			log.Warnf("End-to-end encryption of toolbox requests is disabled: %s", err)
+		} else {
+			log.Infof("End-to-end encryption key fingerprint: %s", e2e.Fingerprint(e2eKey.PublicKey()))
 		}
 
-		telemetryEnabled := os.Getenv("DAYTONA_TELEMETRY_ENABLED") == "true"
+		toolBoxServer := &toolbox.Server{
+			ProjectDir:  c.ProjectDir,
+			E2EKey:      e2eKey,
+			E2ERequired: c.E2ERequired,
+		}
 
 		tailscaleServer := &tailscale.Server{
 			Hostname:         tailscaleHostname,
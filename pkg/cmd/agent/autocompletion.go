@@ -0,0 +1,31 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"os/exec"
+
+	"github.com/daytonaio/daytona/pkg/cmd/autocomplete"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var projectShells = []string{"bash", "zsh", "fish"}
+
+// setupAutocompletion installs the workspace mode CLI completion script for every shell
+// available in the project so that Daytona commands are completable in SSH sessions
+func setupAutocompletion(rootCmd *cobra.Command) {
+	for _, shell := range projectShells {
+		if _, err := exec.LookPath(shell); err != nil {
+			continue
+		}
+
+		_, err := autocomplete.SetupAutocompletionForShell(rootCmd, shell)
+		if err != nil {
+			log.Debugf("Failed to set up %s autocompletion: %s", shell, err)
+		}
+	}
+}
@@ -16,6 +16,8 @@ import (
 	view "github.com/daytonaio/daytona/pkg/views/apikey"
 )
 
+var readOnlyFlag bool
+
 var GenerateCmd = &cobra.Command{
 	Use:     "generate [NAME]",
 	Short:   "Generate a new API key",
@@ -47,7 +49,7 @@ var GenerateCmd = &cobra.Command{
 			}
 		}
 
-		key, _, err := apiClient.ApiKeyAPI.GenerateApiKey(ctx, keyName).Execute()
+		key, _, err := apiClient.ApiKeyAPI.GenerateApiKey(ctx, keyName).ReadOnly(readOnlyFlag).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(nil, err)
 		}
@@ -67,3 +69,7 @@ var GenerateCmd = &cobra.Command{
 		return nil
 	},
 }
+
+func init() {
+	GenerateCmd.Flags().BoolVar(&readOnlyFlag, "read-only", false, "Generate a key that can only be used to view workspaces and connect to them")
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/apikeys"
+	"github.com/daytonaio/daytona/internal/i18n"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/common"
@@ -75,11 +76,11 @@ var revokeCmd = &cobra.Command{
 		}
 
 		if !yesFlag {
-			title := fmt.Sprintf("Revoke API Key '%s'?", selectedApiKey.Name)
-			description := fmt.Sprintf("Are you sure you want to revoke '%s'?", selectedApiKey.Name)
+			title := i18n.T("Revoke API Key '%s'?", selectedApiKey.Name)
+			description := i18n.T("Are you sure you want to revoke '%s'?", selectedApiKey.Name)
 			if apikeys.EqualsKeyHashFromApi(activeProfile.Api.Key, selectedApiKey.KeyHash) {
-				title = fmt.Sprintf("Warning! API Key '%s' is attached to your active profile", selectedApiKey.Name)
-				description = fmt.Sprintf("Revoking '%s' will lock out your active profile from accessing the server.", selectedApiKey.Name)
+				title = i18n.T("Warning! API Key '%s' is attached to your active profile", selectedApiKey.Name)
+				description = i18n.T("Revoking '%s' will lock out your active profile from accessing the server.", selectedApiKey.Name)
 			}
 
 			form := huh.NewForm(
@@ -105,7 +106,7 @@ var revokeCmd = &cobra.Command{
 
 			views.RenderInfoMessage("API key revoked")
 		} else {
-			fmt.Println("Operation canceled.")
+			fmt.Println(i18n.T("Operation canceled."))
 		}
 
 		return nil
@@ -84,6 +84,11 @@ func SetupAutocompletionForShell(rootCmd *cobra.Command, shell string) (string,
 		return "", errors.New("unsupported shell type. Please use bash, zsh, fish, or powershell")
 	}
 
+	err = os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		return "", fmt.Errorf("error creating completion script directory: %s", err)
+	}
+
 	file, err := os.Create(filePath)
 	if err != nil {
 		return "", fmt.Errorf("error creating completion script file: %s", err)
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -51,7 +52,7 @@ var buildRunCmd = &cobra.Command{
 		}
 
 		if chosenBranch == nil {
-			fmt.Println("Operation canceled")
+			fmt.Println(i18n.T("Operation canceled"))
 			return nil
 		}
 
@@ -13,25 +13,35 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/internal/i18n"
 	. "github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
 	. "github.com/daytonaio/daytona/pkg/cmd/apikey"
 	. "github.com/daytonaio/daytona/pkg/cmd/autocomplete"
 	. "github.com/daytonaio/daytona/pkg/cmd/build"
 	. "github.com/daytonaio/daytona/pkg/cmd/containerregistry"
 	. "github.com/daytonaio/daytona/pkg/cmd/gitprovider"
+	. "github.com/daytonaio/daytona/pkg/cmd/group"
 	. "github.com/daytonaio/daytona/pkg/cmd/ports"
 	. "github.com/daytonaio/daytona/pkg/cmd/prebuild"
 	. "github.com/daytonaio/daytona/pkg/cmd/profile"
 	. "github.com/daytonaio/daytona/pkg/cmd/profiledata/env"
+	. "github.com/daytonaio/daytona/pkg/cmd/project"
 	. "github.com/daytonaio/daytona/pkg/cmd/projectconfig"
 	. "github.com/daytonaio/daytona/pkg/cmd/provider"
+	. "github.com/daytonaio/daytona/pkg/cmd/report"
+	. "github.com/daytonaio/daytona/pkg/cmd/secret"
 	. "github.com/daytonaio/daytona/pkg/cmd/server"
+	. "github.com/daytonaio/daytona/pkg/cmd/snapshot"
+	. "github.com/daytonaio/daytona/pkg/cmd/sshconfig"
+	. "github.com/daytonaio/daytona/pkg/cmd/stdio"
 	. "github.com/daytonaio/daytona/pkg/cmd/target"
 	. "github.com/daytonaio/daytona/pkg/cmd/telemetry"
 	. "github.com/daytonaio/daytona/pkg/cmd/workspace"
 	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/posthogservice"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/views"
 	view "github.com/daytonaio/daytona/pkg/views/initial"
 	log "github.com/sirupsen/logrus"
 
@@ -54,12 +64,26 @@ func Execute() error {
 	rootCmd.AddGroup(&cobra.Group{ID: PROFILE_GROUP, Title: "Profile"})
 
 	rootCmd.AddCommand(CodeCmd)
+	rootCmd.AddCommand(CpCmd)
+	rootCmd.AddCommand(OpenFileCmd)
+	rootCmd.AddCommand(SyncCmd)
 	rootCmd.AddCommand(SshCmd)
 	rootCmd.AddCommand(SshProxyCmd)
+	rootCmd.AddCommand(SshConfigCmd)
 	rootCmd.AddCommand(CreateCmd)
+	rootCmd.AddCommand(ApplyCmd)
+	rootCmd.AddCommand(LabelCmd)
+	rootCmd.AddCommand(CloneCmd)
+	rootCmd.AddCommand(InviteCmd)
+	rootCmd.AddCommand(ShareCmd)
+	rootCmd.AddCommand(JoinCmd)
+	rootCmd.AddCommand(InstallCmd)
 	rootCmd.AddCommand(DeleteCmd)
+	rootCmd.AddCommand(RenameCmd)
 	rootCmd.AddCommand(ProjectConfigCmd)
+	rootCmd.AddCommand(ProjectCmd)
 	rootCmd.AddCommand(ServeCmd)
+	rootCmd.AddCommand(ServeStdioCmd)
 	rootCmd.AddCommand(DaemonServeCmd)
 	rootCmd.AddCommand(ServerCmd)
 	rootCmd.AddCommand(ApiKeyCmd)
@@ -68,23 +92,44 @@ func Execute() error {
 	rootCmd.AddCommand(TargetCmd)
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(ideCmd)
+	rootCmd.AddCommand(themeCmd)
 	rootCmd.AddCommand(ProfileCmd)
 	rootCmd.AddCommand(ProfileUseCmd)
 	rootCmd.AddCommand(whoamiCmd)
+	rootCmd.AddCommand(doctorCmd)
+	rootCmd.AddCommand(updateCmd)
 	rootCmd.AddCommand(purgeCmd)
 	rootCmd.AddCommand(GitProviderCmd)
 	rootCmd.AddCommand(StartCmd)
 	rootCmd.AddCommand(StopCmd)
 	rootCmd.AddCommand(RestartCmd)
+	rootCmd.AddCommand(UpgradeTemplateCmd)
 	rootCmd.AddCommand(InfoCmd)
+	rootCmd.AddCommand(StatusCmd)
+	rootCmd.AddCommand(EventsCmd)
 	rootCmd.AddCommand(PrebuildCmd)
 	rootCmd.AddCommand(BuildCmd)
+	rootCmd.AddCommand(GroupCmd)
+	rootCmd.AddCommand(SnapshotCmd)
+	rootCmd.AddCommand(ReportCmd)
 	rootCmd.AddCommand(PortForwardCmd)
+	rootCmd.AddCommand(PortsCmd)
+	rootCmd.AddCommand(AutoForwardCmd)
 	rootCmd.AddCommand(EnvCmd)
+	rootCmd.AddCommand(SecretCmd)
 	rootCmd.AddCommand(TelemetryCmd)
+	rootCmd.AddCommand(pluginCmd)
 
 	SetupRootCommand(rootCmd)
 
+	if p, args, ok := findPlugin(rootCmd, os.Args[1:]); ok {
+		exitCode, err := p.Run(args)
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T("failed to run plugin '%s'", p.Name), err)
+		}
+		os.Exit(exitCode)
+	}
+
 	startTime := time.Now()
 	clientId := config.GetClientId()
 	telemetryEnabled := config.TelemetryEnabled()
@@ -157,6 +202,48 @@ func SetupRootCommand(cmd *cobra.Command) {
 	cmd.CompletionOptions.HiddenDefaultCmd = true
 	cmd.PersistentFlags().BoolP("help", "", false, "help for daytona")
 	cmd.Flags().BoolP("version", "v", false, "Display the version of Daytona")
+	cmd.PersistentFlags().Duration("timeout", 0, "Maximum time to wait on the Daytona Server, including retries (e.g. 30s, 2m). 0 means no limit")
+	cmd.PersistentFlags().Int("max-retries", apiclient.DefaultRetryConfig.MaxRetries, "Maximum number of retries for transient Daytona Server errors")
+	cmd.PersistentFlags().Bool("plain", false, "Print plain output without colors, borders or spinners. Tables are printed as tab-separated lines")
+	cmd.PersistentFlags().Bool("dry-run", false, "Print the requests that would change the Daytona Server state and the affected SSH config entries without executing them")
+	cmd.PersistentFlags().Bool("no-color", false, "Disable colors in the output. Colors are also disabled if the NO_COLOR environment variable is set")
+
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		maxRetries, _ := cmd.Flags().GetInt("max-retries")
+		if maxRetries < 0 {
+			return fmt.Errorf("invalid value for --max-retries: %d", maxRetries)
+		}
+
+		retryConfig := apiclient.DefaultRetryConfig
+		retryConfig.MaxRetries = maxRetries
+		apiclient.SetRetryConfig(retryConfig)
+		apiclient.SetTimeout(timeout)
+
+		noColor, _ := cmd.Flags().GetBool("no-color")
+		applyTheme(noColor)
+
+		err := checkRestrictedProfile(cmd)
+		if err != nil {
+			return err
+		}
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if dryRun {
+			apiclient.EnableDryRun()
+		}
+
+		plain, _ := cmd.Flags().GetBool("plain")
+		if plain {
+			views.EnablePlainOutput()
+		}
+
+		if shouldRunSetupWizard(cmd) {
+			return runSetupWizard(cmd)
+		}
+
+		return nil
+	}
 
 	cmd.PreRun = func(cmd *cobra.Command, args []string) {
 		versionFlag, _ := cmd.Flags().GetBool("version")
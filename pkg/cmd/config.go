@@ -4,8 +4,14 @@
 package cmd
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/daytonaio/daytona/pkg/views"
 	config_view "github.com/daytonaio/daytona/pkg/views/config"
 	"github.com/spf13/cobra"
 )
@@ -13,8 +19,22 @@ import (
 var showApiKeysFlag bool
 
 var configCmd = &cobra.Command{
-	Use:     "config",
-	Short:   "Output Daytona configuration",
+	Use:   "config",
+	Short: "Output Daytona configuration",
+	Long: `Output Daytona configuration
+
+Configuration values can be overridden with environment variables, which allows the CLI to be used without a config file:
+  DAYTONA_PROFILE            ID or name of the profile to use
+  DAYTONA_API_URL            Server API URL of the active profile
+  DAYTONA_API_KEY            Server API key of the active profile
+  DAYTONA_E2E_ENCRYPTION     Enable end-to-end encryption of toolbox requests (true/false)
+  DAYTONA_PROXY_JUMP         Jump host used to reach the server of the active profile
+  DAYTONA_DEFAULT_IDE        Default IDE
+  DAYTONA_TELEMETRY_ENABLED  Enable telemetry (true/false)
+  DAYTONA_CONFIG_DIR         Directory of the config file
+
+Command flags take precedence over environment variables, which take precedence over the config file.
+API keys of profiles can be kept out of the config file with 'daytona config credentials-store'.`,
 	Aliases: []string{"cfg"},
 	Args:    cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -41,7 +61,136 @@ var configCmd = &cobra.Command{
 	},
 }
 
+var credentialsStoreCmd = &cobra.Command{
+	Use:   "credentials-store [HELPER|none]",
+	Short: "Store the API keys of profiles with a credential helper",
+	Long: `Store the API keys of profiles with a Docker credential helper instead of the config file, e.g. osxkeychain, wincred, secretservice or pass.
+The docker-credential-<HELPER> program must be in PATH. Use "none" to move the API keys back to the config file.
+Without arguments, the current credentials store is printed.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 0 {
+			if c.CredentialsStore == "" {
+				views.RenderInfoMessage("API keys are stored in the config file")
+			} else {
+				views.RenderInfoMessage(fmt.Sprintf("API keys are stored with docker-credential-%s", c.CredentialsStore))
+			}
+			return nil
+		}
+
+		helper := args[0]
+		if helper == "none" {
+			helper = ""
+		}
+
+		err = c.SetCredentialsStore(helper)
+		if err != nil {
+			return err
+		}
+
+		if helper == "" {
+			views.RenderInfoMessageBold("API keys moved to the config file")
+		} else {
+			views.RenderInfoMessageBold(fmt.Sprintf("API keys moved to docker-credential-%s", helper))
+		}
+		return nil
+	},
+}
+
+var autoForwardEnabledFlag bool
+var autoForwardAllowFlag []string
+var autoForwardIgnoreFlag []string
+var autoForwardOpenBrowserFlag bool
+
+var autoForwardCmd = &cobra.Command{
+	Use:   "auto-forward",
+	Short: "Configure the forwarding of ports detected in projects",
+	Long: `Configure the forwarding of ports that start listening in a project while an SSH or IDE connection to it is open.
+Ports declared on projects are always forwarded. The allow and ignore lists contain ports or port ranges, e.g. 3000 or 8000-8999.
+Flags replace the current lists, pass an empty value to clear a list. Without flags, the current configuration is printed.`,
+	Example: `  daytona config auto-forward --ignore 5432 --ignore 6379
+  daytona config auto-forward --allow 3000-3999 --allow 8080
+  daytona config auto-forward --open-browser
+  daytona config auto-forward --enabled=false`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		if cmd.Flags().NFlag() == 0 {
+			renderAutoForwardConfig(c.PortsAutoForward)
+			return nil
+		}
+
+		if cmd.Flags().Changed("enabled") {
+			c.PortsAutoForward.Disabled = !autoForwardEnabledFlag
+		}
+
+		if cmd.Flags().Changed("allow") {
+			c.PortsAutoForward.Allow = slices.DeleteFunc(autoForwardAllowFlag, func(s string) bool { return s == "" })
+		}
+
+		if cmd.Flags().Changed("ignore") {
+			c.PortsAutoForward.Ignore = slices.DeleteFunc(autoForwardIgnoreFlag, func(s string) bool { return s == "" })
+		}
+
+		if cmd.Flags().Changed("open-browser") {
+			c.PortsAutoForward.OpenBrowser = autoForwardOpenBrowserFlag
+		}
+
+		_, err = ports.NewFilter(c.PortsAutoForward.Allow, c.PortsAutoForward.Ignore)
+		if err != nil {
+			return err
+		}
+
+		err = c.Save()
+		if err != nil {
+			return err
+		}
+
+		renderAutoForwardConfig(c.PortsAutoForward)
+		return nil
+	},
+}
+
+func renderAutoForwardConfig(autoForward config.PortsAutoForward) {
+	if autoForward.Disabled {
+		views.RenderInfoMessage("Only the ports declared on projects are forwarded")
+		return
+	}
+
+	allowed := "all"
+	if len(autoForward.Allow) > 0 {
+		allowed = strings.Join(autoForward.Allow, ", ")
+	}
+
+	ignored := "none"
+	if len(autoForward.Ignore) > 0 {
+		ignored = strings.Join(autoForward.Ignore, ", ")
+	}
+
+	message := fmt.Sprintf("Detected ports are forwarded while a project is connected\nAllowed ports: %s\nIgnored ports: %s", allowed, ignored)
+	if autoForward.OpenBrowser {
+		message += "\nDetected ports are opened in the default browser"
+	}
+
+	views.RenderInfoMessage(message)
+}
+
 func init() {
 	format.RegisterFormatFlag(configCmd)
+	configCmd.AddCommand(credentialsStoreCmd)
+	configCmd.AddCommand(autoForwardCmd)
+	autoForwardCmd.Flags().BoolVar(&autoForwardEnabledFlag, "enabled", true, "Forward the ports detected in projects")
+	autoForwardCmd.Flags().StringArrayVar(&autoForwardAllowFlag, "allow", []string{}, "Port or port range to forward when detected. If set, other detected ports are not forwarded")
+	autoForwardCmd.Flags().StringArrayVar(&autoForwardIgnoreFlag, "ignore", []string{}, "Port or port range to not forward when detected")
+	autoForwardCmd.Flags().BoolVar(&autoForwardOpenBrowserFlag, "open-browser", false, "Open detected ports in the default browser once they are forwarded")
 	configCmd.Flags().BoolVarP(&showApiKeysFlag, "show-api-keys", "k", false, "Show API keys")
 }
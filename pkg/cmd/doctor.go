@@ -0,0 +1,352 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/doctor"
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose problems with the CLI setup and the connection to the Daytona Server",
+	Long:  "Checks the config file, SSH key permissions, the SSH config include, the reachability of the Daytona Server, the version of the CLI and the server and the Docker availability on the server host, and prints how to fix each problem",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checks := []view.Check{}
+
+		configCheck, profile := checkConfig()
+		checks = append(checks, configCheck)
+		checks = append(checks, checkSshPermissions(profile))
+		checks = append(checks, checkSshConfigInclude())
+		checks = append(checks, checkServer(profile)...)
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(checks)
+			formattedData.Print()
+		} else {
+			view.Render(checks)
+		}
+
+		failed := 0
+		for _, check := range checks {
+			if check.Status == view.CheckFailed {
+				failed++
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("%d of %d checks failed", failed, len(checks))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(doctorCmd)
+}
+
+func checkConfig() (view.Check, *config.Profile) {
+	check := view.Check{Name: "Config file"}
+
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		check.Status = view.CheckFailed
+		check.Message = err.Error()
+		return check, nil
+	}
+	configPath := filepath.Join(configDir, "config.json")
+
+	c, err := config.GetConfig()
+	if err != nil {
+		check.Status = view.CheckFailed
+		check.Message = fmt.Sprintf("Failed to read %s: %s", configPath, err)
+		check.Fix = fmt.Sprintf("Fix the syntax of %s or move it away to start with a new config", configPath)
+		return check, nil
+	}
+
+	profile, err := c.GetActiveProfile()
+	if err != nil {
+		check.Status = view.CheckFailed
+		check.Message = err.Error()
+		check.Fix = "Add a profile with 'daytona profile add' or select an existing one with 'daytona profile use'"
+		return check, nil
+	}
+
+	if profile.Api.Url == "" || profile.Api.Key == "" {
+		check.Status = view.CheckFailed
+		check.Message = fmt.Sprintf("Profile %s has no server API URL or API key", profile.Name)
+		check.Fix = fmt.Sprintf("Set the server API URL and API key with 'daytona profile edit %s'", profile.Name)
+		return check, &profile
+	}
+
+	check.Status = view.CheckPassed
+	check.Message = fmt.Sprintf("%s is valid, the active profile is %s", configPath, profile.Name)
+	return check, &profile
+}
+
+func checkSshPermissions(profile *config.Profile) view.Check {
+	check := view.Check{Name: "SSH permissions"}
+
+	if runtime.GOOS == "windows" {
+		check.Status = view.CheckPassed
+		check.Message = "File permissions are not checked on Windows"
+		return check
+	}
+
+	sshDir := filepath.Join(config.SshHomeDir, ".ssh")
+	info, err := os.Stat(sshDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			check.Status = view.CheckPassed
+			check.Message = fmt.Sprintf("%s does not exist yet", sshDir)
+			return check
+		}
+		check.Status = view.CheckFailed
+		check.Message = err.Error()
+		return check
+	}
+
+	insecure := []string{}
+	fixes := []string{}
+
+	if info.Mode().Perm()&0077 != 0 {
+		insecure = append(insecure, sshDir)
+		fixes = append(fixes, fmt.Sprintf("chmod 700 %s", sshDir))
+	}
+
+	// SSH refuses to use config files that can be modified by other users
+	configPaths := []string{filepath.Join(sshDir, "config")}
+	if daytonaSshConfigPath, err := config.GetSshConfigPath(); err == nil {
+		configPaths = append(configPaths, daytonaSshConfigPath)
+	}
+
+	for _, path := range configPaths {
+		if info, err := os.Stat(path); err == nil && info.Mode().Perm()&0022 != 0 {
+			insecure = append(insecure, path)
+			fixes = append(fixes, fmt.Sprintf("chmod 600 %s", path))
+		}
+	}
+
+	// SSH refuses to use private keys that can be read by other users
+	keys := []string{}
+	if entries, err := os.ReadDir(sshDir); err == nil {
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasPrefix(entry.Name(), "id_") && !strings.HasSuffix(entry.Name(), ".pub") {
+				keys = append(keys, filepath.Join(sshDir, entry.Name()))
+			}
+		}
+	}
+	if profile != nil && profile.ProxyJumpIdentityFile != "" {
+		keys = append(keys, expandHomeDir(profile.ProxyJumpIdentityFile))
+	}
+
+	for _, key := range keys {
+		if info, err := os.Stat(key); err == nil && info.Mode().Perm()&0077 != 0 {
+			insecure = append(insecure, key)
+			fixes = append(fixes, fmt.Sprintf("chmod 600 %s", key))
+		}
+	}
+
+	if len(insecure) > 0 {
+		check.Status = view.CheckFailed
+		check.Message = fmt.Sprintf("Permissions are too open on %s", strings.Join(insecure, ", "))
+		check.Fix = fmt.Sprintf("Run '%s'", strings.Join(fixes, " && "))
+		return check
+	}
+
+	check.Status = view.CheckPassed
+	check.Message = fmt.Sprintf("%s and the keys in it are only accessible by you", sshDir)
+	return check
+}
+
+func checkSshConfigInclude() view.Check {
+	check := view.Check{Name: "SSH config"}
+
+	sshConfigPath := filepath.Join(config.SshHomeDir, ".ssh", "config")
+	fix := "Run 'daytona ssh-config regenerate' to include it at the top of the SSH config"
+
+	daytonaSshConfigPath, err := config.GetSshConfigPath()
+	if err != nil {
+		check.Status = view.CheckFailed
+		check.Message = err.Error()
+		return check
+	}
+
+	file, err := os.Open(sshConfigPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			check.Status = view.CheckWarning
+			check.Message = fmt.Sprintf("%s does not exist", sshConfigPath)
+			check.Fix = "It is created the next time you connect to a workspace with 'daytona ssh' or 'daytona code'"
+			return check
+		}
+		check.Status = view.CheckFailed
+		check.Message = err.Error()
+		return check
+	}
+	defer file.Close()
+
+	included := false
+	insideHostBlock := false
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		keyword := strings.ToLower(fields[0])
+		if keyword == "host" || keyword == "match" {
+			insideHostBlock = true
+			continue
+		}
+
+		if keyword != "include" {
+			continue
+		}
+
+		// Paths with spaces are quoted
+		if strings.Trim(strings.TrimSpace(line[len(fields[0]):]), "\"") == daytonaSshConfigPath {
+			included = true
+			break
+		}
+
+		for _, path := range fields[1:] {
+			if path == daytonaSshConfigPath {
+				included = true
+				break
+			}
+		}
+
+		if included {
+			break
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		check.Status = view.CheckFailed
+		check.Message = err.Error()
+		return check
+	}
+
+	if !included {
+		check.Status = view.CheckFailed
+		check.Message = fmt.Sprintf("%s does not include %s so IDEs can't connect to workspaces", sshConfigPath, daytonaSshConfigPath)
+		check.Fix = fix
+		return check
+	}
+
+	if insideHostBlock {
+		check.Status = view.CheckFailed
+		check.Message = fmt.Sprintf("%s is included inside a Host or Match block and only applies to the hosts of that block", daytonaSshConfigPath)
+		check.Fix = fix
+		return check
+	}
+
+	if _, err := os.Stat(daytonaSshConfigPath); err != nil {
+		check.Status = view.CheckWarning
+		check.Message = fmt.Sprintf("%s is included but does not exist", daytonaSshConfigPath)
+		check.Fix = fix
+		return check
+	}
+
+	check.Status = view.CheckPassed
+	check.Message = fmt.Sprintf("%s includes %s", sshConfigPath, daytonaSshConfigPath)
+	return check
+}
+
+func checkServer(profile *config.Profile) []view.Check {
+	serverCheck := view.Check{Name: "Server"}
+	versionCheck := view.Check{Name: "Version"}
+	dockerCheck := view.Check{Name: "Docker"}
+
+	skip := func(reason string) []view.Check {
+		for _, check := range []*view.Check{&versionCheck, &dockerCheck} {
+			check.Status = view.CheckWarning
+			check.Message = fmt.Sprintf("Skipped because %s", reason)
+		}
+		return []view.Check{serverCheck, versionCheck, dockerCheck}
+	}
+
+	if profile == nil {
+		serverCheck.Status = view.CheckFailed
+		serverCheck.Message = "No active profile"
+		serverCheck.Fix = "Fix the config file first"
+		return skip("there is no active profile")
+	}
+
+	start := time.Now()
+
+	apiClient, err := apiclient_util.GetApiClient(profile)
+	if err != nil {
+		serverCheck.Status = view.CheckFailed
+		serverCheck.Message = err.Error()
+		if profile.Id == "default" {
+			serverCheck.Fix = "Start the server with 'daytona server'"
+		} else {
+			serverCheck.Fix = fmt.Sprintf("Make sure the server at %s is running and reachable from this machine or update the profile with 'daytona profile edit %s'", profile.Api.Url, profile.Name)
+		}
+		return skip("the server is not reachable")
+	}
+
+	hostInfo, res, err := apiClient.ServerAPI.GetHostInfo(context.Background()).Execute()
+	if err != nil {
+		serverCheck.Status = view.CheckFailed
+		serverCheck.Message = apiclient_util.HandleErrorResponse(res, err).Error()
+		serverCheck.Fix = fmt.Sprintf("Generate a new API key on the server with 'daytona api-key generate' and set it with 'daytona profile edit %s'", profile.Name)
+		return skip("the server rejected the request")
+	}
+
+	serverCheck.Status = view.CheckPassed
+	serverCheck.Message = fmt.Sprintf("%s is reachable (%d ms)", profile.Api.Url, time.Since(start).Milliseconds())
+
+	serverVersion := res.Header.Get(middlewares.SERVER_VERSION_HEADER)
+	if serverVersion == internal.Version {
+		versionCheck.Status = view.CheckPassed
+		versionCheck.Message = fmt.Sprintf("The CLI and the server are on version %s", internal.Version)
+	} else {
+		versionCheck.Status = view.CheckWarning
+		versionCheck.Message = fmt.Sprintf("The CLI is on version %s, the server is on version %s", internal.Version, serverVersion)
+		versionCheck.Fix = "Install the same version of the CLI and the server to ensure maximum compatibility"
+	}
+
+	if hostInfo.Docker {
+		dockerCheck.Status = view.CheckPassed
+		dockerCheck.Message = "Docker or Podman is available on the server host"
+	} else {
+		dockerCheck.Status = view.CheckFailed
+		dockerCheck.Message = "No Docker or Podman socket was found on the server host"
+		dockerCheck.Fix = "Install Docker on the server host and make sure the user running the server can access /var/run/docker.sock, or enable the Podman socket with 'systemctl --user enable --now podman.socket'"
+	}
+
+	return []view.Check{serverCheck, versionCheck, dockerCheck}
+}
+
+func expandHomeDir(path string) string {
+	if strings.HasPrefix(path, "~/") {
+		if homeDir, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(homeDir, path[2:])
+		}
+	}
+
+	return path
+}
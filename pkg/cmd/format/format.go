@@ -4,18 +4,25 @@
 package format
 
 import (
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/charmbracelet/x/ansi"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
 )
 
 const (
-	formatFlagDescription = `Output format. Must be one of (yaml, json)`
-	formatFlagName        = "format"
-	formatFlagShortHand   = "f"
+	formatFlagDescription      = `Output format. Must be one of (yaml, json)`
+	tableFormatFlagDescription = `Output format. Must be one of (yaml, json, csv)`
+	formatFlagName             = "format"
+	formatFlagShortHand        = "f"
+	// Hidden alias of the format flag
+	formatFlagAlias = "output"
 )
 
 var (
@@ -25,9 +32,29 @@ var (
 
 type outputFormatter struct {
 	data      interface{}
+	table     *Table
 	formatter Formatter
 }
 
+// Table is the data of a list in the columns of the interactive table. It's used for the csv format
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// NewTable returns a table with the styling and padding of the interactive table removed from the cells
+func NewTable(headers []string, rows [][]string) *Table {
+	table := &Table{Headers: headers, Rows: [][]string{}}
+	for _, row := range rows {
+		cells := []string{}
+		for _, cell := range row {
+			cells = append(cells, strings.TrimSpace(ansi.Strip(cell)))
+		}
+		table.Rows = append(table.Rows, cells)
+	}
+	return table
+}
+
 func NewFormatter(data interface{}) *outputFormatter {
 	var formatter Formatter
 	switch FormatFlag {
@@ -35,6 +62,8 @@ func NewFormatter(data interface{}) *outputFormatter {
 		formatter = JSONFormatter{}
 	case "yaml":
 		formatter = YAMLFormatter{}
+	case "csv":
+		formatter = CSVFormatter{}
 	case "":
 		formatter = nil
 	default:
@@ -62,6 +91,12 @@ func (f JSONFormatter) Format(data interface{}) (string, error) {
 	return string(jsonData), nil
 }
 
+// WithTable sets the table that is printed in the csv format
+func (f *outputFormatter) WithTable(table *Table) *outputFormatter {
+	f.table = table
+	return f
+}
+
 type YAMLFormatter struct{}
 
 func (f YAMLFormatter) Format(data interface{}) (string, error) {
@@ -72,9 +107,37 @@ func (f YAMLFormatter) Format(data interface{}) (string, error) {
 	return string(yamlData), nil
 }
 
+type CSVFormatter struct{}
+
+func (f CSVFormatter) Format(data interface{}) (string, error) {
+	table, ok := data.(*Table)
+	if !ok || table == nil {
+		return "", errors.New("the csv format is not supported by this command")
+	}
+
+	var output strings.Builder
+	writer := csv.NewWriter(&output)
+
+	err := writer.Write(table.Headers)
+	if err != nil {
+		return "", err
+	}
+
+	err = writer.WriteAll(table.Rows)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(output.String(), "\n"), nil
+}
+
 func (f *outputFormatter) Print() {
+	data := f.data
+	if _, ok := f.formatter.(CSVFormatter); ok {
+		data = f.table
+	}
 
-	formattedOutput, err := f.formatter.Format(f.data)
+	formattedOutput, err := f.formatter.Format(data)
 	if err != nil {
 		fmt.Printf("Error formatting output: %v\n", err)
 		os.Exit(1)
@@ -100,7 +163,18 @@ func UnblockStdOut() {
 }
 
 func RegisterFormatFlag(cmd *cobra.Command) {
-	cmd.Flags().StringVarP(&FormatFlag, formatFlagName, formatFlagShortHand, FormatFlag, formatFlagDescription)
+	registerFormatFlag(cmd, formatFlagDescription)
+}
+
+// RegisterTableFormatFlag registers the format flag with the csv format for commands that list data in a table
+func RegisterTableFormatFlag(cmd *cobra.Command) {
+	registerFormatFlag(cmd, tableFormatFlagDescription)
+}
+
+func registerFormatFlag(cmd *cobra.Command, description string) {
+	cmd.Flags().StringVarP(&FormatFlag, formatFlagName, formatFlagShortHand, FormatFlag, description)
+	cmd.Flags().StringVar(&FormatFlag, formatFlagAlias, FormatFlag, description)
+	_ = cmd.Flags().MarkHidden(formatFlagAlias)
 	cmd.PreRun = func(cmd *cobra.Command, args []string) {
 		if FormatFlag != "" {
 			BlockStdOut()
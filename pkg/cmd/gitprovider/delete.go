@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -48,8 +49,8 @@ var gitProviderDeleteCmd = &cobra.Command{
 				form := huh.NewForm(
 					huh.NewGroup(
 						huh.NewConfirm().
-							Title("Remove all git providers?").
-							Description("Are you sure you want to remove all git providers?").
+							Title(i18n.T("Remove all git providers?")).
+							Description(i18n.T("Are you sure you want to remove all git providers?")).
 							Value(&yesFlag),
 					),
 				).WithTheme(views.GetCustomTheme())
@@ -64,7 +65,7 @@ var gitProviderDeleteCmd = &cobra.Command{
 						return err
 					}
 				} else {
-					fmt.Println("Operation canceled.")
+					fmt.Println(i18n.T("Operation canceled."))
 				}
 			}
 
@@ -85,8 +86,8 @@ var gitProviderDeleteCmd = &cobra.Command{
 			form := huh.NewForm(
 				huh.NewGroup(
 					huh.NewConfirm().
-						Title(fmt.Sprintf("Remove git provider: %s?", selectedGitProviderText)).
-						Description(fmt.Sprintf("Are you sure you want to remove the git provider: %s?", selectedGitProviderText)).
+						Title(i18n.T("Remove git provider: %s?", selectedGitProviderText)).
+						Description(i18n.T("Are you sure you want to remove the git provider: %s?", selectedGitProviderText)).
 						Value(&yesFlag),
 				),
 			).WithTheme(views.GetCustomTheme())
@@ -98,7 +99,7 @@ var gitProviderDeleteCmd = &cobra.Command{
 		}
 
 		if !yesFlag {
-			fmt.Println("Operation canceled.")
+			fmt.Println(i18n.T("Operation canceled."))
 		} else {
 			_, err = apiClient.GitProviderAPI.RemoveGitProvider(ctx, selectedGitProvider.Id).Execute()
 			if err != nil {
@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package group
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var GroupCmd = &cobra.Command{
+	Use:     "group",
+	Aliases: []string{"groups"},
+	Short:   "Manage workspace groups",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+func init() {
+	GroupCmd.AddCommand(groupStatusCmd)
+}
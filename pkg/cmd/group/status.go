@@ -0,0 +1,123 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package group
+
+import (
+	"context"
+	"os"
+	"slices"
+
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_cmd "github.com/daytonaio/daytona/pkg/cmd/workspace"
+	"github.com/daytonaio/daytona/pkg/views"
+	group_view "github.com/daytonaio/daytona/pkg/views/workspace/group"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var groupStatusCmd = &cobra.Command{
+	Use:   "status [GROUP]",
+	Short: "Show the status of all workspaces in a group",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		groupName := args[0]
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		for {
+			workspaceList, err := getGroupWorkspaces(ctx, apiClient, groupName)
+			if err != nil {
+				return err
+			}
+
+			if len(workspaceList) == 0 {
+				views.RenderInfoMessage(i18n.T("No workspaces found in group '%s'", groupName))
+				return nil
+			}
+
+			if !term.IsTerminal(int(os.Stdout.Fd())) {
+				group_view.Render(groupName, workspaceList)
+				return nil
+			}
+
+			action, err := group_view.GetActionFromPrompt(groupName, workspaceList)
+			if err != nil {
+				return err
+			}
+
+			switch action {
+			case group_view.ActionStartAll:
+				for _, workspace := range workspaceList {
+					err := workspace_cmd.StartWorkspace(apiClient, workspace.Name, "")
+					if err != nil {
+						log.Errorf("Failed to start workspace %s: %v\n\n", workspace.Name, err)
+						continue
+					}
+					views.RenderInfoMessage(i18n.T("- Workspace '%s' started successfully", workspace.Name))
+				}
+			case group_view.ActionStopAll:
+				for _, workspace := range workspaceList {
+					err := workspace_cmd.StopWorkspace(apiClient, workspace.Name, "")
+					if err != nil {
+						log.Errorf("Failed to stop workspace %s: %v\n\n", workspace.Name, err)
+						continue
+					}
+					views.RenderInfoMessage(i18n.T("- Workspace '%s' successfully stopped", workspace.Name))
+				}
+			case group_view.ActionRefresh:
+				continue
+			default:
+				return nil
+			}
+		}
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		workspaceList, _, err := apiClient.WorkspaceAPI.ListWorkspaces(context.Background()).Execute()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var choices []string
+		for _, workspace := range workspaceList {
+			if workspace.Group != nil && *workspace.Group != "" && !slices.Contains(choices, *workspace.Group) {
+				choices = append(choices, *workspace.Group)
+			}
+		}
+
+		return choices, cobra.ShellCompDirectiveNoFileComp
+	},
+}
+
+func getGroupWorkspaces(ctx context.Context, apiClient *apiclient.APIClient, groupName string) ([]apiclient.WorkspaceDTO, error) {
+	// Verbose includes the provider info with the resource usage of the projects
+	workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(true).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	var groupWorkspaces []apiclient.WorkspaceDTO
+	for _, workspace := range workspaceList {
+		if workspace.Group != nil && *workspace.Group == groupName {
+			groupWorkspaces = append(groupWorkspaces, workspace)
+		}
+	}
+
+	return groupWorkspaces, nil
+}
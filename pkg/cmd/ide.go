@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
@@ -69,10 +70,27 @@ var ideCmd = &cobra.Command{
 			}
 		}
 
-		c.DefaultIdeId = chosenIde.Id
-
 		telemetry.AdditionalData["ide"] = chosenIde.Id
 
+		if ideProfileFlag != "" {
+			profile, err := getProfileByIdOrName(c, ideProfileFlag)
+			if err != nil {
+				return err
+			}
+
+			profile.DefaultIdeId = chosenIde.Id
+			err = c.EditProfile(profile)
+			if err != nil {
+				return err
+			}
+
+			content := fmt.Sprintf("%s %s", views.GetPropertyKey(fmt.Sprintf("Default IDE for profile %s: ", profile.Name)), chosenIde.Name)
+			views.RenderContainerLayout(views.GetInfoMessage(content))
+			return nil
+		}
+
+		c.DefaultIdeId = chosenIde.Id
+
 		err = c.Save()
 		if err != nil {
 			return err
@@ -83,3 +101,19 @@ var ideCmd = &cobra.Command{
 		return nil
 	},
 }
+
+var ideProfileFlag string
+
+func init() {
+	ideCmd.Flags().StringVarP(&ideProfileFlag, "profile", "p", "", "Set the default IDE only for the given profile (ID or name)")
+}
+
+func getProfileByIdOrName(c *config.Config, idOrName string) (config.Profile, error) {
+	for _, profile := range c.Profiles {
+		if profile.Id == idOrName || profile.Name == idOrName {
+			return profile, nil
+		}
+	}
+
+	return config.Profile{}, errors.New("profile not found")
+}
@@ -6,8 +6,10 @@ package cmd
 import (
 	"context"
 	"errors"
+	"os"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -18,6 +20,7 @@ import (
 
 var followFlag bool
 var workspaceFlag bool
+var agentFlag bool
 
 var logsCmd = &cobra.Command{
 	Use:     "logs [WORKSPACE] [PROJECT_NAME]",
@@ -68,7 +71,7 @@ var logsCmd = &cobra.Command{
 		if workspace == nil {
 			return errors.New("workspace not found")
 		} else if len(workspace.Projects) == 0 {
-			return errors.New("no projects found in workspace")
+			return errors.New(i18n.T("no projects found in workspace"))
 		}
 
 		if len(args) == 2 {
@@ -83,7 +86,7 @@ var logsCmd = &cobra.Command{
 				}
 			}
 			if !found {
-				return errors.New("project not found in workspace")
+				return errors.New(i18n.T("project not found in workspace"))
 			}
 			projectNames = append(projectNames, args[1])
 			if workspaceFlag {
@@ -97,6 +100,13 @@ var logsCmd = &cobra.Command{
 			})
 		}
 
+		if agentFlag {
+			if len(projectNames) != 1 {
+				return errors.New("a single project is required to view agent logs")
+			}
+			return apiclient_util.ReadAgentLogs(ctx, apiClient, workspace.Id, projectNames[0], followFlag, os.Stdout)
+		}
+
 		apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, followFlag, showWorkspaceLogs, nil)
 
 		return nil
@@ -106,4 +116,6 @@ var logsCmd = &cobra.Command{
 func init() {
 	logsCmd.Flags().BoolVarP(&followFlag, "follow", "f", false, "Follow logs")
 	logsCmd.Flags().BoolVarP(&workspaceFlag, "workspace", "w", false, "View workspace logs")
+	logsCmd.Flags().BoolVar(&agentFlag, "agent", false, "View the Daytona Agent logs of a project, end-to-end encrypted if the profile enables it")
+	logsCmd.MarkFlagsMutuallyExclusive("agent", "workspace")
 }
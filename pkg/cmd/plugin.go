@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/plugin"
+	"github.com/daytonaio/daytona/pkg/views"
+	plugin_view "github.com/daytonaio/daytona/pkg/views/plugin"
+	"github.com/spf13/cobra"
+)
+
+var pluginCmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "Manage CLI plugins",
+	Long: `Manage CLI plugins
+
+Plugins are executables on PATH named daytona-<NAME> and are invoked as 'daytona <NAME>'.
+Dashes in the executable name separate subcommands, e.g. daytona-team-sync is invoked as 'daytona team sync'.
+Underscores are invoked as dashes, e.g. daytona-my_tool is invoked as 'daytona my-tool'.
+
+Plugins receive the remaining arguments, the environment of the CLI and the path of the daytona executable in DAYTONA_BIN.
+Built-in commands take precedence over plugins.`,
+	Aliases: []string{"plugins"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var pluginListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List CLI plugins found on PATH",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		plugins := plugin.List()
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(plugins)
+			formattedData.Print()
+			return nil
+		}
+
+		if len(plugins) == 0 {
+			views.RenderInfoMessage("No plugins found. Add an executable named daytona-<NAME> to your PATH to extend the CLI.")
+			return nil
+		}
+
+		overridden := []string{}
+		for _, p := range plugins {
+			if isBuiltinCommand(cmd.Root(), strings.Fields(p.Name)[0]) {
+				overridden = append(overridden, p.Name)
+			}
+		}
+
+		plugin_view.ListPlugins(plugins, overridden)
+		return nil
+	},
+}
+
+// findPlugin returns the plugin that should handle the arguments if they do not start with a built-in command
+func findPlugin(rootCmd *cobra.Command, args []string) (*plugin.Plugin, []string, bool) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") || strings.HasPrefix(args[0], "__") {
+		return nil, nil, false
+	}
+
+	rootCmd.InitDefaultHelpCmd()
+	if isBuiltinCommand(rootCmd, args[0]) {
+		return nil, nil, false
+	}
+
+	return plugin.Find(args)
+}
+
+func isBuiltinCommand(rootCmd *cobra.Command, name string) bool {
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func init() {
+	format.RegisterFormatFlag(pluginListCmd)
+	pluginCmd.AddCommand(pluginListCmd)
+}
@@ -0,0 +1,265 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	ports_util "github.com/daytonaio/daytona/pkg/ports"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// Sessions and the auto-forward process refresh their files on this interval
+const autoForwardHeartbeatInterval = 10 * time.Second
+
+// Files that were not refreshed for this long belong to a process that is gone
+const autoForwardStaleTimeout = 30 * time.Second
+
+// AutoForwardCmd keeps the ports declared on a project, and the ports detected in it unless disabled in the config,
+// forwarded to the local machine for as long as at least one SSH or IDE connection to the project is open.
+// It is started in the background by ssh-proxy
+var AutoForwardCmd = &cobra.Command{
+	Use:    "auto-forward [PROFILE_ID] [WORKSPACE_ID] [PROJECT] [PORT]...",
+	Args:   cobra.MinimumNArgs(3),
+	Hidden: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		profile, err := c.GetProfile(args[0])
+		if err != nil {
+			return err
+		}
+
+		workspaceId := args[1]
+		projectName := args[2]
+
+		ports := []uint16{}
+		for _, arg := range args[3:] {
+			port, err := strconv.ParseUint(arg, 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid port %s: %w", arg, err)
+			}
+			ports = append(ports, uint16(port))
+		}
+
+		dir, err := getAutoForwardDir(profile.Id, workspaceId, projectName)
+		if err != nil {
+			return err
+		}
+
+		logFile, err := os.OpenFile(filepath.Join(dir, "auto-forward.log"), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer logFile.Close()
+		log.SetOutput(logFile)
+
+		lockPath := filepath.Join(dir, "auto-forward.pid")
+		acquired, err := acquireAutoForwardLock(lockPath)
+		if err != nil {
+			return err
+		}
+		if !acquired {
+			log.Info("Ports are already forwarded by another process")
+			return nil
+		}
+		defer os.Remove(lockPath)
+
+		forwarded := map[uint16]bool{}
+		for _, port := range ports {
+			hostPort, errChan := tailscale.ForwardPort(workspaceId, projectName, port, profile)
+			if hostPort == nil {
+				log.Errorf("Failed to forward port %d: %v", port, <-errChan)
+				continue
+			}
+
+			forwarded[port] = true
+			log.Infof("Port %d available at http://localhost:%d", port, *hostPort)
+
+			go func() {
+				for err := range errChan {
+					log.Debug(err)
+				}
+			}()
+		}
+
+		if !c.PortsAutoForward.Disabled {
+			filter, err := ports_util.NewFilter(c.PortsAutoForward.Allow, c.PortsAutoForward.Ignore)
+			if err != nil {
+				log.Errorf("Detected ports are not forwarded: %v", err)
+			} else {
+				go forwardDetectedPorts(profile, workspaceId, projectName, filter, c.PortsAutoForward.OpenBrowser, forwarded)
+			}
+		}
+
+		for {
+			time.Sleep(autoForwardHeartbeatInterval)
+
+			if !hasActiveAutoForwardSessions(dir) {
+				log.Info("No open connections to the project, stopping")
+				return nil
+			}
+
+			now := time.Now()
+			err = os.Chtimes(lockPath, now, now)
+			if err != nil {
+				return err
+			}
+		}
+	},
+}
+
+// RegisterAutoForwardSession records an open connection to the project and makes sure that the given ports
+// are forwarded while it is open. The returned function closes the session
+func RegisterAutoForwardSession(profileId, workspaceId, projectName string, ports []int32) (func(), error) {
+	dir, err := getAutoForwardDir(profileId, workspaceId, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionPath := filepath.Join(dir, "sessions", strconv.Itoa(os.Getpid()))
+	err = os.MkdirAll(filepath.Dir(sessionPath), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(sessionPath, nil, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isFresh(filepath.Join(dir, "auto-forward.pid")) {
+		err = startAutoForward(profileId, workspaceId, projectName, ports)
+		if err != nil {
+			os.Remove(sessionPath)
+			return nil, err
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(autoForwardHeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				now := time.Now()
+				err := os.Chtimes(sessionPath, now, now)
+				if err != nil {
+					log.Debug(err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		os.Remove(sessionPath)
+	}, nil
+}
+
+func startAutoForward(profileId, workspaceId, projectName string, ports []int32) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	args := []string{"auto-forward", profileId, workspaceId, projectName}
+	for _, port := range ports {
+		args = append(args, strconv.Itoa(int(port)))
+	}
+
+	// The process must not inherit the standard streams since ssh-proxy uses them for the SSH connection
+	cmd := exec.Command(executable, args...)
+	cmd.SysProcAttr = detachedProcAttr()
+
+	err = cmd.Start()
+	if err != nil {
+		return err
+	}
+
+	return cmd.Process.Release()
+}
+
+// acquireAutoForwardLock creates the lock file of the auto-forward process. A lock left behind by
+// a process that is gone is taken over
+func acquireAutoForwardLock(lockPath string) (bool, error) {
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_, err = f.WriteString(strconv.Itoa(os.Getpid()))
+			f.Close()
+			return err == nil, err
+		}
+
+		if !errors.Is(err, os.ErrExist) {
+			return false, err
+		}
+
+		if isFresh(lockPath) {
+			return false, nil
+		}
+
+		err = os.Remove(lockPath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return false, err
+		}
+	}
+}
+
+func hasActiveAutoForwardSessions(dir string) bool {
+	sessionsDir := filepath.Join(dir, "sessions")
+
+	entries, err := os.ReadDir(sessionsDir)
+	if err != nil {
+		return false
+	}
+
+	active := false
+	for _, entry := range entries {
+		sessionPath := filepath.Join(sessionsDir, entry.Name())
+		if isFresh(sessionPath) {
+			active = true
+		} else {
+			os.Remove(sessionPath)
+		}
+	}
+
+	return active
+}
+
+func isFresh(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return time.Since(info.ModTime()) < autoForwardStaleTimeout
+}
+
+func getAutoForwardDir(profileId, workspaceId, projectName string) (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(configDir, "auto-forward", fmt.Sprintf("%s-%s-%s", profileId, workspaceId, projectName))
+
+	return dir, os.MkdirAll(dir, 0700)
+}
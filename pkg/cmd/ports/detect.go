@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	toolbox_config "github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	toolbox_ports "github.com/daytonaio/daytona/pkg/agent/toolbox/ports"
+	ports_util "github.com/daytonaio/daytona/pkg/ports"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/browser"
+	log "github.com/sirupsen/logrus"
+	"tailscale.com/tsnet"
+)
+
+// forwardDetectedPorts forwards the ports that start listening in the project and match the filter, and opens
+// them in the default browser if enabled.
+// The agent reports them over a websocket, which is reconnected when it closes, e.g. while the project restarts.
+// Ports stay forwarded when they stop listening so that restarted processes are reachable at the same local port
+func forwardDetectedPorts(profile config.Profile, workspaceId, projectName string, filter *ports_util.Filter, openBrowser bool, forwarded map[uint16]bool) {
+	var tsConn *tsnet.Server
+
+	for {
+		var err error
+		if tsConn == nil {
+			tsConn, err = tailscale.GetConnection(&profile)
+			// The ports are reported by the toolbox, which can't be reached through the tunnel of the server
+			if errors.Is(err, tailscale.ErrNoNetworkAccess) {
+				log.Warn("Ports can't be detected without access to the network of the server")
+				return
+			}
+		}
+
+		if err == nil {
+			err = watchPorts(tsConn, workspaceId, projectName, func(event toolbox_ports.PortsEvent) {
+				for _, port := range event.Opened {
+					if forwarded[port] || !filter.Matches(port) {
+						continue
+					}
+
+					hostPort, errChan := tailscale.ForwardPort(workspaceId, projectName, port, profile)
+					if hostPort == nil {
+						log.Errorf("Failed to forward detected port %d: %v", port, <-errChan)
+						continue
+					}
+
+					forwarded[port] = true
+					url := fmt.Sprintf("http://localhost:%d", *hostPort)
+					log.Infof("Detected port %d available at %s", port, url)
+
+					if openBrowser {
+						err := browser.OpenURL(url)
+						if err != nil {
+							log.Warnf("Failed to open %s in the browser: %v", url, err)
+						}
+					}
+
+					go func() {
+						for err := range errChan {
+							log.Debug(err)
+						}
+					}()
+				}
+			})
+		}
+
+		log.Debugf("Failed to watch the ports of the project: %v", err)
+		time.Sleep(autoForwardHeartbeatInterval)
+	}
+}
+
+// watchPorts calls onEvent with the port events of the project agent until the connection closes
+func watchPorts(tsConn *tsnet.Server, workspaceId, projectName string, onEvent func(toolbox_ports.PortsEvent)) error {
+	dialer := websocket.Dialer{
+		NetDialContext:   tsConn.Dial,
+		HandshakeTimeout: 10 * time.Second,
+	}
+
+	url := fmt.Sprintf("ws://%s:%d/ports/watch", project.GetProjectHostname(workspaceId, projectName), toolbox_config.TOOLBOX_API_PORT)
+
+	conn, _, err := dialer.Dial(url, nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	for {
+		var event toolbox_ports.PortsEvent
+		err := conn.ReadJSON(&event)
+		if err != nil {
+			return err
+		}
+
+		onEvent(event)
+	}
+}
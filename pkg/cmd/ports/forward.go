@@ -9,21 +9,34 @@ import (
 	"errors"
 	"fmt"
 	"hash/fnv"
+	"io"
+	"net"
+	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/huh"
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
 	"github.com/daytonaio/daytona/internal/util"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/frpc"
+	"github.com/daytonaio/daytona/pkg/mdns"
+	ports_util "github.com/daytonaio/daytona/pkg/ports"
 	"github.com/daytonaio/daytona/pkg/views"
 	log "github.com/sirupsen/logrus"
 	qrcode "github.com/skip2/go-qrcode"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 var publicPreview bool
+var mdnsFlag bool
+var openFlag bool
+var hostFlag string
+var publicPortFlags PublicPortFlags
 var workspaceId string
 var projectName string
 
@@ -46,7 +59,27 @@ var PortForwardCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		workspace, err := apiclient.GetWorkspace(args[1], true)
+
+		if publicPortFlags.IsSet() && !publicPreview {
+			return errors.New("--route, --rewrite-host, --password and --link-expiry can only be used with --public")
+		}
+
+		publicPortOptions, err := publicPortFlags.ToOptions()
+		if err != nil {
+			return err
+		}
+
+		host := hostFlag
+		if mdnsFlag {
+			// Other devices can only reach the port if it listens on the local network
+			if !cmd.Flags().Changed("host") {
+				host = "0.0.0.0"
+			} else if ip := net.ParseIP(host); host == "localhost" || (ip != nil && ip.IsLoopback()) {
+				return errors.New("--mdns can not be used with a loopback --host")
+			}
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[1], true)
 		if err != nil {
 			return err
 		}
@@ -55,28 +88,52 @@ var PortForwardCmd = &cobra.Command{
 		if len(args) == 3 {
 			projectName = args[2]
 		} else {
-			projectName, err = apiclient.GetFirstWorkspaceProjectName(workspaceId, projectName, nil)
+			projectName, err = apiclient_util.GetFirstWorkspaceProjectName(workspaceId, projectName, nil)
 			if err != nil {
 				return err
 			}
 		}
 
-		hostPort, errChan := tailscale.ForwardPort(workspaceId, projectName, uint16(port), activeProfile)
+		hostPort, errChan := tailscale.ForwardPortOnHost(workspaceId, projectName, uint16(port), activeProfile, host)
 
 		if hostPort == nil {
 			if err = <-errChan; err != nil {
 				return err
 			}
 		} else {
-			if *hostPort != uint16(port) {
-				views.RenderInfoMessage(fmt.Sprintf("Port %d already in use.", port))
+			renderForwardedPort(uint16(port), *hostPort, host, openFlag)
+
+			if mdnsFlag {
+				go func() {
+					err := advertiseOnLocalNetwork(workspace.Name, *hostPort)
+					if err != nil {
+						log.Warn(err)
+					}
+				}()
 			}
-			views.RenderInfoMessage(fmt.Sprintf("Port available at http://localhost:%d\n", *hostPort))
 		}
 
 		if publicPreview {
+			publicRoutes := []frpc.FrpcRoute{}
+			for _, route := range publicPortOptions.Routes {
+				routeHostPort, routeErrChan := tailscale.ForwardPort(workspaceId, projectName, uint16(route.Port), activeProfile)
+				if routeHostPort == nil {
+					return <-routeErrChan
+				}
+
+				go func() {
+					for err := range routeErrChan {
+						errChan <- err
+					}
+				}()
+
+				publicRoutes = append(publicRoutes, frpc.FrpcRoute{Location: route.Location, Port: int(*routeHostPort)})
+			}
+
+			publicPortOptions.Routes = publicRoutes
+
 			go func() {
-				errChan <- ForwardPublicPort(workspaceId, projectName, *hostPort, uint16(port))
+				errChan <- ForwardPublicPort(workspaceId, projectName, *hostPort, uint16(port), publicPortOptions)
 			}()
 		}
 
@@ -91,47 +148,248 @@ var PortForwardCmd = &cobra.Command{
 
 func init() {
 	PortForwardCmd.Flags().BoolVar(&publicPreview, "public", false, "Should be port be available publicly via an URL")
+	PortForwardCmd.Flags().BoolVar(&mdnsFlag, "mdns", false, "Advertise the port on the local network via mDNS as <WORKSPACE>.local. Listens on 0.0.0.0 unless --host is set")
+	PortForwardCmd.Flags().BoolVar(&openFlag, "open", false, "Open the forwarded port in the default browser")
+	PortForwardCmd.Flags().StringVar(&hostFlag, "host", tailscale.DefaultForwardHost, "Local address the forwarded port listens on. Use 0.0.0.0 to share the port on the local network")
+	AddPublicPortFlags(PortForwardCmd, &publicPortFlags)
+}
+
+// advertiseOnLocalNetwork answers mDNS queries for the workspace hostname so that other devices
+// on the local network can reach the forwarded port
+func advertiseOnLocalNetwork(workspaceName string, hostPort uint16) error {
+	responder, err := mdns.NewResponder(workspaceName, []uint16{hostPort})
+	if err != nil {
+		return fmt.Errorf("failed to advertise the port via mDNS: %w", err)
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("Port available on the local network at http://%s:%d\n", mdns.GetHostname(workspaceName), hostPort))
+
+	err = responder.Run(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to advertise the port via mDNS: %w", err)
+	}
+
+	return nil
+}
+
+// PublicPortOptions configure how a port is exposed at a public URL
+type PublicPortOptions struct {
+	// Additional ports exposed under path prefixes of the public URL
+	Routes []frpc.FrpcRoute
+	// Rewrite the Host header of public requests to localhost
+	RewriteHost bool
+	// If set, only authenticated requests reach the port
+	Auth *ports_util.PublicPortAuth
+	// Validity of the signed link printed when the URL is protected with signed links
+	LinkExpiry time.Duration
+}
+
+type PublicPortFlags struct {
+	Routes      []string
+	RewriteHost bool
+	Password    bool
+	LinkExpiry  time.Duration
+}
+
+// AddPublicPortFlags adds the flags used to configure how a port is exposed at a public URL
+func AddPublicPortFlags(cmd *cobra.Command, flags *PublicPortFlags) {
+	cmd.Flags().StringArrayVar(&flags.Routes, "route", []string{}, "Expose an additional port under a path prefix of the public URL (e.g. --route /api=8080)")
+	cmd.Flags().BoolVar(&flags.RewriteHost, "rewrite-host", false, "Rewrite the Host header of public requests to localhost")
+	cmd.Flags().BoolVar(&flags.Password, "password", false, fmt.Sprintf("Protect the public URL with HTTP basic auth (username: %s). The password is prompted for or read from stdin", ports_util.PUBLIC_PORT_USERNAME))
+	cmd.Flags().DurationVar(&flags.LinkExpiry, "link-expiry", 0, "Protect the public URL with a signed link that expires after this duration (e.g. 24h)")
+}
+
+func (f PublicPortFlags) IsSet() bool {
+	return len(f.Routes) > 0 || f.RewriteHost || f.Password || f.LinkExpiry != 0
+}
+
+// ToOptions validates the flags and reads the password. The port of the returned routes is the project port
+func (f PublicPortFlags) ToOptions() (PublicPortOptions, error) {
+	routes, err := ParseRoutes(f.Routes)
+	if err != nil {
+		return PublicPortOptions{}, err
+	}
+
+	if f.LinkExpiry < 0 {
+		return PublicPortOptions{}, errors.New("--link-expiry must be positive")
+	}
+
+	options := PublicPortOptions{
+		Routes:      routes,
+		RewriteHost: f.RewriteHost,
+		LinkExpiry:  f.LinkExpiry,
+	}
+
+	if !f.Password && f.LinkExpiry == 0 {
+		return options, nil
+	}
+
+	options.Auth = &ports_util.PublicPortAuth{}
+
+	if f.Password {
+		options.Auth.Password, err = readPublicPortPassword()
+		if err != nil {
+			return PublicPortOptions{}, err
+		}
+	}
+
+	if f.LinkExpiry != 0 {
+		options.Auth.LinkKey, err = ports_util.NewLinkKey()
+		if err != nil {
+			return PublicPortOptions{}, err
+		}
+	}
+
+	return options, nil
+}
+
+// readPublicPortPassword prompts for the password or reads it from stdin so that it is not exposed in the process arguments
+func readPublicPortPassword() (string, error) {
+	var password string
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		value, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", err
+		}
+		password = strings.TrimRight(string(value), "\r\n")
+	} else {
+		err := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("Password of the public URL").
+					EchoMode(huh.EchoModePassword).
+					Value(&password),
+			),
+		).WithTheme(views.GetCustomTheme()).Run()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if password == "" {
+		return "", errors.New("the password can not be empty")
+	}
+
+	return password, nil
 }
 
-func ForwardPublicPort(workspaceId, projectName string, hostPort, targetPort uint16) error {
+// ParseRoutes parses routes in the PATH=PORT format. The port of the returned routes is the project port
+func ParseRoutes(routes []string) ([]frpc.FrpcRoute, error) {
+	result := []frpc.FrpcRoute{}
+
+	for _, route := range routes {
+		location, portStr, found := strings.Cut(route, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid route %s. Expected PATH=PORT (e.g. /api=8080)", route)
+		}
+
+		location = strings.TrimSuffix(location, "/")
+		if !strings.HasPrefix(location, "/") {
+			return nil, fmt.Errorf("invalid route %s. The path must start with / and can not be the root path", route)
+		}
+
+		port, err := strconv.ParseUint(portStr, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid route %s. The port must be a number", route)
+		}
+
+		result = append(result, frpc.FrpcRoute{Location: location, Port: int(port)})
+	}
+
+	return result, nil
+}
+
+// ForwardPublicPort exposes the host port at a public URL. Routes expose additional host ports
+// under path prefixes of the same URL
+func ForwardPublicPort(workspaceId, projectName string, hostPort, targetPort uint16, options PublicPortOptions) error {
 	views.RenderInfoMessage("Forwarding port to a public URL...")
 
-	apiClient, err := apiclient.GetApiClient(nil)
+	apiClient, err := apiclient_util.GetApiClient(nil)
 	if err != nil {
 		return err
 	}
 
 	serverConfig, res, err := apiClient.ServerAPI.GetConfig(context.Background()).Execute()
 	if err != nil {
-		return apiclient.HandleErrorResponse(res, err)
+		return apiclient_util.HandleErrorResponse(res, err)
 	}
 
-	h := fnv.New64()
-	h.Write([]byte(fmt.Sprintf("%s-%s-%s", workspaceId, projectName, serverConfig.Id)))
+	subDomain := getPublicPortSubDomain(serverConfig.Id, workspaceId, projectName, targetPort)
+
+	url, err := GetPublicPortUrl(serverConfig, workspaceId, projectName, targetPort)
+	if err != nil {
+		return err
+	}
 
-	subDomain := fmt.Sprintf("%d-%s", targetPort, base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprint(h.Sum64()))))
+	signedUrl := ""
+	var expiresAt time.Time
+	if options.Auth != nil {
+		// Requests are authenticated by a local proxy in front of every exposed port
+		hostPort, err = options.Auth.StartProxy(hostPort)
+		if err != nil {
+			return err
+		}
 
-	if serverConfig.Frps == nil {
-		return errors.New("frps config is missing")
+		routes := []frpc.FrpcRoute{}
+		for _, route := range options.Routes {
+			proxyPort, err := options.Auth.StartProxy(uint16(route.Port))
+			if err != nil {
+				return err
+			}
+			routes = append(routes, frpc.FrpcRoute{Location: route.Location, Port: int(proxyPort)})
+		}
+		options.Routes = routes
+
+		if options.LinkExpiry != 0 {
+			expiresAt = time.Now().Add(options.LinkExpiry)
+			signedUrl, err = options.Auth.SignLink(url, expiresAt)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	go func() {
 		time.Sleep(1 * time.Second)
-		var url = fmt.Sprintf("%s://%s.%s", serverConfig.Frps.Protocol, subDomain, serverConfig.Frps.Domain)
 		views.RenderInfoMessage(fmt.Sprintf("Port available at %s", url))
-		err := renderQr(url)
+		for _, route := range options.Routes {
+			views.RenderInfoMessage(fmt.Sprintf("Route %s available at %s%s", route.Location, url, route.Location))
+		}
+		if options.Auth != nil && options.Auth.Password != "" {
+			views.RenderInfoMessage(fmt.Sprintf("Access requires the password with username %s", ports_util.PUBLIC_PORT_USERNAME))
+		}
+		if signedUrl != "" {
+			views.RenderInfoMessage(fmt.Sprintf("Signed link valid until %s: %s", expiresAt.Format(time.RFC1123), signedUrl))
+		}
+
+		// The unsigned URL is rejected unless a password is set as well
+		qrUrl := url
+		if signedUrl != "" {
+			qrUrl = signedUrl
+		}
+		err := renderQr(qrUrl)
 		if err != nil {
 			log.Error(err)
 		}
 	}()
 
-	_, service, err := frpc.GetService(frpc.FrpcConnectParams{
-		ServerDomain: serverConfig.Frps.Domain,
-		ServerPort:   int(serverConfig.Frps.Port),
-		Name:         subDomain,
-		SubDomain:    subDomain,
-		Port:         int(hostPort),
-	})
+	hostHeaderRewrite := ""
+	if options.RewriteHost {
+		hostHeaderRewrite = "localhost"
+	}
+
+	params := frpc.FrpcConnectParams{
+		ServerDomain:      serverConfig.Frps.Domain,
+		ServerPort:        int(serverConfig.Frps.Port),
+		Name:              subDomain,
+		SubDomain:         subDomain,
+		Port:              int(hostPort),
+		Routes:            options.Routes,
+		HostHeaderRewrite: hostHeaderRewrite,
+	}
+
+	_, service, err := frpc.GetService(params)
 	if err != nil {
 		return err
 	}
@@ -139,6 +397,24 @@ func ForwardPublicPort(workspaceId, projectName string, hostPort, targetPort uin
 	return service.Run(context.Background())
 }
 
+// GetPublicPortUrl returns the URL at which a project port is available when it is forwarded publicly
+func GetPublicPortUrl(serverConfig *apiclient.ServerConfig, workspaceId, projectName string, port uint16) (string, error) {
+	if serverConfig.Frps == nil {
+		return "", errors.New("frps config is missing")
+	}
+
+	subDomain := getPublicPortSubDomain(serverConfig.Id, workspaceId, projectName, port)
+
+	return fmt.Sprintf("%s://%s.%s", serverConfig.Frps.Protocol, subDomain, serverConfig.Frps.Domain), nil
+}
+
+func getPublicPortSubDomain(serverId, workspaceId, projectName string, port uint16) string {
+	h := fnv.New64()
+	h.Write([]byte(fmt.Sprintf("%s-%s-%s", workspaceId, projectName, serverId)))
+
+	return fmt.Sprintf("%d-%s", port, base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprint(h.Sum64()))))
+}
+
 func renderQr(s string) error {
 	q, err := qrcode.New(s, qrcode.Medium)
 	if err != nil {
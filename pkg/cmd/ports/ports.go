@@ -0,0 +1,142 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	ports_view "github.com/daytonaio/daytona/pkg/views/ports"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var PortsCmd = &cobra.Command{
+	Use:   "ports WORKSPACE [PROJECT]",
+	Short: "List the ports listening in workspace projects",
+	Long: `List the TCP ports that processes inside the running projects of a workspace are listening on.
+In a terminal, select a port to forward it to your local machine (f) or to forward it and open it in the browser (o).`,
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], true)
+		if err != nil {
+			return err
+		}
+
+		projects := workspace.Projects
+		if len(args) == 2 {
+			projects = slices.DeleteFunc(projects, func(p apiclient.Project) bool {
+				return p.Name != args[1]
+			})
+			if len(projects) == 0 {
+				return fmt.Errorf("project %s not found in workspace %s", args[1], workspace.Name)
+			}
+		}
+
+		for {
+			projectPorts, err := listProjectPorts(ctx, apiClient, workspace.Id, projects)
+			if err != nil {
+				return err
+			}
+
+			if format.FormatFlag != "" {
+				formattedData := format.NewFormatter(projectPorts)
+				formattedData.Print()
+				return nil
+			}
+
+			if len(projectPorts) == 0 || views.IsPlainOutput() || !term.IsTerminal(int(os.Stdout.Fd())) {
+				ports_view.Render(workspace.Name, projectPorts)
+				return nil
+			}
+
+			action, projectPort, err := ports_view.GetActionFromPrompt(workspace.Name, projectPorts)
+			if err != nil {
+				return err
+			}
+
+			switch action {
+			case ports_view.ActionForward, ports_view.ActionOpen:
+				return forwardProjectPort(workspace.Id, projectPort.ProjectName, uint16(projectPort.Port), activeProfile, action == ports_view.ActionOpen)
+			case ports_view.ActionRefresh:
+				continue
+			default:
+				return nil
+			}
+		}
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(PortsCmd)
+}
+
+// listProjectPorts returns the listening ports of the running projects. Stopped projects are skipped
+func listProjectPorts(ctx context.Context, apiClient *apiclient.APIClient, workspaceId string, projects []apiclient.Project) ([]ports_view.ProjectPort, error) {
+	projectPorts := []ports_view.ProjectPort{}
+
+	for _, project := range projects {
+		if project.State == nil || project.State.Uptime == 0 {
+			log.Debugf("Project %s is not running, skipping", project.Name)
+			continue
+		}
+
+		listPortsResponse, res, err := apiClient.WorkspaceToolboxAPI.PortsListPorts(ctx, workspaceId, project.Name).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		for _, port := range listPortsResponse.Ports {
+			projectPorts = append(projectPorts, ports_view.ProjectPort{ProjectName: project.Name, Port: port})
+		}
+	}
+
+	return projectPorts, nil
+}
+
+// forwardProjectPort forwards the project port to the local machine until the command is interrupted.
+// With openBrowser, the local URL is opened in the default browser once the port is forwarded
+func forwardProjectPort(workspaceId, projectName string, port uint16, profile config.Profile, openBrowser bool) error {
+	hostPort, errChan := tailscale.ForwardPort(workspaceId, projectName, port, profile)
+	if hostPort == nil {
+		return <-errChan
+	}
+
+	renderForwardedPort(port, *hostPort, tailscale.DefaultForwardHost, openBrowser)
+
+	for {
+		err := <-errChan
+		if err != nil {
+			log.Debug(err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/daytonaio/daytona/pkg/mdns"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/pkg/browser"
+	log "github.com/sirupsen/logrus"
+)
+
+// renderForwardedPort prints the URLs of a forwarded port on separate lines without styling so that they can be
+// copied, and opens the first URL in the default browser if requested
+func renderForwardedPort(port, hostPort uint16, host string, openBrowser bool) {
+	if hostPort != port {
+		views.RenderInfoMessage(fmt.Sprintf("Port %d already in use.", port))
+	}
+
+	urls := getForwardedPortUrls(host, hostPort)
+
+	views.RenderInfoMessage(fmt.Sprintf("Port %d available at:", port))
+	for _, url := range urls {
+		fmt.Println(url)
+	}
+	fmt.Println()
+
+	if openBrowser {
+		err := browser.OpenURL(urls[0])
+		if err != nil {
+			log.Warnf("Failed to open %s in the browser: %v", urls[0], err)
+		}
+	}
+}
+
+// getForwardedPortUrls returns the URLs at which a forwarded port is reachable. If it listens on all interfaces,
+// the URLs with the local network addresses of the machine are included for sharing the port
+func getForwardedPortUrls(host string, hostPort uint16) []string {
+	urls := []string{fmt.Sprintf("http://localhost:%d", hostPort)}
+	if host == "localhost" {
+		return urls
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || (!ip.IsLoopback() && !ip.IsUnspecified()) {
+		return []string{fmt.Sprintf("http://%s", net.JoinHostPort(host, fmt.Sprint(hostPort)))}
+	}
+
+	if ip.IsLoopback() {
+		return urls
+	}
+
+	localIPs, err := mdns.GetLocalIPs()
+	if err != nil {
+		log.Debug(err)
+		return urls
+	}
+
+	for _, localIP := range localIPs {
+		urls = append(urls, fmt.Sprintf("http://%s", net.JoinHostPort(localIP.String(), fmt.Sprint(hostPort))))
+	}
+
+	return urls
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -61,7 +62,7 @@ var prebuildAddCmd = &cobra.Command{
 			}
 
 			if projectConfig.Name == selection.NewProjectConfigIdentifier {
-				projectConfig, err = projectconfig.RunProjectConfigAddFlow(apiClient, gitProviders, ctx)
+				projectConfig, err = projectconfig.RunProjectConfigAddFlow(apiClient, gitProviders, nil, ctx)
 				if err != nil {
 					return err
 				}
@@ -81,7 +82,7 @@ var prebuildAddCmd = &cobra.Command{
 			}
 
 			if chosenBranch == nil {
-				fmt.Println("Operation canceled")
+				fmt.Println(i18n.T("Operation canceled"))
 				return nil
 			}
 			prebuildAddView.RunBuildOnAdd = runFlag
@@ -21,4 +21,5 @@ func init() {
 	PrebuildCmd.AddCommand(prebuildInfoCmd)
 	PrebuildCmd.AddCommand(prebuildUpdateCmd)
 	PrebuildCmd.AddCommand(prebuildDeleteCmd)
+	PrebuildCmd.AddCommand(prebuildTriggerCmd)
 }
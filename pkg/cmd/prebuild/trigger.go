@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package prebuild
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var prebuildTriggerCmd = &cobra.Command{
+	Use:   "trigger [PROJECT_CONFIG] [PREBUILD]",
+	Short: "Trigger a prebuild for the latest commit on the prebuild branch",
+	Args:  cobra.MaximumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var selectedPrebuildId string
+		var selectedProjectConfigName string
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		if len(args) < 2 {
+			var prebuilds []apiclient.PrebuildDTO
+			var res *http.Response
+
+			if len(args) == 1 {
+				prebuilds, res, err = apiClient.PrebuildAPI.ListPrebuildsForProjectConfig(context.Background(), args[0]).Execute()
+				if err != nil {
+					return apiclient_util.HandleErrorResponse(res, err)
+				}
+			} else {
+				prebuilds, res, err = apiClient.PrebuildAPI.ListPrebuilds(context.Background()).Execute()
+				if err != nil {
+					return apiclient_util.HandleErrorResponse(res, err)
+				}
+			}
+
+			if len(prebuilds) == 0 {
+				views_util.NotifyEmptyPrebuildList(false)
+				return nil
+			}
+
+			selectedPrebuild := selection.GetPrebuildFromPrompt(prebuilds, "Trigger")
+			if selectedPrebuild == nil {
+				return nil
+			}
+			selectedPrebuildId = selectedPrebuild.Id
+			selectedProjectConfigName = selectedPrebuild.ProjectConfigName
+		} else {
+			selectedProjectConfigName = args[0]
+			selectedPrebuildId = args[1]
+		}
+
+		buildId, res, err := apiClient.PrebuildAPI.TriggerPrebuild(context.Background(), selectedProjectConfigName, selectedPrebuildId).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(i18n.T("Prebuild triggered. Build ID: %s", buildId))
+
+		return nil
+	},
+}
@@ -4,8 +4,12 @@
 package profile
 
 import (
+	"errors"
+
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/endpoint"
+	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/views/profile"
 
 	"github.com/spf13/cobra"
@@ -60,10 +64,30 @@ func addProfile(profileView profile.ProfileAddView, c *config.Config, notify boo
 			Url: profileView.ApiUrl,
 			Key: profileView.ApiKey,
 		},
+		E2EEncryption:         e2eEncryptionFlag,
+		ProxyJump:             proxyJumpFlag,
+		ProxyJumpIdentityFile: proxyJumpIdentityFileFlag,
+		Proxy:                 proxyFlag,
+		Restricted:            restrictedFlag,
+		DefaultTarget:         defaultTargetFlag,
+		DockerHost:            dockerHostFlag,
+		DockerContext:         dockerContextFlag,
+		Transport:             config.ServerTransport(transportFlag),
+		SocketPath:            socketPathFlag,
+	}
+
+	err := validateDockerHost(newProfile)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateTransport(newProfile)
+	if err != nil {
+		return "", err
 	}
 
 	newProfile.Api.Url = profileView.ApiUrl
-	err := c.AddProfile(newProfile)
+	err = c.AddProfile(newProfile)
 	if err != nil {
 		return "", err
 	}
@@ -81,9 +105,58 @@ func addProfile(profileView profile.ProfileAddView, c *config.Config, notify boo
 var profileNameFlag string
 var apiUrlFlag string
 var apiKeyFlag string
+var e2eEncryptionFlag bool
+var proxyJumpFlag string
+var proxyJumpIdentityFileFlag string
+var proxyFlag string
+var restrictedFlag bool
+var defaultTargetFlag string
+var dockerHostFlag string
+var dockerContextFlag string
+var transportFlag string
+var socketPathFlag string
+
+const restrictedFlagDescription = "Only allow commands that don't modify resources (e.g. list, info, ssh) while the profile is active"
+const defaultTargetFlagDescription = "Target used for new workspaces if none is specified"
+const dockerHostFlagDescription = "Docker daemon used to reach local project containers directly (e.g. tcp://10.0.0.5:2376)"
+const dockerContextFlagDescription = "Docker CLI context used to reach local project containers directly"
+const transportFlagDescription = "How to connect to the server: auto, unix (local socket), tcp (API URL) or ssh (socket on the jump host)"
+const socketPathFlagDescription = "Path of the server API socket for the unix and ssh transports"
 
 func init() {
 	ProfileAddCmd.Flags().StringVarP(&profileNameFlag, "name", "n", "", "Profile name")
 	ProfileAddCmd.Flags().StringVarP(&apiUrlFlag, "api-url", "a", "", "API URL")
 	ProfileAddCmd.Flags().StringVarP(&apiKeyFlag, "api-key", "k", "", "API Key")
+	ProfileAddCmd.Flags().BoolVar(&e2eEncryptionFlag, "e2e-encryption", false, "Encrypt toolbox requests end-to-end between the client and project agents. Projects created with the profile reject unencrypted toolbox requests")
+	ProfileAddCmd.Flags().StringVar(&proxyJumpFlag, "proxy-jump", "", "Jump host used to reach the server ([user@]host[:port])")
+	ProfileAddCmd.Flags().StringVar(&proxyJumpIdentityFileFlag, "proxy-jump-identity-file", "", "Private key used to authenticate with the jump host")
+	ProfileAddCmd.Flags().StringVar(&proxyFlag, "proxy", "", "Proxy URL used to reach the server (http, https or socks5)")
+	ProfileAddCmd.Flags().BoolVar(&restrictedFlag, "restricted", false, restrictedFlagDescription)
+	ProfileAddCmd.Flags().StringVar(&defaultTargetFlag, "default-target", "", defaultTargetFlagDescription)
+	ProfileAddCmd.Flags().StringVar(&dockerHostFlag, "docker-host", "", dockerHostFlagDescription)
+	ProfileAddCmd.Flags().StringVar(&dockerContextFlag, "docker-context", "", dockerContextFlagDescription)
+	ProfileAddCmd.Flags().StringVar(&transportFlag, "transport", "", transportFlagDescription)
+	ProfileAddCmd.Flags().StringVar(&socketPathFlag, "socket-path", "", socketPathFlagDescription)
+	ProfileAddCmd.MarkFlagsMutuallyExclusive("proxy", "proxy-jump")
+	ProfileAddCmd.MarkFlagsMutuallyExclusive("docker-host", "docker-context")
+}
+
+func validateDockerHost(p config.Profile) error {
+	_, err := docker.ResolveDockerEndpoint(docker.DockerHostConfig{
+		Host:    p.DockerHost,
+		Context: p.DockerContext,
+	})
+	return err
+}
+
+func validateTransport(p config.Profile) error {
+	if !endpoint.IsValidTransport(string(p.Transport)) {
+		return endpoint.ErrInvalidTransport(string(p.Transport))
+	}
+
+	if p.Transport == config.ServerTransportSsh && p.ProxyJump == "" {
+		return errors.New("the ssh transport requires the SSH host of the server, set it with --proxy-jump")
+	}
+
+	return nil
 }
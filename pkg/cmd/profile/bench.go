@@ -0,0 +1,324 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	profile_view "github.com/daytonaio/daytona/pkg/views/profile"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+)
+
+var benchSizeFlag int
+var benchSamplesFlag int
+var benchWorkspaceFlag string
+var benchProjectFlag string
+var benchHistoryFlag bool
+
+var profileBenchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "Measure the latency and throughput of the connection to the server of the active profile",
+	Long:  "Measure the round-trip latency and the HTTP and stream throughput to the server of the active profile and, if a workspace is given, the SSH throughput to one of its projects.\nResults are stored so that each run can be compared with the previous ones.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		history, err := config.GetBenchHistory(activeProfile.Id)
+		if err != nil {
+			return err
+		}
+
+		if benchHistoryFlag {
+			if format.FormatFlag != "" {
+				format.NewFormatter(history).Print()
+				return nil
+			}
+
+			if len(history) == 0 {
+				views.RenderInfoMessage("No benchmark results found. Run 'daytona profile bench' to measure the connection")
+				return nil
+			}
+
+			profile_view.RenderBenchHistory(history)
+			return nil
+		}
+
+		if benchSizeFlag <= 0 || benchSamplesFlag <= 0 {
+			return errors.New("size and samples must be positive")
+		}
+		size := int64(benchSizeFlag) * 1024 * 1024
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		result := config.BenchResult{Time: time.Now()}
+
+		views.RenderInfoMessage(fmt.Sprintf("Measuring the connection to %s...", activeProfile.Api.Url))
+
+		result.LatencyMs, result.LatencyMaxMs, err = measureLatency(ctx, apiClient, benchSamplesFlag)
+		if err != nil {
+			return fmt.Errorf("failed to measure the latency: %w", err)
+		}
+
+		result.DownloadMbps, err = measureDownload(ctx, apiClient, activeProfile.Api.Url, size)
+		if err != nil {
+			return fmt.Errorf("failed to measure the download throughput: %w", err)
+		}
+
+		// The upload would only be printed in dry-run mode
+		if !apiclient_util.IsDryRun() {
+			result.UploadMbps, err = measureUpload(ctx, apiClient, activeProfile.Api.Url, size)
+			if err != nil {
+				return fmt.Errorf("failed to measure the upload throughput: %w", err)
+			}
+		}
+
+		result.StreamMbps, err = measureStream(ctx, &activeProfile, size)
+		if err != nil {
+			return fmt.Errorf("failed to measure the stream throughput: %w", err)
+		}
+
+		if benchWorkspaceFlag != "" {
+			result.SshMbps, err = measureSsh(&activeProfile, benchWorkspaceFlag, benchProjectFlag, size)
+			if err != nil {
+				return fmt.Errorf("failed to measure the SSH throughput: %w", err)
+			}
+		}
+
+		err = config.SaveBenchResult(activeProfile.Id, result)
+		if err != nil {
+			return err
+		}
+
+		if format.FormatFlag != "" {
+			format.NewFormatter(result).Print()
+			return nil
+		}
+
+		profile_view.RenderBenchResult(result, history)
+
+		if benchWorkspaceFlag == "" {
+			views.RenderTip("Use --workspace to also measure the SSH throughput to a workspace.")
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	profileBenchCmd.Flags().IntVar(&benchSizeFlag, "size", 10, "Amount of data in MB transferred by each throughput measurement")
+	profileBenchCmd.Flags().IntVar(&benchSamplesFlag, "samples", 10, "Number of requests the latency is measured with")
+	profileBenchCmd.Flags().StringVarP(&benchWorkspaceFlag, "workspace", "w", "", "Also measure the SSH throughput to a project of the workspace")
+	profileBenchCmd.Flags().StringVarP(&benchProjectFlag, "project", "p", "", "Project of the workspace to measure the SSH throughput to. Defaults to the first project")
+	profileBenchCmd.Flags().BoolVar(&benchHistoryFlag, "history", false, "Show the stored results instead of running the benchmark")
+	format.RegisterFormatFlag(profileBenchCmd)
+}
+
+// measureLatency returns the median and the maximum round-trip time of the health check in milliseconds.
+// The first request is not measured since it also opens the connection
+func measureLatency(ctx context.Context, apiClient *apiclient.APIClient, samples int) (float64, float64, error) {
+	_, _, err := apiClient.DefaultAPI.HealthCheck(ctx).Execute()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	durations := []float64{}
+	for i := 0; i < samples; i++ {
+		start := time.Now()
+		_, _, err := apiClient.DefaultAPI.HealthCheck(ctx).Execute()
+		if err != nil {
+			return 0, 0, err
+		}
+		durations = append(durations, float64(time.Since(start).Microseconds())/1000)
+	}
+
+	slices.Sort(durations)
+
+	return durations[len(durations)/2], durations[len(durations)-1], nil
+}
+
+func measureDownload(ctx context.Context, apiClient *apiclient.APIClient, serverUrl string, size int64) (float64, error) {
+	res, err := doBenchRequest(ctx, apiClient, serverUrl, http.MethodGet, fmt.Sprintf("bench/download?size=%d", size), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	start := time.Now()
+	n, err := io.Copy(io.Discard, res.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	return getMbps(n, time.Since(start)), nil
+}
+
+func measureUpload(ctx context.Context, apiClient *apiclient.APIClient, serverUrl string, size int64) (float64, error) {
+	start := time.Now()
+
+	res, err := doBenchRequest(ctx, apiClient, serverUrl, http.MethodPost, "bench/upload", io.LimitReader(newBenchDataReader(), size))
+	if err != nil {
+		return 0, err
+	}
+	res.Body.Close()
+
+	return getMbps(size, time.Since(start)), nil
+}
+
+// measureStream measures the throughput of the websocket transport that the log streams use
+func measureStream(ctx context.Context, profile *config.Profile, size int64) (float64, error) {
+	query := fmt.Sprintf("size=%d", size)
+	ws, res, err := apiclient_util.GetWebsocketConn(ctx, "/bench/stream", profile, &query)
+	if err != nil {
+		return 0, apiclient_util.HandleErrorResponse(res, err)
+	}
+	defer ws.Close()
+
+	start := time.Now()
+	received := int64(0)
+
+	for {
+		_, message, err := ws.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				break
+			}
+			return 0, err
+		}
+		received += int64(len(message))
+	}
+
+	return getMbps(received, time.Since(start)), nil
+}
+
+func measureSsh(profile *config.Profile, workspaceId, projectName string, size int64) (float64, error) {
+	ws, err := apiclient_util.GetWorkspace(workspaceId, false)
+	if err != nil {
+		return 0, err
+	}
+
+	projectName, err = apiclient_util.GetFirstWorkspaceProjectName(ws.Id, projectName, profile)
+	if err != nil {
+		return 0, err
+	}
+
+	client, err := util.GetProjectSshClient(profile.Id, ws.Id, projectName)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return 0, err
+	}
+	defer session.Close()
+
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+
+	err = session.Start(fmt.Sprintf("head -c %d /dev/zero", size))
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(io.Discard, stdout)
+	if err != nil {
+		return 0, err
+	}
+
+	err = session.Wait()
+	if err != nil {
+		return 0, err
+	}
+
+	return getMbps(n, time.Since(start)), nil
+}
+
+// doBenchRequest sends a raw request with the authentication and the transport of the API client
+// since the generated client buffers the whole response
+func doBenchRequest(ctx context.Context, apiClient *apiclient.APIClient, serverUrl, method, path string, body io.Reader) (*http.Response, error) {
+	requestUrl, err := url.JoinPath(serverUrl, "/")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestUrl+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range apiClient.GetConfig().DefaultHeader {
+		req.Header.Set(key, value)
+	}
+
+	res, err := apiClient.GetConfig().HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		res.Body.Close()
+		return nil, fmt.Errorf("the server responded with %s", res.Status)
+	}
+
+	return res, nil
+}
+
+func getMbps(bytes int64, duration time.Duration) float64 {
+	if duration <= 0 {
+		return 0
+	}
+
+	return float64(bytes) * 8 / duration.Seconds() / 1e6
+}
+
+// benchDataReader repeats a random chunk so that compressing proxies don't skew the throughput
+type benchDataReader struct {
+	chunk  []byte
+	offset int
+}
+
+func newBenchDataReader() *benchDataReader {
+	chunk := make([]byte, 32*1024)
+	_, _ = rand.Read(chunk)
+	return &benchDataReader{chunk: chunk}
+}
+
+func (r *benchDataReader) Read(p []byte) (int, error) {
+	n := copy(p, r.chunk[r.offset:])
+	r.offset = (r.offset + n) % len(r.chunk)
+	return n, nil
+}
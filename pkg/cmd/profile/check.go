@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var profileCheckCmd = &cobra.Command{
+	Use:   "check [PROFILE_NAME]",
+	Short: "Check the profile's server connection and local Docker configuration",
+	Args:  cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		var chosenProfile *config.Profile
+
+		if len(args) == 0 {
+			activeProfile, err := c.GetActiveProfile()
+			if err != nil {
+				return err
+			}
+			chosenProfile = &activeProfile
+		} else {
+			for _, profile := range c.Profiles {
+				if profile.Id == args[0] || profile.Name == args[0] {
+					chosenProfile = &profile
+					break
+				}
+			}
+		}
+
+		if chosenProfile == nil {
+			return errors.New(i18n.T("profile does not exist"))
+		}
+
+		_, err = apiclient_util.GetApiClient(chosenProfile)
+		if err != nil {
+			log.Warn(err)
+		} else {
+			renderCheckPassed(fmt.Sprintf("Daytona Server is reachable at %s", chosenProfile.Api.Url))
+		}
+
+		// The Docker daemon can only be inspected when the server runs on this machine or a Docker host is configured
+		dockerHostConfig := workspace_util.GetDockerHostConfig(*chosenProfile)
+		if chosenProfile.Id != "default" && dockerHostConfig.IsEmpty() {
+			return nil
+		}
+
+		for _, warning := range checkDocker(dockerHostConfig) {
+			log.Warn(warning)
+		}
+
+		return nil
+	},
+}
+
+func checkDocker(dockerHostConfig docker.DockerHostConfig) []string {
+	cli, err := docker.NewApiClient(dockerHostConfig)
+	if err != nil {
+		return []string{fmt.Sprintf("failed to create Docker client: %v", err)}
+	}
+	defer cli.Close()
+
+	info, err := cli.Info(context.Background())
+	if err != nil && !dockerHostConfig.IsEmpty() {
+		return []string{fmt.Sprintf("failed to connect to the Docker daemon (%s): %v", dockerHostConfig, err)}
+	}
+	if err != nil {
+		return []string{fmt.Sprintf("failed to connect to the Docker daemon: %v. If Docker runs in rootless mode, make sure DOCKER_HOST points to the rootless socket (e.g. unix://$XDG_RUNTIME_DIR/docker.sock). If Podman is used, enable its socket with 'systemctl --user enable --now podman.socket'", err)}
+	}
+
+	runtimeName := "Docker"
+	version, err := cli.ServerVersion(context.Background())
+	if err == nil && docker.GetRuntimeFromVersion(version) == docker.RuntimePodman {
+		runtimeName = "Podman"
+	}
+
+	renderCheckPassed(fmt.Sprintf("%s daemon is reachable at %s (version %s)", runtimeName, cli.DaemonHost(), info.ServerVersion))
+
+	var warnings []string
+
+	switch docker.GetUsernsModeFromSecurityOptions(info.SecurityOptions) {
+	case docker.UsernsModeRootless:
+		renderCheckPassed(fmt.Sprintf("%s is running in rootless mode", runtimeName))
+		if runtime.GOOS != "windows" && os.Geteuid() == 0 {
+			warnings = append(warnings, fmt.Sprintf("%s is running in rootless mode but Daytona is running as root. Project files will not be accessible to the daemon user. Run Daytona as the user that owns the rootless %s daemon", runtimeName, runtimeName))
+		}
+	case docker.UsernsModeRemap:
+		renderCheckPassed("Docker is running with user namespace remapping. Project containers require privileged mode and will use the host user namespace")
+		_, err := os.Stat("/etc/subuid")
+		if err != nil {
+			warnings = append(warnings, "Docker is running with userns-remap but /etc/subuid could not be read. Make sure the remapped user has subordinate UID and GID ranges configured")
+		}
+	}
+
+	return warnings
+}
+
+func renderCheckPassed(message string) {
+	views.RenderListLine(fmt.Sprintf("%s %s", views.CheckmarkSymbol, message))
+}
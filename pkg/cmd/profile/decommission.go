@@ -0,0 +1,208 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/profile"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var decommissionYesFlag bool
+var decommissionForceFlag bool
+
+var profileDecommissionCmd = &cobra.Command{
+	Use:   "decommission [PROFILE]",
+	Short: "Destroy the resources of a profile on its server and remove the profile",
+	Long:  "List the workspaces and prebuilds on the server of the profile, destroy the selected ones and finally remove the profile together with its SSH config entries and pinned encryption keys",
+	Args:  cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		var chosenProfile *config.Profile
+
+		if len(args) == 0 {
+			chosenProfile, err = profile.GetProfileFromPrompt(c.Profiles, c.ActiveProfileId, false)
+			if err != nil {
+				return err
+			}
+
+			if chosenProfile == nil {
+				return nil
+			}
+		} else {
+			for _, p := range c.Profiles {
+				if p.Id == args[0] || p.Name == args[0] {
+					chosenProfile = &p
+					break
+				}
+			}
+		}
+
+		if chosenProfile == nil {
+			return errors.New(i18n.T("profile does not exist"))
+		}
+
+		if chosenProfile.Id == "default" {
+			return errors.New("can not decommission default profile")
+		}
+
+		// Nothing is destroyed in dry-run mode so there is nothing to confirm
+		if apiclient_util.IsDryRun() {
+			decommissionYesFlag = true
+		}
+
+		ctx := context.Background()
+
+		var items []profile.DecommissionItem
+		apiClient, err := apiclient_util.NewApiClient(*chosenProfile)
+		if err != nil {
+			log.Warnf("Server resources can not be destroyed: %v", err)
+		} else {
+			if chosenProfile.Restricted {
+				return fmt.Errorf("can not destroy server resources with the restricted profile %s", chosenProfile.Name)
+			}
+
+			items, err = listDecommissionItems(ctx, apiClient)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !decommissionYesFlag {
+			if len(items) > 0 {
+				items, err = profile.SelectDecommissionItems(chosenProfile.Name, items)
+				if err != nil {
+					return err
+				}
+			}
+
+			confirmed, err := profile.ConfirmDecommission(chosenProfile.Name, items)
+			if err != nil {
+				return err
+			}
+
+			if !confirmed {
+				fmt.Println(i18n.T("Operation canceled."))
+				return nil
+			}
+		}
+
+		failed := 0
+		for i, item := range items {
+			message := fmt.Sprintf("[%d/%d] Destroying %s", i+1, len(items), item)
+			err := views_util.WithInlineSpinner(message, func() error {
+				return destroyDecommissionItem(ctx, apiClient, item)
+			})
+			if err != nil {
+				log.Errorf("Failed to destroy %s: %v", item, err)
+				failed++
+				continue
+			}
+			if !apiclient_util.IsDryRun() {
+				views.RenderInfoMessage(fmt.Sprintf("%s destroyed", item))
+			}
+		}
+
+		// The profile is kept so that the remaining resources can still be reached
+		if failed > 0 {
+			return fmt.Errorf("%d resource(s) could not be destroyed. Profile %s was not removed", failed, chosenProfile.Name)
+		}
+
+		if apiclient_util.IsDryRun() {
+			fmt.Printf("[dry-run] Would remove profile %s with its SSH config entries, pinned SSH host keys and pinned encryption keys\n", chosenProfile.Name)
+			return nil
+		}
+
+		err = config.RemoveProfileSshEntries(chosenProfile.Id)
+		if err != nil {
+			log.Warnf("failed to remove the SSH config entries of the profile: %v", err)
+		}
+
+		err = config.RemoveProfileE2EKeys(chosenProfile.Id)
+		if err != nil {
+			log.Warnf("failed to remove the pinned encryption keys of the profile: %v", err)
+		}
+
+		err = deleteProfile(c, chosenProfile)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Profile %s decommissioned", chosenProfile.Name))
+		return nil
+	},
+}
+
+func listDecommissionItems(ctx context.Context, apiClient *apiclient.APIClient) ([]profile.DecommissionItem, error) {
+	items := []profile.DecommissionItem{}
+
+	workspaces, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for _, workspace := range workspaces {
+		items = append(items, profile.DecommissionItem{
+			Kind:        profile.DecommissionItemWorkspace,
+			Id:          workspace.Id,
+			Name:        workspace.Name,
+			Description: fmt.Sprintf("target %s, %d project(s)", workspace.Target, len(workspace.Projects)),
+		})
+	}
+
+	prebuilds, res, err := apiClient.PrebuildAPI.ListPrebuilds(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for _, prebuild := range prebuilds {
+		items = append(items, profile.DecommissionItem{
+			Kind:        profile.DecommissionItemPrebuild,
+			Id:          prebuild.Id,
+			Name:        prebuild.ProjectConfigName,
+			Description: fmt.Sprintf("branch %s", prebuild.Branch),
+			Parent:      prebuild.ProjectConfigName,
+		})
+	}
+
+	return items, nil
+}
+
+func destroyDecommissionItem(ctx context.Context, apiClient *apiclient.APIClient, item profile.DecommissionItem) error {
+	switch item.Kind {
+	case profile.DecommissionItemWorkspace:
+		res, err := apiClient.WorkspaceAPI.RemoveWorkspace(ctx, item.Id).Force(decommissionForceFlag).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+	case profile.DecommissionItemPrebuild:
+		res, err := apiClient.PrebuildAPI.DeletePrebuild(ctx, item.Parent, item.Id).Force(decommissionForceFlag).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+	default:
+		return fmt.Errorf("unknown resource kind %s", item.Kind)
+	}
+
+	return nil
+}
+
+func init() {
+	profileDecommissionCmd.Flags().BoolVarP(&decommissionYesFlag, "yes", "y", false, "Destroy all resources and remove the profile without prompt")
+	profileDecommissionCmd.Flags().BoolVarP(&decommissionForceFlag, "force", "f", false, "Destroy resources by force")
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views/profile"
 
 	log "github.com/sirupsen/logrus"
@@ -56,24 +57,38 @@ var profileDeleteCmd = &cobra.Command{
 		}
 
 		if chosenProfile == nil {
-			return errors.New("profile does not exist")
+			return errors.New(i18n.T("profile does not exist"))
 		}
 
-		if c.ActiveProfileId == chosenProfile.Id {
-			c.ActiveProfileId = "default"
-		}
-
-		for _, profile := range c.Profiles {
-			if profile.Name == chosenProfile.Name || profile.Id == chosenProfile.Id {
-				err = c.RemoveProfile(profile.Id)
-				if err != nil {
-					return err
-				}
-				break
-			}
+		err = deleteProfile(c, chosenProfile)
+		if err != nil {
+			return err
 		}
 
 		log.Infof("Deleted profile %s", chosenProfile.Name)
 		return nil
 	},
 }
+
+func deleteProfile(c *config.Config, profileToDelete *config.Profile) error {
+	if c.ActiveProfileId == profileToDelete.Id {
+		c.ActiveProfileId = "default"
+	}
+
+	for _, profile := range c.Profiles {
+		if profile.Name == profileToDelete.Name || profile.Id == profileToDelete.Id {
+			err := c.RemoveProfile(profile.Id)
+			if err != nil {
+				return err
+			}
+
+			err = config.DeleteBenchHistory(profile.Id)
+			if err != nil {
+				log.Warnf("failed to delete the benchmark history of the profile: %v", err)
+			}
+			break
+		}
+	}
+
+	return nil
+}
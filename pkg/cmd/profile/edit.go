@@ -7,14 +7,16 @@ import (
 	"errors"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views/profile"
 
 	"github.com/spf13/cobra"
 )
 
 var profileEditCmd = &cobra.Command{
-	Use:   "edit",
-	Short: "Edit profile [PROFILE_NAME]",
+	Use:   "edit [PROFILE]",
+	Short: "Edit profile",
+	Long:  "Edit the server connection, API key and jump host of a profile in a form pre-populated with its current settings",
 	Args:  cobra.RangeArgs(0, 1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		c, err := config.GetConfig()
@@ -45,7 +47,7 @@ var profileEditCmd = &cobra.Command{
 		}
 
 		if chosenProfile == nil {
-			return errors.New("profile does not exist")
+			return errors.New(i18n.T("profile does not exist"))
 		}
 
 		if profileNameFlag != "" {
@@ -57,6 +59,47 @@ var profileEditCmd = &cobra.Command{
 		if apiKeyFlag != "" {
 			chosenProfile.Api.Key = apiKeyFlag
 		}
+		if cmd.Flags().Changed("e2e-encryption") {
+			chosenProfile.E2EEncryption = e2eEncryptionFlag
+		}
+		if cmd.Flags().Changed("proxy-jump") {
+			chosenProfile.ProxyJump = proxyJumpFlag
+		}
+		if cmd.Flags().Changed("proxy-jump-identity-file") {
+			chosenProfile.ProxyJumpIdentityFile = proxyJumpIdentityFileFlag
+		}
+		if cmd.Flags().Changed("proxy") {
+			chosenProfile.Proxy = proxyFlag
+		}
+		if cmd.Flags().Changed("restricted") {
+			chosenProfile.Restricted = restrictedFlag
+		}
+		if cmd.Flags().Changed("default-target") {
+			chosenProfile.DefaultTarget = defaultTargetFlag
+		}
+		if cmd.Flags().Changed("docker-host") {
+			chosenProfile.DockerHost = dockerHostFlag
+		}
+		if cmd.Flags().Changed("docker-context") {
+			chosenProfile.DockerContext = dockerContextFlag
+		}
+		if cmd.Flags().Changed("transport") {
+			chosenProfile.Transport = config.ServerTransport(transportFlag)
+		}
+		if cmd.Flags().Changed("socket-path") {
+			chosenProfile.SocketPath = socketPathFlag
+		}
+		if chosenProfile.Proxy != "" && chosenProfile.ProxyJump != "" {
+			return errors.New("a profile can not have both a proxy and a jump host")
+		}
+		err = validateDockerHost(*chosenProfile)
+		if err != nil {
+			return err
+		}
+		err = validateTransport(*chosenProfile)
+		if err != nil {
+			return err
+		}
 
 		if profileNameFlag == "" || apiUrlFlag == "" || apiKeyFlag == "" {
 			return EditProfile(c, true, chosenProfile)
@@ -77,15 +120,31 @@ func EditProfile(c *config.Config, notify bool, profileToEdit *config.Profile) e
 		return errors.New("profile must not be nil")
 	}
 
-	profileAddView := profile.ProfileAddView{
-		ProfileName: profileToEdit.Name,
-		ApiUrl:      profileToEdit.Api.Url,
-		ApiKey:      profileToEdit.Api.Key,
+	profileEditView, err := profile.NewProfileEditView(*profileToEdit)
+	if err != nil {
+		return err
 	}
 
-	profile.ProfileCreationView(c, &profileAddView, true)
+	err = profile.ProfileEditFormView(c, profileToEdit.Id, profileEditView)
+	if err != nil {
+		return err
+	}
+
+	profileToEdit.ProxyJump = profileEditView.ProxyJump()
+	profileToEdit.ProxyJumpIdentityFile = profileEditView.ProxyJumpIdentityFile()
+	if profileToEdit.Proxy != "" && profileToEdit.ProxyJump != "" {
+		return errors.New("a profile can not have both a proxy and a jump host")
+	}
+	err = validateTransport(*profileToEdit)
+	if err != nil {
+		return err
+	}
 
-	return editProfile(profileToEdit, profileAddView, c, notify)
+	return editProfile(profileToEdit, profile.ProfileAddView{
+		ProfileName: profileEditView.ProfileName,
+		ApiUrl:      profileEditView.ApiUrl(),
+		ApiKey:      profileEditView.ApiKey,
+	}, c, notify)
 }
 
 func editProfile(profileToEdit *config.Profile, profileView profile.ProfileAddView, c *config.Config, notify bool) error {
@@ -114,4 +173,14 @@ func init() {
 	profileEditCmd.Flags().StringVarP(&profileNameFlag, "name", "n", "", "Profile name")
 	profileEditCmd.Flags().StringVarP(&apiUrlFlag, "api-url", "a", "", "API URL")
 	profileEditCmd.Flags().StringVarP(&apiKeyFlag, "api-key", "k", "", "API Key")
+	profileEditCmd.Flags().BoolVar(&e2eEncryptionFlag, "e2e-encryption", false, "Encrypt toolbox requests end-to-end between the client and project agents. Projects created with the profile reject unencrypted toolbox requests")
+	profileEditCmd.Flags().StringVar(&proxyJumpFlag, "proxy-jump", "", "Jump host used to reach the server ([user@]host[:port]). Set to an empty value to remove it")
+	profileEditCmd.Flags().StringVar(&proxyJumpIdentityFileFlag, "proxy-jump-identity-file", "", "Private key used to authenticate with the jump host. Set to an empty value to use the SSH config and agent")
+	profileEditCmd.Flags().StringVar(&proxyFlag, "proxy", "", "Proxy URL used to reach the server (http, https or socks5). Set to an empty value to remove it")
+	profileEditCmd.Flags().BoolVar(&restrictedFlag, "restricted", false, restrictedFlagDescription)
+	profileEditCmd.Flags().StringVar(&defaultTargetFlag, "default-target", "", defaultTargetFlagDescription+". Set to an empty value to use the default target of the server")
+	profileEditCmd.Flags().StringVar(&dockerHostFlag, "docker-host", "", dockerHostFlagDescription+". Set to an empty value to remove it")
+	profileEditCmd.Flags().StringVar(&dockerContextFlag, "docker-context", "", dockerContextFlagDescription+". Set to an empty value to remove it")
+	profileEditCmd.Flags().StringVar(&transportFlag, "transport", "", transportFlagDescription+". Set to an empty value to select it automatically")
+	profileEditCmd.Flags().StringVar(&socketPathFlag, "socket-path", "", socketPathFlagDescription+". Set to an empty value to use the default socket")
 }
@@ -28,7 +28,7 @@ var profileListCmd = &cobra.Command{
 		}
 
 		if format.FormatFlag != "" {
-			formattedData := format.NewFormatter(c.Profiles)
+			formattedData := format.NewFormatter(c.Profiles).WithTable(format.NewTable(profile.GetTable(c.Profiles, c.ActiveProfileId, false)))
 			formattedData.Print()
 			return nil
 		}
@@ -44,5 +44,5 @@ var profileListCmd = &cobra.Command{
 }
 
 func init() {
-	format.RegisterFormatFlag(profileListCmd)
+	format.RegisterTableFormatFlag(profileListCmd)
 }
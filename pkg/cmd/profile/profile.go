@@ -21,4 +21,7 @@ func init() {
 	ProfileCmd.AddCommand(ProfileAddCmd)
 	ProfileCmd.AddCommand(profileEditCmd)
 	ProfileCmd.AddCommand(profileDeleteCmd)
+	ProfileCmd.AddCommand(profileDecommissionCmd)
+	ProfileCmd.AddCommand(profileCheckCmd)
+	ProfileCmd.AddCommand(profileBenchCmd)
 }
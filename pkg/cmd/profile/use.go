@@ -4,9 +4,11 @@
 package profile
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 
 	"github.com/daytonaio/daytona/pkg/views"
@@ -82,7 +84,7 @@ var ProfileUseCmd = &cobra.Command{
 			}
 
 			if chosenProfile == (config.Profile{}) {
-				return fmt.Errorf("profile does not exist: %s", profileArg)
+				return errors.New(i18n.T("profile does not exist: %s", profileArg))
 			}
 
 			c.ActiveProfileId = chosenProfile.Id
@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var ProjectCmd = &cobra.Command{
+	Use:     "project",
+	Aliases: []string{"projects"},
+	Short:   "Manage workspace projects",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+func init() {
+	ProjectCmd.AddCommand(projectRefreshCmd)
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var resetHardFlag bool
+var pullFlag bool
+var forceFlag bool
+
+var projectRefreshCmd = &cobra.Command{
+	Use:   "refresh [WORKSPACE] [PROJECT]",
+	Short: "Fetch the project repository from origin and optionally update the current branch",
+	Args:  cobra.RangeArgs(0, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		if resetHardFlag && pullFlag {
+			return errors.New(i18n.T("--reset-hard and --pull can not be used together"))
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		var workspace *apiclient.WorkspaceDTO
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(workspaceList) == 0 {
+				views_util.NotifyEmptyWorkspaceList(true)
+				return nil
+			}
+
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Refresh")
+			if workspace == nil {
+				return nil
+			}
+		} else {
+			workspace, err = apiclient_util.GetWorkspace(args[0], false)
+			if err != nil {
+				return err
+			}
+		}
+
+		var projectName string
+		if len(args) == 2 {
+			projectName = args[1]
+		} else if len(workspace.Projects) == 1 {
+			projectName = workspace.Projects[0].Name
+		} else {
+			project := selection.GetProjectFromPrompt(workspace.Projects, "Refresh")
+			if project == nil {
+				return nil
+			}
+			projectName = project.Name
+		}
+
+		projectDir, res, err := apiClient.WorkspaceToolboxAPI.GetProjectDir(ctx, workspace.Id, projectName).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		var status *apiclient.GitStatus
+		err = views_util.WithInlineSpinner(i18n.T("Refreshing project"), func() error {
+			status, res, err = apiClient.WorkspaceToolboxAPI.GitRefreshRepository(ctx, workspace.Id, projectName).Params(apiclient.GitRefreshRequest{
+				Path:      projectDir.GetDir(),
+				ResetHard: &resetHardFlag,
+				Pull:      &pullFlag,
+				Force:     &forceFlag,
+			}).Execute()
+			if err != nil {
+				if res != nil && res.StatusCode == http.StatusConflict {
+					apiErr := apiclient_util.HandleErrorResponse(res, err)
+					if strings.Contains(apiErr.Error(), git.ErrUnpushedCommits.Error()) {
+						return errors.New(i18n.T("project '%s' has commits that are not pushed. Push them, or use --force to discard them", projectName))
+					}
+					return errors.New(i18n.T("project '%s' has uncommitted changes. Commit or stash them, or use --force to skip this check", projectName))
+				}
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(i18n.T("Project '%s' refreshed on branch '%s' (%d ahead, %d behind origin)", projectName, status.CurrentBranch, status.GetAhead(), status.GetBehind()))
+		return nil
+	},
+}
+
+func init() {
+	projectRefreshCmd.Flags().BoolVar(&resetHardFlag, "reset-hard", false, "Reset the current branch to its upstream, discarding local changes and commits")
+	projectRefreshCmd.Flags().BoolVar(&pullFlag, "pull", false, "Fast-forward the current branch to its upstream")
+	projectRefreshCmd.Flags().BoolVarP(&forceFlag, "force", "f", false, "Skip the uncommitted changes and unpushed commits checks")
+}
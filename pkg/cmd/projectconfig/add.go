@@ -30,6 +30,11 @@ var projectConfigAddCmd = &cobra.Command{
 		var projectConfigName *string
 		ctx := context.Background()
 
+		forwardPorts, err := getForwardPortsFromFlag()
+		if err != nil {
+			return err
+		}
+
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			return err
@@ -41,7 +46,7 @@ var projectConfigAddCmd = &cobra.Command{
 		}
 
 		if len(args) == 0 {
-			projectConfig, err = RunProjectConfigAddFlow(apiClient, gitProviders, ctx)
+			projectConfig, err = RunProjectConfigAddFlow(apiClient, gitProviders, forwardPorts, ctx)
 			if err != nil {
 				return err
 			}
@@ -50,7 +55,7 @@ var projectConfigAddCmd = &cobra.Command{
 			}
 			projectConfigName = &projectConfig.Name
 		} else {
-			projectConfigName, err = processCmdArgument(args[0], apiClient, ctx)
+			projectConfigName, err = processCmdArgument(args[0], apiClient, forwardPorts, ctx)
 			if err != nil {
 				return err
 			}
@@ -65,7 +70,7 @@ var projectConfigAddCmd = &cobra.Command{
 	},
 }
 
-func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apiclient.GitProvider, ctx context.Context) (*apiclient.ProjectConfig, error) {
+func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apiclient.GitProvider, forwardPorts []int32, ctx context.Context) (*apiclient.ProjectConfig, error) {
 	if workspace_util.CheckAnyProjectConfigurationFlagSet(projectConfigurationFlags) {
 		return nil, errors.New("please provide the repository URL in order to set up custom project config details through the CLI")
 	}
@@ -145,6 +150,7 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		RepositoryUrl:       createDtos[0].Source.Repository.Url,
 		EnvVars:             createDtos[0].EnvVars,
 		GitProviderConfigId: createDtos[0].GitProviderConfigId,
+		ForwardPorts:        forwardPorts,
 	}
 
 	res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(createProjectConfig).Execute()
@@ -160,6 +166,7 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		Prebuilds:           nil,
 		RepositoryUrl:       createProjectConfig.RepositoryUrl,
 		GitProviderConfigId: createProjectConfig.GitProviderConfigId,
+		ForwardPorts:        createProjectConfig.ForwardPorts,
 	}
 
 	if createProjectConfig.Image != nil {
@@ -181,7 +188,7 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 	return &projectConfig, nil
 }
 
-func processCmdArgument(argument string, apiClient *apiclient.APIClient, ctx context.Context) (*string, error) {
+func processCmdArgument(argument string, apiClient *apiclient.APIClient, forwardPorts []int32, ctx context.Context) (*string, error) {
 	if *projectConfigurationFlags.Builder != "" && *projectConfigurationFlags.Builder != views_util.DEVCONTAINER && *projectConfigurationFlags.DevcontainerPath != "" {
 		return nil, fmt.Errorf("can't set devcontainer file path if builder is not set to %s", views_util.DEVCONTAINER)
 	}
@@ -242,6 +249,7 @@ func processCmdArgument(argument string, apiClient *apiclient.APIClient, ctx con
 		RepositoryUrl:       repoUrl,
 		EnvVars:             project.EnvVars,
 		GitProviderConfigId: project.GitProviderConfigId,
+		ForwardPorts:        forwardPorts,
 	}
 
 	if newProjectConfig.Image == nil {
@@ -276,6 +284,7 @@ func GetExistingProjectConfigNames(apiClient *apiclient.APIClient) ([]string, er
 }
 
 var nameFlag string
+var forwardPortsFlag []int
 
 var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	Builder:           new(views_util.BuildChoice),
@@ -290,5 +299,19 @@ var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 
 func init() {
 	projectConfigAddCmd.Flags().StringVar(&nameFlag, "name", "", "Specify the project config name")
+	projectConfigAddCmd.Flags().IntSliceVar(&forwardPortsFlag, "forward-port", nil, "Port to forward to the local machine while an SSH or IDE connection to the project is open. Can be specified multiple times")
 	workspace_util.AddProjectConfigurationFlags(projectConfigAddCmd, projectConfigurationFlags, false)
 }
+
+func getForwardPortsFromFlag() ([]int32, error) {
+	var forwardPorts []int32
+
+	for _, port := range forwardPortsFlag {
+		if port < 1 || port > 65535 {
+			return nil, fmt.Errorf("invalid forward port %d: port must be a number between 1 and 65535", port)
+		}
+		forwardPorts = append(forwardPorts, int32(port))
+	}
+
+	return forwardPorts, nil
+}
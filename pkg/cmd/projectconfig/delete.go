@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -40,8 +41,8 @@ var projectConfigDeleteCmd = &cobra.Command{
 				form := huh.NewForm(
 					huh.NewGroup(
 						huh.NewConfirm().
-							Title("Delete all project configs?").
-							Description("Are you sure you want to delete all project configs?").
+							Title(i18n.T("Delete all project configs?")).
+							Description(i18n.T("Are you sure you want to delete all project configs?")).
 							Value(&yesFlag),
 					),
 				).WithTheme(views.GetCustomTheme())
@@ -52,7 +53,7 @@ var projectConfigDeleteCmd = &cobra.Command{
 				}
 
 				if !yesFlag {
-					fmt.Println("Operation canceled.")
+					fmt.Println(i18n.T("Operation canceled."))
 					return nil
 				}
 			}
@@ -165,6 +165,7 @@ func importProjectConfig(ctx context.Context, apiClient *apiclient.APIClient, co
 		RepositoryUrl:       config.RepositoryUrl,
 		EnvVars:             config.EnvVars,
 		GitProviderConfigId: config.GitProviderConfigId,
+		ForwardPorts:        config.ForwardPorts,
 	}
 
 	if newProjectConfig.Image == nil {
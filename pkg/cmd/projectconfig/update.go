@@ -26,6 +26,11 @@ var projectConfigUpdateCmd = &cobra.Command{
 		var res *http.Response
 		ctx := context.Background()
 
+		forwardPorts, err := getForwardPortsFromFlag()
+		if err != nil {
+			return err
+		}
+
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			return err
@@ -118,6 +123,11 @@ var projectConfigUpdateCmd = &cobra.Command{
 			RepositoryUrl:       createDto[0].Source.Repository.Url,
 			EnvVars:             createDto[0].EnvVars,
 			GitProviderConfigId: createDto[0].GitProviderConfigId,
+			ForwardPorts:        projectConfig.ForwardPorts,
+		}
+
+		if cmd.Flags().Changed("forward-port") {
+			newProjectConfig.ForwardPorts = forwardPorts
 		}
 
 		res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(newProjectConfig).Execute()
@@ -129,3 +139,7 @@ var projectConfigUpdateCmd = &cobra.Command{
 		return nil
 	},
 }
+
+func init() {
+	projectConfigUpdateCmd.Flags().IntSliceVar(&forwardPortsFlag, "forward-port", nil, "Port to forward to the local machine while an SSH or IDE connection to the project is open. Replaces the ports set on the project config. Can be specified multiple times")
+}
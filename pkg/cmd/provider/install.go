@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/common"
@@ -115,7 +116,7 @@ var providerInstallCmd = &cobra.Command{
 			form := huh.NewForm(
 				huh.NewGroup(
 					huh.NewConfirm().
-						Title("Add a Target?").
+						Title(i18n.T("Add a Target?")).
 						Value(&yesFlag),
 				),
 			).WithTheme(views.GetCustomTheme())
@@ -11,6 +11,7 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/build"
 	server_cmd "github.com/daytonaio/daytona/pkg/cmd/server"
@@ -60,7 +61,7 @@ var purgeCmd = &cobra.Command{
 			if !yesFlag {
 				view.DefaultProfileNoticePrompt(&defaultProfileNoticeConfirm)
 				if !defaultProfileNoticeConfirm {
-					fmt.Println("Operation cancelled.")
+					fmt.Println(i18n.T("Operation cancelled."))
 					return nil
 				}
 			}
@@ -85,7 +86,7 @@ var purgeCmd = &cobra.Command{
 					return nil
 				}
 			} else {
-				fmt.Println("Operation cancelled.")
+				fmt.Println(i18n.T("Operation cancelled."))
 				return nil
 			}
 		}
@@ -93,7 +94,7 @@ var purgeCmd = &cobra.Command{
 		if !yesFlag {
 			view.ConfirmPrompt(&confirmCheck)
 			if !confirmCheck {
-				fmt.Println("Operation cancelled.")
+				fmt.Println(i18n.T("Operation cancelled."))
 				return nil
 			}
 		}
@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views/report"
+	"github.com/spf13/cobra"
+)
+
+var monthFlag string
+
+var egressCmd = &cobra.Command{
+	Use:   "egress",
+	Short: "Show the outbound traffic of workspaces",
+	Long:  "Show the outbound network traffic sent by each workspace project during a month. The server samples the traffic every minute while projects are running",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		month := monthFlag
+		if month == "" {
+			month = time.Now().Format("2006-01")
+		} else if _, err := time.Parse("2006-01", month); err != nil {
+			return fmt.Errorf("invalid month %s, expected the YYYY-MM format", month)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.WorkspaceAPI.ListEgressUsage(ctx).Month(month)
+
+		if workspaceFlag != "" {
+			workspace, err := apiclient_util.GetWorkspace(workspaceFlag, false)
+			if err != nil {
+				return err
+			}
+			req = req.WorkspaceId(workspace.Id)
+		}
+
+		usages, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(usages)
+			formattedData.Print()
+			return nil
+		}
+
+		report.RenderEgressUsage(usages)
+		return nil
+	},
+}
+
+func init() {
+	egressCmd.Flags().StringVarP(&workspaceFlag, "workspace", "w", "", "Only include projects of the specified workspace")
+	egressCmd.Flags().StringVarP(&monthFlag, "month", "m", "", "Month in the YYYY-MM format. Defaults to the current month")
+	format.RegisterFormatFlag(egressCmd)
+}
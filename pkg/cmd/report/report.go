@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var ReportCmd = &cobra.Command{
+	Use:     "report",
+	Short:   "Show reports about workspaces",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+func init() {
+	ReportCmd.AddCommand(timingsCmd)
+	ReportCmd.AddCommand(egressCmd)
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views/report"
+	"github.com/spf13/cobra"
+)
+
+var workspaceFlag string
+var limitFlag int
+
+var timingsCmd = &cobra.Command{
+	Use:   "timings",
+	Short: "Show where the time of workspace creations goes",
+	Long:  "Show the average, median and slowest durations of each project creation phase (queue wait, pull, clone, build and hooks) across recent workspace creations",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.WorkspaceAPI.ListCreationTimings(ctx)
+
+		if workspaceFlag != "" {
+			workspace, err := apiclient_util.GetWorkspace(workspaceFlag, false)
+			if err != nil {
+				return err
+			}
+			req = req.WorkspaceId(workspace.Id)
+		}
+
+		creationTimings, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		// Timings are listed newest first
+		if limitFlag > 0 && len(creationTimings) > limitFlag {
+			creationTimings = creationTimings[:limitFlag]
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(creationTimings)
+			formattedData.Print()
+			return nil
+		}
+
+		report.RenderTimings(creationTimings)
+		return nil
+	},
+}
+
+func init() {
+	timingsCmd.Flags().StringVarP(&workspaceFlag, "workspace", "w", "", "Only include creations of the specified workspace")
+	timingsCmd.Flags().IntVarP(&limitFlag, "limit", "l", 100, "Number of most recent project creations to include. Use 0 to include all")
+	format.RegisterFormatFlag(timingsCmd)
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/spf13/cobra"
+)
+
+// Commands that can be run with a restricted profile. Subcommands of these commands are allowed as well
+var restrictedProfileCommands = []string{
+	"daytona list",
+	"daytona info",
+	"daytona status",
+	"daytona events",
+	"daytona logs",
+	"daytona ssh",
+	"daytona ssh-proxy",
+	// Only forwards the API of the server, which enforces the permissions of the API key
+	"daytona server dial-stdio",
+	"daytona code",
+	"daytona profile list",
+	"daytona whoami",
+	"daytona version",
+	"daytona docs",
+	"daytona help",
+	"daytona completion",
+	"daytona join",
+	"daytona use",
+	"daytona profile use",
+}
+
+// checkRestrictedProfile returns an error if the active profile is restricted and the command could modify resources.
+// The server enforces the same restriction for read-only API keys
+func checkRestrictedProfile(cmd *cobra.Command) error {
+	c, err := config.GetConfig()
+	if err != nil {
+		return nil
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil || !activeProfile.Restricted {
+		return nil
+	}
+
+	if versionFlag, _ := cmd.Flags().GetBool("version"); versionFlag && cmd == cmd.Root() {
+		return nil
+	}
+
+	// Shell completions only list resources
+	if strings.HasPrefix(cmd.Name(), "__complete") {
+		return nil
+	}
+
+	commandPath := cmd.CommandPath()
+	for _, allowedCommand := range restrictedProfileCommands {
+		if commandPath == allowedCommand || strings.HasPrefix(commandPath, allowedCommand+" ") {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s' is not allowed with the restricted profile %s", commandPath, activeProfile.Name)
+}
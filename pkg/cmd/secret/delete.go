@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:     "delete NAME",
+	Short:   "Delete a secret",
+	Aliases: []string{"remove", "rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		if projectFlag != "" && workspaceFlag == "" {
+			return errors.New(i18n.T("--project requires --workspace"))
+		}
+
+		deleteRequest := apiClient.SecretAPI.DeleteSecret(ctx, args[0])
+		if workspaceFlag != "" {
+			deleteRequest = deleteRequest.WorkspaceId(workspaceFlag)
+		}
+		if projectFlag != "" {
+			deleteRequest = deleteRequest.ProjectName(projectFlag)
+		}
+
+		res, err := deleteRequest.Execute()
+		if err != nil {
+			return apiclient.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Secret %s has been deleted", args[0]))
+		return nil
+	},
+}
+
+func init() {
+	deleteCmd.Flags().StringVarP(&workspaceFlag, "workspace", "w", "", "Delete the secret scoped to the specified workspace")
+	deleteCmd.Flags().StringVarP(&projectFlag, "project", "p", "", "Delete the secret scoped to the specified project of the workspace")
+}
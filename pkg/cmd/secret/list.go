@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views/secret"
+	"github.com/spf13/cobra"
+)
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List secrets",
+	Long:    "List secrets and where they are injected. Secret values are never displayed",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		listRequest := apiClient.SecretAPI.ListSecrets(ctx)
+		if workspaceFlag != "" {
+			listRequest = listRequest.WorkspaceId(workspaceFlag)
+		}
+
+		secretList, res, err := listRequest.Execute()
+		if err != nil {
+			return apiclient.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(secretList)
+			formattedData.Print()
+			return nil
+		}
+
+		workspaceNames := map[string]string{}
+		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+		if err != nil {
+			return apiclient.HandleErrorResponse(res, err)
+		}
+		for _, workspace := range workspaceList {
+			workspaceNames[workspace.Id] = workspace.Name
+		}
+
+		secret.ListSecrets(secretList, workspaceNames)
+		return nil
+	},
+}
+
+func init() {
+	listCmd.Flags().StringVarP(&workspaceFlag, "workspace", "w", "", "Only list secrets of the specified workspace")
+	format.RegisterFormatFlag(listCmd)
+}
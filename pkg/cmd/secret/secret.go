@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var SecretCmd = &cobra.Command{
+	Use:     "secret",
+	Short:   "Manage secrets that are stored encrypted on the server and injected into workspaces",
+	Args:    cobra.NoArgs,
+	GroupID: util.PROFILE_GROUP,
+}
+
+var (
+	workspaceFlag string
+	projectFlag   string
+)
+
+func init() {
+	SecretCmd.AddCommand(setCmd)
+	SecretCmd.AddCommand(listCmd)
+	SecretCmd.AddCommand(deleteCmd)
+}
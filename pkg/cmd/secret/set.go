@@ -0,0 +1,131 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var (
+	filePathFlag string
+	fileModeFlag string
+)
+
+var setCmd = &cobra.Command{
+	Use:   "set NAME [VALUE]",
+	Short: "Set a secret",
+	Long: `Set a secret that is injected into workspace projects when they start.
+The value is read from standard input or prompted for if it is not passed as an argument.
+Secrets are injected as environment variables unless a file path is set with --file.
+File secrets are kept in memory inside the project container and linked at the file path.`,
+	Example: `  daytona secret set GITHUB_TOKEN
+  cat id_rsa | daytona secret set deploy-key --file /home/daytona/.ssh/deploy_key --mode 0400
+  daytona secret set DB_PASSWORD --workspace my-workspace
+  daytona secret set gcloud-key --workspace my-workspace --project api --file /home/daytona/.config/gcloud/key.json`,
+	Aliases: []string{"s", "add", "update"},
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		name := args[0]
+		value, err := getSecretValue(name, args)
+		if err != nil {
+			return err
+		}
+
+		if value == "" {
+			return errors.New("secret value can not be empty")
+		}
+
+		if projectFlag != "" && workspaceFlag == "" {
+			return errors.New(i18n.T("--project requires --workspace"))
+		}
+
+		if fileModeFlag != "" && filePathFlag == "" {
+			return errors.New("--mode requires --file")
+		}
+
+		req := apiclient.SetSecretDTO{
+			Name:  name,
+			Value: value,
+		}
+		if workspaceFlag != "" {
+			req.WorkspaceId = &workspaceFlag
+		}
+		if projectFlag != "" {
+			req.ProjectName = &projectFlag
+		}
+		if filePathFlag != "" {
+			req.FilePath = &filePathFlag
+		}
+		if fileModeFlag != "" {
+			req.FileMode = &fileModeFlag
+		}
+
+		res, err := apiClient.SecretAPI.SetSecret(ctx).Secret(req).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Secret %s has been set", name))
+		views.RenderTip("Running projects receive the new value the next time they are started")
+		return nil
+	},
+}
+
+func getSecretValue(name string, args []string) (string, error) {
+	if len(args) == 2 {
+		return args[1], nil
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		value, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", err
+		}
+
+		// Values of file secrets are kept as is
+		if filePathFlag != "" {
+			return string(value), nil
+		}
+		return strings.TrimRight(string(value), "\r\n"), nil
+	}
+
+	var value string
+	err := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Value of " + name).
+				EchoMode(huh.EchoModePassword).
+				Value(&value),
+		),
+	).WithTheme(views.GetCustomTheme()).Run()
+
+	return value, err
+}
+
+func init() {
+	setCmd.Flags().StringVarP(&workspaceFlag, "workspace", "w", "", "Scope the secret to a workspace instead of the whole profile")
+	setCmd.Flags().StringVarP(&projectFlag, "project", "p", "", "Scope the secret to a project of the workspace")
+	setCmd.Flags().StringVar(&filePathFlag, "file", "", "Write the secret to this absolute path in the project instead of setting an environment variable")
+	setCmd.Flags().StringVar(&fileModeFlag, "mode", "", "Octal permissions of the secret file (default 0600)")
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	view "github.com/daytonaio/daytona/pkg/views/apikey"
+	"github.com/spf13/cobra"
+)
+
+var apiKeyReadOnlyFlag bool
+var apiKeyAdminFlag bool
+
+var apiKeyCmd = &cobra.Command{
+	Use:   "api-key",
+	Short: "Manage the API keys of the Daytona Server on this machine",
+}
+
+var apiKeyCreateCmd = &cobra.Command{
+	Use:   "create NAME",
+	Short: "Create a named API key for clients on other machines",
+	Long:  "Create a named API key in the database of the Daytona Server on this machine.\nClients authenticate with the key after adding it to a profile with 'daytona profile add'. The name of the key identifies the user that owns the workspaces created with it. The key does not require a running server and can be revoked with 'daytona api-key revoke'.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := server.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		dbPath, err := getDbPath()
+		if err != nil {
+			return err
+		}
+
+		apiKeyStore, err := db.NewApiKeyStore(db.GetSQLiteConnection(dbPath))
+		if err != nil {
+			return err
+		}
+
+		_, err = apiKeyStore.FindByName(args[0])
+		if err == nil {
+			return errors.New("key name already exists, please choose a different one")
+		}
+		if !apikey.IsApiKeyNotFound(err) {
+			return err
+		}
+
+		apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+			ApiKeyStore: apiKeyStore,
+		})
+
+		if apiKeyReadOnlyFlag && apiKeyAdminFlag {
+			return errors.New("an API key can not be both read-only and admin")
+		}
+
+		var key string
+		switch {
+		case apiKeyReadOnlyFlag:
+			key, err = apiKeyService.GenerateReadOnly(args[0])
+		case apiKeyAdminFlag:
+			key, err = apiKeyService.GenerateAdmin(args[0])
+		default:
+			key, err = apiKeyService.Generate(apikey.ApiKeyTypeClient, args[0])
+		}
+		if err != nil {
+			return err
+		}
+
+		view.Render(key, getServerApiUrl(c))
+		return nil
+	},
+}
+
+// getServerApiUrl returns the URL clients on other machines use to reach the API
+func getServerApiUrl(c *server.Config) string {
+	if c.Frps != nil {
+		return util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+
+	return fmt.Sprintf("http://%s:%d", hostname, c.ApiPort)
+}
+
+func init() {
+	apiKeyCreateCmd.Flags().BoolVar(&apiKeyReadOnlyFlag, "read-only", false, "Create a key that can only be used to view workspaces and connect to them")
+	apiKeyCreateCmd.Flags().BoolVar(&apiKeyAdminFlag, "admin", false, "Create a key that can access the workspaces of all users")
+	apiKeyCmd.AddCommand(apiKeyCreateCmd)
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/backup"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up and restore the Daytona Server data",
+	Long:  "Back up and restore the Daytona Server database, config and secrets key.\nBackups include the workspace metadata, project configs, targets, git providers, API keys and secrets. Workspace volumes, logs and providers are not included.",
+}
+
+var backupCreateCmd = &cobra.Command{
+	Use:   "create [FILE]",
+	Short: "Create a backup archive of the Daytona Server data",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		archivePath := fmt.Sprintf("daytona-backup-%s.tar.gz", time.Now().Format("20060102-150405"))
+		if len(args) == 1 {
+			archivePath = args[0]
+		}
+
+		paths, err := getBackupPaths()
+		if err != nil {
+			return err
+		}
+
+		// The archive contains secrets so it is only readable by the current user
+		file, err := os.OpenFile(archivePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+
+		err = backup.Create(file, paths, internal.Version)
+		file.Close()
+		if err != nil {
+			os.Remove(archivePath)
+			return err
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Backup saved to %s", archivePath))
+		return nil
+	},
+}
+
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore [FILE]",
+	Short: "Restore the Daytona Server data from a backup archive",
+	Long:  "Restore the Daytona Server data from a backup archive.\nThe server must be stopped. The current database and secrets key are kept with the .bak suffix and host specific settings, like the providers directory and the log file, are kept from the current config.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := server.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		if !ports.IsPortAvailable(uint16(c.ApiPort)) {
+			return errors.New("the Daytona Server is running. Stop it with 'daytona server stop' before restoring a backup")
+		}
+
+		if !yesFlag {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(i18n.T("Restore the Daytona Server data from %s?", filepath.Base(args[0]))).
+						Description(i18n.T("Workspaces, project configs, targets and other server data created since the backup will be lost.")).
+						Value(&yesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				return err
+			}
+
+			if !yesFlag {
+				fmt.Println(i18n.T("Operation canceled."))
+				return nil
+			}
+		}
+
+		paths, err := getBackupPaths()
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		manifest, err := backup.Restore(file, paths)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Restored the backup created at %s by Daytona %s", manifest.CreatedAt.Format(time.RFC1123), manifest.ServerVersion))
+		views.RenderInfoMessage("Start the server with 'daytona server start'")
+		return nil
+	},
+}
+
+func getBackupPaths() (backup.Paths, error) {
+	dbPath, err := getDbPath()
+	if err != nil {
+		return backup.Paths{}, err
+	}
+
+	configPath, err := server.GetConfigFilePath()
+	if err != nil {
+		return backup.Paths{}, err
+	}
+
+	configDir, err := server.GetConfigDir()
+	if err != nil {
+		return backup.Paths{}, err
+	}
+
+	return backup.Paths{
+		DbPath:         dbPath,
+		ConfigPath:     configPath,
+		SecretsKeyPath: getSecretsKeyPath(configDir),
+	}, nil
+}
+
+func init() {
+	backupRestoreCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Skip the confirmation prompt")
+
+	backupCmd.AddCommand(backupCreateCmd)
+	backupCmd.AddCommand(backupRestoreCmd)
+}
@@ -41,7 +41,7 @@ var configureCmd = &cobra.Command{
 			return apiclient.HandleErrorResponse(res, err)
 		}
 
-		views.RenderContainerLayout(views.GetInfoMessage("Server configuration updated. You need to restart the server for the changes to take effect."))
+		views.RenderContainerLayout(views.GetInfoMessage("Server configuration updated. Run 'daytona server reload' to apply the changes or restart the server."))
 		return nil
 	},
 }
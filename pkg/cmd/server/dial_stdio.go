@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/spf13/cobra"
+)
+
+var socketPathFlag string
+
+// dialStdioCmd connects the standard streams to the API socket of the local server.
+// Clients use it as the remote command of the SSH transport
+var dialStdioCmd = &cobra.Command{
+	Use:    "dial-stdio",
+	Short:  "Proxy the standard input and output to the Daytona Server API socket",
+	Args:   cobra.NoArgs,
+	Hidden: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		socketPath := socketPathFlag
+		if socketPath == "" {
+			var err error
+			socketPath, err = config.GetServerSocketPath()
+			if err != nil {
+				return err
+			}
+		}
+
+		conn, err := net.Dial("unix", socketPath)
+		if err != nil {
+			return fmt.Errorf("failed to connect to the Daytona Server socket %s. Make sure the server is running: %w", socketPath, err)
+		}
+		defer conn.Close()
+
+		errChan := make(chan error, 2)
+
+		go func() {
+			_, err := io.Copy(conn, os.Stdin)
+			errChan <- err
+		}()
+
+		go func() {
+			_, err := io.Copy(os.Stdout, conn)
+			errChan <- err
+		}()
+
+		// The connection is done when either the client or the server closes it
+		return <-errChan
+	},
+}
+
+func init() {
+	dialStdioCmd.Flags().StringVar(&socketPathFlag, "socket", "", "Path of the Daytona Server API socket")
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var hostInfoCmd = &cobra.Command{
+	Use:   "host-info",
+	Short: "Show the capabilities of the Daytona Server host",
+	Long:  "Show the kernel features of the Daytona Server host (CRIU, cgroups v2, user namespaces, KVM and GPUs) and the workspace features that depend on them.\nThe capabilities are detected when the server starts.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		hostInfo, res, err := apiClient.ServerAPI.GetHostInfo(context.Background()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(hostInfo)
+			formattedData.Print()
+			return nil
+		}
+
+		view.RenderHostInfo(hostInfo)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(hostInfoCmd)
+}
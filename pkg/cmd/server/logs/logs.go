@@ -11,15 +11,19 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"time"
 
 	"github.com/charmbracelet/huh"
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/constants"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/daytonaio/daytona/pkg/views/server/selection"
+	"github.com/gorilla/websocket"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -101,7 +105,7 @@ func readRemoteServerLogFile(ctx context.Context, activeProfile config.Profile,
 		query += fmt.Sprintf("file=%s", filepath.Base(fileFlag))
 	}
 
-	ws, res, err := apiclient_util.GetWebsocketConn(context.Background(), "/log/server", &activeProfile, &query)
+	ws, res, err := apiclient_util.GetWebsocketConn(ctx, "/log/server", &activeProfile, &query)
 	if res.StatusCode == http.StatusNotFound {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
@@ -115,13 +119,38 @@ func readRemoteServerLogFile(ctx context.Context, activeProfile config.Profile,
 		return promptReadingLocalServerLogs("An error occurred while connecting to the server.")
 	}
 
+	return followServerLog(ctx, activeProfile, query, ws, res)
+}
+
+// followServerLog prints the server log read from the websocket. When following, the stream is reconnected
+// if it drops and resumes at the last received byte if the server supports offsets
+func followServerLog(ctx context.Context, activeProfile config.Profile, query string, ws *websocket.Conn, res *http.Response) error {
+	received := 0
+
 	for {
 		_, msg, err := ws.ReadMessage()
-		if err != nil {
+		if err == nil {
+			fmt.Print(string(msg))
+			received += len(msg)
+			continue
+		}
+		ws.Close()
+
+		if !followFlag || websocket.IsCloseError(err, websocket.CloseNormalClosure) || res.Header.Get(logs.LogOffsetHeader) == "" {
 			return nil
 		}
+		log.Debug(err)
+
+		for failures := 0; ; failures++ {
+			time.Sleep(util.GetJitteredBackoff(250*time.Millisecond, 5*time.Second, failures))
 
-		fmt.Println(string(msg))
+			resumeQuery := fmt.Sprintf("%s&offset=%d", query, received)
+			ws, res, err = apiclient_util.GetWebsocketConn(ctx, "/log/server", &activeProfile, &resumeQuery)
+			if err == nil {
+				break
+			}
+			log.Trace(apiclient_util.HandleErrorResponse(res, err))
+		}
 	}
 }
 
@@ -129,7 +158,7 @@ func promptReadingLocalServerLogs(info string) error {
 	readLocalLogsFile := true
 	form := huh.NewForm(
 		huh.NewGroup(
-			huh.NewConfirm().Description(info + " Would you like to read from local log files instead?").
+			huh.NewConfirm().Description(info + " " + i18n.T("Would you like to read from local log files instead?")).
 				Value(&readLocalLogsFile),
 		),
 	).WithTheme(views.GetCustomTheme())
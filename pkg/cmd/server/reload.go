@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var reloadCmd = &cobra.Command{
+	Use:   "reload",
+	Short: "Reload the Daytona Server configuration without a restart",
+	Long: `Reload the Daytona Server configuration without a restart. Running workspaces, builds and log streams are not interrupted.
+The registry URL, server download URL, samples index URL, default project image and user, builder image, egress quota and hibernation settings are applied immediately. Changes to other fields are listed but require a restart.
+Sending SIGHUP to the server process also reloads the configuration.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		changes, res, err := apiClient.ServerAPI.ReloadConfig(context.Background()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(changes)
+			formattedData.Print()
+			return nil
+		}
+
+		view.RenderConfigChanges(changes)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(reloadCmd)
+}
@@ -11,6 +11,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
@@ -18,10 +19,10 @@ import (
 	"github.com/daytonaio/daytona/internal/constants"
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/api"
-	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/posthogservice"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
@@ -32,10 +33,12 @@ import (
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/headscale"
+	"github.com/daytonaio/daytona/pkg/server/invites"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
 	"github.com/daytonaio/daytona/pkg/server/registry"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -78,18 +81,37 @@ var ServeCmd = &cobra.Command{
 			return err
 		}
 
+		var bootstrap *server.Bootstrap
+		if bootstrapFlag != "" {
+			bootstrap, err = server.LoadBootstrap(bootstrapFlag)
+			if err != nil {
+				return err
+			}
+
+			err = bootstrap.ApplyConfig(c)
+			if err != nil {
+				return err
+			}
+		}
+
 		telemetryService := posthogservice.NewTelemetryService(posthogservice.PosthogServiceConfig{
 			ApiKey:   internal.PosthogApiKey,
 			Endpoint: internal.PosthogEndpoint,
 			Version:  internal.Version,
 		})
 
+		socketPath, err := config.GetServerSocketPath()
+		if err != nil {
+			return err
+		}
+
 		apiServer := api.NewApiServer(api.ApiServerConfig{
 			ApiPort:          int(c.ApiPort),
 			TelemetryService: telemetryService,
 			Version:          internal.Version,
 			ServerId:         c.Id,
 			Frps:             c.Frps,
+			SocketPath:       socketPath,
 		})
 
 		server, err := GetInstance(c, configDir, internal.Version, telemetryService)
@@ -97,6 +119,13 @@ var ServeCmd = &cobra.Command{
 			return err
 		}
 
+		if bootstrap != nil {
+			err = server.Bootstrap(bootstrap)
+			if err != nil {
+				return err
+			}
+		}
+
 		buildRunnerConfig, err := build.GetConfig()
 		if err != nil {
 			return err
@@ -175,8 +204,23 @@ var ServeCmd = &cobra.Command{
 			return err
 		}
 
+		// Sent by 'kill -HUP' to reload the server config without a restart
+		reloadChannel := make(chan os.Signal, 1)
+		signal.Notify(reloadChannel, syscall.SIGHUP)
+
+		go func() {
+			for range reloadChannel {
+				log.Info("Received SIGHUP, reloading the server config")
+				_, err := server.ReloadConfig()
+				if err != nil {
+					log.Errorf("Failed to reload the server config: %v", err)
+				}
+			}
+		}()
+
+		// SIGTERM is sent when the daemon is stopped, e.g. by 'daytona server stop' or an upgrade
 		interruptChannel := make(chan os.Signal, 1)
-		signal.Notify(interruptChannel, os.Interrupt)
+		signal.Notify(interruptChannel, os.Interrupt, syscall.SIGTERM)
 
 		select {
 		case err := <-apiServerErrChan:
@@ -186,12 +230,41 @@ var ServeCmd = &cobra.Command{
 		case <-interruptChannel:
 			log.Info("Shutting down")
 
+			// Connected clients are told that the server is restarting while the requests in progress finish.
+			// Interrupted builds are requeued when the server starts again
+			server.Shutdown()
+			buildRunner.Stop()
+			apiServer.Stop()
+			server.Stop()
+
 			return server.TailscaleServer.Stop()
 		}
 	},
 }
 
+var bootstrapFlag string
+
+func init() {
+	bootstrapUsage := "Path to a JSON bootstrap file with the server config, API keys, container registries, Git providers, targets, project configs and environment variables to apply on start"
+	ServeCmd.Flags().StringVar(&bootstrapFlag, "bootstrap", "", bootstrapUsage)
+	DaemonServeCmd.Flags().StringVar(&bootstrapFlag, "bootstrap", "", bootstrapUsage)
+}
+
 func GetInstance(c *server.Config, configDir string, version string, telemetryService telemetry.TelemetryService) (*server.Server, error) {
+	dockerHostConfig := docker.DockerHostConfig{
+		Host:    c.DockerHost,
+		Context: c.DockerContext,
+	}
+	// Exported to the environment so that builds and provider plugins use the same daemon.
+	// Without a configured host, a local Podman socket is exported if there is no Docker socket.
+	err := docker.ApplyDockerHost(dockerHostConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure the Docker host: %w", err)
+	}
+	if !dockerHostConfig.IsEmpty() {
+		log.Infof("Using Docker daemon: %s", dockerHostConfig)
+	}
+
 	wsLogsDir, err := server.GetWorkspaceLogsDir(configDir)
 	if err != nil {
 		return nil, err
@@ -237,10 +310,38 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	if err != nil {
 		return nil, err
 	}
+	snapshotStore, err := db.NewSnapshotStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	creationTimingStore, err := db.NewCreationTimingStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	eventStore, err := db.NewEventStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	failureStore, err := db.NewFailureStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	egressUsageStore, err := db.NewEgressUsageStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
 	profileDataStore, err := db.NewProfileDataStore(dbConnection)
 	if err != nil {
 		return nil, err
 	}
+	secretStore, err := db.NewSecretStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	inviteStore, err := db.NewInviteStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
 
 	headscaleServer := headscale.NewHeadscaleServer(&headscale.HeadscaleServerConfig{
 		ServerId:      c.Id,
@@ -283,6 +384,11 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		return nil, err
 	}
 
+	err = projectConfigService.StartPrebuildPoller()
+	if err != nil {
+		return nil, err
+	}
+
 	var localContainerRegistry server.ILocalContainerRegistry
 
 	if c.BuilderRegistryServer != "local" {
@@ -342,8 +448,23 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		ProviderManager: providerManager,
 	})
 
+	secretsKey, err := secrets.GetEncryptionKey(getSecretsKeyPath(configDir))
+	if err != nil {
+		return nil, err
+	}
+
+	secretService := secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore:   secretStore,
+		EncryptionKey: secretsKey,
+	})
+
 	workspaceService := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 		WorkspaceStore:           workspaceStore,
+		SnapshotStore:            snapshotStore,
+		CreationTimingStore:      creationTimingStore,
+		EventStore:               eventStore,
+		FailureStore:             failureStore,
+		EgressUsageStore:         egressUsageStore,
 		TargetStore:              providerTargetStore,
 		ApiKeyService:            apiKeyService,
 		GitProviderService:       gitProviderService,
@@ -359,12 +480,31 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		Provisioner:              provisioner,
 		LoggerFactory:            loggerFactory,
 		TelemetryService:         telemetryService,
+		SecretService:            secretService,
+		EgressQuota:              c.EgressQuotaMb * 1024 * 1024,
+		HibernateAfter:           time.Duration(c.HibernateAfterMinutes) * time.Minute,
+		HibernationRetention:     time.Duration(c.HibernationRetentionDays) * 24 * time.Hour,
 	})
 
+	err = workspaceService.StartEgressCollector()
+	if err != nil {
+		return nil, err
+	}
+
+	err = workspaceService.StartHibernationScheduler()
+	if err != nil {
+		return nil, err
+	}
+
 	profileDataService := profiledata.NewProfileDataService(profiledata.ProfileDataServiceConfig{
 		ProfileDataStore: profileDataStore,
 	})
 
+	inviteService := invites.NewInviteService(invites.InviteServiceConfig{
+		InviteStore:   inviteStore,
+		ApiKeyService: apiKeyService,
+	})
+
 	s := server.GetInstance(&server.ServerInstanceConfig{
 		Config:                   *c,
 		Version:                  version,
@@ -379,6 +519,8 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		GitProviderService:       gitProviderService,
 		ProviderManager:          providerManager,
 		ProfileDataService:       profileDataService,
+		SecretService:            secretService,
+		InviteService:            inviteService,
 		TelemetryService:         telemetryService,
 	})
 
@@ -449,6 +591,12 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 		return nil, err
 	}
 
+	buildKitConfig := build.BuildKitConfig{}
+	if c.BuildLimits != nil {
+		buildKitConfig.Cpus = float64(c.BuildLimits.Cpus)
+		buildKitConfig.MemoryMb = int(c.BuildLimits.MemoryMb)
+	}
+
 	builderFactory := build.NewBuilderFactory(build.BuilderFactoryConfig{
 		Image:                       c.BuilderImage,
 		ContainerRegistry:           cr,
@@ -458,6 +606,7 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 		LoggerFactory:               loggerFactory,
 		DefaultProjectImage:         c.DefaultProjectImage,
 		DefaultProjectUser:          c.DefaultProjectUser,
+		BuildKitConfig:              buildKitConfig,
 	})
 
 	return build.NewBuildRunner(build.BuildRunnerInstanceConfig{
@@ -513,6 +662,11 @@ func getDbPath() (string, error) {
 	return filepath.Join(configDir, "db"), nil
 }
 
+// getSecretsKeyPath returns the key that secrets are encrypted with at rest
+func getSecretsKeyPath(configDir string) string {
+	return filepath.Join(configDir, "secrets.key")
+}
+
 func ensureDefaultProfile(server *server.Server, apiPort uint32) error {
 	existingConfig, err := config.GetConfig()
 	if err != nil {
@@ -525,11 +679,18 @@ func ensureDefaultProfile(server *server.Server, apiPort uint32) error {
 
 	for _, profile := range existingConfig.Profiles {
 		if profile.Id == "default" {
+			// Keys of the default profile created before admin keys existed are upgraded. Having the key in the
+			// config of the user that runs the server grants admin access, not the name of the key
+			err := server.ApiKeyService.GrantAdmin(profile.Api.Key)
+			if err != nil {
+				log.Warnf("The API key of the default profile is not an admin key: %v", err)
+			}
 			return nil
 		}
 	}
 
-	apiKey, err := server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, "default")
+	// The user that runs the server can access the workspaces of all users
+	apiKey, err := server.ApiKeyService.GenerateAdmin(apikeys.DefaultProfileKeyName)
 	if err != nil {
 		return err
 	}
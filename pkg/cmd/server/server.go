@@ -8,6 +8,7 @@ import (
 	"os"
 	"runtime"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/api"
 	"github.com/daytonaio/daytona/pkg/cmd/server/daemon"
@@ -33,7 +34,7 @@ var ServerCmd = &cobra.Command{
 		if !yesFlag {
 			view.ConfirmPrompt(&confirmCheck)
 			if !confirmCheck {
-				views.RenderInfoMessage("Operation cancelled.")
+				views.RenderInfoMessage(i18n.T("Operation cancelled."))
 				return nil
 			}
 		}
@@ -78,11 +79,18 @@ var startCmd = &cobra.Command{
 }
 
 func init() {
+	ServerCmd.AddCommand(apiKeyCmd)
+	ServerCmd.AddCommand(backupCmd)
 	ServerCmd.AddCommand(configureCmd)
 	ServerCmd.AddCommand(configCmd)
 	ServerCmd.AddCommand(logs.LogsCmd)
 	ServerCmd.AddCommand(startCmd)
 	ServerCmd.AddCommand(stopCmd)
 	ServerCmd.AddCommand(restartCmd)
+	ServerCmd.AddCommand(reloadCmd)
+	ServerCmd.AddCommand(statsCmd)
+	ServerCmd.AddCommand(hostInfoCmd)
+	ServerCmd.AddCommand(upgradeCmd)
+	ServerCmd.AddCommand(dialStdioCmd)
 	ServerCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Skip the confirmation prompt")
 }
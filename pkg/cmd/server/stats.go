@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var lastFlag string
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show Daytona Server statistics",
+}
+
+var statsFailuresCmd = &cobra.Command{
+	Use:   "failures",
+	Short: "Show provisioning failures by category",
+	Long:  "Show the provisioning failures of the Daytona Server aggregated by category, e.g. image pull, clone authentication, out of memory or disk space, and provider.\nOnly the category and the provider of each failure are recorded.",
+	Example: `  daytona server stats failures
+  daytona server stats failures --last 7d`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		last, err := parseLastDuration(lastFlag)
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		since := time.Now().Add(-last).Format(time.RFC3339)
+
+		stats, res, err := apiClient.ServerAPI.GetFailureStats(context.Background()).Since(since).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(stats)
+			formattedData.Print()
+			return nil
+		}
+
+		view.RenderFailureStats(stats)
+		return nil
+	},
+}
+
+func init() {
+	statsFailuresCmd.Flags().StringVar(&lastFlag, "last", "30d", "Time period to include, e.g. 30d or 12h")
+	format.RegisterFormatFlag(statsFailuresCmd)
+
+	statsCmd.AddCommand(statsFailuresCmd)
+}
+
+// parseLastDuration parses a Go duration with support for days, e.g. 30d
+func parseLastDuration(value string) (time.Duration, error) {
+	var duration time.Duration
+
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		d, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid period %s", value)
+		}
+		duration = time.Duration(d) * 24 * time.Hour
+	} else {
+		var err error
+		duration, err = time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid period %s", value)
+		}
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("invalid period %s: must be positive", value)
+	}
+
+	return duration, nil
+}
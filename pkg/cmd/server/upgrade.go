@@ -0,0 +1,230 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/internal/util/proxyjump"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+)
+
+const upgradeTimeout = 2 * time.Minute
+
+var sshDestinationFlag string
+var forceFlag bool
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade [VERSION]",
+	Short: "Upgrade the remote Daytona Server to the CLI version",
+	Long:  "Install the given version of the Daytona Server, by default the version of the CLI, on the host of the active profile over SSH and restart the server daemon.\nThe server is asked whether the version is compatible before anything is installed.",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		if activeProfile.Id == "default" {
+			views.RenderInfoMessage("The Daytona Server of the default profile runs on this machine. Update it with 'daytona update' and restart it with 'daytona server restart'")
+			return nil
+		}
+
+		version := internal.Version
+		if len(args) == 1 {
+			version = args[0]
+		} else if strings.HasSuffix(version, "-dev") {
+			return errors.New("the CLI is a development build, please specify the version to install")
+		}
+
+		// The version ends up in a script that runs on the server host, so it is validated even with --force
+		if !semver.IsValid(version) {
+			return fmt.Errorf("invalid version %q, expected a version like v0.40.0", version)
+		}
+
+		destination := sshDestinationFlag
+		if destination == "" {
+			apiUrl, err := url.Parse(activeProfile.Api.Url)
+			if err != nil {
+				return fmt.Errorf("failed to parse the API URL of the profile: %w", err)
+			}
+			destination = apiUrl.Hostname()
+		}
+
+		if destination == "" || strings.HasPrefix(destination, "-") {
+			return fmt.Errorf("invalid SSH destination %q, expected [user@]host", destination)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		check, res, err := apiClient.ServerAPI.CheckUpgrade(ctx).Version(version).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if check.CurrentVersion == version {
+			views.RenderInfoMessage(fmt.Sprintf("The Daytona Server is already on version %s", version))
+			return nil
+		}
+
+		if !check.Compatible {
+			if !forceFlag {
+				return fmt.Errorf("the server can't be upgraded: %s. Use --force to upgrade anyway", check.GetReason())
+			}
+			views.RenderInfoMessage(fmt.Sprintf("Upgrading despite the incompatibility: %s", check.GetReason()))
+		}
+
+		installScriptUrl, err := url.Parse(check.InstallScriptUrl)
+		if err != nil || installScriptUrl.Scheme != "https" || installScriptUrl.Host == "" {
+			return fmt.Errorf("the server reported an invalid install script URL %q, expected an https URL", check.InstallScriptUrl)
+		}
+
+		for _, warning := range check.Warnings {
+			views.RenderInfoMessage(fmt.Sprintf("Warning: %s", warning))
+		}
+
+		if apiclient_util.IsDryRun() {
+			views.RenderInfoMessage(fmt.Sprintf("Would upgrade the Daytona Server on %s (%s/%s) from %s to %s", destination, check.Os, check.Arch, check.CurrentVersion, version))
+			return nil
+		}
+
+		if !yesFlag {
+			confirmed := false
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(i18n.T("Upgrade the Daytona Server on %s from %s to %s?", destination, check.CurrentVersion, version)).
+						Description(i18n.T("The server is stopped while the new version is installed")).
+						Value(&confirmed),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				return err
+			}
+
+			if !confirmed {
+				fmt.Println(i18n.T("Operation canceled."))
+				return nil
+			}
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Upgrading the Daytona Server on %s to %s...", destination, version))
+
+		err = runUpgradeScript(&activeProfile, destination, getUpgradeScript(check.InstallScriptUrl, version))
+		if err != nil {
+			return fmt.Errorf("failed to upgrade the server: %w", err)
+		}
+
+		err = waitForServerVersion(&activeProfile, version)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Daytona Server upgraded to %s", version))
+		return nil
+	},
+}
+
+func init() {
+	upgradeCmd.Flags().StringVar(&sshDestinationFlag, "ssh", "", "SSH destination of the server host in the [user@]host format. Defaults to the host of the profile API URL")
+	upgradeCmd.Flags().BoolVarP(&forceFlag, "force", "f", false, "Upgrade even if the server reports that the version is not compatible")
+	upgradeCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Skip the confirmation prompt")
+}
+
+// getUpgradeScript returns the script that installs the version on the server host and starts the server daemon.
+// The install script stops the running server before replacing the binary
+func getUpgradeScript(installScriptUrl, version string) string {
+	return fmt.Sprintf(`set -e
+curl -sfL --proto '=https' %s | DAYTONA_SERVER_VERSION=%s bash -s -- -y
+daytona server --yes
+`, shellQuote(installScriptUrl), shellQuote(version))
+}
+
+// shellQuote quotes the value as a single shell word that is not expanded
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// runUpgradeScript runs the script on the destination with the system SSH client, through the jump host of the profile if one is set
+func runUpgradeScript(profile *config.Profile, destination, script string) error {
+	sshPath, err := exec.LookPath("ssh")
+	if err != nil {
+		return errors.New("ssh client not found in PATH, it is required to upgrade the server")
+	}
+
+	args := []string{}
+	if profile.ProxyJump != "" {
+		proxyJumpArgs, err := proxyjump.NewDialer(profile.ProxyJump, profile.ProxyJumpIdentityFile).SshArgs()
+		if err != nil {
+			return err
+		}
+		args = append(args, proxyJumpArgs...)
+	}
+	// The destination can't be mistaken for an option after --
+	args = append(args, "--", destination, "sh", "-s")
+
+	cmd := exec.Command(sshPath, args...)
+	cmd.Stdin = strings.NewReader(script)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+// waitForServerVersion waits until the server API responds with the version
+func waitForServerVersion(profile *config.Profile, version string) error {
+	apiClient, err := apiclient_util.GetApiClient(profile)
+	if err != nil {
+		return err
+	}
+
+	serverVersion := ""
+	timeout := time.After(upgradeTimeout)
+
+	for {
+		_, res, err := apiClient.ServerAPI.GetHostInfo(context.Background()).Execute()
+		if err == nil {
+			serverVersion = res.Header.Get(middlewares.SERVER_VERSION_HEADER)
+			if serverVersion == version {
+				return nil
+			}
+		}
+
+		select {
+		case <-timeout:
+			if serverVersion != "" {
+				return fmt.Errorf("the server is running version %s instead of %s after the upgrade", serverVersion, version)
+			}
+			return fmt.Errorf("the server did not respond within %s after the upgrade", upgradeTimeout)
+		case <-time.After(time.Second):
+		}
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellQuote(t *testing.T) {
+	for _, value := range []string{"https://download.daytona.io/daytona/install.sh", "$(touch pwned)", "`id`", "it's"} {
+		out, err := exec.Command("sh", "-c", "printf %s "+shellQuote(value)).Output()
+		require.NoError(t, err)
+		require.Equal(t, value, string(out))
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/profile"
+	"github.com/daytonaio/daytona/pkg/cmd/server"
+	"github.com/daytonaio/daytona/pkg/cmd/workspace"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/setup"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// Commands that work without a profile or create one themselves
+var setupSkippedCommands = []string{
+	"daytona serve",
+	"daytona daemon-serve",
+	"daytona serve-stdio",
+	"daytona server",
+	"daytona profile",
+	"daytona join",
+	"daytona ssh-proxy",
+	"daytona version",
+	"daytona doctor",
+	"daytona update",
+	"daytona docs",
+	"daytona help",
+	"daytona completion",
+	"daytona autocomplete",
+	"daytona generate-docs",
+	"daytona telemetry",
+	"daytona theme",
+	"daytona ide",
+	"daytona config",
+	"daytona purge",
+	"daytona plugin",
+}
+
+const remoteServerInstallScript = `set -e
+if ! command -v daytona >/dev/null 2>&1; then
+  curl -sfL https://download.daytona.io/daytona/install.sh | sudo bash
+fi
+daytona server --yes >&2
+# The server adds the default profile shortly after the API is up
+for i in 1 2 3 4 5 6 7 8 9 10; do
+  daytona profile list >/dev/null 2>&1 && break
+  sleep 1
+done
+daytona api-key generate %s
+`
+
+var profileAddCommandRegex = regexp.MustCompile(`daytona profile add -a (\S+) -k (\S+)`)
+
+// shouldRunSetupWizard returns true if there is no profile to connect to a server and
+// the command was run interactively
+func shouldRunSetupWizard(cmd *cobra.Command) bool {
+	if isSetupSkippedCommand(cmd.CommandPath()) {
+		return false
+	}
+
+	if versionFlag, _ := cmd.Flags().GetBool("version"); versionFlag {
+		return false
+	}
+
+	if strings.HasPrefix(cmd.Name(), "__complete") || internal.WorkspaceMode() || views.IsPlainOutput() || apiclient_util.IsDryRun() {
+		return false
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) || config.HasEnvProfile() {
+		return false
+	}
+
+	c, err := config.GetConfig()
+	if err != nil {
+		return false
+	}
+
+	return len(c.Profiles) == 0 && !c.SetupSkipped
+}
+
+func isSetupSkippedCommand(commandPath string) bool {
+	for _, skippedCommand := range setupSkippedCommands {
+		if commandPath == skippedCommand || strings.HasPrefix(commandPath, skippedCommand+" ") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// runSetupWizard guides the user through connecting the CLI to a Daytona Server on the first run.
+// The command that triggered the wizard runs afterwards with the new profile
+func runSetupWizard(cmd *cobra.Command) error {
+	c, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	method, err := setup.GetSetupMethodFromPrompt()
+	if err != nil {
+		if common.IsCtrlCAbort(err) {
+			return nil
+		}
+		return err
+	}
+
+	var activeProfile *config.Profile
+
+	switch method {
+	case setup.SetupLocalServer:
+		activeProfile, err = setupLocalServer(cmd)
+	case setup.SetupRemoteServer:
+		activeProfile, err = setupRemoteServer(c)
+	case setup.SetupInstallServer:
+		activeProfile, err = setupInstalledServer(c)
+	default:
+		c.SetupSkipped = true
+		views.RenderTip("Run 'daytona serve' to start a local server or 'daytona profile add' to connect to a remote server when you are ready")
+		return c.Save()
+	}
+	if err != nil {
+		if common.IsCtrlCAbort(err) {
+			return nil
+		}
+		return err
+	}
+
+	apiClient, err := apiclient_util.NewApiClient(*activeProfile)
+	if err != nil {
+		return err
+	}
+
+	_, res, err := apiClient.WorkspaceAPI.ListWorkspaces(context.Background()).Execute()
+	if err != nil {
+		return fmt.Errorf("the Daytona Server is reachable but the connection failed: %w", apiclient_util.HandleErrorResponse(res, err))
+	}
+
+	views.RenderInfoMessageBold(fmt.Sprintf("Connected to the Daytona Server at %s with profile %s", activeProfile.Api.Url, activeProfile.Name))
+
+	createWorkspace, err := setup.ConfirmCreateWorkspace()
+	if err != nil {
+		if common.IsCtrlCAbort(err) {
+			return nil
+		}
+		return err
+	}
+
+	if !createWorkspace {
+		views.RenderTip("Run 'daytona create' when you're ready to create a workspace")
+		return nil
+	}
+
+	return workspace.CreateCmd.RunE(workspace.CreateCmd, []string{})
+}
+
+func setupLocalServer(cmd *cobra.Command) (*config.Profile, error) {
+	err := server.ServerCmd.Flags().Set("yes", "true")
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.ServerCmd.RunE(cmd, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	// The server adds the default profile once the API is up
+	for i := 0; i < 20; i++ {
+		c, err := config.GetConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		defaultProfile, err := c.GetProfile("default")
+		if err == nil {
+			return &defaultProfile, nil
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	return nil, errors.New("the Daytona Server did not create the default profile. Check the server logs with 'daytona server logs'")
+}
+
+func setupRemoteServer(c *config.Config) (*config.Profile, error) {
+	profileId, err := profile.CreateProfile(c, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	newProfile, err := c.GetProfile(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newProfile, nil
+}
+
+func setupInstalledServer(c *config.Config) (*config.Profile, error) {
+	destination, err := setup.GetSshDestinationFromPrompt()
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "client"
+	}
+	keyName := util.GenerateIdFromName(fmt.Sprintf("%s-%d", hostname, time.Now().Unix()))
+
+	views.RenderInfoMessageBold(fmt.Sprintf("Installing the Daytona Server on %s...", destination))
+
+	var stdout bytes.Buffer
+	sshCmd := exec.Command("ssh", destination, "sh", "-s")
+	sshCmd.Stdin = strings.NewReader(fmt.Sprintf(remoteServerInstallScript, keyName))
+	sshCmd.Stdout = &stdout
+	sshCmd.Stderr = os.Stderr
+	sshCmd.Env = append(os.Environ(), "NO_COLOR=1")
+
+	err = sshCmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("failed to install the Daytona Server on %s: %w", destination, err)
+	}
+
+	match := profileAddCommandRegex.FindStringSubmatch(ansi.Strip(stdout.String()))
+	if match == nil {
+		return nil, fmt.Errorf("failed to read the API key generated on %s", destination)
+	}
+
+	profileName := getProfileNameFromDestination(destination)
+	newProfile := config.Profile{
+		Id:   util.GenerateIdFromName(profileName),
+		Name: profileName,
+		Api: config.ServerApi{
+			Url: match[1],
+			Key: match[2],
+		},
+	}
+
+	if _, err := c.GetProfile(newProfile.Id); err == nil {
+		c.ActiveProfileId = newProfile.Id
+		err = c.EditProfile(newProfile)
+	} else {
+		err = c.AddProfile(newProfile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &newProfile, nil
+}
+
+// getProfileNameFromDestination returns the host of an SSH destination in the [user@]host[:port] format
+func getProfileNameFromDestination(destination string) string {
+	host := destination
+	if i := strings.LastIndex(host, "@"); i != -1 {
+		host = host[i+1:]
+	}
+	if i := strings.Index(host, ":"); i != -1 {
+		host = host[:i]
+	}
+
+	return regexp.MustCompile(`[^a-zA-Z0-9._-]`).ReplaceAllString(host, "-")
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var nameFlag string
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create [WORKSPACE] [PROJECT]",
+	Short: "Snapshot the container filesystem of a workspace project",
+	Long:  "Snapshot the container filesystem of a workspace project, e.g. installed packages and tools.\nThe project directory is mounted from the host and is not part of the snapshot, neither are other volumes. Restoring a snapshot keeps the current content of the project directory.",
+	Args:  cobra.RangeArgs(0, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := getWorkspace(ctx, apiClient, args, "Snapshot")
+		if err != nil || workspace == nil {
+			return err
+		}
+
+		var projectName string
+		if len(args) == 2 {
+			projectName = args[1]
+		} else if len(workspace.Projects) == 1 {
+			projectName = workspace.Projects[0].Name
+		} else {
+			project := selection.GetProjectFromPrompt(workspace.Projects, "Snapshot")
+			if project == nil {
+				return nil
+			}
+			projectName = project.Name
+		}
+
+		req := apiclient.CreateSnapshot{}
+		if nameFlag != "" {
+			req.Name = &nameFlag
+		}
+
+		var snapshot *apiclient.Snapshot
+		err = views_util.WithInlineSpinner(i18n.T("Creating snapshot"), func() error {
+			var res *http.Response
+			snapshot, res, err = apiClient.WorkspaceAPI.CreateSnapshot(ctx, workspace.Id, projectName).Snapshot(req).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(i18n.T("Snapshot '%s' of project '%s' successfully created", snapshot.Name, projectName))
+		return nil
+	},
+}
+
+func init() {
+	snapshotCreateCmd.Flags().StringVarP(&nameFlag, "name", "n", "", "Snapshot name (defaults to the project name and the current time)")
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/snapshot/list"
+	"github.com/spf13/cobra"
+)
+
+var snapshotListCmd = &cobra.Command{
+	Use:     "list [WORKSPACE]",
+	Short:   "List workspace snapshots",
+	Aliases: []string{"ls"},
+	Args:    cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := getWorkspace(ctx, apiClient, args, "List Snapshots Of")
+		if err != nil || workspace == nil {
+			return err
+		}
+
+		snapshotList, res, err := apiClient.WorkspaceAPI.ListSnapshots(ctx, workspace.Id).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(snapshotList)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListSnapshots(snapshotList)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(snapshotListCmd)
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var yesFlag bool
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:   "restore [WORKSPACE] [SNAPSHOT]",
+	Short: "Roll a workspace project back to a snapshot",
+	Args:  cobra.RangeArgs(0, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := getWorkspace(ctx, apiClient, args, "Restore")
+		if err != nil || workspace == nil {
+			return err
+		}
+
+		var snapshotId, snapshotName, projectName string
+
+		if len(args) == 2 {
+			snapshotId = args[1]
+			snapshotName = args[1]
+		} else {
+			snapshotList, res, err := apiClient.WorkspaceAPI.ListSnapshots(ctx, workspace.Id).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(snapshotList) == 0 {
+				views_util.NotifyEmptySnapshotList(true)
+				return nil
+			}
+
+			snapshot := selection.GetSnapshotFromPrompt(snapshotList, "Restore")
+			if snapshot == nil {
+				return nil
+			}
+			snapshotId = snapshot.Id
+			snapshotName = snapshot.Name
+			projectName = snapshot.ProjectName
+		}
+
+		if !yesFlag {
+			description := i18n.T("Changes made in workspace '%s' since the snapshot was taken will be lost.", workspace.Name)
+			if projectName != "" {
+				description = i18n.T("Changes made to project '%s' since the snapshot was taken will be lost.", projectName)
+			}
+
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(i18n.T("Restore snapshot '%s'?", snapshotName)).
+						Description(description).
+						Value(&yesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				return err
+			}
+
+			if !yesFlag {
+				fmt.Println(i18n.T("Operation canceled."))
+				return nil
+			}
+		}
+
+		err = views_util.WithInlineSpinner(i18n.T("Restoring snapshot"), func() error {
+			res, err := apiClient.WorkspaceAPI.RestoreSnapshot(ctx, workspace.Id, snapshotId).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(i18n.T("Snapshot '%s' successfully restored", snapshotName))
+		return nil
+	},
+}
+
+func init() {
+	snapshotRestoreCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Confirm restoring the snapshot without prompting")
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var SnapshotCmd = &cobra.Command{
+	Use:     "snapshot",
+	Aliases: []string{"snapshots"},
+	Short:   "Checkpoint and restore workspace projects",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+func init() {
+	SnapshotCmd.AddCommand(snapshotCreateCmd)
+	SnapshotCmd.AddCommand(snapshotListCmd)
+	SnapshotCmd.AddCommand(snapshotRestoreCmd)
+}
+
+// Returns the workspace from the args or prompts the user to select one. Returns nil if the selection was canceled.
+func getWorkspace(ctx context.Context, apiClient *apiclient.APIClient, args []string, actionVerb string) (*apiclient.WorkspaceDTO, error) {
+	if len(args) > 0 {
+		return apiclient_util.GetWorkspace(args[0], false)
+	}
+
+	workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(workspaceList) == 0 {
+		views_util.NotifyEmptyWorkspaceList(true)
+		return nil, nil
+	}
+
+	return selection.GetWorkspaceFromPrompt(workspaceList, actionVerb), nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sshconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var regenerateCmd = &cobra.Command{
+	Use:   "regenerate",
+	Short: "Rebuild the SSH config entries of the active profile from the server",
+	Long: `Replace the SSH config entries of the active profile with entries for the projects of the workspaces on the Daytona Server.
+Entries and pinned host keys of removed workspaces are dropped and changes made to the entries, e.g. with 'daytona ssh --edit', are discarded.
+The Include line in ~/.ssh/config is added again if it was removed or moved below a Host block.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(context.Background()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		projects := []config.SshConfigProject{}
+		for _, ws := range workspaceList {
+			for _, project := range ws.Projects {
+				projects = append(projects, config.SshConfigProject{WorkspaceId: ws.Id, ProjectName: project.Name})
+			}
+		}
+
+		if apiclient_util.IsDryRun() {
+			for _, project := range projects {
+				fmt.Printf("[dry-run] Would write the SSH config entry for host %s\n", config.GetProjectHostname(activeProfile.Id, project.WorkspaceId, project.ProjectName))
+			}
+			return nil
+		}
+
+		err = config.RegenerateProfileSshEntries(activeProfile.Id, projects)
+		if err != nil {
+			return err
+		}
+
+		for _, ws := range workspaceList {
+			workspace_util.PinHostKeys(activeProfile, ws.Id, ws.Projects)
+		}
+
+		sshConfigPath, err := config.GetSshConfigPath()
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("%d SSH config entries of profile %s written to %s", len(projects), activeProfile.Name, sshConfigPath))
+		return nil
+	},
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sshconfig
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var SshConfigCmd = &cobra.Command{
+	Use:   "ssh-config",
+	Short: "Manage the SSH config entries of workspace projects",
+	Long: `Manage the SSH config entries that IDEs and the ssh command use to connect to workspace projects.
+The entries are written to the ssh_config file in the Daytona config directory, which is included at the top of ~/.ssh/config.`,
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+func init() {
+	SshConfigCmd.AddCommand(regenerateCmd)
+}
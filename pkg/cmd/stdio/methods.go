@@ -0,0 +1,246 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package stdio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+type methodFunc func(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error)
+
+// apiClientFunc returns the client for the server of the active profile
+type apiClientFunc func() (*apiclient.APIClient, error)
+
+// newApiClientFunc returns an apiClientFunc that is safe to call from concurrent requests.
+// The client is cached by apiclient_util.GetApiClient, which is not synchronized
+func newApiClientFunc() apiClientFunc {
+	var mutex sync.Mutex
+
+	return func() (*apiclient.APIClient, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return apiclient_util.GetApiClient(nil)
+	}
+}
+
+var methods = map[string]methodFunc{
+	"version":          version,
+	"profile.list":     listProfiles,
+	"target.list":      listTargets,
+	"workspace.list":   listWorkspaces,
+	"workspace.get":    getWorkspace,
+	"workspace.create": createWorkspace,
+	"workspace.start":  startWorkspace,
+	"workspace.stop":   stopWorkspace,
+	"workspace.delete": deleteWorkspace,
+}
+
+type workspaceParams struct {
+	Workspace string `json:"workspace"`
+	Project   string `json:"project"`
+	Verbose   bool   `json:"verbose"`
+	Force     bool   `json:"force"`
+}
+
+func parseWorkspaceParams(params json.RawMessage, workspaceRequired bool) (workspaceParams, error) {
+	var p workspaceParams
+	err := json.Unmarshal(params, &p)
+	if err != nil {
+		return p, fmt.Errorf("invalid params: %w", err)
+	}
+
+	if workspaceRequired && p.Workspace == "" {
+		return p, errors.New("workspace is required")
+	}
+
+	return p, nil
+}
+
+func version(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error) {
+	return map[string]string{"version": internal.Version}, nil
+}
+
+func listProfiles(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error) {
+	c, err := config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil && !errors.Is(err, config.ErrNoProfilesFound) {
+		return nil, err
+	}
+
+	type profile struct {
+		Id     string `json:"id"`
+		Name   string `json:"name"`
+		ApiUrl string `json:"apiUrl"`
+		Active bool   `json:"active"`
+	}
+
+	profiles := []profile{}
+	for _, p := range c.Profiles {
+		profiles = append(profiles, profile{
+			Id:     p.Id,
+			Name:   p.Name,
+			ApiUrl: p.Api.Url,
+			Active: p.Id == activeProfile.Id,
+		})
+	}
+
+	return profiles, nil
+}
+
+func listTargets(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error) {
+	apiClient, err := getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	targets, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return targets, nil
+}
+
+func listWorkspaces(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error) {
+	p, err := parseWorkspaceParams(params, false)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(p.Verbose).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return workspaces, nil
+}
+
+func getWorkspace(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error) {
+	p, err := parseWorkspaceParams(params, true)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, p.Workspace).Verbose(p.Verbose).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return workspace, nil
+}
+
+func createWorkspace(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error) {
+	var createWorkspaceDto apiclient.CreateWorkspaceDTO
+	err := json.Unmarshal(params, &createWorkspaceDto)
+	if err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+
+	apiClient, err := getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return workspace, nil
+}
+
+func startWorkspace(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error) {
+	p, err := parseWorkspaceParams(params, true)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Project != "" {
+		res, err := apiClient.WorkspaceAPI.StartProject(ctx, p.Workspace, p.Project).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+	} else {
+		res, err := apiClient.WorkspaceAPI.StartWorkspace(ctx, p.Workspace).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+	}
+
+	return struct{}{}, nil
+}
+
+func stopWorkspace(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error) {
+	p, err := parseWorkspaceParams(params, true)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Project != "" {
+		res, err := apiClient.WorkspaceAPI.StopProject(ctx, p.Workspace, p.Project).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+	} else {
+		res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, p.Workspace).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+	}
+
+	return struct{}{}, nil
+}
+
+func deleteWorkspace(ctx context.Context, getApiClient apiClientFunc, params json.RawMessage) (interface{}, error) {
+	p, err := parseWorkspaceParams(params, true)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := apiClient.WorkspaceAPI.RemoveWorkspace(ctx, p.Workspace).Force(p.Force).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package stdio
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+// Request is a single line read from stdin
+type Request struct {
+	// Set by the caller and echoed back in the response
+	Id     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is written to stdout as a single line for every request
+type Response struct {
+	Id     json.RawMessage `json:"id,omitempty"`
+	Result interface{}     `json:"result,omitempty"`
+	Error  *ResponseError  `json:"error,omitempty"`
+}
+
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+var ServeStdioCmd = &cobra.Command{
+	Use:   "serve-stdio",
+	Short: "Serve Daytona operations as JSON lines over stdin/stdout",
+	Long: `Serve Daytona operations as JSON lines over stdin/stdout, for embedding the CLI in other tools.
+
+Every line on stdin is a request: {"id": 1, "method": "workspace.list", "params": {"verbose": true}}
+Every request is answered with a line on stdout: {"id": 1, "result": [...]} or {"id": 1, "error": {"message": "..."}}
+Requests are processed concurrently, so responses can arrive in a different order. The id is echoed back to match them.
+The server exits when stdin is closed.
+
+Methods:
+  version                                          {}
+  profile.list                                     {}
+  target.list                                      {}
+  workspace.list                                   {"verbose": bool}
+  workspace.get                                    {"workspace": string, "verbose": bool}
+  workspace.create                                 CreateWorkspaceDTO of the Daytona Server API
+  workspace.start, workspace.stop                  {"workspace": string, "project": string}
+  workspace.delete                                 {"workspace": string, "force": bool}`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return serve(cmd.Context(), os.Stdin, os.Stdout, newApiClientFunc())
+	},
+}
+
+func serve(ctx context.Context, in io.Reader, out io.Writer, getApiClient apiClientFunc) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var writeMutex sync.Mutex
+	encoder := json.NewEncoder(out)
+
+	writeResponse := func(response Response) {
+		writeMutex.Lock()
+		defer writeMutex.Unlock()
+
+		err := encoder.Encode(response)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var request Request
+		err := json.Unmarshal(line, &request)
+		if err != nil {
+			writeResponse(Response{Error: &ResponseError{Message: fmt.Sprintf("invalid request: %s", err)}})
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			writeResponse(handle(ctx, getApiClient, request))
+		}()
+	}
+
+	return scanner.Err()
+}
+
+func handle(ctx context.Context, getApiClient apiClientFunc, request Request) Response {
+	response := Response{Id: request.Id}
+
+	method, ok := methods[request.Method]
+	if !ok {
+		response.Error = &ResponseError{Message: fmt.Sprintf("unknown method: %s", request.Method)}
+		return response
+	}
+
+	params := request.Params
+	if len(params) == 0 {
+		params = json.RawMessage("{}")
+	}
+
+	result, err := method(ctx, getApiClient, params)
+	if err != nil {
+		response.Error = &ResponseError{Message: err.Error()}
+		return response
+	}
+
+	response.Result = result
+	return response
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package stdio
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServe(t *testing.T) {
+	var stopped atomic.Bool
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/workspace/ws1":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id": "ws1", "name": "workspace", "target": "local", "projects": []}`)) // nolint:errcheck
+		case r.Method == http.MethodPost && r.URL.Path == "/workspace/ws1/stop":
+			stopped.Store(true)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer apiServer.Close()
+
+	clientConfig := apiclient.NewConfiguration()
+	clientConfig.Servers = apiclient.ServerConfigurations{{URL: apiServer.URL}}
+	client := apiclient.NewAPIClient(clientConfig)
+
+	var apiClientCalls atomic.Int32
+	getApiClient := func() (*apiclient.APIClient, error) {
+		apiClientCalls.Add(1)
+		return client, nil
+	}
+
+	in := strings.Join([]string{
+		`{"id": 1, "method": "version"}`,
+		`{"id": 2, "method": "unknown"}`,
+		`{"id": 3, "method": "workspace.get"}`,
+		`{"id": 4, "method": "workspace.get", "params": {"workspace": "ws1"}}`,
+		`{"id": 5, "method": "workspace.stop", "params": {"workspace": "ws1"}}`,
+		`{"id": 6, "method": "workspace.get", "params": {"workspace": "missing"}}`,
+		``,
+		`not json`,
+	}, "\n")
+
+	var out bytes.Buffer
+	require.NoError(t, serve(context.Background(), strings.NewReader(in), &out, getApiClient))
+
+	responses := readResponses(t, &out)
+	require.Len(t, responses, 7)
+
+	require.Equal(t, map[string]any{"version": internal.Version}, responses["1"].Result)
+	require.Equal(t, "unknown method: unknown", responses["2"].Error.Message)
+	require.Equal(t, "workspace is required", responses["3"].Error.Message)
+
+	require.Nil(t, responses["4"].Error)
+	require.Equal(t, "workspace", responses["4"].Result.(map[string]any)["name"])
+
+	require.Nil(t, responses["5"].Error)
+	require.True(t, stopped.Load())
+
+	require.NotNil(t, responses["6"].Error)
+	require.Contains(t, responses[""].Error.Message, "invalid request")
+
+	// Methods that don't call the server don't need a client
+	require.Equal(t, int32(3), apiClientCalls.Load())
+}
+
+func TestServeApiClientError(t *testing.T) {
+	getApiClient := func() (*apiclient.APIClient, error) {
+		return nil, errors.New("no active profile")
+	}
+
+	in := `{"id": "a", "method": "workspace.list"}` + "\n" + `{"id": "b", "method": "version"}`
+
+	var out bytes.Buffer
+	require.NoError(t, serve(context.Background(), strings.NewReader(in), &out, getApiClient))
+
+	responses := readResponses(t, &out)
+	require.Equal(t, "no active profile", responses[`"a"`].Error.Message)
+	require.Nil(t, responses[`"b"`].Error)
+}
+
+// readResponses returns the response lines by their raw id
+func readResponses(t *testing.T, out *bytes.Buffer) map[string]Response {
+	responses := map[string]Response{}
+
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		var response Response
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &response))
+
+		id := string(response.Id)
+		require.NotContains(t, responses, id, "duplicate response for id %s", id)
+		responses[id] = response
+	}
+	require.NoError(t, scanner.Err())
+
+	return responses
+}
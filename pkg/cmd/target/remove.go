@@ -9,6 +9,7 @@ import (
 
 	"github.com/charmbracelet/huh"
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	workspace_cmd "github.com/daytonaio/daytona/pkg/cmd/workspace"
@@ -93,12 +94,12 @@ var targetRemoveCmd = &cobra.Command{
 			}
 
 			if targetWorkspaceCount > 0 {
-				title := fmt.Sprintf("Delete %d workspaces within %s?", targetWorkspaceCount, selectedTargetName)
-				description := "You might not be able to easily remove these workspaces later."
+				title := i18n.T("Delete %d workspaces within %s?", targetWorkspaceCount, selectedTargetName)
+				description := i18n.T("You might not be able to easily remove these workspaces later.")
 
 				if targetWorkspaceCount == 1 {
-					title = fmt.Sprintf("Delete %d workspace within %s?", targetWorkspaceCount, selectedTargetName)
-					description = "You might not be able to easily remove this workspace later."
+					title = i18n.T("Delete %d workspace within %s?", targetWorkspaceCount, selectedTargetName)
+					description = i18n.T("You might not be able to easily remove this workspace later.")
 				}
 
 				form := huh.NewForm(
@@ -19,6 +19,7 @@ import (
 	provider_view "github.com/daytonaio/daytona/pkg/views/provider"
 	"github.com/daytonaio/daytona/pkg/views/target"
 	target_view "github.com/daytonaio/daytona/pkg/views/target"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/spf13/cobra"
 
 	log "github.com/sirupsen/logrus"
@@ -33,6 +34,15 @@ var TargetSetCmd = &cobra.Command{
 		ctx := context.Background()
 		var isNewProvider bool
 
+		var labels map[string]string
+		if cmd.Flags().Changed("label") {
+			var err error
+			labels, err = workspace.ParseLabels(labelFlags)
+			if err != nil {
+				return err
+			}
+		}
+
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			return err
@@ -151,6 +161,11 @@ var TargetSetCmd = &cobra.Command{
 			return err
 		}
 
+		// Labels of an existing target are kept unless new ones are passed
+		if labels == nil {
+			labels = selectedTarget.Labels
+		}
+
 		targetData := apiclient.CreateProviderTargetDTO{
 			Name:    selectedTarget.Name,
 			Options: selectedTarget.Options,
@@ -158,6 +173,7 @@ var TargetSetCmd = &cobra.Command{
 				Name:    selectedProvider.Name,
 				Version: selectedProvider.Version,
 			},
+			Labels: labels,
 		}
 
 		res, err = apiClient.TargetAPI.SetTarget(context.Background()).Target(targetData).Execute()
@@ -169,3 +185,9 @@ var TargetSetCmd = &cobra.Command{
 		return nil
 	},
 }
+
+var labelFlags []string
+
+func init() {
+	TargetSetCmd.Flags().StringArrayVarP(&labelFlags, "label", "l", []string{}, "Set a label on the target used for workspace placement (e.g. --label gpu=true --label zone=eu)")
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var themeColorFlags []string
+
+var themeCmd = &cobra.Command{
+	Use:   "theme [THEME]",
+	Short: "Choose the color theme",
+	Long: `Choose the color theme of the CLI
+
+Available themes:
+  default  Adapts to the background color of the terminal
+  light    Colors for terminals with a light background
+  dark     Colors for terminals with a dark background
+  custom   The default theme with the colors set with --color`,
+	Example: "  daytona theme custom --color green=#00ff00 --color red=196",
+	Args:    cobra.RangeArgs(0, 1),
+	GroupID: util.PROFILE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		var themeName string
+		if len(args) == 1 {
+			themeName = args[0]
+		} else {
+			themeName = c.Theme
+			if themeName == "" {
+				themeName = views.DefaultThemeName
+			}
+
+			options := []huh.Option[string]{}
+			for _, name := range views.GetThemeNames() {
+				options = append(options, huh.NewOption(name, name))
+			}
+
+			err := huh.NewSelect[string]().
+				Title("Choose a theme").
+				Options(options...).
+				Value(&themeName).
+				WithTheme(views.GetCustomTheme()).
+				Run()
+			if err != nil {
+				return err
+			}
+		}
+
+		if !views.IsValidThemeName(themeName) {
+			_, err := views.GetTheme(themeName, nil)
+			return err
+		}
+
+		customTheme := c.CustomTheme
+		if len(themeColorFlags) > 0 {
+			if themeName != views.CustomThemeName {
+				return fmt.Errorf("--color can only be used with the %s theme", views.CustomThemeName)
+			}
+
+			customTheme = map[string]string{}
+			for _, color := range themeColorFlags {
+				parts := strings.SplitN(color, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid color format: %s", color)
+				}
+				customTheme[parts[0]] = parts[1]
+			}
+		}
+
+		// Validates the custom colors before saving them
+		_, err = views.GetTheme(themeName, customTheme)
+		if err != nil {
+			return err
+		}
+
+		c.Theme = themeName
+		c.CustomTheme = customTheme
+
+		err = c.Save()
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Theme set to %s", themeName))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return views.GetThemeNames(), cobra.ShellCompDirectiveNoFileComp
+	},
+}
+
+func init() {
+	themeCmd.Flags().StringArrayVar(&themeColorFlags, "color", []string{}, "Set a color of the custom theme (e.g. --color green=#00ff00). Replaces the previously set colors")
+}
+
+// applyTheme sets the theme from the config. Colors are disabled with --no-color or the NO_COLOR environment variable
+func applyTheme(noColor bool) {
+	if noColor || os.Getenv("NO_COLOR") != "" {
+		views.DisableColors()
+		return
+	}
+
+	c, err := config.GetConfig()
+	if err != nil || c.Theme == "" {
+		return
+	}
+
+	theme, err := views.GetTheme(c.Theme, c.CustomTheme)
+	if err != nil {
+		log.Warn(err)
+		return
+	}
+
+	views.SetTheme(theme)
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/internal"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/selfupdate"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var channelFlag string
+
+var updateCmd = &cobra.Command{
+	Use:   "update [VERSION]",
+	Short: "Update the Daytona CLI",
+	Long:  "Update the Daytona CLI to the latest version of the release channel or to the given version. The signature of the release checksums and the checksum of the downloaded binary are verified before the executable is replaced",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		channel, err := selfupdate.ParseChannel(channelFlag)
+		if err != nil {
+			return err
+		}
+
+		updater, err := getUpdater()
+		if err != nil {
+			return err
+		}
+
+		var version string
+		if len(args) == 1 {
+			// Pinned versions can also be older than the current one
+			version = args[0]
+		} else {
+			version, err = updater.GetLatestVersion(ctx, channel)
+			if err != nil {
+				return err
+			}
+
+			if !selfupdate.IsNewer(internal.Version, version) {
+				views.RenderInfoMessage(fmt.Sprintf("You are on the latest version of the %s channel (%s)", channel, internal.Version))
+				return nil
+			}
+		}
+
+		if version == internal.Version {
+			views.RenderInfoMessage(fmt.Sprintf("Version %s is already installed", version))
+			return nil
+		}
+
+		executablePath, err := os.Executable()
+		if err != nil {
+			return err
+		}
+
+		executablePath, err = filepath.EvalSymlinks(executablePath)
+		if err != nil {
+			return err
+		}
+
+		if apiclient_util.IsDryRun() {
+			views.RenderInfoMessage(fmt.Sprintf("Would update %s from %s to %s", executablePath, internal.Version, version))
+			return nil
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Updating Daytona from %s to %s...", internal.Version, version))
+
+		err = updater.Update(ctx, version, executablePath)
+		if err != nil {
+			return fmt.Errorf("failed to update: %w", err)
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Daytona updated to %s", version))
+		views.RenderTip("If the Daytona Server runs on this machine, restart it with 'daytona server restart' to use the new version.")
+
+		return nil
+	},
+}
+
+func init() {
+	addChannelFlag(updateCmd)
+}
+
+func addChannelFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&channelFlag, "channel", string(selfupdate.ChannelStable), fmt.Sprintf("Release channel. Must be one of (%s, %s)", selfupdate.ChannelStable, selfupdate.ChannelBeta))
+}
+
+func getUpdater() (*selfupdate.Updater, error) {
+	releaseUrl := os.Getenv(selfupdate.RELEASE_URL_ENV_VAR)
+	if releaseUrl == "" {
+		releaseUrl = selfupdate.DefaultReleaseUrl
+	}
+
+	return selfupdate.NewUpdater(releaseUrl, internal.ReleasePublicKey)
+}
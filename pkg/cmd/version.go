@@ -4,17 +4,53 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/pkg/selfupdate"
+	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/spf13/cobra"
 )
 
+var checkVersionFlag bool
+
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print the version number",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		fmt.Println("Daytona version", internal.Version)
+
+		if !checkVersionFlag {
+			return nil
+		}
+
+		channel, err := selfupdate.ParseChannel(channelFlag)
+		if err != nil {
+			return err
+		}
+
+		updater, err := getUpdater()
+		if err != nil {
+			return err
+		}
+
+		latestVersion, err := updater.GetLatestVersion(context.Background(), channel)
+		if err != nil {
+			return err
+		}
+
+		if selfupdate.IsNewer(internal.Version, latestVersion) {
+			views.RenderInfoMessage(fmt.Sprintf("Version %s is available on the %s channel. Run 'daytona update --channel %s' to install it", latestVersion, channel, channel))
+		} else {
+			views.RenderInfoMessage(fmt.Sprintf("You are on the latest version of the %s channel", channel))
+		}
+
 		return nil
 	},
 }
+
+func init() {
+	versionCmd.Flags().BoolVar(&checkVersionFlag, "check", false, "Check if a newer version is available")
+	addChannelFlag(versionCmd)
+}
@@ -0,0 +1,389 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/docker/docker/pkg/stringid"
+	"github.com/spf13/cobra"
+)
+
+// Label of the workspaces managed by apply. The value is the absolute path of the definitions directory
+const APPLY_LABEL = "daytona.io/apply"
+
+type applyActionType string
+
+const (
+	applyActionCreate applyActionType = "create"
+	applyActionUpdate applyActionType = "update"
+	applyActionRemove applyActionType = "remove"
+)
+
+var appliedActionMessages = map[applyActionType]string{
+	applyActionCreate: "created",
+	applyActionUpdate: "updated",
+	applyActionRemove: "removed",
+}
+
+type applyAction struct {
+	Type       applyActionType
+	Name       string
+	Definition *config.WorkspaceDefinition
+	Workspace  *apiclient.WorkspaceDTO
+	Settings   apiclient.UpdateWorkspaceSettingsDTO
+	Changes    []string
+}
+
+var pruneFlag bool
+
+var ApplyCmd = &cobra.Command{
+	Use:     "apply DIRECTORY",
+	Short:   "Create, update or remove workspaces to match the definition files in a directory",
+	Long:    "Create, update or remove workspaces to match the workspace definition files (*.yaml and *.yml) in a directory.\nMissing workspaces are created and the group and auto-stop timeout of existing workspaces are updated. Defined labels are merged into the labels of existing workspaces. Projects of existing workspaces are not changed. Workspaces that were applied from the directory but are no longer defined are removed with --prune.",
+	Args:    cobra.ExactArgs(1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		dir, err := filepath.Abs(args[0])
+		if err != nil {
+			return err
+		}
+
+		definitions, err := config.LoadWorkspaceDefinitionsFromDir(dir)
+		if err != nil {
+			return err
+		}
+
+		if len(definitions) == 0 && !pruneFlag {
+			return fmt.Errorf("no workspace definitions found in %s", dir)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		actions, warnings, err := getApplyActions(dir, definitions, workspaceList)
+		if err != nil {
+			return err
+		}
+
+		for _, warning := range warnings {
+			views.RenderInfoMessage(warning)
+		}
+
+		if len(actions) == 0 {
+			views.RenderInfoMessageBold("Workspaces are up to date")
+			return nil
+		}
+
+		fmt.Println(renderApplyActions(actions))
+
+		if !yesFlag && !apiclient_util.IsDryRun() {
+			confirmed := false
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(i18n.T("Apply the changes?")).
+						Value(&confirmed),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				return err
+			}
+
+			if !confirmed {
+				fmt.Println(i18n.T("Operation canceled."))
+				return nil
+			}
+		}
+
+		for _, action := range actions {
+			switch action.Type {
+			case applyActionCreate:
+				err = createWorkspaceFromDefinition(ctx, apiClient, action)
+			case applyActionUpdate:
+				_, res, err = apiClient.WorkspaceAPI.UpdateWorkspaceSettings(ctx, action.Workspace.Id).Settings(action.Settings).Execute()
+				if err != nil {
+					err = apiclient_util.HandleErrorResponse(res, err)
+				}
+			case applyActionRemove:
+				err = RemoveWorkspace(ctx, apiClient, action.Workspace, false)
+			}
+
+			if err != nil {
+				if common.IsCtrlCAbort(err) {
+					return nil
+				}
+				return fmt.Errorf("failed to %s workspace %s: %w", action.Type, action.Name, err)
+			}
+
+			if !apiclient_util.IsDryRun() {
+				views.RenderInfoMessage(fmt.Sprintf("Workspace %s %s", action.Name, appliedActionMessages[action.Type]))
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	ApplyCmd.Flags().BoolVar(&pruneFlag, "prune", false, "Remove the workspaces that were applied from the directory but are no longer defined")
+	ApplyCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+}
+
+// getApplyActions diffs the definitions against the existing workspaces. The defined labels are merged into the labels
+// of existing workspaces. Differences in the projects of existing workspaces are returned as warnings since projects
+// can't be changed in place
+func getApplyActions(dir string, definitions []*config.WorkspaceDefinition, workspaceList []apiclient.WorkspaceDTO) ([]applyAction, []string, error) {
+	actions := []applyAction{}
+	warnings := []string{}
+	defined := map[string]bool{}
+
+	for _, definition := range definitions {
+		defined[definition.Name] = true
+
+		autoStop, err := definition.GetAutoStop()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		labels := maps.Clone(definition.Labels)
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[APPLY_LABEL] = dir
+
+		settings := apiclient.UpdateWorkspaceSettingsDTO{
+			Group:    definition.Group,
+			Labels:   labels,
+			AutoStop: int32(autoStop.Minutes()),
+		}
+
+		i := slices.IndexFunc(workspaceList, func(w apiclient.WorkspaceDTO) bool {
+			return w.Name == definition.Name
+		})
+		if i == -1 {
+			actions = append(actions, applyAction{
+				Type:       applyActionCreate,
+				Name:       definition.Name,
+				Definition: definition,
+				Settings:   settings,
+			})
+			continue
+		}
+
+		ws := &workspaceList[i]
+
+		// Labels set outside of the definition (e.g. with daytona label set) are kept
+		settings.Labels = maps.Clone(ws.Labels)
+		if settings.Labels == nil {
+			settings.Labels = map[string]string{}
+		}
+		maps.Copy(settings.Labels, labels)
+
+		if !slices.Equal(getDefinitionRepositories(definition), getWorkspaceRepositories(ws)) {
+			warnings = append(warnings, fmt.Sprintf("The projects of workspace %s differ from its definition. Delete the workspace to recreate it with the defined projects", ws.Name))
+		}
+
+		changes := []string{}
+		if ws.GetGroup() != settings.Group {
+			changes = append(changes, fmt.Sprintf("group: %s → %s", formatApplyValue(ws.GetGroup()), formatApplyValue(settings.Group)))
+		}
+		if !maps.Equal(ws.Labels, settings.Labels) {
+			changes = append(changes, fmt.Sprintf("labels: %s → %s", formatApplyValue(formatLabels(ws.Labels)), formatApplyValue(formatLabels(settings.Labels))))
+		}
+		if ws.GetAutoStop() != settings.AutoStop {
+			changes = append(changes, fmt.Sprintf("auto-stop: %s → %s", formatAutoStop(ws.GetAutoStop()), formatAutoStop(settings.AutoStop)))
+		}
+
+		if len(changes) > 0 {
+			actions = append(actions, applyAction{
+				Type:       applyActionUpdate,
+				Name:       ws.Name,
+				Definition: definition,
+				Workspace:  ws,
+				Settings:   settings,
+				Changes:    changes,
+			})
+		}
+	}
+
+	if pruneFlag {
+		for i, ws := range workspaceList {
+			if ws.Labels[APPLY_LABEL] != dir || defined[ws.Name] {
+				continue
+			}
+
+			actions = append(actions, applyAction{
+				Type:      applyActionRemove,
+				Name:      ws.Name,
+				Workspace: &workspaceList[i],
+			})
+		}
+	}
+
+	return actions, warnings, nil
+}
+
+func createWorkspaceFromDefinition(ctx context.Context, apiClient *apiclient.APIClient, action applyAction) error {
+	projects, err := workspace_util.GetProjectsFromDefinition(ctx, apiClient, action.Definition)
+	if err != nil {
+		return err
+	}
+	dedupProjectNames(&projects)
+
+	profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for i := range projects {
+		if profileData != nil && profileData.EnvVars != nil {
+			projects[i].EnvVars = util.MergeEnvVars(profileData.EnvVars, projects[i].EnvVars)
+		} else {
+			projects[i].EnvVars = util.MergeEnvVars(projects[i].EnvVars)
+		}
+	}
+
+	targetList, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	c, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return err
+	}
+
+	target, err := workspace_util.GetTarget(workspace_util.GetTargetConfig{
+		Ctx:                  ctx,
+		ApiClient:            apiClient,
+		TargetList:           targetList,
+		ActiveProfileName:    activeProfile.Name,
+		TargetNameFlag:       action.Definition.Target,
+		ProfileDefaultTarget: activeProfile.DefaultTarget,
+	})
+	if err != nil {
+		return err
+	}
+
+	projectNames := []string{}
+	for _, project := range projects {
+		projectNames = append(projectNames, project.Name)
+	}
+
+	id := stringid.TruncateID(stringid.GenerateRandomID())
+
+	logsContext, stopLogs := context.WithCancel(context.Background())
+	defer stopLogs()
+	if !apiclient_util.IsDryRun() {
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, id, projectNames, true, true, nil)
+	}
+
+	createWorkspaceDto := apiclient.CreateWorkspaceDTO{
+		Id:       id,
+		Name:     action.Name,
+		Target:   target.Name,
+		Projects: projects,
+		Labels:   action.Settings.Labels,
+		Origin:   workspace_util.GetWorkspaceOrigin(action.Definition),
+	}
+	if action.Settings.Group != "" {
+		createWorkspaceDto.Group = &action.Settings.Group
+	}
+	if action.Settings.AutoStop > 0 {
+		createWorkspaceDto.AutoStop = &action.Settings.AutoStop
+	}
+
+	_, res, err = apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func renderApplyActions(actions []applyAction) string {
+	symbols := map[applyActionType]string{
+		applyActionCreate: "+",
+		applyActionUpdate: "~",
+		applyActionRemove: "-",
+	}
+
+	lines := []string{}
+	for _, action := range actions {
+		lines = append(lines, fmt.Sprintf("%s %s %s", symbols[action.Type], action.Type, action.Name))
+		for _, change := range action.Changes {
+			lines = append(lines, "    "+change)
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func getDefinitionRepositories(definition *config.WorkspaceDefinition) []string {
+	repositories := []string{}
+	for _, project := range definition.Projects {
+		repositories = append(repositories, normalizeRepositoryUrl(project.Repository))
+	}
+	slices.Sort(repositories)
+	return repositories
+}
+
+func getWorkspaceRepositories(ws *apiclient.WorkspaceDTO) []string {
+	repositories := []string{}
+	for _, project := range ws.Projects {
+		repositories = append(repositories, normalizeRepositoryUrl(project.Repository.Url))
+	}
+	slices.Sort(repositories)
+	return repositories
+}
+
+func normalizeRepositoryUrl(repoUrl string) string {
+	repoUrl = strings.TrimSuffix(strings.ToLower(repoUrl), "/")
+	return strings.TrimSuffix(repoUrl, ".git")
+}
+
+func formatApplyValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func formatAutoStop(minutes int32) string {
+	if minutes == 0 {
+		return "off"
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/bulk"
+)
+
+const defaultParallelism = 4
+
+const parallelFlagDescription = "Maximum number of workspaces to process at the same time with --all, glob patterns or several workspaces"
+
+var parallelFlag int
+
+// isBulkSelection returns true if the arguments select workspaces with --all, glob patterns or several workspace names
+func isBulkSelection(args []string) bool {
+	if allFlag || len(args) > 1 {
+		return true
+	}
+
+	return len(args) == 1 && isGlobPattern(args[0])
+}
+
+func isGlobPattern(value string) bool {
+	return strings.ContainsAny(value, "*?[")
+}
+
+// selectBulkWorkspaces returns all workspaces with --all or the workspaces whose name matches one of the patterns.
+// Patterns are either workspace names or IDs or glob patterns such as feature-*
+func selectBulkWorkspaces(workspaceList []apiclient.WorkspaceDTO, patterns []string) ([]apiclient.WorkspaceDTO, error) {
+	if allFlag {
+		if len(patterns) > 0 {
+			return nil, errors.New("--all can not be used together with workspace names")
+		}
+		return workspaceList, nil
+	}
+
+	selected := []apiclient.WorkspaceDTO{}
+	selectedIds := map[string]bool{}
+
+	for _, pattern := range patterns {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return nil, fmt.Errorf("invalid workspace pattern %s: %w", pattern, err)
+		}
+
+		matched := false
+		for _, workspace := range workspaceList {
+			nameMatches, _ := path.Match(pattern, workspace.Name)
+			if !nameMatches && workspace.Id != pattern {
+				continue
+			}
+
+			matched = true
+			if !selectedIds[workspace.Id] {
+				selectedIds[workspace.Id] = true
+				selected = append(selected, workspace)
+			}
+		}
+
+		if !matched {
+			return nil, fmt.Errorf("no workspaces match %s", pattern)
+		}
+	}
+
+	return selected, nil
+}
+
+// runBulkAction runs the action for every workspace with at most --parallel actions running at the same time
+// and renders a table with the result of each workspace. An error is returned if the action failed for any workspace
+func runBulkAction(title, message string, workspaces []apiclient.WorkspaceDTO, action func(workspace apiclient.WorkspaceDTO) error) error {
+	if len(workspaces) == 0 {
+		views_util.NotifyEmptyWorkspaceList(false)
+		return nil
+	}
+
+	parallelism := parallelFlag
+	// Requests printed in dry-run mode would be interleaved
+	if apiclient_util.IsDryRun() {
+		parallelism = 1
+	}
+
+	var results []bulk.Result
+	err := views_util.WithInlineSpinner(message, func() error {
+		results = util.ArrayMapParallel(workspaces, parallelism, func(workspace apiclient.WorkspaceDTO) bulk.Result {
+			start := time.Now()
+			err := action(workspace)
+			return bulk.Result{Workspace: workspace.Name, Duration: time.Since(start), Error: err}
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if apiclient_util.IsDryRun() {
+		return nil
+	}
+
+	bulk.RenderResults(title, results)
+
+	failed := 0
+	for _, result := range results {
+		if result.Error != nil {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return errors.New(i18n.T("%d of %d workspaces failed", failed, len(results)))
+	}
+
+	return nil
+}
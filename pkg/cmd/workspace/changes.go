@@ -0,0 +1,95 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"golang.org/x/term"
+)
+
+var ignoreDirtyFlag bool
+
+const ignoreDirtyFlagDescription = "Skip checking the projects for uncommitted or unpushed changes"
+
+// getChangesSummary returns a description of the uncommitted and unpushed changes in the projects
+// of the workspaces or an empty string if there are none. If projectName is set, only that project is checked
+func getChangesSummary(ctx context.Context, apiClient *apiclient.APIClient, workspaces []*apiclient.WorkspaceDTO, projectName string) (string, error) {
+	if ignoreDirtyFlag {
+		return "", nil
+	}
+
+	changes := []workspace_util.ProjectChanges{}
+
+	err := views_util.WithInlineSpinner(i18n.T("Checking projects for unpushed changes"), func() error {
+		for _, workspace := range workspaces {
+			changes = append(changes, workspace_util.GetProjectChanges(ctx, apiClient, workspace, projectName)...)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(changes) == 0 {
+		return "", nil
+	}
+
+	lines := []string{}
+	for _, c := range changes {
+		lines = append(lines, "- "+c.String())
+	}
+
+	return i18n.T("The following projects have uncommitted or unpushed changes:\n%s\n\nUse --ignore-dirty to skip this check.", strings.Join(lines, "\n")), nil
+}
+
+// confirmProjectChanges asks for confirmation if any of the projects has uncommitted or unpushed changes.
+// Returns false if the user canceled. Without a terminal to prompt in, e.g. in scripts, an error is returned
+// unless the action is confirmed with --yes
+func confirmProjectChanges(ctx context.Context, apiClient *apiclient.APIClient, workspaces []*apiclient.WorkspaceDTO, projectName, action string) (bool, error) {
+	// Nothing changes in dry-run mode so there is nothing to confirm
+	if apiclient_util.IsDryRun() || yesFlag {
+		return true, nil
+	}
+
+	summary, err := getChangesSummary(ctx, apiClient, workspaces, projectName)
+	if err != nil {
+		return false, err
+	}
+
+	if summary == "" {
+		return true, nil
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return false, errors.New(i18n.T("%s\nUse --yes to %s anyway", summary, strings.ToLower(i18n.T(action))))
+	}
+
+	confirmed := false
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(i18n.T("%s anyway?", i18n.T(action))).
+				Description(summary).
+				Value(&confirmed),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err = form.Run()
+	if err != nil {
+		return false, err
+	}
+
+	return confirmed, nil
+}
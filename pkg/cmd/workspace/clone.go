@@ -0,0 +1,299 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/info"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloneFilesFlag bool
+	cloneEnvFlag   bool
+)
+
+// Time to wait for the repository to be cloned in a new project before uncommitted files are copied
+const cloneRepositoryTimeout = 5 * time.Minute
+
+var CloneCmd = &cobra.Command{
+	Use:   "clone SOURCE DESTINATION",
+	Short: "Create a new workspace from the repositories of an existing one",
+	Long: `Create a new workspace with the same repositories, branches, target and settings as an existing workspace, e.g. to try something in parallel.
+Projects are created from the current branch of the source projects if it was pushed. Uncommitted files are copied from the source projects with --files and project environment variables with --env.`,
+	Example: `  daytona clone my-workspace my-experiment
+  daytona clone my-workspace my-experiment --files --env`,
+	Args:    cobra.ExactArgs(2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		source, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		name, err := util.GetValidatedName(args[1])
+		if err != nil {
+			return err
+		}
+
+		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		projects := []apiclient.CreateProjectDTO{}
+		for _, project := range source.Projects {
+			p, warning := getCloneProject(project, profileData.GetEnvVars())
+			if warning != "" {
+				views.RenderInfoMessage(warning)
+			}
+			projects = append(projects, p)
+		}
+
+		id := stringid.TruncateID(stringid.GenerateRandomID())
+
+		createWorkspaceDto := apiclient.CreateWorkspaceDTO{
+			Id:       id,
+			Name:     name,
+			Target:   source.Target,
+			Projects: projects,
+			Labels:   maps.Clone(source.Labels),
+			Group:    source.Group,
+			AutoStop: source.AutoStop,
+		}
+
+		if apiclient_util.IsDryRun() {
+			_, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			if cloneFilesFlag {
+				fmt.Println("[dry-run] Would copy the uncommitted files of the source projects")
+			}
+			return nil
+		}
+
+		projectNames := util.ArrayMap(projects, func(p apiclient.CreateProjectDTO) string {
+			return p.Name
+		})
+
+		logsContext, stopLogs := context.WithCancel(context.Background())
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, id, projectNames, true, true, nil)
+
+		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
+		time.Sleep(100 * time.Millisecond)
+		stopLogs()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if cloneFilesFlag {
+			for _, project := range source.Projects {
+				var copied int
+				err = views_util.WithInlineSpinner(fmt.Sprintf("Copying uncommitted files of project %s", project.Name), func() error {
+					copied, err = copyUncommittedFiles(ctx, apiClient, source.Id, createdWorkspace.Id, project.Name)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("failed to copy the uncommitted files of project %s: %w", project.Name, err)
+				}
+				views.RenderInfoMessage(fmt.Sprintf("Copied %d uncommitted files of project %s", copied, project.Name))
+			}
+		}
+
+		wsInfo, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, createdWorkspace.Id).Verbose(true).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		fmt.Println()
+		info.Render(wsInfo, "", false)
+		views.RenderInfoMessage(fmt.Sprintf("Workspace %s cloned from %s", name, source.Name))
+
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	CloneCmd.Flags().BoolVar(&cloneFilesFlag, "files", false, "Copy the uncommitted files of the source projects")
+	CloneCmd.Flags().BoolVar(&cloneEnvFlag, "env", false, "Copy the environment variables of the source projects instead of using the profile environment variables")
+}
+
+// getCloneProject returns the create DTO of a project with the same repository and configuration as the source project.
+// The current branch is used if it was pushed, otherwise a warning is returned
+func getCloneProject(project apiclient.Project, profileEnvVars map[string]string) (apiclient.CreateProjectDTO, string) {
+	repository := project.Repository
+	warning := ""
+
+	if project.State != nil && project.State.GitStatus != nil {
+		gitStatus := project.State.GitStatus
+		if gitStatus.CurrentBranch != "" && gitStatus.CurrentBranch != repository.Branch {
+			if gitStatus.GetBranchPublished() {
+				repository.Branch = gitStatus.CurrentBranch
+				cloneTarget := apiclient.CloneTargetBranch
+				repository.CloneTarget = &cloneTarget
+			} else {
+				warning = fmt.Sprintf("Branch %s of project %s was not pushed. Using branch %s instead", gitStatus.CurrentBranch, project.Name, repository.Branch)
+			}
+		}
+	}
+
+	envVars := util.MergeEnvVars(profileEnvVars)
+	if cloneEnvFlag {
+		envVars = map[string]string{}
+		for key, value := range project.EnvVars {
+			// Daytona variables are set by the server for each project
+			if !strings.HasPrefix(key, "DAYTONA_") {
+				envVars[key] = value
+			}
+		}
+	}
+
+	p := apiclient.CreateProjectDTO{
+		Name:                 project.Name,
+		GitProviderConfigId:  project.GitProviderConfigId,
+		Source:               apiclient.CreateProjectSourceDTO{Repository: repository},
+		EnvVars:              envVars,
+		BuildConfig:          project.BuildConfig,
+		ForwardPorts:         project.ForwardPorts,
+		OnCreateCommands:     project.OnCreateCommands,
+		PostStartCommands:    project.PostStartCommands,
+		ProjectConfigName:    project.ProjectConfigName,
+		ProjectConfigVersion: project.ProjectConfigVersion,
+		Resources:            project.Resources,
+	}
+
+	if project.Image != "" {
+		p.Image = &project.Image
+	}
+	if project.User != "" {
+		p.User = &project.User
+	}
+
+	return p, warning
+}
+
+// copyUncommittedFiles copies the files with uncommitted changes from a project to the project with the same name
+// in another workspace. Deleted files are removed. Returns the number of copied and removed files
+func copyUncommittedFiles(ctx context.Context, apiClient *apiclient.APIClient, sourceWorkspaceId, targetWorkspaceId, projectName string) (int, error) {
+	sourceDir, res, err := apiClient.WorkspaceToolboxAPI.GetProjectDir(ctx, sourceWorkspaceId, projectName).Execute()
+	if err != nil {
+		return 0, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	gitStatus, res, err := apiClient.WorkspaceToolboxAPI.GitGitStatus(ctx, sourceWorkspaceId, projectName).Path(sourceDir.GetDir()).Execute()
+	if err != nil {
+		return 0, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(gitStatus.FileStatus) == 0 {
+		return 0, nil
+	}
+
+	targetDir, res, err := apiClient.WorkspaceToolboxAPI.GetProjectDir(ctx, targetWorkspaceId, projectName).Execute()
+	if err != nil {
+		return 0, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	// Files can only be copied once the repository was cloned, otherwise the clone would fail
+	err = waitForRepository(ctx, apiClient, targetWorkspaceId, projectName, targetDir.GetDir())
+	if err != nil {
+		return 0, err
+	}
+
+	copied := 0
+	for _, file := range gitStatus.FileStatus {
+		if file.Worktree == apiclient.Unmodified && file.Staging == apiclient.Unmodified {
+			continue
+		}
+
+		targetPath := path.Join(targetDir.GetDir(), file.Name)
+
+		if file.Worktree == apiclient.Deleted || (file.Staging == apiclient.Deleted && file.Worktree == apiclient.Unmodified) {
+			res, err := apiClient.WorkspaceToolboxAPI.FsDeleteFile(ctx, targetWorkspaceId, projectName).Path(targetPath).Execute()
+			if err != nil {
+				return copied, apiclient_util.HandleErrorResponse(res, err)
+			}
+			copied++
+			continue
+		}
+
+		content, res, err := apiClient.WorkspaceToolboxAPI.FsDownloadFile(ctx, sourceWorkspaceId, projectName).Path(path.Join(sourceDir.GetDir(), file.Name)).Execute()
+		if err != nil {
+			return copied, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		_, err = content.Seek(0, 0)
+		if err != nil {
+			content.Close()
+			return copied, err
+		}
+
+		res, err = apiClient.WorkspaceToolboxAPI.FsUploadFile(ctx, targetWorkspaceId, projectName).Path(targetPath).File(content).Execute()
+		content.Close()
+		if err != nil {
+			return copied, apiclient_util.HandleErrorResponse(res, err)
+		}
+		copied++
+	}
+
+	return copied, nil
+}
+
+func waitForRepository(ctx context.Context, apiClient *apiclient.APIClient, workspaceId, projectName, projectDir string) error {
+	timeout := time.After(cloneRepositoryTimeout)
+
+	for {
+		_, _, err := apiClient.WorkspaceToolboxAPI.GitGitStatus(ctx, workspaceId, projectName).Path(projectDir).Execute()
+		if err == nil {
+			return nil
+		}
+		log.Debugf("Waiting for the repository of project %s: %s", projectName, err)
+
+		select {
+		case <-timeout:
+			return errors.New("timed out waiting for the repository to be cloned")
+		case <-time.After(time.Second):
+		}
+	}
+}
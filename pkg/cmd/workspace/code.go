@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/jetbrains"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
@@ -50,7 +51,7 @@ var CodeCmd = &cobra.Command{
 			return err
 		}
 
-		ideId = c.DefaultIdeId
+		ideId = c.GetDefaultIdeId(activeProfile)
 
 		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
 		if err != nil {
@@ -130,6 +131,8 @@ var CodeCmd = &cobra.Command{
 			}
 		}
 
+		workspace_util.PinHostKeys(activeProfile, workspace.Id, workspace.Projects)
+
 		gpgKey, err := GetGitProviderGpgKey(apiClient, ctx, providerConfigId)
 		if err != nil {
 			log.Warn(err)
@@ -172,7 +175,7 @@ func selectWorkspaceProject(workspaceId string, profile *config.Profile) (*apicl
 		return &wsInfo.Projects[0], nil
 	}
 
-	return nil, errors.New("no projects found in workspace")
+	return nil, errors.New(i18n.T("no projects found in workspace"))
 }
 
 func openIDE(ideId string, activeProfile config.Profile, workspaceId string, projectName string, projectProviderMetadata string, yesFlag bool, gpgKey string) error {
@@ -0,0 +1,160 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	copyRecursiveFlag bool
+	copyProjectFlag   string
+)
+
+var CpCmd = &cobra.Command{
+	Use:   "cp SOURCE DESTINATION",
+	Short: "Copy files between the local machine and a project",
+	Long:  "Copy files and directories between the local machine and a project over SFTP. Paths in a project are written as WORKSPACE:PATH and relative paths are resolved against the project directory.",
+	Example: `  daytona cp ./config.yaml my-workspace:config.yaml
+  daytona cp -r my-workspace:/home/daytona/project/dist ./dist`,
+	Args:    cobra.ExactArgs(2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		srcWorkspace, src := parseCopyPath(args[0])
+		dstWorkspace, dst := parseCopyPath(args[1])
+
+		if (srcWorkspace == "") == (dstWorkspace == "") {
+			return errors.New("exactly one of the paths must be in a workspace (WORKSPACE:PATH)")
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		workspaceName := srcWorkspace + dstWorkspace
+
+		workspace, err := apiclient_util.GetWorkspace(workspaceName, false)
+		if err != nil {
+			return err
+		}
+
+		projectName, err := apiclient_util.GetFirstWorkspaceProjectName(workspace.Id, copyProjectFlag, &activeProfile)
+		if err != nil {
+			return err
+		}
+
+		if !workspace_util.IsProjectRunning(workspace, projectName) {
+			started, err := AutoStartWorkspace(workspace.Name, projectName)
+			if err != nil {
+				return err
+			}
+			if !started {
+				return nil
+			}
+		}
+
+		// Resolve relative project paths against the project directory
+		remotePath := src + dst
+		if !path.IsAbs(remotePath) {
+			projectDir, res, err := apiClient.WorkspaceToolboxAPI.GetProjectDir(ctx, workspace.Id, projectName).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			remotePath = path.Join(projectDir.GetDir(), remotePath)
+		}
+
+		client, err := util.GetProjectSshClient(activeProfile.Id, workspace.Id, projectName)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		sftpClient, err := client.NewSftpClient()
+		if err != nil {
+			return err
+		}
+		defer sftpClient.Close()
+
+		var size int64
+		var copyFunc func(report func(n int64)) error
+		var source string
+
+		if srcWorkspace == "" {
+			source = filepath.Base(src)
+			size, err = ssh.GetUploadSize(src)
+			copyFunc = func(report func(n int64)) error {
+				return ssh.Upload(sftpClient, src, remotePath, copyRecursiveFlag, report)
+			}
+		} else {
+			source = path.Base(remotePath)
+			size, err = ssh.GetDownloadSize(sftpClient, remotePath)
+			copyFunc = func(report func(n int64)) error {
+				return ssh.Download(sftpClient, remotePath, dst, copyRecursiveFlag, report)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		err = views_util.WithProgressBar(fmt.Sprintf("Copying %s", source), size, copyFunc)
+		if err != nil {
+			if errors.Is(err, ssh.ErrIsDirectory) {
+				return fmt.Errorf("%w. Use --recursive to copy directories", err)
+			}
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Copied %s to %s", args[0], args[1]))
+		return nil
+	},
+}
+
+func init() {
+	CpCmd.Flags().BoolVarP(&copyRecursiveFlag, "recursive", "r", false, "Copy directories recursively")
+	CpCmd.Flags().StringVarP(&copyProjectFlag, "project", "p", "", "Project to copy to or from in a multi-project workspace")
+	CpCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+}
+
+// parseCopyPath splits a WORKSPACE:PATH argument. Returns an empty workspace for local paths
+func parseCopyPath(arg string) (string, string) {
+	workspace, p, found := strings.Cut(arg, ":")
+	if !found || workspace == "" || strings.ContainsAny(workspace, `/\`) {
+		return "", arg
+	}
+
+	// Windows drive letter
+	if runtime.GOOS == "windows" && len(workspace) == 1 {
+		return "", arg
+	}
+
+	return workspace, p
+}
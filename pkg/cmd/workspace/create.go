@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/url"
 	"os/exec"
@@ -14,24 +15,26 @@ import (
 	"time"
 
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
 	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/e2e"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/views"
 	logs_view "github.com/daytonaio/daytona/pkg/views/logs"
+	"github.com/daytonaio/daytona/pkg/views/report"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
 	"github.com/daytonaio/daytona/pkg/views/workspace/create"
 	"github.com/daytonaio/daytona/pkg/views/workspace/info"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
-	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/pkg/stringid"
 	log "github.com/sirupsen/logrus"
-	"tailscale.com/tsnet"
 
 	"github.com/spf13/cobra"
 
@@ -48,7 +51,58 @@ var CreateCmd = &cobra.Command{
 		var workspaceName string
 		var existingWorkspaceNames []string
 		var existingProjectConfigNames []string
-		promptUsingTUI := len(args) == 0
+		promptUsingTUI := len(args) == 0 && fileFlag == ""
+
+		jsonProgress, err := isJsonProgress()
+		if err != nil {
+			return err
+		}
+		if jsonProgress && promptUsingTUI {
+			return errors.New("--progress json requires a repository URL, a project config or a workspace definition file")
+		}
+
+		var definition *config.WorkspaceDefinition
+		if fileFlag != "" {
+			if len(args) > 0 || multiProjectFlag || workspace_util.CheckAnyProjectConfigurationFlagSet(projectConfigurationFlags) {
+				return errors.New("repositories and project configuration flags can not be used with a workspace definition file")
+			}
+
+			definition, err = config.LoadWorkspaceDefinition(fileFlag)
+			if err != nil {
+				return err
+			}
+
+			err = applyWorkspaceDefinition(cmd, definition)
+			if err != nil {
+				return err
+			}
+		}
+
+		labels, err := workspace.ParseLabels(labelFlags)
+		if err != nil {
+			return err
+		}
+
+		if definition != nil && len(definition.Labels) > 0 {
+			// Labels passed as flags take precedence over the labels in the file
+			definitionLabels := maps.Clone(definition.Labels)
+			maps.Copy(definitionLabels, labels)
+			labels = definitionLabels
+		}
+
+		placement, err := workspace.ParseLabels(placementFlag)
+		if err != nil {
+			return fmt.Errorf("invalid placement constraint: %w", err)
+		}
+
+		resources, err := workspace_util.GetResourceLimitsFromFlags(cpuFlag, memoryFlag, diskFlag)
+		if err != nil {
+			return err
+		}
+
+		if autoStopFlag != 0 && autoStopFlag < time.Minute {
+			return errors.New("auto-stop timeout must be at least one minute")
+		}
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -91,6 +145,19 @@ var CreateCmd = &cobra.Command{
 					return err
 				}
 			}
+		} else if definition != nil {
+			projects, err = workspace_util.GetProjectsFromDefinition(ctx, apiClient, definition)
+			if err != nil {
+				if common.IsCtrlCAbort(err) {
+					return nil
+				}
+				return err
+			}
+			dedupProjectNames(&projects)
+
+			if workspaceName == "" {
+				workspaceName = workspace_util.GetSuggestedName(projects[0].Name, existingWorkspaceNames)
+			}
 		} else {
 			existingProjectConfigNames, err = processCmdArguments(ctx, args, apiClient, &projects)
 			if err != nil {
@@ -105,7 +172,7 @@ var CreateCmd = &cobra.Command{
 		}
 
 		if workspaceName == "" || len(projects) == 0 {
-			return errors.New("workspace name and repository urls are required")
+			return errors.New(i18n.T("workspace name and repository urls are required"))
 		}
 
 		projectNames := []string{}
@@ -115,11 +182,15 @@ var CreateCmd = &cobra.Command{
 			} else {
 				projects[i].EnvVars = util.MergeEnvVars(projects[i].EnvVars)
 			}
+			if activeProfile.E2EEncryption {
+				projects[i].EnvVars[e2e.REQUIRED_ENV_VAR] = "true"
+			}
+			projects[i].Resources = resources
 			projectNames = append(projectNames, projects[i].Name)
 		}
 
 		for i, projectConfigName := range existingProjectConfigNames {
-			if projectConfigName == "" {
+			if projectConfigName == "" || jsonProgress {
 				continue
 			}
 			logs_view.DisplayLogEntry(logs.LogEntry{
@@ -134,12 +205,14 @@ var CreateCmd = &cobra.Command{
 		}
 
 		target, err := workspace_util.GetTarget(workspace_util.GetTargetConfig{
-			Ctx:               ctx,
-			ApiClient:         apiClient,
-			TargetList:        targetList,
-			ActiveProfileName: activeProfile.Name,
-			TargetNameFlag:    targetNameFlag,
-			PromptUsingTUI:    promptUsingTUI,
+			Ctx:                  ctx,
+			ApiClient:            apiClient,
+			TargetList:           targetList,
+			ActiveProfileName:    activeProfile.Name,
+			TargetNameFlag:       targetNameFlag,
+			ProfileDefaultTarget: activeProfile.DefaultTarget,
+			PromptUsingTUI:       promptUsingTUI,
+			Placement:            placement,
 		})
 		if err != nil {
 			if common.IsCtrlCAbort(err) {
@@ -148,53 +221,170 @@ var CreateCmd = &cobra.Command{
 			return err
 		}
 
-		logs_view.CalculateLongestPrefixLength(projectNames)
+		// Labels of the local target are not checked against the server host
+		if target.Name == "local" && placement["gpu"] == "true" {
+			hostInfo, _, err := apiClient.ServerAPI.GetHostInfo(ctx).Execute()
+			if err == nil && hostInfo.Gpus == 0 {
+				log.Warn("The workspace requires a GPU but the Daytona Server host has no GPUs. Run 'daytona server host-info' for details")
+			}
+		}
 
-		logs_view.DisplayLogEntry(logs.LogEntry{
-			Msg: "Request submitted\n",
-		}, logs_view.STATIC_INDEX)
+		if (promptUsingTUI || reviewFlag) && !yesFlag && !views.IsPlainOutput() && !jsonProgress {
+			renderCreationEstimate(ctx, apiClient, workspaceName, target.Name, projects)
 
-		activeProfile, err = c.GetActiveProfile()
-		if err != nil {
-			return err
-		}
+			var profileEnvVars map[string]string
+			if profileData != nil {
+				profileEnvVars = profileData.EnvVars
+			}
 
-		var tsConn *tsnet.Server
-		if target.Name != "local" || activeProfile.Id != "default" {
-			tsConn, err = tailscale.GetConnection(&activeProfile)
+			err = reviewSettings(settingsReviewConfig{
+				Cmd:                    cmd,
+				Definition:             definition,
+				PromptUsingTUI:         promptUsingTUI,
+				WorkspaceName:          &workspaceName,
+				Target:                 target,
+				TargetList:             targetList,
+				Placement:              placement,
+				Labels:                 &labels,
+				ExistingWorkspaceNames: existingWorkspaceNames,
+				ProfileEnvVars:         profileEnvVars,
+				Config:                 c,
+				ActiveProfile:          activeProfile,
+			})
+			if err != nil {
+				if common.IsCtrlCAbort(err) {
+					return nil
+				}
+				return err
+			}
+
+			resources, err = workspace_util.GetResourceLimitsFromFlags(cpuFlag, memoryFlag, diskFlag)
 			if err != nil {
 				return err
 			}
+			for i := range projects {
+				projects[i].Resources = resources
+			}
 		}
 
 		id := stringid.GenerateRandomID()
 		id = stringid.TruncateID(id)
 
-		logsContext, stopLogs := context.WithCancel(context.Background())
-		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, id, projectNames, true, true, nil)
-
-		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(apiclient.CreateWorkspaceDTO{
+		createWorkspaceDto := apiclient.CreateWorkspaceDTO{
 			Id:       id,
 			Name:     workspaceName,
 			Target:   target.Name,
 			Projects: projects,
-		}).Execute()
+		}
+		if groupFlag != "" {
+			createWorkspaceDto.Group = &groupFlag
+		}
+		if len(labels) > 0 {
+			createWorkspaceDto.Labels = labels
+		}
+		if retryFlag > 0 {
+			retries := int32(retryFlag)
+			createWorkspaceDto.Retries = &retries
+		}
+		if keepPartialFlag {
+			createWorkspaceDto.KeepPartial = &keepPartialFlag
+		}
+		if len(placement) > 0 {
+			createWorkspaceDto.Placement = placement
+		}
+		if autoStopFlag > 0 {
+			autoStop := int32(autoStopFlag.Minutes())
+			createWorkspaceDto.AutoStop = &autoStop
+		}
+		if definition != nil {
+			createWorkspaceDto.Origin = workspace_util.GetWorkspaceOrigin(definition)
+		}
+
+		if apiclient_util.IsDryRun() {
+			fmt.Printf("[dry-run] Would add the SSH config entry for host %s\n", config.GetProjectHostname(activeProfile.Id, id, projects[0].Name))
+			_, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		}
+
+		logs_view.CalculateLongestPrefixLength(projectNames)
+
+		if !jsonProgress {
+			logs_view.DisplayLogEntry(logs.LogEntry{
+				Msg: "Request submitted\n",
+			}, logs_view.STATIC_INDEX)
+		}
+
+		activeProfile, err = c.GetActiveProfile()
 		if err != nil {
+			return err
+		}
+
+		var dial tailscale.DialFunc
+		if target.Name != "local" || activeProfile.Id != "default" {
+			dial, err = tailscale.GetProjectDialer(&activeProfile, id, projects[0].Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		logsContext, stopLogs := context.WithCancel(context.Background())
+
+		// The progress view replaces the log output unless the output is plain
+		var progressView create.Progress
+		switch {
+		case jsonProgress:
+			progressView = create.NewJsonProgressView(workspaceName, projectNames)
+			go apiclient_util.FollowWorkspaceLogs(logsContext, activeProfile, id, projectNames, nil, progressView.HandleLogEntry)
+		case views.IsPlainOutput():
+			go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, id, projectNames, true, true, nil)
+		default:
+			tuiProgressView := create.NewProgressView(projectNames)
+			tuiProgressView.Start()
+			progressView = tuiProgressView
+			go apiclient_util.FollowWorkspaceLogs(logsContext, activeProfile, id, projectNames, nil, progressView.HandleLogEntry)
+		}
+
+		stopProgress := func(err error) {
 			stopLogs()
-			return apiclient_util.HandleErrorResponse(res, err)
+			if progressView != nil {
+				progressView.Stop(err)
+			}
+		}
+
+		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
+		if err != nil {
+			err = apiclient_util.HandleErrorResponse(res, err)
+			stopProgress(err)
+			return err
 		}
 		gpgKey, err := GetGitProviderGpgKey(apiClient, ctx, projects[0].GitProviderConfigId)
 		if err != nil {
 			log.Warn(err)
 		}
 
-		err = waitForDial(createdWorkspace, &activeProfile, tsConn, gpgKey)
+		if progressView != nil {
+			progressView.SetStep(logs.StepSshReady, logs.StepStateStarted)
+		}
+
+		err = waitForDial(createdWorkspace, &activeProfile, dial, gpgKey, progressView)
 		if err != nil {
-			stopLogs()
+			stopProgress(err)
 			return err
 		}
 
-		stopLogs()
+		if progressView != nil {
+			progressView.SetStep(logs.StepSshReady, logs.StepStateDone)
+		}
+
+		stopProgress(nil)
+
+		// Wrapping tools open the workspace themselves
+		if jsonProgress {
+			return nil
+		}
 
 		// Make sure terminal cursor is reset
 		fmt.Print("\033[?25h")
@@ -204,7 +394,9 @@ var CreateCmd = &cobra.Command{
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
 
-		chosenIdeId := c.DefaultIdeId
+		workspace_util.PinHostKeys(activeProfile, wsInfo.Id, wsInfo.Projects)
+
+		chosenIdeId := c.GetDefaultIdeId(activeProfile)
 		if ideFlag != "" {
 			chosenIdeId = ideFlag
 		}
@@ -221,6 +413,13 @@ var CreateCmd = &cobra.Command{
 		fmt.Println()
 		info.Render(wsInfo, chosenIde.Name, false)
 
+		creationTimings, _, err := apiClient.WorkspaceAPI.ListCreationTimings(ctx).WorkspaceId(createdWorkspace.Id).Execute()
+		if err != nil {
+			log.Debugf("failed to get creation timings: %s", err)
+		} else {
+			report.RenderCreationBreakdown(creationTimings)
+		}
+
 		if noIdeFlag {
 			views.RenderCreationInfoMessage("Run 'daytona code' when you're ready to start developing")
 			return nil
@@ -239,10 +438,24 @@ var CreateCmd = &cobra.Command{
 }
 
 var nameFlag string
+var groupFlag string
 var targetNameFlag string
 var noIdeFlag bool
 var blankFlag bool
 var multiProjectFlag bool
+var retryFlag int
+var keepPartialFlag bool
+var labelFlags []string
+var cpuFlag string
+var memoryFlag string
+var diskFlag string
+var autoStopFlag time.Duration
+var placementFlag []string
+var fileFlag string
+var reviewFlag bool
+var progressFlag string
+
+const progressFlagDescription = "Print the progress as newline-delimited JSON events (workspace, step, project, state, percent, message, error) instead of the regular output. Must be json"
 
 var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	Builder:           new(views_util.BuildChoice),
@@ -264,12 +477,24 @@ func init() {
 	ideListStr := strings.Join(ids, ", ")
 
 	CreateCmd.Flags().StringVar(&nameFlag, "name", "", "Specify the workspace name")
+	CreateCmd.Flags().StringVarP(&fileFlag, "file", "f", "", "Create the workspace from a workspace definition file (e.g. daytona.yaml)")
 	CreateCmd.Flags().StringVarP(&ideFlag, "ide", "i", "", fmt.Sprintf("Specify the IDE (%s)", ideListStr))
 	CreateCmd.Flags().StringVarP(&targetNameFlag, "target", "t", "", "Specify the target (e.g. 'local')")
+	CreateCmd.Flags().StringVar(&groupFlag, "group", "", "Add the workspace to a group")
+	CreateCmd.Flags().StringArrayVarP(&labelFlags, "label", "l", []string{}, "Add a label to the workspace (e.g. --label team=payments --label env=dev)")
 	CreateCmd.Flags().BoolVar(&blankFlag, "blank", false, "Create a blank project without using existing configurations")
 	CreateCmd.Flags().BoolVarP(&noIdeFlag, "no-ide", "n", false, "Do not open the workspace in the IDE after workspace creation")
 	CreateCmd.Flags().BoolVar(&multiProjectFlag, "multi-project", false, "Workspace with multiple projects/repos")
 	CreateCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+	CreateCmd.Flags().BoolVar(&reviewFlag, "review", false, "Review where each setting comes from and override it before the workspace is created. Always shown when the workspace is created interactively")
+	CreateCmd.Flags().IntVar(&retryFlag, "retry", 0, "Number of times provisioning is retried if it fails")
+	CreateCmd.Flags().BoolVar(&keepPartialFlag, "keep-partial", false, "Keep the partially created workspace if provisioning fails (for debugging)")
+	CreateCmd.Flags().StringVar(&cpuFlag, "cpu", "", "Limit the number of CPUs of each project (e.g. 2 or 0.5)")
+	CreateCmd.Flags().StringVar(&memoryFlag, "memory", "", "Limit the memory of each project (e.g. 4g or 512m)")
+	CreateCmd.Flags().StringVar(&diskFlag, "disk", "", "Limit the disk size of each project (e.g. 20g). Requires a Docker storage driver that supports quotas")
+	CreateCmd.Flags().StringSliceVar(&placementFlag, "placement", []string{}, "Only create the workspace on a target with matching labels (e.g. --placement gpu=true,zone=eu)")
+	CreateCmd.Flags().DurationVar(&autoStopFlag, "auto-stop", 0, "Stop the workspace after a period without SSH activity (e.g. 30m or 2h)")
+	CreateCmd.Flags().StringVar(&progressFlag, "progress", "", progressFlagDescription+". The workspace is not opened in the IDE")
 	CreateCmd.Flags().StringSliceVar(projectConfigurationFlags.Branches, "branch", []string{}, "Specify the Git branches to use in the projects")
 
 	workspace_util.AddProjectConfigurationFlags(CreateCmd, projectConfigurationFlags, true)
@@ -277,7 +502,7 @@ func init() {
 
 func processPrompting(ctx context.Context, apiClient *apiclient.APIClient, workspaceName *string, projects *[]apiclient.CreateProjectDTO, workspaceNames []string) error {
 	if workspace_util.CheckAnyProjectConfigurationFlagSet(projectConfigurationFlags) || (projectConfigurationFlags.Branches != nil && len(*projectConfigurationFlags.Branches) > 0) {
-		return errors.New("please provide the repository URL in order to set up custom project details through the CLI")
+		return errors.New(i18n.T("please provide the repository URL in order to set up custom project details through the CLI"))
 	}
 
 	gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(ctx).Execute()
@@ -342,15 +567,15 @@ func processPrompting(ctx context.Context, apiClient *apiclient.APIClient, works
 
 func processCmdArguments(ctx context.Context, repoUrls []string, apiClient *apiclient.APIClient, projects *[]apiclient.CreateProjectDTO) ([]string, error) {
 	if len(repoUrls) == 0 {
-		return nil, fmt.Errorf("no repository URLs provided")
+		return nil, errors.New(i18n.T("no repository URLs provided"))
 	}
 
 	if len(repoUrls) > 1 && workspace_util.CheckAnyProjectConfigurationFlagSet(projectConfigurationFlags) {
-		return nil, fmt.Errorf("can't set custom project configuration properties for multiple projects")
+		return nil, errors.New(i18n.T("can't set custom project configuration properties for multiple projects"))
 	}
 
 	if *projectConfigurationFlags.Builder != "" && *projectConfigurationFlags.Builder != views_util.DEVCONTAINER && *projectConfigurationFlags.DevcontainerPath != "" {
-		return nil, fmt.Errorf("can't set devcontainer file path if builder is not set to %s", views_util.DEVCONTAINER)
+		return nil, errors.New(i18n.T("can't set devcontainer file path if builder is not set to %s", views_util.DEVCONTAINER))
 	}
 
 	var projectConfig *apiclient.ProjectConfig
@@ -382,7 +607,7 @@ func processCmdArguments(ctx context.Context, repoUrls []string, apiClient *apic
 		// The argument is not a Git URL - try getting the project config
 		projectConfig, _, err = apiClient.ProjectConfigAPI.GetProjectConfig(ctx, repoUrl).Execute()
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse the URL or fetch the project config for '%s'", repoUrl)
+			return nil, errors.New(i18n.T("failed to parse the URL or fetch the project config for '%s'", repoUrl))
 		}
 
 		existingProjectConfigName, err := workspace_util.AddProjectFromConfig(projectConfig, apiClient, projects, branch)
@@ -469,7 +694,9 @@ func processGitURL(ctx context.Context, repoUrl string, apiClient *apiclient.API
 	return nil, nil
 }
 
-func waitForDial(workspace *apiclient.Workspace, activeProfile *config.Profile, tsConn *tsnet.Server, gpgKey string) error {
+// waitForDial waits until the first project accepts SSH connections. Slow connections are reported in the
+// progress view if one is running since it can't be rendered together with a spinner
+func waitForDial(workspace *apiclient.Workspace, activeProfile *config.Profile, dial tailscale.DialFunc, gpgKey string, progressView create.Progress) error {
 	if workspace.Target == "local" && (activeProfile != nil && activeProfile.Id == "default") {
 		err := config.EnsureSshConfigEntryAdded(activeProfile.Id, workspace.Id, workspace.Projects[0].Name, gpgKey)
 		if err != nil {
@@ -499,7 +726,7 @@ func waitForDial(workspace *apiclient.Workspace, activeProfile *config.Profile,
 
 	go func() {
 		for {
-			dialConn, err := tsConn.Dial(context.Background(), "tcp", fmt.Sprintf("%s:%d", project.GetProjectHostname(workspace.Id, workspace.Projects[0].Name), ssh_config.SSH_PORT))
+			dialConn, err := dial(context.Background(), ssh_config.SSH_PORT)
 			if err == nil {
 				connectChan <- dialConn.Close()
 				return
@@ -512,16 +739,44 @@ func waitForDial(workspace *apiclient.Workspace, activeProfile *config.Profile,
 	case err := <-connectChan:
 		return err
 	case <-spinner:
-		err := views_util.WithInlineSpinner("Connection to tailscale is taking longer than usual", func() error {
+		waitForConnection := func() error {
 			select {
 			case err := <-connectChan:
 				return err
 			case <-timeout:
-				return errors.New("secure connection to the Daytona Server could not be established. Please check your internet connection or Tailscale availability")
+				return errors.New(i18n.T("secure connection to the Daytona Server could not be established. Please check your internet connection or Tailscale availability"))
 			}
-		})
-		return err
+		}
+
+		if progressView != nil {
+			progressView.HandleLogEntry(logs.LogEntry{Msg: i18n.T("Connection to tailscale is taking longer than usual")})
+			return waitForConnection()
+		}
+
+		return views_util.WithInlineSpinner(i18n.T("Connection to tailscale is taking longer than usual"), waitForConnection)
+	}
+}
+
+// applyWorkspaceDefinition uses the workspace settings of the definition for the flags that were not set
+func applyWorkspaceDefinition(cmd *cobra.Command, definition *config.WorkspaceDefinition) error {
+	if !cmd.Flags().Changed("name") {
+		nameFlag = definition.Name
+	}
+	if !cmd.Flags().Changed("target") {
+		targetNameFlag = definition.Target
+	}
+	if !cmd.Flags().Changed("group") {
+		groupFlag = definition.Group
+	}
+	if !cmd.Flags().Changed("auto-stop") {
+		autoStop, err := definition.GetAutoStop()
+		if err != nil {
+			return err
+		}
+		autoStopFlag = autoStop
 	}
+
+	return nil
 }
 
 func dedupProjectNames(projects *[]apiclient.CreateProjectDTO) {
@@ -566,3 +821,15 @@ func GetGitProviderGpgKey(apiClient *apiclient.APIClient, ctx context.Context, p
 
 	return gpgKey, nil
 }
+
+// isJsonProgress returns true if the progress should be printed as JSON events
+func isJsonProgress() (bool, error) {
+	switch progressFlag {
+	case "":
+		return false, nil
+	case "json":
+		return true, nil
+	}
+
+	return false, fmt.Errorf("invalid progress format %s, must be json", progressFlag)
+}
@@ -0,0 +1,293 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	target_view "github.com/daytonaio/daytona/pkg/views/target"
+	"github.com/daytonaio/daytona/pkg/views/workspace/create"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type settingsReviewConfig struct {
+	Cmd                    *cobra.Command
+	Definition             *config.WorkspaceDefinition
+	PromptUsingTUI         bool
+	WorkspaceName          *string
+	Target                 *target_view.TargetView
+	TargetList             []apiclient.ProviderTarget
+	Placement              map[string]string
+	Labels                 *map[string]string
+	ExistingWorkspaceNames []string
+	ProfileEnvVars         map[string]string
+	Config                 *config.Config
+	ActiveProfile          config.Profile
+}
+
+// reviewSettings shows the workspace settings with the source of each value, e.g. a flag, the definition
+// file, a profile default or the wizard, and applies the values the user overrides
+func reviewSettings(reviewConfig settingsReviewConfig) error {
+	cmd := reviewConfig.Cmd
+	definition := reviewConfig.Definition
+
+	getSource := func(flagName string, fromDefinition bool, value string) create.SettingSource {
+		if cmd.Flags().Changed(flagName) {
+			return create.SettingSourceFlag
+		}
+		if fromDefinition && value != "" {
+			return create.SettingSourceFile
+		}
+		return create.SettingSourceNone
+	}
+
+	nameSource := getSource("name", definition != nil && definition.Name != "", *reviewConfig.WorkspaceName)
+	if nameSource == create.SettingSourceNone {
+		nameSource = create.SettingSourceSuggested
+		if reviewConfig.PromptUsingTUI {
+			nameSource = create.SettingSourceWizard
+		}
+	}
+
+	targetName := reviewConfig.Target.Name
+	targetSource := getSource("target", definition != nil && definition.Target != "", targetName)
+	if targetSource == create.SettingSourceNone {
+		if len(reviewConfig.Placement) > 0 {
+			targetSource = create.SettingSourcePlacement
+		} else if reviewConfig.ActiveProfile.DefaultTarget != "" {
+			targetSource = create.SettingSourceProfile
+		} else if reviewConfig.PromptUsingTUI {
+			targetSource = create.SettingSourceWizard
+		} else {
+			targetSource = create.SettingSourceDefaultTarget
+		}
+	}
+
+	ideId := ideFlag
+	ideSource := create.SettingSourceFlag
+	if ideId == "" {
+		ideId = reviewConfig.Config.GetDefaultIdeId(reviewConfig.ActiveProfile)
+		ideSource = create.SettingSourceConfig
+		if os.Getenv(config.DEFAULT_IDE_ENV_VAR) != "" {
+			ideSource = create.SettingSourceEnv
+		} else if reviewConfig.ActiveProfile.DefaultIdeId != "" {
+			ideSource = create.SettingSourceProfile
+		}
+	}
+
+	labelsValue := formatLabels(*reviewConfig.Labels)
+	labelsSource := getSource("label", false, labelsValue)
+	if definition != nil && len(definition.Labels) > 0 {
+		if labelsSource == create.SettingSourceFlag {
+			labelsSource = create.SettingSourceFlag + ", " + create.SettingSourceFile
+		} else {
+			labelsSource = create.SettingSourceFile
+		}
+	}
+
+	autoStopValue := ""
+	if autoStopFlag > 0 {
+		autoStopValue = autoStopFlag.String()
+	}
+
+	targetNames := []string{}
+	for _, t := range reviewConfig.TargetList {
+		if workspace.MatchLabels(t.Labels, reviewConfig.Placement) {
+			targetNames = append(targetNames, t.Name)
+		}
+	}
+
+	ideIds := []string{}
+	for _, ide := range config.GetIdeList() {
+		ideIds = append(ideIds, ide.Id)
+	}
+
+	settings := []create.Setting{
+		{
+			Label:  "Name",
+			Value:  reviewConfig.WorkspaceName,
+			Source: nameSource,
+			Validate: func(value string) error {
+				name, err := util.GetValidatedName(value)
+				if err != nil {
+					return err
+				}
+				if slices.Contains(reviewConfig.ExistingWorkspaceNames, name) {
+					return errors.New("name already exists")
+				}
+				return nil
+			},
+		},
+		{
+			Label:   "Target",
+			Value:   &targetName,
+			Source:  targetSource,
+			Options: targetNames,
+		},
+		{
+			Label:   "IDE",
+			Value:   &ideId,
+			Source:  ideSource,
+			Options: ideIds,
+		},
+		{
+			Label:  "Group",
+			Value:  &groupFlag,
+			Source: getSource("group", definition != nil, groupFlag),
+		},
+		{
+			Label:  "Labels",
+			Value:  &labelsValue,
+			Source: labelsSource,
+			Validate: func(value string) error {
+				_, err := parseLabelsValue(value)
+				return err
+			},
+		},
+		{
+			Label:  "Auto-stop",
+			Value:  &autoStopValue,
+			Source: getSource("auto-stop", definition != nil, autoStopValue),
+			Validate: func(value string) error {
+				_, err := parseAutoStop(value)
+				return err
+			},
+		},
+		{
+			Label:  "CPU",
+			Value:  &cpuFlag,
+			Source: getSource("cpu", false, cpuFlag),
+			Validate: func(value string) error {
+				_, err := workspace_util.GetResourceLimitsFromFlags(value, "", "")
+				return err
+			},
+		},
+		{
+			Label:  "Memory",
+			Value:  &memoryFlag,
+			Source: getSource("memory", false, memoryFlag),
+			Validate: func(value string) error {
+				_, err := workspace_util.GetResourceLimitsFromFlags("", value, "")
+				return err
+			},
+		},
+		{
+			Label:  "Disk",
+			Value:  &diskFlag,
+			Source: getSource("disk", false, diskFlag),
+			Validate: func(value string) error {
+				_, err := workspace_util.GetResourceLimitsFromFlags("", "", value)
+				return err
+			},
+		},
+	}
+
+	if len(reviewConfig.ProfileEnvVars) > 0 {
+		envVarNames := strings.Join(slices.Sorted(maps.Keys(reviewConfig.ProfileEnvVars)), ", ")
+		settings = append(settings, create.Setting{
+			Label:    "Env vars",
+			Value:    &envVarNames,
+			Source:   create.SettingSourceProfile,
+			ReadOnly: true,
+		})
+	}
+
+	err := create.RunSettingsReview(settings)
+	if err != nil {
+		return err
+	}
+
+	*reviewConfig.WorkspaceName, err = util.GetValidatedName(*reviewConfig.WorkspaceName)
+	if err != nil {
+		return err
+	}
+
+	if targetName != reviewConfig.Target.Name {
+		for _, t := range reviewConfig.TargetList {
+			if t.Name == targetName {
+				*reviewConfig.Target = target_view.GetTargetViewFromTarget(t)
+			}
+		}
+	}
+
+	if ideSource == create.SettingSourceFlag || ideId != reviewConfig.Config.GetDefaultIdeId(reviewConfig.ActiveProfile) {
+		ideFlag = ideId
+	}
+
+	*reviewConfig.Labels, err = parseLabelsValue(labelsValue)
+	if err != nil {
+		return err
+	}
+
+	autoStopFlag, err = parseAutoStop(autoStopValue)
+	return err
+}
+
+// renderCreationEstimate shows how long the creation is expected to take so that the user can decide whether to
+// wait or pick a prebuilt configuration. The estimate is skipped if the server does not support it
+func renderCreationEstimate(ctx context.Context, apiClient *apiclient.APIClient, workspaceName, targetName string, projects []apiclient.CreateProjectDTO) {
+	estimate, _, err := apiClient.WorkspaceAPI.EstimateWorkspaceCreation(ctx).Workspace(apiclient.CreateWorkspaceDTO{
+		Name:     workspaceName,
+		Target:   targetName,
+		Projects: projects,
+	}).Execute()
+	if err != nil {
+		log.Debugf("Failed to estimate the workspace creation time: %s", err)
+		return
+	}
+
+	create.RenderCreationEstimate(*estimate, targetName)
+}
+
+// formatLabels returns the labels in the key=value format, sorted by key and separated by commas
+func formatLabels(labels map[string]string) string {
+	result := []string{}
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		result = append(result, fmt.Sprintf("%s=%s", key, labels[key]))
+	}
+	return strings.Join(result, ", ")
+}
+
+func parseLabelsValue(value string) (map[string]string, error) {
+	labels := []string{}
+	for _, label := range strings.Split(value, ",") {
+		label = strings.TrimSpace(label)
+		if label != "" {
+			labels = append(labels, label)
+		}
+	}
+
+	return workspace.ParseLabels(labels)
+}
+
+func parseAutoStop(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	autoStop, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if autoStop < time.Minute {
+		return 0, errors.New("auto-stop timeout must be at least one minute")
+	}
+
+	return autoStop, nil
+}
@@ -5,23 +5,29 @@ package workspace
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/charmbracelet/huh"
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/daytonaio/daytona/pkg/views/workspace/teardown"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 var yesFlag bool
 var forceFlag bool
+var deleteProjectFlag string
 
 var DeleteCmd = &cobra.Command{
 	Use:     "delete [WORKSPACE]",
@@ -29,24 +35,39 @@ var DeleteCmd = &cobra.Command{
 	GroupID: util.WORKSPACE_GROUP,
 	Aliases: []string{"remove", "rm"},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		// Nothing is deleted in dry-run mode so there is nothing to confirm
+		if apiclient_util.IsDryRun() {
+			yesFlag = true
+		}
+
 		if allFlag {
 			if yesFlag {
-				fmt.Println("Deleting all workspaces.")
+				fmt.Println(i18n.T("Deleting all workspaces."))
 				err := DeleteAllWorkspaces(forceFlag)
 				if err != nil {
 					return err
 				}
 			} else {
+				description := i18n.T("Are you sure you want to delete all workspaces?")
+
+				changesSummary, err := getAllWorkspacesChangesSummary()
+				if err != nil {
+					return err
+				}
+				if changesSummary != "" {
+					description += "\n\n" + changesSummary
+				}
+
 				form := huh.NewForm(
 					huh.NewGroup(
 						huh.NewConfirm().
-							Title("Delete all workspaces?").
-							Description("Are you sure you want to delete all workspaces?").
+							Title(i18n.T("Delete all workspaces?")).
+							Description(description).
 							Value(&yesFlag),
 					),
 				).WithTheme(views.GetCustomTheme())
 
-				err := form.Run()
+				err = form.Run()
 				if err != nil {
 					return err
 				}
@@ -57,7 +78,7 @@ var DeleteCmd = &cobra.Command{
 						return err
 					}
 				} else {
-					fmt.Println("Operation canceled.")
+					fmt.Println(i18n.T("Operation canceled."))
 				}
 			}
 			return nil
@@ -65,6 +86,13 @@ var DeleteCmd = &cobra.Command{
 
 		ctx := context.Background()
 
+		if deleteProjectFlag != "" {
+			if len(args) != 1 {
+				return cmd.Help()
+			}
+			return deleteProject(ctx, args[0], deleteProjectFlag)
+		}
+
 		var workspaceDeleteList = []*apiclient.WorkspaceDTO{}
 		var workspaceDeleteListNames = []string{}
 		apiClient, err := apiclient_util.GetApiClient(nil)
@@ -104,30 +132,43 @@ var DeleteCmd = &cobra.Command{
 		}
 
 		if !yesFlag {
+			description := i18n.T("Are you sure you want to delete the workspace(s): [%s]?", strings.Join(workspaceDeleteListNames, ", "))
+
+			changesSummary, err := getChangesSummary(ctx, apiClient, workspaceDeleteList, "")
+			if err != nil {
+				return err
+			}
+			if changesSummary != "" {
+				description += "\n\n" + changesSummary
+			}
+
 			form := huh.NewForm(
 				huh.NewGroup(
 					huh.NewConfirm().
-						Title(fmt.Sprintf("Delete workspace(s): [%s]?", strings.Join(workspaceDeleteListNames, ", "))).
-						Description(fmt.Sprintf("Are you sure you want to delete the workspace(s): [%s]?", strings.Join(workspaceDeleteListNames, ", "))).
+						Title(i18n.T("Delete workspace(s): [%s]?", strings.Join(workspaceDeleteListNames, ", "))).
+						Description(description).
 						Value(&yesFlag),
 				),
 			).WithTheme(views.GetCustomTheme())
 
-			err := form.Run()
+			err = form.Run()
 			if err != nil {
 				return err
 			}
 		}
 
 		if !yesFlag {
-			fmt.Println("Operation canceled.")
+			fmt.Println(i18n.T("Operation canceled."))
 		} else {
 			for _, workspace := range workspaceDeleteList {
 				err := RemoveWorkspace(ctx, apiClient, workspace, forceFlag)
 				if err != nil {
 					log.Error(fmt.Sprintf("[ %s ] : %v", workspace.Name, err))
+					continue
+				}
+				if !apiclient_util.IsDryRun() {
+					views.RenderInfoMessage(i18n.T("Workspace '%s' successfully deleted", workspace.Name))
 				}
-				views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' successfully deleted", workspace.Name))
 			}
 		}
 		return nil
@@ -141,6 +182,102 @@ func init() {
 	DeleteCmd.Flags().BoolVarP(&allFlag, "all", "a", false, "Delete all workspaces")
 	DeleteCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Confirm deletion without prompt")
 	DeleteCmd.Flags().BoolVarP(&forceFlag, "force", "f", false, "Delete a workspace by force")
+	DeleteCmd.Flags().StringVarP(&deleteProjectFlag, "project", "p", "", "Delete a single project from the workspace (project name)")
+	DeleteCmd.Flags().BoolVar(&ignoreDirtyFlag, "ignore-dirty", false, ignoreDirtyFlagDescription)
+}
+
+func deleteProject(ctx context.Context, workspaceId, projectName string) error {
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := apiclient_util.GetWorkspace(workspaceId, false)
+	if err != nil {
+		return err
+	}
+
+	if !yesFlag {
+		changesSummary, err := getChangesSummary(ctx, apiClient, []*apiclient.WorkspaceDTO{workspace}, projectName)
+		if err != nil {
+			return err
+		}
+
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewConfirm().
+					Title(i18n.T("Delete project '%s' from workspace '%s'?", projectName, workspace.Name)).
+					Description(changesSummary).
+					Value(&yesFlag),
+			),
+		).WithTheme(views.GetCustomTheme())
+
+		err = form.Run()
+		if err != nil {
+			return err
+		}
+	}
+
+	if !yesFlag {
+		fmt.Println(i18n.T("Operation canceled."))
+		return nil
+	}
+
+	if apiclient_util.IsDryRun() {
+		return dryRunRemoveSshEntries(workspace, []string{projectName}, func() error {
+			res, err := apiClient.WorkspaceAPI.RemoveProject(ctx, workspace.Id, projectName).Force(forceFlag).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+	}
+
+	err = views_util.WithInlineSpinner(i18n.T("Deleting project %s", projectName), func() error {
+		res, err := apiClient.WorkspaceAPI.RemoveProject(ctx, workspace.Id, projectName).Force(forceFlag).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		return config.RemoveWorkspaceSshEntries(activeProfile.Id, workspace.Id, projectName)
+	})
+	if err != nil {
+		return err
+	}
+
+	views.RenderInfoMessage(i18n.T("Project '%s' successfully deleted from workspace '%s'", projectName, workspace.Name))
+	return nil
+}
+
+func getAllWorkspacesChangesSummary() (string, error) {
+	if ignoreDirtyFlag {
+		return "", nil
+	}
+
+	ctx := context.Background()
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return "", err
+	}
+
+	workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return getChangesSummary(ctx, apiClient, util.ArrayMap(workspaceList, func(w apiclient.WorkspaceDTO) *apiclient.WorkspaceDTO {
+		return &w
+	}), "")
 }
 
 func DeleteAllWorkspaces(force bool) error {
@@ -161,40 +298,118 @@ func DeleteAllWorkspaces(force bool) error {
 			log.Errorf("Failed to delete workspace %s: %v", workspace.Name, err)
 			continue
 		}
-		views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' successfully deleted", workspace.Name))
+		if !apiclient_util.IsDryRun() {
+			views.RenderInfoMessage(i18n.T("- Workspace '%s' successfully deleted", workspace.Name))
+		}
 	}
 	return nil
 }
 
 func RemoveWorkspace(ctx context.Context, apiClient *apiclient.APIClient, workspace *apiclient.WorkspaceDTO, force bool) error {
-	message := fmt.Sprintf("Deleting workspace %s", workspace.Name)
-	err := views_util.WithInlineSpinner(message, func() error {
-		res, err := apiClient.WorkspaceAPI.RemoveWorkspace(ctx, workspace.Id).Force(force).Execute()
-		if err != nil {
-			return apiclient_util.HandleErrorResponse(res, err)
-		}
-		c, err := config.GetConfig()
+	if apiclient_util.IsDryRun() {
+		projectNames := util.ArrayMap(workspace.Projects, func(p apiclient.Project) string {
+			return p.Name
+		})
+		return dryRunRemoveSshEntries(workspace, projectNames, func() error {
+			res, err := apiClient.WorkspaceAPI.RemoveWorkspace(ctx, workspace.Id).Force(force).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+	}
+
+	err := streamWorkspaceRemoval(ctx, workspace, force)
+	if errors.Is(err, errTeardownStreamNotSupported) {
+		err = views_util.WithInlineSpinner(i18n.T("Deleting workspace %s", workspace.Name), func() error {
+			res, err := apiClient.WorkspaceAPI.RemoveWorkspace(ctx, workspace.Id).Force(force).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	c, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range workspace.Projects {
+		err = config.RemoveWorkspaceSshEntries(activeProfile.Id, workspace.Id, project.Name)
 		if err != nil {
 			return err
 		}
+	}
 
-		activeProfile, err := c.GetActiveProfile()
+	return nil
+}
+
+// Servers older than the teardown stream only support removing workspaces with a single request
+var errTeardownStreamNotSupported = errors.New("the server does not support streaming the workspace removal")
+
+// streamWorkspaceRemoval removes the workspace and prints the teardown steps as the server reports them
+func streamWorkspaceRemoval(ctx context.Context, ws *apiclient.WorkspaceDTO, force bool) error {
+	query := fmt.Sprintf("force=%t", force)
+	conn, res, err := apiclient_util.GetWebsocketConn(ctx, fmt.Sprintf("/workspace/%s/remove/stream", ws.Id), nil, &query)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return errTeardownStreamNotSupported
+		}
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+	defer conn.Close()
+
+	fmt.Println(i18n.T("Deleting workspace %s", ws.Name))
+
+	for {
+		var progress workspace.TeardownProgress
+		err := conn.ReadJSON(&progress)
 		if err != nil {
-			return err
+			return fmt.Errorf("lost the connection to the server while deleting the workspace: %w", err)
 		}
 
-		for _, project := range workspace.Projects {
-			err = config.RemoveWorkspaceSshEntries(activeProfile.Id, workspace.Id, project.Name)
-			if err != nil {
-				return err
-			}
+		if progress.Step != workspace.TeardownStepDone {
+			teardown.RenderProgress(progress)
+			continue
+		}
+
+		if progress.Status == workspace.TeardownStatusFailed {
+			return errors.New(progress.Error)
 		}
 		return nil
-	})
+	}
+}
 
+// dryRunRemoveSshEntries prints the request of the remove function and the SSH config entries of the projects
+// that would be removed with it
+func dryRunRemoveSshEntries(workspace *apiclient.WorkspaceDTO, projectNames []string, remove func() error) error {
+	err := remove()
 	if err != nil {
 		return err
 	}
 
+	c, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return err
+	}
+
+	for _, projectName := range projectNames {
+		fmt.Printf("[dry-run] Would remove the SSH config entries for host %s\n", config.GetProjectHostname(activeProfile.Id, workspace.Id, projectName))
+	}
+
 	return nil
 }
@@ -0,0 +1,108 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	events_view "github.com/daytonaio/daytona/pkg/views/workspace/events"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var followEventsFlag bool
+
+const (
+	eventsPollInterval    = 2 * time.Second
+	maxEventsPollInterval = 30 * time.Second
+)
+
+var EventsCmd = &cobra.Command{
+	Use:     "events [WORKSPACE]",
+	Short:   "Show workspace lifecycle events",
+	Long:    "Show when workspaces and their projects were created, started, stopped, connected to over SSH and removed. Events of removed workspaces are kept and can be shown by workspace name",
+	Args:    cobra.MaximumNArgs(1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		if followEventsFlag && format.FormatFlag != "" {
+			return errors.New("--follow can not be used with --format")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		listEvents := func(since string) ([]apiclient.WorkspaceEvent, error) {
+			req := apiClient.WorkspaceAPI.ListEvents(ctx)
+			if len(args) == 1 {
+				req = req.Workspace(args[0])
+			}
+			if since != "" {
+				req = req.Since(since)
+			}
+
+			eventList, res, err := req.Execute()
+			if err != nil {
+				return nil, apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			return eventList, nil
+		}
+
+		eventList, err := listEvents("")
+		if err != nil {
+			return err
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(eventList)
+			formattedData.Print()
+			return nil
+		}
+
+		if !followEventsFlag {
+			events_view.ListEvents(eventList)
+			return nil
+		}
+
+		since := ""
+		for _, e := range eventList {
+			events_view.RenderEvent(e)
+			since = e.CreatedAt
+		}
+
+		// Keep following through connection failures, backing off until the server is reachable again
+		failures := 0
+		for {
+			time.Sleep(util.GetJitteredBackoff(eventsPollInterval, maxEventsPollInterval, failures))
+
+			eventList, err := listEvents(since)
+			if err != nil {
+				log.Debug(err)
+				failures++
+				continue
+			}
+			failures = 0
+
+			for _, e := range eventList {
+				events_view.RenderEvent(e)
+				since = e.CreatedAt
+			}
+		}
+	},
+}
+
+func init() {
+	EventsCmd.Flags().BoolVar(&followEventsFlag, "follow", false, "Keep printing new events as they are recorded")
+	format.RegisterFormatFlag(EventsCmd)
+}
@@ -5,11 +5,19 @@ package workspace
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/atotto/clipboard"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/cmd/ports"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
 	"github.com/daytonaio/daytona/pkg/views/workspace/info"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
@@ -63,6 +71,10 @@ var InfoCmd = &cobra.Command{
 			return nil
 		}
 
+		if copyFlag != "" {
+			return copyConnectionDetails(workspace)
+		}
+
 		if format.FormatFlag != "" {
 			formattedData := format.NewFormatter(workspace)
 			formattedData.Print()
@@ -77,6 +89,80 @@ var InfoCmd = &cobra.Command{
 	},
 }
 
+var copyFlag string
+var copyPortFlag uint16
+
 func init() {
 	format.RegisterFormatFlag(InfoCmd)
+	InfoCmd.Flags().StringVar(&copyFlag, "copy", "", "Copy connection details of the first project to the clipboard (ssh, url or port)")
+	InfoCmd.Flags().Uint16Var(&copyPortFlag, "port", 0, "Project port used with --copy url and --copy port")
+}
+
+// copyConnectionDetails puts a connection string for the first project of the workspace on the clipboard:
+// the SSH command, the public URL of a port or the command that forwards a port to the local machine
+func copyConnectionDetails(workspace *apiclient.WorkspaceDTO) error {
+	if len(workspace.Projects) == 0 {
+		return errors.New(i18n.T("no projects found in workspace"))
+	}
+	projectName := workspace.Projects[0].Name
+
+	var connectionDetails string
+
+	switch copyFlag {
+	case "ssh":
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		workspace_util.PinHostKeys(activeProfile, workspace.Id, workspace.Projects)
+
+		err = config.EnsureSshConfigEntryAdded(activeProfile.Id, workspace.Id, projectName, "")
+		if err != nil {
+			return err
+		}
+
+		connectionDetails = fmt.Sprintf("ssh %s", config.GetProjectHostname(activeProfile.Id, workspace.Id, projectName))
+	case "url":
+		if copyPortFlag == 0 {
+			return errors.New(i18n.T("--port is required with --copy %s", copyFlag))
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		serverConfig, res, err := apiClient.ServerAPI.GetConfig(context.Background()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		connectionDetails, err = ports.GetPublicPortUrl(serverConfig, workspace.Id, projectName, copyPortFlag)
+		if err != nil {
+			return err
+		}
+	case "port":
+		if copyPortFlag == 0 {
+			return errors.New(i18n.T("--port is required with --copy %s", copyFlag))
+		}
+
+		connectionDetails = fmt.Sprintf("daytona forward %d %s %s", copyPortFlag, workspace.Name, projectName)
+	default:
+		return errors.New(i18n.T("invalid value for --copy: %s. Must be one of (ssh, url, port)", copyFlag))
+	}
+
+	fmt.Println(connectionDetails)
+
+	if err := clipboard.WriteAll(connectionDetails); err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("could not copy to the clipboard"), err)
+	}
+
+	views.RenderInfoMessage(i18n.T("Copied to the clipboard."))
+	return nil
 }
@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/runtimes"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+var installProjectFlag string
+
+var InstallCmd = &cobra.Command{
+	Use:   "install WORKSPACE RUNTIME...",
+	Short: "Install language runtimes in a project",
+	Long: `Install language runtimes and tools in a project in the tool@version format, e.g. node@20 or go@1.22. The version defaults to latest.
+Runtimes are installed by the agent with mise and added to the PATH of new shells. Installed runtimes are recorded in the project and installed again when the project is recreated.`,
+	Example: `  daytona install my-workspace node@20 go@1.22
+  daytona install my-workspace python@3.12 -p api`,
+	Args:    cobra.MinimumNArgs(2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		installed, err := runtimes.ParseAll(args[1:])
+		if err != nil {
+			return err
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		projectName, err := apiclient_util.GetFirstWorkspaceProjectName(workspace.Id, installProjectFlag, &activeProfile)
+		if err != nil {
+			return err
+		}
+
+		if !workspace_util.IsProjectRunning(workspace, projectName) {
+			started, err := AutoStartWorkspace(workspace.Name, projectName)
+			if err != nil {
+				return err
+			}
+			if !started {
+				return nil
+			}
+		}
+
+		err = views_util.WithInlineSpinner(fmt.Sprintf("Installing %s", strings.Join(installed, ", ")), func() error {
+			_, res, err := apiClient.WorkspaceToolboxAPI.RuntimesInstall(ctx, workspace.Id, projectName).Params(apiclient.InstallRuntimesRequest{
+				Runtimes: installed,
+			}).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		_, res, err := apiClient.WorkspaceAPI.AddProjectRuntimes(ctx, workspace.Id, projectName).Runtimes(apiclient.AddProjectRuntimes{
+			Runtimes: installed,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if apiclient_util.IsDryRun() {
+			return nil
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Installed %s in project %s", strings.Join(installed, ", "), projectName))
+		views.RenderTip("Open a new shell in the project to use the installed runtimes.")
+
+		return nil
+	},
+}
+
+func init() {
+	InstallCmd.Flags().StringVarP(&installProjectFlag, "project", "p", "", "Project to install the runtimes in a multi-project workspace")
+	InstallCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var inviteTtlFlag time.Duration
+
+var InviteCmd = &cobra.Command{
+	Use:   "invite WORKSPACE",
+	Short: "Invite a teammate to connect to a workspace",
+	Long: `Create a one-time code that a teammate can redeem with 'daytona join' to connect to the workspace over SSH or an IDE.
+The code can only be redeemed once and the access it grants is read-only and expires with the invite.`,
+	Example: `  daytona invite my-workspace
+  daytona invite my-workspace --ttl 30m`,
+	Args:    cobra.ExactArgs(1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		if inviteTtlFlag < time.Minute {
+			return errors.New("the invite TTL must be at least one minute")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		ws, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if serverConfig.Frps == nil {
+			return errors.New("frps config is missing")
+		}
+
+		inv, res, err := apiClient.InviteAPI.CreateInvite(ctx, ws.Id).Invite(apiclient.CreateInviteDTO{
+			Ttl: int32(inviteTtlFlag.Minutes()),
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if apiclient_util.IsDryRun() {
+			return nil
+		}
+
+		joinCode, err := encodeJoinCode(util.GetFrpcApiUrl(serverConfig.Frps.Protocol, serverConfig.Id, serverConfig.Frps.Domain), inv.Code)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Invite to workspace %s created. It can be redeemed once until %s", ws.Name, formatInviteExpiry(inv.ExpiresAt)))
+		views.RenderTip("Share the following command with your teammate:")
+		fmt.Printf("daytona join %s\n\n", joinCode)
+
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	InviteCmd.Flags().DurationVar(&inviteTtlFlag, "ttl", time.Hour, "Time the invite can be redeemed and the granted access is valid (e.g. 30m, 8h)")
+}
+
+// joinCode holds what a teammate needs to redeem an invite. It is shared URL-safe base64 encoded
+// so that it can be copied without quoting
+type joinCode struct {
+	ApiUrl string `json:"u"`
+	Code   string `json:"c"`
+}
+
+func encodeJoinCode(apiUrl, code string) (string, error) {
+	content, err := json.Marshal(joinCode{ApiUrl: apiUrl, Code: code})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(content), nil
+}
+
+func decodeJoinCode(value string) (*joinCode, error) {
+	content, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("invalid invite code")
+	}
+
+	var code joinCode
+	err = json.Unmarshal(content, &code)
+	if err != nil || code.ApiUrl == "" || code.Code == "" {
+		return nil, errors.New("invalid invite code")
+	}
+
+	return &code, nil
+}
+
+func formatInviteExpiry(expiresAt string) string {
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return expiresAt
+	}
+
+	return t.Local().Format("Jan 2 15:04")
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var JoinCmd = &cobra.Command{
+	Use:   "join CODE",
+	Short: "Join a workspace with an invite code",
+	Long: `Redeem an invite code created with 'daytona invite' to connect to a teammate's workspace.
+A restricted profile with temporary read-only access to the workspace is added and activated. Switch back with 'daytona use'.`,
+	Args:    cobra.ExactArgs(1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		code, err := decodeJoinCode(args[0])
+		if err != nil {
+			return err
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		// The invite code is the only credential until it is redeemed
+		apiClient, err := apiclient_util.NewApiClient(config.Profile{
+			Api: config.ServerApi{
+				Url: code.ApiUrl,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		access, res, err := apiClient.InviteAPI.RedeemInvite(context.Background()).Invite(apiclient.RedeemInviteDTO{
+			Code: code.Code,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if apiclient_util.IsDryRun() {
+			return nil
+		}
+
+		profileName := fmt.Sprintf("%s-invite", access.WorkspaceName)
+		profile := config.Profile{
+			Id:   util.GenerateIdFromName(profileName),
+			Name: profileName,
+			Api: config.ServerApi{
+				Url: code.ApiUrl,
+				Key: access.ApiKey,
+			},
+			Restricted: true,
+		}
+
+		// Joining the same workspace again replaces the previous access
+		if _, err := c.GetProfile(profile.Id); err == nil {
+			c.ActiveProfileId = profile.Id
+			err = c.EditProfile(profile)
+		} else {
+			err = c.AddProfile(profile)
+		}
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Joined workspace %s until %s. Profile %s is now active", access.WorkspaceName, formatInviteExpiry(access.ExpiresAt), profileName))
+		views.RenderTip(fmt.Sprintf("Connect with 'daytona ssh %s' or 'daytona code %s'", access.WorkspaceName, access.WorkspaceName))
+
+		return nil
+	},
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const selectorFlagDescription = "Select the workspaces to update, e.g. 'repo~payments-*,env!=prod'. The name, group, target and repo keys match the workspace fields, all other keys match labels. Use = and != to compare values and ~ and !~ to match glob patterns"
+
+var labelSelectorFlag string
+
+var LabelCmd = &cobra.Command{
+	Use:     "label",
+	Aliases: []string{"labels"},
+	Short:   "Manage the labels of many workspaces at once",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+var labelSetCmd = &cobra.Command{
+	Use:     "set KEY=VALUE...",
+	Short:   "Add or update labels on the selected workspaces",
+	Example: "daytona label set team=payments --selector 'repo~payments-*'",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		labels, err := workspace.ParseLabels(args)
+		if err != nil {
+			return err
+		}
+
+		return updateSelectedWorkspaceLabels(func(current map[string]string) map[string]string {
+			updated := maps.Clone(current)
+			if updated == nil {
+				updated = map[string]string{}
+			}
+			maps.Copy(updated, labels)
+			return updated
+		})
+	},
+}
+
+var labelRemoveCmd = &cobra.Command{
+	Use:     "remove KEY...",
+	Aliases: []string{"rm", "unset"},
+	Short:   "Remove labels from the selected workspaces",
+	Example: "daytona label remove team --selector 'team=payments,repo~legacy-*'",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateSelectedWorkspaceLabels(func(current map[string]string) map[string]string {
+			updated := maps.Clone(current)
+			for _, key := range args {
+				delete(updated, key)
+			}
+			return updated
+		})
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{labelSetCmd, labelRemoveCmd} {
+		cmd.Flags().StringVarP(&labelSelectorFlag, "selector", "s", "", selectorFlagDescription)
+		cmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+		_ = cmd.MarkFlagRequired("selector")
+		LabelCmd.AddCommand(cmd)
+	}
+}
+
+type labelUpdate struct {
+	Workspace *apiclient.WorkspaceDTO
+	Labels    map[string]string
+}
+
+// updateSelectedWorkspaceLabels previews the label changes on the workspaces matching the selector and applies them once confirmed
+func updateSelectedWorkspaceLabels(update func(map[string]string) map[string]string) error {
+	ctx := context.Background()
+
+	selector, err := workspace.ParseSelector(labelSelectorFlag)
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return err
+	}
+
+	workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	matched := 0
+	updates := []labelUpdate{}
+
+	for i, ws := range workspaceList {
+		if !selector.Match(getSelectorFields(&ws)) {
+			continue
+		}
+		matched++
+
+		labels := update(ws.Labels)
+		if maps.Equal(ws.Labels, labels) {
+			continue
+		}
+
+		updates = append(updates, labelUpdate{Workspace: &workspaceList[i], Labels: labels})
+	}
+
+	if matched == 0 {
+		views.RenderInfoMessage(fmt.Sprintf("No workspaces match the selector %s", labelSelectorFlag))
+		return nil
+	}
+
+	if len(updates) == 0 {
+		views.RenderInfoMessageBold(fmt.Sprintf("The labels of the %d matching workspace(s) are up to date", matched))
+		return nil
+	}
+
+	for _, u := range updates {
+		fmt.Printf("~ update %s\n    labels: %s → %s\n", u.Workspace.Name, formatApplyValue(formatLabels(u.Workspace.Labels)), formatApplyValue(formatLabels(u.Labels)))
+	}
+	fmt.Println()
+
+	if !yesFlag && !apiclient_util.IsDryRun() {
+		confirmed := false
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewConfirm().
+					Title(i18n.T("Update the labels of %d workspace(s)?", len(updates))).
+					Value(&confirmed),
+			),
+		).WithTheme(views.GetCustomTheme())
+
+		err := form.Run()
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			fmt.Println(i18n.T("Operation canceled."))
+			return nil
+		}
+	}
+
+	failed := 0
+	for _, u := range updates {
+		settings := apiclient.UpdateWorkspaceSettingsDTO{
+			Group:    u.Workspace.GetGroup(),
+			Labels:   u.Labels,
+			AutoStop: u.Workspace.GetAutoStop(),
+		}
+
+		_, res, err := apiClient.WorkspaceAPI.UpdateWorkspaceSettings(ctx, u.Workspace.Id).Settings(settings).Execute()
+		if err != nil {
+			failed++
+			views.RenderInfoMessage(fmt.Sprintf("Failed to update workspace %s: %s", u.Workspace.Name, apiclient_util.HandleErrorResponse(res, err)))
+			continue
+		}
+
+		if !apiclient_util.IsDryRun() {
+			views.RenderInfoMessage(fmt.Sprintf("Workspace %s updated", u.Workspace.Name))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to update %d of %d workspace(s)", failed, len(updates))
+	}
+
+	return nil
+}
+
+// getSelectorFields returns the fields of the workspace that selectors are matched against
+func getSelectorFields(ws *apiclient.WorkspaceDTO) workspace.SelectorFields {
+	repositories := []string{}
+	for _, project := range ws.Projects {
+		repositories = append(repositories, project.Repository.Name)
+	}
+
+	return workspace.SelectorFields{
+		Name:         ws.Name,
+		Group:        ws.GetGroup(),
+		Target:       ws.Target,
+		Repositories: repositories,
+		Labels:       ws.Labels,
+	}
+}
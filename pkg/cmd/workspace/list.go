@@ -5,17 +5,31 @@ package workspace
 
 import (
 	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/format"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
 	list_view "github.com/daytonaio/daytona/pkg/views/workspace/list"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
 var verbose bool
+var listLabelFlags []string
+var watchFlag bool
+var allProfilesFlag bool
+var allUsersFlag bool
+
+const watchInterval = 5 * time.Second
 
 var ListCmd = &cobra.Command{
 	Use:     "list",
@@ -27,20 +41,43 @@ var ListCmd = &cobra.Command{
 		ctx := context.Background()
 		var specifyGitProviders bool
 
+		if watchFlag && format.FormatFlag != "" {
+			return errors.New("--watch can not be used with --format")
+		}
+
+		if watchFlag && views.IsPlainOutput() {
+			return errors.New("--watch requires a terminal and can not be used with --plain, use the watch command instead")
+		}
+
+		labelSelector, err := workspace.ParseLabels(listLabelFlags)
+		if err != nil {
+			return err
+		}
+
+		if allProfilesFlag {
+			if watchFlag {
+				return errors.New("--watch can not be used with --all-profiles")
+			}
+			return listAllProfilesWorkspaces(labelSelector)
+		}
+
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			return err
 		}
 
-		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(verbose).Execute()
+		fetchWorkspaces := func() ([]apiclient.WorkspaceDTO, error) {
+			return getWorkspaceList(ctx, apiClient, labelSelector)
+		}
 
+		workspaceList, err := fetchWorkspaces()
 		if err != nil {
-			return apiclient.HandleErrorResponse(res, err)
+			return err
 		}
 
 		gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(ctx).Execute()
 		if err != nil {
-			return apiclient.HandleErrorResponse(res, err)
+			return apiclient_util.HandleErrorResponse(res, err)
 		}
 
 		if len(gitProviders) > 1 {
@@ -48,7 +85,7 @@ var ListCmd = &cobra.Command{
 		}
 
 		if format.FormatFlag != "" {
-			formattedData := format.NewFormatter(workspaceList)
+			formattedData := format.NewFormatter(workspaceList).WithTable(format.NewTable(list_view.GetTable(workspaceList, specifyGitProviders, verbose)))
 			formattedData.Print()
 			return nil
 		}
@@ -63,13 +100,113 @@ var ListCmd = &cobra.Command{
 			return err
 		}
 
+		if watchFlag {
+			watchParams := list_view.WatchParams{
+				FetchWorkspaces:     fetchWorkspaces,
+				Interval:            watchInterval,
+				SpecifyGitProviders: specifyGitProviders,
+				Verbose:             verbose,
+				ActiveProfileName:   activeProfile.Name,
+			}
+
+			// Requests printed in dry-run mode would break the view so actions are only enabled otherwise
+			if !apiclient_util.IsDryRun() {
+				watchParams.StartWorkspace = func(workspaceId string) error {
+					res, err := apiClient.WorkspaceAPI.StartWorkspace(ctx, workspaceId).Execute()
+					if err != nil {
+						return apiclient_util.HandleErrorResponse(res, err)
+					}
+					return nil
+				}
+				watchParams.StopWorkspace = func(workspaceId string) error {
+					res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, workspaceId).Execute()
+					if err != nil {
+						return apiclient_util.HandleErrorResponse(res, err)
+					}
+					return nil
+				}
+			}
+
+			return list_view.WatchWorkspaces(watchParams)
+		}
+
 		list_view.ListWorkspaces(workspaceList, specifyGitProviders, verbose, activeProfile.Name)
 
+		// The list is still useful if the project configs can't be fetched so the error is not returned
+		outdatedProjects, _, err := workspace_util.GetOutdatedProjects(ctx, apiClient, workspaceList)
+		if err == nil {
+			list_view.NotifyOutdatedWorkspaces(slices.Collect(maps.Keys(outdatedProjects)))
+		}
+
 		return nil
 	},
 }
 
 func init() {
 	ListCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Show verbose output")
-	format.RegisterFormatFlag(ListCmd)
+	ListCmd.Flags().StringArrayVarP(&listLabelFlags, "label", "l", []string{}, "Only list workspaces with the given label (e.g. --label team=payments)")
+	ListCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Keep the list open and refresh it every few seconds. The selected workspace can be started with s and stopped with x")
+	ListCmd.Flags().BoolVar(&allProfilesFlag, "all-profiles", false, "List the workspaces of all profiles")
+	ListCmd.Flags().BoolVar(&allUsersFlag, "all", false, "List the workspaces of all users instead of the workspaces owned by or shared with you. Requires an admin API key")
+	format.RegisterTableFormatFlag(ListCmd)
+}
+
+func getWorkspaceList(ctx context.Context, apiClient *apiclient.APIClient, labelSelector map[string]string) ([]apiclient.WorkspaceDTO, error) {
+	workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(verbose).All(allUsersFlag).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(labelSelector) > 0 {
+		workspaceList = slices.DeleteFunc(workspaceList, func(ws apiclient.WorkspaceDTO) bool {
+			return !workspace.MatchLabels(ws.Labels, labelSelector)
+		})
+	}
+
+	return workspaceList, nil
+}
+
+// listAllProfilesWorkspaces lists the workspaces of all profiles concurrently. Unreachable
+// servers don't fail the command and are listed with their error instead
+func listAllProfilesWorkspaces(labelSelector map[string]string) error {
+	c, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	profileLists := make([]list_view.ProfileWorkspaceList, len(c.Profiles))
+
+	var wg sync.WaitGroup
+	for i, profile := range c.Profiles {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			profileLists[i].ProfileName = profile.Name
+
+			apiClient, err := apiclient_util.NewApiClient(profile)
+			if err != nil {
+				profileLists[i].Error = err.Error()
+				return
+			}
+
+			workspaceList, err := getWorkspaceList(context.Background(), apiClient, labelSelector)
+			if err != nil {
+				profileLists[i].Error = err.Error()
+				return
+			}
+
+			profileLists[i].WorkspaceList = workspaceList
+		}()
+	}
+	wg.Wait()
+
+	if format.FormatFlag != "" {
+		formattedData := format.NewFormatter(profileLists).WithTable(format.NewTable(list_view.GetProfileWorkspacesTable(profileLists, verbose)))
+		formattedData.Print()
+		return nil
+	}
+
+	list_view.ListProfileWorkspaces(profileLists, verbose)
+	return nil
 }
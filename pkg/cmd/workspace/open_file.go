@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/ide"
+	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var openFileProjectFlag string
+
+var OpenFileCmd = &cobra.Command{
+	Use:   "open-file WORKSPACE PATH[:LINE[:COLUMN]]",
+	Short: "Open a project file at a line in your preferred IDE",
+	Long: `Open a project file at a line in your preferred IDE, so that locations from stack traces and code review links can be opened in the remote project.
+Relative paths are resolved against the project directory. Locations can also be written as PATH#L42.
+
+VS Code, Cursor and Zed open the file over SSH remote development, JetBrains IDEs navigate to the file in a project opened with 'daytona code' and the ssh IDE opens the file in $EDITOR in the project.`,
+	Example: `  daytona open-file my-workspace pkg/server/server.go:42
+  daytona open-file my-workspace /home/daytona/app/main.py:10:5 --ide ssh`,
+	Args:    cobra.ExactArgs(2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		location, err := ide.ParseFileLocation(args[1])
+		if err != nil {
+			return err
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], true)
+		if err != nil {
+			return err
+		}
+
+		projectName, err := apiclient_util.GetFirstWorkspaceProjectName(workspace.Id, openFileProjectFlag, &activeProfile)
+		if err != nil {
+			return err
+		}
+
+		ideId := c.GetDefaultIdeId(activeProfile)
+		if ideFlag != "" {
+			ideId = ideFlag
+		}
+
+		if !workspace_util.IsProjectRunning(workspace, projectName) {
+			started, err := AutoStartWorkspace(workspace.Name, projectName)
+			if err != nil {
+				return err
+			}
+			if !started {
+				return nil
+			}
+		}
+
+		projectDir, res, err := apiClient.WorkspaceToolboxAPI.GetProjectDir(ctx, workspace.Id, projectName).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		var providerConfigId *string
+		for _, project := range workspace.Projects {
+			if project.Name == projectName {
+				providerConfigId = project.GitProviderConfigId
+				break
+			}
+		}
+
+		gpgKey, err := GetGitProviderGpgKey(apiClient, ctx, providerConfigId)
+		if err != nil {
+			log.Warn(err)
+		}
+
+		telemetry.AdditionalData["ide"] = ideId
+
+		views.RenderInfoMessage(fmt.Sprintf("Opening %s in %s/%s", location, workspace.Name, projectName))
+		return ide.OpenFile(activeProfile, ideId, workspace.Id, projectName, projectDir.GetDir(), *location, gpgKey)
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		// Project paths can't be completed from the local file system
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	OpenFileCmd.Flags().StringVarP(&openFileProjectFlag, "project", "p", "", "Project to open the file in for a multi-project workspace")
+	OpenFileCmd.Flags().StringVarP(&ideFlag, "ide", "i", "", "Specify the IDE (vscode, cursor, zed, ssh or a JetBrains IDE)")
+	OpenFileCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+}
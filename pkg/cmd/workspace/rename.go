@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var RenameCmd = &cobra.Command{
+	Use:   "rename [WORKSPACE] [NEW_NAME]",
+	Short: "Rename a workspace",
+	Long: `Rename a workspace.
+SSH config entries and pinned host keys reference the workspace by its ID, so existing SSH and IDE connection settings keep working after the rename.`,
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		newName, err := util.GetValidatedName(args[1])
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.WorkspaceAPI.RenameWorkspace(ctx, workspace.Id).Rename(apiclient.RenameWorkspace{
+			Name: newName,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(i18n.T("Workspace '%s' successfully renamed to '%s'", workspace.Name, newName))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
@@ -5,8 +5,8 @@ package workspace
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -66,9 +66,9 @@ var RestartCmd = &cobra.Command{
 			return err
 		}
 		if restartProjectFlag != "" {
-			views.RenderInfoMessage(fmt.Sprintf("Project '%s' from workspace '%s' successfully restarted", restartProjectFlag, workspaceId))
+			views.RenderInfoMessage(i18n.T("Project '%s' from workspace '%s' successfully restarted", restartProjectFlag, workspaceId))
 		} else {
-			views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' successfully restarted", workspaceId))
+			views.RenderInfoMessage(i18n.T("Workspace '%s' successfully restarted", workspaceId))
 		}
 		return nil
 	},
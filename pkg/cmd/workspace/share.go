@@ -0,0 +1,93 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var shareWithFlag []string
+var shareRemoveFlag []string
+
+var ShareCmd = &cobra.Command{
+	Use:   "share WORKSPACE",
+	Short: "Share a workspace with other users",
+	Long: `Share a workspace with other users of the server so that they can view it and connect to it over SSH or an IDE.
+Users are identified by the name of their API key. Shared users can't start, stop or remove the workspace.
+Without flags, the owner of the workspace and the users it is shared with are printed.`,
+	Example: `  daytona share my-workspace --with alice --with bob
+  daytona share my-workspace --remove bob`,
+	Args:    cobra.ExactArgs(1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		ws, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		if len(shareWithFlag) == 0 && len(shareRemoveFlag) == 0 {
+			renderWorkspaceSharing(ws.Name, ws.GetOwner(), ws.SharedWith)
+			return nil
+		}
+
+		sharedWith := slices.DeleteFunc(append(slices.Clone(ws.SharedWith), shareWithFlag...), func(user string) bool {
+			return slices.Contains(shareRemoveFlag, user)
+		})
+
+		updated, res, err := apiClient.WorkspaceAPI.ShareWorkspace(ctx, ws.Id).Share(apiclient.ShareWorkspaceDTO{
+			SharedWith: sharedWith,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if apiclient_util.IsDryRun() {
+			return nil
+		}
+
+		renderWorkspaceSharing(updated.Name, updated.GetOwner(), updated.SharedWith)
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	ShareCmd.Flags().StringArrayVar(&shareWithFlag, "with", []string{}, "User to share the workspace with")
+	ShareCmd.Flags().StringArrayVar(&shareRemoveFlag, "remove", []string{}, "User to stop sharing the workspace with")
+}
+
+func renderWorkspaceSharing(workspaceName, owner string, sharedWith []string) {
+	if owner == "" {
+		owner = "-"
+	}
+
+	if len(sharedWith) == 0 {
+		views.RenderInfoMessageBold(fmt.Sprintf("Workspace %s is owned by %s and not shared with other users", workspaceName, owner))
+		return
+	}
+
+	views.RenderInfoMessageBold(fmt.Sprintf("Workspace %s is owned by %s and shared with %s", workspaceName, owner, strings.Join(sharedWith, ", ")))
+}
@@ -12,17 +12,19 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
 	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/ports"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
 	"github.com/daytonaio/daytona/pkg/docker"
-	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/docker/docker/api/types/container"
-	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	gossh "golang.org/x/crypto/ssh"
 )
 
 var SshProxyCmd = &cobra.Command{
@@ -44,20 +46,36 @@ var SshProxyCmd = &cobra.Command{
 			return err
 		}
 
+		// Make sure all requests go to the server of the entry's profile
+		_, err = apiclient_util.GetApiClient(&profile)
+		if err != nil {
+			return err
+		}
+
 		if len(args) == 3 {
 			projectName = args[2]
 		} else {
-			projectName, err = apiclient.GetFirstWorkspaceProjectName(workspaceId, projectName, &profile)
+			projectName, err = apiclient_util.GetFirstWorkspaceProjectName(workspaceId, projectName, &profile)
 			if err != nil {
 				return err
 			}
 		}
 
-		workspace, err := apiclient.GetWorkspace(workspaceId, true)
+		workspace, err := apiclient_util.GetWorkspace(workspaceId, true)
 		if err != nil {
 			return err
 		}
 
+		err = grantSshAccess(workspace.Id)
+		if err != nil {
+			return err
+		}
+
+		go recordSshConnectedEvent(workspace.Id, projectName)
+
+		closeAutoForwardSession := registerAutoForwardSession(profile.Id, workspace, projectName)
+		defer closeAutoForwardSession()
+
 		if workspace.Target == "local" && profile.Id == "default" {
 			// If the workspace is local, we directly access the ssh port through the container
 			project := workspace.Projects[0]
@@ -71,7 +89,7 @@ var SshProxyCmd = &cobra.Command{
 				}
 			}
 
-			cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+			cli, err := workspace_util.GetDockerApiClient(profile)
 			if err != nil {
 				return err
 			}
@@ -125,6 +143,7 @@ var SshProxyCmd = &cobra.Command{
 				}
 
 				if !res.Running {
+					closeAutoForwardSession()
 					os.Exit(res.ExitCode)
 				}
 
@@ -132,14 +151,14 @@ var SshProxyCmd = &cobra.Command{
 			}
 		}
 
-		tsConn, err := tailscale.GetConnection(&profile)
+		dial, err := tailscale.GetProjectDialer(&profile, workspaceId, projectName)
 		if err != nil {
 			return err
 		}
 
 		errChan := make(chan error)
 
-		dialConn, err := tsConn.Dial(context.Background(), "tcp", fmt.Sprintf("%s:%d", project.GetProjectHostname(workspaceId, projectName), ssh_config.SSH_PORT))
+		dialConn, err := dial(context.Background(), ssh_config.SSH_PORT)
 		if err != nil {
 			return err
 		}
@@ -164,3 +183,68 @@ var SshProxyCmd = &cobra.Command{
 		return <-errChan
 	},
 }
+
+// grantSshAccess lets the SSH key of the CLI authenticate to the projects of the workspace for the connection.
+// The server only grants access if the user can access the workspace
+func grantSshAccess(workspaceId string) error {
+	signer, err := config.LoadOrCreateSshKey()
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := apiClient.WorkspaceAPI.GrantSshAccess(context.Background(), workspaceId).Access(apiclient.SshAccess{
+		PublicKey: string(gossh.MarshalAuthorizedKey(signer.PublicKey())),
+	}).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func recordSshConnectedEvent(workspaceId, projectName string) {
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	res, err := apiClient.WorkspaceAPI.RecordEvent(context.Background(), workspaceId).Event(apiclient.RecordEvent{
+		Type:        apiclient.EventTypeSshConnected,
+		ProjectName: &projectName,
+	}).Execute()
+	if err != nil {
+		log.Debug(apiclient_util.HandleErrorResponse(res, err))
+	}
+}
+
+// registerAutoForwardSession makes sure the ports declared on the project, and the ports detected in it unless disabled,
+// are forwarded while the connection is open
+func registerAutoForwardSession(profileId string, workspace *apiclient.WorkspaceDTO, projectName string) func() {
+	detectPorts := false
+	c, err := config.GetConfig()
+	if err == nil {
+		detectPorts = !c.PortsAutoForward.Disabled
+	}
+
+	for _, p := range workspace.Projects {
+		if p.Name != projectName || (len(p.ForwardPorts) == 0 && !detectPorts) {
+			continue
+		}
+
+		closeSession, err := ports.RegisterAutoForwardSession(profileId, workspace.Id, projectName, p.ForwardPorts)
+		if err != nil {
+			log.Debug(err)
+			break
+		}
+
+		return closeSession
+	}
+
+	return func() {}
+}
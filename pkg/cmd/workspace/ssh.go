@@ -6,13 +6,13 @@ package workspace
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/huh"
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -26,13 +26,16 @@ import (
 )
 
 var (
-	sshOptions []string
-	edit       bool
+	sshOptions       []string
+	edit             bool
+	sshProjectFlag   string
+	forwardAgentFlag bool
 )
 
 var SshCmd = &cobra.Command{
 	Use:     "ssh [WORKSPACE] [PROJECT] [CMD...]",
 	Short:   "SSH into a project using the terminal",
+	Long:    "SSH into a project using the built-in SSH client. The connection goes through the active profile and an interactive shell is started on a PTY unless a command is passed.\nThe system OpenSSH client and the generated SSH config are used instead when SSH options are passed or a GPG key is configured for the project's Git provider.",
 	Args:    cobra.ArbitraryArgs,
 	GroupID: util.WORKSPACE_GROUP,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -78,7 +81,20 @@ var SshCmd = &cobra.Command{
 			}
 		}
 
-		if len(args) == 0 || len(args) == 1 {
+		if sshProjectFlag != "" {
+			projectName = sshProjectFlag
+			found := false
+			for _, project := range workspace.Projects {
+				if project.Name == projectName {
+					providerConfigId = project.GitProviderConfigId
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("project %s not found in workspace %s", projectName, workspace.Name)
+			}
+		} else if len(args) == 0 || len(args) == 1 {
 			selectedProject, err := selectWorkspaceProject(workspace.Id, &activeProfile)
 			if err != nil {
 				return err
@@ -90,7 +106,7 @@ var SshCmd = &cobra.Command{
 			providerConfigId = selectedProject.GitProviderConfigId
 		}
 
-		if len(args) >= 2 {
+		if sshProjectFlag == "" && len(args) >= 2 {
 			projectName = args[1]
 			for _, project := range workspace.Projects {
 				if project.Name == projectName {
@@ -118,20 +134,33 @@ var SshCmd = &cobra.Command{
 			}
 		}
 
+		// With the --project flag, everything after the workspace is the command
+		commandArgsStart := 2
+		if sshProjectFlag != "" {
+			commandArgsStart = 1
+		}
+
 		sshArgs := []string{}
-		if len(args) > 2 {
-			sshArgs = append(sshArgs, args[2:]...)
+		if len(args) > commandArgsStart {
+			sshArgs = append(sshArgs, args[commandArgsStart:]...)
 		}
 
+		workspace_util.PinHostKeys(activeProfile, workspace.Id, workspace.Projects)
+
 		gpgKey, err := GetGitProviderGpgKey(apiClient, ctx, providerConfigId)
 		if err != nil {
 			log.Warn(err)
 		}
 
-		return ide.OpenTerminalSsh(activeProfile, workspace.Id, projectName, gpgKey, sshOptions, sshArgs...)
+		// The built-in client doesn't support custom SSH options or GPG agent forwarding
+		if len(sshOptions) > 0 || gpgKey != "" {
+			return ide.OpenTerminalSsh(activeProfile, workspace.Id, projectName, gpgKey, sshOptions, sshArgs...)
+		}
+
+		return ide.OpenNativeTerminalSsh(activeProfile, workspace.Id, projectName, forwardAgentFlag, sshArgs...)
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		if len(args) >= 2 {
+		if len(args) >= 2 || (len(args) == 1 && sshProjectFlag != "") {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		}
 		if len(args) == 1 {
@@ -146,11 +175,16 @@ func init() {
 	SshCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
 	SshCmd.Flags().BoolVarP(&edit, "edit", "e", false, "Edit the project's SSH config")
 	SshCmd.Flags().StringArrayVarP(&sshOptions, "option", "o", []string{}, "Specify SSH options in KEY=VALUE format.")
+	SshCmd.Flags().StringVarP(&sshProjectFlag, "project", "p", "", "Project to connect to in a multi-project workspace")
+	SshCmd.Flags().BoolVarP(&forwardAgentFlag, "forward-agent", "A", false, "Forward the local SSH agent to the project")
 }
 
 func editSSHConfig(activeProfile config.Profile, workspace *apiclient.WorkspaceDTO, projectName string) error {
-	sshDir := filepath.Join(config.SshHomeDir, ".ssh")
-	configPath := filepath.Join(sshDir, "daytona_config")
+	configPath, err := config.GetSshConfigPath()
+	if err != nil {
+		return err
+	}
+
 	sshConfig, err := config.ReadSshConfig(configPath)
 	if err != nil {
 		return err
@@ -185,12 +219,12 @@ func editSSHConfig(activeProfile config.Profile, workspace *apiclient.WorkspaceD
 	isCorrect := true
 	formFields := []huh.Field{
 		huh.NewText().
-			Title("Edit SSH Config").
+			Title(i18n.T("Edit SSH Config")).
 			Description(hostLine).
 			CharLimit(-1).
 			Value(&modifiedContent).ShowLineNumbers(true).WithHeight(10),
 		huh.NewConfirm().
-			Title("Is the above information correct?").
+			Title(i18n.T("Is the above information correct?")).
 			Value(&isCorrect),
 	}
 	form := huh.NewForm(
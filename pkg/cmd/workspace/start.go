@@ -5,10 +5,12 @@ package workspace
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"net/http"
 	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -16,6 +18,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/views"
 	ide_views "github.com/daytonaio/daytona/pkg/views/ide"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/create"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -33,9 +36,12 @@ var allFlag bool
 var codeFlag bool
 
 var StartCmd = &cobra.Command{
-	Use:     "start [WORKSPACE]",
-	Short:   "Start a workspace",
-	Args:    cobra.RangeArgs(0, 1),
+	Use:   "start [WORKSPACE]...",
+	Short: "Start a workspace",
+	Long:  "Start a workspace. Several workspaces can be started at once with --all, several workspace names or glob patterns (e.g. 'feature-*')",
+	Example: `  daytona start my-workspace
+  daytona start 'feature-*' --parallel 8`,
+	Args:    cobra.ArbitraryArgs,
 	GroupID: util.WORKSPACE_GROUP,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var selectedWorkspacesNames []string
@@ -47,13 +53,24 @@ var StartCmd = &cobra.Command{
 
 		ctx := context.Background()
 
+		jsonProgress, err := isJsonProgress()
+		if err != nil {
+			return err
+		}
+		if jsonProgress && codeFlag {
+			return errors.New("--progress json can not be used with --code")
+		}
+
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			return err
 		}
 
-		if allFlag {
-			return startAllWorkspaces()
+		if isBulkSelection(args) {
+			if startProjectFlag != "" || codeFlag {
+				return errors.New("--project and --code can only be used with a single workspace")
+			}
+			return startWorkspaces(args, jsonProgress)
 		}
 
 		if len(args) == 0 {
@@ -93,7 +110,7 @@ var StartCmd = &cobra.Command{
 				}
 
 				ideList = config.GetIdeList()
-				ideId = c.DefaultIdeId
+				ideId = c.GetDefaultIdeId(activeProfile)
 
 				wsInfo, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceName).Execute()
 				if err != nil {
@@ -120,19 +137,22 @@ var StartCmd = &cobra.Command{
 				}
 			}
 
-			err = StartWorkspace(apiClient, workspaceName, startProjectFlag)
+			err = startWorkspace(apiClient, workspaceName, startProjectFlag, jsonProgress)
 			if err != nil {
 				return err
 			}
+			if apiclient_util.IsDryRun() || jsonProgress {
+				return nil
+			}
 			gpgKey, err := GetGitProviderGpgKey(apiClient, ctx, providerConfigId)
 			if err != nil {
 				log.Warn(err)
 			}
 
 			if startProjectFlag == "" {
-				views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' started successfully", workspaceName))
+				views.RenderInfoMessage(i18n.T("Workspace '%s' started successfully", workspaceName))
 			} else {
-				views.RenderInfoMessage(fmt.Sprintf("Project '%s' from workspace '%s' started successfully", startProjectFlag, workspaceName))
+				views.RenderInfoMessage(i18n.T("Project '%s' from workspace '%s' started successfully", startProjectFlag, workspaceName))
 
 				if codeFlag {
 					ide_views.RenderIdeOpeningMessage(workspaceName, startProjectFlag, ideId, ideList)
@@ -144,12 +164,15 @@ var StartCmd = &cobra.Command{
 			}
 		} else {
 			for _, workspace := range selectedWorkspacesNames {
-				err := StartWorkspace(apiClient, workspace, "")
+				err := startWorkspace(apiClient, workspace, "", jsonProgress)
 				if err != nil {
 					log.Errorf("Failed to start workspace %s: %v\n\n", workspace, err)
 					continue
 				}
-				views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' started successfully", workspace))
+				if apiclient_util.IsDryRun() || jsonProgress {
+					continue
+				}
+				views.RenderInfoMessage(i18n.T("- Workspace '%s' started successfully", workspace))
 			}
 		}
 		return nil
@@ -164,6 +187,8 @@ func init() {
 	StartCmd.PersistentFlags().BoolVarP(&allFlag, "all", "a", false, "Start all workspaces")
 	StartCmd.PersistentFlags().BoolVarP(&codeFlag, "code", "c", false, "Open the workspace in the IDE after workspace start")
 	StartCmd.PersistentFlags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+	StartCmd.PersistentFlags().StringVar(&progressFlag, "progress", "", progressFlagDescription)
+	StartCmd.PersistentFlags().IntVar(&parallelFlag, "parallel", defaultParallelism, parallelFlagDescription)
 
 	err := StartCmd.RegisterFlagCompletionFunc("project", getProjectNameCompletions)
 	if err != nil {
@@ -171,7 +196,8 @@ func init() {
 	}
 }
 
-func startAllWorkspaces() error {
+// startWorkspaces starts all workspaces or the workspaces matching the patterns in parallel
+func startWorkspaces(patterns []string, jsonProgress bool) error {
 	ctx := context.Background()
 	apiClient, err := apiclient_util.GetApiClient(nil)
 	if err != nil {
@@ -183,16 +209,26 @@ func startAllWorkspaces() error {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
 
-	for _, workspace := range workspaceList {
-		err := StartWorkspace(apiClient, workspace.Name, "")
-		if err != nil {
-			log.Errorf("Failed to start workspace %s: %v\n\n", workspace.Name, err)
-			continue
-		}
+	selectedWorkspaces, err := selectBulkWorkspaces(workspaceList, patterns)
+	if err != nil {
+		return err
+	}
 
-		views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' started successfully", workspace.Name))
+	// Progress events are reported per workspace so there is no results table
+	if jsonProgress {
+		errs := util.ArrayMapParallel(selectedWorkspaces, parallelFlag, func(workspace apiclient.WorkspaceDTO) error {
+			return startWorkspace(apiClient, workspace.Name, "", true)
+		})
+		return errors.Join(errs...)
 	}
-	return nil
+
+	return runBulkAction(i18n.T("Start workspaces"), i18n.T("Starting %d workspaces", len(selectedWorkspaces)), selectedWorkspaces, func(workspace apiclient.WorkspaceDTO) error {
+		res, err := apiClient.WorkspaceAPI.StartWorkspace(ctx, workspace.Id).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		return nil
+	})
 }
 
 func getProjectNameCompletions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -268,6 +304,10 @@ func getAllWorkspacesByState(state WorkspaceState) ([]string, cobra.ShellCompDir
 }
 
 func StartWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName string) error {
+	return startWorkspace(apiClient, workspaceId, projectName, false)
+}
+
+func startWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName string, jsonProgress bool) error {
 	ctx := context.Background()
 	var projectNames []string
 	timeFormat := time.Now().Format("2006-01-02 15:04:05")
@@ -299,25 +339,36 @@ func StartWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName str
 	}
 
 	logsContext, stopLogs := context.WithCancel(context.Background())
-	go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, workspace.Id, projectNames, true, true, &from)
-
-	if projectName == "" {
-		res, err := apiClient.WorkspaceAPI.StartWorkspace(ctx, workspaceId).Execute()
-		if err != nil {
-			stopLogs()
-			return apiclient_util.HandleErrorResponse(res, err)
+	var progressView create.Progress
+	if !apiclient_util.IsDryRun() {
+		if jsonProgress {
+			progressView = create.NewStartJsonProgressView(workspace.Name, projectNames)
+			go apiclient_util.FollowWorkspaceLogs(logsContext, activeProfile, workspace.Id, projectNames, &from, progressView.HandleLogEntry)
+		} else {
+			go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, workspace.Id, projectNames, true, true, &from)
 		}
-		time.Sleep(100 * time.Millisecond)
+	}
+
+	stopProgress := func(err error) {
 		stopLogs()
-		return nil
-	} else {
-		res, err := apiClient.WorkspaceAPI.StartProject(ctx, workspaceId, projectName).Execute()
-		if err != nil {
-			stopLogs()
-			return apiclient_util.HandleErrorResponse(res, err)
+		if progressView != nil {
+			progressView.Stop(err)
 		}
-		time.Sleep(100 * time.Millisecond)
-		stopLogs()
-		return nil
 	}
+
+	var res *http.Response
+	if projectName == "" {
+		res, err = apiClient.WorkspaceAPI.StartWorkspace(ctx, workspaceId).Execute()
+	} else {
+		res, err = apiClient.WorkspaceAPI.StartProject(ctx, workspaceId, projectName).Execute()
+	}
+	if err != nil {
+		err = apiclient_util.HandleErrorResponse(res, err)
+		stopProgress(err)
+		return err
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	stopProgress(nil)
+	return nil
 }
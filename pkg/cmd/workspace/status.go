@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/daytonaio/daytona/pkg/views/workspace/status"
+	"github.com/spf13/cobra"
+)
+
+var checkFlag bool
+var failOnFlag []string
+
+var StatusCmd = &cobra.Command{
+	Use:   "status [WORKSPACE]",
+	Short: "Show the health of a workspace",
+	Long: `Show the health of a workspace and its projects.
+A workspace is healthy if all of its projects are running, stopped if none of them are and degraded otherwise.
+Projects whose agent has stopped reporting are unresponsive and make the workspace degraded.
+
+With --check the command exits with a non-zero code if the workspace health is one of --fail-on,
+which lets pipelines assert that a workspace is ready before running against it.`,
+	Example: `  daytona status my-workspace
+  daytona status --check my-workspace
+  daytona status --check my-workspace --fail-on stopped`,
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		failOn := []status.Health{}
+		for _, health := range failOnFlag {
+			health := status.Health(strings.TrimSpace(health))
+			if !slices.Contains(status.Healths, health) {
+				return fmt.Errorf("invalid value for --fail-on: %s. Must be one of (healthy, degraded, stopped)", health)
+			}
+			failOn = append(failOn, health)
+		}
+
+		var workspace *apiclient.WorkspaceDTO
+
+		if len(args) == 0 {
+			if checkFlag {
+				return errors.New("a workspace is required with --check")
+			}
+
+			apiClient, err := apiclient_util.GetApiClient(nil)
+			if err != nil {
+				return err
+			}
+
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(workspaceList) == 0 {
+				views_util.NotifyEmptyWorkspaceList(true)
+				return nil
+			}
+
+			if format.FormatFlag != "" {
+				format.UnblockStdOut()
+			}
+
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Check")
+			if format.FormatFlag != "" {
+				format.BlockStdOut()
+			}
+		} else {
+			var err error
+			workspace, err = apiclient_util.GetWorkspace(args[0], false)
+			if err != nil {
+				return err
+			}
+		}
+
+		if workspace == nil {
+			return nil
+		}
+
+		workspaceStatus := status.GetWorkspaceStatus(*workspace, time.Now())
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(workspaceStatus)
+			formattedData.Print()
+		} else if checkFlag {
+			fmt.Printf("%s: %s\n", workspaceStatus.Name, workspaceStatus.Health)
+		} else {
+			status.Render(workspaceStatus)
+		}
+
+		if checkFlag && slices.Contains(failOn, workspaceStatus.Health) {
+			return fmt.Errorf("workspace %s is %s", workspaceStatus.Name, workspaceStatus.Health)
+		}
+
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	StatusCmd.Flags().BoolVar(&checkFlag, "check", false, "Exit with a non-zero code if the workspace health is one of --fail-on")
+	StatusCmd.Flags().StringSliceVar(&failOnFlag, "fail-on", []string{string(status.HealthDegraded), string(status.HealthStopped)}, "Comma separated health states that fail the check (healthy, degraded, stopped)")
+	format.RegisterFormatFlag(StatusCmd)
+}
@@ -5,10 +5,12 @@ package workspace
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -22,10 +24,13 @@ import (
 var stopProjectFlag string
 
 var StopCmd = &cobra.Command{
-	Use:     "stop [WORKSPACE]",
-	Short:   "Stop a workspace",
+	Use:   "stop [WORKSPACE]...",
+	Short: "Stop a workspace",
+	Long:  "Stop a workspace. Several workspaces can be stopped at once with --all, several workspace names or glob patterns (e.g. 'feature-*')",
+	Example: `  daytona stop my-workspace
+  daytona stop --all --parallel 8`,
 	GroupID: util.WORKSPACE_GROUP,
-	Args:    cobra.RangeArgs(0, 1),
+	Args:    cobra.ArbitraryArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		timeFormat := time.Now().Format("2006-01-02 15:04:05")
 		from, err := time.Parse("2006-01-02 15:04:05", timeFormat)
@@ -43,8 +48,11 @@ var StopCmd = &cobra.Command{
 			return err
 		}
 
-		if allFlag {
-			return stopAllWorkspaces(activeProfile, from)
+		if isBulkSelection(args) {
+			if stopProjectFlag != "" {
+				return errors.New("--project can only be used with a single workspace")
+			}
+			return stopWorkspaces(args)
 		}
 
 		ctx := context.Background()
@@ -70,32 +78,56 @@ var StopCmd = &cobra.Command{
 
 			selectedWorkspaces := selection.GetWorkspacesFromPrompt(workspaceList, "Stop")
 
+			confirmed, err := confirmProjectChanges(ctx, apiClient, selectedWorkspaces, "", "Stop")
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				fmt.Println(i18n.T("Operation canceled."))
+				return nil
+			}
+
 			for _, workspace := range selectedWorkspaces {
 				err := StopWorkspace(apiClient, workspace.Name, "")
 				if err != nil {
 					log.Errorf("Failed to stop workspace %s: %v\n\n", workspace.Name, err)
 					continue
 				}
+				if apiclient_util.IsDryRun() {
+					continue
+				}
 
 				projectNames := util.ArrayMap(workspace.Projects, func(p apiclient.Project) string {
 					return p.Name
 				})
 				apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, false, true, &from)
-				views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' successfully stopped", workspace.Name))
+				views.RenderInfoMessage(i18n.T("- Workspace '%s' successfully stopped", workspace.Name))
 			}
 		} else {
 			workspaceId := args[0]
 			var projectNames []string
 
-			err = StopWorkspace(apiClient, workspaceId, stopProjectFlag)
+			workspace, err := apiclient_util.GetWorkspace(workspaceId, false)
 			if err != nil {
 				return err
 			}
 
-			workspace, err := apiclient_util.GetWorkspace(workspaceId, false)
+			confirmed, err := confirmProjectChanges(ctx, apiClient, []*apiclient.WorkspaceDTO{workspace}, stopProjectFlag, "Stop")
 			if err != nil {
 				return err
 			}
+			if !confirmed {
+				fmt.Println(i18n.T("Operation canceled."))
+				return nil
+			}
+
+			err = StopWorkspace(apiClient, workspaceId, stopProjectFlag)
+			if err != nil {
+				return err
+			}
+			if apiclient_util.IsDryRun() {
+				return nil
+			}
 
 			if startProjectFlag != "" {
 				projectNames = append(projectNames, stopProjectFlag)
@@ -108,9 +140,9 @@ var StopCmd = &cobra.Command{
 			apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, false, true, &from)
 
 			if stopProjectFlag != "" {
-				views.RenderInfoMessage(fmt.Sprintf("Project '%s' from workspace '%s' successfully stopped", stopProjectFlag, workspaceId))
+				views.RenderInfoMessage(i18n.T("Project '%s' from workspace '%s' successfully stopped", stopProjectFlag, workspaceId))
 			} else {
-				views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' successfully stopped", workspaceId))
+				views.RenderInfoMessage(i18n.T("Workspace '%s' successfully stopped", workspaceId))
 			}
 		}
 		return nil
@@ -123,9 +155,13 @@ var StopCmd = &cobra.Command{
 func init() {
 	StopCmd.Flags().StringVarP(&stopProjectFlag, "project", "p", "", "Stop a single project in the workspace (project name)")
 	StopCmd.Flags().BoolVarP(&allFlag, "all", "a", false, "Stop all workspaces")
+	StopCmd.Flags().BoolVar(&ignoreDirtyFlag, "ignore-dirty", false, ignoreDirtyFlagDescription)
+	StopCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Stop projects with uncommitted or unpushed changes without a prompt")
+	StopCmd.Flags().IntVar(&parallelFlag, "parallel", defaultParallelism, parallelFlagDescription)
 }
 
-func stopAllWorkspaces(activeProfile config.Profile, from time.Time) error {
+// stopWorkspaces stops all workspaces or the workspaces matching the patterns in parallel
+func stopWorkspaces(patterns []string) error {
 	ctx := context.Background()
 	apiClient, err := apiclient_util.GetApiClient(nil)
 	if err != nil {
@@ -137,21 +173,29 @@ func stopAllWorkspaces(activeProfile config.Profile, from time.Time) error {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
 
-	for _, workspace := range workspaceList {
-		err := StopWorkspace(apiClient, workspace.Name, "")
-		if err != nil {
-			log.Errorf("Failed to stop workspace %s: %v\n\n", workspace.Name, err)
-			continue
-		}
-
-		projectNames := util.ArrayMap(workspace.Projects, func(p apiclient.Project) string {
-			return p.Name
-		})
+	selectedWorkspaces, err := selectBulkWorkspaces(workspaceList, patterns)
+	if err != nil {
+		return err
+	}
 
-		apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, false, true, &from)
-		views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' successfully stopped", workspace.Name))
+	confirmed, err := confirmProjectChanges(ctx, apiClient, util.ArrayMap(selectedWorkspaces, func(w apiclient.WorkspaceDTO) *apiclient.WorkspaceDTO {
+		return &w
+	}), "", "Stop")
+	if err != nil {
+		return err
 	}
-	return nil
+	if !confirmed {
+		fmt.Println(i18n.T("Operation canceled."))
+		return nil
+	}
+
+	return runBulkAction(i18n.T("Stop workspaces"), i18n.T("Stopping %d workspaces", len(selectedWorkspaces)), selectedWorkspaces, func(workspace apiclient.WorkspaceDTO) error {
+		res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, workspace.Id).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		return nil
+	})
 }
 
 func StopWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName string) error {
@@ -160,7 +204,7 @@ func StopWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName stri
 	var stopFunc func() error
 
 	if projectName == "" {
-		message = fmt.Sprintf("Workspace '%s' is stopping", workspaceId)
+		message = i18n.T("Workspace '%s' is stopping", workspaceId)
 		stopFunc = func() error {
 			res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, workspaceId).Execute()
 			if err != nil {
@@ -169,7 +213,7 @@ func StopWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName stri
 			return nil
 		}
 	} else {
-		message = fmt.Sprintf("Project '%s' from workspace '%s' is stopping", projectName, workspaceId)
+		message = i18n.T("Project '%s' from workspace '%s' is stopping", projectName, workspaceId)
 		stopFunc = func() error {
 			res, err := apiClient.WorkspaceAPI.StopProject(ctx, workspaceId, projectName).Execute()
 			if err != nil {
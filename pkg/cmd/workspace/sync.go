@@ -0,0 +1,197 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/filesync"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	syncProjectFlag       string
+	syncPathFlag          string
+	syncBidirectionalFlag bool
+	syncExcludeFlag       []string
+)
+
+const syncRemoteInterval = 2 * time.Second
+
+var SyncCmd = &cobra.Command{
+	Use:   "sync WORKSPACE [LOCAL_DIR]",
+	Short: "Sync a local directory into a project",
+	Long: `Mirror a local directory into a project and keep it in sync while the command runs, so that the project can be edited with local tools.
+Local changes are synced as soon as they are saved and only the changed parts of files are transferred over SSH. Files that only exist in the project are kept until they are removed locally.
+With --bidirectional, changes made in the project are synced back to the local directory as well. Local changes win if a file was changed on both sides.`,
+	Example: `  daytona sync my-workspace
+  daytona sync my-workspace ./web --path web --bidirectional`,
+	Args:    cobra.RangeArgs(1, 2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		localDir := "."
+		if len(args) == 2 {
+			localDir = args[1]
+		}
+
+		localDir, err := filepath.Abs(localDir)
+		if err != nil {
+			return err
+		}
+
+		info, err := os.Stat(localDir)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("%s is not a directory", localDir)
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		projectName, err := apiclient_util.GetFirstWorkspaceProjectName(workspace.Id, syncProjectFlag, &activeProfile)
+		if err != nil {
+			return err
+		}
+
+		if !workspace_util.IsProjectRunning(workspace, projectName) {
+			started, err := AutoStartWorkspace(workspace.Name, projectName)
+			if err != nil {
+				return err
+			}
+			if !started {
+				return nil
+			}
+		}
+
+		// Resolve relative project paths against the project directory
+		remoteDir := syncPathFlag
+		if !path.IsAbs(remoteDir) {
+			projectDir, res, err := apiClient.WorkspaceToolboxAPI.GetProjectDir(ctx, workspace.Id, projectName).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			remoteDir = path.Join(projectDir.GetDir(), remoteDir)
+		}
+
+		client, err := util.GetProjectSshClient(activeProfile.Id, workspace.Id, projectName)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		syncSession, err := client.StartSync(remoteDir)
+		if err != nil {
+			return err
+		}
+		defer syncSession.Close()
+
+		initialChanges := 0
+		initialSyncDone := false
+
+		syncer := filesync.NewSyncer(filesync.SyncConfig{
+			LocalDir:       localDir,
+			Client:         syncSession.Client,
+			Excludes:       syncExcludeFlag,
+			Bidirectional:  syncBidirectionalFlag,
+			RemoteInterval: syncRemoteInterval,
+			OnChange: func(change filesync.Change) {
+				if !initialSyncDone {
+					initialChanges++
+					return
+				}
+				renderSyncChange(change)
+			},
+			OnError: func(path string, err error) {
+				if path != "" {
+					err = fmt.Errorf("%s: %w", path, err)
+				}
+				fmt.Println(" " + views.InactiveStyle.Render(fmt.Sprintf("Failed to sync %s", err)))
+			},
+		})
+
+		err = views_util.WithInlineSpinner("Syncing files", syncer.InitialSync)
+		if err != nil {
+			return handleSyncError(err, syncSession.Stderr())
+		}
+		initialSyncDone = true
+
+		views.RenderInfoMessage(fmt.Sprintf("Synced %d files between %s and %s:%s", initialChanges, localDir, workspace.Name, remoteDir))
+		views.RenderTip("Watching for changes. Press Ctrl+C to stop.")
+
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+
+		err = syncer.Watch(ctx)
+		if err != nil {
+			return handleSyncError(err, syncSession.Stderr())
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	SyncCmd.Flags().StringVarP(&syncProjectFlag, "project", "p", "", "Project to sync to in a multi-project workspace")
+	SyncCmd.Flags().StringVar(&syncPathFlag, "path", "", "Directory in the project to sync to. Relative paths are resolved against the project directory")
+	SyncCmd.Flags().BoolVarP(&syncBidirectionalFlag, "bidirectional", "b", false, "Sync changes made in the project back to the local directory")
+	SyncCmd.Flags().StringSliceVar(&syncExcludeFlag, "exclude", []string{".git"}, "Names or paths relative to the synced directory to exclude. Supports wildcards")
+	SyncCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+}
+
+func renderSyncChange(change filesync.Change) {
+	direction := "→"
+	if change.Direction == filesync.DirectionPull {
+		direction = "←"
+	}
+
+	message := fmt.Sprintf("%s %s %s", time.Now().Format(time.TimeOnly), direction, change.Path)
+	if change.Removed {
+		message += " (removed)"
+	}
+
+	fmt.Println(" " + message)
+}
+
+// handleSyncError adds the output of the sync server in the project to connection errors
+func handleSyncError(err error, stderr string) error {
+	if errors.Is(err, filesync.ErrDisconnected) && stderr != "" {
+		return fmt.Errorf("%w: %s", err, stderr)
+	}
+
+	return err
+}
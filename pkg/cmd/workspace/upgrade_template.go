@@ -0,0 +1,154 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var upgradeProjectFlag string
+var upgradeYesFlag bool
+
+var UpgradeTemplateCmd = &cobra.Command{
+	Use:     "upgrade-template [WORKSPACE]",
+	Short:   "Rebuild workspace projects with the latest version of their project config",
+	Long:    "Rebuild the projects of a workspace that were created from an older version of their project config.\nProjects are recreated from scratch so uncommitted and unpushed changes are lost.",
+	Args:    cobra.RangeArgs(0, 1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		var ws *apiclient.WorkspaceDTO
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			outdatedProjects, _, err := workspace_util.GetOutdatedProjects(ctx, apiClient, workspaceList)
+			if err != nil {
+				return err
+			}
+
+			outdatedWorkspaces := []apiclient.WorkspaceDTO{}
+			for _, workspace := range workspaceList {
+				if len(outdatedProjects[workspace.Name]) > 0 {
+					outdatedWorkspaces = append(outdatedWorkspaces, workspace)
+				}
+			}
+
+			if len(outdatedWorkspaces) == 0 {
+				views.RenderInfoMessage("All workspaces use the latest version of their project configs")
+				return nil
+			}
+
+			ws = selection.GetWorkspaceFromPrompt(outdatedWorkspaces, "Upgrade")
+			if ws == nil {
+				return nil
+			}
+		} else {
+			ws, err = apiclient_util.GetWorkspace(args[0], false)
+			if err != nil {
+				return err
+			}
+		}
+
+		outdatedProjects, latestVersions, err := workspace_util.GetOutdatedProjects(ctx, apiClient, []apiclient.WorkspaceDTO{*ws})
+		if err != nil {
+			return err
+		}
+
+		projects := []apiclient.Project{}
+		for _, project := range outdatedProjects[ws.Name] {
+			if upgradeProjectFlag == "" || project.Name == upgradeProjectFlag {
+				projects = append(projects, project)
+			}
+		}
+
+		if len(projects) == 0 {
+			views.RenderInfoMessage(i18n.T("Workspace '%s' uses the latest version of its project configs", ws.Name))
+			return nil
+		}
+
+		lines := []string{}
+		for _, project := range projects {
+			lines = append(lines, fmt.Sprintf("- %s: %s v%d -> v%d", project.Name, project.GetProjectConfigName(), project.GetProjectConfigVersion(), latestVersions[project.GetProjectConfigName()]))
+		}
+
+		if !upgradeYesFlag {
+			description := fmt.Sprintf("The following projects will be rebuilt:\n%s\n\nUncommitted and unpushed changes in these projects will be lost.", strings.Join(lines, "\n"))
+
+			changesSummary, err := getChangesSummary(ctx, apiClient, []*apiclient.WorkspaceDTO{ws}, upgradeProjectFlag)
+			if err != nil {
+				return err
+			}
+			if changesSummary != "" {
+				description += "\n\n" + changesSummary
+			}
+
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(i18n.T("Upgrade workspace '%s'?", ws.Name)).
+						Description(description).
+						Value(&upgradeYesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err = form.Run()
+			if err != nil {
+				return err
+			}
+		}
+
+		if !upgradeYesFlag {
+			fmt.Println(i18n.T("Operation canceled."))
+			return nil
+		}
+
+		for _, project := range projects {
+			err = views_util.WithInlineSpinner(i18n.T("Upgrading project '%s'", project.Name), func() error {
+				res, err := apiClient.WorkspaceAPI.UpgradeProjectConfig(ctx, ws.Id, project.Name).Execute()
+				if err != nil {
+					return apiclient_util.HandleErrorResponse(res, err)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			views.RenderInfoMessage(i18n.T("Project '%s' upgraded to version %d of project config '%s'", project.Name, latestVersions[project.GetProjectConfigName()], project.GetProjectConfigName()))
+		}
+
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	UpgradeTemplateCmd.Flags().StringVarP(&upgradeProjectFlag, "project", "p", "", "Upgrade a single project in the workspace (project name)")
+	UpgradeTemplateCmd.Flags().BoolVarP(&upgradeYesFlag, "yes", "y", false, "Confirm the upgrade without prompt")
+	UpgradeTemplateCmd.Flags().BoolVar(&ignoreDirtyFlag, "ignore-dirty", false, ignoreDirtyFlagDescription)
+}
@@ -43,10 +43,14 @@ func AddProjectFromConfig(projectConfig *apiclient.ProjectConfig, apiClient *api
 		Source: apiclient.CreateProjectSourceDTO{
 			Repository: *configRepo,
 		},
-		BuildConfig: projectConfig.BuildConfig,
-		Image:       &projectConfig.Image,
-		User:        &projectConfig.User,
-		EnvVars:     projectConfig.EnvVars,
+		BuildConfig:  projectConfig.BuildConfig,
+		Image:        &projectConfig.Image,
+		User:         &projectConfig.User,
+		EnvVars:      projectConfig.EnvVars,
+		ForwardPorts: projectConfig.ForwardPorts,
+		// Recorded so that the project can later be upgraded to newer versions of the project config
+		ProjectConfigName:    &projectConfig.Name,
+		ProjectConfigVersion: projectConfig.Version,
 	}
 	*projects = append(*projects, *project)
 
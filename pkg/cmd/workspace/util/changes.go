@@ -0,0 +1,109 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// ProjectChanges describes the work in a project that hasn't been pushed to the Git provider
+type ProjectChanges struct {
+	WorkspaceName string
+	ProjectName   string
+	// Number of files with uncommitted changes
+	UncommittedFiles int
+	// Number of local commits that haven't been pushed
+	UnpushedCommits int32
+	// Set if the current branch doesn't exist on the remote
+	UnpublishedBranch string
+	// Set if the agent couldn't be reached and the last status reported by the project was used
+	Stale bool
+}
+
+func (c ProjectChanges) HasChanges() bool {
+	return c.UncommittedFiles > 0 || c.UnpushedCommits > 0 || c.UnpublishedBranch != ""
+}
+
+func (c ProjectChanges) String() string {
+	changes := []string{}
+	if c.UncommittedFiles > 0 {
+		changes = append(changes, fmt.Sprintf("%d uncommitted file(s)", c.UncommittedFiles))
+	}
+	if c.UnpushedCommits > 0 {
+		changes = append(changes, fmt.Sprintf("%d unpushed commit(s)", c.UnpushedCommits))
+	}
+	if c.UnpublishedBranch != "" {
+		changes = append(changes, fmt.Sprintf("unpublished branch %s", c.UnpublishedBranch))
+	}
+
+	result := fmt.Sprintf("%s/%s: %s", c.WorkspaceName, c.ProjectName, strings.Join(changes, ", "))
+	if c.Stale {
+		result += " (last known status)"
+	}
+
+	return result
+}
+
+// GetProjectChanges returns the projects of the workspace that have uncommitted or unpushed changes.
+// The agent of running projects is asked for the current Git status and the last reported status is
+// used for the others. If projectName is set, only that project is checked
+func GetProjectChanges(ctx context.Context, apiClient *apiclient.APIClient, workspace *apiclient.WorkspaceDTO, projectName string) []ProjectChanges {
+	result := []ProjectChanges{}
+
+	for _, project := range workspace.Projects {
+		if projectName != "" && project.Name != projectName {
+			continue
+		}
+
+		gitStatus := getProjectGitStatus(ctx, apiClient, workspace.Id, project)
+		stale := gitStatus == nil
+		if gitStatus == nil && project.State != nil {
+			gitStatus = project.State.GitStatus
+		}
+
+		if gitStatus == nil {
+			continue
+		}
+
+		changes := ProjectChanges{
+			WorkspaceName:    workspace.Name,
+			ProjectName:      project.Name,
+			UncommittedFiles: len(gitStatus.FileStatus),
+			UnpushedCommits:  gitStatus.GetAhead(),
+			Stale:            stale,
+		}
+
+		if gitStatus.BranchPublished != nil && !*gitStatus.BranchPublished {
+			changes.UnpublishedBranch = gitStatus.CurrentBranch
+		}
+
+		if changes.HasChanges() {
+			result = append(result, changes)
+		}
+	}
+
+	return result
+}
+
+func getProjectGitStatus(ctx context.Context, apiClient *apiclient.APIClient, workspaceId string, project apiclient.Project) *apiclient.GitStatus {
+	if project.State == nil || project.State.Uptime == 0 {
+		return nil
+	}
+
+	projectDir, _, err := apiClient.WorkspaceToolboxAPI.GetProjectDir(ctx, workspaceId, project.Name).Execute()
+	if err != nil || projectDir.Dir == nil {
+		return nil
+	}
+
+	gitStatus, _, err := apiClient.WorkspaceToolboxAPI.GitGitStatus(ctx, workspaceId, project.Name).Path(*projectDir.Dir).Execute()
+	if err != nil {
+		return nil
+	}
+
+	return gitStatus
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"context"
+	"net/url"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/go-git/go-git/v5"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetProjectsFromDefinition returns the create DTOs of the projects declared in a workspace definition
+func GetProjectsFromDefinition(ctx context.Context, apiClient *apiclient.APIClient, definition *config.WorkspaceDefinition) ([]apiclient.CreateProjectDTO, error) {
+	projects := []apiclient.CreateProjectDTO{}
+
+	for _, projectDefinition := range definition.Projects {
+		repoContext := apiclient.GetRepositoryContext{
+			Url: projectDefinition.Repository,
+		}
+		if projectDefinition.Branch != "" {
+			repoContext.Branch = &projectDefinition.Branch
+		}
+
+		repo, res, err := apiClient.GitProviderAPI.GetGitContext(ctx).Repository(repoContext).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		projectName := projectDefinition.Name
+		if projectName == "" {
+			projectName = repo.Name
+		}
+
+		projectName, err = GetSanitizedProjectName(projectName)
+		if err != nil {
+			return nil, err
+		}
+
+		gitProviderConfigId, err := getGitProviderConfigIdForUrl(ctx, apiClient, projectDefinition.Repository)
+		if err != nil {
+			return nil, err
+		}
+
+		envVars := projectDefinition.Env
+		if envVars == nil {
+			envVars = map[string]string{}
+		}
+
+		project := apiclient.CreateProjectDTO{
+			Name:                projectName,
+			GitProviderConfigId: gitProviderConfigId,
+			Source: apiclient.CreateProjectSourceDTO{
+				Repository: *repo,
+			},
+			EnvVars:           envVars,
+			BuildConfig:       &apiclient.BuildConfig{},
+			OnCreateCommands:  projectDefinition.OnCreate,
+			PostStartCommands: projectDefinition.PostStart,
+		}
+
+		if projectDefinition.Devcontainer != "" {
+			project.BuildConfig.Devcontainer = &apiclient.DevcontainerConfig{
+				FilePath: projectDefinition.Devcontainer,
+			}
+		}
+
+		if projectDefinition.Image != "" {
+			project.BuildConfig = nil
+			project.Image = &projectDefinition.Image
+			project.User = &projectDefinition.User
+		}
+
+		for _, port := range projectDefinition.Ports {
+			project.ForwardPorts = append(project.ForwardPorts, int32(port))
+		}
+
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+// getGitProviderConfigIdForUrl returns the git provider config that matches the repository URL.
+// The user is prompted to choose one if multiple configs match
+func getGitProviderConfigIdForUrl(ctx context.Context, apiClient *apiclient.APIClient, repoUrl string) (*string, error) {
+	gitProviderConfigs, res, err := apiClient.GitProviderAPI.ListGitProvidersForUrl(ctx, url.QueryEscape(repoUrl)).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(gitProviderConfigs) == 0 {
+		return nil, nil
+	}
+
+	if len(gitProviderConfigs) == 1 {
+		return &gitProviderConfigs[0].Id, nil
+	}
+
+	gp := selection.GetGitProviderConfigFromPrompt(selection.GetGitProviderConfigParams{
+		GitProviderConfigs: gitProviderConfigs,
+		ActionVerb:         "Use",
+	})
+	if gp == nil {
+		return nil, common.ErrCtrlCAbort
+	}
+
+	return &gp.Id, nil
+}
+
+// GetWorkspaceOrigin returns the origin recorded for workspaces created from the definition, including the
+// commit of the git repository that contains the definition file
+func GetWorkspaceOrigin(definition *config.WorkspaceDefinition) *apiclient.WorkspaceOrigin {
+	origin := &apiclient.WorkspaceOrigin{
+		DefinitionFile:   definition.Path,
+		DefinitionSha256: definition.Sha256,
+	}
+
+	repo, err := git.PlainOpenWithOptions(filepath.Dir(definition.Path), &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return origin
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		log.Debug(err)
+		return origin
+	}
+
+	commit := head.Hash().String()
+	origin.DefinitionCommit = &commit
+
+	return origin
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"os"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/docker/docker/client"
+)
+
+// GetDockerHostConfig returns the Docker daemon the CLI should use for the profile.
+// The default profile falls back to the Docker host of the local server so that both reach the same containers
+func GetDockerHostConfig(profile config.Profile) docker.DockerHostConfig {
+	dockerHostConfig := docker.DockerHostConfig{
+		Host:    profile.DockerHost,
+		Context: profile.DockerContext,
+	}
+
+	if !dockerHostConfig.IsEmpty() || profile.Id != "default" {
+		return dockerHostConfig
+	}
+
+	// Avoid creating a server config on machines that only run the CLI
+	configFilePath, err := server.GetConfigFilePath()
+	if err != nil {
+		return dockerHostConfig
+	}
+
+	_, err = os.Stat(configFilePath)
+	if err != nil {
+		return dockerHostConfig
+	}
+
+	serverConfig, err := server.GetConfig()
+	if err != nil {
+		return dockerHostConfig
+	}
+
+	return docker.DockerHostConfig{
+		Host:    serverConfig.DockerHost,
+		Context: serverConfig.DockerContext,
+	}
+}
+
+// GetDockerApiClient creates a Docker API client for the daemon configured for the profile
+func GetDockerApiClient(profile config.Profile) (client.APIClient, error) {
+	return docker.NewApiClient(GetDockerHostConfig(profile))
+}
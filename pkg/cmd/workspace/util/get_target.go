@@ -15,6 +15,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	provider_view "github.com/daytonaio/daytona/pkg/views/provider"
 	target_view "github.com/daytonaio/daytona/pkg/views/target"
+	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 type GetTargetConfig struct {
@@ -23,10 +24,18 @@ type GetTargetConfig struct {
 	TargetList        []apiclient.ProviderTarget
 	ActiveProfileName string
 	TargetNameFlag    string
-	PromptUsingTUI    bool
+	// Target used if TargetNameFlag is empty, e.g. the default target of the active profile
+	ProfileDefaultTarget string
+	PromptUsingTUI       bool
+	// Labels the target must have. Only matching targets are offered
+	Placement map[string]string
 }
 
 func GetTarget(config GetTargetConfig) (*target_view.TargetView, error) {
+	if len(config.Placement) > 0 {
+		return getPlacementTarget(config)
+	}
+
 	if config.TargetNameFlag != "" {
 		for _, t := range config.TargetList {
 			if t.Name == config.TargetNameFlag {
@@ -36,6 +45,15 @@ func GetTarget(config GetTargetConfig) (*target_view.TargetView, error) {
 		return nil, fmt.Errorf("target '%s' not found", config.TargetNameFlag)
 	}
 
+	if config.ProfileDefaultTarget != "" {
+		for _, t := range config.TargetList {
+			if t.Name == config.ProfileDefaultTarget {
+				return util.Pointer(target_view.GetTargetViewFromTarget(t)), nil
+			}
+		}
+		return nil, fmt.Errorf("default target '%s' of profile '%s' not found. Run 'daytona profile edit --default-target' to change it", config.ProfileDefaultTarget, config.ActiveProfileName)
+	}
+
 	if !config.PromptUsingTUI {
 		for _, t := range config.TargetList {
 			if t.IsDefault {
@@ -120,3 +138,41 @@ func GetTarget(config GetTargetConfig) (*target_view.TargetView, error) {
 
 	return selectedTarget, nil
 }
+
+// getPlacementTarget selects a target whose labels match the placement constraints. New targets
+// can't be added from the prompt because they have no labels yet
+func getPlacementTarget(config GetTargetConfig) (*target_view.TargetView, error) {
+	targets := []apiclient.ProviderTarget{}
+	for _, t := range config.TargetList {
+		if workspace.MatchLabels(t.Labels, config.Placement) {
+			targets = append(targets, t)
+		}
+	}
+
+	if config.TargetNameFlag != "" {
+		for _, t := range targets {
+			if t.Name == config.TargetNameFlag {
+				return util.Pointer(target_view.GetTargetViewFromTarget(t)), nil
+			}
+		}
+		return nil, fmt.Errorf("target '%s' not found or does not satisfy the placement constraints", config.TargetNameFlag)
+	}
+
+	if len(targets) == 0 {
+		return nil, errors.New("no target satisfies the placement constraints. Use 'daytona target set --label' to label targets")
+	}
+
+	if len(targets) == 1 {
+		return util.Pointer(target_view.GetTargetViewFromTarget(targets[0])), nil
+	}
+
+	if !config.PromptUsingTUI {
+		for _, t := range targets {
+			if t.IsDefault {
+				return util.Pointer(target_view.GetTargetViewFromTarget(t)), nil
+			}
+		}
+	}
+
+	return target_view.GetTargetFromPrompt(targets, config.ActiveProfileName, nil, false, "Use")
+}
@@ -0,0 +1,31 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// PinHostKeys pins the SSH host keys the project agents reported to the server so that SSH clients verify them.
+// Projects that haven't reported a host key yet are pinned on the first connection.
+// Keys are not pinned for profiles with end-to-end encryption since they don't trust the server
+func PinHostKeys(profile config.Profile, workspaceId string, projects []apiclient.Project) {
+	if profile.E2EEncryption {
+		return
+	}
+
+	for _, project := range projects {
+		if project.State == nil || project.State.GetHostKey() == "" {
+			continue
+		}
+
+		err := config.PinProjectHostKey(profile.Id, workspaceId, project.Name, project.State.GetHostKey())
+		if err != nil {
+			log.Warnf("failed to pin the SSH host key of project %s: %v", project.Name, err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// GetOutdatedProjects returns the projects of the workspaces that were created from an older version of their project config.
+// The result is keyed by workspace name and holds the outdated projects of the workspace
+func GetOutdatedProjects(ctx context.Context, apiClient *apiclient.APIClient, workspaceList []apiclient.WorkspaceDTO) (map[string][]apiclient.Project, map[string]int32, error) {
+	projectConfigs, res, err := apiClient.ProjectConfigAPI.ListProjectConfigs(ctx).Execute()
+	if err != nil {
+		return nil, nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	latestVersions := map[string]int32{}
+	for _, projectConfig := range projectConfigs {
+		latestVersions[projectConfig.Name] = projectConfig.GetVersion()
+	}
+
+	outdatedProjects := map[string][]apiclient.Project{}
+	for _, workspace := range workspaceList {
+		for _, project := range workspace.Projects {
+			latestVersion, ok := latestVersions[project.GetProjectConfigName()]
+			if !ok || project.GetProjectConfigVersion() >= latestVersion {
+				continue
+			}
+			outdatedProjects[workspace.Name] = append(outdatedProjects[workspace.Name], project)
+		}
+	}
+
+	return outdatedProjects, latestVersions, nil
+}
@@ -31,7 +31,7 @@ func isGitProviderWithUnsupportedPagination(providerId string) bool {
 
 func gitProviderAppendsPersonalNamespace(providerId string) bool {
 	switch providerId {
-	case "github", "gitlab", "gitea":
+	case "github", "gitlab", "gitea", "codeberg", "forgejo":
 		return true
 	default:
 		return false
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/docker/go-units"
+)
+
+// GetResourceLimitsFromFlags parses the --cpu, --memory and --disk flags. Memory and disk accept
+// sizes such as 512m or 20g, plain numbers are read as MiB of memory and GiB of disk.
+// Returns nil if no limit is set
+func GetResourceLimitsFromFlags(cpus, memory, disk string) (*apiclient.ResourceLimits, error) {
+	if cpus == "" && memory == "" && disk == "" {
+		return nil, nil
+	}
+
+	limits := &apiclient.ResourceLimits{}
+
+	if cpus != "" {
+		value, err := strconv.ParseFloat(cpus, 32)
+		if err != nil || value <= 0 {
+			return nil, fmt.Errorf("invalid CPU limit %s: must be a positive number", cpus)
+		}
+		cpuLimit := float32(value)
+		limits.Cpus = &cpuLimit
+	}
+
+	if memory != "" {
+		value, err := parseSize(memory, units.MiB)
+		if err != nil {
+			return nil, fmt.Errorf("invalid memory limit %s: %w", memory, err)
+		}
+		limits.Memory = &value
+	}
+
+	if disk != "" {
+		value, err := parseSize(disk, units.GiB)
+		if err != nil {
+			return nil, fmt.Errorf("invalid disk limit %s: %w", disk, err)
+		}
+		limits.Disk = &value
+	}
+
+	return limits, nil
+}
+
+// parseSize returns the size in multiples of unit, rounded up
+func parseSize(size string, unit int64) (int32, error) {
+	if value, err := strconv.ParseInt(size, 10, 32); err == nil {
+		if value <= 0 {
+			return 0, fmt.Errorf("must be positive")
+		}
+		return int32(value), nil
+	}
+
+	bytes, err := units.RAMInBytes(size)
+	if err != nil {
+		return 0, err
+	}
+	if bytes <= 0 {
+		return 0, fmt.Errorf("must be positive")
+	}
+
+	value := int64(math.Ceil(float64(bytes) / float64(unit)))
+	if value > math.MaxInt32 {
+		return 0, fmt.Errorf("too large")
+	}
+
+	return int32(value), nil
+}
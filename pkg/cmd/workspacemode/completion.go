@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspacemode
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/spf13/cobra"
+)
+
+func getProjectEnvVars() (map[string]string, error) {
+	workspace, err := apiclient_util.GetWorkspace(workspaceId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range workspace.Projects {
+		if project.Name == projectName {
+			return project.EnvVars, nil
+		}
+	}
+
+	return nil, errors.New("project not found in workspace")
+}
+
+func getListeningPortCompletions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	listeningPorts, err := ports.GetListeningPorts()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var choices []string
+	for _, port := range listeningPorts {
+		choices = append(choices, fmt.Sprint(port))
+	}
+
+	return choices, cobra.ShellCompDirectiveNoFileComp
+}
+
+func getEnvVarNameCompletions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	envVars, err := getProjectEnvVars()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var choices []string
+	for name := range envVars {
+		choices = append(choices, name)
+	}
+	slices.Sort(choices)
+
+	return choices, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspacemode
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var envCmd = &cobra.Command{
+	Use:               "env [NAME]",
+	Short:             "Print the project environment variables set by Daytona",
+	Args:              cobra.MaximumNArgs(1),
+	GroupID:           util.WORKSPACE_GROUP,
+	ValidArgsFunction: getEnvVarNameCompletions,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		envVars, err := getProjectEnvVars()
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 1 {
+			value, ok := envVars[args[0]]
+			if !ok {
+				return fmt.Errorf("environment variable %s is not set for the project", args[0])
+			}
+
+			fmt.Println(value)
+			return nil
+		}
+
+		names := []string{}
+		for name := range envVars {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+
+		for _, name := range names {
+			fmt.Printf("%s=%s\n", name, envVars[name])
+		}
+
+		return nil
+	},
+}
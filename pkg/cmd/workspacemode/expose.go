@@ -16,10 +16,11 @@ import (
 )
 
 var exposeCmd = &cobra.Command{
-	Use:     "expose [PORT]",
-	Short:   "Expose a local port over stdout - Used by the Daytona CLI to make direct connections to the project",
-	Args:    cobra.ExactArgs(1),
-	GroupID: util.WORKSPACE_GROUP,
+	Use:               "expose [PORT]",
+	Short:             "Expose a local port over stdout - Used by the Daytona CLI to make direct connections to the project",
+	Args:              cobra.ExactArgs(1),
+	GroupID:           util.WORKSPACE_GROUP,
+	ValidArgsFunction: getListeningPortCompletions,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		port, err := strconv.Atoi(args[0])
 		if err != nil {
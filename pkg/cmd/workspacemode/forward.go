@@ -12,20 +12,29 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var publicPortFlags defaultPortForwardCmd.PublicPortFlags
+
 var portForwardCmd = &cobra.Command{
-	Use:     "forward [PORT]",
-	Short:   "Forward a port publicly via an URL",
-	Args:    cobra.ExactArgs(1),
-	GroupID: util.WORKSPACE_GROUP,
+	Use:               "forward [PORT]",
+	Short:             "Forward a port publicly via an URL",
+	Args:              cobra.ExactArgs(1),
+	GroupID:           util.WORKSPACE_GROUP,
+	ValidArgsFunction: getListeningPortCompletions,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		port, err := strconv.Atoi(args[0])
 		if err != nil {
 			return err
 		}
 
+		// Project ports are local so the routes don't need to be forwarded
+		publicPortOptions, err := publicPortFlags.ToOptions()
+		if err != nil {
+			return err
+		}
+
 		errChan := make(chan error)
 		go func() {
-			errChan <- defaultPortForwardCmd.ForwardPublicPort(workspaceId, projectName, uint16(port), uint16(port))
+			errChan <- defaultPortForwardCmd.ForwardPublicPort(workspaceId, projectName, uint16(port), uint16(port), publicPortOptions)
 		}()
 
 		for {
@@ -36,3 +45,7 @@ var portForwardCmd = &cobra.Command{
 		}
 	},
 }
+
+func init() {
+	defaultPortForwardCmd.AddPublicPortFlags(portForwardCmd, &publicPortFlags)
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspacemode
+
+import (
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/filesync"
+	"github.com/spf13/cobra"
+)
+
+// syncServerCmd serves the requests of daytona sync over the SSH session that started it
+var syncServerCmd = &cobra.Command{
+	Use:    "sync-server DIR",
+	Args:   cobra.ExactArgs(1),
+	Hidden: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return filesync.Serve(args[0], os.Stdin, os.Stdout)
+	},
+}
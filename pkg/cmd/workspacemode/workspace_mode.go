@@ -34,6 +34,7 @@ func Execute() error {
 	cmd.SetupRootCommand(workspaceModeRootCmd)
 	workspaceModeRootCmd.AddGroup(&cobra.Group{ID: util.WORKSPACE_GROUP, Title: "Project & Workspace"})
 	workspaceModeRootCmd.AddCommand(gitCredCmd)
+	workspaceModeRootCmd.AddCommand(syncServerCmd)
 	workspaceModeRootCmd.AddCommand(AgentCmd)
 	workspaceModeRootCmd.AddCommand(startCmd)
 	workspaceModeRootCmd.AddCommand(stopCmd)
@@ -41,6 +42,7 @@ func Execute() error {
 	workspaceModeRootCmd.AddCommand(infoCmd)
 	workspaceModeRootCmd.AddCommand(portForwardCmd)
 	workspaceModeRootCmd.AddCommand(exposeCmd)
+	workspaceModeRootCmd.AddCommand(envCmd)
 
 	clientId := config.GetClientId()
 	telemetryEnabled := config.TelemetryEnabled()
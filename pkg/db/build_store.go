@@ -128,6 +128,12 @@ func processBuildFilters(tx *gorm.DB, filter *build.Filter) *gorm.DB {
 		if filter.Branch != nil {
 			tx = tx.Where("json_extract(repository, '$.branch') = ?", *filter.Branch)
 		}
+		if filter.CacheNamespaces != nil && len(*filter.CacheNamespaces) > 0 {
+			placeholders := strings.Repeat("?,", len(*filter.CacheNamespaces))
+			placeholders = placeholders[:len(placeholders)-1]
+
+			tx = tx.Where(fmt.Sprintf("cache_namespace IN (%s)", placeholders), stringsToInterface(*filter.CacheNamespaces)...)
+		}
 		if filter.EnvVars != nil && len(*filter.EnvVars) > 0 {
 			envVarsJSON, err := json.Marshal(filter.EnvVars)
 			if err == nil {
@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/timings"
+)
+
+type CreationTimingStore struct {
+	db *gorm.DB
+}
+
+func NewCreationTimingStore(db *gorm.DB) (*CreationTimingStore, error) {
+	err := db.AutoMigrate(&CreationTimingDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreationTimingStore{db: db}, nil
+}
+
+func (s *CreationTimingStore) List(filter *timings.Filter) ([]*timings.CreationTiming, error) {
+	timingDTOs := []CreationTimingDTO{}
+	tx := processCreationTimingFilters(s.db, filter).Order("created_at desc").Find(&timingDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	creationTimings := []*timings.CreationTiming{}
+	for _, timingDTO := range timingDTOs {
+		creationTimings = append(creationTimings, ToCreationTiming(timingDTO))
+	}
+
+	return creationTimings, nil
+}
+
+func (s *CreationTimingStore) Save(timing *timings.CreationTiming) error {
+	tx := s.db.Save(ToCreationTimingDTO(timing))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processCreationTimingFilters(tx *gorm.DB, filter *timings.Filter) *gorm.DB {
+	if filter != nil {
+		if filter.WorkspaceId != nil {
+			tx = tx.Where("workspace_id = ?", *filter.WorkspaceId)
+		}
+		if filter.Target != nil {
+			tx = tx.Where("target = ?", *filter.Target)
+		}
+	}
+
+	return tx
+}
@@ -4,27 +4,41 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/apikey"
 )
 
 type ApiKeyDTO struct {
-	KeyHash string `gorm:"primaryKey"`
-	Type    apikey.ApiKeyType
-	Name    string `gorm:"uniqueIndex"`
+	KeyHash     string `gorm:"primaryKey"`
+	Type        apikey.ApiKeyType
+	Name        string `gorm:"uniqueIndex"`
+	ReadOnly    bool
+	Admin       bool
+	WorkspaceId string
+	ExpiresAt   *time.Time
 }
 
 func ToApiKeyDTO(apiKey apikey.ApiKey) ApiKeyDTO {
 	return ApiKeyDTO{
-		KeyHash: apiKey.KeyHash,
-		Type:    apiKey.Type,
-		Name:    apiKey.Name,
+		KeyHash:     apiKey.KeyHash,
+		Type:        apiKey.Type,
+		Name:        apiKey.Name,
+		ReadOnly:    apiKey.ReadOnly,
+		Admin:       apiKey.Admin,
+		WorkspaceId: apiKey.WorkspaceId,
+		ExpiresAt:   apiKey.ExpiresAt,
 	}
 }
 
 func ToApiKey(apiKeyDTO ApiKeyDTO) apikey.ApiKey {
 	return apikey.ApiKey{
-		KeyHash: apiKeyDTO.KeyHash,
-		Type:    apiKeyDTO.Type,
-		Name:    apiKeyDTO.Name,
+		KeyHash:     apiKeyDTO.KeyHash,
+		Type:        apiKeyDTO.Type,
+		Name:        apiKeyDTO.Name,
+		ReadOnly:    apiKeyDTO.ReadOnly,
+		Admin:       apiKeyDTO.Admin,
+		WorkspaceId: apiKeyDTO.WorkspaceId,
+		ExpiresAt:   apiKeyDTO.ExpiresAt,
 	}
 }
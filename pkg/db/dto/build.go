@@ -20,6 +20,7 @@ type BuildDTO struct {
 	Repository      RepositoryDTO                   `gorm:"serializer:json"`
 	EnvVars         map[string]string               `json:"envVars" gorm:"serializer:json"`
 	PrebuildId      string                          `json:"prebuildId"`
+	CacheNamespace  string                          `json:"cacheNamespace"`
 	CreatedAt       time.Time                       `json:"createdAt"`
 	UpdatedAt       time.Time                       `json:"updatedAt"`
 }
@@ -35,6 +36,7 @@ func ToBuildDTO(build *build.Build) BuildDTO {
 		Repository:      ToRepositoryDTO(build.Repository),
 		EnvVars:         build.EnvVars,
 		PrebuildId:      build.PrebuildId,
+		CacheNamespace:  build.CacheNamespace,
 		CreatedAt:       build.CreatedAt,
 		UpdatedAt:       build.UpdatedAt,
 	}
@@ -51,6 +53,7 @@ func ToBuild(buildDTO BuildDTO) *build.Build {
 		Repository:      ToRepository(buildDTO.Repository),
 		EnvVars:         buildDTO.EnvVars,
 		PrebuildId:      buildDTO.PrebuildId,
+		CacheNamespace:  buildDTO.CacheNamespace,
 		CreatedAt:       buildDTO.CreatedAt,
 		UpdatedAt:       buildDTO.UpdatedAt,
 	}
@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/timings"
+)
+
+type CreationTimingDTO struct {
+	Id            string    `json:"id" gorm:"primaryKey"`
+	WorkspaceId   string    `json:"workspaceId"`
+	WorkspaceName string    `json:"workspaceName"`
+	ProjectName   string    `json:"projectName"`
+	Target        string    `json:"target"`
+	RepositoryUrl string    `json:"repositoryUrl"`
+	Image         string    `json:"image"`
+	Prebuilt      bool      `json:"prebuilt"`
+	QueueWait     int64     `json:"queueWait"`
+	Pull          int64     `json:"pull"`
+	Clone         int64     `json:"clone"`
+	Build         int64     `json:"build"`
+	Hooks         int64     `json:"hooks"`
+	Total         int64     `json:"total"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+func ToCreationTimingDTO(timing *timings.CreationTiming) CreationTimingDTO {
+	return CreationTimingDTO{
+		Id:            timing.Id,
+		WorkspaceId:   timing.WorkspaceId,
+		WorkspaceName: timing.WorkspaceName,
+		ProjectName:   timing.ProjectName,
+		Target:        timing.Target,
+		RepositoryUrl: timing.RepositoryUrl,
+		Image:         timing.Image,
+		Prebuilt:      timing.Prebuilt,
+		QueueWait:     timing.QueueWait,
+		Pull:          timing.Pull,
+		Clone:         timing.Clone,
+		Build:         timing.Build,
+		Hooks:         timing.Hooks,
+		Total:         timing.Total,
+		CreatedAt:     timing.CreatedAt,
+	}
+}
+
+func ToCreationTiming(timingDTO CreationTimingDTO) *timings.CreationTiming {
+	return &timings.CreationTiming{
+		Id:            timingDTO.Id,
+		WorkspaceId:   timingDTO.WorkspaceId,
+		WorkspaceName: timingDTO.WorkspaceName,
+		ProjectName:   timingDTO.ProjectName,
+		Target:        timingDTO.Target,
+		RepositoryUrl: timingDTO.RepositoryUrl,
+		Image:         timingDTO.Image,
+		Prebuilt:      timingDTO.Prebuilt,
+		QueueWait:     timingDTO.QueueWait,
+		Pull:          timingDTO.Pull,
+		Clone:         timingDTO.Clone,
+		Build:         timingDTO.Build,
+		Hooks:         timingDTO.Hooks,
+		Total:         timingDTO.Total,
+		CreatedAt:     timingDTO.CreatedAt,
+	}
+}
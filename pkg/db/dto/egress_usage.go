@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/egress"
+)
+
+type EgressUsageDTO struct {
+	WorkspaceId   string    `json:"workspaceId" gorm:"primaryKey"`
+	ProjectName   string    `json:"projectName" gorm:"primaryKey"`
+	Month         string    `json:"month" gorm:"primaryKey"`
+	WorkspaceName string    `json:"workspaceName"`
+	Bytes         uint64    `json:"bytes"`
+	LastCounter   uint64    `json:"lastCounter"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+func ToEgressUsageDTO(usage *egress.Usage) EgressUsageDTO {
+	return EgressUsageDTO{
+		WorkspaceId:   usage.WorkspaceId,
+		ProjectName:   usage.ProjectName,
+		Month:         usage.Month,
+		WorkspaceName: usage.WorkspaceName,
+		Bytes:         usage.Bytes,
+		LastCounter:   usage.LastCounter,
+		UpdatedAt:     usage.UpdatedAt,
+	}
+}
+
+func ToEgressUsage(usageDTO EgressUsageDTO) *egress.Usage {
+	return &egress.Usage{
+		WorkspaceId:   usageDTO.WorkspaceId,
+		ProjectName:   usageDTO.ProjectName,
+		Month:         usageDTO.Month,
+		WorkspaceName: usageDTO.WorkspaceName,
+		Bytes:         usageDTO.Bytes,
+		LastCounter:   usageDTO.LastCounter,
+		UpdatedAt:     usageDTO.UpdatedAt,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+)
+
+type EventDTO struct {
+	Id            string    `json:"id" gorm:"primaryKey"`
+	WorkspaceId   string    `json:"workspaceId"`
+	WorkspaceName string    `json:"workspaceName"`
+	ProjectName   string    `json:"projectName"`
+	Type          string    `json:"type"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+func ToEventDTO(event *events.Event) EventDTO {
+	return EventDTO{
+		Id:            event.Id,
+		WorkspaceId:   event.WorkspaceId,
+		WorkspaceName: event.WorkspaceName,
+		ProjectName:   event.ProjectName,
+		Type:          string(event.Type),
+		CreatedAt:     event.CreatedAt,
+	}
+}
+
+func ToEvent(eventDTO EventDTO) *events.Event {
+	return &events.Event{
+		Id:            eventDTO.Id,
+		WorkspaceId:   eventDTO.WorkspaceId,
+		WorkspaceName: eventDTO.WorkspaceName,
+		ProjectName:   eventDTO.ProjectName,
+		Type:          events.EventType(eventDTO.Type),
+		CreatedAt:     eventDTO.CreatedAt,
+	}
+}
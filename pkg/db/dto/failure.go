@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/failures"
+)
+
+type FailureDTO struct {
+	Id        string    `json:"id" gorm:"primaryKey"`
+	Category  string    `json:"category"`
+	Provider  string    `json:"provider"`
+	CreatedAt time.Time `json:"createdAt" gorm:"index"`
+}
+
+func ToFailureDTO(failure *failures.Failure) FailureDTO {
+	return FailureDTO{
+		Id:        failure.Id,
+		Category:  string(failure.Category),
+		Provider:  failure.Provider,
+		CreatedAt: failure.CreatedAt,
+	}
+}
+
+func ToFailure(failureDTO FailureDTO) *failures.Failure {
+	return &failures.Failure{
+		Id:        failureDTO.Id,
+		Category:  failures.Category(failureDTO.Category),
+		Provider:  failureDTO.Provider,
+		CreatedAt: failureDTO.CreatedAt,
+	}
+}
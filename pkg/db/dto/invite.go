@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/invite"
+)
+
+type InviteDTO struct {
+	CodeHash    string `gorm:"primaryKey"`
+	WorkspaceId string `gorm:"index"`
+	ExpiresAt   time.Time
+}
+
+func ToInviteDTO(invite *invite.Invite) InviteDTO {
+	return InviteDTO{
+		CodeHash:    invite.CodeHash,
+		WorkspaceId: invite.WorkspaceId,
+		ExpiresAt:   invite.ExpiresAt,
+	}
+}
+
+func ToInvite(inviteDTO InviteDTO) *invite.Invite {
+	return &invite.Invite{
+		CodeHash:    inviteDTO.CodeHash,
+		WorkspaceId: inviteDTO.WorkspaceId,
+		ExpiresAt:   inviteDTO.ExpiresAt,
+	}
+}
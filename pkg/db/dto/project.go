@@ -41,6 +41,8 @@ type ProjectStateDTO struct {
 	UpdatedAt string        `json:"updatedAt"`
 	Uptime    uint64        `json:"uptime"`
 	GitStatus *GitStatusDTO `json:"gitStatus"`
+	IdleTime  uint64        `json:"idleTime,omitempty"`
+	HostKey   string        `json:"hostKey,omitempty"`
 }
 
 type ProjectBuildDevcontainerDTO struct {
@@ -51,31 +53,51 @@ type ProjectBuildDTO struct {
 	Devcontainer *ProjectBuildDevcontainerDTO `json:"devcontainer"`
 }
 
+type ProjectResourcesDTO struct {
+	Cpus   float32 `json:"cpus,omitempty"`
+	Memory uint32  `json:"memory,omitempty"`
+	Disk   uint32  `json:"disk,omitempty"`
+}
+
 type ProjectDTO struct {
-	Name                string           `json:"name"`
-	Image               string           `json:"image"`
-	User                string           `json:"user"`
-	Build               *ProjectBuildDTO `json:"build,omitempty" gorm:"serializer:json"`
-	Repository          RepositoryDTO    `json:"repository" gorm:"serializer:json"`
-	WorkspaceId         string           `json:"workspaceId"`
-	Target              string           `json:"target"`
-	ApiKey              string           `json:"apiKey"`
-	State               *ProjectStateDTO `json:"state,omitempty" gorm:"serializer:json"`
-	GitProviderConfigId *string          `json:"gitProviderConfigId,omitempty"`
+	Name                 string               `json:"name"`
+	Image                string               `json:"image"`
+	User                 string               `json:"user"`
+	Build                *ProjectBuildDTO     `json:"build,omitempty" gorm:"serializer:json"`
+	Repository           RepositoryDTO        `json:"repository" gorm:"serializer:json"`
+	WorkspaceId          string               `json:"workspaceId"`
+	Target               string               `json:"target"`
+	ApiKey               string               `json:"apiKey"`
+	State                *ProjectStateDTO     `json:"state,omitempty" gorm:"serializer:json"`
+	GitProviderConfigId  *string              `json:"gitProviderConfigId,omitempty"`
+	Resources            *ProjectResourcesDTO `json:"resources,omitempty" gorm:"serializer:json"`
+	ProjectConfigName    string               `json:"projectConfigName,omitempty"`
+	ProjectConfigVersion uint32               `json:"projectConfigVersion,omitempty"`
+	OnCreateCommands     []string             `json:"onCreateCommands,omitempty" gorm:"serializer:json"`
+	PostStartCommands    []string             `json:"postStartCommands,omitempty" gorm:"serializer:json"`
+	ForwardPorts         []uint16             `json:"forwardPorts,omitempty" gorm:"serializer:json"`
+	Runtimes             []string             `json:"runtimes,omitempty" gorm:"serializer:json"`
 }
 
 func ToProjectDTO(project *project.Project) ProjectDTO {
 	return ProjectDTO{
-		Name:                project.Name,
-		Image:               project.Image,
-		User:                project.User,
-		Build:               ToProjectBuildDTO(project.BuildConfig),
-		Repository:          ToRepositoryDTO(project.Repository),
-		WorkspaceId:         project.WorkspaceId,
-		Target:              project.Target,
-		State:               ToProjectStateDTO(project.State),
-		ApiKey:              project.ApiKey,
-		GitProviderConfigId: project.GitProviderConfigId,
+		Name:                 project.Name,
+		Image:                project.Image,
+		User:                 project.User,
+		Build:                ToProjectBuildDTO(project.BuildConfig),
+		Repository:           ToRepositoryDTO(project.Repository),
+		WorkspaceId:          project.WorkspaceId,
+		Target:               project.Target,
+		State:                ToProjectStateDTO(project.State),
+		ApiKey:               project.ApiKey,
+		GitProviderConfigId:  project.GitProviderConfigId,
+		Resources:            ToProjectResourcesDTO(project.Resources),
+		ProjectConfigName:    project.ProjectConfigName,
+		ProjectConfigVersion: project.ProjectConfigVersion,
+		OnCreateCommands:     project.OnCreateCommands,
+		PostStartCommands:    project.PostStartCommands,
+		ForwardPorts:         project.ForwardPorts,
+		Runtimes:             project.Runtimes,
 	}
 }
 
@@ -137,6 +159,8 @@ func ToProjectStateDTO(state *project.ProjectState) *ProjectStateDTO {
 		UpdatedAt: state.UpdatedAt,
 		Uptime:    state.Uptime,
 		GitStatus: ToGitStatusDTO(state.GitStatus),
+		IdleTime:  state.IdleTime,
+		HostKey:   state.HostKey,
 	}
 }
 
@@ -158,16 +182,23 @@ func ToProjectBuildDTO(build *buildconfig.BuildConfig) *ProjectBuildDTO {
 
 func ToProject(projectDTO ProjectDTO) *project.Project {
 	return &project.Project{
-		Name:                projectDTO.Name,
-		Image:               projectDTO.Image,
-		User:                projectDTO.User,
-		BuildConfig:         ToProjectBuild(projectDTO.Build),
-		Repository:          ToRepository(projectDTO.Repository),
-		WorkspaceId:         projectDTO.WorkspaceId,
-		Target:              projectDTO.Target,
-		State:               ToProjectState(projectDTO.State),
-		ApiKey:              projectDTO.ApiKey,
-		GitProviderConfigId: projectDTO.GitProviderConfigId,
+		Name:                 projectDTO.Name,
+		Image:                projectDTO.Image,
+		User:                 projectDTO.User,
+		BuildConfig:          ToProjectBuild(projectDTO.Build),
+		Repository:           ToRepository(projectDTO.Repository),
+		WorkspaceId:          projectDTO.WorkspaceId,
+		Target:               projectDTO.Target,
+		State:                ToProjectState(projectDTO.State),
+		ApiKey:               projectDTO.ApiKey,
+		GitProviderConfigId:  projectDTO.GitProviderConfigId,
+		Resources:            ToProjectResources(projectDTO.Resources),
+		ProjectConfigName:    projectDTO.ProjectConfigName,
+		ProjectConfigVersion: projectDTO.ProjectConfigVersion,
+		OnCreateCommands:     projectDTO.OnCreateCommands,
+		PostStartCommands:    projectDTO.PostStartCommands,
+		ForwardPorts:         projectDTO.ForwardPorts,
+		Runtimes:             projectDTO.Runtimes,
 	}
 }
 
@@ -212,6 +243,8 @@ func ToProjectState(stateDTO *ProjectStateDTO) *project.ProjectState {
 		UpdatedAt: stateDTO.UpdatedAt,
 		Uptime:    stateDTO.Uptime,
 		GitStatus: ToGitStatus(stateDTO.GitStatus),
+		IdleTime:  stateDTO.IdleTime,
+		HostKey:   stateDTO.HostKey,
 	}
 }
 
@@ -247,3 +280,27 @@ func ToProjectBuild(buildDTO *ProjectBuildDTO) *buildconfig.BuildConfig {
 		},
 	}
 }
+
+func ToProjectResourcesDTO(resources *project.ResourceLimits) *ProjectResourcesDTO {
+	if resources == nil {
+		return nil
+	}
+
+	return &ProjectResourcesDTO{
+		Cpus:   resources.Cpus,
+		Memory: resources.Memory,
+		Disk:   resources.Disk,
+	}
+}
+
+func ToProjectResources(resourcesDTO *ProjectResourcesDTO) *project.ResourceLimits {
+	if resourcesDTO == nil {
+		return nil
+	}
+
+	return &project.ResourceLimits{
+		Cpus:   resourcesDTO.Cpus,
+		Memory: resourcesDTO.Memory,
+		Disk:   resourcesDTO.Disk,
+	}
+}
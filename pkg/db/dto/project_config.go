@@ -17,6 +17,8 @@ type ProjectConfigDTO struct {
 	Prebuilds           []PrebuildDTO     `gorm:"serializer:json"`
 	IsDefault           bool              `json:"isDefault"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId" validate:"optional"`
+	ForwardPorts        []uint16          `json:"forwardPorts,omitempty" gorm:"serializer:json"`
+	Version             uint32            `json:"version"`
 }
 
 type PrebuildDTO struct {
@@ -43,6 +45,8 @@ func ToProjectConfigDTO(projectConfig *config.ProjectConfig) ProjectConfigDTO {
 		Prebuilds:           prebuilds,
 		IsDefault:           projectConfig.IsDefault,
 		GitProviderConfigId: projectConfig.GitProviderConfigId,
+		ForwardPorts:        projectConfig.ForwardPorts,
+		Version:             projectConfig.Version,
 	}
 }
 
@@ -62,6 +66,8 @@ func ToProjectConfig(projectConfigDTO ProjectConfigDTO) *config.ProjectConfig {
 		Prebuilds:           prebuilds,
 		IsDefault:           projectConfigDTO.IsDefault,
 		GitProviderConfigId: projectConfigDTO.GitProviderConfigId,
+		ForwardPorts:        projectConfigDTO.ForwardPorts,
+		Version:             projectConfigDTO.Version,
 	}
 }
 
@@ -6,12 +6,13 @@ package dto
 import "github.com/daytonaio/daytona/pkg/provider"
 
 type ProviderTargetDTO struct {
-	Name            string  `json:"name" gorm:"primaryKey"`
-	ProviderName    string  `json:"providerName"`
-	ProviderLabel   *string `json:"providerLabel,omitempty"`
-	ProviderVersion string  `json:"providerVersion"`
-	Options         string  `json:"options"`
-	IsDefault       bool    `json:"isDefault"`
+	Name            string            `json:"name" gorm:"primaryKey"`
+	ProviderName    string            `json:"providerName"`
+	ProviderLabel   *string           `json:"providerLabel,omitempty"`
+	ProviderVersion string            `json:"providerVersion"`
+	Options         string            `json:"options"`
+	IsDefault       bool              `json:"isDefault"`
+	Labels          map[string]string `json:"labels" gorm:"serializer:json"`
 }
 
 func ToProviderTargetDTO(providerTarget *provider.ProviderTarget) ProviderTargetDTO {
@@ -22,6 +23,7 @@ func ToProviderTargetDTO(providerTarget *provider.ProviderTarget) ProviderTarget
 		ProviderVersion: providerTarget.ProviderInfo.Version,
 		Options:         providerTarget.Options,
 		IsDefault:       providerTarget.IsDefault,
+		Labels:          providerTarget.Labels,
 	}
 }
 
@@ -35,5 +37,6 @@ func ToProviderTarget(providerTargetDTO ProviderTargetDTO) *provider.ProviderTar
 		},
 		Options:   providerTargetDTO.Options,
 		IsDefault: providerTargetDTO.IsDefault,
+		Labels:    providerTargetDTO.Labels,
 	}
 }
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type SecretDTO struct {
+	Name           string `gorm:"primaryKey"`
+	WorkspaceId    string `gorm:"primaryKey"`
+	ProjectName    string `gorm:"primaryKey"`
+	FilePath       string
+	FileMode       string
+	EncryptedValue []byte
+	UpdatedAt      time.Time
+}
+
+func ToSecretDTO(secret *secret.Secret) SecretDTO {
+	return SecretDTO{
+		Name:           secret.Name,
+		WorkspaceId:    secret.WorkspaceId,
+		ProjectName:    secret.ProjectName,
+		FilePath:       secret.FilePath,
+		FileMode:       secret.FileMode,
+		EncryptedValue: secret.EncryptedValue,
+		UpdatedAt:      secret.UpdatedAt,
+	}
+}
+
+func ToSecret(secretDTO SecretDTO) *secret.Secret {
+	return &secret.Secret{
+		Name:           secretDTO.Name,
+		WorkspaceId:    secretDTO.WorkspaceId,
+		ProjectName:    secretDTO.ProjectName,
+		FilePath:       secretDTO.FilePath,
+		FileMode:       secretDTO.FileMode,
+		EncryptedValue: secretDTO.EncryptedValue,
+		UpdatedAt:      secretDTO.UpdatedAt,
+	}
+}
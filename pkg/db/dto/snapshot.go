@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/snapshot"
+)
+
+type SnapshotDTO struct {
+	Id          string    `json:"id" gorm:"primaryKey"`
+	Name        string    `json:"name"`
+	WorkspaceId string    `json:"workspaceId"`
+	ProjectName string    `json:"projectName"`
+	Image       string    `json:"image"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func ToSnapshotDTO(snapshot *snapshot.Snapshot) SnapshotDTO {
+	return SnapshotDTO{
+		Id:          snapshot.Id,
+		Name:        snapshot.Name,
+		WorkspaceId: snapshot.WorkspaceId,
+		ProjectName: snapshot.ProjectName,
+		Image:       snapshot.Image,
+		CreatedAt:   snapshot.CreatedAt,
+	}
+}
+
+func ToSnapshot(snapshotDTO SnapshotDTO) *snapshot.Snapshot {
+	return &snapshot.Snapshot{
+		Id:          snapshotDTO.Id,
+		Name:        snapshotDTO.Name,
+		WorkspaceId: snapshotDTO.WorkspaceId,
+		ProjectName: snapshotDTO.ProjectName,
+		Image:       snapshotDTO.Image,
+		CreatedAt:   snapshotDTO.CreatedAt,
+	}
+}
@@ -5,16 +5,24 @@ package dto
 
 import (
 	"errors"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 type WorkspaceDTO struct {
-	Id       string       `gorm:"primaryKey"`
-	Name     string       `json:"name" gorm:"unique"`
-	Target   string       `json:"target"`
-	ApiKey   string       `json:"apiKey"`
-	Projects []ProjectDTO `gorm:"serializer:json"`
+	Id           string                     `gorm:"primaryKey"`
+	Name         string                     `json:"name" gorm:"unique"`
+	Target       string                     `json:"target"`
+	Group        string                     `json:"group"`
+	Labels       map[string]string          `json:"labels" gorm:"serializer:json"`
+	AutoStop     uint32                     `json:"autoStop"`
+	ApiKey       string                     `json:"apiKey"`
+	Projects     []ProjectDTO               `gorm:"serializer:json"`
+	Origin       *workspace.WorkspaceOrigin `json:"origin,omitempty" gorm:"serializer:json"`
+	Owner        string                     `json:"owner"`
+	SharedWith   []string                   `json:"sharedWith" gorm:"serializer:json"`
+	HibernatedAt *time.Time                 `json:"hibernatedAt"`
 }
 
 func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
@@ -29,10 +37,17 @@ func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
 
 func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 	workspaceDTO := WorkspaceDTO{
-		Id:     workspace.Id,
-		Name:   workspace.Name,
-		Target: workspace.Target,
-		ApiKey: workspace.ApiKey,
+		Id:           workspace.Id,
+		Name:         workspace.Name,
+		Target:       workspace.Target,
+		Group:        workspace.Group,
+		Labels:       workspace.Labels,
+		AutoStop:     workspace.AutoStop,
+		ApiKey:       workspace.ApiKey,
+		Origin:       workspace.Origin,
+		Owner:        workspace.Owner,
+		SharedWith:   workspace.SharedWith,
+		HibernatedAt: workspace.HibernatedAt,
 	}
 
 	for _, project := range workspace.Projects {
@@ -44,10 +59,17 @@ func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 
 func ToWorkspace(workspaceDTO WorkspaceDTO) *workspace.Workspace {
 	workspace := workspace.Workspace{
-		Id:     workspaceDTO.Id,
-		Name:   workspaceDTO.Name,
-		Target: workspaceDTO.Target,
-		ApiKey: workspaceDTO.ApiKey,
+		Id:           workspaceDTO.Id,
+		Name:         workspaceDTO.Name,
+		Target:       workspaceDTO.Target,
+		Group:        workspaceDTO.Group,
+		Labels:       workspaceDTO.Labels,
+		AutoStop:     workspaceDTO.AutoStop,
+		ApiKey:       workspaceDTO.ApiKey,
+		Origin:       workspaceDTO.Origin,
+		Owner:        workspaceDTO.Owner,
+		SharedWith:   workspaceDTO.SharedWith,
+		HibernatedAt: workspaceDTO.HibernatedAt,
 	}
 
 	for _, projectDTO := range workspaceDTO.Projects {
@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/egress"
+)
+
+type EgressUsageStore struct {
+	db *gorm.DB
+}
+
+func NewEgressUsageStore(db *gorm.DB) (*EgressUsageStore, error) {
+	err := db.AutoMigrate(&EgressUsageDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &EgressUsageStore{db: db}, nil
+}
+
+func (s *EgressUsageStore) List(filter *egress.Filter) ([]*egress.Usage, error) {
+	usageDTOs := []EgressUsageDTO{}
+	tx := processEgressUsageFilters(s.db, filter).Order("month desc").Find(&usageDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	usages := []*egress.Usage{}
+	for _, usageDTO := range usageDTOs {
+		usages = append(usages, ToEgressUsage(usageDTO))
+	}
+
+	return usages, nil
+}
+
+func (s *EgressUsageStore) Save(usage *egress.Usage) error {
+	tx := s.db.Save(ToEgressUsageDTO(usage))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processEgressUsageFilters(tx *gorm.DB, filter *egress.Filter) *gorm.DB {
+	if filter != nil {
+		if filter.WorkspaceId != nil {
+			tx = tx.Where("workspace_id = ?", *filter.WorkspaceId)
+		}
+		if filter.ProjectName != nil {
+			tx = tx.Where("project_name = ?", *filter.ProjectName)
+		}
+		if filter.Month != nil {
+			tx = tx.Where("month = ?", *filter.Month)
+		}
+	}
+
+	return tx
+}
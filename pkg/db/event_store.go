@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+)
+
+type EventStore struct {
+	db *gorm.DB
+}
+
+func NewEventStore(db *gorm.DB) (*EventStore, error) {
+	err := db.AutoMigrate(&EventDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventStore{db: db}, nil
+}
+
+func (s *EventStore) List(filter *events.Filter) ([]*events.Event, error) {
+	eventDTOs := []EventDTO{}
+	tx := processEventFilters(s.db, filter).Order("created_at asc").Find(&eventDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	result := []*events.Event{}
+	for _, eventDTO := range eventDTOs {
+		result = append(result, ToEvent(eventDTO))
+	}
+
+	return result, nil
+}
+
+func (s *EventStore) Save(event *events.Event) error {
+	tx := s.db.Save(ToEventDTO(event))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processEventFilters(tx *gorm.DB, filter *events.Filter) *gorm.DB {
+	if filter != nil {
+		if filter.Workspace != nil {
+			tx = tx.Where("workspace_id = ? OR workspace_name = ?", *filter.Workspace, *filter.Workspace)
+		}
+		if filter.Since != nil {
+			tx = tx.Where("created_at > ?", *filter.Since)
+		}
+	}
+
+	return tx
+}
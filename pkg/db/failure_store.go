@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/failures"
+)
+
+type FailureStore struct {
+	db *gorm.DB
+}
+
+func NewFailureStore(db *gorm.DB) (*FailureStore, error) {
+	err := db.AutoMigrate(&FailureDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &FailureStore{db: db}, nil
+}
+
+func (s *FailureStore) List(filter *failures.Filter) ([]*failures.Failure, error) {
+	failureDTOs := []FailureDTO{}
+	tx := processFailureFilters(s.db, filter).Order("created_at desc").Find(&failureDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	failureList := []*failures.Failure{}
+	for _, failureDTO := range failureDTOs {
+		failureList = append(failureList, ToFailure(failureDTO))
+	}
+
+	return failureList, nil
+}
+
+func (s *FailureStore) Save(failure *failures.Failure) error {
+	tx := s.db.Save(ToFailureDTO(failure))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processFailureFilters(tx *gorm.DB, filter *failures.Filter) *gorm.DB {
+	if filter != nil {
+		if filter.Since != nil {
+			tx = tx.Where("created_at >= ?", *filter.Since)
+		}
+	}
+
+	return tx
+}
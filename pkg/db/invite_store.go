@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/invite"
+)
+
+type InviteStore struct {
+	db *gorm.DB
+}
+
+func NewInviteStore(db *gorm.DB) (*InviteStore, error) {
+	err := db.AutoMigrate(&InviteDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &InviteStore{db: db}, nil
+}
+
+func (s *InviteStore) Find(codeHash string) (*invite.Invite, error) {
+	inviteDTO := InviteDTO{}
+	tx := s.db.Where("code_hash = ?", codeHash).First(&inviteDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, invite.ErrInviteNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToInvite(inviteDTO), nil
+}
+
+func (s *InviteStore) Save(invite *invite.Invite) error {
+	tx := s.db.Save(ToInviteDTO(invite))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *InviteStore) Delete(inv *invite.Invite) error {
+	tx := s.db.Where("code_hash = ?", inv.CodeHash).Delete(&InviteDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return invite.ErrInviteNotFound
+	}
+
+	return nil
+}
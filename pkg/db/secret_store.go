@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type SecretStore struct {
+	db *gorm.DB
+}
+
+func NewSecretStore(db *gorm.DB) (*SecretStore, error) {
+	err := db.AutoMigrate(&SecretDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecretStore{db: db}, nil
+}
+
+func (s *SecretStore) List(filter *secret.Filter) ([]*secret.Secret, error) {
+	secretDTOs := []SecretDTO{}
+	tx := processSecretFilters(s.db, filter).Order("name").Find(&secretDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	secrets := []*secret.Secret{}
+	for _, secretDTO := range secretDTOs {
+		secrets = append(secrets, ToSecret(secretDTO))
+	}
+
+	return secrets, nil
+}
+
+func (s *SecretStore) Find(filter *secret.Filter) (*secret.Secret, error) {
+	secretDTO := SecretDTO{}
+	tx := processSecretFilters(s.db, filter).First(&secretDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, secret.ErrSecretNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToSecret(secretDTO), nil
+}
+
+func (s *SecretStore) Save(secret *secret.Secret) error {
+	tx := s.db.Save(ToSecretDTO(secret))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *SecretStore) Delete(sec *secret.Secret) error {
+	tx := s.db.Where("name = ? AND workspace_id = ? AND project_name = ?", sec.Name, sec.WorkspaceId, sec.ProjectName).Delete(&SecretDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return secret.ErrSecretNotFound
+	}
+
+	return nil
+}
+
+func processSecretFilters(tx *gorm.DB, filter *secret.Filter) *gorm.DB {
+	if filter != nil {
+		if filter.Name != nil {
+			tx = tx.Where("name = ?", *filter.Name)
+		}
+		if filter.WorkspaceId != nil {
+			tx = tx.Where("workspace_id = ?", *filter.WorkspaceId)
+		}
+		if filter.ProjectName != nil {
+			tx = tx.Where("project_name = ?", *filter.ProjectName)
+		}
+	}
+
+	return tx
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BuildxBuilder is a BuildKit builder that runs in its own container. Every builder has its own cache
+// so builds on different builders can't read or overwrite each other's cache
+type BuildxBuilder struct {
+	Name string
+	// CPU limit of the builder container, 0 means no limit
+	Cpus float64
+	// Memory limit of the builder container in MB, 0 means no limit
+	MemoryMb int
+}
+
+// GetSetupCommand returns the shell command that creates the builder if it does not exist.
+// Concurrent builds can race to create the same builder, so the creation only fails if the builder still does not exist
+func (b *BuildxBuilder) GetSetupCommand() string {
+	createCmd := []string{"docker", "buildx", "create", "--name", b.Name, "--driver", "docker-container"}
+	for _, opt := range b.GetDriverOpts() {
+		createCmd = append(createCmd, "--driver-opt", opt)
+	}
+
+	inspectCmd := fmt.Sprintf("docker buildx inspect %s > /dev/null 2>&1", b.Name)
+
+	return fmt.Sprintf("(%s || %s > /dev/null || %s)", inspectCmd, strings.Join(createCmd, " "), inspectCmd)
+}
+
+func (b *BuildxBuilder) GetDriverOpts() []string {
+	opts := []string{}
+
+	if b.Cpus > 0 {
+		opts = append(opts, "cpu-period=100000", fmt.Sprintf("cpu-quota=%d", int64(b.Cpus*100000)))
+	}
+
+	if b.MemoryMb > 0 {
+		opts = append(opts, fmt.Sprintf("memory=%dm", b.MemoryMb), fmt.Sprintf("memory-swap=%dm", b.MemoryMb))
+	}
+
+	return opts
+}
@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildxBuilderSetupCommand(t *testing.T) {
+	builder := docker.BuildxBuilder{Name: "daytona-default"}
+	require.Equal(t, "(docker buildx inspect daytona-default > /dev/null 2>&1 || docker buildx create --name daytona-default --driver docker-container > /dev/null || docker buildx inspect daytona-default > /dev/null 2>&1)", builder.GetSetupCommand())
+
+	builder = docker.BuildxBuilder{Name: "daytona-default", Cpus: 1.5, MemoryMb: 2048}
+	require.Equal(t, []string{"cpu-period=100000", "cpu-quota=150000", "memory=2048m", "memory-swap=2048m"}, builder.GetDriverOpts())
+	require.Contains(t, builder.GetSetupCommand(), "--driver-opt cpu-quota=150000 --driver-opt memory=2048m")
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/ssh"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -28,6 +29,8 @@ type CreateProjectOptions struct {
 	SshClient                *ssh.Client
 	BuilderImage             string
 	BuilderContainerRegistry *containerregistry.ContainerRegistry
+	// If set, the durations of the pull, clone, build and hook phases are recorded during project creation and start
+	Timings *provider.ProjectCreationTimings
 }
 
 type IDockerClient interface {
@@ -40,6 +43,13 @@ type IDockerClient interface {
 	StartProject(opts *CreateProjectOptions, daytonaDownloadUrl string) error
 	StopProject(project *project.Project, logWriter io.Writer) error
 
+	CreateSnapshot(project *project.Project, snapshotImage string, logWriter io.Writer) error
+	RestoreSnapshot(project *project.Project, snapshotImage string, logWriter io.Writer) error
+	DeleteSnapshot(snapshotImage string, logWriter io.Writer) error
+
+	HibernateProject(project *project.Project, projectDir string, logWriter io.Writer, sshClient *ssh.Client) error
+	WakeProject(project *project.Project, projectDir string, logWriter io.Writer, sshClient *ssh.Client) error
+
 	GetProjectInfo(project *project.Project) (*project.ProjectInfo, error)
 	GetWorkspaceInfo(ws *workspace.Workspace) (*workspace.WorkspaceInfo, error)
 
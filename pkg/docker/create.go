@@ -16,6 +16,7 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/build/detect"
 	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/ssh"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/api/types/container"
@@ -40,17 +41,25 @@ func (d *DockerClient) CreateProject(opts *CreateProjectOptions) error {
 	// This is only an optimisation for images with tag 'latest'
 	pulledImages := map[string]bool{}
 
+	if opts.Timings == nil {
+		opts.Timings = &provider.ProjectCreationTimings{}
+	}
+
 	if opts.Project.BuildConfig != nil {
+		startedAt := time.Now()
 		err := d.PullImage(opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
 		if err != nil {
 			return err
 		}
 		pulledImages[opts.BuilderImage] = true
+		opts.Timings.Pull += time.Since(startedAt)
 
+		startedAt = time.Now()
 		err = d.cloneProjectRepository(opts)
 		if err != nil {
 			return err
 		}
+		opts.Timings.Clone += time.Since(startedAt)
 
 		builderType, err := detect.DetectProjectBuilderType(opts.Project.BuildConfig, opts.ProjectDir, opts.SshClient)
 		if err != nil {
@@ -59,8 +68,13 @@ func (d *DockerClient) CreateProject(opts *CreateProjectOptions) error {
 
 		switch builderType {
 		case detect.BuilderTypeDevcontainer:
-			_, _, err := d.CreateFromDevcontainer(d.toCreateDevcontainerOptions(opts, true))
-			return err
+			startedAt = time.Now()
+			containerId, _, err := d.CreateFromDevcontainer(d.toCreateDevcontainerOptions(opts, true))
+			opts.Timings.Build += time.Since(startedAt)
+			if err != nil || containerId == "" {
+				return err
+			}
+			return d.updateContainerResources(containerId, opts.Project.Resources, opts.LogWriter)
 		case detect.BuilderTypeImage:
 			return d.createProjectFromImage(opts, pulledImages, true)
 		default:
@@ -100,6 +114,11 @@ func (d *DockerClient) cloneProjectRepository(opts *CreateProjectOptions) error
 
 	cloneCmd := gitService.CloneRepositoryCmd(opts.Project.Repository, auth)
 
+	usernsMode, err := d.GetUsernsMode()
+	if err != nil {
+		log.Warnf("Failed to detect Docker user namespace mode: %v", err)
+	}
+
 	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
 		Image:      opts.BuilderImage,
 		Entrypoint: []string{"sleep"},
@@ -108,6 +127,7 @@ func (d *DockerClient) cloneProjectRepository(opts *CreateProjectOptions) error
 			"GIT_SSL_NO_VERIFY=true",
 		},
 	}, &container.HostConfig{
+		UsernsMode: getHostConfigUsernsMode(usernsMode),
 		Mounts: []mount.Mount{
 			{
 				Type:   mount.TypeBind,
@@ -159,6 +179,17 @@ func (d *DockerClient) cloneProjectRepository(opts *CreateProjectOptions) error
 }
 
 func (d *DockerClient) updateContainerUserUidGid(containerId string, opts *CreateProjectOptions) (string, error) {
+	usernsMode, err := d.GetUsernsMode()
+	if err != nil {
+		log.Warnf("Failed to detect Docker user namespace mode: %v", err)
+	}
+
+	// With rootless Docker, the container root user is mapped to the host user running the Docker daemon
+	// so files created by root inside the container are owned by that user on the host
+	if usernsMode == UsernsModeRootless {
+		return "root", nil
+	}
+
 	currentUser, err := user.Current()
 	if err != nil {
 		return "", err
@@ -26,6 +26,7 @@ import (
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 )
 
 const dockerSockForwardContainer = "daytona-sock-forward"
@@ -42,16 +43,21 @@ type DevcontainerPaths struct {
 type CreateDevcontainerOptions struct {
 	ProjectDir string
 	// Name of the project inside the devcontainer
-	ProjectName              string
-	BuildConfig              *buildconfig.BuildConfig
-	LogWriter                io.Writer
-	SshClient                *ssh.Client
-	ContainerRegistry        *containerregistry.ContainerRegistry
-	Prebuild                 bool
-	EnvVars                  map[string]string
-	IdLabels                 map[string]string
+	ProjectName       string
+	BuildConfig       *buildconfig.BuildConfig
+	LogWriter         io.Writer
+	SshClient         *ssh.Client
+	ContainerRegistry *containerregistry.ContainerRegistry
+	Prebuild          bool
+	EnvVars           map[string]string
+	IdLabels          map[string]string
+	// Labels of the containers that run the devcontainer CLI. Must not match the ID labels,
+	// otherwise the devcontainer CLI mistakes these containers for the devcontainer
+	ExecLabels               map[string]string
 	BuilderImage             string
 	BuilderContainerRegistry *containerregistry.ContainerRegistry
+	// If set, images are built with this BuildKit builder instead of the default builder of the Docker daemon
+	BuildxBuilder *BuildxBuilder
 }
 
 func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error) {
@@ -292,7 +298,12 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 		devcontainerCmd = append(devcontainerCmd, "--prebuild")
 	}
 
-	output, err := d.execDevcontainerCommand(strings.Join(devcontainerCmd, " "), &opts, paths, paths.ProjectTarget, socketForwardId, true, []mount.Mount{
+	cmd := strings.Join(devcontainerCmd, " ")
+	if opts.BuildxBuilder != nil {
+		cmd = fmt.Sprintf("%s && BUILDX_BUILDER=%s %s", opts.BuildxBuilder.GetSetupCommand(), opts.BuildxBuilder.Name, cmd)
+	}
+
+	output, err := d.execDevcontainerCommand(cmd, &opts, paths, paths.ProjectTarget, socketForwardId, true, []mount.Mount{
 		{
 			Type:   mount.TypeBind,
 			Source: paths.OverridesDir,
@@ -353,21 +364,33 @@ func (d *DockerClient) ensureDockerSockForward(builderImage string, builderConta
 		return "", err
 	}
 
-	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
-		Image:      builderImage,
-		Entrypoint: []string{"socat"},
-		User:       "root",
-		Cmd:        []string{"tcp-listen:2375,fork,reuseaddr", "unix-connect:/var/run/docker.sock"},
-	}, &container.HostConfig{
+	containerRuntime, err := d.GetRuntime()
+	if err != nil {
+		log.Warnf("Failed to detect the container runtime: %v", err)
+	}
+
+	hostConfig := &container.HostConfig{
 		Privileged: true,
 		Mounts: []mount.Mount{
 			{
 				Type:   mount.TypeBind,
-				Source: "/var/run/docker.sock",
-				Target: "/var/run/docker.sock",
+				Source: d.getDaemonSocketPath(containerRuntime),
+				Target: dockerSocketPath,
 			},
 		},
-	}, nil, nil, dockerSockForwardContainer)
+	}
+
+	// SELinux denies containers access to the Podman socket unless labeling is disabled
+	if containerRuntime == RuntimePodman {
+		hostConfig.SecurityOpt = []string{"label=disable"}
+	}
+
+	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image:      builderImage,
+		Entrypoint: []string{"socat"},
+		User:       "root",
+		Cmd:        []string{"tcp-listen:2375,fork,reuseaddr", "unix-connect:" + dockerSocketPath},
+	}, hostConfig, nil, nil, dockerSockForwardContainer)
 	if err != nil {
 		return "", err
 	}
@@ -542,6 +565,7 @@ func (d *DockerClient) execDevcontainerCommand(cmd string, opts *CreateDevcontai
 		Cmd:        append([]string{"-c"}, cmd),
 		Tty:        true,
 		WorkingDir: workdir,
+		Labels:     opts.ExecLabels,
 	}, &container.HostConfig{
 		Privileged:  true,
 		NetworkMode: container.NetworkMode(fmt.Sprintf("container:%s", socketForwardId)),
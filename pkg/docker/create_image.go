@@ -24,11 +24,13 @@ func (d *DockerClient) createProjectFromImage(opts *CreateProjectOptions, pulled
 		return d.initProjectContainer(opts, mountProjectDir)
 	}
 
+	startedAt := time.Now()
 	err := d.PullImage(opts.Project.Image, opts.ContainerRegistry, opts.LogWriter)
 	if err != nil {
 		return err
 	}
 	pulledImages[opts.Project.Image] = true
+	opts.Timings.Pull += time.Since(startedAt)
 
 	return d.initProjectContainer(opts, mountProjectDir)
 }
@@ -36,13 +38,9 @@ func (d *DockerClient) createProjectFromImage(opts *CreateProjectOptions, pulled
 func (d *DockerClient) initProjectContainer(opts *CreateProjectOptions, mountProjectDir bool) error {
 	ctx := context.Background()
 
-	mounts := []mount.Mount{}
-	if mountProjectDir {
-		mounts = append(mounts, mount.Mount{
-			Type:   mount.TypeBind,
-			Source: opts.ProjectDir,
-			Target: fmt.Sprintf("/home/%s/%s", opts.Project.User, opts.Project.Name),
-		})
+	usernsMode, err := d.GetUsernsMode()
+	if err != nil {
+		log.Warnf("Failed to detect Docker user namespace mode: %v", err)
 	}
 
 	var availablePort *uint16
@@ -64,14 +62,49 @@ func (d *DockerClient) initProjectContainer(opts *CreateProjectOptions, mountPro
 		}
 	}
 
-	c, err := d.apiClient.ContainerCreate(ctx, GetContainerCreateConfig(opts.Project, availablePort), &container.HostConfig{
+	metadata, err := d.getImageMetadata(opts.Project.Image)
+	if err != nil {
+		log.Warnf("Failed to read image metadata: %v", err)
+	}
+
+	containerConfig := GetContainerCreateConfig(opts.Project, availablePort)
+	applyImageMetadata(containerConfig, metadata)
+
+	if metadata != nil && len(metadata.ForwardPorts) > 0 && opts.LogWriter != nil {
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Image exposes ports %v\n", metadata.ForwardPorts)))
+	}
+
+	projectDir := fmt.Sprintf("/home/%s/%s", containerConfig.User, opts.Project.Name)
+
+	mounts := []mount.Mount{}
+	if mountProjectDir {
+		mounts = append(mounts, mount.Mount{
+			Type:   mount.TypeBind,
+			Source: opts.ProjectDir,
+			Target: projectDir,
+		})
+	}
+
+	hostConfig := &container.HostConfig{
 		Privileged: true,
+		UsernsMode: getHostConfigUsernsMode(usernsMode),
 		Mounts:     mounts,
 		ExtraHosts: []string{
 			"host.docker.internal:host-gateway",
 		},
 		PortBindings: portBindings,
-	}, nil, nil, d.GetProjectContainerName(opts.Project))
+		Resources:    GetContainerResources(opts.Project.Resources),
+		StorageOpt:   getStorageOpt(opts.Project.Resources),
+	}
+
+	c, err := d.apiClient.ContainerCreate(ctx, containerConfig, hostConfig, nil, nil, d.GetProjectContainerName(opts.Project))
+	if err != nil && hostConfig.StorageOpt != nil && isStorageOptUnsupported(err) {
+		if opts.LogWriter != nil {
+			opts.LogWriter.Write([]byte("The Docker storage driver does not support disk limits, creating the project without one\n"))
+		}
+		hostConfig.StorageOpt = nil
+		c, err = d.apiClient.ContainerCreate(ctx, containerConfig, hostConfig, nil, nil, d.GetProjectContainerName(opts.Project))
+	}
 	if err != nil {
 		return err
 	}
@@ -96,6 +129,37 @@ func (d *DockerClient) initProjectContainer(opts *CreateProjectOptions, mountPro
 		_, err = d.updateContainerUserUidGid(c.ID, opts)
 	}
 
+	// The project is cloned by the agent when the directory isn't mounted
+	workdir := ""
+	if mountProjectDir {
+		workdir = projectDir
+	}
+
+	startedAt := time.Now()
+
+	if metadata != nil && len(metadata.OnCreateCommands) > 0 {
+		if opts.LogWriter != nil {
+			opts.LogWriter.Write([]byte("Running image onCreate commands\n"))
+		}
+
+		err = d.runImageMetadataCommands(c.ID, containerConfig.User, workdir, metadata.OnCreateCommands, opts.LogWriter)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(opts.Project.OnCreateCommands) > 0 {
+		if opts.LogWriter != nil {
+			opts.LogWriter.Write([]byte("Running project onCreate commands\n"))
+		}
+
+		err = d.runImageMetadataCommands(c.ID, containerConfig.User, workdir, toShellCommands(opts.Project.OnCreateCommands), opts.LogWriter)
+		if err != nil {
+			return err
+		}
+	}
+	opts.Timings.Hooks += time.Since(startedAt)
+
 	err = d.apiClient.ContainerStop(ctx, c.ID, container.StopOptions{
 		Signal: "SIGKILL",
 	})
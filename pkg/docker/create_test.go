@@ -18,6 +18,7 @@ import (
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/system"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,7 @@ func (s *DockerClientTestSuite) TestCreateWorkspace() {
 
 func (s *DockerClientTestSuite) TestCreateProject() {
 	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+	s.mockClient.On("Info", mock.Anything).Return(system.Info{}, nil)
 
 	var networkingConfig *network.NetworkingConfig
 	var platform *v1.Platform
@@ -50,6 +52,7 @@ func (s *DockerClientTestSuite) TestCreateProject() {
 	).Return([]image.Summary{}, nil)
 
 	s.mockClient.On("ImagePull", mock.Anything, project1.Image, mock.Anything).Return(t_docker.NewPipeReader(""), nil)
+	s.mockClient.On("ImageInspectWithRaw", mock.Anything, project1.Image).Return(types.ImageInspect{}, []byte{}, nil)
 	s.mockClient.On("ImagePull", mock.Anything, "daytonaio/workspace-project", mock.Anything).Return(t_docker.NewPipeReader(""), nil)
 
 	s.mockClient.On("ContainerRemove", mock.Anything, mock.Anything, container.RemoveOptions{RemoveVolumes: true, Force: true}).Return(nil)
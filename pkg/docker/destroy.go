@@ -29,10 +29,16 @@ func (d *DockerClient) DestroyProject(project *project.Project, projectDir strin
 		return err
 	}
 
+	archivePath := GetHibernationArchivePath(projectDir)
+
 	if sshClient == nil {
-		return os.RemoveAll(projectDir)
+		err = os.RemoveAll(projectDir)
+		if err != nil {
+			return err
+		}
+		return os.RemoveAll(archivePath)
 	} else {
-		return sshClient.Exec(fmt.Sprintf("rm -rf %s", projectDir), nil)
+		return sshClient.Exec(fmt.Sprintf("rm -rf %s %s", projectDir, archivePath), nil)
 	}
 }
 
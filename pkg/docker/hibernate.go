@@ -0,0 +1,238 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+var ErrProjectRunning = errors.New("the project must be stopped before it is hibernated")
+
+// GetHibernationArchivePath returns the path of the archive the project directory is compressed to while the project is hibernated
+func GetHibernationArchivePath(projectDir string) string {
+	return projectDir + ".tar.gz"
+}
+
+// HibernateProject compresses the project directory of a stopped project into an archive next to it and removes the directory.
+// The project container is kept stopped so that its configuration and filesystem survive until the project is woken
+func (d *DockerClient) HibernateProject(p *project.Project, projectDir string, logWriter io.Writer, sshClient *ssh.Client) error {
+	c, err := d.apiClient.ContainerInspect(context.Background(), d.GetProjectContainerName(p))
+	if err != nil {
+		return err
+	}
+
+	if c.State != nil && c.State.Running {
+		return ErrProjectRunning
+	}
+
+	archivePath := GetHibernationArchivePath(projectDir)
+
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Compressing project %s to %s\n", p.Name, archivePath)))
+	}
+
+	if sshClient != nil {
+		err = sshClient.Exec(fmt.Sprintf("tar -czf %s -C %s . && rm -rf %s", archivePath, projectDir, projectDir), logWriter)
+	} else {
+		err = archiveDir(projectDir, archivePath)
+	}
+	if err != nil {
+		return err
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Project %s hibernated\n", p.Name)))
+	}
+
+	return nil
+}
+
+// WakeProject extracts the archive created by HibernateProject back to the project directory.
+// Projects that are not hibernated are left untouched
+func (d *DockerClient) WakeProject(p *project.Project, projectDir string, logWriter io.Writer, sshClient *ssh.Client) error {
+	archivePath := GetHibernationArchivePath(projectDir)
+
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Restoring project %s from %s\n", p.Name, archivePath)))
+	}
+
+	var err error
+	if sshClient != nil {
+		err = sshClient.Exec(fmt.Sprintf("if [ -f %s ]; then mkdir -p %s && tar -xzf %s -C %s && rm -f %s; fi", archivePath, projectDir, archivePath, projectDir, archivePath), logWriter)
+	} else {
+		err = extractDir(archivePath, projectDir)
+	}
+	if err != nil {
+		return err
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Project %s woken\n", p.Name)))
+	}
+
+	return nil
+}
+
+// archiveDir writes the directory tree to a gzipped tar archive and removes the directory
+func archiveDir(dir, archivePath string) error {
+	file, err := os.OpenFile(archivePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	err = writeArchive(dir, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(archivePath)
+		return err
+	}
+
+	return os.RemoveAll(dir)
+}
+
+func writeArchive(dir string, w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err := filepath.Walk(dir, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil || name == "." {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&fs.ModeSymlink != 0 {
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+
+		err = tarWriter.WriteHeader(header)
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tarWriter, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return gzipWriter.Close()
+}
+
+// extractDir extracts the archive written by archiveDir to the directory and removes the archive. A missing archive is not an error
+func extractDir(archivePath, dir string) error {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if !filepath.IsLocal(filepath.FromSlash(header.Name)) {
+			return fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+		path := filepath.Join(dir, filepath.FromSlash(header.Name))
+		mode := fs.FileMode(header.Mode).Perm()
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(path, mode)
+		case tar.TypeSymlink:
+			err = os.Symlink(header.Linkname, path)
+		case tar.TypeReg:
+			err = extractFile(tarReader, path, mode)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// Ownership can only be restored by root, other users keep owning the extracted files
+		if os.Geteuid() == 0 {
+			err = os.Lchown(path, header.Uid, header.Gid)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	file.Close()
+	return os.Remove(archivePath)
+}
+
+func extractFile(r io.Reader, path string, mode fs.FileMode) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) mockProjectContainerState(running bool) {
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			State: &types.ContainerState{
+				Running: running,
+			},
+		},
+		Config: &container.Config{},
+	}, nil)
+}
+
+func (s *DockerClientTestSuite) TestHibernateAndWakeProject() {
+	s.mockProjectContainerState(false)
+
+	projectDir := filepath.Join(s.T().TempDir(), "123-test")
+	require.Nil(s.T(), os.MkdirAll(filepath.Join(projectDir, "src"), 0755))
+	require.Nil(s.T(), os.WriteFile(filepath.Join(projectDir, "src", "main.go"), []byte("package main"), 0644))
+
+	err := s.dockerClient.HibernateProject(project1, projectDir, nil, nil)
+	require.Nil(s.T(), err)
+
+	_, err = os.Stat(projectDir)
+	require.True(s.T(), os.IsNotExist(err))
+	_, err = os.Stat(docker.GetHibernationArchivePath(projectDir))
+	require.Nil(s.T(), err)
+
+	err = s.dockerClient.WakeProject(project1, projectDir, nil, nil)
+	require.Nil(s.T(), err)
+
+	content, err := os.ReadFile(filepath.Join(projectDir, "src", "main.go"))
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "package main", string(content))
+	_, err = os.Stat(docker.GetHibernationArchivePath(projectDir))
+	require.True(s.T(), os.IsNotExist(err))
+}
+
+func (s *DockerClientTestSuite) TestHibernateProject_Running() {
+	s.mockProjectContainerState(true)
+
+	projectDir := s.T().TempDir()
+
+	err := s.dockerClient.HibernateProject(project1, projectDir, nil, nil)
+	require.ErrorIs(s.T(), err, docker.ErrProjectRunning)
+
+	_, err = os.Stat(projectDir)
+	require.Nil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestWakeProject_NotHibernated() {
+	projectDir := s.T().TempDir()
+
+	err := s.dockerClient.WakeProject(project1, projectDir, nil, nil)
+	require.Nil(s.T(), err)
+}
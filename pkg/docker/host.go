@@ -0,0 +1,242 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/tlsconfig"
+)
+
+const defaultDockerContext = "default"
+
+// DockerHostConfig selects the Docker daemon Daytona talks to.
+// If both fields are empty, the daemon is resolved from the environment (DOCKER_HOST) or the local Docker or Podman socket.
+type DockerHostConfig struct {
+	// Docker daemon address, e.g. unix:///var/run/docker.sock or tcp://10.0.0.5:2376
+	Host string
+	// Name of a Docker CLI context (see 'docker context ls')
+	Context string
+}
+
+func (c DockerHostConfig) IsEmpty() bool {
+	return c.Host == "" && c.Context == ""
+}
+
+func (c DockerHostConfig) String() string {
+	if c.Context != "" {
+		return fmt.Sprintf("context %s", c.Context)
+	}
+	if c.Host != "" {
+		return c.Host
+	}
+	return "local Docker daemon"
+}
+
+// DockerEndpoint is a resolved Docker daemon address
+type DockerEndpoint struct {
+	Host string
+	// Directory containing ca.pem, cert.pem and key.pem
+	TLSPath       string
+	SkipTLSVerify bool
+}
+
+type dockerContextMeta struct {
+	Name      string `json:"Name"`
+	Endpoints map[string]struct {
+		Host          string `json:"Host"`
+		SkipTLSVerify bool   `json:"SkipTLSVerify"`
+	} `json:"Endpoints"`
+}
+
+// ResolveDockerEndpoint returns the daemon endpoint for the config or nil if the environment defaults should be used
+func ResolveDockerEndpoint(config DockerHostConfig) (*DockerEndpoint, error) {
+	if config.Host != "" && config.Context != "" {
+		return nil, errors.New("only one of docker host and docker context can be set")
+	}
+
+	if config.Host != "" {
+		err := validateDockerHost(config.Host)
+		if err != nil {
+			return nil, err
+		}
+		return &DockerEndpoint{Host: config.Host}, nil
+	}
+
+	if config.Context == "" || config.Context == defaultDockerContext {
+		return resolveLocalEndpoint(), nil
+	}
+
+	return resolveDockerContext(config.Context)
+}
+
+// ApplyDockerHost exports the resolved endpoint as DOCKER_* environment variables so that
+// Docker clients created from the environment, including those in provider processes, use it
+func ApplyDockerHost(config DockerHostConfig) error {
+	endpoint, err := ResolveDockerEndpoint(config)
+	if err != nil {
+		return err
+	}
+
+	if endpoint == nil {
+		return nil
+	}
+
+	err = os.Setenv(client.EnvOverrideHost, endpoint.Host)
+	if err != nil {
+		return err
+	}
+
+	if endpoint.TLSPath == "" {
+		return errors.Join(os.Unsetenv(client.EnvOverrideCertPath), os.Unsetenv(client.EnvTLSVerify))
+	}
+
+	err = os.Setenv(client.EnvOverrideCertPath, endpoint.TLSPath)
+	if err != nil {
+		return err
+	}
+
+	if endpoint.SkipTLSVerify {
+		return os.Unsetenv(client.EnvTLSVerify)
+	}
+
+	return os.Setenv(client.EnvTLSVerify, "1")
+}
+
+// NewApiClient creates a Docker API client for the config without modifying the environment
+func NewApiClient(config DockerHostConfig) (client.APIClient, error) {
+	endpoint, err := ResolveDockerEndpoint(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint == nil {
+		return client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	}
+
+	opts := []client.Opt{client.WithVersionFromEnv(), client.WithAPIVersionNegotiation()}
+
+	if endpoint.TLSPath != "" {
+		tlsConfig, err := tlsconfig.Client(tlsconfig.Options{
+			CAFile:             filepath.Join(endpoint.TLSPath, "ca.pem"),
+			CertFile:           filepath.Join(endpoint.TLSPath, "cert.pem"),
+			KeyFile:            filepath.Join(endpoint.TLSPath, "key.pem"),
+			InsecureSkipVerify: endpoint.SkipTLSVerify,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS configuration for %s: %w", endpoint.Host, err)
+		}
+
+		opts = append(opts, client.WithHTTPClient(&http.Client{
+			Transport:     &http.Transport{TLSClientConfig: tlsConfig},
+			CheckRedirect: client.CheckRedirect,
+		}))
+	}
+
+	// The host has to be applied last so that it configures the transport of the custom HTTP client
+	opts = append(opts, client.WithHost(endpoint.Host))
+
+	return client.NewClientWithOpts(opts...)
+}
+
+// resolveLocalEndpoint returns the local Podman socket if DOCKER_HOST is not set and the Docker socket does not exist.
+// The Docker socket is left to the client defaults.
+func resolveLocalEndpoint() *DockerEndpoint {
+	if os.Getenv(client.EnvOverrideHost) != "" {
+		return nil
+	}
+
+	socketPath := FindLocalSocket("/")
+	if socketPath == "" || socketPath == dockerSocketPath {
+		return nil
+	}
+
+	return &DockerEndpoint{Host: "unix://" + socketPath}
+}
+
+func validateDockerHost(host string) error {
+	scheme, _, found := strings.Cut(host, "://")
+	if !found {
+		return fmt.Errorf("invalid docker host %s: expected <scheme>://<address>", host)
+	}
+
+	switch scheme {
+	case "unix", "tcp", "npipe", "http", "https":
+		return nil
+	case "ssh":
+		return fmt.Errorf("docker host %s is not supported: ssh endpoints are not supported, expose the daemon over tcp with TLS instead", host)
+	default:
+		return fmt.Errorf("invalid docker host %s: unsupported scheme %s", host, scheme)
+	}
+}
+
+func resolveDockerContext(name string) (*DockerEndpoint, error) {
+	configDir, err := getDockerConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(name))
+	contextId := hex.EncodeToString(digest[:])
+
+	metaFile, err := os.ReadFile(filepath.Join(configDir, "contexts", "meta", contextId, "meta.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("docker context %s not found", name)
+		}
+		return nil, fmt.Errorf("failed to read docker context %s: %w", name, err)
+	}
+
+	var meta dockerContextMeta
+	err = json.Unmarshal(metaFile, &meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse docker context %s: %w", name, err)
+	}
+
+	dockerEndpoint, ok := meta.Endpoints["docker"]
+	if !ok || dockerEndpoint.Host == "" {
+		return nil, fmt.Errorf("docker context %s has no docker endpoint", name)
+	}
+
+	err = validateDockerHost(dockerEndpoint.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := &DockerEndpoint{
+		Host:          dockerEndpoint.Host,
+		SkipTLSVerify: dockerEndpoint.SkipTLSVerify,
+	}
+
+	tlsPath := filepath.Join(configDir, "contexts", "tls", contextId, "docker")
+	_, err = os.Stat(tlsPath)
+	if err == nil {
+		endpoint.TLSPath = tlsPath
+	}
+
+	return endpoint, nil
+}
+
+func getDockerConfigDir() (string, error) {
+	configDir := os.Getenv("DOCKER_CONFIG")
+	if configDir != "" {
+		return configDir, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".docker"), nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveDockerEndpoint(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("DOCKER_CONFIG", configDir)
+	// Local sockets are only looked up if DOCKER_HOST is not set
+	t.Setenv("DOCKER_HOST", "unix:///var/run/docker.sock")
+
+	writeDockerContext(t, configDir, "remote", `{"Name":"remote","Endpoints":{"docker":{"Host":"tcp://10.0.0.5:2376","SkipTLSVerify":false}}}`, true)
+	writeDockerContext(t, configDir, "over-ssh", `{"Name":"over-ssh","Endpoints":{"docker":{"Host":"ssh://user@10.0.0.5"}}}`, false)
+
+	endpoint, err := docker.ResolveDockerEndpoint(docker.DockerHostConfig{})
+	require.NoError(t, err)
+	require.Nil(t, endpoint)
+
+	endpoint, err = docker.ResolveDockerEndpoint(docker.DockerHostConfig{Context: "default"})
+	require.NoError(t, err)
+	require.Nil(t, endpoint)
+
+	endpoint, err = docker.ResolveDockerEndpoint(docker.DockerHostConfig{Host: "tcp://10.0.0.6:2375"})
+	require.NoError(t, err)
+	require.Equal(t, &docker.DockerEndpoint{Host: "tcp://10.0.0.6:2375"}, endpoint)
+
+	endpoint, err = docker.ResolveDockerEndpoint(docker.DockerHostConfig{Context: "remote"})
+	require.NoError(t, err)
+	require.Equal(t, "tcp://10.0.0.5:2376", endpoint.Host)
+	require.Equal(t, filepath.Join(configDir, "contexts", "tls", contextId("remote"), "docker"), endpoint.TLSPath)
+	require.False(t, endpoint.SkipTLSVerify)
+
+	_, err = docker.ResolveDockerEndpoint(docker.DockerHostConfig{Context: "over-ssh"})
+	require.Error(t, err)
+
+	_, err = docker.ResolveDockerEndpoint(docker.DockerHostConfig{Context: "missing"})
+	require.EqualError(t, err, "docker context missing not found")
+
+	_, err = docker.ResolveDockerEndpoint(docker.DockerHostConfig{Host: "10.0.0.5:2376"})
+	require.Error(t, err)
+
+	_, err = docker.ResolveDockerEndpoint(docker.DockerHostConfig{Host: "tcp://10.0.0.5:2376", Context: "remote"})
+	require.Error(t, err)
+}
+
+func TestApplyDockerHost(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("DOCKER_CONFIG", configDir)
+	t.Setenv("DOCKER_HOST", "")
+	t.Setenv("DOCKER_CERT_PATH", "/tmp/certs")
+	t.Setenv("DOCKER_TLS_VERIFY", "1")
+
+	writeDockerContext(t, configDir, "remote", `{"Name":"remote","Endpoints":{"docker":{"Host":"tcp://10.0.0.5:2376","SkipTLSVerify":true}}}`, true)
+
+	err := docker.ApplyDockerHost(docker.DockerHostConfig{Host: "tcp://10.0.0.6:2375"})
+	require.NoError(t, err)
+	require.Equal(t, "tcp://10.0.0.6:2375", os.Getenv("DOCKER_HOST"))
+	require.Empty(t, os.Getenv("DOCKER_CERT_PATH"))
+	require.Empty(t, os.Getenv("DOCKER_TLS_VERIFY"))
+
+	err = docker.ApplyDockerHost(docker.DockerHostConfig{Context: "remote"})
+	require.NoError(t, err)
+	require.Equal(t, "tcp://10.0.0.5:2376", os.Getenv("DOCKER_HOST"))
+	require.Equal(t, filepath.Join(configDir, "contexts", "tls", contextId("remote"), "docker"), os.Getenv("DOCKER_CERT_PATH"))
+	require.Empty(t, os.Getenv("DOCKER_TLS_VERIFY"))
+}
+
+func writeDockerContext(t *testing.T, configDir, name, meta string, withTLS bool) {
+	metaDir := filepath.Join(configDir, "contexts", "meta", contextId(name))
+	require.NoError(t, os.MkdirAll(metaDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(metaDir, "meta.json"), []byte(meta), 0644))
+
+	if withTLS {
+		require.NoError(t, os.MkdirAll(filepath.Join(configDir, "contexts", "tls", contextId(name), "docker"), 0755))
+	}
+}
+
+func contextId(name string) string {
+	digest := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(digest[:])
+}
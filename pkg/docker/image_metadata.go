@@ -0,0 +1,290 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+)
+
+// Label used by the dev container spec to embed the dev environment configuration in an image
+const IMAGE_METADATA_LABEL = "devcontainer.metadata"
+
+// ImageMetadata is the dev environment configuration read from the image labels.
+// Images can be published with this configuration so that projects using them need no other setup
+type ImageMetadata struct {
+	RemoteUser   string
+	ContainerEnv map[string]string
+	ForwardPorts []uint16
+	// Commands run once after the project container is created, in order
+	OnCreateCommands [][]string
+	// Commands run every time the project is started, in order
+	PostStartCommands [][]string
+}
+
+type imageMetadataEntry struct {
+	RemoteUser        string            `json:"remoteUser"`
+	ContainerUser     string            `json:"containerUser"`
+	ContainerEnv      map[string]string `json:"containerEnv"`
+	ForwardPorts      []interface{}     `json:"forwardPorts"`
+	OnCreateCommand   lifecycleCommand  `json:"onCreateCommand"`
+	PostCreateCommand lifecycleCommand  `json:"postCreateCommand"`
+	PostStartCommand  lifecycleCommand  `json:"postStartCommand"`
+}
+
+// lifecycleCommand can be a shell command string, a command array run without a shell
+// or an object of named commands of either form
+type lifecycleCommand [][]string
+
+func (c *lifecycleCommand) UnmarshalJSON(data []byte) error {
+	var command interface{}
+	err := json.Unmarshal(data, &command)
+	if err != nil {
+		return err
+	}
+
+	if commands, ok := command.(map[string]interface{}); ok {
+		names := []string{}
+		for name := range commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			cmd, err := toCommand(commands[name])
+			if err != nil {
+				return fmt.Errorf("invalid command %s: %w", name, err)
+			}
+			if cmd != nil {
+				*c = append(*c, cmd)
+			}
+		}
+
+		return nil
+	}
+
+	cmd, err := toCommand(command)
+	if err != nil {
+		return err
+	}
+	if cmd != nil {
+		*c = append(*c, cmd)
+	}
+
+	return nil
+}
+
+func toCommand(command interface{}) ([]string, error) {
+	switch command := command.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if command == "" {
+			return nil, nil
+		}
+		return []string{"sh", "-c", command}, nil
+	case []interface{}:
+		cmd := []string{}
+		for _, arg := range command {
+			arg, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("command arguments must be strings")
+			}
+			cmd = append(cmd, arg)
+		}
+		if len(cmd) == 0 {
+			return nil, nil
+		}
+		return cmd, nil
+	}
+
+	return nil, fmt.Errorf("command must be a string or an array of strings")
+}
+
+// GetImageMetadata reads the dev environment configuration from the image labels.
+// Returns nil if the image has no metadata
+func GetImageMetadata(labels map[string]string) (*ImageMetadata, error) {
+	label, ok := labels[IMAGE_METADATA_LABEL]
+	if !ok {
+		return nil, nil
+	}
+
+	entries := []imageMetadataEntry{}
+	if strings.HasPrefix(strings.TrimSpace(label), "{") {
+		var entry imageMetadataEntry
+		err := json.Unmarshal([]byte(label), &entry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s label: %w", IMAGE_METADATA_LABEL, err)
+		}
+		entries = append(entries, entry)
+	} else {
+		err := json.Unmarshal([]byte(label), &entries)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s label: %w", IMAGE_METADATA_LABEL, err)
+		}
+	}
+
+	// Entries are ordered from the base image to the final image so later entries take precedence
+	metadata := &ImageMetadata{
+		ContainerEnv: map[string]string{},
+	}
+	containerUser := ""
+
+	for _, entry := range entries {
+		if entry.RemoteUser != "" {
+			metadata.RemoteUser = entry.RemoteUser
+		}
+		if entry.ContainerUser != "" {
+			containerUser = entry.ContainerUser
+		}
+
+		for key, value := range entry.ContainerEnv {
+			metadata.ContainerEnv[key] = value
+		}
+
+		for _, p := range entry.ForwardPorts {
+			port, err := parseForwardPort(p)
+			if err != nil {
+				return nil, err
+			}
+			if port != nil && !containsPort(metadata.ForwardPorts, *port) {
+				metadata.ForwardPorts = append(metadata.ForwardPorts, *port)
+			}
+		}
+
+		metadata.OnCreateCommands = append(metadata.OnCreateCommands, entry.OnCreateCommand...)
+		metadata.OnCreateCommands = append(metadata.OnCreateCommands, entry.PostCreateCommand...)
+		metadata.PostStartCommands = append(metadata.PostStartCommands, entry.PostStartCommand...)
+	}
+
+	if metadata.RemoteUser == "" {
+		metadata.RemoteUser = containerUser
+	}
+
+	return metadata, nil
+}
+
+// parseForwardPort parses a port number or a "host:port" string. Ports of hosts other
+// than localhost belong to other containers and are skipped
+func parseForwardPort(port interface{}) (*uint16, error) {
+	var value string
+
+	switch port := port.(type) {
+	case float64:
+		value = strconv.FormatFloat(port, 'f', -1, 64)
+	case string:
+		host, p, found := strings.Cut(port, ":")
+		if found && host != "localhost" && host != "127.0.0.1" {
+			return nil, nil
+		}
+		if !found {
+			p = host
+		}
+		value = p
+	default:
+		return nil, fmt.Errorf("invalid forward port: %v", port)
+	}
+
+	p, err := strconv.ParseUint(value, 10, 16)
+	if err != nil || p == 0 {
+		return nil, fmt.Errorf("invalid forward port: %v", port)
+	}
+
+	result := uint16(p)
+	return &result, nil
+}
+
+func containsPort(ports []uint16, port uint16) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *DockerClient) getImageMetadata(imageName string) (*ImageMetadata, error) {
+	image, _, err := d.apiClient.ImageInspectWithRaw(context.Background(), imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	if image.Config == nil {
+		return nil, nil
+	}
+
+	return GetImageMetadata(image.Config.Labels)
+}
+
+// applyImageMetadata updates the project container config with the image metadata.
+// Project environment variables take precedence over the ones set in the image
+func applyImageMetadata(config *container.Config, metadata *ImageMetadata) {
+	if metadata == nil {
+		return
+	}
+
+	if metadata.RemoteUser != "" {
+		config.User = metadata.RemoteUser
+	}
+
+	keys := []string{}
+	for key := range metadata.ContainerEnv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	envVars := []string{}
+	for _, key := range keys {
+		envVars = append(envVars, fmt.Sprintf("%s=%s", key, metadata.ContainerEnv[key]))
+	}
+	config.Env = append(envVars, config.Env...)
+
+	for _, port := range metadata.ForwardPorts {
+		if config.ExposedPorts == nil {
+			config.ExposedPorts = nat.PortSet{}
+		}
+		config.ExposedPorts[nat.Port(fmt.Sprintf("%d/tcp", port))] = struct{}{}
+	}
+}
+
+// toShellCommands converts shell command strings to commands that run them with sh
+func toShellCommands(commands []string) [][]string {
+	result := [][]string{}
+	for _, cmd := range commands {
+		result = append(result, []string{"/bin/sh", "-c", cmd})
+	}
+	return result
+}
+
+func (d *DockerClient) runImageMetadataCommands(containerId, user, workdir string, commands [][]string, logWriter io.Writer) error {
+	for _, cmd := range commands {
+		if logWriter != nil {
+			logWriter.Write([]byte(fmt.Sprintf("Running %s\n", strings.Join(cmd, " "))))
+		}
+
+		result, err := d.ExecSync(containerId, container.ExecOptions{
+			User:       user,
+			WorkingDir: workdir,
+			Cmd:        cmd,
+		}, logWriter)
+		if err != nil {
+			return err
+		}
+
+		if result.ExitCode != 0 {
+			return fmt.Errorf("command %s failed with exit code %d", strings.Join(cmd, " "), result.ExitCode)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetImageMetadata(t *testing.T) {
+	metadata, err := docker.GetImageMetadata(map[string]string{})
+	require.Nil(t, err)
+	require.Nil(t, metadata)
+
+	metadata, err = docker.GetImageMetadata(map[string]string{
+		docker.IMAGE_METADATA_LABEL: `[
+			{"id": "ghcr.io/devcontainers/features/node:1", "containerEnv": {"NODE_ENV": "development"}},
+			{"remoteUser": "vscode", "containerEnv": {"PATH_EXTRA": "/opt/bin"}, "forwardPorts": [3000, "localhost:8080", "db:5432", 3000]},
+			{"onCreateCommand": "npm install", "postCreateCommand": ["make", "setup"], "postStartCommand": {"b": "echo b", "a": "echo a"}}
+		]`,
+	})
+	require.Nil(t, err)
+	require.Equal(t, &docker.ImageMetadata{
+		RemoteUser: "vscode",
+		ContainerEnv: map[string]string{
+			"NODE_ENV":   "development",
+			"PATH_EXTRA": "/opt/bin",
+		},
+		ForwardPorts: []uint16{3000, 8080},
+		OnCreateCommands: [][]string{
+			{"sh", "-c", "npm install"},
+			{"make", "setup"},
+		},
+		PostStartCommands: [][]string{
+			{"sh", "-c", "echo a"},
+			{"sh", "-c", "echo b"},
+		},
+	}, metadata)
+
+	metadata, err = docker.GetImageMetadata(map[string]string{
+		docker.IMAGE_METADATA_LABEL: `{"containerUser": "node"}`,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "node", metadata.RemoteUser)
+
+	_, err = docker.GetImageMetadata(map[string]string{
+		docker.IMAGE_METADATA_LABEL: `[{"forwardPorts": [70000]}]`,
+	})
+	require.NotNil(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -70,6 +71,21 @@ func (d *DockerClient) GetProjectInfo(p *project.Project) (*project.ProjectInfo,
 		projectInfo.ProviderMetadata = string(metadata)
 	}
 
+	if info.State.Running {
+		stats, err := d.getContainerStats(p)
+		if err != nil {
+			return nil, err
+		}
+		projectInfo.EgressBytes = stats.EgressBytes
+		projectInfo.MemoryUsage = stats.MemoryBytes
+
+		// One-shot stats don't include a previous sample so the CPU usage is averaged since the container started
+		startedAt, err := time.Parse(time.RFC3339Nano, info.State.StartedAt)
+		if err == nil && time.Since(startedAt) > 0 {
+			projectInfo.CpuUsage = float64(stats.CpuTime) / float64(time.Since(startedAt)) * 100
+		}
+	}
+
 	return projectInfo, nil
 }
 
@@ -5,6 +5,9 @@ package docker_test
 
 import (
 	"fmt"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -22,7 +25,8 @@ func (s *DockerClientTestSuite) TestGetProjectInfo() {
 	inspectResult := types.ContainerJSON{
 		ContainerJSONBase: &types.ContainerJSONBase{
 			State: &types.ContainerState{
-				Running: true,
+				Running:   true,
+				StartedAt: time.Now().Add(-100 * time.Second).Format(time.RFC3339Nano),
 			},
 			Created: "test-created",
 		},
@@ -35,6 +39,13 @@ func (s *DockerClientTestSuite) TestGetProjectInfo() {
 	metadata := `{"test":"label"}`
 
 	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(inspectResult, nil)
+	s.mockClient.On("ContainerStatsOneShot", mock.Anything, containerName).Return(container.StatsResponseReader{
+		Body: io.NopCloser(strings.NewReader(`{
+			"networks":{"eth0":{"tx_bytes":1024},"eth1":{"tx_bytes":512}},
+			"cpu_stats":{"cpu_usage":{"total_usage":50000000000}},
+			"memory_stats":{"usage":4096,"stats":{"inactive_file":1024}}
+		}`)),
+	}, nil)
 
 	projectInfo, err := s.dockerClient.GetProjectInfo(project1)
 	require.Nil(s.T(), err)
@@ -42,6 +53,9 @@ func (s *DockerClientTestSuite) TestGetProjectInfo() {
 	require.Equal(s.T(), projectInfo.IsRunning, inspectResult.State.Running)
 	require.Equal(s.T(), projectInfo.Created, inspectResult.Created)
 	require.Equal(s.T(), projectInfo.ProviderMetadata, metadata)
+	require.Equal(s.T(), uint64(1536), projectInfo.EgressBytes)
+	require.Equal(s.T(), uint64(3072), projectInfo.MemoryUsage)
+	require.InDelta(s.T(), 50, projectInfo.CpuUsage, 1)
 }
 
 func (s *DockerClientTestSuite) TestGetWorkspaceInfo() {
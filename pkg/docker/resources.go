@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-units"
+)
+
+// GetContainerResources returns the CPU and memory limits of a project container
+func GetContainerResources(limits *project.ResourceLimits) container.Resources {
+	resources := container.Resources{}
+	if limits == nil {
+		return resources
+	}
+
+	if limits.Cpus > 0 {
+		resources.NanoCPUs = int64(float64(limits.Cpus) * 1e9)
+	}
+
+	if limits.Memory > 0 {
+		resources.Memory = int64(limits.Memory) * units.MiB
+		// Disable swap so that the memory limit is enforced
+		resources.MemorySwap = resources.Memory
+	}
+
+	return resources
+}
+
+// getStorageOpt returns the storage options that limit the size of the container filesystem
+func getStorageOpt(limits *project.ResourceLimits) map[string]string {
+	if limits == nil || limits.Disk == 0 {
+		return nil
+	}
+
+	return map[string]string{
+		"size": fmt.Sprintf("%dG", limits.Disk),
+	}
+}
+
+// isStorageOptUnsupported returns true if the error is caused by a storage driver that can not limit the container size
+func isStorageOptUnsupported(err error) bool {
+	return strings.Contains(err.Error(), "storage-opt")
+}
+
+// updateContainerResources applies the CPU and memory limits to an existing container.
+// The disk limit can only be set when the container is created
+func (d *DockerClient) updateContainerResources(containerId string, limits *project.ResourceLimits, logWriter io.Writer) error {
+	if limits.IsEmpty() {
+		return nil
+	}
+
+	if limits.Disk > 0 && logWriter != nil {
+		logWriter.Write([]byte("Disk limit is not supported for devcontainer projects and will be ignored\n"))
+	}
+
+	resources := GetContainerResources(limits)
+	if resources.NanoCPUs == 0 && resources.Memory == 0 {
+		return nil
+	}
+
+	_, err := d.apiClient.ContainerUpdate(context.Background(), containerId, container.UpdateConfig{
+		Resources: resources,
+	})
+	return err
+}
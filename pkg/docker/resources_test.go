@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetContainerResources(t *testing.T) {
+	require.Equal(t, container.Resources{}, docker.GetContainerResources(nil))
+	require.Equal(t, container.Resources{}, docker.GetContainerResources(&project.ResourceLimits{Disk: 20}))
+
+	require.Equal(t, container.Resources{
+		NanoCPUs:   1500000000,
+		Memory:     4 * 1024 * 1024 * 1024,
+		MemorySwap: 4 * 1024 * 1024 * 1024,
+	}, docker.GetContainerResources(&project.ResourceLimits{
+		Cpus:   1.5,
+		Memory: 4096,
+	}))
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+)
+
+// Runtime is the container engine behind the Docker API.
+// Podman serves a Docker compatible API so the same client is used for both.
+type Runtime string
+
+const (
+	RuntimeDocker Runtime = "docker"
+	RuntimePodman Runtime = "podman"
+)
+
+const (
+	dockerSocketPath = "/var/run/docker.sock"
+	// Socket of the Podman service started by root (podman.socket systemd unit)
+	podmanSocketPath = "/run/podman/podman.sock"
+)
+
+func (d *DockerClient) GetRuntime() (Runtime, error) {
+	version, err := d.apiClient.ServerVersion(context.Background())
+	if err != nil {
+		return RuntimeDocker, err
+	}
+
+	return GetRuntimeFromVersion(version), nil
+}
+
+// GetRuntimeFromVersion returns the runtime from the components reported by the daemon.
+// Podman reports a "Podman Engine" component in place of the Docker "Engine" component.
+func GetRuntimeFromVersion(version types.Version) Runtime {
+	for _, component := range version.Components {
+		if strings.Contains(strings.ToLower(component.Name), "podman") {
+			return RuntimePodman
+		}
+	}
+
+	return RuntimeDocker
+}
+
+// FindLocalSocket returns the first Docker or Podman socket found under root or an empty string.
+// The Docker socket is preferred, followed by the rootless Podman socket of the current user and the rootful Podman socket.
+func FindLocalSocket(root string) string {
+	candidates := []string{dockerSocketPath}
+
+	rootlessSocketPath := getRootlessPodmanSocketPath()
+	if rootlessSocketPath != "" {
+		candidates = append(candidates, rootlessSocketPath)
+	}
+
+	candidates = append(candidates, podmanSocketPath)
+
+	for _, candidate := range candidates {
+		info, err := os.Stat(filepath.Join(root, candidate))
+		if err == nil && info.Mode()&os.ModeSocket != 0 {
+			return candidate
+		}
+	}
+
+	return ""
+}
+
+func getRootlessPodmanSocketPath() string {
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		uid := os.Getuid()
+		// Root and platforms without user IDs have no rootless socket
+		if uid <= 0 {
+			return ""
+		}
+		runtimeDir = fmt.Sprintf("/run/user/%d", uid)
+	}
+
+	return filepath.Join(runtimeDir, "podman", "podman.sock")
+}
+
+// getDaemonSocketPath returns the path of the daemon socket on the daemon host.
+// It is used to give containers access to the daemon, e.g. for Docker in Docker.
+func (d *DockerClient) getDaemonSocketPath(runtime Runtime) string {
+	socketPath, found := strings.CutPrefix(d.apiClient.DaemonHost(), "unix://")
+	if found && socketPath != "" {
+		return socketPath
+	}
+
+	// The socket path of daemons reached over the network is unknown so the runtime default is assumed
+	if runtime == RuntimePodman {
+		return podmanSocketPath
+	}
+
+	return dockerSocketPath
+}
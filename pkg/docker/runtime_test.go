@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRuntimeFromVersion(t *testing.T) {
+	require.Equal(t, docker.RuntimeDocker, docker.GetRuntimeFromVersion(types.Version{
+		Components: []types.ComponentVersion{{Name: "Engine"}, {Name: "containerd"}},
+	}))
+	require.Equal(t, docker.RuntimePodman, docker.GetRuntimeFromVersion(types.Version{
+		Components: []types.ComponentVersion{{Name: "Podman Engine"}},
+	}))
+	require.Equal(t, docker.RuntimeDocker, docker.GetRuntimeFromVersion(types.Version{}))
+}
+
+func TestFindLocalSocket(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("XDG_RUNTIME_DIR", "/run/user/1000")
+
+	require.Empty(t, docker.FindLocalSocket(root))
+
+	// A regular file is not a socket
+	writeSocketFile(t, root, "run/podman/podman.sock", false)
+	require.Empty(t, docker.FindLocalSocket(root))
+
+	writeSocketFile(t, root, "run/user/1000/podman/podman.sock", true)
+	require.Equal(t, "/run/user/1000/podman/podman.sock", docker.FindLocalSocket(root))
+
+	writeSocketFile(t, root, "var/run/docker.sock", true)
+	require.Equal(t, "/var/run/docker.sock", docker.FindLocalSocket(root))
+}
+
+func writeSocketFile(t *testing.T, root, path string, socket bool) {
+	path = filepath.Join(root, path)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+
+	if !socket {
+		require.NoError(t, os.WriteFile(path, nil, 0644))
+		return
+	}
+
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+)
+
+// CreateSnapshot commits the project container filesystem to the snapshot image.
+// Volumes and bind mounts (including the project directory) are not part of the snapshot.
+func (d *DockerClient) CreateSnapshot(p *project.Project, snapshotImage string, logWriter io.Writer) error {
+	ctx := context.Background()
+
+	containerName := d.GetProjectContainerName(p)
+
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Committing project %s to %s\n", p.Name, snapshotImage)))
+	}
+
+	_, err := d.apiClient.ContainerCommit(ctx, containerName, container.CommitOptions{
+		Reference: snapshotImage,
+		Comment:   fmt.Sprintf("Daytona snapshot of project %s in workspace %s", p.Name, p.WorkspaceId),
+		Pause:     true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte("Snapshot created\n"))
+	}
+
+	return nil
+}
+
+// RestoreSnapshot recreates the project container from the snapshot image while keeping
+// the rest of the container configuration intact. The restored container is left stopped.
+// The new container is created next to the existing one, which is only removed once the creation succeeded.
+func (d *DockerClient) RestoreSnapshot(p *project.Project, snapshotImage string, logWriter io.Writer) error {
+	ctx := context.Background()
+
+	containerName := d.GetProjectContainerName(p)
+
+	c, err := d.apiClient.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = d.apiClient.ImageInspectWithRaw(ctx, snapshotImage)
+	if err != nil {
+		return fmt.Errorf("snapshot image %s is not available: %w", snapshotImage, err)
+	}
+
+	if c.State != nil && c.State.Running {
+		err = d.stopProjectContainer(p, logWriter)
+		if err != nil {
+			return err
+		}
+	}
+
+	config := *c.Config
+	config.Image = snapshotImage
+
+	var networkingConfig *network.NetworkingConfig
+	if c.NetworkSettings != nil && len(c.NetworkSettings.Networks) > 0 {
+		networkingConfig = &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{},
+		}
+		for name, endpoint := range c.NetworkSettings.Networks {
+			networkingConfig.EndpointsConfig[name] = &network.EndpointSettings{
+				Aliases: endpoint.Aliases,
+			}
+		}
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Restoring project %s from %s\n", p.Name, snapshotImage)))
+	}
+
+	name := strings.TrimPrefix(c.Name, "/")
+
+	// A container left over from an interrupted restore would block the name
+	err = d.RemoveContainer(name + "-restore")
+	if err != nil {
+		return err
+	}
+
+	restored, err := d.apiClient.ContainerCreate(ctx, &config, c.HostConfig, networkingConfig, nil, name+"-restore")
+	if err != nil {
+		return err
+	}
+
+	err = d.RemoveContainer(c.ID)
+	if err != nil {
+		// The project keeps its current container
+		removeErr := d.RemoveContainer(restored.ID)
+		if removeErr != nil {
+			return fmt.Errorf("%w, failed to remove the restored container: %w", err, removeErr)
+		}
+		return err
+	}
+
+	err = d.apiClient.ContainerRename(ctx, restored.ID, name)
+	if err != nil {
+		return fmt.Errorf("failed to rename the restored container to %s: %w", name, err)
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte("Snapshot restored\n"))
+	}
+
+	return nil
+}
+
+// DeleteSnapshot removes the snapshot image. Snapshots whose image no longer exists are not an error.
+func (d *DockerClient) DeleteSnapshot(snapshotImage string, logWriter io.Writer) error {
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Deleting snapshot image %s\n", snapshotImage)))
+	}
+
+	err := d.DeleteImage(snapshotImage, true, logWriter)
+	if err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"errors"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/errdefs"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const snapshotImage = "daytona-snapshot-123-test:snapshot1"
+
+func (s *DockerClientTestSuite) TestCreateSnapshot() {
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("ContainerCommit", mock.Anything, containerName, mock.MatchedBy(func(opts container.CommitOptions) bool {
+		return opts.Reference == snapshotImage && opts.Pause
+	})).Return(types.IDResponse{ID: "image-id"}, nil)
+
+	err := s.dockerClient.CreateSnapshot(project1, snapshotImage, nil)
+	require.Nil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) mockSnapshotContainer() (string, *container.HostConfig) {
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	hostConfig := &container.HostConfig{Privileged: true}
+
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			ID:   "container-id",
+			Name: "/" + containerName,
+			State: &types.ContainerState{
+				Running: false,
+			},
+			HostConfig: hostConfig,
+		},
+		Config: &container.Config{
+			Image:  project1.Image,
+			Labels: map[string]string{},
+		},
+	}, nil)
+
+	return containerName, hostConfig
+}
+
+func (s *DockerClientTestSuite) mockRemoveRestoreContainer(containerName string) {
+	s.mockClient.On("ContainerRemove", mock.Anything, containerName+"-restore", container.RemoveOptions{
+		Force:         true,
+		RemoveVolumes: true,
+	}).Return(errdefs.NotFound(errors.New("no such container")))
+}
+
+func (s *DockerClientTestSuite) TestRestoreSnapshot() {
+	containerName, hostConfig := s.mockSnapshotContainer()
+
+	s.mockClient.On("ImageInspectWithRaw", mock.Anything, snapshotImage).Return(types.ImageInspect{}, []byte{}, nil)
+	s.mockRemoveRestoreContainer(containerName)
+	s.mockClient.On("ContainerCreate", mock.Anything, mock.MatchedBy(func(config *container.Config) bool {
+		return config.Image == snapshotImage
+	}), hostConfig, mock.Anything, mock.Anything, containerName+"-restore").Return(container.CreateResponse{ID: "new-container-id"}, nil)
+	s.mockClient.On("ContainerRemove", mock.Anything, "container-id", container.RemoveOptions{
+		Force:         true,
+		RemoveVolumes: true,
+	}).Return(nil)
+	s.mockClient.On("ContainerRename", mock.Anything, "new-container-id", containerName).Return(nil)
+
+	err := s.dockerClient.RestoreSnapshot(project1, snapshotImage, nil)
+	require.Nil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestRestoreSnapshot_ImageNotFound() {
+	s.mockSnapshotContainer()
+
+	s.mockClient.On("ImageInspectWithRaw", mock.Anything, snapshotImage).Return(types.ImageInspect{}, []byte{}, errdefs.NotFound(errors.New("no such image")))
+
+	// Removing the existing container is not mocked, so the test fails if it is removed
+	err := s.dockerClient.RestoreSnapshot(project1, snapshotImage, nil)
+	require.NotNil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestRestoreSnapshot_CreateFails() {
+	containerName, hostConfig := s.mockSnapshotContainer()
+
+	s.mockClient.On("ImageInspectWithRaw", mock.Anything, snapshotImage).Return(types.ImageInspect{}, []byte{}, nil)
+	s.mockRemoveRestoreContainer(containerName)
+	s.mockClient.On("ContainerCreate", mock.Anything, mock.Anything, hostConfig, mock.Anything, mock.Anything, containerName+"-restore").Return(container.CreateResponse{}, errors.New("create failed"))
+
+	// Removing the existing container is not mocked, so the test fails if it is removed
+	err := s.dockerClient.RestoreSnapshot(project1, snapshotImage, nil)
+	require.NotNil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestDeleteSnapshot() {
+	s.mockClient.On("ImageRemove", mock.Anything, snapshotImage, image.RemoveOptions{Force: true}).Return([]image.DeleteResponse{}, nil).Once()
+
+	err := s.dockerClient.DeleteSnapshot(snapshotImage, nil)
+	require.Nil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestDeleteSnapshot_ImageNotFound() {
+	s.mockClient.On("ImageRemove", mock.Anything, snapshotImage, image.RemoveOptions{Force: true}).Return([]image.DeleteResponse{}, errdefs.NotFound(errors.New("no such image"))).Once()
+
+	err := s.dockerClient.DeleteSnapshot(snapshotImage, nil)
+	require.Nil(s.T(), err)
+}
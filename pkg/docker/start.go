@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provider/util"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/docker/docker/api/types/container"
@@ -20,6 +21,10 @@ func (d *DockerClient) StartProject(opts *CreateProjectOptions, daytonaDownloadU
 	var err error
 	containerUser := opts.Project.User
 
+	if opts.Timings == nil {
+		opts.Timings = &provider.ProjectCreationTimings{}
+	}
+
 	builderType, err := detect.DetectProjectBuilderType(opts.Project.BuildConfig, opts.ProjectDir, opts.SshClient)
 	if err != nil {
 		return err
@@ -31,7 +36,11 @@ func (d *DockerClient) StartProject(opts *CreateProjectOptions, daytonaDownloadU
 		remoteUser, err = d.startDevcontainerProject(opts)
 		containerUser = string(remoteUser)
 	case detect.BuilderTypeImage:
-		err = d.startImageProject(opts)
+		var remoteUser RemoteUser
+		remoteUser, err = d.startImageProject(opts)
+		if remoteUser != "" {
+			containerUser = string(remoteUser)
+		}
 	default:
 		return fmt.Errorf("unknown builder type: %s", builderType)
 	}
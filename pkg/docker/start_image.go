@@ -12,6 +12,7 @@ import (
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/client"
+	log "github.com/sirupsen/logrus"
 )
 
 type FeatureItem struct {
@@ -20,19 +21,30 @@ type FeatureItem struct {
 	// Add other fields as needed
 }
 
-func (d *DockerClient) startImageProject(opts *CreateProjectOptions) error {
+func (d *DockerClient) startImageProject(opts *CreateProjectOptions) (RemoteUser, error) {
 	containerName := d.GetProjectContainerName(opts.Project)
 	ctx := context.Background()
 
 	c, err := d.apiClient.ContainerInspect(ctx, containerName)
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	// Container labels include the labels of the image
+	metadata, err := GetImageMetadata(c.Config.Labels)
+	if err != nil {
+		log.Warnf("Failed to read image metadata: %v", err)
+	}
+
+	var remoteUser RemoteUser
+	if metadata != nil {
+		remoteUser = RemoteUser(metadata.RemoteUser)
 	}
 
 	// TODO: Add logging
 	_, composeContainers, err := d.getComposeContainers(c)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if composeContainers != nil {
@@ -43,7 +55,7 @@ func (d *DockerClient) startImageProject(opts *CreateProjectOptions) error {
 		for _, c := range composeContainers {
 			err = d.apiClient.ContainerStart(ctx, c.ID, container.StartOptions{})
 			if err != nil {
-				return err
+				return "", err
 			}
 			if opts.LogWriter != nil {
 				opts.LogWriter.Write([]byte(fmt.Sprintf("Started %s\n", strings.TrimPrefix(c.Names[0], "/"))))
@@ -52,12 +64,12 @@ func (d *DockerClient) startImageProject(opts *CreateProjectOptions) error {
 	}
 
 	if err == nil && c.State.Running {
-		return nil
+		return remoteUser, nil
 	}
 
 	err = d.apiClient.ContainerStart(ctx, containerName, container.StartOptions{})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// make sure container is running
@@ -65,7 +77,7 @@ func (d *DockerClient) startImageProject(opts *CreateProjectOptions) error {
 	for {
 		c, err = d.apiClient.ContainerInspect(ctx, containerName)
 		if err != nil {
-			return err
+			return "", err
 		}
 
 		if c.State.Running {
@@ -79,34 +91,51 @@ func (d *DockerClient) startImageProject(opts *CreateProjectOptions) error {
 	//	These entrypoints are used to run commands after the container is started (e.g. dockerd)
 	c, err = d.apiClient.ContainerInspect(ctx, containerName)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	//	First check if the image was built using devcontainer
 
 	// Check if the "devcontainer.metadata" label exists
-	metadata, ok := c.Config.Labels["devcontainer.metadata"]
+	metadataLabel, ok := c.Config.Labels[IMAGE_METADATA_LABEL]
 	if ok {
 		opts.LogWriter.Write([]byte("Found devcontainer.metadata label\n"))
 		// Parse the metadata JSON
 		var features []FeatureItem
-		err = json.Unmarshal([]byte(metadata), &features)
+		err = json.Unmarshal([]byte(metadataLabel), &features)
 		if err != nil {
 			opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to parse devcontainer.metadata: %v", err)))
-			return nil
+		} else {
+			// Execute entrypoints
+			err = executeEntrypoints(ctx, d.apiClient, c.ID, features, opts)
+			if err != nil {
+				opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to execute entrypoints: %v", err)))
+			}
 		}
+	}
 
-		// Execute entrypoints
-		err = executeEntrypoints(ctx, d.apiClient, c.ID, features, opts)
+	startedAt := time.Now()
+
+	if metadata != nil && len(metadata.PostStartCommands) > 0 {
+		opts.LogWriter.Write([]byte("Running image postStart commands\n"))
+
+		err = d.runImageMetadataCommands(c.ID, c.Config.User, "", metadata.PostStartCommands, opts.LogWriter)
 		if err != nil {
-			opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to execute entrypoints: %v", err)))
-			return nil
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to run postStart commands: %v\n", err)))
 		}
 	}
 
-	//	TODO: add daytona metadata support for images that are not based on the devcontainer config
+	if len(opts.Project.PostStartCommands) > 0 {
+		opts.LogWriter.Write([]byte("Running project postStart commands\n"))
 
-	return nil
+		err = d.runImageMetadataCommands(c.ID, c.Config.User, "", toShellCommands(opts.Project.PostStartCommands), opts.LogWriter)
+		if err != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to run postStart commands: %v\n", err)))
+		}
+	}
+	opts.Timings.Hooks += time.Since(startedAt)
+
+	return remoteUser, nil
 }
 
 func executeEntrypoints(ctx context.Context, cli client.APIClient, containerID string, features []FeatureItem, opts *CreateProjectOptions) error {
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+)
+
+type containerStats struct {
+	// Bytes sent over all networks of the container since it was started
+	EgressBytes uint64
+	// Memory used by the container without the page cache, as reported by docker stats
+	MemoryBytes uint64
+	// Total CPU time consumed by the container since it was started
+	CpuTime time.Duration
+}
+
+func (d *DockerClient) getContainerStats(p *project.Project) (*containerStats, error) {
+	ctx := context.Background()
+
+	stats, err := d.apiClient.ContainerStatsOneShot(ctx, d.GetProjectContainerName(p))
+	if err != nil {
+		return nil, err
+	}
+	defer stats.Body.Close()
+
+	var statsResponse container.StatsResponse
+	err = json.NewDecoder(stats.Body).Decode(&statsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &containerStats{
+		CpuTime: time.Duration(statsResponse.CPUStats.CPUUsage.TotalUsage),
+	}
+
+	for _, network := range statsResponse.Networks {
+		result.EgressBytes += network.TxBytes
+	}
+
+	// Same calculation as the docker CLI: the inactive page cache can be reclaimed so it is not counted
+	result.MemoryBytes = statsResponse.MemoryStats.Usage
+	for _, key := range []string{"total_inactive_file", "inactive_file"} {
+		if inactive, ok := statsResponse.MemoryStats.Stats[key]; ok && inactive < result.MemoryBytes {
+			result.MemoryBytes -= inactive
+			break
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+type UsernsMode string
+
+const (
+	UsernsModeNone UsernsMode = "none"
+	// The Docker daemon runs as an unprivileged user and the container root user is mapped to that user
+	UsernsModeRootless UsernsMode = "rootless"
+	// The Docker daemon runs with userns-remap and container users are mapped to subordinate host IDs
+	UsernsModeRemap UsernsMode = "userns-remap"
+)
+
+func (d *DockerClient) GetUsernsMode() (UsernsMode, error) {
+	info, err := d.apiClient.Info(context.Background())
+	if err != nil {
+		return UsernsModeNone, err
+	}
+
+	return GetUsernsModeFromSecurityOptions(info.SecurityOptions), nil
+}
+
+// GetUsernsModeFromSecurityOptions parses the security options reported by the Docker daemon
+// (e.g. "name=seccomp,profile=builtin", "name=rootless", "name=userns")
+func GetUsernsModeFromSecurityOptions(securityOptions []string) UsernsMode {
+	mode := UsernsModeNone
+
+	for _, option := range securityOptions {
+		for _, field := range strings.Split(option, ",") {
+			switch field {
+			case "name=rootless":
+				return UsernsModeRootless
+			case "name=userns":
+				mode = UsernsModeRemap
+			}
+		}
+	}
+
+	return mode
+}
+
+// getHostConfigUsernsMode returns the user namespace mode for project containers.
+// Privileged containers can't run in a remapped user namespace so they are run in the host namespace instead.
+func getHostConfigUsernsMode(usernsMode UsernsMode) container.UsernsMode {
+	if usernsMode == UsernsModeRemap {
+		return "host"
+	}
+
+	return ""
+}
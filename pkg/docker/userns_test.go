@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUsernsModeFromSecurityOptions(t *testing.T) {
+	tests := []struct {
+		securityOptions []string
+		expected        docker.UsernsMode
+	}{
+		{nil, docker.UsernsModeNone},
+		{[]string{"name=seccomp,profile=builtin", "name=cgroupns"}, docker.UsernsModeNone},
+		{[]string{"name=seccomp,profile=builtin", "name=rootless", "name=cgroupns"}, docker.UsernsModeRootless},
+		{[]string{"name=apparmor", "name=seccomp,profile=builtin", "name=userns"}, docker.UsernsModeRemap},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, docker.GetUsernsModeFromSecurityOptions(test.securityOptions))
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package e2e implements end-to-end encryption of toolbox requests between a client and a
+// project agent. Request and response bodies are encrypted with keys derived from an X25519
+// key exchange so the Daytona Server proxying the traffic can not read workspace contents.
+// Request paths and query parameters remain visible to the server.
+package e2e
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// Header carrying the base64 encoded ephemeral public key of the client
+const PUBLIC_KEY_HEADER = "X-Daytona-E2E-Public-Key"
+
+// Header set by the agent on responses with an encrypted body
+const ENCRYPTED_HEADER = "X-Daytona-E2E-Encrypted"
+
+// Project environment variable that makes the agent reject toolbox requests that are not encrypted
+const REQUIRED_ENV_VAR = "DAYTONA_E2E_REQUIRED"
+
+const keySize = 32
+
+var ErrInvalidPublicKey = errors.New("invalid end-to-end encryption public key")
+
+func GenerateKey() (*ecdh.PrivateKey, error) {
+	return ecdh.X25519().GenerateKey(rand.Reader)
+}
+
+// LoadOrCreateKey reads the private key stored at path or generates and stores a new one
+func LoadOrCreateKey(path string) (*ecdh.PrivateKey, error) {
+	keyBytes, err := os.ReadFile(path)
+	if err == nil {
+		return ecdh.X25519().NewPrivateKey(keyBytes)
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, err := GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(path, key.Bytes(), 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func EncodePublicKey(key *ecdh.PublicKey) string {
+	return base64.StdEncoding.EncodeToString(key.Bytes())
+}
+
+func DecodePublicKey(encoded string) (*ecdh.PublicKey, error) {
+	keyBytes, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+
+	key, err := ecdh.X25519().NewPublicKey(keyBytes)
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+
+	return key, nil
+}
+
+// Fingerprint returns a short representation of the public key that can be compared out of band
+func Fingerprint(key *ecdh.PublicKey) string {
+	sum := sha256.Sum256(key.Bytes())
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+// DeriveKeys derives the keys used to encrypt the request and the response body.
+// Separate keys are used for each direction so the chunk nonces never repeat under the same key.
+func DeriveKeys(privateKey *ecdh.PrivateKey, peerPublicKey *ecdh.PublicKey) (requestKey []byte, responseKey []byte, err error) {
+	secret, err := privateKey.ECDH(peerPublicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Both public keys are mixed into the derivation so the keys are bound to this exchange
+	salt := concatSorted(privateKey.PublicKey().Bytes(), peerPublicKey.Bytes())
+
+	requestKey = make([]byte, keySize)
+	_, err = io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte("daytona-e2e request")), requestKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseKey = make([]byte, keySize)
+	_, err = io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte("daytona-e2e response")), responseKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return requestKey, responseKey, nil
+}
+
+// concatSorted concatenates the keys in a fixed order so both sides derive the same salt
+func concatSorted(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	return append(append([]byte{}, a...), b...)
+}
@@ -0,0 +1,280 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/e2e"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamRoundTrip(t *testing.T) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.Nil(t, err)
+
+	for _, size := range []int{0, 10, 64 * 1024, 3*64*1024 + 7} {
+		plaintext := make([]byte, size)
+		_, err := rand.Read(plaintext)
+		require.Nil(t, err)
+
+		var encrypted bytes.Buffer
+		writer, err := e2e.NewWriter(&encrypted, key)
+		require.Nil(t, err)
+
+		_, err = writer.Write(plaintext)
+		require.Nil(t, err)
+		require.Nil(t, writer.Close())
+
+		reader, err := e2e.NewReader(&encrypted, key)
+		require.Nil(t, err)
+
+		decrypted, err := io.ReadAll(reader)
+		require.Nil(t, err)
+		require.Equal(t, plaintext, decrypted)
+	}
+}
+
+func TestStreamTampered(t *testing.T) {
+	key := make([]byte, 32)
+
+	var encrypted bytes.Buffer
+	writer, err := e2e.NewWriter(&encrypted, key)
+	require.Nil(t, err)
+
+	_, err = writer.Write([]byte("workspace contents"))
+	require.Nil(t, err)
+	require.Nil(t, writer.Close())
+
+	tampered := encrypted.Bytes()
+	tampered[len(tampered)-1] ^= 1
+
+	reader, err := e2e.NewReader(bytes.NewReader(tampered), key)
+	require.Nil(t, err)
+
+	_, err = io.ReadAll(reader)
+	require.Equal(t, e2e.ErrDecryptionFailed, err)
+}
+
+func TestStreamTruncated(t *testing.T) {
+	key := make([]byte, 32)
+
+	var encrypted bytes.Buffer
+	writer, err := e2e.NewWriter(&encrypted, key)
+	require.Nil(t, err)
+
+	_, err = writer.Write([]byte("workspace contents"))
+	require.Nil(t, err)
+	require.Nil(t, writer.Flush())
+
+	// The final chunk is never written
+	reader, err := e2e.NewReader(&encrypted, key)
+	require.Nil(t, err)
+
+	_, err = io.ReadAll(reader)
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestTransport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	agentKey, err := e2e.GenerateKey()
+	require.Nil(t, err)
+
+	var receivedBody []byte
+	r := gin.New()
+	r.Use(e2e.Middleware(agentKey, false))
+	r.POST("/echo", func(ctx *gin.Context) {
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		receivedBody = body
+		ctx.Data(200, "text/plain", body)
+	})
+
+	var wireBody []byte
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Record what an intermediary sees before handing the request to the agent
+		wireBody, _ = io.ReadAll(req.Body)
+		req.Body = io.NopCloser(bytes.NewReader(wireBody))
+		r.ServeHTTP(w, req)
+	}))
+	defer proxy.Close()
+
+	client := &http.Client{
+		Transport: &e2e.Transport{
+			Base: http.DefaultTransport,
+			GetAgentKey: func(req *http.Request) (*ecdh.PublicKey, error) {
+				return agentKey.PublicKey(), nil
+			},
+		},
+	}
+
+	res, err := client.Post(proxy.URL+"/echo", "text/plain", bytes.NewReader([]byte("secret file contents")))
+	require.Nil(t, err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	require.Nil(t, err)
+
+	require.Equal(t, "secret file contents", string(receivedBody))
+	require.Equal(t, "secret file contents", string(body))
+	require.NotContains(t, string(wireBody), "secret file contents")
+}
+
+func TestTransportRejectsPlaintextResponse(t *testing.T) {
+	agentKey, err := e2e.GenerateKey()
+	require.Nil(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte("forged contents"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &e2e.Transport{
+			Base: http.DefaultTransport,
+			GetAgentKey: func(req *http.Request) (*ecdh.PublicKey, error) {
+				return agentKey.PublicKey(), nil
+			},
+		},
+	}
+
+	_, err = client.Get(server.URL)
+	require.ErrorIs(t, err, e2e.ErrUnencryptedResponse)
+}
+
+func TestMiddlewareEncryptsErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	agentKey, err := e2e.GenerateKey()
+	require.Nil(t, err)
+
+	r := gin.New()
+	r.Use(e2e.Middleware(agentKey, false))
+	r.GET("/fail", func(ctx *gin.Context) {
+		ctx.AbortWithError(http.StatusConflict, errors.New("uncommitted changes"))
+	})
+
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &e2e.Transport{
+			Base: http.DefaultTransport,
+			GetAgentKey: func(req *http.Request) (*ecdh.PublicKey, error) {
+				return agentKey.PublicKey(), nil
+			},
+		},
+	}
+
+	res, err := client.Get(server.URL + "/fail")
+	require.Nil(t, err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	require.Nil(t, err)
+
+	require.Equal(t, http.StatusConflict, res.StatusCode)
+	require.JSONEq(t, `{"error":"uncommitted changes"}`, string(body))
+}
+
+func TestMiddlewareRequiresEncryption(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	agentKey, err := e2e.GenerateKey()
+	require.Nil(t, err)
+
+	r := gin.New()
+	r.Use(e2e.Middleware(agentKey, true))
+	r.GET("/project-dir", func(ctx *gin.Context) {
+		ctx.String(http.StatusOK, "/home/daytona/project")
+	})
+
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	// A request whose public key header was stripped on the way must not get a plaintext response
+	res, err := http.Get(server.URL + "/project-dir")
+	require.Nil(t, err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	require.Nil(t, err)
+
+	require.Equal(t, http.StatusForbidden, res.StatusCode)
+	require.NotContains(t, string(body), "/home/daytona/project")
+
+	client := &http.Client{
+		Transport: &e2e.Transport{
+			Base: http.DefaultTransport,
+			GetAgentKey: func(req *http.Request) (*ecdh.PublicKey, error) {
+				return agentKey.PublicKey(), nil
+			},
+		},
+	}
+
+	res, err = client.Get(server.URL + "/project-dir")
+	require.Nil(t, err)
+	defer res.Body.Close()
+
+	body, err = io.ReadAll(res.Body)
+	require.Nil(t, err)
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "/home/daytona/project", string(body))
+}
+
+func TestMiddlewareStreamsFlushedChunks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	agentKey, err := e2e.GenerateKey()
+	require.Nil(t, err)
+
+	release := make(chan struct{})
+
+	r := gin.New()
+	r.Use(e2e.Middleware(agentKey, true))
+	r.GET("/logs", func(ctx *gin.Context) {
+		ctx.Writer.WriteString("first line\n")
+		ctx.Writer.Flush()
+		<-release
+		ctx.Writer.WriteString("second line\n")
+	})
+
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &e2e.Transport{
+			Base: http.DefaultTransport,
+			GetAgentKey: func(req *http.Request) (*ecdh.PublicKey, error) {
+				return agentKey.PublicKey(), nil
+			},
+		},
+	}
+
+	res, err := client.Get(server.URL + "/logs")
+	require.Nil(t, err)
+	defer res.Body.Close()
+
+	// The flushed chunk is readable before the handler finishes
+	line, err := bufio.NewReader(res.Body).ReadString('\n')
+	require.Nil(t, err)
+	require.Equal(t, "first line\n", line)
+
+	close(release)
+}
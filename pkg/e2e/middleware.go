@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"crypto/ecdh"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrEncryptionRequired = errors.New("end-to-end encryption is required")
+
+// Middleware decrypts the body of requests that carry the client public key header and
+// encrypts their response body. Requests without the header are rejected if required is set
+// and passed through unchanged otherwise.
+func Middleware(privateKey *ecdh.PrivateKey, required bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		encodedKey := ctx.GetHeader(PUBLIC_KEY_HEADER)
+		if encodedKey == "" {
+			if required {
+				ctx.AbortWithError(http.StatusForbidden, ErrEncryptionRequired)
+				return
+			}
+			ctx.Next()
+			return
+		}
+
+		clientKey, err := DecodePublicKey(encodedKey)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+
+		requestKey, responseKey, err := DeriveKeys(privateKey, clientKey)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+
+		if ctx.Request.Body != nil && ctx.Request.Body != http.NoBody {
+			reader, err := NewReader(ctx.Request.Body, requestKey)
+			if err != nil {
+				ctx.AbortWithError(http.StatusInternalServerError, err)
+				return
+			}
+
+			ctx.Request.Body = &readCloser{Reader: reader, Closer: ctx.Request.Body}
+			ctx.Request.ContentLength = -1
+			ctx.Request.Header.Del("Content-Length")
+		}
+
+		writer, err := NewWriter(ctx.Writer, responseKey)
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		ctx.Header(ENCRYPTED_HEADER, "true")
+		encryptedWriter := &encryptedResponseWriter{ResponseWriter: ctx.Writer, writer: writer}
+		ctx.Writer = encryptedWriter
+
+		ctx.Next()
+
+		if !bodyAllowed(ctx.Writer.Status()) {
+			return
+		}
+
+		// Error responses are otherwise rendered by the logging middleware after the encrypted body is closed
+		if len(ctx.Errors) > 0 && !encryptedWriter.written {
+			ctx.JSON(ctx.Writer.Status(), gin.H{"error": ctx.Errors[0].Err.Error()})
+		}
+
+		err = writer.Close()
+		if err != nil {
+			log.Errorf("failed to write end-to-end encrypted response: %s", err)
+		}
+	}
+}
+
+type encryptedResponseWriter struct {
+	gin.ResponseWriter
+	writer  *Writer
+	written bool
+}
+
+func (w *encryptedResponseWriter) WriteHeader(code int) {
+	// The length of the encrypted body differs from the plaintext length
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *encryptedResponseWriter) Write(data []byte) (int, error) {
+	w.Header().Del("Content-Length")
+	w.written = true
+	return w.writer.Write(data)
+}
+
+func (w *encryptedResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *encryptedResponseWriter) Flush() {
+	err := w.writer.Flush()
+	if err != nil {
+		log.Errorf("failed to flush end-to-end encrypted response: %s", err)
+		return
+	}
+
+	w.ResponseWriter.Flush()
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func bodyAllowed(status int) bool {
+	return status != http.StatusNoContent && status != http.StatusNotModified && (status < 100 || status > 199)
+}
@@ -0,0 +1,203 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Plaintext is sealed in chunks so large file transfers don't have to be buffered in memory.
+// Each chunk is prefixed with a 4 byte header holding the ciphertext length and, in the highest bit,
+// a flag marking the final chunk. The chunk counter and the final flag are part of the nonce so
+// chunks can't be reordered, dropped or truncated without failing authentication.
+const (
+	chunkSize  = 64 * 1024
+	finalFlag  = 1 << 31
+	headerSize = 4
+)
+
+var ErrDecryptionFailed = errors.New("failed to decrypt end-to-end encrypted stream")
+
+type Writer struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+	closed  bool
+}
+
+// NewWriter returns a writer that encrypts everything written to it with key.
+// Close must be called to write the final chunk.
+func NewWriter(w io.Writer, key []byte) (*Writer, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, chunkSize),
+	}, nil
+}
+
+func (sw *Writer) Write(p []byte) (int, error) {
+	if sw.closed {
+		return 0, errors.New("write to closed end-to-end encryption writer")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		n := copy(sw.buf[len(sw.buf):cap(sw.buf)], p)
+		sw.buf = sw.buf[:len(sw.buf)+n]
+		p = p[n:]
+		written += n
+
+		if len(sw.buf) == cap(sw.buf) {
+			err := sw.writeChunk(false)
+			if err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// Flush seals the buffered plaintext so it can be read by the peer before the stream ends
+func (sw *Writer) Flush() error {
+	if sw.closed || len(sw.buf) == 0 {
+		return nil
+	}
+
+	return sw.writeChunk(false)
+}
+
+func (sw *Writer) Close() error {
+	if sw.closed {
+		return nil
+	}
+
+	sw.closed = true
+	return sw.writeChunk(true)
+}
+
+func (sw *Writer) writeChunk(final bool) error {
+	sealed := sw.aead.Seal(nil, getNonce(sw.counter, final), sw.buf, nil)
+	sw.counter++
+	sw.buf = sw.buf[:0]
+
+	header := uint32(len(sealed))
+	if final {
+		header |= finalFlag
+	}
+
+	chunk := make([]byte, headerSize, headerSize+len(sealed))
+	binary.BigEndian.PutUint32(chunk, header)
+
+	_, err := sw.w.Write(append(chunk, sealed...))
+	return err
+}
+
+type Reader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+	done    bool
+}
+
+// NewReader returns a reader that decrypts a stream written by an encryption writer using the same key
+func NewReader(r io.Reader, key []byte) (*Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		r:    r,
+		aead: aead,
+	}, nil
+}
+
+func (sr *Reader) Read(p []byte) (int, error) {
+	for len(sr.buf) == 0 {
+		if sr.done {
+			return 0, io.EOF
+		}
+
+		err := sr.readChunk()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, sr.buf)
+	sr.buf = sr.buf[n:]
+
+	return n, nil
+}
+
+func (sr *Reader) readChunk() error {
+	header := make([]byte, headerSize)
+	_, err := io.ReadFull(sr.r, header)
+	if err != nil {
+		if err == io.EOF {
+			// The stream ended before the final chunk
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	length := binary.BigEndian.Uint32(header)
+	final := length&finalFlag != 0
+	length &^= finalFlag
+
+	if length > chunkSize+uint32(sr.aead.Overhead()) {
+		return ErrDecryptionFailed
+	}
+
+	sealed := make([]byte, length)
+	_, err = io.ReadFull(sr.r, sealed)
+	if err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	plaintext, err := sr.aead.Open(nil, getNonce(sr.counter, final), sealed, nil)
+	if err != nil {
+		return ErrDecryptionFailed
+	}
+
+	sr.counter++
+	sr.buf = plaintext
+	sr.done = final
+
+	return nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func getNonce(counter uint64, final bool) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce, counter)
+	if final {
+		nonce[11] = 1
+	}
+
+	return nonce
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"crypto/ecdh"
+	"errors"
+	"io"
+	"net/http"
+)
+
+var ErrUnencryptedResponse = errors.New("expected an end-to-end encrypted response but received a plaintext one")
+
+// Transport encrypts request bodies for, and decrypts response bodies from, a project agent
+type Transport struct {
+	Base http.RoundTripper
+	// GetAgentKey returns the public key of the agent the request is sent to.
+	// Requests for which it returns a nil key are sent unencrypted.
+	GetAgentKey func(req *http.Request) (*ecdh.PublicKey, error)
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	agentKey, err := t.GetAgentKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if agentKey == nil {
+		return t.Base.RoundTrip(req)
+	}
+
+	// A new key is generated for every request so keys and nonces are never reused
+	clientKey, err := GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	requestKey, responseKey, err := DeriveKeys(clientKey, agentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set(PUBLIC_KEY_HEADER, EncodePublicKey(clientKey.PublicKey()))
+
+	if req.Body != nil && req.Body != http.NoBody {
+		encryptedBody, err := encryptBody(req.Body, requestKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Body = encryptedBody
+		req.ContentLength = -1
+		// The encrypted body is streamed and can not be replayed
+		req.GetBody = nil
+		req.Header.Del("Content-Length")
+	}
+
+	res, err := t.Base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Header.Get(ENCRYPTED_HEADER) == "" {
+		// Errors returned by the Daytona Server itself, e.g. when the project is not found, are not encrypted.
+		// A successful response must always come from the agent.
+		if res.StatusCode >= 200 && res.StatusCode < 300 {
+			res.Body.Close()
+			return nil, ErrUnencryptedResponse
+		}
+		return res, nil
+	}
+
+	reader, err := NewReader(res.Body, responseKey)
+	if err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	res.Body = &readCloser{Reader: reader, Closer: res.Body}
+	res.ContentLength = -1
+	res.Header.Del("Content-Length")
+
+	return res, nil
+}
+
+func encryptBody(body io.ReadCloser, key []byte) (io.ReadCloser, error) {
+	pipeReader, pipeWriter := io.Pipe()
+
+	writer, err := NewWriter(pipeWriter, key)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		defer body.Close()
+
+		_, err := io.Copy(writer, body)
+		if err == nil {
+			err = writer.Close()
+		}
+		pipeWriter.CloseWithError(err)
+	}()
+
+	return pipeReader, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+)
+
+const DEFAULT_BLOCK_SIZE = 2048
+
+// Signature describes the blocks of a file so that the other side of the sync can compute
+// the changes between its version of the file and this one without transferring the file
+type Signature struct {
+	BlockSize int
+	Size      int64
+	Blocks    []BlockSignature
+}
+
+type BlockSignature struct {
+	// Rolling checksum used to find candidate blocks at any offset
+	Weak uint32
+	// Hash used to confirm that a candidate block matches
+	Strong [sha256.Size]byte
+}
+
+// Operation is a step of a delta. A delta rebuilds the new version of a file from the blocks of the
+// old version and the data that is not found in it
+type Operation struct {
+	// Index of the block of the old version to copy, used if Data is nil
+	Block int
+	Data  []byte
+}
+
+// ComputeSignature returns the signature of the file read from r
+func ComputeSignature(r io.Reader, blockSize int) (*Signature, error) {
+	signature := &Signature{BlockSize: blockSize}
+	buf := make([]byte, blockSize)
+
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			signature.Size += int64(n)
+			signature.Blocks = append(signature.Blocks, BlockSignature{
+				Weak:   weakChecksum(buf[:n]),
+				Strong: sha256.Sum256(buf[:n]),
+			})
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return signature, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ComputeDelta returns the operations that turn the file with the given signature into data.
+// Blocks are matched at any offset so that insertions and deletions only transfer the changed data
+func ComputeDelta(signature *Signature, data []byte) []Operation {
+	blockSize := signature.BlockSize
+	if len(signature.Blocks) == 0 || blockSize <= 0 {
+		return literalDelta(data)
+	}
+
+	blocks := map[uint32][]int{}
+	for i, block := range signature.Blocks {
+		blocks[block.Weak] = append(blocks[block.Weak], i)
+	}
+
+	// The last block of the old version can be shorter than the block size and is only matched at the end
+	lastBlock := len(signature.Blocks) - 1
+	lastBlockSize := int(signature.Size - int64(lastBlock)*int64(blockSize))
+
+	delta := []Operation{}
+	literalStart := 0
+	offset := 0
+
+	var checksum rollingChecksum
+	if len(data) >= blockSize {
+		checksum = newRollingChecksum(data[:blockSize])
+	}
+
+	for offset+blockSize <= len(data) {
+		weak := checksum.sum()
+		if match := findBlock(signature, blocks[weak], weak, data[offset:offset+blockSize]); match >= 0 {
+			if literalStart < offset {
+				delta = append(delta, Operation{Data: data[literalStart:offset]})
+			}
+			delta = append(delta, Operation{Block: match})
+
+			offset += blockSize
+			literalStart = offset
+			if offset+blockSize <= len(data) {
+				checksum = newRollingChecksum(data[offset : offset+blockSize])
+			}
+			continue
+		}
+
+		if offset+blockSize < len(data) {
+			checksum.roll(data[offset], data[offset+blockSize])
+		}
+		offset++
+	}
+
+	// Match the end of the remaining data against a short last block
+	tail := data[literalStart:]
+	if lastBlockSize < blockSize && lastBlockSize > 0 && len(tail) >= lastBlockSize {
+		suffix := tail[len(tail)-lastBlockSize:]
+		if findBlock(signature, []int{lastBlock}, weakChecksum(suffix), suffix) >= 0 {
+			if len(tail) > lastBlockSize {
+				delta = append(delta, Operation{Data: tail[:len(tail)-lastBlockSize]})
+			}
+			return append(delta, Operation{Block: lastBlock})
+		}
+	}
+
+	if len(tail) > 0 {
+		delta = append(delta, Operation{Data: tail})
+	}
+
+	return delta
+}
+
+// ApplyDelta writes the new version of a file rebuilt from the old version and the delta
+func ApplyDelta(old io.ReaderAt, blockSize int, delta []Operation, w io.Writer) error {
+	buf := make([]byte, blockSize)
+
+	for _, op := range delta {
+		if op.Data != nil {
+			_, err := w.Write(op.Data)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if old == nil {
+			return fmt.Errorf("delta references block %d of an empty file", op.Block)
+		}
+
+		n, err := old.ReadAt(buf, int64(op.Block)*int64(blockSize))
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("delta references missing block %d", op.Block)
+		}
+
+		_, err = w.Write(buf[:n])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func literalDelta(data []byte) []Operation {
+	if len(data) == 0 {
+		return []Operation{}
+	}
+
+	return []Operation{{Data: data}}
+}
+
+func findBlock(signature *Signature, candidates []int, weak uint32, data []byte) int {
+	var strong *[sha256.Size]byte
+
+	for _, i := range candidates {
+		if signature.Blocks[i].Weak != weak {
+			continue
+		}
+		if strong == nil {
+			sum := sha256.Sum256(data)
+			strong = &sum
+		}
+		if signature.Blocks[i].Strong == *strong {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// rollingChecksum is the rsync weak checksum. It can be moved along the data one byte at a time
+type rollingChecksum struct {
+	a, b   uint32
+	length uint32
+}
+
+func newRollingChecksum(data []byte) rollingChecksum {
+	c := rollingChecksum{length: uint32(len(data))}
+	for i, x := range data {
+		c.a += uint32(x)
+		c.b += uint32(len(data)-i) * uint32(x)
+	}
+	return c
+}
+
+func (c *rollingChecksum) roll(out, in byte) {
+	c.a = c.a - uint32(out) + uint32(in)
+	c.b = c.b - c.length*uint32(out) + c.a
+}
+
+func (c rollingChecksum) sum() uint32 {
+	return (c.a & 0xffff) | (c.b&0xffff)<<16
+}
+
+func weakChecksum(data []byte) uint32 {
+	c := newRollingChecksum(data)
+	return c.sum()
+}
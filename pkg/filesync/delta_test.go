@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomData(size int) []byte {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(int64(size))).Read(data)
+	return data
+}
+
+// applyDelta returns the new version rebuilt from old and the literal data that the delta transferred
+func applyDelta(t *testing.T, old, new []byte) ([]byte, int) {
+	signature, err := ComputeSignature(bytes.NewReader(old), 64)
+	require.Nil(t, err)
+
+	delta := ComputeDelta(signature, new)
+
+	literal := 0
+	for _, op := range delta {
+		literal += len(op.Data)
+	}
+
+	var result bytes.Buffer
+	err = ApplyDelta(bytes.NewReader(old), signature.BlockSize, delta, &result)
+	require.Nil(t, err)
+
+	return result.Bytes(), literal
+}
+
+func TestDelta(t *testing.T) {
+	old := randomData(1000)
+
+	t.Run("Unchanged file", func(t *testing.T) {
+		result, literal := applyDelta(t, old, old)
+		require.Equal(t, old, result)
+		require.Equal(t, 0, literal)
+	})
+
+	t.Run("Inserted data", func(t *testing.T) {
+		new := append(append(append([]byte{}, old[:300]...), []byte("inserted")...), old[300:]...)
+
+		result, literal := applyDelta(t, old, new)
+		require.Equal(t, new, result)
+		require.LessOrEqual(t, literal, 64+len("inserted"))
+	})
+
+	t.Run("Removed data", func(t *testing.T) {
+		new := append(append([]byte{}, old[:100]...), old[500:]...)
+
+		result, literal := applyDelta(t, old, new)
+		require.Equal(t, new, result)
+		require.LessOrEqual(t, literal, 64)
+	})
+
+	t.Run("Appended data", func(t *testing.T) {
+		new := append(append([]byte{}, old...), []byte("appended")...)
+
+		result, literal := applyDelta(t, old, new)
+		require.Equal(t, new, result)
+		require.LessOrEqual(t, literal, 64+len("appended"))
+	})
+
+	t.Run("New file", func(t *testing.T) {
+		result, literal := applyDelta(t, nil, old)
+		require.Equal(t, old, result)
+		require.Equal(t, len(old), literal)
+	})
+
+	t.Run("Emptied file", func(t *testing.T) {
+		result, _ := applyDelta(t, old, []byte{})
+		require.Empty(t, result)
+	})
+}
@@ -0,0 +1,384 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ErrDisconnected is returned by the client if the connection to the server is lost
+var ErrDisconnected = errors.New("sync connection lost")
+
+type requestType string
+
+const (
+	requestList      requestType = "list"
+	requestSignature requestType = "signature"
+	requestPatch     requestType = "patch"
+	requestDelta     requestType = "delta"
+	requestMkdir     requestType = "mkdir"
+	requestRemove    requestType = "remove"
+)
+
+type request struct {
+	Type      requestType
+	Path      string
+	Excludes  []string
+	Mode      os.FileMode
+	ModTime   time.Time
+	BlockSize int
+	Signature *Signature
+	Delta     []Operation
+}
+
+type response struct {
+	Error     string
+	Files     []FileState
+	File      *FileState
+	Signature *Signature
+	Delta     []Operation
+}
+
+// Serve handles sync requests for the directory at root until r is closed.
+// It runs on the remote side of the sync with r and w connected to the client over SSH
+func Serve(root string, r io.Reader, w io.Writer) error {
+	decoder := gob.NewDecoder(r)
+	encoder := gob.NewEncoder(w)
+
+	for {
+		var req request
+		err := decoder.Decode(&req)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		res, err := handleRequest(root, req)
+		if err != nil {
+			res = &response{Error: err.Error()}
+		}
+
+		err = encoder.Encode(res)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func handleRequest(root string, req request) (*response, error) {
+	switch req.Type {
+	case requestList:
+		err := os.MkdirAll(root, 0755)
+		if err != nil {
+			return nil, err
+		}
+
+		tree, err := ListTree(root, req.Excludes)
+		if err != nil {
+			return nil, err
+		}
+
+		files := []FileState{}
+		for _, file := range tree {
+			files = append(files, file)
+		}
+		return &response{Files: files}, nil
+	case requestSignature:
+		signature, err := getSignature(root, req.Path)
+		if err != nil {
+			return nil, err
+		}
+		return &response{Signature: signature}, nil
+	case requestPatch:
+		file, err := patchFile(root, req.Path, req.BlockSize, req.Delta, req.Mode, req.ModTime)
+		if err != nil {
+			return nil, err
+		}
+		return &response{File: file}, nil
+	case requestDelta:
+		if req.Signature == nil {
+			return nil, errors.New("missing signature")
+		}
+		file, delta, err := getDelta(root, req.Path, req.Signature)
+		if err != nil {
+			return nil, err
+		}
+		return &response{File: file, Delta: delta}, nil
+	case requestMkdir:
+		file, err := mkdir(root, req.Path, req.Mode)
+		if err != nil {
+			return nil, err
+		}
+		return &response{File: file}, nil
+	case requestRemove:
+		p, err := localPath(root, req.Path)
+		if err != nil {
+			return nil, err
+		}
+		return &response{}, os.RemoveAll(p)
+	}
+
+	return nil, fmt.Errorf("unknown request %s", req.Type)
+}
+
+// Client sends sync requests to a server started with Serve
+type Client struct {
+	mu      sync.Mutex
+	encoder *gob.Encoder
+	decoder *gob.Decoder
+}
+
+func NewClient(r io.Reader, w io.Writer) *Client {
+	return &Client{
+		encoder: gob.NewEncoder(w),
+		decoder: gob.NewDecoder(r),
+	}
+}
+
+// List returns the state of the files in the remote directory
+func (c *Client) List(excludes []string) (map[string]FileState, error) {
+	res, err := c.send(request{Type: requestList, Excludes: excludes})
+	if err != nil {
+		return nil, err
+	}
+
+	tree := map[string]FileState{}
+	for _, file := range res.Files {
+		tree[file.Path] = file
+	}
+
+	return tree, nil
+}
+
+// Push updates the remote file to match the local file at root and returns the new remote state.
+// Only the blocks that changed are transferred
+func (c *Client) Push(root, relPath string) (*FileState, error) {
+	res, err := c.send(request{Type: requestSignature, Path: relPath})
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := localPath(root, relPath)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = c.send(request{
+		Type:      requestPatch,
+		Path:      relPath,
+		Mode:      info.Mode().Perm(),
+		ModTime:   info.ModTime(),
+		BlockSize: res.Signature.BlockSize,
+		Delta:     ComputeDelta(res.Signature, data),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.File, nil
+}
+
+// Pull updates the local file at root to match the remote file and returns the new local state
+func (c *Client) Pull(root, relPath string) (*FileState, error) {
+	signature, err := getSignature(root, relPath)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.send(request{Type: requestDelta, Path: relPath, Signature: signature})
+	if err != nil {
+		return nil, err
+	}
+
+	return patchFile(root, relPath, signature.BlockSize, res.Delta, res.File.Mode, res.File.ModTime)
+}
+
+// Mkdir creates the remote directory and returns its state
+func (c *Client) Mkdir(relPath string, mode os.FileMode) (*FileState, error) {
+	res, err := c.send(request{Type: requestMkdir, Path: relPath, Mode: mode})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.File, nil
+}
+
+// Remove removes the remote file or directory
+func (c *Client) Remove(relPath string) error {
+	_, err := c.send(request{Type: requestRemove, Path: relPath})
+	return err
+}
+
+func (c *Client) send(req request) (*response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := c.encoder.Encode(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDisconnected, err)
+	}
+
+	var res response
+	err = c.decoder.Decode(&res)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDisconnected, err)
+	}
+
+	if res.Error != "" {
+		return nil, errors.New(res.Error)
+	}
+
+	return &res, nil
+}
+
+// getSignature returns the signature of the file at root. A missing file has an empty signature
+func getSignature(root, relPath string) (*Signature, error) {
+	p, err := localPath(root, relPath)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Signature{BlockSize: DEFAULT_BLOCK_SIZE}, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	return ComputeSignature(file, DEFAULT_BLOCK_SIZE)
+}
+
+func getDelta(root, relPath string, signature *Signature) (*FileState, []Operation, error) {
+	p, err := localPath(root, relPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file := GetFileState(relPath, info)
+	return &file, ComputeDelta(signature, data), nil
+}
+
+// patchFile rebuilds the file at root from its current content and the delta. The new content is written
+// to a temporary file that replaces the old one so that readers never see a partially written file
+func patchFile(root, relPath string, blockSize int, delta []Operation, mode os.FileMode, modTime time.Time) (*FileState, error) {
+	p, err := localPath(root, relPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(p), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	var old *os.File
+	old, err = os.Open(p)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if old != nil {
+		defer old.Close()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p), "."+filepath.Base(p)+".daytona-sync-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	var base io.ReaderAt
+	if old != nil {
+		base = old
+	}
+
+	err = ApplyDelta(base, blockSize, delta, tmp)
+	if err != nil {
+		tmp.Close()
+		return nil, err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(tmp.Name(), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chtimes(tmp.Name(), modTime, modTime)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Rename(tmp.Name(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+
+	file := GetFileState(relPath, info)
+	return &file, nil
+}
+
+func mkdir(root, relPath string, mode os.FileMode) (*FileState, error) {
+	p, err := localPath(root, relPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(p, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(p, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+
+	file := GetFileState(relPath, info)
+	return &file, nil
+}
@@ -0,0 +1,464 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Temporary files written while patching are never synced
+const tempFilePattern = ".*.daytona-sync-*"
+
+// Local changes are collected for this long before they are synced so that
+// a burst of events from a single save is synced once
+const debounceInterval = 100 * time.Millisecond
+
+type Direction string
+
+const (
+	DirectionPush Direction = "push"
+	DirectionPull Direction = "pull"
+)
+
+type Change struct {
+	Path      string
+	Direction Direction
+	Removed   bool
+}
+
+type SyncConfig struct {
+	LocalDir string
+	Client   *Client
+	Excludes []string
+	// Sync remote changes back to the local directory
+	Bidirectional bool
+	// Interval of checking the remote directory for changes in bidirectional mode
+	RemoteInterval time.Duration
+	// Called after each synced change
+	OnChange func(Change)
+	// Called with errors that don't stop the sync, e.g. a file that can not be read
+	OnError func(path string, err error)
+}
+
+// Syncer mirrors a local directory into a remote directory. In bidirectional mode remote changes
+// are synced back as well and local changes win if a file changed on both sides
+type Syncer struct {
+	config SyncConfig
+	// States of the files on both sides after they were last synced
+	local  map[string]FileState
+	remote map[string]FileState
+}
+
+func NewSyncer(config SyncConfig) *Syncer {
+	config.Excludes = append(config.Excludes, tempFilePattern)
+
+	return &Syncer{
+		config: config,
+		local:  map[string]FileState{},
+		remote: map[string]FileState{},
+	}
+}
+
+// InitialSync copies all local files that differ from the remote ones. Remote files that don't exist locally
+// are kept in one-way mode and copied to the local directory in bidirectional mode, where the newer version of a
+// file that exists on both sides wins
+func (s *Syncer) InitialSync() error {
+	localTree, err := ListTree(s.config.LocalDir, s.config.Excludes)
+	if err != nil {
+		return err
+	}
+
+	remoteTree, err := s.config.Client.List(s.config.Excludes)
+	if err != nil {
+		return err
+	}
+
+	for _, relPath := range sortedPaths(localTree) {
+		localState := localTree[relPath]
+		remoteState, ok := remoteTree[relPath]
+
+		switch {
+		case ok && localState.Equal(remoteState):
+			s.local[relPath] = localState
+			s.remote[relPath] = remoteState
+			continue
+		case ok && s.config.Bidirectional && !localState.IsDir && !remoteState.IsDir && remoteState.ModTime.After(localState.ModTime):
+			err = s.pull(remoteState)
+		default:
+			if ok && localState.IsDir != remoteState.IsDir {
+				err = s.config.Client.Remove(relPath)
+				if err != nil {
+					return err
+				}
+			}
+			err = s.push(relPath)
+		}
+
+		err = s.handleError(relPath, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !s.config.Bidirectional {
+		return nil
+	}
+
+	for _, relPath := range sortedPaths(remoteTree) {
+		if _, ok := localTree[relPath]; ok {
+			continue
+		}
+
+		err = s.handleError(relPath, s.pull(remoteTree[relPath]))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch syncs changes until the context is canceled or the connection is lost
+func (s *Syncer) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	err = s.addWatches(watcher, s.config.LocalDir)
+	if err != nil {
+		return err
+	}
+
+	var remoteTicker <-chan time.Time
+	if s.config.Bidirectional {
+		ticker := time.NewTicker(s.config.RemoteInterval)
+		defer ticker.Stop()
+		remoteTicker = ticker.C
+	}
+
+	pending := map[string]bool{}
+	debounce := time.NewTimer(debounceInterval)
+	debounce.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			relPath, err := filepath.Rel(s.config.LocalDir, event.Name)
+			if err != nil || relPath == "." {
+				continue
+			}
+
+			pending[filepath.ToSlash(relPath)] = true
+			debounce.Reset(debounceInterval)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			s.reportError("", err)
+		case <-debounce.C:
+			paths := []string{}
+			for relPath := range pending {
+				paths = append(paths, relPath)
+			}
+			sort.Strings(paths)
+			pending = map[string]bool{}
+
+			for _, relPath := range paths {
+				err := s.handleError(relPath, s.syncLocalChange(watcher, relPath))
+				if err != nil {
+					return err
+				}
+			}
+		case <-remoteTicker:
+			err := s.syncRemoteChanges()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Syncer) syncLocalChange(watcher *fsnotify.Watcher, relPath string) error {
+	if IsExcluded(relPath, s.config.Excludes) {
+		return nil
+	}
+
+	p, err := localPath(s.config.LocalDir, relPath)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		if _, ok := s.local[relPath]; !ok {
+			return nil
+		}
+
+		err = s.config.Client.Remove(relPath)
+		if err != nil {
+			return err
+		}
+		s.forget(relPath)
+		s.notify(Change{Path: relPath, Direction: DirectionPush, Removed: true})
+		return nil
+	}
+
+	if !info.IsDir() && !info.Mode().IsRegular() {
+		return nil
+	}
+
+	state := GetFileState(relPath, info)
+	if previous, ok := s.local[relPath]; ok && previous.Equal(state) {
+		if info.IsDir() {
+			return s.addWatches(watcher, p)
+		}
+		return nil
+	}
+
+	if previous, ok := s.local[relPath]; ok && previous.IsDir != state.IsDir {
+		err = s.config.Client.Remove(relPath)
+		if err != nil {
+			return err
+		}
+		s.forget(relPath)
+	}
+
+	err = s.push(relPath)
+	if err != nil || !info.IsDir() {
+		return err
+	}
+
+	// Files can be created in a new directory before it is watched
+	tree, err := ListTree(p, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, childPath := range sortedPaths(tree) {
+		childPath = path.Join(relPath, childPath)
+		if IsExcluded(childPath, s.config.Excludes) {
+			continue
+		}
+		err = s.handleError(childPath, s.push(childPath))
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.addWatches(watcher, p)
+}
+
+func (s *Syncer) syncRemoteChanges() error {
+	remoteTree, err := s.config.Client.List(s.config.Excludes)
+	if err != nil {
+		return err
+	}
+
+	for _, relPath := range sortedPaths(remoteTree) {
+		remoteState := remoteTree[relPath]
+		if previous, ok := s.remote[relPath]; ok && previous.Equal(remoteState) {
+			continue
+		}
+
+		if s.localChanged(relPath) {
+			// Local changes win and are synced by the watcher
+			continue
+		}
+
+		err = s.handleError(relPath, s.pull(remoteState))
+		if err != nil {
+			return err
+		}
+	}
+
+	removed := []string{}
+	for relPath := range s.remote {
+		if _, ok := remoteTree[relPath]; !ok {
+			removed = append(removed, relPath)
+		}
+	}
+	sort.Strings(removed)
+
+	for _, relPath := range removed {
+		if _, ok := s.remote[relPath]; !ok || s.localChanged(relPath) {
+			continue
+		}
+
+		p, err := localPath(s.config.LocalDir, relPath)
+		if err != nil {
+			return err
+		}
+
+		err = s.handleError(relPath, os.RemoveAll(p))
+		if err != nil {
+			return err
+		}
+		s.forget(relPath)
+		s.notify(Change{Path: relPath, Direction: DirectionPull, Removed: true})
+	}
+
+	return nil
+}
+
+func (s *Syncer) push(relPath string) error {
+	p, err := localPath(s.config.LocalDir, relPath)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		return err
+	}
+
+	var remoteState *FileState
+	if info.IsDir() {
+		remoteState, err = s.config.Client.Mkdir(relPath, info.Mode().Perm())
+	} else {
+		remoteState, err = s.config.Client.Push(s.config.LocalDir, relPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.local[relPath] = GetFileState(relPath, info)
+	s.remote[relPath] = *remoteState
+	if !info.IsDir() {
+		s.notify(Change{Path: relPath, Direction: DirectionPush})
+	}
+
+	return nil
+}
+
+func (s *Syncer) pull(remoteState FileState) error {
+	var localState *FileState
+	var err error
+
+	if remoteState.IsDir {
+		localState, err = mkdir(s.config.LocalDir, remoteState.Path, remoteState.Mode)
+	} else {
+		localState, err = s.config.Client.Pull(s.config.LocalDir, remoteState.Path)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.local[remoteState.Path] = *localState
+	s.remote[remoteState.Path] = remoteState
+	if !remoteState.IsDir {
+		s.notify(Change{Path: remoteState.Path, Direction: DirectionPull})
+	}
+
+	return nil
+}
+
+// localChanged returns true if the local file changed since it was last synced
+func (s *Syncer) localChanged(relPath string) bool {
+	p, err := localPath(s.config.LocalDir, relPath)
+	if err != nil {
+		return false
+	}
+
+	previous, synced := s.local[relPath]
+
+	info, err := os.Stat(p)
+	if err != nil {
+		return synced
+	}
+
+	return !synced || !previous.Equal(GetFileState(relPath, info))
+}
+
+// forget removes the synced state of a path and everything under it
+func (s *Syncer) forget(relPath string) {
+	for _, states := range []map[string]FileState{s.local, s.remote} {
+		for p := range states {
+			if p == relPath || strings.HasPrefix(p, relPath+"/") {
+				delete(states, p)
+			}
+		}
+	}
+}
+
+func (s *Syncer) addWatches(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(s.config.LocalDir, p)
+		if err != nil {
+			return err
+		}
+		if relPath != "." && IsExcluded(filepath.ToSlash(relPath), s.config.Excludes) {
+			return filepath.SkipDir
+		}
+
+		return watcher.Add(p)
+	})
+}
+
+// handleError reports errors of single files and returns errors that stop the sync
+func (s *Syncer) handleError(relPath string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, ErrDisconnected) {
+		return err
+	}
+
+	s.reportError(relPath, err)
+	return nil
+}
+
+func (s *Syncer) reportError(relPath string, err error) {
+	if s.config.OnError != nil {
+		s.config.OnError(relPath, err)
+	}
+}
+
+func (s *Syncer) notify(change Change) {
+	if s.config.OnChange != nil {
+		s.config.OnChange(change)
+	}
+}
+
+func sortedPaths(tree map[string]FileState) []string {
+	paths := []string{}
+	for p := range tree {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a client of a server that serves the remote directory
+func newTestClient(t *testing.T, remoteDir string) *Client {
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	go Serve(remoteDir, serverReader, serverWriter) // nolint:errcheck
+
+	t.Cleanup(func() {
+		clientWriter.Close()
+		serverWriter.Close()
+	})
+
+	return NewClient(clientReader, clientWriter)
+}
+
+func writeFile(t *testing.T, p, content string) {
+	require.Nil(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.Nil(t, os.WriteFile(p, []byte(content), 0640))
+}
+
+func readFile(t *testing.T, p string) string {
+	content, err := os.ReadFile(p)
+	require.Nil(t, err)
+	return string(content)
+}
+
+func TestInitialSync(t *testing.T) {
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+
+	writeFile(t, filepath.Join(localDir, "main.go"), "package main")
+	writeFile(t, filepath.Join(localDir, "pkg", "util.go"), "package pkg")
+	writeFile(t, filepath.Join(localDir, ".git", "HEAD"), "ref: refs/heads/main")
+	writeFile(t, filepath.Join(remoteDir, "main.go"), "package old")
+	writeFile(t, filepath.Join(remoteDir, "remote.go"), "package remote")
+
+	t.Run("One-way", func(t *testing.T) {
+		syncer := NewSyncer(SyncConfig{
+			LocalDir: localDir,
+			Client:   newTestClient(t, remoteDir),
+			Excludes: []string{".git"},
+		})
+		require.Nil(t, syncer.InitialSync())
+
+		require.Equal(t, "package main", readFile(t, filepath.Join(remoteDir, "main.go")))
+		require.Equal(t, "package pkg", readFile(t, filepath.Join(remoteDir, "pkg", "util.go")))
+		require.NoFileExists(t, filepath.Join(remoteDir, ".git", "HEAD"))
+		require.NoFileExists(t, filepath.Join(localDir, "remote.go"))
+
+		info, err := os.Stat(filepath.Join(remoteDir, "main.go"))
+		require.Nil(t, err)
+		require.Equal(t, os.FileMode(0640), info.Mode().Perm())
+	})
+
+	t.Run("Bidirectional", func(t *testing.T) {
+		syncer := NewSyncer(SyncConfig{
+			LocalDir:      localDir,
+			Client:        newTestClient(t, remoteDir),
+			Excludes:      []string{".git"},
+			Bidirectional: true,
+		})
+		require.Nil(t, syncer.InitialSync())
+
+		require.Equal(t, "package remote", readFile(t, filepath.Join(localDir, "remote.go")))
+	})
+}
+
+func TestWatch(t *testing.T) {
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+
+	writeFile(t, filepath.Join(localDir, "main.go"), "package main")
+
+	changes := make(chan Change, 10)
+	syncer := NewSyncer(SyncConfig{
+		LocalDir:       localDir,
+		Client:         newTestClient(t, remoteDir),
+		Bidirectional:  true,
+		RemoteInterval: 50 * time.Millisecond,
+		OnChange: func(change Change) {
+			changes <- change
+		},
+	})
+	require.Nil(t, syncer.InitialSync())
+	<-changes
+
+	ctx, cancel := context.WithCancel(context.Background())
+	watchErr := make(chan error)
+	go func() {
+		watchErr <- syncer.Watch(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.Nil(t, <-watchErr)
+	})
+
+	// Give the watcher time to start
+	time.Sleep(100 * time.Millisecond)
+
+	t.Run("Push local change", func(t *testing.T) {
+		writeFile(t, filepath.Join(localDir, "pkg", "util.go"), "package pkg")
+
+		require.Equal(t, Change{Path: "pkg/util.go", Direction: DirectionPush}, waitForChange(t, changes))
+		require.Equal(t, "package pkg", readFile(t, filepath.Join(remoteDir, "pkg", "util.go")))
+	})
+
+	t.Run("Push local removal", func(t *testing.T) {
+		require.Nil(t, os.Remove(filepath.Join(localDir, "main.go")))
+
+		require.Equal(t, Change{Path: "main.go", Direction: DirectionPush, Removed: true}, waitForChange(t, changes))
+		require.NoFileExists(t, filepath.Join(remoteDir, "main.go"))
+	})
+
+	t.Run("Pull remote change", func(t *testing.T) {
+		writeFile(t, filepath.Join(remoteDir, "remote.go"), "package remote")
+
+		require.Equal(t, Change{Path: "remote.go", Direction: DirectionPull}, waitForChange(t, changes))
+		require.Equal(t, "package remote", readFile(t, filepath.Join(localDir, "remote.go")))
+
+		// The pulled file is not pushed back
+		select {
+		case change := <-changes:
+			require.Fail(t, "unexpected change", change)
+		case <-time.After(300 * time.Millisecond):
+		}
+	})
+}
+
+func waitForChange(t *testing.T, changes chan Change) Change {
+	select {
+	case change := <-changes:
+		return change
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for change")
+		return Change{}
+	}
+}
+
+func TestIsExcluded(t *testing.T) {
+	excludes := []string{".git", "node_modules", "build/*.o", tempFilePattern}
+
+	require.True(t, IsExcluded(".git", excludes))
+	require.True(t, IsExcluded(".git/HEAD", excludes))
+	require.True(t, IsExcluded("web/node_modules/react/index.js", excludes))
+	require.True(t, IsExcluded("build/main.o", excludes))
+	require.True(t, IsExcluded("pkg/.util.go.daytona-sync-123", excludes))
+	require.False(t, IsExcluded("src/build/main.o", excludes))
+	require.False(t, IsExcluded("main.go", excludes))
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileState is the state of a synced file or directory. Paths are relative to the synced
+// directory and use forward slashes on both sides
+type FileState struct {
+	Path    string
+	IsDir   bool
+	Mode    os.FileMode
+	Size    int64
+	ModTime time.Time
+}
+
+// Equal returns true if the file was not changed between the two states
+func (s FileState) Equal(other FileState) bool {
+	if s.IsDir || other.IsDir {
+		return s.IsDir == other.IsDir
+	}
+
+	return s.Size == other.Size && s.ModTime.Equal(other.ModTime) && s.Mode == other.Mode
+}
+
+// ListTree returns the state of all files and directories under root that are not excluded
+func ListTree(root string, excludes []string) (map[string]FileState, error) {
+	tree := map[string]FileState{}
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Files can be removed while walking the tree
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if p == root {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if IsExcluded(relPath, excludes) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Only regular files and directories are synced
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		tree[relPath] = GetFileState(relPath, info)
+		return nil
+	})
+
+	return tree, err
+}
+
+func GetFileState(relPath string, info os.FileInfo) FileState {
+	state := FileState{
+		Path:  relPath,
+		IsDir: info.IsDir(),
+		Mode:  info.Mode().Perm(),
+	}
+
+	if !state.IsDir {
+		state.Size = info.Size()
+		state.ModTime = info.ModTime()
+	}
+
+	return state
+}
+
+// IsExcluded returns true if the path or any of its parent directories matches one of the exclude patterns.
+// Patterns without a slash match names at any depth, other patterns match paths from the synced directory
+func IsExcluded(relPath string, excludes []string) bool {
+	for _, pattern := range excludes {
+		pattern = strings.Trim(filepath.ToSlash(pattern), "/")
+		if pattern == "" {
+			continue
+		}
+
+		if !strings.Contains(pattern, "/") {
+			for _, name := range strings.Split(relPath, "/") {
+				if matched, _ := path.Match(pattern, name); matched {
+					return true
+				}
+			}
+			continue
+		}
+
+		for p := relPath; p != "." && p != "/"; p = path.Dir(p) {
+			if matched, _ := path.Match(pattern, p); matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// localPath returns the path of a synced file under root. Paths outside of root are rejected
+func localPath(root, relPath string) (string, error) {
+	p := filepath.FromSlash(relPath)
+	if !filepath.IsLocal(p) {
+		return "", errors.New("invalid path " + relPath)
+	}
+
+	return filepath.Join(root, p), nil
+}
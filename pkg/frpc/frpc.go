@@ -18,8 +18,22 @@ type FrpcConnectParams struct {
 	Name         string
 	SubDomain    string
 	Port         int
+	// Additional ports exposed under the same subdomain with a path prefix
+	Routes []FrpcRoute
+	// If set, the Host header of proxied requests is replaced with this value
+	HostHeaderRewrite string
 }
 
+type FrpcRoute struct {
+	// Path prefix of the route, e.g. /api
+	Location string
+	Port     int
+}
+
+// Header set on requests proxied through a route. The path prefix is not stripped
+// so this lets the backend know under which prefix it is served
+const FORWARDED_PREFIX_HEADER = "X-Forwarded-Prefix"
+
 type HealthCheckFunc func() error
 
 func GetService(params FrpcConnectParams) (HealthCheckFunc, *client.Service, error) {
@@ -29,13 +43,20 @@ func GetService(params FrpcConnectParams) (HealthCheckFunc, *client.Service, err
 	cfg.Common.ServerPort = params.ServerPort
 	cfg.ProxyCfgs = []v1.ProxyConfigurer{}
 
-	httpConfig := &v1.HTTPProxyConfig{}
-	httpConfig.GetBaseConfig().Name = params.Name
-	httpConfig.GetBaseConfig().LocalPort = params.Port
-	httpConfig.GetBaseConfig().Type = string(v1.ProxyTypeHTTP)
-	httpConfig.SubDomain = params.SubDomain
+	proxyNames := []string{params.Name}
+	cfg.ProxyCfgs = append(cfg.ProxyCfgs, getHttpProxyConfig(params.Name, params.Port, params))
+
+	for _, route := range params.Routes {
+		name := fmt.Sprintf("%s-%d", params.Name, route.Port)
+		httpConfig := getHttpProxyConfig(name, route.Port, params)
+		httpConfig.Locations = []string{route.Location}
+		httpConfig.RequestHeaders.Set = map[string]string{
+			FORWARDED_PREFIX_HEADER: route.Location,
+		}
 
-	cfg.ProxyCfgs = append(cfg.ProxyCfgs, httpConfig)
+		proxyNames = append(proxyNames, name)
+		cfg.ProxyCfgs = append(cfg.ProxyCfgs, httpConfig)
+	}
 
 	service, err := client.NewService(cfg)
 	if err != nil {
@@ -43,19 +64,32 @@ func GetService(params FrpcConnectParams) (HealthCheckFunc, *client.Service, err
 	}
 
 	return func() error {
-		proxyStatus, ok := service.StatusExporter().GetProxyStatus(params.Name)
-		if !ok || proxyStatus == nil {
-			return fmt.Errorf("%w %w", errors.New("failed to get proxy status"), common.ErrConnection)
-		}
+		for _, name := range proxyNames {
+			proxyStatus, ok := service.StatusExporter().GetProxyStatus(name)
+			if !ok || proxyStatus == nil {
+				return fmt.Errorf("%w %w", errors.New("failed to get proxy status"), common.ErrConnection)
+			}
 
-		if proxyStatus.Err != "" {
-			return fmt.Errorf("proxy error: %s %w", proxyStatus.Err, common.ErrConnection)
-		}
+			if proxyStatus.Err != "" {
+				return fmt.Errorf("proxy error: %s %w", proxyStatus.Err, common.ErrConnection)
+			}
 
-		if proxyStatus.Phase != "running" {
-			return fmt.Errorf("proxy state is not running. State is %s. %w", proxyStatus.Phase, common.ErrConnection)
+			if proxyStatus.Phase != "running" {
+				return fmt.Errorf("proxy state is not running. State is %s. %w", proxyStatus.Phase, common.ErrConnection)
+			}
 		}
 
 		return nil
 	}, service, nil
 }
+
+func getHttpProxyConfig(name string, port int, params FrpcConnectParams) *v1.HTTPProxyConfig {
+	httpConfig := &v1.HTTPProxyConfig{}
+	httpConfig.GetBaseConfig().Name = name
+	httpConfig.GetBaseConfig().LocalPort = port
+	httpConfig.GetBaseConfig().Type = string(v1.ProxyTypeHTTP)
+	httpConfig.SubDomain = params.SubDomain
+	httpConfig.HostHeaderRewrite = params.HostHeaderRewrite
+
+	return httpConfig
+}
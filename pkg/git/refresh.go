@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+var ErrUncommittedChanges = errors.New("the repository has uncommitted changes")
+var ErrUnpushedCommits = errors.New("the current branch has commits that are not pushed to its upstream")
+
+// The git CLI is used instead of go-git so credential helpers configured in the project are respected
+
+func (s *Service) Fetch() error {
+	return s.runGitCommand("fetch", "--prune", "origin")
+}
+
+// ResetToUpstream discards all local changes and commits and resets the current branch to its upstream
+func (s *Service) ResetToUpstream() error {
+	upstream, err := s.getUpstreamBranch()
+	if err != nil {
+		return err
+	}
+
+	if upstream == "" {
+		return errors.New("the current branch has no upstream branch")
+	}
+
+	err = s.runGitCommand("reset", "--hard", upstream)
+	if err != nil {
+		return err
+	}
+
+	return s.runGitCommand("clean", "-fd")
+}
+
+func (s *Service) PullFastForward() error {
+	return s.runGitCommand("pull", "--ff-only")
+}
+
+func (s *Service) HasUncommittedChanges() (bool, error) {
+	cmd := exec.Command("git", "-C", s.ProjectDir, "status", "--porcelain")
+	out, err := cmd.Output()
+	if err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(string(out)) != "", nil
+}
+
+// HasUnpushedCommits reports whether the current branch has commits that are not on its upstream branch.
+// Branches without an upstream are reported as having no unpushed commits
+func (s *Service) HasUnpushedCommits() (bool, error) {
+	upstream, err := s.getUpstreamBranch()
+	if err != nil {
+		return false, err
+	}
+
+	if upstream == "" {
+		return false, nil
+	}
+
+	cmd := exec.Command("git", "-C", s.ProjectDir, "rev-list", "--count", fmt.Sprintf("%s..HEAD", upstream))
+	out, err := cmd.Output()
+	if err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(string(out)) != "0", nil
+}
+
+func (s *Service) runGitCommand(args ...string) error {
+	cmd := exec.Command("git", append([]string{"-C", s.ProjectDir}, args...)...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git %s failed: %w\n%s", args[0], err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/stretchr/testify/require"
+)
+
+func runGit(t *testing.T, dir string, args ...string) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestHasUnpushedCommits(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	upstreamDir := filepath.Join(dir, "upstream.git")
+	projectDir := filepath.Join(dir, "project")
+
+	runGit(t, dir, "init", "--bare", "-b", "main", upstreamDir)
+	runGit(t, dir, "clone", upstreamDir, projectDir)
+	runGit(t, projectDir, "checkout", "-b", "main")
+	runGit(t, projectDir, "commit", "--allow-empty", "-m", "initial")
+	runGit(t, projectDir, "push", "-u", "origin", "main")
+
+	gitService := git.Service{ProjectDir: projectDir}
+
+	unpushed, err := gitService.HasUnpushedCommits()
+	require.NoError(t, err)
+	require.False(t, unpushed)
+
+	runGit(t, projectDir, "commit", "--allow-empty", "-m", "local")
+
+	unpushed, err = gitService.HasUnpushedCommits()
+	require.NoError(t, err)
+	require.True(t, unpushed)
+
+	runGit(t, projectDir, "checkout", "-b", "no-upstream")
+
+	unpushed, err = gitService.HasUnpushedCommits()
+	require.NoError(t, err)
+	require.False(t, unpushed)
+}
@@ -529,7 +529,8 @@ func (g *GiteaGitProvider) GetCommitsRange(repo *GitRepository, initialSha strin
 }
 
 func (g *GiteaGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
-	if request.Header.Get("X-Gitea-Event") != "push" {
+	// Forgejo sends the Gitea event header as well
+	if request.Header.Get("X-Gitea-Event") != "push" && request.Header.Get("X-Forgejo-Event") != "push" {
 		return nil, errors.New("invalid event key")
 	}
 
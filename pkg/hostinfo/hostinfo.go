@@ -0,0 +1,118 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hostinfo
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+)
+
+// HostInfo describes the kernel features of the server host that advanced workspace features depend on
+type HostInfo struct {
+	Os            string `json:"os" validate:"required"`
+	Arch          string `json:"arch" validate:"required"`
+	KernelVersion string `json:"kernelVersion" validate:"optional"`
+	// CRIU is required to checkpoint and restore running containers
+	Criu      bool `json:"criu" validate:"required"`
+	CgroupsV2 bool `json:"cgroupsV2" validate:"required"`
+	// Unprivileged user namespaces are required for rootless containers, e.g. Docker in Docker
+	UserNamespaces bool `json:"userNamespaces" validate:"required"`
+	// The host exposes KVM so that projects can run virtual machines
+	NestedVirtualization bool `json:"nestedVirtualization" validate:"required"`
+	Gpus                 int  `json:"gpus" validate:"required"`
+	// The Docker or Podman socket is available. Daemons on other hosts set with DOCKER_HOST are assumed to be available
+	Docker bool `json:"docker" validate:"required"`
+} // @name HostInfo
+
+var nvidiaDeviceRegex = regexp.MustCompile(`^nvidia[0-9]+$`)
+
+// Detect inspects the host the server runs on. Features are only detected on Linux
+func Detect() HostInfo {
+	return detect("/", exec.LookPath)
+}
+
+func detect(root string, lookPath func(string) (string, error)) HostInfo {
+	info := HostInfo{
+		Os:   runtime.GOOS,
+		Arch: runtime.GOARCH,
+	}
+
+	info.Docker = detectDocker(root)
+
+	if info.Os != "linux" {
+		return info
+	}
+
+	if release, err := os.ReadFile(filepath.Join(root, "proc/sys/kernel/osrelease")); err == nil {
+		info.KernelVersion = strings.TrimSpace(string(release))
+	}
+
+	if _, err := lookPath("criu"); err == nil {
+		info.Criu = true
+	}
+
+	if _, err := os.Stat(filepath.Join(root, "sys/fs/cgroup/cgroup.controllers")); err == nil {
+		info.CgroupsV2 = true
+	}
+
+	info.UserNamespaces = detectUserNamespaces(root)
+
+	if _, err := os.Stat(filepath.Join(root, "dev/kvm")); err == nil {
+		info.NestedVirtualization = true
+	}
+
+	if entries, err := os.ReadDir(filepath.Join(root, "dev")); err == nil {
+		for _, entry := range entries {
+			if nvidiaDeviceRegex.MatchString(entry.Name()) {
+				info.Gpus++
+			}
+		}
+	}
+
+	return info
+}
+
+func detectDocker(root string) bool {
+	if dockerHost := os.Getenv("DOCKER_HOST"); dockerHost != "" {
+		if !strings.HasPrefix(dockerHost, "unix://") {
+			return true
+		}
+
+		info, err := os.Stat(strings.TrimPrefix(dockerHost, "unix://"))
+		return err == nil && info.Mode()&os.ModeSocket != 0
+	}
+
+	return docker.FindLocalSocket(root) != ""
+}
+
+func detectUserNamespaces(root string) bool {
+	maxUserNamespaces, err := readInt(filepath.Join(root, "proc/sys/user/max_user_namespaces"))
+	if err != nil || maxUserNamespaces == 0 {
+		return false
+	}
+
+	// Debian and Ubuntu kernels can disable user namespaces for unprivileged users
+	unprivilegedClone, err := readInt(filepath.Join(root, "proc/sys/kernel/unprivileged_userns_clone"))
+	if err == nil && unprivilegedClone == 0 {
+		return false
+	}
+
+	return true
+}
+
+func readInt(path string) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(string(content)))
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hostinfo
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("host features are only detected on Linux")
+	}
+
+	root := t.TempDir()
+	writeFile(t, root, "proc/sys/kernel/osrelease", "6.8.0-45-generic\n")
+	writeFile(t, root, "proc/sys/user/max_user_namespaces", "63936\n")
+	writeFile(t, root, "proc/sys/kernel/unprivileged_userns_clone", "1\n")
+	writeFile(t, root, "sys/fs/cgroup/cgroup.controllers", "cpuset cpu io memory pids\n")
+	writeFile(t, root, "dev/kvm", "")
+	writeFile(t, root, "dev/nvidia0", "")
+	writeFile(t, root, "dev/nvidia1", "")
+	writeFile(t, root, "dev/nvidiactl", "")
+
+	t.Setenv("DOCKER_HOST", "")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "var/run"), 0755))
+	listener, err := net.Listen("unix", filepath.Join(root, "var/run/docker.sock"))
+	require.NoError(t, err)
+	defer listener.Close()
+
+	info := detect(root, func(string) (string, error) {
+		return "/usr/sbin/criu", nil
+	})
+
+	require.Equal(t, HostInfo{
+		Os:                   "linux",
+		Arch:                 runtime.GOARCH,
+		KernelVersion:        "6.8.0-45-generic",
+		Criu:                 true,
+		CgroupsV2:            true,
+		UserNamespaces:       true,
+		NestedVirtualization: true,
+		Gpus:                 2,
+		Docker:               true,
+	}, info)
+}
+
+func TestDetect_Unsupported(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("host features are only detected on Linux")
+	}
+
+	root := t.TempDir()
+	writeFile(t, root, "proc/sys/user/max_user_namespaces", "63936\n")
+	writeFile(t, root, "proc/sys/kernel/unprivileged_userns_clone", "0\n")
+	// A regular file is not a Docker socket
+	writeFile(t, root, "var/run/docker.sock", "")
+
+	t.Setenv("DOCKER_HOST", "")
+
+	info := detect(root, func(string) (string, error) {
+		return "", errors.New("not found")
+	})
+
+	require.False(t, info.Criu)
+	require.False(t, info.CgroupsV2)
+	require.False(t, info.UserNamespaces)
+	require.False(t, info.NestedVirtualization)
+	require.Zero(t, info.Gpus)
+	require.False(t, info.Docker)
+}
+
+func writeFile(t *testing.T, root, path, content string) {
+	path = filepath.Join(root, path)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
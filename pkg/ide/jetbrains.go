@@ -21,12 +21,14 @@ import (
 	ospkg "github.com/daytonaio/daytona/pkg/os"
 	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/pkg/browser"
+	log "github.com/sirupsen/logrus"
 )
 
 func OpenJetbrainsIDE(activeProfile config.Profile, ide, workspaceId, projectName string, gpgKey string) error {
+	// Gateway installed through JetBrains Toolbox is usually not in PATH but still handles the join link
 	err := IsJetBrainsGatewayInstalled()
 	if err != nil {
-		return err
+		log.Warn(err)
 	}
 
 	projectDir, err := util.GetProjectDir(activeProfile, workspaceId, projectName, gpgKey)
@@ -76,7 +78,15 @@ func OpenJetbrainsIDE(activeProfile config.Profile, ide, workspaceId, projectNam
 
 	gatewayUrl := fmt.Sprintf("jetbrains-gateway://connect#host=%s&type=ssh&deploy=false&projectPath=%s&user=daytona&port=%d&idePath=%s", projectHostname, projectDir, ssh_config.SSH_PORT, url.QueryEscape(downloadPath))
 
-	return browser.OpenURL(gatewayUrl)
+	views.RenderInfoMessage(fmt.Sprintf("JetBrains Gateway link: %s", gatewayUrl))
+
+	err = browser.OpenURL(gatewayUrl)
+	if err != nil {
+		log.Debug(err)
+		views.RenderInfoMessage("Could not open JetBrains Gateway automatically. Open the link above to connect.")
+	}
+
+	return nil
 }
 
 func downloadJetbrainsIDE(projectHostname, downloadUrl, downloadPath string) error {
@@ -0,0 +1,202 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ide
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/jetbrains"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/pkg/browser"
+	log "github.com/sirupsen/logrus"
+)
+
+// FileLocation is a file in a project with an optional 1-based line and column
+type FileLocation struct {
+	Path   string
+	Line   int
+	Column int
+}
+
+func (l FileLocation) String() string {
+	location := l.Path
+	if l.Line > 0 {
+		location += fmt.Sprintf(":%d", l.Line)
+		if l.Column > 0 {
+			location += fmt.Sprintf(":%d", l.Column)
+		}
+	}
+	return location
+}
+
+// ParseFileLocation parses locations as printed in stack traces (PATH:LINE or PATH:LINE:COLUMN)
+// and in code review links (PATH#L42)
+func ParseFileLocation(location string) (*FileLocation, error) {
+	if location == "" {
+		return nil, errors.New("file path is required")
+	}
+
+	if filePath, anchor, found := strings.Cut(location, "#L"); found {
+		line, err := strconv.Atoi(strings.SplitN(anchor, "-", 2)[0])
+		if err != nil || line < 1 || filePath == "" {
+			return nil, fmt.Errorf("invalid file location %s", location)
+		}
+		return &FileLocation{Path: filePath, Line: line}, nil
+	}
+
+	fileLocation := &FileLocation{Path: location}
+
+	parts := strings.Split(location, ":")
+	numbers := []int{}
+	for i := len(parts) - 1; i > 0 && len(numbers) < 2; i-- {
+		number, err := strconv.Atoi(parts[i])
+		if err != nil {
+			break
+		}
+		if number < 1 {
+			return nil, fmt.Errorf("invalid file location %s: line and column start at 1", location)
+		}
+		numbers = append([]int{number}, numbers...)
+	}
+
+	if len(numbers) == 0 {
+		return fileLocation, nil
+	}
+
+	fileLocation.Path = strings.Join(parts[:len(parts)-len(numbers)], ":")
+	fileLocation.Line = numbers[0]
+	if len(numbers) == 2 {
+		fileLocation.Column = numbers[1]
+	}
+
+	if fileLocation.Path == "" {
+		return nil, fmt.Errorf("invalid file location %s", location)
+	}
+
+	return fileLocation, nil
+}
+
+// OpenFile opens the file at the location in the IDE. Relative paths are resolved against the project directory
+func OpenFile(activeProfile config.Profile, ideId string, workspaceId string, projectName string, projectDir string, location FileLocation, gpgKey string) error {
+	remotePath := location.Path
+	if !path.IsAbs(remotePath) {
+		remotePath = path.Join(projectDir, remotePath)
+	}
+
+	remoteLocation := location
+	remoteLocation.Path = remotePath
+
+	err := config.EnsureSshConfigEntryAdded(activeProfile.Id, workspaceId, projectName, gpgKey)
+	if err != nil {
+		return err
+	}
+
+	projectHostname := config.GetProjectHostname(activeProfile.Id, workspaceId, projectName)
+
+	switch ideId {
+	case "vscode":
+		CheckAndAlertVSCodeInstalled()
+		err = installRemoteSSHExtension()
+		if err != nil {
+			return err
+		}
+		return openVSCodeUrl("code", "vscode", projectHostname, remoteLocation)
+	case "cursor":
+		cursorPath, err := GetCursorBinaryPath()
+		if err != nil {
+			return err
+		}
+		return openVSCodeUrl(cursorPath, "cursor", projectHostname, remoteLocation)
+	case "zed":
+		zedPath, err := GetZedBinaryPath()
+		if err != nil {
+			return err
+		}
+		return exec.Command(zedPath, fmt.Sprintf("ssh://%s%s", projectHostname, remoteLocation)).Run()
+	case "ssh":
+		return openTerminalEditor(activeProfile, workspaceId, projectName, gpgKey, remoteLocation)
+	default:
+		if _, ok := jetbrains.GetIdes()[jetbrains.Id(ideId)]; ok {
+			return openJetbrainsFile(ideId, projectDir, remoteLocation)
+		}
+	}
+
+	return fmt.Errorf("opening files is not supported with the %s IDE. Use --ide with vscode, cursor, zed, ssh or a JetBrains IDE", ideId)
+}
+
+// GetVSCodeRemoteFileUrl returns the URL handled by VS Code and its forks that opens a file over Remote SSH.
+// The line and column are appended to the path as in vscode://vscode-remote/ssh-remote+host/path/file.go:10:2
+func GetVSCodeRemoteFileUrl(scheme string, projectHostname string, location FileLocation) string {
+	fileUrl := url.URL{
+		Scheme: scheme,
+		Host:   "vscode-remote",
+		Path:   fmt.Sprintf("/ssh-remote+%s%s", projectHostname, location.Path),
+	}
+
+	if location.Line > 0 {
+		column := location.Column
+		if column == 0 {
+			column = 1
+		}
+		return fmt.Sprintf("%s:%d:%d", fileUrl.String(), location.Line, column)
+	}
+
+	return fileUrl.String()
+}
+
+func openVSCodeUrl(binaryPath string, scheme string, projectHostname string, location FileLocation) error {
+	return exec.Command(binaryPath, "--open-url", GetVSCodeRemoteFileUrl(scheme, projectHostname, location)).Run()
+}
+
+// openTerminalEditor opens the file in the project's $EDITOR over SSH. Most terminal editors accept +LINE
+func openTerminalEditor(activeProfile config.Profile, workspaceId string, projectName string, gpgKey string, location FileLocation) error {
+	editorCommand := fmt.Sprintf("${EDITOR:-vi} %s", shellQuote(location.Path))
+	if location.Line > 0 {
+		editorCommand = fmt.Sprintf("${EDITOR:-vi} +%d %s", location.Line, shellQuote(location.Path))
+	}
+
+	return OpenTerminalSsh(activeProfile, workspaceId, projectName, gpgKey, []string{"RequestTTY=yes"}, editorCommand)
+}
+
+// openJetbrainsFile navigates to the file in a project already open in the JetBrains client, e.g. with 'daytona code'
+func openJetbrainsFile(ideId string, projectDir string, location FileLocation) error {
+	// Toolbox registers IntelliJ IDEA as "idea", the other IDEs use their Daytona IDs
+	tool := ideId
+	if jetbrains.Id(ideId) == jetbrains.IntelliJ {
+		tool = "idea"
+	}
+
+	// Paths are relative to the project root unless the file is outside of it
+	navigatePath := location
+	if relativePath, found := strings.CutPrefix(location.Path, strings.TrimSuffix(projectDir, "/")+"/"); found {
+		navigatePath.Path = relativePath
+	}
+
+	query := url.Values{}
+	query.Set("project", path.Base(projectDir))
+	query.Set("path", navigatePath.String())
+
+	navigateUrl := fmt.Sprintf("jetbrains://%s/navigate/reference?%s", tool, query.Encode())
+
+	views.RenderInfoMessage(fmt.Sprintf("JetBrains link: %s", navigateUrl))
+
+	err := browser.OpenURL(navigateUrl)
+	if err != nil {
+		log.Debug(err)
+		views.RenderInfoMessage("Could not open the link automatically. Make sure the project is open in the IDE with 'daytona code' and open the link above.")
+	}
+
+	return nil
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFileLocation(t *testing.T) {
+	tests := []struct {
+		location string
+		expected FileLocation
+	}{
+		{"main.go", FileLocation{Path: "main.go"}},
+		{"pkg/server/server.go:42", FileLocation{Path: "pkg/server/server.go", Line: 42}},
+		{"/home/daytona/app/main.py:10:5", FileLocation{Path: "/home/daytona/app/main.py", Line: 10, Column: 5}},
+		{"src/app.ts#L7", FileLocation{Path: "src/app.ts", Line: 7}},
+		{"src/app.ts#L7-L12", FileLocation{Path: "src/app.ts", Line: 7}},
+		{"dir:with:colons/file.go:3", FileLocation{Path: "dir:with:colons/file.go", Line: 3}},
+	}
+
+	for _, test := range tests {
+		location, err := ParseFileLocation(test.location)
+		require.NoError(t, err, test.location)
+		require.Equal(t, test.expected, *location, test.location)
+	}
+
+	for _, invalid := range []string{"", ":42", "main.go:0", "main.go#Lfoo"} {
+		_, err := ParseFileLocation(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestGetVSCodeRemoteFileUrl(t *testing.T) {
+	require.Equal(t, "vscode://vscode-remote/ssh-remote+default-ws1-project/home/daytona/project/main.go:42:1",
+		GetVSCodeRemoteFileUrl("vscode", "default-ws1-project", FileLocation{Path: "/home/daytona/project/main.go", Line: 42}))
+	require.Equal(t, "cursor://vscode-remote/ssh-remote+default-ws1-project/home/daytona/project/main.go",
+		GetVSCodeRemoteFileUrl("cursor", "default-ws1-project", FileLocation{Path: "/home/daytona/project/main.go"}))
+}
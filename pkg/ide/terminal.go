@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
 )
 
 func OpenTerminalSsh(activeProfile config.Profile, workspaceId string, projectName string, gpgKey string, sshOptions []string, args ...string) error {
@@ -34,6 +35,19 @@ func OpenTerminalSsh(activeProfile config.Profile, workspaceId string, projectNa
 	return sshCommand.Run()
 }
 
+// OpenNativeTerminalSsh connects to the project with the built-in SSH client through the same proxy
+// that the generated SSH config uses. Starts a shell if no command is passed.
+// The local SSH agent is only forwarded with forwardAgent since anyone with access to the project can use it
+func OpenNativeTerminalSsh(activeProfile config.Profile, workspaceId string, projectName string, forwardAgent bool, args ...string) error {
+	client, err := util.GetProjectSshClient(activeProfile.Id, workspaceId, projectName)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return client.Shell(strings.Join(args, " "), forwardAgent)
+}
+
 // parseSshOptions validates and parses the SSH options.
 func parseSshOptions(sshOptions []string) (map[string]string, error) {
 	parsedOptions := make(map[string]string)
@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package invite
+
+import "time"
+
+// Invite is a one-time code that grants temporary access to a workspace when it is redeemed
+type Invite struct {
+	// Hash of the code, the code itself is only returned when the invite is created
+	CodeHash    string    `json:"-"`
+	WorkspaceId string    `json:"workspaceId" validate:"required"`
+	ExpiresAt   time.Time `json:"expiresAt" validate:"required"`
+}
+
+func (i *Invite) IsExpired() bool {
+	return time.Now().After(i.ExpiresAt)
+}
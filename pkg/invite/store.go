@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package invite
+
+import "errors"
+
+type Store interface {
+	Find(codeHash string) (*Invite, error)
+	Save(invite *Invite) error
+	Delete(invite *Invite) error
+}
+
+var (
+	ErrInviteNotFound = errors.New("invite not found")
+)
+
+func IsInviteNotFound(err error) bool {
+	return err.Error() == ErrInviteNotFound.Error()
+}
@@ -9,6 +9,10 @@ import (
 
 var LogDelimiter = "!-#_^*|\n"
 
+// Set on log stream responses by servers that start the stream at the requested offset.
+// The offset is the number of non-empty entries for JSON logs and the number of bytes for server logs
+const LogOffsetHeader = "X-Daytona-Log-Offset"
+
 type Logger interface {
 	io.WriteCloser
 	Cleanup() error
@@ -30,6 +34,7 @@ type LogEntry struct {
 	Msg         string  `json:"msg"`
 	Level       string  `json:"level"`
 	Time        string  `json:"time"`
+	Step        *Step   `json:"step,omitempty"`
 }
 
 type LoggerFactory interface {
@@ -23,23 +23,35 @@ type projectLogger struct {
 }
 
 func (pl *projectLogger) Write(p []byte) (n int, err error) {
+	var entry LogEntry
+	entry.Msg = string(p)
+
+	return len(p), pl.writeEntry(entry)
+}
+
+func (pl *projectLogger) WriteStep(step Step) error {
+	var entry LogEntry
+	entry.Step = &step
+
+	return pl.writeEntry(entry)
+}
+
+func (pl *projectLogger) writeEntry(entry LogEntry) error {
 	if pl.logFile == nil {
 		filePath := filepath.Join(pl.logsDir, pl.workspaceId, pl.projectName, "log")
-		err = os.MkdirAll(filepath.Dir(filePath), 0755)
+		err := os.MkdirAll(filepath.Dir(filePath), 0755)
 		if err != nil {
-			return len(p), err
+			return err
 		}
 
 		logFile, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 		if err != nil {
-			return len(p), err
+			return err
 		}
 		pl.logFile = logFile
 		pl.logger.SetOutput(pl.logFile)
 	}
 
-	var entry LogEntry
-	entry.Msg = string(p)
 	entry.Source = string(pl.source)
 	entry.WorkspaceId = &pl.workspaceId
 	entry.ProjectName = &pl.projectName
@@ -47,17 +59,13 @@ func (pl *projectLogger) Write(p []byte) (n int, err error) {
 
 	b, err := json.Marshal(entry)
 	if err != nil {
-		return len(p), err
+		return err
 	}
 
 	b = append(b, []byte(LogDelimiter)...)
 
 	_, err = pl.logFile.Write(b)
-	if err != nil {
-		return len(p), err
-	}
-
-	return len(p), nil
+	return err
 }
 
 func (pl *projectLogger) Close() error {
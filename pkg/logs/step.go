@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import "io"
+
+type StepState string
+
+const (
+	StepStateStarted StepState = "started"
+	StepStateDone    StepState = "done"
+	StepStateFailed  StepState = "failed"
+)
+
+const (
+	StepProvisionWorkspace = "provision-workspace"
+	// Cloning the repository and pulling or building the project image
+	StepCreateProject = "create-project"
+	StepStartProject  = "start-project"
+	// Reported by the client once the project accepts SSH connections
+	StepSshReady = "ssh-ready"
+)
+
+// Step marks the progress of a long running operation in the log stream so that clients
+// can render it separately from the log output
+type Step struct {
+	Name  string    `json:"name"`
+	State StepState `json:"state"`
+}
+
+type StepWriter interface {
+	WriteStep(step Step) error
+}
+
+// WriteStep writes the step to the writer if it supports steps and ignores it otherwise
+func WriteStep(w io.Writer, name string, state StepState) {
+	stepWriter, ok := w.(StepWriter)
+	if !ok {
+		return
+	}
+
+	stepWriter.WriteStep(Step{Name: name, State: state}) // nolint:errcheck
+}
+
+// RunStep runs fn and writes the step state to the writer before and after it
+func RunStep(w io.Writer, name string, fn func() error) error {
+	WriteStep(w, name, StepStateStarted)
+
+	err := fn()
+	if err != nil {
+		WriteStep(w, name, StepStateFailed)
+		return err
+	}
+
+	WriteStep(w, name, StepStateDone)
+	return nil
+}
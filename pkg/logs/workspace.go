@@ -22,39 +22,47 @@ type workspaceLogger struct {
 }
 
 func (w *workspaceLogger) Write(p []byte) (n int, err error) {
+	var entry LogEntry
+	entry.Msg = string(p)
+
+	return len(p), w.writeEntry(entry)
+}
+
+func (w *workspaceLogger) WriteStep(step Step) error {
+	var entry LogEntry
+	entry.Step = &step
+
+	return w.writeEntry(entry)
+}
+
+func (w *workspaceLogger) writeEntry(entry LogEntry) error {
 	if w.logFile == nil {
 		filePath := filepath.Join(w.logsDir, w.workspaceId, "log")
-		err = os.MkdirAll(filepath.Dir(filePath), 0755)
+		err := os.MkdirAll(filepath.Dir(filePath), 0755)
 		if err != nil {
-			return len(p), err
+			return err
 		}
 		logFile, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 		if err != nil {
-			return len(p), err
+			return err
 		}
 		w.logFile = logFile
 		w.logger.SetOutput(w.logFile)
 	}
 
-	var entry LogEntry
-	entry.Msg = string(p)
 	entry.Source = string(w.source)
 	entry.WorkspaceId = &w.workspaceId
 	entry.Time = time.Now().Format(time.RFC3339)
 
 	b, err := json.Marshal(entry)
 	if err != nil {
-		return len(p), err
+		return err
 	}
 
 	b = append(b, []byte(LogDelimiter)...)
 
 	_, err = w.logFile.Write(b)
-	if err != nil {
-		return len(p), err
-	}
-
-	return len(p), nil
+	return err
 }
 
 func (w *workspaceLogger) Close() error {
@@ -0,0 +1,280 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package mdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	MDNS_ADDRESS = "224.0.0.251:5353"
+	MDNS_PORT    = 5353
+	// Services are advertised as HTTP so that they can be discovered by browsers
+	SERVICE_TYPE   = "_http._tcp.local."
+	SERVICES_QUERY = "_services._dns-sd._udp.local."
+
+	recordTTL = 120
+	// Unique records have the cache-flush bit set in their class
+	cacheFlushClass = dns.ClassINET | 1<<15
+)
+
+var invalidLabelCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// Responder answers mDNS queries for the <name>.local hostname with the IPv4 addresses
+// of the client machine and advertises the forwarded ports as HTTP services
+type Responder struct {
+	label string
+	ports []uint16
+	ips   []net.IP
+}
+
+func NewResponder(name string, ports []uint16) (*Responder, error) {
+	ips, err := GetLocalIPs()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ips) == 0 {
+		return nil, errors.New("no network interface with an IPv4 address found")
+	}
+
+	return &Responder{
+		label: getLabel(name),
+		ports: ports,
+		ips:   ips,
+	}, nil
+}
+
+// GetHostname returns the mDNS hostname of the name (e.g. my_ws -> my-ws.local)
+func GetHostname(name string) string {
+	return fmt.Sprintf("%s.local", getLabel(name))
+}
+
+// Run announces the records and answers queries until the context is cancelled
+func (r *Responder) Run(ctx context.Context) error {
+	groupAddr, err := net.ResolveUDPAddr("udp4", MDNS_ADDRESS)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.ListenMulticastUDP("udp4", nil, groupAddr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	go r.announce(ctx, conn, groupAddr)
+
+	buf := make([]byte, 9000)
+	for {
+		n, src, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		var query dns.Msg
+		err = query.Unpack(buf[:n])
+		if err != nil || query.Response {
+			continue
+		}
+
+		res := r.answer(&query)
+		if res == nil {
+			continue
+		}
+
+		dest := groupAddr
+		// Legacy resolvers that don't send from the mDNS port expect a unicast reply to their query
+		if src.Port != MDNS_PORT {
+			dest = src
+			res.Id = query.Id
+			res.Question = query.Question
+		}
+
+		err = r.send(conn, res, dest)
+		if err != nil {
+			log.Debug(err)
+		}
+	}
+}
+
+// announce sends unsolicited responses with all records so that caches of other devices are populated
+func (r *Responder) announce(ctx context.Context, conn *net.UDPConn, groupAddr *net.UDPAddr) {
+	res := r.newResponse()
+	res.Answer = append(r.addressRecords(), r.serviceRecords()...)
+
+	for i := 0; i < 2; i++ {
+		err := r.send(conn, res, groupAddr)
+		if err != nil {
+			log.Debug(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (r *Responder) send(conn *net.UDPConn, msg *dns.Msg, dest *net.UDPAddr) error {
+	b, err := msg.Pack()
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.WriteToUDP(b, dest)
+	return err
+}
+
+// answer returns the response to the query or nil if none of the questions are for the records of the responder
+func (r *Responder) answer(query *dns.Msg) *dns.Msg {
+	res := r.newResponse()
+
+	for _, q := range query.Question {
+		name := strings.ToLower(q.Name)
+
+		switch {
+		case name == r.hostname() && (q.Qtype == dns.TypeA || q.Qtype == dns.TypeANY):
+			res.Answer = append(res.Answer, r.addressRecords()...)
+		case name == SERVICES_QUERY && (q.Qtype == dns.TypePTR || q.Qtype == dns.TypeANY):
+			if len(r.ports) > 0 {
+				res.Answer = append(res.Answer, &dns.PTR{Hdr: header(SERVICES_QUERY, dns.TypePTR, dns.ClassINET), Ptr: SERVICE_TYPE})
+			}
+		case name == SERVICE_TYPE && (q.Qtype == dns.TypePTR || q.Qtype == dns.TypeANY):
+			for _, port := range r.ports {
+				res.Answer = append(res.Answer, &dns.PTR{Hdr: header(SERVICE_TYPE, dns.TypePTR, dns.ClassINET), Ptr: r.instanceName(port)})
+				res.Extra = append(res.Extra, r.instanceRecords(port)...)
+			}
+			if len(r.ports) > 0 {
+				res.Extra = append(res.Extra, r.addressRecords()...)
+			}
+		default:
+			for _, port := range r.ports {
+				if name == r.instanceName(port) && (q.Qtype == dns.TypeSRV || q.Qtype == dns.TypeTXT || q.Qtype == dns.TypeANY) {
+					res.Answer = append(res.Answer, r.instanceRecords(port)...)
+					res.Extra = append(res.Extra, r.addressRecords()...)
+				}
+			}
+		}
+	}
+
+	if len(res.Answer) == 0 {
+		return nil
+	}
+
+	return res
+}
+
+func (r *Responder) newResponse() *dns.Msg {
+	res := new(dns.Msg)
+	res.Response = true
+	res.Authoritative = true
+	return res
+}
+
+func (r *Responder) hostname() string {
+	return fmt.Sprintf("%s.local.", r.label)
+}
+
+func (r *Responder) instanceName(port uint16) string {
+	return fmt.Sprintf("%s-%d.%s", r.label, port, SERVICE_TYPE)
+}
+
+func (r *Responder) addressRecords() []dns.RR {
+	records := []dns.RR{}
+	for _, ip := range r.ips {
+		records = append(records, &dns.A{Hdr: header(r.hostname(), dns.TypeA, cacheFlushClass), A: ip})
+	}
+	return records
+}
+
+func (r *Responder) instanceRecords(port uint16) []dns.RR {
+	return []dns.RR{
+		&dns.SRV{Hdr: header(r.instanceName(port), dns.TypeSRV, cacheFlushClass), Target: r.hostname(), Port: port},
+		&dns.TXT{Hdr: header(r.instanceName(port), dns.TypeTXT, cacheFlushClass), Txt: []string{"path=/"}},
+	}
+}
+
+func (r *Responder) serviceRecords() []dns.RR {
+	records := []dns.RR{}
+	for _, port := range r.ports {
+		records = append(records, &dns.PTR{Hdr: header(SERVICE_TYPE, dns.TypePTR, dns.ClassINET), Ptr: r.instanceName(port)})
+		records = append(records, r.instanceRecords(port)...)
+	}
+	return records
+}
+
+func header(name string, rrtype uint16, class uint16) dns.RR_Header {
+	return dns.RR_Header{Name: name, Rrtype: rrtype, Class: class, Ttl: recordTTL}
+}
+
+func getLabel(name string) string {
+	label := invalidLabelCharacters.ReplaceAllString(strings.ToLower(name), "-")
+	label = strings.Trim(label, "-")
+	if len(label) > 63 {
+		label = strings.TrimRight(label[:63], "-")
+	}
+	return label
+}
+
+// GetLocalIPs returns the IPv4 addresses of the interfaces that can reach the local network.
+// Tailscale addresses are skipped since other devices on the network can't reach them
+func GetLocalIPs() ([]net.IP, error) {
+	_, tailscaleRange, err := net.ParseCIDR("100.64.0.0/10")
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	ips := []net.IP{}
+	for _, iface := range interfaces {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagLoopback != 0 || iface.Flags&net.FlagMulticast == 0 {
+			continue
+		}
+
+		addrs, err := iface.Addrs()
+		if err != nil {
+			log.Debug(err)
+			continue
+		}
+
+		for _, addr := range addrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok {
+				continue
+			}
+
+			ip := ipNet.IP.To4()
+			if ip == nil || tailscaleRange.Contains(ip) {
+				continue
+			}
+
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips, nil
+}
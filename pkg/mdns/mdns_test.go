@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package mdns
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHostname(t *testing.T) {
+	require.Equal(t, "my-ws.local", GetHostname("My_WS"))
+	require.Equal(t, "ws-1.local", GetHostname("--ws.1--"))
+}
+
+func TestAnswer(t *testing.T) {
+	r := &Responder{
+		label: "myws",
+		ports: []uint16{3000},
+		ips:   []net.IP{net.ParseIP("192.168.1.10").To4()},
+	}
+
+	query := new(dns.Msg)
+	query.SetQuestion("MyWS.local.", dns.TypeA)
+
+	res := r.answer(query)
+	require.NotNil(t, res)
+	require.Len(t, res.Answer, 1)
+	require.Equal(t, "192.168.1.10", res.Answer[0].(*dns.A).A.String())
+
+	query.SetQuestion(SERVICE_TYPE, dns.TypePTR)
+
+	res = r.answer(query)
+	require.NotNil(t, res)
+	require.Equal(t, "myws-3000."+SERVICE_TYPE, res.Answer[0].(*dns.PTR).Ptr)
+	require.Equal(t, uint16(3000), res.Extra[0].(*dns.SRV).Port)
+
+	query.SetQuestion("other.local.", dns.TypeA)
+	require.Nil(t, r.answer(query))
+}
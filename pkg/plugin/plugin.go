@@ -0,0 +1,155 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Plugins are executables on PATH named daytona-<NAME>. Dashes in the name separate subcommands,
+// e.g. daytona-team-sync is invoked with 'daytona team sync'. Underscores are invoked as dashes
+const PluginPrefix = "daytona-"
+
+// Set for plugins to the path of the daytona executable that invoked them
+const DAYTONA_BIN_ENV_VAR = "DAYTONA_BIN"
+
+type Plugin struct {
+	// Name of the command that invokes the plugin, e.g. "team sync"
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// Paths of the plugins with the same name that are later on PATH and are never invoked
+	Shadows []string `json:"shadows,omitempty"`
+}
+
+// List returns the plugins found on PATH. If several executables have the same name, the first one is used
+func List() []Plugin {
+	plugins := []Plugin{}
+	indexes := map[string]int{}
+
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, ok := getPluginName(entry.Name())
+			if !ok || entry.IsDir() {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+
+			if i, ok := indexes[name]; ok {
+				if plugins[i].Path != path {
+					plugins[i].Shadows = append(plugins[i].Shadows, path)
+				}
+				continue
+			}
+
+			indexes[name] = len(plugins)
+			plugins = append(plugins, Plugin{Name: name, Path: path})
+		}
+	}
+
+	return plugins
+}
+
+// Find returns the plugin for the longest prefix of the arguments that matches a plugin and the remaining arguments.
+// Only the arguments before the first flag are considered
+func Find(args []string) (*Plugin, []string, bool) {
+	nameArgs := []string{}
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		nameArgs = append(nameArgs, arg)
+	}
+
+	for i := len(nameArgs); i > 0; i-- {
+		parts := []string{}
+		for _, arg := range nameArgs[:i] {
+			parts = append(parts, strings.ReplaceAll(arg, "-", "_"))
+		}
+
+		path, err := exec.LookPath(PluginPrefix + strings.Join(parts, "-"))
+		if err != nil {
+			continue
+		}
+
+		return &Plugin{Name: strings.Join(nameArgs[:i], " "), Path: path}, args[i:], true
+	}
+
+	return nil, nil, false
+}
+
+// Run runs the plugin with the arguments and returns its exit code
+func (p *Plugin) Run(args []string) (int, error) {
+	cmd := exec.Command(p.Path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+
+	if daytonaPath, err := os.Executable(); err == nil {
+		cmd.Env = append(cmd.Env, DAYTONA_BIN_ENV_VAR+"="+daytonaPath)
+	}
+
+	err := cmd.Run()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode(), nil
+		}
+		return 1, err
+	}
+
+	return 0, nil
+}
+
+// getPluginName returns the command name of the plugin executable file
+func getPluginName(fileName string) (string, bool) {
+	if runtime.GOOS == "windows" {
+		ext := strings.ToLower(filepath.Ext(fileName))
+		if ext != ".exe" && ext != ".bat" && ext != ".cmd" {
+			return "", false
+		}
+		fileName = strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	}
+
+	if !strings.HasPrefix(fileName, PluginPrefix) || len(fileName) == len(PluginPrefix) {
+		return "", false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(fileName, PluginPrefix), "-")
+	for i, part := range parts {
+		if part == "" {
+			return "", false
+		}
+		parts[i] = strings.ReplaceAll(part, "_", "-")
+	}
+
+	return strings.Join(parts, " "), true
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+
+	if runtime.GOOS == "windows" {
+		return true
+	}
+
+	return info.Mode()&0111 != 0
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writePlugin(t *testing.T, dir, name string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\nexit 3\n"), 0755))
+	return path
+}
+
+func TestList(t *testing.T) {
+	dir1, dir2 := t.TempDir(), t.TempDir()
+	t.Setenv("PATH", strings.Join([]string{dir1, dir2}, string(os.PathListSeparator)))
+
+	teamSync := writePlugin(t, dir1, "daytona-team-sync")
+	shadowed := writePlugin(t, dir2, "daytona-team-sync")
+	myTool := writePlugin(t, dir2, "daytona-my_tool")
+	require.NoError(t, os.WriteFile(filepath.Join(dir1, "daytona-not-executable"), []byte{}, 0644))
+	writePlugin(t, dir1, "other-tool")
+
+	require.ElementsMatch(t, []Plugin{
+		{Name: "team sync", Path: teamSync, Shadows: []string{shadowed}},
+		{Name: "my-tool", Path: myTool},
+	}, List())
+}
+
+func TestFind(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("PATH", dir)
+
+	team := writePlugin(t, dir, "daytona-team")
+	teamSync := writePlugin(t, dir, "daytona-team-sync")
+	myTool := writePlugin(t, dir, "daytona-my_tool")
+
+	plugin, args, ok := Find([]string{"team", "sync", "now", "--force"})
+	require.True(t, ok)
+	require.Equal(t, &Plugin{Name: "team sync", Path: teamSync}, plugin)
+	require.Equal(t, []string{"now", "--force"}, args)
+
+	plugin, args, ok = Find([]string{"team", "--verbose", "sync"})
+	require.True(t, ok)
+	require.Equal(t, team, plugin.Path)
+	require.Equal(t, []string{"--verbose", "sync"}, args)
+
+	plugin, _, ok = Find([]string{"my-tool"})
+	require.True(t, ok)
+	require.Equal(t, myTool, plugin.Path)
+
+	_, _, ok = Find([]string{"unknown"})
+	require.False(t, ok)
+
+	code, err := plugin.Run(nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, code)
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Username used for HTTP basic auth of password protected public URLs
+const PUBLIC_PORT_USERNAME = "daytona"
+
+// Query parameter of signed links. The token is moved to a cookie on the first request
+const PUBLIC_PORT_TOKEN_PARAM = "daytona_token"
+
+const publicPortTokenCookie = "daytona_token"
+
+// PublicPortAuth protects a public port with a password, signed expiring links or both.
+// A request is let through if it satisfies any of the configured methods
+// TODO: add GitHub organization SSO, which requires an OAuth app registered for the server
+type PublicPortAuth struct {
+	// If set, requests can authenticate with HTTP basic auth
+	Password string
+	// If set, requests can authenticate with a link signed with this key
+	LinkKey []byte
+}
+
+// NewLinkKey returns a random key to sign links with
+func NewLinkKey() ([]byte, error) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// SignLink returns the link with a token that is valid until expiresAt
+func (a *PublicPortAuth) SignLink(link string, expiresAt time.Time) (string, error) {
+	if len(a.LinkKey) == 0 {
+		return "", errors.New("links can not be signed without a key")
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set(PUBLIC_PORT_TOKEN_PARAM, a.signToken(expiresAt.Unix()))
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+func (a *PublicPortAuth) signToken(expiresAt int64) string {
+	expiry := strconv.FormatInt(expiresAt, 10)
+
+	mac := hmac.New(sha256.New, a.LinkKey)
+	mac.Write([]byte(expiry))
+
+	return expiry + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifyToken returns the expiry of a valid token
+func (a *PublicPortAuth) verifyToken(token string) (time.Time, bool) {
+	if len(a.LinkKey) == 0 {
+		return time.Time{}, false
+	}
+
+	expiry, _, found := strings.Cut(token, ".")
+	if !found {
+		return time.Time{}, false
+	}
+
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil || time.Now().Unix() >= expiresAt {
+		return time.Time{}, false
+	}
+
+	if !hmac.Equal([]byte(token), []byte(a.signToken(expiresAt))) {
+		return time.Time{}, false
+	}
+
+	return time.Unix(expiresAt, 0), true
+}
+
+// Handler lets authenticated requests through to next. Requests with a valid link token get the token
+// as a cookie and are redirected to the same URL without it
+func (a *PublicPortAuth) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if token := query.Get(PUBLIC_PORT_TOKEN_PARAM); token != "" {
+			expiresAt, ok := a.verifyToken(token)
+			if !ok {
+				http.Error(w, "the link is invalid or has expired", http.StatusUnauthorized)
+				return
+			}
+
+			http.SetCookie(w, &http.Cookie{
+				Name:     publicPortTokenCookie,
+				Value:    token,
+				Path:     "/",
+				Expires:  expiresAt,
+				HttpOnly: true,
+				SameSite: http.SameSiteLaxMode,
+			})
+
+			query.Del(PUBLIC_PORT_TOKEN_PARAM)
+			redirectUrl := *r.URL
+			redirectUrl.RawQuery = query.Encode()
+
+			http.Redirect(w, r, redirectUrl.RequestURI(), http.StatusSeeOther)
+			return
+		}
+
+		if cookie, err := r.Cookie(publicPortTokenCookie); err == nil {
+			if _, ok := a.verifyToken(cookie.Value); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		if a.Password != "" {
+			username, password, ok := r.BasicAuth()
+			if ok && username == PUBLIC_PORT_USERNAME && subtle.ConstantTimeCompare([]byte(password), []byte(a.Password)) == 1 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("WWW-Authenticate", `Basic realm="Daytona", charset="UTF-8"`)
+		}
+
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	})
+}
+
+// StartProxy serves a reverse proxy on a random local port that only forwards authenticated requests
+// to the target port. The Host header of the requests is kept as is
+func (a *PublicPortAuth) StartProxy(targetPort uint16) (uint16, error) {
+	target, err := url.Parse(fmt.Sprintf("http://localhost:%d", targetPort))
+	if err != nil {
+		return 0, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+
+	go func() {
+		err := http.Serve(listener, a.Handler(httputil.NewSingleHostReverseProxy(target)))
+		if err != nil {
+			log.Error(err)
+		}
+	}()
+
+	return uint16(listener.Addr().(*net.TCPAddr).Port), nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+func serve(handler http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestPublicPortAuth_Password(t *testing.T) {
+	handler := (&PublicPortAuth{Password: "secret"}).Handler(okHandler)
+
+	w := serve(handler, httptest.NewRequest("GET", "/", nil))
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.SetBasicAuth(PUBLIC_PORT_USERNAME, "wrong")
+	require.Equal(t, http.StatusUnauthorized, serve(handler, r).Code)
+
+	r = httptest.NewRequest("GET", "/", nil)
+	r.SetBasicAuth(PUBLIC_PORT_USERNAME, "secret")
+	require.Equal(t, http.StatusOK, serve(handler, r).Code)
+}
+
+func TestPublicPortAuth_SignedLink(t *testing.T) {
+	key, err := NewLinkKey()
+	require.NoError(t, err)
+
+	auth := &PublicPortAuth{LinkKey: key}
+	handler := auth.Handler(okHandler)
+
+	require.Equal(t, http.StatusUnauthorized, serve(handler, httptest.NewRequest("GET", "/", nil)).Code)
+
+	link, err := auth.SignLink("https://3000-abc.example.com/app?tab=1", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+
+	w := serve(handler, httptest.NewRequest("GET", u.RequestURI(), nil))
+	require.Equal(t, http.StatusSeeOther, w.Code)
+	require.Equal(t, "/app?tab=1", w.Header().Get("Location"))
+
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+
+	r := httptest.NewRequest("GET", "/app?tab=1", nil)
+	r.AddCookie(cookies[0])
+	require.Equal(t, http.StatusOK, serve(handler, r).Code)
+
+	// Tokens signed with another key are rejected
+	otherKey, err := NewLinkKey()
+	require.NoError(t, err)
+	forged, err := (&PublicPortAuth{LinkKey: otherKey}).SignLink("/", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, serve(handler, httptest.NewRequest("GET", forged, nil)).Code)
+
+	expired, err := auth.SignLink("/", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, serve(handler, httptest.NewRequest("GET", expired, nil)).Code)
+}
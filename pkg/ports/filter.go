@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PortRange is an inclusive range of ports. A single port has the same start and end
+type PortRange struct {
+	Start uint16
+	End   uint16
+}
+
+func (r PortRange) Contains(port uint16) bool {
+	return port >= r.Start && port <= r.End
+}
+
+// ParsePortRange parses a port (e.g. 3000) or a port range (e.g. 8000-8999)
+func ParsePortRange(s string) (PortRange, error) {
+	startStr, endStr, isRange := strings.Cut(strings.TrimSpace(s), "-")
+
+	start, err := strconv.ParseUint(startStr, 10, 16)
+	if err != nil || start == 0 {
+		return PortRange{}, fmt.Errorf("invalid port %s. Expected a port (e.g. 3000) or a port range (e.g. 8000-8999)", s)
+	}
+
+	if !isRange {
+		return PortRange{Start: uint16(start), End: uint16(start)}, nil
+	}
+
+	end, err := strconv.ParseUint(endStr, 10, 16)
+	if err != nil || end < start {
+		return PortRange{}, fmt.Errorf("invalid port range %s. Expected a port range (e.g. 8000-8999)", s)
+	}
+
+	return PortRange{Start: uint16(start), End: uint16(end)}, nil
+}
+
+// Filter selects the ports detected in a project that are forwarded automatically
+type Filter struct {
+	// If not empty, only the ports in these ranges are selected
+	Allow []PortRange
+	// Ports that are never selected. Takes precedence over the allow list
+	Ignore []PortRange
+}
+
+// NewFilter parses the allow and ignore lists of ports and port ranges
+func NewFilter(allow, ignore []string) (*Filter, error) {
+	filter := &Filter{}
+
+	for _, s := range allow {
+		r, err := ParsePortRange(s)
+		if err != nil {
+			return nil, err
+		}
+		filter.Allow = append(filter.Allow, r)
+	}
+
+	for _, s := range ignore {
+		r, err := ParsePortRange(s)
+		if err != nil {
+			return nil, err
+		}
+		filter.Ignore = append(filter.Ignore, r)
+	}
+
+	return filter, nil
+}
+
+func (f *Filter) Matches(port uint16) bool {
+	for _, r := range f.Ignore {
+		if r.Contains(port) {
+			return false
+		}
+	}
+
+	if len(f.Allow) == 0 {
+		return true
+	}
+
+	for _, r := range f.Allow {
+		if r.Contains(port) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePortRange(t *testing.T) {
+	r, err := ParsePortRange("3000")
+	require.NoError(t, err)
+	require.Equal(t, PortRange{Start: 3000, End: 3000}, r)
+
+	r, err = ParsePortRange("8000-8999")
+	require.NoError(t, err)
+	require.Equal(t, PortRange{Start: 8000, End: 8999}, r)
+
+	for _, invalid := range []string{"", "0", "abc", "70000", "9000-8000", "8000-"} {
+		_, err = ParsePortRange(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	filter, err := NewFilter(nil, []string{"5432", "9000-9100"})
+	require.NoError(t, err)
+	require.True(t, filter.Matches(3000))
+	require.False(t, filter.Matches(5432))
+	require.False(t, filter.Matches(9050))
+
+	filter, err = NewFilter([]string{"3000-3999", "8080"}, []string{"3306"})
+	require.NoError(t, err)
+	require.True(t, filter.Matches(3000))
+	require.True(t, filter.Matches(8080))
+	require.False(t, filter.Matches(3306))
+	require.False(t, filter.Matches(5000))
+
+	_, err = NewFilter([]string{"invalid"}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// TCP_LISTEN is the socket state of listening sockets in /proc/net/tcp
+const TCP_LISTEN = "0A"
+
+var procNetTcpFiles = []string{"/proc/net/tcp", "/proc/net/tcp6"}
+
+// GetListeningPorts returns the sorted list of local TCP ports that have a listening socket.
+// It is only supported on Linux, where it reads the kernel socket tables.
+func GetListeningPorts() ([]uint16, error) {
+	ports := []uint16{}
+
+	for _, path := range procNetTcpFiles {
+		file, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		filePorts, err := parseListeningPorts(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, port := range filePorts {
+			if !slices.Contains(ports, port) {
+				ports = append(ports, port)
+			}
+		}
+	}
+
+	slices.Sort(ports)
+
+	return ports, nil
+}
+
+func parseListeningPorts(r io.Reader) ([]uint16, error) {
+	ports := []uint16{}
+
+	scanner := bufio.NewScanner(r)
+	// Skip the header line
+	scanner.Scan()
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || fields[3] != TCP_LISTEN {
+			continue
+		}
+
+		// The local address is formatted as <hex ip>:<hex port>
+		_, hexPort, found := strings.Cut(fields[1], ":")
+		if !found {
+			continue
+		}
+
+		port, err := strconv.ParseUint(hexPort, 16, 16)
+		if err != nil {
+			continue
+		}
+
+		if !slices.Contains(ports, uint16(port)) {
+			ports = append(ports, uint16(port))
+		}
+	}
+
+	return ports, scanner.Err()
+}
@@ -43,6 +43,7 @@ type IProviderManager interface {
 	GetProviders() map[string]Provider
 	GetProvidersManifest() (*ProvidersManifest, error)
 	RegisterProvider(pluginPath string, manualInstall bool) error
+	SetRegistryUrl(registryUrl string)
 	TerminateProviderProcesses(providersBasePath string) error
 	UninstallProvider(name string) error
 	Purge() error
@@ -94,6 +95,11 @@ type ProviderManager struct {
 	createProviderNetworkKey func(providerName string) (string, error)
 }
 
+// SetRegistryUrl sets the registry the providers manifest and provider binaries are downloaded from
+func (m *ProviderManager) SetRegistryUrl(registryUrl string) {
+	m.registryUrl = registryUrl
+}
+
 func (m *ProviderManager) GetProvider(name string) (*Provider, error) {
 	pluginRef, ok := m.pluginRefs[name]
 	if !ok {
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"errors"
 	"net/rpc"
 
 	"github.com/daytonaio/daytona/pkg/provider/util"
@@ -33,6 +34,30 @@ type Provider interface {
 	GetProjectInfo(*ProjectRequest) (*project.ProjectInfo, error)
 }
 
+// The interfaces below are optional capabilities added after Provider was published, so that providers built
+// against Provider keep working. They are detected at runtime and return ErrNotSupported if the provider lacks them
+var ErrNotSupported = errors.New("not supported by provider")
+
+// ProjectTimingsProvider reports how long the phases of creating and starting a project took on the target.
+// Projects of other providers are created and started without these timings
+type ProjectTimingsProvider interface {
+	CreateProjectWithTimings(*ProjectRequest) (*ProjectCreationTimings, error)
+	StartProjectWithTimings(*ProjectRequest) (*ProjectCreationTimings, error)
+}
+
+type SnapshotProvider interface {
+	CreateProjectSnapshot(*ProjectSnapshotRequest) (*util.Empty, error)
+	RestoreProjectSnapshot(*ProjectSnapshotRequest) (*util.Empty, error)
+	DeleteProjectSnapshot(*ProjectSnapshotRequest) (*util.Empty, error)
+}
+
+type HibernationProvider interface {
+	// HibernateProject archives the data of a stopped project on the target to free disk space
+	HibernateProject(*ProjectRequest) (*util.Empty, error)
+	// WakeProject restores the data archived by HibernateProject so the project can be started again
+	WakeProject(*ProjectRequest) (*util.Empty, error)
+}
+
 type ProviderPlugin struct {
 	Impl Provider
 }
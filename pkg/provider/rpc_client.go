@@ -4,7 +4,9 @@
 package provider
 
 import (
+	"errors"
 	"net/rpc"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/provider/util"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -72,15 +74,29 @@ func (m *ProviderRPCClient) GetWorkspaceInfo(workspaceReq *WorkspaceRequest) (*w
 }
 
 func (m *ProviderRPCClient) CreateProject(projectReq *ProjectRequest) (*util.Empty, error) {
-	err := m.client.Call("Plugin.CreateProject", projectReq, new(util.Empty))
+	_, err := m.CreateProjectWithTimings(projectReq)
 	return new(util.Empty), err
 }
 
 func (m *ProviderRPCClient) StartProject(projectReq *ProjectRequest) (*util.Empty, error) {
-	err := m.client.Call("Plugin.StartProject", projectReq, new(util.Empty))
+	_, err := m.StartProjectWithTimings(projectReq)
 	return new(util.Empty), err
 }
 
+// CreateProjectWithTimings creates the project. Providers that don't report timings return empty timings
+func (m *ProviderRPCClient) CreateProjectWithTimings(projectReq *ProjectRequest) (*ProjectCreationTimings, error) {
+	var response ProjectCreationTimings
+	err := m.client.Call("Plugin.CreateProject", projectReq, &response)
+	return &response, err
+}
+
+// StartProjectWithTimings starts the project. Providers that don't report timings return empty timings
+func (m *ProviderRPCClient) StartProjectWithTimings(projectReq *ProjectRequest) (*ProjectCreationTimings, error) {
+	var response ProjectCreationTimings
+	err := m.client.Call("Plugin.StartProject", projectReq, &response)
+	return &response, err
+}
+
 func (m *ProviderRPCClient) StopProject(projectReq *ProjectRequest) (*util.Empty, error) {
 	err := m.client.Call("Plugin.StopProject", projectReq, new(util.Empty))
 	return new(util.Empty), err
@@ -96,3 +112,43 @@ func (m *ProviderRPCClient) GetProjectInfo(projectReq *ProjectRequest) (*project
 	err := m.client.Call("Plugin.GetProjectInfo", projectReq, &resp)
 	return &resp, err
 }
+
+func (m *ProviderRPCClient) CreateProjectSnapshot(snapshotReq *ProjectSnapshotRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.CreateProjectSnapshot", snapshotReq, new(util.Empty))
+	return new(util.Empty), notSupportedError(err)
+}
+
+func (m *ProviderRPCClient) RestoreProjectSnapshot(snapshotReq *ProjectSnapshotRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.RestoreProjectSnapshot", snapshotReq, new(util.Empty))
+	return new(util.Empty), notSupportedError(err)
+}
+
+func (m *ProviderRPCClient) DeleteProjectSnapshot(snapshotReq *ProjectSnapshotRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.DeleteProjectSnapshot", snapshotReq, new(util.Empty))
+	return new(util.Empty), notSupportedError(err)
+}
+
+func (m *ProviderRPCClient) HibernateProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.HibernateProject", projectReq, new(util.Empty))
+	return new(util.Empty), notSupportedError(err)
+}
+
+func (m *ProviderRPCClient) WakeProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.WakeProject", projectReq, new(util.Empty))
+	return new(util.Empty), notSupportedError(err)
+}
+
+// notSupportedError returns ErrNotSupported if the provider does not implement the called capability,
+// either because it was built before the capability was added or because it does not implement its interface
+func notSupportedError(err error) error {
+	var serverErr rpc.ServerError
+	if !errors.As(err, &serverErr) {
+		return err
+	}
+
+	if strings.HasPrefix(string(serverErr), "rpc: can't find method") || string(serverErr) == ErrNotSupported.Error() {
+		return ErrNotSupported
+	}
+
+	return err
+}
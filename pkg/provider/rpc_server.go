@@ -87,14 +87,40 @@ func (m *ProviderRPCServer) GetWorkspaceInfo(arg *WorkspaceRequest, resp *worksp
 	return nil
 }
 
-func (m *ProviderRPCServer) CreateProject(arg *ProjectRequest, resp *util.Empty) error {
-	_, err := m.Impl.CreateProject(arg)
-	return err
+func (m *ProviderRPCServer) CreateProject(arg *ProjectRequest, resp *ProjectCreationTimings) error {
+	timingsProvider, ok := m.Impl.(ProjectTimingsProvider)
+	if !ok {
+		_, err := m.Impl.CreateProject(arg)
+		return err
+	}
+
+	timings, err := timingsProvider.CreateProjectWithTimings(arg)
+	if err != nil {
+		return err
+	}
+
+	if timings != nil {
+		*resp = *timings
+	}
+	return nil
 }
 
-func (m *ProviderRPCServer) StartProject(arg *ProjectRequest, resp *util.Empty) error {
-	_, err := m.Impl.StartProject(arg)
-	return err
+func (m *ProviderRPCServer) StartProject(arg *ProjectRequest, resp *ProjectCreationTimings) error {
+	timingsProvider, ok := m.Impl.(ProjectTimingsProvider)
+	if !ok {
+		_, err := m.Impl.StartProject(arg)
+		return err
+	}
+
+	timings, err := timingsProvider.StartProjectWithTimings(arg)
+	if err != nil {
+		return err
+	}
+
+	if timings != nil {
+		*resp = *timings
+	}
+	return nil
 }
 
 func (m *ProviderRPCServer) StopProject(arg *ProjectRequest, resp *util.Empty) error {
@@ -116,3 +142,53 @@ func (m *ProviderRPCServer) GetProjectInfo(arg *ProjectRequest, resp *project.Pr
 	*resp = *info
 	return nil
 }
+
+func (m *ProviderRPCServer) CreateProjectSnapshot(arg *ProjectSnapshotRequest, resp *util.Empty) error {
+	snapshotProvider, ok := m.Impl.(SnapshotProvider)
+	if !ok {
+		return ErrNotSupported
+	}
+
+	_, err := snapshotProvider.CreateProjectSnapshot(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) RestoreProjectSnapshot(arg *ProjectSnapshotRequest, resp *util.Empty) error {
+	snapshotProvider, ok := m.Impl.(SnapshotProvider)
+	if !ok {
+		return ErrNotSupported
+	}
+
+	_, err := snapshotProvider.RestoreProjectSnapshot(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) DeleteProjectSnapshot(arg *ProjectSnapshotRequest, resp *util.Empty) error {
+	snapshotProvider, ok := m.Impl.(SnapshotProvider)
+	if !ok {
+		return ErrNotSupported
+	}
+
+	_, err := snapshotProvider.DeleteProjectSnapshot(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) HibernateProject(arg *ProjectRequest, resp *util.Empty) error {
+	hibernationProvider, ok := m.Impl.(HibernationProvider)
+	if !ok {
+		return ErrNotSupported
+	}
+
+	_, err := hibernationProvider.HibernateProject(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) WakeProject(arg *ProjectRequest, resp *util.Empty) error {
+	hibernationProvider, ok := m.Impl.(HibernationProvider)
+	if !ok {
+		return ErrNotSupported
+	}
+
+	_, err := hibernationProvider.WakeProject(arg)
+	return err
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"net"
+	"net/rpc"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyProvider only implements the methods of the Provider interface used in the tests
+type legacyProvider struct {
+	Provider
+	created bool
+}
+
+func (p *legacyProvider) CreateProject(*ProjectRequest) (*util.Empty, error) {
+	p.created = true
+	return new(util.Empty), nil
+}
+
+type timingsProvider struct {
+	legacyProvider
+}
+
+func (p *timingsProvider) CreateProjectWithTimings(*ProjectRequest) (*ProjectCreationTimings, error) {
+	return &ProjectCreationTimings{Pull: time.Second}, nil
+}
+
+func (p *timingsProvider) StartProjectWithTimings(*ProjectRequest) (*ProjectCreationTimings, error) {
+	return &ProjectCreationTimings{}, nil
+}
+
+// legacyPluginServer is the RPC server of a plugin built before the optional capabilities were added
+type legacyPluginServer struct{}
+
+func (s *legacyPluginServer) CreateProject(arg *ProjectRequest, resp *util.Empty) error {
+	return nil
+}
+
+func newTestClient(t *testing.T, server any) *ProviderRPCClient {
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("Plugin", server))
+
+	serverConn, clientConn := net.Pipe()
+	go rpcServer.ServeConn(serverConn)
+
+	client := rpc.NewClient(clientConn)
+	t.Cleanup(func() { client.Close() })
+
+	return &ProviderRPCClient{client: client}
+}
+
+func TestProviderWithoutOptionalCapabilities(t *testing.T) {
+	impl := &legacyProvider{}
+	client := newTestClient(t, &ProviderRPCServer{Impl: impl})
+
+	timings, err := client.CreateProjectWithTimings(&ProjectRequest{})
+	require.NoError(t, err)
+	require.True(t, impl.created)
+	require.Zero(t, *timings)
+
+	_, err = client.CreateProjectSnapshot(&ProjectSnapshotRequest{})
+	require.ErrorIs(t, err, ErrNotSupported)
+
+	_, err = client.HibernateProject(&ProjectRequest{})
+	require.ErrorIs(t, err, ErrNotSupported)
+}
+
+func TestProviderWithTimings(t *testing.T) {
+	client := newTestClient(t, &ProviderRPCServer{Impl: &timingsProvider{}})
+
+	timings, err := client.CreateProjectWithTimings(&ProjectRequest{})
+	require.NoError(t, err)
+	require.Equal(t, time.Second, timings.Pull)
+}
+
+func TestLegacyPlugin(t *testing.T) {
+	client := newTestClient(t, &legacyPluginServer{})
+
+	_, err := client.CreateProject(&ProjectRequest{})
+	require.NoError(t, err)
+
+	_, err = client.RestoreProjectSnapshot(&ProjectSnapshotRequest{})
+	require.ErrorIs(t, err, ErrNotSupported)
+
+	_, err = client.WakeProject(&ProjectRequest{})
+	require.ErrorIs(t, err, ErrNotSupported)
+}
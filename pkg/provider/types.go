@@ -4,6 +4,8 @@
 package provider
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -45,12 +47,31 @@ type ProjectRequest struct {
 	BuilderContainerRegistry *containerregistry.ContainerRegistry
 }
 
+// Durations of the project creation phases measured by the provider.
+// Phases the provider does not measure are left at zero.
+type ProjectCreationTimings struct {
+	Pull  time.Duration
+	Clone time.Duration
+	Build time.Duration
+	// Time spent running lifecycle hooks that block the project creation or start
+	Hooks time.Duration
+}
+
+type ProjectSnapshotRequest struct {
+	TargetOptions string
+	Project       *project.Project
+	// Image the project container filesystem is committed to, restored from or deleted
+	SnapshotImage string
+}
+
 type ProviderTarget struct {
 	Name         string       `json:"name" validate:"required"`
 	ProviderInfo ProviderInfo `json:"providerInfo" validate:"required"`
 	// JSON encoded map of options
 	Options   string `json:"options" validate:"required"`
 	IsDefault bool   `json:"isDefault" validate:"required"`
+	// Labels of the target host, matched against the placement constraints of new workspaces
+	Labels map[string]string `json:"labels,omitempty" validate:"optional"`
 } // @name ProviderTarget
 
 type ProviderTargetManifest map[string]ProviderTargetProperty // @name ProviderTargetManifest
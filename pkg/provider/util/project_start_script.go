@@ -6,5 +6,6 @@ package util
 import "fmt"
 
 func GetProjectStartScript(daytonaDownloadUrl string, apiKey string) string {
-	return fmt.Sprintf(`curl -sfL -H "Authorization: Bearer %s" %s | sudo -E bash && daytona agent`, apiKey, daytonaDownloadUrl)
+	// The project user might be root (e.g. with rootless Docker) in which case sudo might not be installed
+	return fmt.Sprintf(`curl -sfL -H "Authorization: Bearer %s" %s | (if [ "$(id -u)" = "0" ]; then bash; else sudo -E bash; fi) && daytona agent`, apiKey, daytonaDownloadUrl)
 }
@@ -22,20 +22,26 @@ func (p *Provisioner) CreateWorkspace(workspace *workspace.Workspace, target *pr
 	return err
 }
 
-func (p *Provisioner) CreateProject(params ProjectParams) error {
+func (p *Provisioner) CreateProject(params ProjectParams) (*provider.ProjectCreationTimings, error) {
 	targetProvider, err := p.providerManager.GetProvider(params.Target.ProviderInfo.Name)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = (*targetProvider).CreateProject(&provider.ProjectRequest{
+	projectReq := &provider.ProjectRequest{
 		TargetOptions:            params.Target.Options,
 		Project:                  params.Project,
 		ContainerRegistry:        params.ContainerRegistry,
 		GitProviderConfig:        params.GitProviderConfig,
 		BuilderImage:             params.BuilderImage,
 		BuilderContainerRegistry: params.BuilderImageContainerRegistry,
-	})
+	}
 
-	return err
+	timingsProvider, ok := (*targetProvider).(provider.ProjectTimingsProvider)
+	if !ok {
+		_, err = (*targetProvider).CreateProject(projectReq)
+		return nil, err
+	}
+
+	return timingsProvider.CreateProjectWithTimings(projectReq)
 }
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (p *Provisioner) HibernateProject(proj *project.Project, target *provider.ProviderTarget) error {
+	hibernationProvider, err := p.getHibernationProvider(target)
+	if err != nil {
+		return err
+	}
+
+	_, err = hibernationProvider.HibernateProject(&provider.ProjectRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+	})
+
+	return hibernationNotSupportedError(err, target)
+}
+
+func (p *Provisioner) WakeProject(proj *project.Project, target *provider.ProviderTarget) error {
+	hibernationProvider, err := p.getHibernationProvider(target)
+	if err != nil {
+		return err
+	}
+
+	_, err = hibernationProvider.WakeProject(&provider.ProjectRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+	})
+
+	return hibernationNotSupportedError(err, target)
+}
+
+func (p *Provisioner) getHibernationProvider(target *provider.ProviderTarget) (provider.HibernationProvider, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	hibernationProvider, ok := (*targetProvider).(provider.HibernationProvider)
+	if !ok {
+		return nil, hibernationNotSupportedError(provider.ErrNotSupported, target)
+	}
+
+	return hibernationProvider, nil
+}
+
+func hibernationNotSupportedError(err error, target *provider.ProviderTarget) error {
+	if errors.Is(err, provider.ErrNotSupported) {
+		return fmt.Errorf("hibernation is %w %s", err, target.ProviderInfo.Name)
+	}
+
+	return err
+}
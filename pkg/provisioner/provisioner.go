@@ -24,15 +24,20 @@ type ProjectParams struct {
 }
 
 type IProvisioner interface {
-	CreateProject(params ProjectParams) error
+	CreateProject(params ProjectParams) (*provider.ProjectCreationTimings, error)
+	CreateProjectSnapshot(project *project.Project, target *provider.ProviderTarget, snapshotImage string) error
 	CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	DeleteProjectSnapshot(project *project.Project, target *provider.ProviderTarget, snapshotImage string) error
 	DestroyProject(project *project.Project, target *provider.ProviderTarget) error
 	DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
 	GetWorkspaceInfo(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error)
-	StartProject(params ProjectParams) error
+	HibernateProject(project *project.Project, target *provider.ProviderTarget) error
+	RestoreProjectSnapshot(project *project.Project, target *provider.ProviderTarget, snapshotImage string) error
+	StartProject(params ProjectParams) (*provider.ProjectCreationTimings, error)
 	StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
 	StopProject(project *project.Project, target *provider.ProviderTarget) error
 	StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	WakeProject(project *project.Project, target *provider.ProviderTarget) error
 }
 
 type ProvisionerConfig struct {
@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (p *Provisioner) CreateProjectSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotImage string) error {
+	snapshotProvider, err := p.getSnapshotProvider(target)
+	if err != nil {
+		return err
+	}
+
+	_, err = snapshotProvider.CreateProjectSnapshot(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotImage: snapshotImage,
+	})
+
+	return snapshotsNotSupportedError(err, target)
+}
+
+func (p *Provisioner) RestoreProjectSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotImage string) error {
+	snapshotProvider, err := p.getSnapshotProvider(target)
+	if err != nil {
+		return err
+	}
+
+	_, err = snapshotProvider.RestoreProjectSnapshot(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotImage: snapshotImage,
+	})
+
+	return snapshotsNotSupportedError(err, target)
+}
+
+func (p *Provisioner) DeleteProjectSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotImage string) error {
+	snapshotProvider, err := p.getSnapshotProvider(target)
+	if err != nil {
+		return err
+	}
+
+	_, err = snapshotProvider.DeleteProjectSnapshot(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotImage: snapshotImage,
+	})
+
+	return snapshotsNotSupportedError(err, target)
+}
+
+func (p *Provisioner) getSnapshotProvider(target *provider.ProviderTarget) (provider.SnapshotProvider, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotProvider, ok := (*targetProvider).(provider.SnapshotProvider)
+	if !ok {
+		return nil, snapshotsNotSupportedError(provider.ErrNotSupported, target)
+	}
+
+	return snapshotProvider, nil
+}
+
+func snapshotsNotSupportedError(err error, target *provider.ProviderTarget) error {
+	if errors.Is(err, provider.ErrNotSupported) {
+		return fmt.Errorf("snapshots are %w %s", err, target.ProviderInfo.Name)
+	}
+
+	return err
+}
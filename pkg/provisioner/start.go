@@ -22,20 +22,26 @@ func (p *Provisioner) StartWorkspace(workspace *workspace.Workspace, target *pro
 	return err
 }
 
-func (p *Provisioner) StartProject(params ProjectParams) error {
+func (p *Provisioner) StartProject(params ProjectParams) (*provider.ProjectCreationTimings, error) {
 	targetProvider, err := p.providerManager.GetProvider(params.Target.ProviderInfo.Name)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = (*targetProvider).StartProject(&provider.ProjectRequest{
+	projectReq := &provider.ProjectRequest{
 		TargetOptions:            params.Target.Options,
 		Project:                  params.Project,
 		ContainerRegistry:        params.ContainerRegistry,
 		GitProviderConfig:        params.GitProviderConfig,
 		BuilderImage:             params.BuilderImage,
 		BuilderContainerRegistry: params.BuilderImageContainerRegistry,
-	})
+	}
 
-	return err
+	timingsProvider, ok := (*targetProvider).(provider.ProjectTimingsProvider)
+	if !ok {
+		_, err = (*targetProvider).StartProject(projectReq)
+		return nil, err
+	}
+
+	return timingsProvider.StartProjectWithTimings(projectReq)
 }
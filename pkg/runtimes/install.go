@@ -0,0 +1,112 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimes
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const miseInstallScriptUrl = "https://mise.run"
+
+// Install installs the runtimes for the current user with mise and makes them the global defaults.
+// mise is installed first if it is not available and shells are configured to activate it
+func Install(runtimes []string, output io.Writer) error {
+	runtimes, err := ParseAll(runtimes)
+	if err != nil {
+		return err
+	}
+
+	if len(runtimes) == 0 {
+		return errors.New("no runtimes to install")
+	}
+
+	misePath, err := ensureMise(output)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(misePath, append([]string{"use", "--global", "--yes"}, runtimes...)...)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	err = cmd.Run()
+	if err != nil {
+		return fmt.Errorf("failed to install %s: %w", strings.Join(runtimes, ", "), err)
+	}
+
+	return activateInShells(misePath)
+}
+
+func ensureMise(output io.Writer) (string, error) {
+	misePath, err := exec.LookPath("mise")
+	if err == nil {
+		return misePath, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	misePath = filepath.Join(homeDir, ".local", "bin", "mise")
+	if _, err := os.Stat(misePath); err == nil {
+		return misePath, nil
+	}
+
+	fmt.Fprintln(output, "Installing mise...")
+
+	cmd := exec.Command("sh", "-c", fmt.Sprintf("curl -fsSL %s | sh", miseInstallScriptUrl))
+	cmd.Stdout = output
+	cmd.Stderr = output
+	err = cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("failed to install mise: %w", err)
+	}
+
+	return misePath, nil
+}
+
+// activateInShells adds the mise activation to the rc files of bash and zsh so that the installed
+// runtimes are on the PATH of new shells
+func activateInShells(misePath string) error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+
+	for shell, rcFile := range map[string]string{"bash": ".bashrc", "zsh": ".zshrc"} {
+		rcPath := filepath.Join(homeDir, rcFile)
+		activation := fmt.Sprintf("eval \"$(%s activate %s)\"", misePath, shell)
+
+		content, err := os.ReadFile(rcPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+
+		if strings.Contains(string(content), activation) {
+			continue
+		}
+
+		f, err := os.OpenFile(rcPath, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(f, "\n# Added by Daytona\n%s\n", activation)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimes
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	toolPattern    = regexp.MustCompile(`^[a-z0-9][a-z0-9._:/-]*$`)
+	versionPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._+-]*$`)
+)
+
+// Runtime is a language runtime or tool in the tool@version format, e.g. node@20 or go@1.22
+type Runtime struct {
+	Tool    string
+	Version string
+}
+
+func (r Runtime) String() string {
+	return fmt.Sprintf("%s@%s", r.Tool, r.Version)
+}
+
+// Parse parses a runtime in the tool@version format. The version defaults to latest
+func Parse(runtime string) (Runtime, error) {
+	tool, version, found := strings.Cut(runtime, "@")
+	if !found {
+		version = "latest"
+	}
+
+	if !toolPattern.MatchString(tool) {
+		return Runtime{}, fmt.Errorf("invalid runtime %s: the tool name is not valid", runtime)
+	}
+
+	if !versionPattern.MatchString(version) {
+		return Runtime{}, fmt.Errorf("invalid runtime %s: the version is not valid", runtime)
+	}
+
+	return Runtime{Tool: tool, Version: version}, nil
+}
+
+// ParseAll parses the runtimes and returns them in the tool@version format
+func ParseAll(runtimes []string) ([]string, error) {
+	result := []string{}
+	for _, r := range runtimes {
+		runtime, err := Parse(r)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, runtime.String())
+	}
+	return result, nil
+}
+
+// Merge adds the runtimes to the existing ones. Existing versions of the same tool are replaced
+func Merge(existing, added []string) []string {
+	result := []string{}
+	index := map[string]int{}
+
+	for _, r := range append(append([]string{}, existing...), added...) {
+		runtime, err := Parse(r)
+		if err != nil {
+			continue
+		}
+
+		if i, ok := index[runtime.Tool]; ok {
+			result[i] = runtime.String()
+			continue
+		}
+
+		index[runtime.Tool] = len(result)
+		result = append(result, runtime.String())
+	}
+
+	return result
+}
@@ -0,0 +1,31 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	runtime, err := Parse("node@20")
+	require.NoError(t, err)
+	require.Equal(t, Runtime{Tool: "node", Version: "20"}, runtime)
+
+	runtime, err = Parse("go")
+	require.NoError(t, err)
+	require.Equal(t, "go@latest", runtime.String())
+
+	_, err = Parse("node@20; rm -rf /")
+	require.Error(t, err)
+
+	_, err = Parse("@20")
+	require.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	merged := Merge([]string{"node@18", "python@3.12"}, []string{"go@1.22", "node@20"})
+	require.Equal(t, []string{"node@20", "python@3.12", "go@1.22"}, merged)
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import "time"
+
+type Secret struct {
+	Name string `json:"name" validate:"required"`
+	// Workspace the secret is scoped to. Secrets without a workspace are available to all workspaces of the profile
+	WorkspaceId string `json:"workspaceId" validate:"optional"`
+	// Project of the workspace the secret is scoped to. Secrets without a project are available to all projects of the workspace
+	ProjectName string `json:"projectName" validate:"optional"`
+	// Path the secret is written to inside the project container. Secrets without a path are injected as environment variables
+	FilePath string `json:"filePath" validate:"optional"`
+	// Octal permissions of the secret file, e.g. 0400
+	FileMode string `json:"fileMode" validate:"optional"`
+	// Value encrypted with the server secrets key, never returned by the API
+	EncryptedValue []byte    `json:"-"`
+	UpdatedAt      time.Time `json:"updatedAt" validate:"required"`
+} // @name Secret
+
+// IsFile returns true if the secret is injected as a file instead of an environment variable
+func (s *Secret) IsFile() bool {
+	return s.FilePath != ""
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import "errors"
+
+type Store interface {
+	List(filter *Filter) ([]*Secret, error)
+	Find(filter *Filter) (*Secret, error)
+	Save(secret *Secret) error
+	Delete(secret *Secret) error
+}
+
+type Filter struct {
+	Name        *string
+	WorkspaceId *string
+	ProjectName *string
+}
+
+var (
+	ErrSecretNotFound = errors.New("secret not found")
+)
+
+func IsSecretNotFound(err error) bool {
+	return err.Error() == ErrSecretNotFound.Error()
+}
@@ -0,0 +1,268 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package selfupdate
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+const DefaultReleaseUrl = "https://download.daytona.io/daytona"
+
+// Overrides the URL releases are downloaded from, e.g. to use a mirror
+const RELEASE_URL_ENV_VAR = "DAYTONA_RELEASE_URL"
+
+type Channel string
+
+const (
+	ChannelStable Channel = "stable"
+	ChannelBeta   Channel = "beta"
+)
+
+func ParseChannel(channel string) (Channel, error) {
+	switch Channel(channel) {
+	case ChannelStable, ChannelBeta:
+		return Channel(channel), nil
+	}
+
+	return "", fmt.Errorf("invalid release channel %s. Must be one of (%s, %s)", channel, ChannelStable, ChannelBeta)
+}
+
+// Updater downloads releases from the release URL. Every release directory contains the binaries, a checksums.txt
+// file in the sha256sum format and the base64 encoded ed25519 signature of the checksums in checksums.txt.sig.
+// The latest version of every channel is listed in channels/<CHANNEL>
+type Updater struct {
+	releaseUrl string
+	// Releases can only be installed if their checksums are signed with the key
+	publicKey  ed25519.PublicKey
+	httpClient *http.Client
+}
+
+// NewUpdater creates an updater for the release URL, which must use HTTPS. The public key is base64 encoded.
+// Without it the latest versions can be checked but no release can be installed
+func NewUpdater(releaseUrl, publicKey string) (*Updater, error) {
+	parsedUrl, err := url.Parse(releaseUrl)
+	if err != nil || parsedUrl.Scheme != "https" || parsedUrl.Host == "" {
+		return nil, fmt.Errorf("invalid release URL %s. Releases can only be downloaded over HTTPS", releaseUrl)
+	}
+
+	u := &Updater{
+		releaseUrl: strings.TrimSuffix(releaseUrl, "/"),
+		httpClient: &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if req.URL.Scheme != "https" {
+					return fmt.Errorf("refusing to follow the redirect to %s. Releases can only be downloaded over HTTPS", req.URL)
+				}
+				if len(via) >= 10 {
+					return errors.New("stopped after 10 redirects")
+				}
+				return nil
+			},
+		},
+	}
+
+	if publicKey != "" {
+		key, err := base64.StdEncoding.DecodeString(publicKey)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid release public key")
+		}
+		u.publicKey = key
+	}
+
+	return u, nil
+}
+
+// GetLatestVersion returns the latest version released on the channel
+func (u *Updater) GetLatestVersion(ctx context.Context, channel Channel) (string, error) {
+	content, err := u.get(ctx, fmt.Sprintf("channels/%s", channel))
+	if err != nil {
+		return "", fmt.Errorf("failed to get the latest version of the %s channel: %w", channel, err)
+	}
+
+	version := strings.TrimSpace(string(content))
+	if !semver.IsValid(version) {
+		return "", fmt.Errorf("invalid version %s on the %s channel", version, channel)
+	}
+
+	return version, nil
+}
+
+// IsNewer returns true if the version is newer than the current one
+func IsNewer(current, version string) bool {
+	return semver.Compare(version, current) > 0
+}
+
+// Update downloads the binary of the version for the current platform, verifies it and replaces the executable with it
+func (u *Updater) Update(ctx context.Context, version, executablePath string) error {
+	if !semver.IsValid(version) {
+		return fmt.Errorf("invalid version %s. Expected a version like v0.1.0", version)
+	}
+
+	if u.publicKey == nil {
+		return errors.New("this build has no release public key so releases can not be verified. Please install the new version manually")
+	}
+
+	binaryName := GetBinaryName(runtime.GOOS, runtime.GOARCH)
+
+	checksum, err := u.getChecksum(ctx, version, binaryName)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(executablePath), ".daytona-update-*")
+	if err != nil {
+		return fmt.Errorf("failed to create the update file next to %s: %w", executablePath, err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	err = u.download(ctx, fmt.Sprintf("%s/%s", version, binaryName), tmpFile, checksum)
+	tmpFile.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tmpFile.Name(), 0755)
+	if err != nil {
+		return err
+	}
+
+	return replaceExecutable(executablePath, tmpFile.Name())
+}
+
+// GetBinaryName returns the name of the release binary for the platform
+func GetBinaryName(goos, goarch string) string {
+	name := fmt.Sprintf("daytona-%s-%s", goos, goarch)
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+func (u *Updater) getChecksum(ctx context.Context, version, binaryName string) ([]byte, error) {
+	checksums, err := u.get(ctx, fmt.Sprintf("%s/checksums.txt", version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the checksums of %s: %w", version, err)
+	}
+
+	signature, err := u.get(ctx, fmt.Sprintf("%s/checksums.txt.sig", version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the signature of %s: %w", version, err)
+	}
+
+	decodedSignature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil || !ed25519.Verify(u.publicKey, checksums, decodedSignature) {
+		return nil, fmt.Errorf("the signature of the %s checksums is not valid", version)
+	}
+
+	return parseChecksum(checksums, binaryName)
+}
+
+// parseChecksum finds the checksum of the file in the sha256sum output
+func parseChecksum(checksums []byte, fileName string) ([]byte, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != fileName {
+			continue
+		}
+
+		checksum, err := hex.DecodeString(fields[0])
+		if err != nil || len(checksum) != sha256.Size {
+			return nil, fmt.Errorf("invalid checksum for %s", fileName)
+		}
+
+		return checksum, nil
+	}
+
+	return nil, fmt.Errorf("no checksum found for %s", fileName)
+}
+
+func (u *Updater) download(ctx context.Context, path string, w io.Writer, checksum []byte) error {
+	res, err := u.request(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(w, hash), res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", path, err)
+	}
+
+	if !bytes.Equal(hash.Sum(nil), checksum) {
+		return fmt.Errorf("the checksum of %s does not match", path)
+	}
+
+	return nil
+}
+
+func (u *Updater) get(ctx context.Context, path string) ([]byte, error) {
+	res, err := u.request(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	return io.ReadAll(res.Body)
+}
+
+func (u *Updater) request(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s", u.releaseUrl, path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("%s returned %s", req.URL, res.Status)
+	}
+
+	return res, nil
+}
+
+// replaceExecutable renames the new binary over the executable so that the executable is never partially written.
+// Windows does not allow replacing a running executable, so it is moved away first
+func replaceExecutable(executablePath, newPath string) error {
+	if runtime.GOOS == "windows" {
+		oldPath := executablePath + ".old"
+		_ = os.Remove(oldPath)
+
+		err := os.Rename(executablePath, oldPath)
+		if err != nil {
+			return err
+		}
+
+		err = os.Rename(newPath, executablePath)
+		if err != nil {
+			_ = os.Rename(oldPath, executablePath)
+			return err
+		}
+
+		return nil
+	}
+
+	return os.Rename(newPath, executablePath)
+}
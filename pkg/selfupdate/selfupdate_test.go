@@ -0,0 +1,178 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package selfupdate
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var binary = []byte("new daytona binary")
+
+func newReleaseServer(t *testing.T, privateKey ed25519.PrivateKey, checksum []byte) *httptest.Server {
+	checksums := []byte(fmt.Sprintf("%s  %s\n", hex.EncodeToString(checksum), GetBinaryName(runtime.GOOS, runtime.GOARCH)))
+
+	files := map[string][]byte{
+		"/channels/stable":          []byte("v0.2.0\n"),
+		"/channels/beta":            []byte("v0.3.0-beta.1\n"),
+		"/v0.2.0/checksums.txt":     checksums,
+		"/v0.2.0/checksums.txt.sig": []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, checksums))),
+		"/v0.2.0/" + GetBinaryName(runtime.GOOS, runtime.GOARCH): binary,
+		// Release without a signature
+		"/v0.2.1/checksums.txt":                                  checksums,
+		"/v0.2.1/" + GetBinaryName(runtime.GOOS, runtime.GOARCH): binary,
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newUpdater(t *testing.T, server *httptest.Server, publicKey ed25519.PublicKey) *Updater {
+	encodedKey := ""
+	if publicKey != nil {
+		encodedKey = base64.StdEncoding.EncodeToString(publicKey)
+	}
+
+	updater, err := NewUpdater(server.URL, encodedKey)
+	require.NoError(t, err)
+	updater.httpClient.Transport = server.Client().Transport
+
+	return updater
+}
+
+func TestGetLatestVersion(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	server := newReleaseServer(t, privateKey, nil)
+
+	updater := newUpdater(t, server, nil)
+
+	version, err := updater.GetLatestVersion(context.Background(), ChannelStable)
+	require.NoError(t, err)
+	require.Equal(t, "v0.2.0", version)
+
+	version, err = updater.GetLatestVersion(context.Background(), ChannelBeta)
+	require.NoError(t, err)
+	require.Equal(t, "v0.3.0-beta.1", version)
+
+	require.True(t, IsNewer("v0.1.0", "v0.2.0"))
+	require.True(t, IsNewer("v0.0.0-dev", "v0.2.0"))
+	require.False(t, IsNewer("v0.2.0", "v0.2.0"))
+	require.False(t, IsNewer("v0.2.0", "v0.2.0-beta.1"))
+}
+
+func TestUpdate(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	checksum := sha256.Sum256(binary)
+	server := newReleaseServer(t, privateKey, checksum[:])
+
+	executablePath := filepath.Join(t.TempDir(), "daytona")
+	require.NoError(t, os.WriteFile(executablePath, []byte("old daytona binary"), 0755))
+
+	updater := newUpdater(t, server, publicKey)
+
+	require.NoError(t, updater.Update(context.Background(), "v0.2.0", executablePath))
+
+	content, err := os.ReadFile(executablePath)
+	require.NoError(t, err)
+	require.Equal(t, binary, content)
+
+	require.Error(t, updater.Update(context.Background(), "v0.9.0", executablePath))
+}
+
+func TestUpdate_InvalidChecksum(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	checksum := sha256.Sum256([]byte("other binary"))
+	server := newReleaseServer(t, privateKey, checksum[:])
+
+	executablePath := filepath.Join(t.TempDir(), "daytona")
+	require.NoError(t, os.WriteFile(executablePath, []byte("old daytona binary"), 0755))
+
+	updater := newUpdater(t, server, publicKey)
+
+	require.ErrorContains(t, updater.Update(context.Background(), "v0.2.0", executablePath), "checksum")
+
+	content, err := os.ReadFile(executablePath)
+	require.NoError(t, err)
+	require.Equal(t, []byte("old daytona binary"), content)
+}
+
+func TestUpdate_InvalidSignature(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	checksum := sha256.Sum256(binary)
+	server := newReleaseServer(t, privateKey, checksum[:])
+
+	executablePath := filepath.Join(t.TempDir(), "daytona")
+	require.NoError(t, os.WriteFile(executablePath, []byte("old daytona binary"), 0755))
+
+	updater := newUpdater(t, server, otherPublicKey)
+
+	require.ErrorContains(t, updater.Update(context.Background(), "v0.2.0", executablePath), "signature")
+}
+
+func TestUpdate_MissingSignature(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	checksum := sha256.Sum256(binary)
+	server := newReleaseServer(t, privateKey, checksum[:])
+
+	executablePath := filepath.Join(t.TempDir(), "daytona")
+	require.NoError(t, os.WriteFile(executablePath, []byte("old daytona binary"), 0755))
+
+	updater := newUpdater(t, server, publicKey)
+
+	require.ErrorContains(t, updater.Update(context.Background(), "v0.2.1", executablePath), "signature")
+}
+
+func TestUpdate_NoPublicKey(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	checksum := sha256.Sum256(binary)
+	server := newReleaseServer(t, privateKey, checksum[:])
+
+	executablePath := filepath.Join(t.TempDir(), "daytona")
+	require.NoError(t, os.WriteFile(executablePath, []byte("old daytona binary"), 0755))
+
+	updater := newUpdater(t, server, nil)
+
+	require.ErrorContains(t, updater.Update(context.Background(), "v0.2.0", executablePath), "public key")
+
+	content, err := os.ReadFile(executablePath)
+	require.NoError(t, err)
+	require.Equal(t, []byte("old daytona binary"), content)
+}
+
+func TestNewUpdater_InsecureUrl(t *testing.T) {
+	for _, releaseUrl := range []string{"http://download.daytona.io/daytona", "download.daytona.io/daytona", "file:///tmp/daytona"} {
+		_, err := NewUpdater(releaseUrl, "")
+		require.Error(t, err, releaseUrl)
+	}
+}
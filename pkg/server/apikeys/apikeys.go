@@ -4,6 +4,9 @@
 package apikeys
 
 import (
+	"errors"
+	"time"
+
 	"github.com/daytonaio/daytona/internal/apikeys"
 	"github.com/daytonaio/daytona/pkg/apikey"
 )
@@ -35,14 +38,66 @@ func (s *ApiKeyService) Revoke(name string) error {
 }
 
 func (s *ApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (string, error) {
-	key := apikeys.GenerateRandomKey()
+	return s.generate(&apikey.ApiKey{
+		Type: keyType,
+		Name: name,
+	})
+}
 
-	apiKey := &apikey.ApiKey{
-		KeyHash: apikeys.HashKey(key),
-		Type:    keyType,
-		Name:    name,
+// GenerateReadOnly generates a client key that can't be used to create, modify or remove resources
+func (s *ApiKeyService) GenerateReadOnly(name string) (string, error) {
+	return s.generate(&apikey.ApiKey{
+		Type:     apikey.ApiKeyTypeClient,
+		Name:     name,
+		ReadOnly: true,
+	})
+}
+
+// GenerateAdmin generates a client key that can access the workspaces of all users
+func (s *ApiKeyService) GenerateAdmin(name string) (string, error) {
+	return s.generate(&apikey.ApiKey{
+		Type:  apikey.ApiKeyTypeClient,
+		Name:  name,
+		Admin: true,
+	})
+}
+
+// GrantAdmin makes an existing client key an admin key. Read-only keys and keys restricted to a workspace can't be admin keys
+func (s *ApiKeyService) GrantAdmin(apiKey string) error {
+	key, err := s.apiKeyStore.Find(apikeys.HashKey(apiKey))
+	if err != nil {
+		return err
+	}
+
+	if key.Type != apikey.ApiKeyTypeClient || key.ReadOnly || key.WorkspaceId != "" {
+		return errors.New("only unrestricted client keys can be admin keys")
+	}
+
+	if key.Admin {
+		return nil
 	}
 
+	key.Admin = true
+	return s.apiKeyStore.Save(key)
+}
+
+// GenerateWorkspaceAccess generates a read-only client key that can only be used to connect to the workspace
+// until it expires
+func (s *ApiKeyService) GenerateWorkspaceAccess(name, workspaceId string, expiresAt time.Time) (string, error) {
+	return s.generate(&apikey.ApiKey{
+		Type:        apikey.ApiKeyTypeClient,
+		Name:        name,
+		ReadOnly:    true,
+		WorkspaceId: workspaceId,
+		ExpiresAt:   &expiresAt,
+	})
+}
+
+// generate saves the API key with the hash of a new random key and returns the key
+func (s *ApiKeyService) generate(apiKey *apikey.ApiKey) (string, error) {
+	key := apikeys.GenerateRandomKey()
+	apiKey.KeyHash = apikeys.HashKey(key)
+
 	err := s.apiKeyStore.Save(apiKey)
 	if err != nil {
 		return "", err
@@ -50,3 +105,24 @@ func (s *ApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (string
 
 	return key, nil
 }
+
+// Import stores an existing key under the given name, replacing any key with the same name
+func (s *ApiKeyService) Import(keyType apikey.ApiKeyType, name string, key string) error {
+	existingKey, err := s.apiKeyStore.FindByName(name)
+	if err == nil {
+		if existingKey.KeyHash == apikeys.HashKey(key) && existingKey.Type == keyType {
+			return nil
+		}
+
+		err = s.apiKeyStore.Delete(existingKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.apiKeyStore.Save(&apikey.ApiKey{
+		KeyHash: apikeys.HashKey(key),
+		Type:    keyType,
+		Name:    name,
+	})
+}
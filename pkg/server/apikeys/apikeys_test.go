@@ -70,3 +70,20 @@ func (s *ApiKeyServiceTestSuite) TestGenerate() {
 	require.Nil(err)
 	require.ElementsMatch(expectedKeys, apiKeys)
 }
+
+func (s *ApiKeyServiceTestSuite) TestImport() {
+	require := s.Require()
+
+	err := s.apiKeyService.Import(apikey.ApiKeyTypeClient, clientKeyNames[0], "imported-key")
+	require.Nil(err)
+
+	require.True(s.apiKeyService.IsValidApiKey("imported-key"))
+
+	apiKey, err := s.apiKeyStore.FindByName(clientKeyNames[0])
+	require.Nil(err)
+	require.Equal(apikey.ApiKeyTypeClient, apiKey.Type)
+
+	keys, err := s.apiKeyService.ListClientKeys()
+	require.Nil(err)
+	require.Len(keys, len(clientKeyNames))
+}
@@ -3,17 +3,33 @@
 
 package apikeys
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
 
 type IApiKeyService interface {
 	Generate(keyType apikey.ApiKeyType, name string) (string, error)
+	GenerateReadOnly(name string) (string, error)
+	GenerateAdmin(name string) (string, error)
+	GenerateWorkspaceAccess(name, workspaceId string, expiresAt time.Time) (string, error)
+	GetApiKeyName(apiKey string) (string, error)
+	GrantAdmin(apiKey string) error
+	GetApiKeyWorkspaceId(apiKey string) string
+	Import(keyType apikey.ApiKeyType, name string, key string) error
+	IsAdminApiKey(apiKey string) bool
 	IsProjectApiKey(apiKey string) bool
+	IsReadOnlyApiKey(apiKey string) bool
 	IsWorkspaceApiKey(apiKey string) bool
 	IsValidApiKey(apiKey string) bool
 	ListClientKeys() ([]*apikey.ApiKey, error)
 	Revoke(name string) error
 }
 
+// Name of the admin key the server creates for the default profile of the user that runs it
+const DefaultProfileKeyName = "default"
+
 type ApiKeyServiceConfig struct {
 	ApiKeyStore apikey.Store
 }
@@ -4,6 +4,8 @@
 package apikeys
 
 import (
+	"strings"
+
 	"github.com/daytonaio/daytona/internal/apikeys"
 	"github.com/daytonaio/daytona/pkg/apikey"
 )
@@ -11,8 +13,18 @@ import (
 func (s *ApiKeyService) IsValidApiKey(apiKey string) bool {
 	keyHash := apikeys.HashKey(apiKey)
 
-	_, err := s.apiKeyStore.Find(keyHash)
-	return err == nil
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil {
+		return false
+	}
+
+	if key.IsExpired() {
+		// Expired keys are removed the first time they are used
+		_ = s.apiKeyStore.Delete(key)
+		return false
+	}
+
+	return true
 }
 
 func (s *ApiKeyService) IsProjectApiKey(apiKey string) bool {
@@ -44,3 +56,59 @@ func (s *ApiKeyService) IsWorkspaceApiKey(apiKey string) bool {
 
 	return true
 }
+
+func (s *ApiKeyService) IsReadOnlyApiKey(apiKey string) bool {
+	keyHash := apikeys.HashKey(apiKey)
+
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil {
+		return false
+	}
+
+	return key.ReadOnly
+}
+
+// IsAdminApiKey returns true if the key is a client key that can access the workspaces of all users and manage API keys
+func (s *ApiKeyService) IsAdminApiKey(apiKey string) bool {
+	keyHash := apikeys.HashKey(apiKey)
+
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil || key.Type != apikey.ApiKeyTypeClient {
+		return false
+	}
+
+	return key.Admin
+}
+
+// GetApiKeyName returns the name of a valid key. Project keys are named after the workspace and project they belong to
+func (s *ApiKeyService) GetApiKeyName(apiKey string) (string, error) {
+	keyHash := apikeys.HashKey(apiKey)
+
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil {
+		return "", err
+	}
+
+	return key.Name, nil
+}
+
+// GetApiKeyWorkspaceId returns the workspace the key is restricted to. Workspace keys are named after their workspace
+// and project keys after their workspace and project, so they are always restricted. Empty if the key is not restricted
+func (s *ApiKeyService) GetApiKeyWorkspaceId(apiKey string) string {
+	keyHash := apikeys.HashKey(apiKey)
+
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil {
+		return ""
+	}
+
+	switch key.Type {
+	case apikey.ApiKeyTypeWorkspace:
+		return key.Name
+	case apikey.ApiKeyTypeProject:
+		workspaceId, _, _ := strings.Cut(key.Name, "/")
+		return workspaceId
+	}
+
+	return key.WorkspaceId
+}
@@ -3,7 +3,12 @@
 
 package apikeys_test
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+)
 
 func (s *ApiKeyServiceTestSuite) TestIsValidKey_True() {
 	keyName := "api-key"
@@ -26,6 +31,35 @@ func (s *ApiKeyServiceTestSuite) TestIsValidKey_False() {
 	require.False(res)
 }
 
+func (s *ApiKeyServiceTestSuite) TestIsValidKey_Expired() {
+	require := s.Require()
+
+	apiKey, err := s.apiKeyService.GenerateWorkspaceAccess("expired", "ws1", time.Now().Add(-time.Minute))
+	require.Nil(err)
+
+	res := s.apiKeyService.IsValidApiKey(apiKey)
+	require.False(res)
+
+	_, err = s.apiKeyService.GetApiKeyName(apiKey)
+	require.True(apikey.IsApiKeyNotFound(err))
+}
+
+func (s *ApiKeyServiceTestSuite) TestGetApiKeyWorkspaceId() {
+	require := s.Require()
+
+	apiKey, err := s.apiKeyService.GenerateWorkspaceAccess("invite", "ws1", time.Now().Add(time.Hour))
+	require.Nil(err)
+
+	require.True(s.apiKeyService.IsValidApiKey(apiKey))
+	require.True(s.apiKeyService.IsReadOnlyApiKey(apiKey))
+	require.Equal("ws1", s.apiKeyService.GetApiKeyWorkspaceId(apiKey))
+
+	apiKey, err = s.apiKeyService.Generate(apikey.ApiKeyTypeClient, "unrestricted")
+	require.Nil(err)
+
+	require.Equal("", s.apiKeyService.GetApiKeyWorkspaceId(apiKey))
+}
+
 func (s *ApiKeyServiceTestSuite) TestIsProjectApiKey_True() {
 	keyName := "projectKey"
 
@@ -73,3 +107,73 @@ func (s *ApiKeyServiceTestSuite) TestIsWorkspaceApiKey_False() {
 	res := s.apiKeyService.IsWorkspaceApiKey(apiKey)
 	require.False(res)
 }
+
+func (s *ApiKeyServiceTestSuite) TestIsReadOnlyApiKey() {
+	require := s.Require()
+
+	readOnlyKey, err := s.apiKeyService.GenerateReadOnly("readOnlyKey")
+	require.Nil(err)
+
+	clientKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeClient, "clientKey")
+	require.Nil(err)
+
+	require.True(s.apiKeyService.IsReadOnlyApiKey(readOnlyKey))
+	require.True(s.apiKeyService.IsValidApiKey(readOnlyKey))
+	require.False(s.apiKeyService.IsProjectApiKey(readOnlyKey))
+	require.False(s.apiKeyService.IsReadOnlyApiKey(clientKey))
+}
+
+func (s *ApiKeyServiceTestSuite) TestGetApiKeyName() {
+	keyName := "workspaceId/projectName"
+
+	require := s.Require()
+
+	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeProject, keyName)
+	require.Nil(err)
+
+	name, err := s.apiKeyService.GetApiKeyName(apiKey)
+	require.Nil(err)
+	require.Equal(keyName, name)
+
+	_, err = s.apiKeyService.GetApiKeyName("unknown")
+	require.True(apikey.IsApiKeyNotFound(err))
+}
+
+func (s *ApiKeyServiceTestSuite) TestIsAdminApiKey() {
+	require := s.Require()
+
+	adminKey, err := s.apiKeyService.GenerateAdmin("admin")
+	require.Nil(err)
+
+	// Admin access doesn't depend on the name of the key
+	defaultKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeClient, apikeys.DefaultProfileKeyName)
+	require.Nil(err)
+
+	require.True(s.apiKeyService.IsAdminApiKey(adminKey))
+	require.False(s.apiKeyService.IsAdminApiKey(defaultKey))
+}
+
+func (s *ApiKeyServiceTestSuite) TestGrantAdmin() {
+	require := s.Require()
+
+	clientKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeClient, "clientKey")
+	require.Nil(err)
+
+	err = s.apiKeyService.GrantAdmin(clientKey)
+	require.Nil(err)
+	require.True(s.apiKeyService.IsAdminApiKey(clientKey))
+
+	readOnlyKey, err := s.apiKeyService.GenerateReadOnly("readOnlyKey")
+	require.Nil(err)
+
+	err = s.apiKeyService.GrantAdmin(readOnlyKey)
+	require.NotNil(err)
+	require.False(s.apiKeyService.IsAdminApiKey(readOnlyKey))
+
+	projectKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeProject, "workspaceId/projectName")
+	require.Nil(err)
+
+	err = s.apiKeyService.GrantAdmin(projectKey)
+	require.NotNil(err)
+	require.False(s.apiKeyService.IsAdminApiKey(projectKey))
+}
@@ -0,0 +1,322 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Version of the archive format. Archives with a newer version can't be restored
+const ARCHIVE_VERSION = 1
+
+const (
+	manifestFileName   = "manifest.json"
+	configFileName     = "config.json"
+	dbFileName         = "db"
+	secretsKeyFileName = "secrets.key"
+)
+
+// Config keys that depend on the host and are kept from the current config when a backup is restored
+var hostSpecificConfigKeys = []string{"providersDir", "binariesPath", "logFile"}
+
+type Manifest struct {
+	Version       int       `json:"version"`
+	ServerVersion string    `json:"serverVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type archiveFile struct {
+	name    string
+	content []byte
+}
+
+type Paths struct {
+	DbPath     string
+	ConfigPath string
+	// Key the secrets in the database are encrypted with
+	SecretsKeyPath string
+}
+
+// Create writes a gzipped tar archive of the server database, config and secrets key to w. The database holds
+// the workspace metadata, project configs, targets, git providers, API keys and secrets. Workspace logs,
+// providers and builder caches are not included
+func Create(w io.Writer, paths Paths, serverVersion string) error {
+	_, err := os.Stat(paths.DbPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.New("server database not found")
+		}
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "daytona-backup-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// The database is copied with VACUUM INTO to get a consistent snapshot while the server is running
+	snapshotPath := filepath.Join(tmpDir, dbFileName)
+	err = snapshotDb(paths.DbPath, snapshotPath)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot the server database: %w", err)
+	}
+
+	manifest, err := json.MarshalIndent(Manifest{
+		Version:       ARCHIVE_VERSION,
+		ServerVersion: serverVersion,
+		CreatedAt:     time.Now(),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	config, err := os.ReadFile(paths.ConfigPath)
+	if err != nil {
+		return err
+	}
+
+	db, err := os.ReadFile(snapshotPath)
+	if err != nil {
+		return err
+	}
+
+	files := []archiveFile{
+		{manifestFileName, manifest},
+		{configFileName, config},
+		{dbFileName, db},
+	}
+
+	// Servers that never stored a secret don't have a secrets key yet
+	secretsKey, err := os.ReadFile(paths.SecretsKeyPath)
+	if err == nil {
+		files = append(files, archiveFile{secretsKeyFileName, secretsKey})
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for _, file := range files {
+		err = tarWriter.WriteHeader(&tar.Header{
+			Name:    file.name,
+			Mode:    0600,
+			Size:    int64(len(file.content)),
+			ModTime: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tarWriter.Write(file.content)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return gzipWriter.Close()
+}
+
+// Restore replaces the server database, config and secrets key with the ones from the archive. The current
+// database and secrets key are kept with the .bak suffix. Host specific settings of the current config, like the providers directory
+// and the log file, are kept so that a backup can be restored on a different host
+func Restore(r io.Reader, paths Paths) (*Manifest, error) {
+	files, err := readArchive(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the backup archive: %w", err)
+	}
+
+	for _, name := range []string{manifestFileName, configFileName, dbFileName} {
+		if _, ok := files[name]; !ok {
+			return nil, fmt.Errorf("invalid backup archive: %s is missing", name)
+		}
+	}
+
+	var manifest Manifest
+	err = json.Unmarshal(files[manifestFileName], &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup archive: %w", err)
+	}
+
+	if manifest.Version > ARCHIVE_VERSION {
+		return nil, fmt.Errorf("backup archive version %d is not supported. Update Daytona to restore it", manifest.Version)
+	}
+
+	config, err := mergeConfig(files[configFileName], paths.ConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = os.Stat(paths.DbPath)
+	if err == nil {
+		err = os.Rename(paths.DbPath, paths.DbPath+".bak")
+		if err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	// Journal files of the replaced database must not be applied to the restored one
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		err = os.Remove(paths.DbPath + suffix)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	err = os.MkdirAll(filepath.Dir(paths.DbPath), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(paths.DbPath, files[dbFileName], 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(paths.ConfigPath), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(paths.ConfigPath, config, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	// Secrets in the restored database can only be decrypted with the key of the backed up server
+	if secretsKey, ok := files[secretsKeyFileName]; ok {
+		err = restoreSecretsKey(secretsKey, paths.SecretsKeyPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &manifest, nil
+}
+
+func restoreSecretsKey(secretsKey []byte, keyPath string) error {
+	current, err := os.ReadFile(keyPath)
+	if err == nil {
+		if bytes.Equal(current, secretsKey) {
+			return nil
+		}
+
+		err = os.Rename(keyPath, keyPath+".bak")
+		if err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(keyPath), 0700)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(keyPath, secretsKey, 0600)
+}
+
+func snapshotDb(dbPath, snapshotPath string) error {
+	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{
+		Logger: logger.Discard,
+	})
+	if err != nil {
+		return err
+	}
+
+	sqlDb, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDb.Close()
+
+	return db.Exec("VACUUM INTO ?", snapshotPath).Error
+}
+
+func readArchive(r io.Reader) (map[string][]byte, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	files := map[string][]byte{}
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, err
+		}
+
+		files[filepath.Base(header.Name)] = content
+	}
+
+	return files, nil
+}
+
+// mergeConfig returns the restored config with the host specific settings of the current config, if there is one
+func mergeConfig(restored []byte, currentConfigPath string) ([]byte, error) {
+	var restoredConfig map[string]json.RawMessage
+	err := json.Unmarshal(restored, &restoredConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server config in backup archive: %w", err)
+	}
+
+	current, err := os.ReadFile(currentConfigPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return restored, nil
+		}
+		return nil, err
+	}
+
+	var currentConfig map[string]json.RawMessage
+	err = json.Unmarshal(current, &currentConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range hostSpecificConfigKeys {
+		if value, ok := currentConfig[key]; ok {
+			restoredConfig[key] = value
+		}
+	}
+
+	return json.MarshalIndent(restoredConfig, "", "  ")
+}
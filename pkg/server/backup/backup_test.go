@@ -0,0 +1,163 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	db_store "github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type testRecord struct {
+	Id   string `gorm:"primaryKey"`
+	Name string
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	sourceDir := t.TempDir()
+	sourcePaths := Paths{
+		DbPath:     filepath.Join(sourceDir, "db"),
+		ConfigPath: filepath.Join(sourceDir, "server", "config.json"),
+	}
+
+	db, err := gorm.Open(sqlite.Open(sourcePaths.DbPath), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&testRecord{}))
+	require.NoError(t, db.Create(&testRecord{Id: "1", Name: "workspace"}).Error)
+	sqlDb, err := db.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDb.Close())
+
+	writeConfig(t, sourcePaths.ConfigPath, map[string]any{"id": "server-id", "providersDir": "/source/providers"})
+
+	var archive bytes.Buffer
+	require.NoError(t, Create(&archive, sourcePaths, "v0.0.1"))
+
+	targetDir := t.TempDir()
+	targetPaths := Paths{
+		DbPath:     filepath.Join(targetDir, "db"),
+		ConfigPath: filepath.Join(targetDir, "server", "config.json"),
+	}
+	writeConfig(t, targetPaths.ConfigPath, map[string]any{"id": "other-id", "providersDir": "/target/providers"})
+	require.NoError(t, os.WriteFile(targetPaths.DbPath, []byte("old"), 0644))
+
+	manifest, err := Restore(&archive, targetPaths)
+	require.NoError(t, err)
+	require.Equal(t, ARCHIVE_VERSION, manifest.Version)
+	require.Equal(t, "v0.0.1", manifest.ServerVersion)
+
+	restoredDb, err := gorm.Open(sqlite.Open(targetPaths.DbPath), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	var record testRecord
+	require.NoError(t, restoredDb.First(&record, "id = ?", "1").Error)
+	require.Equal(t, "workspace", record.Name)
+	sqlDb, err = restoredDb.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDb.Close())
+
+	oldDb, err := os.ReadFile(targetPaths.DbPath + ".bak")
+	require.NoError(t, err)
+	require.Equal(t, "old", string(oldDb))
+
+	var config map[string]any
+	content, err := os.ReadFile(targetPaths.ConfigPath)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(content, &config))
+	require.Equal(t, "server-id", config["id"])
+	require.Equal(t, "/target/providers", config["providersDir"])
+}
+
+func TestBackupAndRestoreSecrets(t *testing.T) {
+	sourceDir := t.TempDir()
+	sourcePaths := Paths{
+		DbPath:         filepath.Join(sourceDir, "db"),
+		ConfigPath:     filepath.Join(sourceDir, "server", "config.json"),
+		SecretsKeyPath: filepath.Join(sourceDir, "server", "secrets.key"),
+	}
+	writeConfig(t, sourcePaths.ConfigPath, map[string]any{"id": "server-id"})
+
+	sourceKey, err := secrets.GetEncryptionKey(sourcePaths.SecretsKeyPath)
+	require.NoError(t, err)
+
+	sourceDb, sourceSecrets := newSecretService(t, sourcePaths.DbPath, sourceKey)
+	_, err = sourceSecrets.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", WorkspaceId: "workspace"})
+	require.NoError(t, err)
+	closeDb(t, sourceDb)
+
+	var archive bytes.Buffer
+	require.NoError(t, Create(&archive, sourcePaths, "v0.0.1"))
+
+	targetDir := t.TempDir()
+	targetPaths := Paths{
+		DbPath:         filepath.Join(targetDir, "db"),
+		ConfigPath:     filepath.Join(targetDir, "server", "config.json"),
+		SecretsKeyPath: filepath.Join(targetDir, "server", "secrets.key"),
+	}
+	targetKey, err := secrets.GetEncryptionKey(targetPaths.SecretsKeyPath)
+	require.NoError(t, err)
+
+	_, err = Restore(&archive, targetPaths)
+	require.NoError(t, err)
+
+	info, err := os.Stat(targetPaths.SecretsKeyPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	oldKey, err := os.ReadFile(targetPaths.SecretsKeyPath + ".bak")
+	require.NoError(t, err)
+	require.Equal(t, targetKey, oldKey)
+
+	restoredKey, err := secrets.GetEncryptionKey(targetPaths.SecretsKeyPath)
+	require.NoError(t, err)
+	require.Equal(t, sourceKey, restoredKey)
+
+	restoredDb, restoredSecrets := newSecretService(t, targetPaths.DbPath, restoredKey)
+	defer closeDb(t, restoredDb)
+
+	projectSecrets, err := restoredSecrets.GetProjectSecrets("workspace", "project")
+	require.NoError(t, err)
+	require.Len(t, projectSecrets, 1)
+	require.Equal(t, "TOKEN", projectSecrets[0].Name)
+	require.Equal(t, "value", projectSecrets[0].Value)
+}
+
+func TestRestoreInvalidArchive(t *testing.T) {
+	_, err := Restore(bytes.NewBufferString("not an archive"), Paths{})
+	require.Error(t, err)
+}
+
+func newSecretService(t *testing.T, dbPath string, key []byte) (*gorm.DB, secrets.ISecretService) {
+	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+
+	secretStore, err := db_store.NewSecretStore(db)
+	require.NoError(t, err)
+
+	return db, secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore:   secretStore,
+		EncryptionKey: key,
+	})
+}
+
+func closeDb(t *testing.T, db *gorm.DB) {
+	sqlDb, err := db.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDb.Close())
+}
+
+func writeConfig(t *testing.T, path string, config map[string]any) {
+	content, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, content, 0600))
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/profiledata"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Bootstrap is a declarative description of a Daytona Server used to set up identical servers
+// without the interactive setup. Applying a bootstrap file is idempotent: existing entries
+// with the same name are overwritten and entries not mentioned in the file are left untouched.
+type Bootstrap struct {
+	// Overrides for the server config, using the same keys as the server config file
+	Server              json.RawMessage                        `json:"server,omitempty"`
+	ApiKeys             []BootstrapApiKey                      `json:"apiKeys,omitempty"`
+	ContainerRegistries []*containerregistry.ContainerRegistry `json:"containerRegistries,omitempty"`
+	GitProviders        []*gitprovider.GitProviderConfig       `json:"gitProviders,omitempty"`
+	Targets             []*provider.ProviderTarget             `json:"targets,omitempty"`
+	ProjectConfigs      []*config.ProjectConfig                `json:"projectConfigs,omitempty"`
+	// Environment variables added to the profile data of the server
+	EnvVars map[string]string `json:"envVars,omitempty"`
+}
+
+// Client API key with a value known in advance, e.g. one generated by the provisioning tool
+type BootstrapApiKey struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+	// Admin keys can access the workspaces of all users and manage API keys
+	Admin bool `json:"admin,omitempty"`
+}
+
+// LoadBootstrap reads a bootstrap file. Environment variable references ($VAR or ${VAR})
+// are expanded so secrets don't need to be written to the file.
+func LoadBootstrap(path string) (*Bootstrap, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(os.ExpandEnv(string(content)))))
+	decoder.DisallowUnknownFields()
+
+	var bootstrap Bootstrap
+	err = decoder.Decode(&bootstrap)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bootstrap file %s: %w", path, err)
+	}
+
+	for _, key := range bootstrap.ApiKeys {
+		if key.Name == "" || key.Key == "" {
+			return nil, fmt.Errorf("invalid bootstrap file %s: api keys require a name and a key", path)
+		}
+	}
+
+	return &bootstrap, nil
+}
+
+// ApplyConfig applies the server config overrides of the bootstrap and saves the resulting config
+func (b *Bootstrap) ApplyConfig(c *Config) error {
+	if len(b.Server) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b.Server))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(c)
+	if err != nil {
+		return fmt.Errorf("invalid server config in bootstrap file: %w", err)
+	}
+
+	return Save(*c)
+}
+
+// Bootstrap stores the entries of the bootstrap using the server services
+func (s *Server) Bootstrap(b *Bootstrap) error {
+	var errs []error
+
+	for _, key := range b.ApiKeys {
+		err := s.ApiKeyService.Import(apikey.ApiKeyTypeClient, key.Name, key.Key)
+		if err == nil && key.Admin {
+			err = s.ApiKeyService.GrantAdmin(key.Key)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("api key %s: %w", key.Name, err))
+		}
+	}
+
+	for _, cr := range b.ContainerRegistries {
+		err := s.ContainerRegistryService.Save(cr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("container registry %s: %w", cr.Server, err))
+		}
+	}
+
+	for _, gp := range b.GitProviders {
+		existing, err := s.GitProviderService.GetConfig(gp.Id)
+		if err == nil && reflect.DeepEqual(existing, gp) {
+			continue
+		}
+
+		err = s.GitProviderService.SetGitProviderConfig(gp)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("git provider %s: %w", gp.Id, err))
+		}
+	}
+
+	for _, target := range b.Targets {
+		err := s.ProviderTargetService.Save(target)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("target %s: %w", target.Name, err))
+		}
+	}
+
+	for _, projectConfig := range b.ProjectConfigs {
+		err := s.ProjectConfigService.Save(projectConfig)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("project config %s: %w", projectConfig.Name, err))
+			continue
+		}
+
+		if projectConfig.IsDefault {
+			err = s.ProjectConfigService.SetDefault(projectConfig.Name)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("project config %s: %w", projectConfig.Name, err))
+			}
+		}
+	}
+
+	if len(b.EnvVars) > 0 {
+		err := s.bootstrapEnvVars(b.EnvVars)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("env vars: %w", err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to apply bootstrap file: %w", errors.Join(errs...))
+	}
+
+	log.Info("Bootstrap file applied")
+
+	return nil
+}
+
+func (s *Server) bootstrapEnvVars(envVars map[string]string) error {
+	profileData, err := s.ProfileDataService.Get()
+	if err != nil {
+		if !profiledata.IsProfileDataNotFound(err) {
+			return err
+		}
+		profileData = &profiledata.ProfileData{}
+	}
+
+	if profileData.EnvVars == nil {
+		profileData.EnvVars = map[string]string{}
+	}
+
+	for key, value := range envVars {
+		profileData.EnvVars[key] = value
+	}
+
+	return s.ProfileDataService.Save(profileData)
+}
@@ -15,4 +15,6 @@ type BuildCreationData struct {
 	Repository  *gitprovider.GitRepository `json:"repository" validate:"optional"`
 	EnvVars     map[string]string          `json:"envVars" validate:"required"`
 	PrebuildId  string                     `json:"prebuildId" validate:"required"`
+	// Set by the server, usually to the name of the API key that requested the build
+	CacheNamespace string `json:"-"`
 } // @name BuildCreationData
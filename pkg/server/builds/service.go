@@ -5,6 +5,7 @@ package builds
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"time"
 
@@ -20,11 +21,14 @@ type IBuildService interface {
 	Find(filter *build.Filter) (*build.Build, error)
 	List(filter *build.Filter) ([]*build.Build, error)
 	MarkForDeletion(filter *build.Filter, force bool) []error
+	Cancel(id string) error
 	Delete(id string) error
 	AwaitEmptyList(time.Duration) error
 	GetBuildLogReader(buildId string) (io.Reader, error)
 }
 
+var ErrBuildNotCancelable = errors.New("only pending and running builds can be canceled")
+
 type BuildServiceConfig struct {
 	BuildStore    build.Store
 	LoggerFactory logs.LoggerFactory
@@ -58,6 +62,7 @@ func (s *BuildService) Create(b dto.BuildCreationData) (string, error) {
 	newBuild.Repository = b.Repository
 	newBuild.EnvVars = b.EnvVars
 	newBuild.PrebuildId = b.PrebuildId
+	newBuild.CacheNamespace = b.CacheNamespace
 
 	err := s.buildStore.Save(&newBuild)
 	if err != nil {
@@ -99,6 +104,28 @@ func (s *BuildService) MarkForDeletion(filter *build.Filter, force bool) []error
 	return errors
 }
 
+// Cancel cancels a build that has not finished yet. Pending builds are canceled right away,
+// running builds are stopped by the build runner
+func (s *BuildService) Cancel(id string) error {
+	b, err := s.buildStore.Find(&build.Filter{Id: &id})
+	if err != nil {
+		return err
+	}
+
+	switch b.State {
+	case build.BuildStatePendingRun:
+		b.State = build.BuildStateCanceled
+	case build.BuildStateRunning:
+		b.State = build.BuildStatePendingCancel
+	case build.BuildStatePendingCancel, build.BuildStateCanceled:
+		return nil
+	default:
+		return fmt.Errorf("%w: build %s is %s", ErrBuildNotCancelable, id, b.State)
+	}
+
+	return s.buildStore.Save(b)
+}
+
 func (s *BuildService) Delete(id string) error {
 	return s.buildStore.Delete(id)
 }
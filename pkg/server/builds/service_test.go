@@ -190,3 +190,40 @@ func (s *BuildServiceTestSuite) TestDelete() {
 	require.Nil(err)
 	require.ElementsMatch(expectedBuilds, builds)
 }
+
+func (s *BuildServiceTestSuite) TestCancel() {
+	require := s.Require()
+
+	pendingBuild := &build.Build{Id: "pending", State: build.BuildStatePendingRun}
+	runningBuild := &build.Build{Id: "running", State: build.BuildStateRunning}
+	publishedBuild := &build.Build{Id: "published", State: build.BuildStatePublished}
+
+	for _, b := range []*build.Build{pendingBuild, runningBuild, publishedBuild} {
+		require.Nil(s.buildStore.Save(b))
+	}
+
+	require.Nil(s.buildService.Cancel(pendingBuild.Id))
+	require.Nil(s.buildService.Cancel(runningBuild.Id))
+	require.NotNil(s.buildService.Cancel(publishedBuild.Id))
+
+	b, err := s.buildService.Find(&build.Filter{Id: &pendingBuild.Id})
+	require.Nil(err)
+	require.Equal(build.BuildStateCanceled, b.State)
+
+	b, err = s.buildService.Find(&build.Filter{Id: &runningBuild.Id})
+	require.Nil(err)
+	require.Equal(build.BuildStatePendingCancel, b.State)
+}
+
+func (s *BuildServiceTestSuite) TestFindCacheNamespaces() {
+	require := s.Require()
+
+	require.Nil(s.buildStore.Save(&build.Build{Id: "alice", State: build.BuildStatePublished, CacheNamespace: "alice"}))
+
+	b, err := s.buildService.Find(&build.Filter{CacheNamespaces: &[]string{"alice"}})
+	require.Nil(err)
+	require.Equal("alice", b.Id)
+
+	_, err = s.buildService.Find(&build.Filter{CacheNamespaces: &[]string{"bob"}})
+	require.True(build.IsBuildNotFound(err))
+}
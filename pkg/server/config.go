@@ -14,7 +14,7 @@ import (
 )
 
 func GetConfig() (*Config, error) {
-	configFilePath, err := configFilePath()
+	configFilePath, err := GetConfigFilePath()
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +65,7 @@ func GetConfig() (*Config, error) {
 	return &c, nil
 }
 
-func configFilePath() (string, error) {
+func GetConfigFilePath() (string, error) {
 	configDir, err := GetConfigDir()
 	if err != nil {
 		return "", err
@@ -82,7 +82,7 @@ func Save(c Config) error {
 		return err
 	}
 
-	configFilePath, err := configFilePath()
+	configFilePath, err := GetConfigFilePath()
 	if err != nil {
 		return err
 	}
@@ -46,7 +46,7 @@ func (s *Server) GetBinaryPath(binaryName, binaryVersion string) (string, error)
 		return binaryPath, nil
 	}
 
-	downloadUrl, err := url.JoinPath(s.config.RegistryUrl, binaryVersion, binaryName)
+	downloadUrl, err := url.JoinPath(s.getConfig().RegistryUrl, binaryVersion, binaryName)
 	if err != nil {
 		return "", err
 	}
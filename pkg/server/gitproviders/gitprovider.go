@@ -4,7 +4,9 @@
 package gitproviders
 
 import (
+	"bytes"
 	"errors"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -66,28 +68,59 @@ func (s *GitProviderService) GetGitProviderForUrl(repoUrl string) (gitprovider.G
 }
 
 func (s *GitProviderService) GetGitProviderForHttpRequest(req *http.Request) (gitprovider.GitProvider, error) {
-	var provider *gitprovider.GitProviderConfig
-
 	gitProviders, err := s.configStore.List()
 	if err != nil {
 		return nil, err
 	}
 
+	candidates := []gitprovider.GitProvider{}
+
 	for _, p := range gitProviders {
 		header := req.Header.Get(config.GetWebhookEventHeaderKeyFromGitProvider(p.ProviderId))
 		if header == "" {
 			continue
-		} else {
-			provider = p
-			break
 		}
+
+		provider, err := s.newGitProvider(p)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, provider)
 	}
 
-	if provider == nil {
+	if len(candidates) == 0 {
 		return nil, errors.New("git provider for HTTP request not found")
 	}
 
-	return s.newGitProvider(provider)
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+
+	// Self-hosted instances of the same provider send the same headers so the
+	// provider is chosen by the repository that the event was sent for
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}()
+
+	for _, provider := range candidates {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		eventData, err := provider.ParseEventData(req)
+		if err != nil {
+			continue
+		}
+
+		canHandle, _ := provider.CanHandle(eventData.Url)
+		if canHandle {
+			return provider, nil
+		}
+	}
+
+	return candidates[0], nil
 }
 
 func getHostnameFromUrl(urlToParse string) (string, error) {
@@ -174,7 +174,7 @@ func (s *GitProviderService) newGitProvider(config *gitprovider.GitProviderConfi
 		return gitprovider.NewGitLabGitProvider(config.Token, config.BaseApiUrl), nil
 	case "codeberg":
 		return gitprovider.NewGiteaGitProvider(config.Token, codebergUrl), nil
-	case "gitea":
+	case "gitea", "forgejo":
 		return gitprovider.NewGiteaGitProvider(config.Token, baseApiUrl), nil
 	case "gitness":
 		return gitprovider.NewGitnessGitProvider(config.Token, baseApiUrl), nil
@@ -4,7 +4,9 @@
 package headscale
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"path/filepath"
 
@@ -59,3 +61,7 @@ func (s *HeadscaleServer) Connect() error {
 func (s *HeadscaleServer) HTTPClient() *http.Client {
 	return tsNetServer.HTTPClient()
 }
+
+func (s *HeadscaleServer) Dial(ctx context.Context, network, address string) (net.Conn, error) {
+	return tsNetServer.Dial(ctx, network, address)
+}
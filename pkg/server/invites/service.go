@@ -0,0 +1,108 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package invites
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/apikeys"
+	"github.com/daytonaio/daytona/pkg/invite"
+	server_apikeys "github.com/daytonaio/daytona/pkg/server/apikeys"
+)
+
+const (
+	MinTtl = time.Minute
+	MaxTtl = 24 * time.Hour
+)
+
+var ErrInvalidInvite = errors.New("the invite code is invalid, expired or was already used")
+
+type IInviteService interface {
+	Create(workspaceId string, ttl time.Duration) (string, *invite.Invite, error)
+	Redeem(code string) (*InviteAccess, error)
+}
+
+// InviteAccess is the temporary workspace access granted by a redeemed invite
+type InviteAccess struct {
+	ApiKey      string
+	WorkspaceId string
+	ExpiresAt   time.Time
+}
+
+type InviteServiceConfig struct {
+	InviteStore   invite.Store
+	ApiKeyService server_apikeys.IApiKeyService
+}
+
+func NewInviteService(config InviteServiceConfig) IInviteService {
+	return &InviteService{
+		inviteStore:   config.InviteStore,
+		apiKeyService: config.ApiKeyService,
+	}
+}
+
+type InviteService struct {
+	inviteStore   invite.Store
+	apiKeyService server_apikeys.IApiKeyService
+}
+
+// Create returns a one-time code that grants access to the workspace until the invite expires
+func (s *InviteService) Create(workspaceId string, ttl time.Duration) (string, *invite.Invite, error) {
+	if ttl < MinTtl || ttl > MaxTtl {
+		return "", nil, fmt.Errorf("invite TTL must be between %s and %s", MinTtl, MaxTtl)
+	}
+
+	code := apikeys.GenerateRandomKey()
+
+	inv := &invite.Invite{
+		CodeHash:    apikeys.HashKey(code),
+		WorkspaceId: workspaceId,
+		ExpiresAt:   time.Now().Add(ttl),
+	}
+
+	err := s.inviteStore.Save(inv)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return code, inv, nil
+}
+
+// Redeem consumes the invite and generates a read-only API key for the workspace that expires with the invite
+func (s *InviteService) Redeem(code string) (*InviteAccess, error) {
+	inv, err := s.inviteStore.Find(apikeys.HashKey(code))
+	if err != nil {
+		if invite.IsInviteNotFound(err) {
+			return nil, ErrInvalidInvite
+		}
+		return nil, err
+	}
+
+	// The invite is removed before the key is generated so that it can't be redeemed twice
+	err = s.inviteStore.Delete(inv)
+	if err != nil {
+		if invite.IsInviteNotFound(err) {
+			return nil, ErrInvalidInvite
+		}
+		return nil, err
+	}
+
+	if inv.IsExpired() {
+		return nil, ErrInvalidInvite
+	}
+
+	keyName := fmt.Sprintf("invite-%s-%s", inv.WorkspaceId, inv.CodeHash[:8])
+	apiKey, err := s.apiKeyService.GenerateWorkspaceAccess(keyName, inv.WorkspaceId, inv.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InviteAccess{
+		ApiKey:      apiKey,
+		WorkspaceId: inv.WorkspaceId,
+		ExpiresAt:   inv.ExpiresAt,
+	}, nil
+}
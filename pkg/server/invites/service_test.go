@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package invites_test
+
+import (
+	"testing"
+	"time"
+
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_invites "github.com/daytonaio/daytona/internal/testing/server/invites"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/invites"
+	"github.com/stretchr/testify/suite"
+)
+
+type InviteServiceTestSuite struct {
+	suite.Suite
+	inviteService invites.IInviteService
+	apiKeyService apikeys.IApiKeyService
+}
+
+func NewInviteServiceTestSuite() *InviteServiceTestSuite {
+	return &InviteServiceTestSuite{}
+}
+
+func (s *InviteServiceTestSuite) SetupTest() {
+	s.apiKeyService = apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+	s.inviteService = invites.NewInviteService(invites.InviteServiceConfig{
+		InviteStore:   t_invites.NewInMemoryInviteStore(),
+		ApiKeyService: s.apiKeyService,
+	})
+}
+
+func TestInviteService(t *testing.T) {
+	suite.Run(t, NewInviteServiceTestSuite())
+}
+
+func (s *InviteServiceTestSuite) TestRedeem() {
+	code, inv, err := s.inviteService.Create("ws1", time.Hour)
+	s.Require().Nil(err)
+	s.Require().NotEqual(code, inv.CodeHash)
+
+	access, err := s.inviteService.Redeem(code)
+	s.Require().Nil(err)
+	s.Require().Equal("ws1", access.WorkspaceId)
+	s.Require().Equal(inv.ExpiresAt, access.ExpiresAt)
+
+	s.Require().True(s.apiKeyService.IsValidApiKey(access.ApiKey))
+	s.Require().True(s.apiKeyService.IsReadOnlyApiKey(access.ApiKey))
+	s.Require().Equal("ws1", s.apiKeyService.GetApiKeyWorkspaceId(access.ApiKey))
+}
+
+func (s *InviteServiceTestSuite) TestRedeemOnce() {
+	code, _, err := s.inviteService.Create("ws1", time.Hour)
+	s.Require().Nil(err)
+
+	_, err = s.inviteService.Redeem(code)
+	s.Require().Nil(err)
+
+	_, err = s.inviteService.Redeem(code)
+	s.Require().ErrorIs(err, invites.ErrInvalidInvite)
+}
+
+func (s *InviteServiceTestSuite) TestRedeemInvalidCode() {
+	_, err := s.inviteService.Redeem("invalid")
+	s.Require().ErrorIs(err, invites.ErrInvalidInvite)
+}
+
+func (s *InviteServiceTestSuite) TestCreateValidatesTtl() {
+	_, _, err := s.inviteService.Create("ws1", time.Second)
+	s.Require().NotNil(err)
+
+	_, _, err = s.inviteService.Create("ws1", 48*time.Hour)
+	s.Require().NotNil(err)
+}
@@ -332,6 +332,117 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 	return nil
 }
 
+// TriggerPrebuild creates a build from the latest commit on the prebuild branch regardless of the prebuild trigger rules
+func (s *ProjectConfigService) TriggerPrebuild(projectConfigName string, prebuildId string) (string, error) {
+	projectConfig, err := s.Find(&config.ProjectConfigFilter{
+		Name: &projectConfigName,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	prebuild, err := projectConfig.FindPrebuild(&config.PrebuildFilter{
+		Id: &prebuildId,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	gitProvider, _, err := s.gitProviderService.GetGitProviderForUrl(projectConfig.RepositoryUrl)
+	if err != nil {
+		return "", fmt.Errorf("failed to get git provider for URL: %s", err)
+	}
+
+	repo, err := gitProvider.GetRepositoryContext(gitprovider.GetRepositoryContext{
+		Url:    projectConfig.RepositoryUrl,
+		Branch: &prebuild.Branch,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get repository context: %s", err)
+	}
+
+	return s.buildService.Create(getPrebuildCreationData(projectConfig, prebuild, repo))
+}
+
+// PollPrebuilds checks every prebuild branch for new commits and triggers a build once the
+// commit interval is reached. This keeps prebuilds fresh when the Git provider webhook can not reach the server.
+// Prebuilds that only define trigger files rely on webhooks since polling does not know which files changed.
+func (s *ProjectConfigService) PollPrebuilds() error {
+	projectConfigs, err := s.List(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, projectConfig := range projectConfigs {
+		for _, prebuild := range projectConfig.Prebuilds {
+			err := s.pollPrebuild(projectConfig, prebuild)
+			if err != nil {
+				log.Errorf("failed to poll prebuild %s for project config %s: %s", prebuild.Id, projectConfig.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *ProjectConfigService) pollPrebuild(projectConfig *config.ProjectConfig, prebuild *config.PrebuildConfig) error {
+	if prebuild.CommitInterval == nil {
+		return nil
+	}
+
+	gitProvider, _, err := s.gitProviderService.GetGitProviderForUrl(projectConfig.RepositoryUrl)
+	if err != nil {
+		return err
+	}
+
+	repo, err := gitProvider.GetRepositoryContext(gitprovider.GetRepositoryContext{
+		Url:    projectConfig.RepositoryUrl,
+		Branch: &prebuild.Branch,
+	})
+	if err != nil {
+		return err
+	}
+
+	newestBuild, err := s.buildService.Find(&build.Filter{
+		PrebuildIds: &[]string{prebuild.Id},
+		GetNewest:   util.Pointer(true),
+	})
+	if err == nil {
+		if newestBuild.Repository == nil || newestBuild.Repository.Sha == repo.Sha {
+			return nil
+		}
+
+		commitsRange, err := gitProvider.GetCommitsRange(repo, newestBuild.Repository.Sha, repo.Sha)
+		if err != nil {
+			return err
+		}
+
+		if commitsRange < *prebuild.CommitInterval {
+			return nil
+		}
+	}
+
+	_, err = s.buildService.Create(getPrebuildCreationData(projectConfig, prebuild, repo))
+	return err
+}
+
+func (s *ProjectConfigService) StartPrebuildPoller() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(PREBUILD_POLL_INTERVAL, func() {
+		err := s.PollPrebuilds()
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
 // Marks the [retention] oldest published builds for deletion for each prebuild
 func (s *ProjectConfigService) EnforceRetentionPolicy() error {
 	prebuilds, err := s.ListPrebuilds(nil, nil)
@@ -400,6 +511,17 @@ func (s *ProjectConfigService) StartRetentionPoller() error {
 	return nil
 }
 
+func getPrebuildCreationData(projectConfig *config.ProjectConfig, prebuild *config.PrebuildConfig, repo *gitprovider.GitRepository) build_dto.BuildCreationData {
+	return build_dto.BuildCreationData{
+		Image:       projectConfig.Image,
+		User:        projectConfig.User,
+		BuildConfig: projectConfig.BuildConfig,
+		Repository:  repo,
+		EnvVars:     projectConfig.EnvVars,
+		PrebuildId:  prebuild.Id,
+	}
+}
+
 func slicesHaveCommonEntry(slice1, slice2 []string) bool {
 	entryMap := make(map[string]bool)
 
@@ -12,6 +12,7 @@ import (
 	build_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/stretchr/testify/mock"
 )
 
 var prebuild1 *config.PrebuildConfig = &config.PrebuildConfig{
@@ -194,6 +195,63 @@ func (s *ProjectConfigServiceTestSuite) TestProcessGitEventTriggerFiles() {
 	require.Nil(err)
 }
 
+func (s *ProjectConfigServiceTestSuite) TestTriggerPrebuild() {
+	require := s.Require()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url:    repository1.Url,
+		Branch: &prebuild1.Branch,
+	}).Return(repository1, nil)
+
+	s.buildService.On("Create", build_dto.BuildCreationData{
+		PrebuildId: prebuild1.Id,
+		Repository: repository1,
+		User:       projectConfig1.User,
+		Image:      projectConfig1.Image,
+	}).Return("build-id", nil)
+
+	buildId, err := s.projectConfigService.TriggerPrebuild(projectConfig1.Name, prebuild1.Id)
+	require.Nil(err)
+	require.Equal("build-id", buildId)
+}
+
+func (s *ProjectConfigServiceTestSuite) TestPollPrebuilds() {
+	require := s.Require()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", mock.Anything).Return(repository1, nil)
+
+	// The newest build of prebuild1 is behind the branch by the commit interval
+	s.buildService.On("Find", mock.MatchedBy(func(filter *build.Filter) bool {
+		return (*filter.PrebuildIds)[0] == prebuild1.Id
+	})).Return(&build.Build{
+		Id:         "1",
+		PrebuildId: prebuild1.Id,
+		Repository: &gitprovider.GitRepository{
+			Url: repository1.Url,
+			Sha: "sha0",
+		},
+	}, nil)
+	// Other prebuilds are up to date
+	s.buildService.On("Find", mock.Anything).Return(&build.Build{
+		Id:         "2",
+		Repository: repository1,
+	}, nil).Maybe()
+
+	s.gitProvider.On("GetCommitsRange", repository1, "sha0", repository1.Sha).Return(*prebuild1.CommitInterval, nil)
+
+	s.buildService.On("Create", build_dto.BuildCreationData{
+		PrebuildId: prebuild1.Id,
+		Repository: repository1,
+		User:       projectConfig1.User,
+		Image:      projectConfig1.Image,
+	}).Return("", nil)
+
+	err := s.projectConfigService.PollPrebuilds()
+	require.Nil(err)
+}
+
 func (s *ProjectConfigServiceTestSuite) TestEnforceRetentionPolicy() {
 	require := s.Require()
 
@@ -4,6 +4,8 @@
 package projectconfig
 
 import (
+	"maps"
+	"reflect"
 	"strings"
 
 	"github.com/daytonaio/daytona/internal/util"
@@ -25,12 +27,18 @@ type IProjectConfigService interface {
 	FindPrebuild(projectConfigFilter *config.ProjectConfigFilter, prebuildFilter *config.PrebuildFilter) (*dto.PrebuildDTO, error)
 	ListPrebuilds(projectConfigFilter *config.ProjectConfigFilter, prebuildFilter *config.PrebuildFilter) ([]*dto.PrebuildDTO, error)
 	DeletePrebuild(projectConfigName string, id string, force bool) []error
+	TriggerPrebuild(projectConfigName string, prebuildId string) (string, error)
 
 	StartRetentionPoller() error
 	EnforceRetentionPolicy() error
+	StartPrebuildPoller() error
+	PollPrebuilds() error
 	ProcessGitEvent(gitprovider.GitEventData) error
 }
 
+// Git providers are polled for new commits on prebuild branches every 10 minutes
+const PREBUILD_POLL_INTERVAL = "0 */10 * * * *"
+
 type ProjectConfigServiceConfig struct {
 	PrebuildWebhookEndpoint string
 	ConfigStore             config.Store
@@ -101,7 +109,20 @@ func (s *ProjectConfigService) Find(filter *config.ProjectConfigFilter) (*config
 func (s *ProjectConfigService) Save(projectConfig *config.ProjectConfig) error {
 	projectConfig.RepositoryUrl = util.CleanUpRepositoryUrl(projectConfig.RepositoryUrl)
 
-	err := s.configStore.Save(projectConfig)
+	existingProjectConfig, err := s.configStore.Find(&config.ProjectConfigFilter{Name: &projectConfig.Name})
+	if err != nil && !config.IsProjectConfigNotFound(err) {
+		return err
+	}
+
+	projectConfig.Version = 1
+	if existingProjectConfig != nil {
+		projectConfig.Version = existingProjectConfig.Version
+		if !isSameProjectTemplate(existingProjectConfig, projectConfig) {
+			projectConfig.Version++
+		}
+	}
+
+	err = s.configStore.Save(projectConfig)
 	if err != nil {
 		return err
 	}
@@ -132,3 +153,13 @@ func (s *ProjectConfigService) Delete(projectConfigName string, force bool) []er
 
 	return nil
 }
+
+// isSameProjectTemplate checks if the properties that projects are created from are the same.
+// Prebuilds and the default flag don't affect projects and are ignored
+func isSameProjectTemplate(a, b *config.ProjectConfig) bool {
+	return a.Image == b.Image &&
+		a.User == b.User &&
+		a.RepositoryUrl == b.RepositoryUrl &&
+		maps.Equal(a.EnvVars, b.EnvVars) &&
+		reflect.DeepEqual(a.BuildConfig, b.BuildConfig)
+}
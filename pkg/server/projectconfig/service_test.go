@@ -172,6 +172,21 @@ func (s *ProjectConfigServiceTestSuite) TestSave() {
 	require.ElementsMatch(expectedProjectConfigs, projectConfigs)
 }
 
+func (s *ProjectConfigServiceTestSuite) TestSaveIncrementsVersion() {
+	require := s.Require()
+
+	projectConfig := *projectConfig1
+	projectConfig.EnvVars = map[string]string{}
+	err := s.projectConfigService.Save(&projectConfig)
+	require.Nil(err)
+	require.Equal(projectConfig1.Version, projectConfig.Version)
+
+	projectConfig.Image = "updated-image"
+	err = s.projectConfigService.Save(&projectConfig)
+	require.Nil(err)
+	require.Equal(projectConfig1.Version+1, projectConfig.Version)
+}
+
 func (s *ProjectConfigServiceTestSuite) TestDelete() {
 	expectedProjectConfigs = expectedProjectConfigs[:2]
 
@@ -9,4 +9,5 @@ type CreateProviderTargetDTO struct {
 	Name         string                `json:"name" validate:"required"`
 	ProviderInfo provider.ProviderInfo `json:"providerInfo" validate:"required"`
 	Options      string                `json:"options" validate:"required"`
+	Labels       map[string]string     `json:"labels,omitempty" validate:"optional"`
 } // @name CreateProviderTargetDTO
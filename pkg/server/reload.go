@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ConfigChange is a server config field that changed since the config was last loaded
+type ConfigChange struct {
+	Field    string `json:"field" validate:"required"`
+	OldValue string `json:"oldValue" validate:"required"`
+	NewValue string `json:"newValue" validate:"required"`
+	// True if the change is saved but only takes effect after the server is restarted
+	RequiresRestart bool `json:"requiresRestart" validate:"required"`
+} // @name ConfigChange
+
+// Config fields that are applied when the config is reloaded. Changes to other fields require a restart
+var reloadableConfigFields = []string{
+	"registryUrl",
+	"serverDownloadUrl",
+	"defaultProjectImage",
+	"defaultProjectUser",
+	"builderImage",
+	"samplesIndexUrl",
+	"egressQuotaMb",
+	"hibernateAfterMinutes",
+	"hibernationRetentionDays",
+}
+
+func applyReloadableConfig(dst *Config, src Config) {
+	dst.RegistryUrl = src.RegistryUrl
+	dst.ServerDownloadUrl = src.ServerDownloadUrl
+	dst.DefaultProjectImage = src.DefaultProjectImage
+	dst.DefaultProjectUser = src.DefaultProjectUser
+	dst.BuilderImage = src.BuilderImage
+	dst.SamplesIndexUrl = src.SamplesIndexUrl
+	dst.EgressQuotaMb = src.EgressQuotaMb
+	dst.HibernateAfterMinutes = src.HibernateAfterMinutes
+	dst.HibernationRetentionDays = src.HibernationRetentionDays
+}
+
+// ReloadConfig reads the config file again and applies the changed fields that don't require a restart,
+// without interrupting running API requests and log streams. The changes are written to the server log
+func (s *Server) ReloadConfig() ([]ConfigChange, error) {
+	c, err := GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	s.configMutex.Lock()
+	defer s.configMutex.Unlock()
+
+	changes := DiffConfig(s.loadedConfig, *c)
+	if len(changes) == 0 {
+		log.Info("Server config reloaded, no changes")
+		return changes, nil
+	}
+
+	applyReloadableConfig(&s.config, *c)
+	// Fields that require a restart are kept so that they are reported until the server is restarted
+	applyReloadableConfig(&s.loadedConfig, *c)
+
+	s.ProviderManager.SetRegistryUrl(c.RegistryUrl)
+	s.WorkspaceService.UpdateSettings(workspaces.Settings{
+		DefaultProjectImage:  c.DefaultProjectImage,
+		DefaultProjectUser:   c.DefaultProjectUser,
+		BuilderImage:         c.BuilderImage,
+		EgressQuota:          c.EgressQuotaMb * 1024 * 1024,
+		HibernateAfter:       time.Duration(c.HibernateAfterMinutes) * time.Minute,
+		HibernationRetention: time.Duration(c.HibernationRetentionDays) * 24 * time.Hour,
+	})
+
+	log.Info("Server config reloaded")
+	for _, change := range changes {
+		if change.RequiresRestart {
+			log.Warnf("  %s: %q -> %q (requires a restart)", change.Field, change.OldValue, change.NewValue)
+		} else {
+			log.Infof("  %s: %q -> %q", change.Field, change.OldValue, change.NewValue)
+		}
+	}
+
+	return changes, nil
+}
+
+// DiffConfig returns the fields that differ between the configs. Nested fields are named by their path, e.g. logFile.maxSize
+func DiffConfig(oldConfig, newConfig Config) []ConfigChange {
+	return diffFields("", reflect.ValueOf(oldConfig), reflect.ValueOf(newConfig))
+}
+
+func diffFields(prefix string, oldValue, newValue reflect.Value) []ConfigChange {
+	changes := []ConfigChange{}
+
+	for i := 0; i < oldValue.NumField(); i++ {
+		field := oldValue.Type().Field(i)
+		name := prefix + strings.Split(field.Tag.Get("json"), ",")[0]
+
+		oldField, newField := oldValue.Field(i), newValue.Field(i)
+
+		// A missing nested config is compared as its zero value
+		if field.Type.Kind() == reflect.Pointer && field.Type.Elem().Kind() == reflect.Struct {
+			changes = append(changes, diffFields(name+".", derefOrZero(oldField), derefOrZero(newField))...)
+			continue
+		}
+
+		if reflect.DeepEqual(oldField.Interface(), newField.Interface()) {
+			continue
+		}
+
+		changes = append(changes, ConfigChange{
+			Field:           name,
+			OldValue:        fmt.Sprint(oldField.Interface()),
+			NewValue:        fmt.Sprint(newField.Interface()),
+			RequiresRestart: !slices.Contains(reloadableConfigFields, name),
+		})
+	}
+
+	return changes
+}
+
+func derefOrZero(value reflect.Value) reflect.Value {
+	if value.IsNil() {
+		return reflect.Zero(value.Type().Elem())
+	}
+	return value.Elem()
+}
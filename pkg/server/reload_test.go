@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffConfig(t *testing.T) {
+	oldConfig := Config{
+		ApiPort:             3986,
+		DefaultProjectImage: "daytonaio/workspace-project:latest",
+		LogFile:             &LogFileConfig{Path: "daytona.log", MaxSize: 100},
+	}
+
+	newConfig := oldConfig
+	newConfig.ApiPort = 3987
+	newConfig.DefaultProjectImage = "ubuntu:22.04"
+	newConfig.LogFile = &LogFileConfig{Path: "daytona.log", MaxSize: 200}
+	newConfig.BuildLimits = &BuildLimitsConfig{Cpus: 2}
+
+	require.Equal(t, []ConfigChange{
+		{Field: "apiPort", OldValue: "3986", NewValue: "3987", RequiresRestart: true},
+		{Field: "logFile.maxSize", OldValue: "100", NewValue: "200", RequiresRestart: true},
+		{Field: "defaultProjectImage", OldValue: "daytonaio/workspace-project:latest", NewValue: "ubuntu:22.04"},
+		{Field: "buildLimits.cpus", OldValue: "0", NewValue: "2", RequiresRestart: true},
+	}, DiffConfig(oldConfig, newConfig))
+}
+
+func TestDiffConfigNoChanges(t *testing.T) {
+	c := Config{Frps: &FRPSConfig{Domain: "try-eu.daytona.io"}}
+
+	require.Empty(t, DiffConfig(c, c))
+	require.Empty(t, DiffConfig(Config{}, Config{LogFile: &LogFileConfig{}}))
+}
@@ -10,9 +10,10 @@ import (
 )
 
 func (s *Server) FetchSamples() ([]sample.Sample, *http.Response, error) {
-	if s.config.SamplesIndexUrl == "" {
+	samplesIndexUrl := s.getConfig().SamplesIndexUrl
+	if samplesIndexUrl == "" {
 		return []sample.Sample{}, nil, nil
 	}
 
-	return sample.FetchSamples(s.config.SamplesIndexUrl)
+	return sample.FetchSamples(samplesIndexUrl)
 }
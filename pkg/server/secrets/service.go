@@ -0,0 +1,199 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type ISecretService interface {
+	List(workspaceId *string) ([]*secret.Secret, error)
+	Set(params SetSecretParams) (*secret.Secret, error)
+	Delete(name, workspaceId, projectName string) error
+	DeleteWorkspaceSecrets(workspaceId string) error
+	DeleteProjectSecrets(workspaceId, projectName string) error
+	GetProjectSecrets(workspaceId, projectName string) ([]ProjectSecret, error)
+}
+
+type SetSecretParams struct {
+	Name        string
+	Value       string
+	WorkspaceId string
+	ProjectName string
+	FilePath    string
+	// Octal permissions of the secret file, defaults to DefaultFileMode
+	FileMode string
+}
+
+// ProjectSecret is a decrypted secret injected into a project container
+type ProjectSecret struct {
+	Name     string `json:"name" validate:"required"`
+	Value    string `json:"value" validate:"required"`
+	FilePath string `json:"filePath" validate:"optional"`
+	FileMode string `json:"fileMode" validate:"optional"`
+} // @name ProjectSecret
+
+// DefaultFileMode is the mode of secret files that don't set one
+const DefaultFileMode = "0600"
+
+type SecretServiceConfig struct {
+	SecretStore secret.Store
+	// Key used to encrypt secret values at rest, must be 32 bytes long
+	EncryptionKey []byte
+}
+
+func NewSecretService(config SecretServiceConfig) ISecretService {
+	return &SecretService{
+		secretStore:   config.SecretStore,
+		encryptionKey: config.EncryptionKey,
+	}
+}
+
+type SecretService struct {
+	secretStore   secret.Store
+	encryptionKey []byte
+}
+
+var envVarNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func (s *SecretService) List(workspaceId *string) ([]*secret.Secret, error) {
+	return s.secretStore.List(&secret.Filter{WorkspaceId: workspaceId})
+}
+
+func (s *SecretService) Set(params SetSecretParams) (*secret.Secret, error) {
+	if params.FilePath == "" && !envVarNameRegex.MatchString(params.Name) {
+		return nil, fmt.Errorf("invalid secret name %s: secrets injected as environment variables must be valid variable names", params.Name)
+	}
+
+	if params.FilePath != "" && !path.IsAbs(params.FilePath) {
+		return nil, errors.New("secret file path must be absolute")
+	}
+
+	if params.ProjectName != "" && params.WorkspaceId == "" {
+		return nil, errors.New("project secrets must be scoped to a workspace")
+	}
+
+	fileMode := ""
+	if params.FilePath != "" {
+		fileMode = DefaultFileMode
+		if params.FileMode != "" {
+			mode, err := strconv.ParseUint(params.FileMode, 8, 32)
+			if err != nil || mode > 0777 {
+				return nil, fmt.Errorf("invalid secret file mode %s: must be octal permissions, e.g. 0400", params.FileMode)
+			}
+			fileMode = fmt.Sprintf("%04o", mode)
+		}
+	} else if params.FileMode != "" {
+		return nil, errors.New("a file mode can only be set for secrets injected as files")
+	}
+
+	encryptedValue, err := encrypt(s.encryptionKey, params.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+
+	sec := &secret.Secret{
+		Name:           params.Name,
+		WorkspaceId:    params.WorkspaceId,
+		ProjectName:    params.ProjectName,
+		FilePath:       params.FilePath,
+		FileMode:       fileMode,
+		EncryptedValue: encryptedValue,
+		UpdatedAt:      time.Now(),
+	}
+
+	err = s.secretStore.Save(sec)
+	if err != nil {
+		return nil, err
+	}
+
+	return sec, nil
+}
+
+func (s *SecretService) Delete(name, workspaceId, projectName string) error {
+	return s.secretStore.Delete(&secret.Secret{Name: name, WorkspaceId: workspaceId, ProjectName: projectName})
+}
+
+func (s *SecretService) DeleteWorkspaceSecrets(workspaceId string) error {
+	return s.deleteSecrets(&secret.Filter{WorkspaceId: &workspaceId})
+}
+
+func (s *SecretService) DeleteProjectSecrets(workspaceId, projectName string) error {
+	return s.deleteSecrets(&secret.Filter{WorkspaceId: &workspaceId, ProjectName: &projectName})
+}
+
+func (s *SecretService) deleteSecrets(filter *secret.Filter) error {
+	secrets, err := s.secretStore.List(filter)
+	if err != nil {
+		return err
+	}
+
+	for _, sec := range secrets {
+		err = s.secretStore.Delete(sec)
+		if err != nil && !secret.IsSecretNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetProjectSecrets returns the decrypted profile, workspace and project secrets of a project.
+// Project secrets take precedence over workspace secrets with the same name, which take precedence over profile secrets
+func (s *SecretService) GetProjectSecrets(workspaceId, projectName string) ([]ProjectSecret, error) {
+	profileWorkspaceId := ""
+	profileSecrets, err := s.secretStore.List(&secret.Filter{WorkspaceId: &profileWorkspaceId})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceSecrets, err := s.secretStore.List(&secret.Filter{WorkspaceId: &workspaceId})
+	if err != nil {
+		return nil, err
+	}
+
+	// Secrets are applied from the least to the most specific scope
+	scoped := map[string]*secret.Secret{}
+	for _, sec := range profileSecrets {
+		scoped[sec.Name] = sec
+	}
+	for _, sec := range workspaceSecrets {
+		if sec.ProjectName == "" {
+			scoped[sec.Name] = sec
+		}
+	}
+	for _, sec := range workspaceSecrets {
+		if sec.ProjectName == projectName {
+			scoped[sec.Name] = sec
+		}
+	}
+
+	result := []ProjectSecret{}
+	for _, name := range slices.Sorted(maps.Keys(scoped)) {
+		sec := scoped[name]
+
+		value, err := decrypt(s.encryptionKey, sec.EncryptedValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt secret %s: %w", sec.Name, err)
+		}
+
+		result = append(result, ProjectSecret{
+			Name:     sec.Name,
+			Value:    value,
+			FilePath: sec.FilePath,
+			FileMode: sec.FileMode,
+		})
+	}
+
+	return result, nil
+}
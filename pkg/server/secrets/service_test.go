@@ -0,0 +1,178 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"bytes"
+	"testing"
+
+	t_secrets "github.com/daytonaio/daytona/internal/testing/server/secrets"
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/stretchr/testify/suite"
+)
+
+type SecretServiceTestSuite struct {
+	suite.Suite
+	secretService secrets.ISecretService
+	secretStore   secret.Store
+}
+
+func NewSecretServiceTestSuite() *SecretServiceTestSuite {
+	return &SecretServiceTestSuite{}
+}
+
+func (s *SecretServiceTestSuite) SetupTest() {
+	s.secretStore = t_secrets.NewInMemorySecretStore()
+	s.secretService = secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore:   s.secretStore,
+		EncryptionKey: bytes.Repeat([]byte{1}, 32),
+	})
+}
+
+func TestSecretService(t *testing.T) {
+	suite.Run(t, NewSecretServiceTestSuite())
+}
+
+func (s *SecretServiceTestSuite) TestSetEncryptsValue() {
+	_, err := s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "secret-value"})
+	s.Require().Nil(err)
+
+	name := "TOKEN"
+	sec, err := s.secretStore.Find(&secret.Filter{Name: &name})
+	s.Require().Nil(err)
+	s.Require().NotEmpty(sec.EncryptedValue)
+	s.Require().NotContains(string(sec.EncryptedValue), "secret-value")
+}
+
+func (s *SecretServiceTestSuite) TestSetValidatesName() {
+	_, err := s.secretService.Set(secrets.SetSecretParams{Name: "MY-TOKEN", Value: "value"})
+	s.Require().NotNil(err)
+
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "MY-TOKEN", Value: "value", FilePath: "/home/daytona/.token"})
+	s.Require().Nil(err)
+
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "key", Value: "value", FilePath: "relative/path"})
+	s.Require().NotNil(err)
+}
+
+func (s *SecretServiceTestSuite) TestSetValidatesScope() {
+	_, err := s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", ProjectName: "project1"})
+	s.Require().NotNil(err)
+
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", WorkspaceId: "ws1", ProjectName: "project1"})
+	s.Require().Nil(err)
+}
+
+func (s *SecretServiceTestSuite) TestSetFileMode() {
+	sec, err := s.secretService.Set(secrets.SetSecretParams{Name: "key", Value: "value", FilePath: "/home/daytona/.key"})
+	s.Require().Nil(err)
+	s.Require().Equal(secrets.DefaultFileMode, sec.FileMode)
+
+	sec, err = s.secretService.Set(secrets.SetSecretParams{Name: "key", Value: "value", FilePath: "/home/daytona/.key", FileMode: "400"})
+	s.Require().Nil(err)
+	s.Require().Equal("0400", sec.FileMode)
+
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "key", Value: "value", FilePath: "/home/daytona/.key", FileMode: "0999"})
+	s.Require().NotNil(err)
+
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "key", Value: "value", FilePath: "/home/daytona/.key", FileMode: "01777"})
+	s.Require().NotNil(err)
+
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", FileMode: "0400"})
+	s.Require().NotNil(err)
+}
+
+func (s *SecretServiceTestSuite) TestGetProjectSecrets() {
+	_, err := s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "profile-token"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "PASSWORD", Value: "profile-password"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "workspace-token", WorkspaceId: "ws1"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "OTHER", Value: "other", WorkspaceId: "ws2"})
+	s.Require().Nil(err)
+
+	projectSecrets, err := s.secretService.GetProjectSecrets("ws1", "project1")
+	s.Require().Nil(err)
+	s.Require().ElementsMatch([]secrets.ProjectSecret{
+		{Name: "PASSWORD", Value: "profile-password"},
+		{Name: "TOKEN", Value: "workspace-token"},
+	}, projectSecrets)
+}
+
+func (s *SecretServiceTestSuite) TestGetProjectSecretsWithProjectScope() {
+	_, err := s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "profile-token"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "workspace-token", WorkspaceId: "ws1"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "project-token", WorkspaceId: "ws1", ProjectName: "project1"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "key", Value: "project-key", WorkspaceId: "ws1", ProjectName: "project2", FilePath: "/home/daytona/.key", FileMode: "0400"})
+	s.Require().Nil(err)
+
+	projectSecrets, err := s.secretService.GetProjectSecrets("ws1", "project1")
+	s.Require().Nil(err)
+	s.Require().Equal([]secrets.ProjectSecret{
+		{Name: "TOKEN", Value: "project-token"},
+	}, projectSecrets)
+
+	projectSecrets, err = s.secretService.GetProjectSecrets("ws1", "project2")
+	s.Require().Nil(err)
+	s.Require().Equal([]secrets.ProjectSecret{
+		{Name: "TOKEN", Value: "workspace-token"},
+		{Name: "key", Value: "project-key", FilePath: "/home/daytona/.key", FileMode: "0400"},
+	}, projectSecrets)
+}
+
+func (s *SecretServiceTestSuite) TestDelete() {
+	_, err := s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", WorkspaceId: "ws1"})
+	s.Require().Nil(err)
+
+	err = s.secretService.Delete("TOKEN", "", "")
+	s.Require().True(secret.IsSecretNotFound(err))
+
+	err = s.secretService.Delete("TOKEN", "ws1", "")
+	s.Require().Nil(err)
+
+	secretList, err := s.secretService.List(nil)
+	s.Require().Nil(err)
+	s.Require().Empty(secretList)
+}
+
+func (s *SecretServiceTestSuite) TestDeleteWorkspaceSecrets() {
+	_, err := s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", WorkspaceId: "ws1"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "KEY", Value: "value", WorkspaceId: "ws1", FilePath: "/tmp/key"})
+	s.Require().Nil(err)
+
+	err = s.secretService.DeleteWorkspaceSecrets("ws1")
+	s.Require().Nil(err)
+
+	secretList, err := s.secretService.List(nil)
+	s.Require().Nil(err)
+	s.Require().Len(secretList, 1)
+	s.Require().Equal("", secretList[0].WorkspaceId)
+}
+
+func (s *SecretServiceTestSuite) TestDeleteProjectSecrets() {
+	_, err := s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", WorkspaceId: "ws1"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", WorkspaceId: "ws1", ProjectName: "p1"})
+	s.Require().Nil(err)
+	_, err = s.secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", WorkspaceId: "ws1", ProjectName: "p2"})
+	s.Require().Nil(err)
+
+	err = s.secretService.DeleteProjectSecrets("ws1", "p1")
+	s.Require().Nil(err)
+
+	secretList, err := s.secretService.List(nil)
+	s.Require().Nil(err)
+	s.Require().Len(secretList, 2)
+	for _, sec := range secretList {
+		s.Require().NotEqual("p1", sec.ProjectName)
+	}
+}
@@ -4,20 +4,21 @@
 package server
 
 import (
-	"os"
-	"os/signal"
+	"sync"
 
+	"github.com/daytonaio/daytona/pkg/hostinfo"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
 	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/server/invites"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
-	"github.com/hashicorp/go-plugin"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -36,6 +37,8 @@ type ServerInstanceConfig struct {
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
+	SecretService            secrets.ISecretService
+	InviteService            invites.IInviteService
 	TelemetryService         telemetry.TelemetryService
 }
 
@@ -65,7 +68,10 @@ func GetInstance(serverConfig *ServerInstanceConfig) *Server {
 			GitProviderService:       serverConfig.GitProviderService,
 			ProviderManager:          serverConfig.ProviderManager,
 			ProfileDataService:       serverConfig.ProfileDataService,
+			SecretService:            serverConfig.SecretService,
+			InviteService:            serverConfig.InviteService,
 			TelemetryService:         serverConfig.TelemetryService,
+			shutdown:                 make(chan struct{}),
 		}
 	}
 
@@ -73,8 +79,11 @@ func GetInstance(serverConfig *ServerInstanceConfig) *Server {
 }
 
 type Server struct {
-	Id                       string
-	config                   Config
+	Id     string
+	config Config
+	// The config as read from the config file, used to find the changes when the config is reloaded
+	loadedConfig             Config
+	configMutex              sync.RWMutex
 	Version                  string
 	TailscaleServer          TailscaleServer
 	ProviderTargetService    providertargets.IProviderTargetService
@@ -87,24 +96,41 @@ type Server struct {
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
+	SecretService            secrets.ISecretService
+	InviteService            invites.IInviteService
 	TelemetryService         telemetry.TelemetryService
+
+	// Detected when the server starts
+	HostInfo hostinfo.HostInfo
+
+	// Closed when the server starts shutting down
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
 }
 
 func (s *Server) Initialize() error {
+	// The config is read again because some of its values are rewritten when the server instance is created
+	c, err := GetConfig()
+	if err != nil {
+		return err
+	}
+	s.loadedConfig = *c
+
 	return s.initLogs()
 }
 
+func (s *Server) getConfig() Config {
+	s.configMutex.RLock()
+	defer s.configMutex.RUnlock()
+
+	return s.config
+}
+
 func (s *Server) Start() error {
 	log.Info("Starting Daytona server")
 
-	go func() {
-		interruptChannel := make(chan os.Signal, 1)
-		signal.Notify(interruptChannel, os.Interrupt)
-
-		for range interruptChannel {
-			plugin.CleanupClients()
-		}
-	}()
+	s.HostInfo = hostinfo.Detect()
+	log.Debugf("Host info: %+v", s.HostInfo)
 
 	// Terminate orphaned provider processes
 	err := s.ProviderManager.TerminateProviderProcesses(s.config.ProvidersDir)
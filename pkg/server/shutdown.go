@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"github.com/hashicorp/go-plugin"
+	log "github.com/sirupsen/logrus"
+)
+
+// Shutdown marks the server as restarting. New API requests are rejected with the restarting status so that
+// clients retry them once the server is back, and log streams are closed with the service restart code
+func (s *Server) Shutdown() {
+	s.shutdownOnce.Do(func() {
+		log.Info("Server is shutting down, rejecting new requests")
+		close(s.shutdown)
+	})
+}
+
+// ShutdownNotify returns a channel that is closed when the server starts shutting down
+func (s *Server) ShutdownNotify() <-chan struct{} {
+	return s.shutdown
+}
+
+func (s *Server) IsShuttingDown() bool {
+	select {
+	case <-s.shutdown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Stop terminates the provider plugins. It is called after the requests in progress finished
+func (s *Server) Stop() {
+	plugin.CleanupClients()
+}
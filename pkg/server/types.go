@@ -4,6 +4,8 @@
 package server
 
 import (
+	"context"
+	"net"
 	"net/http"
 )
 
@@ -12,6 +14,8 @@ type TailscaleServer interface {
 	CreateAuthKey() (string, error)
 	CreateUser() error
 	HTTPClient() *http.Client
+	// Dial connects to an address on the network of the server, e.g. a port of a project
+	Dial(ctx context.Context, network, address string) (net.Conn, error)
 	Start(errChan chan error) error
 	Stop() error
 	Purge() error
@@ -34,25 +38,45 @@ type NetworkKey struct {
 } // @name NetworkKey
 
 type Config struct {
-	ProvidersDir              string         `json:"providersDir" validate:"required"`
-	RegistryUrl               string         `json:"registryUrl" validate:"required"`
-	Id                        string         `json:"id" validate:"required"`
-	ServerDownloadUrl         string         `json:"serverDownloadUrl" validate:"required"`
-	Frps                      *FRPSConfig    `json:"frps,omitempty" validate:"optional"`
-	ApiPort                   uint32         `json:"apiPort" validate:"required"`
-	HeadscalePort             uint32         `json:"headscalePort" validate:"required"`
-	BinariesPath              string         `json:"binariesPath" validate:"required"`
-	LogFile                   *LogFileConfig `json:"logFile" validate:"required"`
-	DefaultProjectImage       string         `json:"defaultProjectImage" validate:"required"`
-	DefaultProjectUser        string         `json:"defaultProjectUser" validate:"required"`
-	BuilderImage              string         `json:"builderImage" validate:"required"`
-	LocalBuilderRegistryPort  uint32         `json:"localBuilderRegistryPort" validate:"required"`
-	LocalBuilderRegistryImage string         `json:"localBuilderRegistryImage" validate:"required"`
-	BuilderRegistryServer     string         `json:"builderRegistryServer" validate:"required"`
-	BuildImageNamespace       string         `json:"buildImageNamespace" validate:"optional"`
-	SamplesIndexUrl           string         `json:"samplesIndexUrl" validate:"optional"`
+	ProvidersDir              string             `json:"providersDir" validate:"required"`
+	RegistryUrl               string             `json:"registryUrl" validate:"required"`
+	Id                        string             `json:"id" validate:"required"`
+	ServerDownloadUrl         string             `json:"serverDownloadUrl" validate:"required"`
+	Frps                      *FRPSConfig        `json:"frps,omitempty" validate:"optional"`
+	ApiPort                   uint32             `json:"apiPort" validate:"required"`
+	HeadscalePort             uint32             `json:"headscalePort" validate:"required"`
+	BinariesPath              string             `json:"binariesPath" validate:"required"`
+	LogFile                   *LogFileConfig     `json:"logFile" validate:"required"`
+	DefaultProjectImage       string             `json:"defaultProjectImage" validate:"required"`
+	DefaultProjectUser        string             `json:"defaultProjectUser" validate:"required"`
+	BuilderImage              string             `json:"builderImage" validate:"required"`
+	LocalBuilderRegistryPort  uint32             `json:"localBuilderRegistryPort" validate:"required"`
+	LocalBuilderRegistryImage string             `json:"localBuilderRegistryImage" validate:"required"`
+	BuilderRegistryServer     string             `json:"builderRegistryServer" validate:"required"`
+	BuildImageNamespace       string             `json:"buildImageNamespace" validate:"optional"`
+	SamplesIndexUrl           string             `json:"samplesIndexUrl" validate:"optional"`
+	BuildLimits               *BuildLimitsConfig `json:"buildLimits,omitempty" validate:"optional"`
+	// Docker daemon address used for builds and passed to providers, e.g. tcp://10.0.0.5:2376
+	DockerHost string `json:"dockerHost" validate:"optional"`
+	// Docker CLI context to use instead of the docker host
+	DockerContext string `json:"dockerContext" validate:"optional"`
+	// Outbound traffic in MB a workspace may send per month before it is stopped. 0 disables the quota
+	EgressQuotaMb uint64 `json:"egressQuotaMb,omitempty" validate:"optional"`
+	// Minutes a workspace has to be stopped before its project data is archived to disk. 0 disables hibernation
+	HibernateAfterMinutes uint32 `json:"hibernateAfterMinutes,omitempty" validate:"optional"`
+	// Days a hibernated workspace is kept before it is removed. 0 keeps hibernated workspaces
+	HibernationRetentionDays uint32 `json:"hibernationRetentionDays,omitempty" validate:"optional"`
 } // @name ServerConfig
 
+// BuildLimitsConfig limits the resources of the BuildKit builders that run the image builds.
+// Builds requested with different API keys run on separate builders, so the limits apply to each of them
+type BuildLimitsConfig struct {
+	// 0 means no limit
+	Cpus float32 `json:"cpus" validate:"optional"`
+	// 0 means no limit
+	MemoryMb uint32 `json:"memoryMb" validate:"optional"`
+} // @name BuildLimitsConfig
+
 type LogFileConfig struct {
 	Path       string `json:"path" validate:"required"`
 	MaxSize    int    `json:"maxSize" validate:"required"`
@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"golang.org/x/mod/semver"
+)
+
+// UpgradeCheck is the result of the pre-flight check run before the server is upgraded
+type UpgradeCheck struct {
+	CurrentVersion string `json:"currentVersion" validate:"required"`
+	TargetVersion  string `json:"targetVersion" validate:"required"`
+	// False if the server can't be upgraded to the target version
+	Compatible bool   `json:"compatible" validate:"required"`
+	Reason     string `json:"reason,omitempty" validate:"optional"`
+	// Problems that don't block the upgrade
+	Warnings []string `json:"warnings" validate:"required"`
+	// URL of the script that installs Daytona on the server host
+	InstallScriptUrl string `json:"installScriptUrl" validate:"required"`
+	Os               string `json:"os" validate:"required"`
+	Arch             string `json:"arch" validate:"required"`
+} // @name UpgradeCheck
+
+func (s *Server) CheckUpgrade(targetVersion string) (*UpgradeCheck, error) {
+	check := &UpgradeCheck{
+		CurrentVersion:   s.Version,
+		TargetVersion:    targetVersion,
+		Warnings:         []string{},
+		InstallScriptUrl: s.getConfig().ServerDownloadUrl,
+		Os:               runtime.GOOS,
+		Arch:             runtime.GOARCH,
+	}
+
+	check.Compatible, check.Reason = checkUpgradeCompatibility(s.Version, targetVersion)
+
+	builds, err := s.BuildService.List(&build.Filter{
+		States: &[]build.BuildState{build.BuildStatePendingRun, build.BuildStateRunning},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(builds) > 0 {
+		check.Warnings = append(check.Warnings, fmt.Sprintf("%d build(s) in progress will be interrupted", len(builds)))
+	}
+
+	return check, nil
+}
+
+// checkUpgradeCompatibility returns false and the reason if the server can't be upgraded to the target version.
+// Development builds can be upgraded to any version
+func checkUpgradeCompatibility(currentVersion, targetVersion string) (bool, string) {
+	if !semver.IsValid(targetVersion) {
+		return false, fmt.Sprintf("%s is not a valid version", targetVersion)
+	}
+
+	if !semver.IsValid(currentVersion) || semver.Prerelease(currentVersion) == "-dev" {
+		return true, ""
+	}
+
+	if semver.Major(currentVersion) != semver.Major(targetVersion) {
+		return false, fmt.Sprintf("upgrading from %s to %s crosses a major version and requires a manual migration", currentVersion, targetVersion)
+	}
+
+	if semver.Compare(targetVersion, currentVersion) < 0 {
+		return false, fmt.Sprintf("downgrading from %s to %s is not supported since database migrations can't be reverted", currentVersion, targetVersion)
+	}
+
+	return true, ""
+}
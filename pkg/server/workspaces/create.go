@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
@@ -22,6 +23,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 
@@ -46,6 +48,8 @@ func isValidWorkspaceName(name string) bool {
 }
 
 func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO) (*workspace.Workspace, error) {
+	startedAt := time.Now()
+
 	_, err := s.workspaceStore.Find(req.Name)
 	if err == nil {
 		return nil, ErrWorkspaceAlreadyExists
@@ -56,10 +60,39 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 		return nil, ErrInvalidWorkspaceName
 	}
 
+	if req.Group != "" && !isValidWorkspaceName(req.Group) {
+		return nil, ErrInvalidGroupName
+	}
+
+	if req.Retries < 0 || req.Retries > MaxCreateRetries {
+		return nil, ErrInvalidRetries
+	}
+
+	err = workspace.ValidateLabels(req.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Placement) > 0 {
+		target, err := s.targetStore.Find(&provider.TargetFilter{Name: &req.Target})
+		if err != nil {
+			return nil, err
+		}
+
+		if !workspace.MatchLabels(target.Labels, req.Placement) {
+			return nil, ErrPlacementNotSatisfied
+		}
+	}
+
 	w := &workspace.Workspace{
-		Id:     req.Id,
-		Name:   req.Name,
-		Target: req.Target,
+		Id:       req.Id,
+		Name:     req.Name,
+		Target:   req.Target,
+		Group:    req.Group,
+		Labels:   req.Labels,
+		AutoStop: req.AutoStop,
+		Origin:   req.Origin,
+		Owner:    req.Owner,
 	}
 
 	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, w.Id)
@@ -103,18 +136,18 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 		}
 
 		if p.BuildConfig != nil {
-			cachedBuild, err := s.getCachedBuildForProject(p)
+			cachedBuild, err := s.getCachedBuildForProject(p, w.Owner)
 			if err == nil {
 				p.BuildConfig.CachedBuild = cachedBuild
 			}
 		}
 
 		if p.Image == "" {
-			p.Image = s.defaultProjectImage
+			p.Image = s.getSettings().DefaultProjectImage
 		}
 
 		if p.User == "" {
-			p.User = s.defaultProjectUser
+			p.User = s.getSettings().DefaultProjectUser
 		}
 
 		apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", w.Id, p.Name))
@@ -138,7 +171,10 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 		return w, err
 	}
 
-	w, err = s.createWorkspace(ctx, w, target)
+	w, err = s.createWorkspaceWithRetries(ctx, w, target, startedAt, req.Retries, req.KeepPartial)
+	if err == nil {
+		s.recordEvent(w, "", events.EventTypeCreated)
+	}
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return w, err
@@ -160,17 +196,108 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 	return w, err
 }
 
-func (s *WorkspaceService) createProject(p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+// MaxCreateRetries is the maximum number of times provisioning can be retried
+const MaxCreateRetries = 10
+
+// Delay before provisioning is retried, multiplied by the number of the attempt
+var createRetryDelay = 5 * time.Second
+
+// createWorkspaceWithRetries provisions the workspace and retries on failure after destroying the partially
+// created resources. If all attempts fail, the workspace is removed unless keepPartial is set.
+func (s *WorkspaceService) createWorkspaceWithRetries(ctx context.Context, ws *workspace.Workspace, target *provider.ProviderTarget, startedAt time.Time, retries int, keepPartial bool) (*workspace.Workspace, error) {
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		var createdWorkspace *workspace.Workspace
+		createdWorkspace, err = s.createWorkspace(ctx, ws, target, startedAt)
+		if err == nil {
+			return createdWorkspace, nil
+		}
+
+		s.recordFailure(target, err)
+
+		if attempt >= retries {
+			break
+		}
+
+		s.logWorkspaceMessage(ws.Id, fmt.Sprintf("Workspace creation failed: %s. Retrying (%d/%d)...\n", err, attempt+1, retries))
+		s.destroyWorkspaceResources(ws, target)
+
+		// The retry is abandoned when the request is canceled, e.g. when the client disconnects
+		waitErr := waitForRetry(ctx, createRetryDelay*time.Duration(attempt+1))
+		if waitErr != nil {
+			err = errors.Join(err, waitErr)
+			break
+		}
+	}
+
+	if keepPartial {
+		s.logWorkspaceMessage(ws.Id, "Workspace creation failed. Keeping the partially created workspace\n")
+		return nil, err
+	}
+
+	s.logWorkspaceMessage(ws.Id, "Workspace creation failed. Removing the partially created workspace\n")
+
+	// The partially created workspace is removed even if the request was canceled
+	removeErr := s.ForceRemoveWorkspace(context.WithoutCancel(ctx), ws.Id)
+	if removeErr != nil {
+		log.Errorf("Failed to remove partially created workspace %s: %s", ws.Id, removeErr)
+	}
+
+	return nil, err
+}
+
+// waitForRetry waits for the delay or until the context is done
+func waitForRetry(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// destroyWorkspaceResources destroys the provider resources of the workspace and its projects,
+// ignoring errors since some of them might not have been created
+func (s *WorkspaceService) destroyWorkspaceResources(ws *workspace.Workspace, target *provider.ProviderTarget) {
+	for _, p := range ws.Projects {
+		err := s.provisioner.DestroyProject(p, target)
+		if err != nil {
+			log.Debug(err)
+		}
+	}
+
+	err := s.provisioner.DestroyWorkspace(ws, target)
+	if err != nil {
+		log.Debug(err)
+	}
+}
+
+func (s *WorkspaceService) logWorkspaceMessage(workspaceId, message string) {
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(workspaceId, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	_, err := wsLogger.Write([]byte(message))
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func (s *WorkspaceService) createProject(p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) (*provider.ProjectCreationTimings, error) {
 	logWriter.Write([]byte(fmt.Sprintf("Creating project %s\n", p.Name)))
 
 	cr, err := s.containerRegistryService.FindByImageName(p.Image)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
-		return err
+		return nil, err
 	}
 
-	builderCr, err := s.containerRegistryService.FindByImageName(s.builderImage)
+	builderImage := s.getSettings().BuilderImage
+	builderCr, err := s.containerRegistryService.FindByImageName(builderImage)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
-		return err
+		return nil, err
 	}
 
 	var gc *gitprovider.GitProviderConfig
@@ -178,28 +305,28 @@ func (s *WorkspaceService) createProject(p *project.Project, target *provider.Pr
 	if p.GitProviderConfigId != nil {
 		gc, err = s.gitProviderService.GetConfig(*p.GitProviderConfigId)
 		if err != nil && !gitprovider.IsGitProviderNotFound(err) {
-			return err
+			return nil, err
 		}
 	}
 
-	err = s.provisioner.CreateProject(provisioner.ProjectParams{
+	providerTimings, err := s.provisioner.CreateProject(provisioner.ProjectParams{
 		Project:                       p,
 		Target:                        target,
 		ContainerRegistry:             cr,
 		GitProviderConfig:             gc,
-		BuilderImage:                  s.builderImage,
+		BuilderImage:                  builderImage,
 		BuilderImageContainerRegistry: builderCr,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logWriter.Write([]byte(fmt.Sprintf("Project %s created\n", p.Name)))
 
-	return nil
+	return providerTimings, nil
 }
 
-func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Workspace, target *provider.ProviderTarget) (*workspace.Workspace, error) {
+func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Workspace, target *provider.ProviderTarget, startedAt time.Time) (*workspace.Workspace, error) {
 	wsLogger := s.loggerFactory.CreateWorkspaceLogger(ws.Id, logs.LogSourceServer)
 	defer wsLogger.Close()
 
@@ -212,10 +339,17 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 		ClientId:      telemetry.ClientId(ctx),
 	}, telemetry.TelemetryEnabled(ctx))
 
-	err := s.provisioner.CreateWorkspace(ws, target)
+	queueWait := time.Since(startedAt)
+
+	err := logs.RunStep(wsLogger, logs.StepProvisionWorkspace, func() error {
+		return s.provisioner.CreateWorkspace(ws, target)
+	})
 	if err != nil {
 		return nil, err
 	}
+	provisioned := time.Since(startedAt)
+
+	projectTimings := creationTimings{}
 
 	for i, p := range ws.Projects {
 		projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, p.Name, logs.LogSourceServer)
@@ -243,23 +377,35 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 			return nil, err
 		}
 
-		err = s.createProject(p, target, projectLogger)
+		projectTimings[p.Name] = newCreationTiming(ws, p, startedAt, queueWait, provisioned)
+
+		var providerTimings *provider.ProjectCreationTimings
+		projectStartedAt := time.Now()
+		err = logs.RunStep(projectLogger, logs.StepCreateProject, func() error {
+			providerTimings, err = s.createProject(p, target, projectLogger)
+			return err
+		})
 		if err != nil {
 			return nil, err
 		}
+		projectTimings.addProviderTimings(p.Name, providerTimings, time.Since(projectStartedAt))
 	}
 
 	wsLogger.Write([]byte("Workspace creation complete. Pending start...\n"))
 
-	err = s.startWorkspace(ctx, ws, target, wsLogger)
+	err = s.startWorkspace(ctx, ws, target, wsLogger, projectTimings)
 	if err != nil {
 		return nil, err
 	}
 
+	s.saveCreationTimings(projectTimings)
+
 	return ws, nil
 }
 
-func (s *WorkspaceService) getCachedBuildForProject(p *project.Project) (*buildconfig.CachedBuild, error) {
+// getCachedBuildForProject returns the newest published build of the project that was requested by the owner
+// or built by the server for a prebuild. Builds of other users are not used so that they can't poison the image
+func (s *WorkspaceService) getCachedBuildForProject(p *project.Project, owner string) (*buildconfig.CachedBuild, error) {
 	validStates := &[]build.BuildState{
 		build.BuildState(build.BuildStatePublished),
 	}
@@ -271,6 +417,8 @@ func (s *WorkspaceService) getCachedBuildForProject(p *project.Project) (*buildc
 		EnvVars:       &p.EnvVars,
 		BuildConfig:   p.BuildConfig,
 		GetNewest:     util.Pointer(true),
+		// Prebuilds are built by the server without a namespace
+		CacheNamespaces: &[]string{owner, ""},
 	})
 	if err != nil {
 		return nil, err
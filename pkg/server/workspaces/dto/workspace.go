@@ -24,9 +24,35 @@ type CreateWorkspaceDTO struct {
 	Id       string             `json:"id" validate:"required"`
 	Name     string             `json:"name" validate:"required"`
 	Target   string             `json:"target" validate:"required"`
+	Group    string             `json:"group,omitempty" validate:"optional"`
 	Projects []CreateProjectDTO `json:"projects" validate:"required,gt=0,dive"`
+	Labels   map[string]string  `json:"labels,omitempty" validate:"optional"`
+	// Number of times provisioning is retried after a failure
+	Retries int `json:"retries,omitempty" validate:"optional" minimum:"0" maximum:"10"`
+	// Keep the partially created workspace when provisioning fails instead of removing it
+	KeepPartial bool `json:"keepPartial,omitempty" validate:"optional"`
+	// Minutes without SSH activity after which the workspace is stopped. Zero disables auto-stop
+	AutoStop uint32 `json:"autoStop,omitempty" validate:"optional"`
+	// Labels the target must have for the workspace to be created on it
+	Placement map[string]string          `json:"placement,omitempty" validate:"optional"`
+	Origin    *workspace.WorkspaceOrigin `json:"origin,omitempty" validate:"optional"`
+	// Set by the server to the user that creates the workspace
+	Owner string `json:"-"`
 } //	@name	CreateWorkspaceDTO
 
+// ShareWorkspaceDTO replaces the users an existing workspace is shared with
+type ShareWorkspaceDTO struct {
+	SharedWith []string `json:"sharedWith" validate:"required"`
+} //	@name	ShareWorkspaceDTO
+
+// UpdateWorkspaceSettingsDTO replaces the settings of an existing workspace
+type UpdateWorkspaceSettingsDTO struct {
+	Group  string            `json:"group" validate:"required"`
+	Labels map[string]string `json:"labels" validate:"required"`
+	// Minutes without SSH activity after which the workspace is stopped. Zero disables auto-stop
+	AutoStop uint32 `json:"autoStop" validate:"required"`
+} //	@name	UpdateWorkspaceSettingsDTO
+
 type CreateProjectDTO struct {
 	Name                string                   `json:"name" validate:"required"`
 	Image               *string                  `json:"image,omitempty" validate:"optional"`
@@ -35,6 +61,13 @@ type CreateProjectDTO struct {
 	Source              CreateProjectSourceDTO   `json:"source" validate:"required"`
 	EnvVars             map[string]string        `json:"envVars" validate:"required"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	Resources           *project.ResourceLimits  `json:"resources,omitempty" validate:"optional"`
+	// Name and version of the project config the project is created from
+	ProjectConfigName    string   `json:"projectConfigName,omitempty" validate:"optional"`
+	ProjectConfigVersion uint32   `json:"projectConfigVersion,omitempty" validate:"optional"`
+	OnCreateCommands     []string `json:"onCreateCommands,omitempty" validate:"optional"`
+	PostStartCommands    []string `json:"postStartCommands,omitempty" validate:"optional"`
+	ForwardPorts         []uint16 `json:"forwardPorts,omitempty" validate:"optional"`
 } //	@name	CreateProjectDTO
 
 type CreateProjectSourceDTO struct {
@@ -0,0 +1,179 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/egress"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+const EGRESS_POLL_INTERVAL = "0 * * * * *"
+
+func (s *WorkspaceService) ListEgressUsage(filter *egress.Filter) ([]*egress.Usage, error) {
+	return s.egressUsageStore.List(filter)
+}
+
+// CollectEgressUsage samples the egress counters of all workspaces, adds the traffic sent since the
+// previous sample to the usage of the current month and stops workspaces that exceed the egress quota
+func (s *WorkspaceService) CollectEgressUsage(ctx context.Context) error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	month := time.Now().Format(egress.MonthFormat)
+
+	for _, ws := range workspaces {
+		err := s.collectWorkspaceEgress(ctx, ws, month)
+		if err != nil {
+			log.Errorf("failed to collect egress usage of workspace %s: %s", ws.Name, err)
+			continue
+		}
+
+		err = s.enforceEgressQuota(ctx, ws, month)
+		if err != nil {
+			log.Errorf("failed to enforce the egress quota of workspace %s: %s", ws.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkspaceService) StartEgressCollector() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(EGRESS_POLL_INTERVAL, func() {
+		err := s.CollectEgressUsage(context.Background())
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
+func (s *WorkspaceService) collectWorkspaceEgress(ctx context.Context, ws *workspace.Workspace, month string) error {
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &ws.Target})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	workspaceInfo, err := s.provisioner.GetWorkspaceInfo(ctx, ws, target)
+	if err != nil {
+		return err
+	}
+
+	for _, projectInfo := range workspaceInfo.Projects {
+		err := s.collectProjectEgress(ws, projectInfo, month)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkspaceService) collectProjectEgress(ws *workspace.Workspace, projectInfo *project.ProjectInfo, month string) error {
+	usage, err := s.findEgressUsage(ws, projectInfo.Name, month)
+	if err != nil {
+		return err
+	}
+
+	if !projectInfo.IsRunning {
+		// Counters start from zero on the next start so there is nothing to account until then
+		if usage.LastCounter == 0 {
+			return nil
+		}
+		usage.LastCounter = 0
+	} else {
+		usage.Add(projectInfo.EgressBytes)
+	}
+
+	usage.WorkspaceName = ws.Name
+
+	return s.egressUsageStore.Save(usage)
+}
+
+// findEgressUsage returns the usage of the project in the given month. A new month continues from
+// the counter of the previous month so that traffic is not accounted twice
+func (s *WorkspaceService) findEgressUsage(ws *workspace.Workspace, projectName, month string) (*egress.Usage, error) {
+	usages, err := s.egressUsageStore.List(&egress.Filter{
+		WorkspaceId: &ws.Id,
+		ProjectName: &projectName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &egress.Usage{
+		WorkspaceId:   ws.Id,
+		WorkspaceName: ws.Name,
+		ProjectName:   projectName,
+		Month:         month,
+	}
+
+	// Usages are listed newest month first
+	if len(usages) > 0 {
+		if usages[0].Month == month {
+			return usages[0], nil
+		}
+		usage.LastCounter = usages[0].LastCounter
+	}
+
+	return usage, nil
+}
+
+func (s *WorkspaceService) enforceEgressQuota(ctx context.Context, ws *workspace.Workspace, month string) error {
+	quota := s.getSettings().EgressQuota
+	if quota == 0 {
+		return nil
+	}
+
+	usages, err := s.egressUsageStore.List(&egress.Filter{
+		WorkspaceId: &ws.Id,
+		Month:       &month,
+	})
+	if err != nil {
+		return err
+	}
+
+	// A stopped workspace reports a zero counter for each of its projects
+	isRunning := false
+	for _, u := range usages {
+		if u.LastCounter > 0 {
+			isRunning = true
+		}
+	}
+
+	if !isRunning || egress.WorkspaceTotals(usages)[ws.Id] <= quota {
+		return nil
+	}
+
+	log.Warnf("workspace %s exceeded the egress quota of %d bytes, stopping it", ws.Name, quota)
+
+	err = s.StopWorkspace(ctx, ws.Id)
+	if err != nil {
+		return fmt.Errorf("failed to stop workspace: %w", err)
+	}
+
+	s.recordEvent(ws, "", events.EventTypeEgressQuotaExceeded)
+
+	return nil
+}
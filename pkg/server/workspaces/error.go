@@ -5,6 +5,7 @@ package workspaces
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
@@ -12,8 +13,20 @@ var (
 	ErrInvalidWorkspaceName   = errors.New("name is not a valid alphanumeric string")
 	ErrWorkspaceNotFound      = errors.New("workspace not found")
 	ErrProjectNotFound        = errors.New("project not found")
+	ErrLastProject            = errors.New("can not remove the only project of a workspace, remove the workspace instead")
 	ErrInvalidProjectName     = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
 	ErrInvalidProjectConfig   = errors.New("project config is invalid")
+	ErrInvalidGroupName       = errors.New("group name is not valid. Only [a-zA-Z0-9-_.] are allowed")
+	ErrSnapshotNotFound       = errors.New("snapshot not found")
+	ErrSnapshotAlreadyExists  = errors.New("snapshot already exists")
+	ErrInvalidSnapshotName    = errors.New("snapshot name is not valid. Only [a-zA-Z0-9-_.] are allowed")
+	ErrInvalidEventType       = errors.New("only ssh-connected events can be recorded by clients")
+	ErrPlacementNotSatisfied  = errors.New("target does not satisfy the placement constraints")
+	ErrNoProjectConfig        = errors.New("project was not created from a project config")
+	ErrProjectConfigUpToDate  = errors.New("project already uses the latest version of its project config")
+	ErrUserNotFound           = errors.New("user not found")
+	ErrInvalidPublicKey       = errors.New("public key is not valid, expected the authorized_keys format")
+	ErrInvalidRetries         = fmt.Errorf("retries must be between 0 and %d", MaxCreateRetries)
 )
 
 func IsWorkspaceAlreadyExists(err error) bool {
@@ -31,3 +44,23 @@ func IsProjectNotFound(err error) bool {
 func IsInvalidWorkspaceName(err error) bool {
 	return err.Error() == ErrInvalidWorkspaceName.Error()
 }
+
+func IsSnapshotNotFound(err error) bool {
+	return err.Error() == ErrSnapshotNotFound.Error()
+}
+
+func IsInvalidGroupName(err error) bool {
+	return err.Error() == ErrInvalidGroupName.Error()
+}
+
+func IsUserNotFound(err error) bool {
+	return errors.Is(err, ErrUserNotFound)
+}
+
+func IsInvalidPublicKey(err error) bool {
+	return errors.Is(err, ErrInvalidPublicKey)
+}
+
+func IsInvalidRetries(err error) bool {
+	return errors.Is(err, ErrInvalidRetries)
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/timings"
+)
+
+// EstimateWorkspaceCreation estimates how long the creation of the workspace takes from the previous creations on
+// its target, taking into account whether a prebuild is available and whether the image is likely cached
+func (s *WorkspaceService) EstimateWorkspaceCreation(ctx context.Context, req dto.CreateWorkspaceDTO) (*timings.CreationEstimate, error) {
+	history, err := s.creationTimingStore.List(&timings.Filter{Target: &req.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(history) > timings.EstimateHistorySize {
+		history = history[:timings.EstimateHistorySize]
+	}
+
+	estimate := &timings.CreationEstimate{
+		Projects: []timings.ProjectCreationEstimate{},
+	}
+
+	for _, projectDto := range req.Projects {
+		p := conversion.CreateDtoToProject(projectDto)
+		p.Repository.Url = util.CleanUpRepositoryUrl(p.Repository.Url)
+
+		params := timings.ProjectEstimateParams{
+			ProjectName:   p.Name,
+			RepositoryUrl: p.Repository.Url,
+			Image:         p.Image,
+		}
+
+		if p.BuildConfig != nil {
+			cachedBuild, err := s.getCachedBuildForProject(p, req.Owner)
+			if err == nil {
+				params.PrebuildAvailable = true
+				params.Image = cachedBuild.Image
+			} else {
+				params.RequiresBuild = true
+			}
+		} else if params.Image == "" {
+			params.Image = s.getSettings().DefaultProjectImage
+		}
+
+		projectEstimate := timings.EstimateProject(params, history)
+		estimate.Projects = append(estimate.Projects, projectEstimate)
+		estimate.Total += projectEstimate.Total
+	}
+
+	return estimate, nil
+}
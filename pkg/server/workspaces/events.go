@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *WorkspaceService) ListEvents(filter *events.Filter) ([]*events.Event, error) {
+	return s.eventStore.List(filter)
+}
+
+// RecordEvent records an event reported by a client, e.g. an SSH connection to a project
+func (s *WorkspaceService) RecordEvent(workspaceId, projectName string, eventType events.EventType) error {
+	if eventType != events.EventTypeSshConnected {
+		return ErrInvalidEventType
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	if projectName != "" {
+		_, err = w.GetProject(projectName)
+		if err != nil {
+			return ErrProjectNotFound
+		}
+	}
+
+	return s.eventStore.Save(newEvent(w, projectName, eventType))
+}
+
+// recordEvent records a lifecycle event and only logs failures since events are informational
+func (s *WorkspaceService) recordEvent(ws *workspace.Workspace, projectName string, eventType events.EventType) {
+	err := s.eventStore.Save(newEvent(ws, projectName, eventType))
+	if err != nil {
+		log.Errorf("failed to record %s event for workspace %s: %s", eventType, ws.Id, err)
+	}
+}
+
+func newEvent(ws *workspace.Workspace, projectName string, eventType events.EventType) *events.Event {
+	return &events.Event{
+		Id:            stringid.TruncateID(stringid.GenerateRandomID()),
+		WorkspaceId:   ws.Id,
+		WorkspaceName: ws.Name,
+		ProjectName:   projectName,
+		Type:          eventType,
+		CreatedAt:     time.Now(),
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/failures"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetFailureStats returns the provisioning failures since the given time aggregated by category
+func (s *WorkspaceService) GetFailureStats(since time.Time) (*failures.Stats, error) {
+	failureList, err := s.failureStore.List(&failures.Filter{Since: &since})
+	if err != nil {
+		return nil, err
+	}
+
+	return failures.Aggregate(failureList, since), nil
+}
+
+// recordFailure records the category of a failed provisioning attempt. Failures are only logged
+// if they can't be recorded since the stats are informational
+func (s *WorkspaceService) recordFailure(target *provider.ProviderTarget, err error) {
+	failure := &failures.Failure{
+		Id:        stringid.TruncateID(stringid.GenerateRandomID()),
+		Category:  failures.Categorize(err),
+		Provider:  target.ProviderInfo.Name,
+		CreatedAt: time.Now(),
+	}
+
+	saveErr := s.failureStore.Save(failure)
+	if saveErr != nil {
+		log.Errorf("failed to record provisioning failure: %s", saveErr)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+	log "github.com/sirupsen/logrus"
+)
+
+const HIBERNATION_POLL_INTERVAL = "0 */5 * * * *"
+
+// HibernateInactiveWorkspaces moves inactive workspaces to the next tier. Workspaces that were stopped for longer than
+// the hibernation delay are hibernated and hibernated workspaces that are older than the retention are removed
+func (s *WorkspaceService) HibernateInactiveWorkspaces(ctx context.Context) error {
+	settings := s.getSettings()
+	if settings.HibernateAfter == 0 && settings.HibernationRetention == 0 {
+		return nil
+	}
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, ws := range workspaces {
+		if ws.HibernatedAt != nil {
+			err = s.removeExpiredWorkspace(ctx, ws, settings.HibernationRetention)
+			if err != nil {
+				log.Errorf("failed to remove hibernated workspace %s: %s", ws.Name, err)
+			}
+			continue
+		}
+
+		err = s.hibernateIfInactive(ctx, ws, settings.HibernateAfter)
+		if errors.Is(err, provider.ErrNotSupported) {
+			// Retried on every poll, so the workspaces of providers that can't hibernate are not reported as failures
+			log.Debugf("skipping hibernation of workspace %s: %s", ws.Name, err)
+			continue
+		}
+		if err != nil {
+			log.Errorf("failed to hibernate workspace %s: %s", ws.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkspaceService) StartHibernationScheduler() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(HIBERNATION_POLL_INTERVAL, func() {
+		err := s.HibernateInactiveWorkspaces(context.Background())
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
+func (s *WorkspaceService) hibernateIfInactive(ctx context.Context, ws *workspace.Workspace, hibernateAfter time.Duration) error {
+	if hibernateAfter == 0 {
+		return nil
+	}
+
+	stoppedAt, err := s.getStoppedAt(ws)
+	if err != nil || stoppedAt == nil || time.Since(*stoppedAt) < hibernateAfter {
+		return err
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &ws.Target})
+	if err != nil {
+		return err
+	}
+
+	// The last event only covers the changes made through the server, so the providers are asked as well
+	infoCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	workspaceInfo, err := s.provisioner.GetWorkspaceInfo(infoCtx, ws, target)
+	if err != nil {
+		return err
+	}
+
+	for _, projectInfo := range workspaceInfo.Projects {
+		if projectInfo.IsRunning {
+			return nil
+		}
+	}
+
+	log.Infof("Workspace %s was stopped for longer than %s, hibernating it", ws.Name, hibernateAfter)
+
+	return s.hibernateWorkspace(ws, target)
+}
+
+// getStoppedAt returns the time of the last lifecycle event of the workspace if it is a stop, nil otherwise
+func (s *WorkspaceService) getStoppedAt(ws *workspace.Workspace) (*time.Time, error) {
+	workspaceEvents, err := s.eventStore.List(&events.Filter{Workspace: &ws.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	// Events are listed oldest first
+	for i := len(workspaceEvents) - 1; i >= 0; i-- {
+		switch workspaceEvents[i].Type {
+		case events.EventTypeStopped, events.EventTypeEgressQuotaExceeded:
+			return &workspaceEvents[i].CreatedAt, nil
+		case events.EventTypeCreated, events.EventTypeStarted, events.EventTypeWoken:
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *WorkspaceService) hibernateWorkspace(ws *workspace.Workspace, target *provider.ProviderTarget) error {
+	for i, p := range ws.Projects {
+		err := s.provisioner.HibernateProject(p, target)
+		if err == nil {
+			continue
+		}
+
+		// The projects hibernated so far are restored so that the workspace can still be started
+		for _, hibernated := range ws.Projects[:i] {
+			wakeErr := s.provisioner.WakeProject(hibernated, target)
+			if wakeErr != nil {
+				log.Errorf("failed to wake project %s: %s", hibernated.Name, wakeErr)
+			}
+		}
+
+		return fmt.Errorf("failed to hibernate project %s: %w", p.Name, err)
+	}
+
+	ws.HibernatedAt = util.Pointer(time.Now())
+
+	err := s.workspaceStore.Save(ws)
+	if err != nil {
+		return err
+	}
+
+	s.recordEvent(ws, "", events.EventTypeHibernated)
+
+	return nil
+}
+
+// wakeWorkspace restores the projects of a hibernated workspace before it is started. Other workspaces are left as they are
+func (s *WorkspaceService) wakeWorkspace(ws *workspace.Workspace, target *provider.ProviderTarget, logWriter io.Writer) error {
+	if ws.HibernatedAt == nil {
+		return nil
+	}
+
+	logWriter.Write([]byte(fmt.Sprintf("Waking workspace %s from hibernation\n", ws.Name)))
+
+	for _, p := range ws.Projects {
+		err := s.provisioner.WakeProject(p, target)
+		if err != nil {
+			return fmt.Errorf("failed to wake project %s: %w", p.Name, err)
+		}
+	}
+
+	ws.HibernatedAt = nil
+
+	err := s.workspaceStore.Save(ws)
+	if err != nil {
+		return err
+	}
+
+	s.recordEvent(ws, "", events.EventTypeWoken)
+
+	return nil
+}
+
+func (s *WorkspaceService) removeExpiredWorkspace(ctx context.Context, ws *workspace.Workspace, retention time.Duration) error {
+	if retention == 0 || time.Since(*ws.HibernatedAt) < retention {
+		return nil
+	}
+
+	log.Warnf("Workspace %s was hibernated for longer than %s, removing it", ws.Name, retention)
+
+	err := s.RemoveWorkspace(ctx, ws.Id)
+	if err != nil {
+		return err
+	}
+
+	if s.secretService == nil {
+		return nil
+	}
+
+	return s.secretService.DeleteWorkspaceSecrets(ws.Id)
+}
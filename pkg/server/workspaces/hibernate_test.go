@@ -0,0 +1,173 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	t_targets "github.com/daytonaio/daytona/internal/testing/provider/targets"
+	t_events "github.com/daytonaio/daytona/internal/testing/server/events"
+	t_failures "github.com/daytonaio/daytona/internal/testing/server/failures"
+	t_secrets "github.com/daytonaio/daytona/internal/testing/server/secrets"
+	t_snapshots "github.com/daytonaio/daytona/internal/testing/server/snapshots"
+	t_timings "github.com/daytonaio/daytona/internal/testing/server/timings"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceHibernation(t *testing.T) {
+	ctx := context.Background()
+
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	eventStore := t_events.NewInMemoryEventStore()
+	targetStore := t_targets.NewInMemoryTargetStore()
+	require.Nil(t, targetStore.Save(&target))
+
+	containerRegistryService := mocks.NewMockContainerRegistryService()
+	apiKeyService := mocks.NewMockApiKeyService()
+	mockProvisioner := mocks.NewMockProvisioner()
+
+	secretService := secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore:   t_secrets.NewInMemorySecretStore(),
+		EncryptionKey: bytes.Repeat([]byte{1}, 32),
+	})
+
+	wsLogsDir := t.TempDir()
+	buildLogsDir := t.TempDir()
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:           workspaceStore,
+		SnapshotStore:            t_snapshots.NewInMemorySnapshotStore(),
+		CreationTimingStore:      t_timings.NewInMemoryCreationTimingStore(),
+		EventStore:               eventStore,
+		FailureStore:             t_failures.NewInMemoryFailureStore(),
+		TargetStore:              targetStore,
+		ContainerRegistryService: containerRegistryService,
+		BuilderImage:             defaultProjectImage,
+		ApiKeyService:            apiKeyService,
+		Provisioner:              mockProvisioner,
+		LoggerFactory:            logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir),
+		SecretService:            secretService,
+		HibernateAfter:           time.Hour,
+		HibernationRetention:     24 * time.Hour,
+	})
+
+	ws := &workspace.Workspace{
+		Id:     "hibernated",
+		Name:   "hibernated",
+		Target: target.Name,
+		Projects: []*project.Project{
+			{
+				Name:        "project1",
+				Image:       defaultProjectImage,
+				User:        defaultProjectUser,
+				Repository:  &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona"},
+				WorkspaceId: "hibernated",
+				Target:      target.Name,
+			},
+		},
+	}
+	require.Nil(t, workspaceStore.Save(ws))
+
+	require.Nil(t, eventStore.Save(&events.Event{
+		Id:          "stopped",
+		WorkspaceId: ws.Id,
+		Type:        events.EventTypeStopped,
+		CreatedAt:   time.Now().Add(-2 * time.Hour),
+	}))
+
+	mockProvisioner.On("GetWorkspaceInfo", mock.Anything, mock.Anything, &target).Return(&workspace.WorkspaceInfo{
+		Name:     ws.Name,
+		Projects: []*project.ProjectInfo{{Name: "project1", IsRunning: false}},
+	}, nil)
+
+	t.Run("HibernateInactiveWorkspaces skips recently stopped workspaces", func(t *testing.T) {
+		service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+			WorkspaceStore: workspaceStore,
+			EventStore:     eventStore,
+			TargetStore:    targetStore,
+			Provisioner:    mockProvisioner,
+			HibernateAfter: 3 * time.Hour,
+		})
+
+		err := service.HibernateInactiveWorkspaces(ctx)
+		require.Nil(t, err)
+
+		w, err := workspaceStore.Find(ws.Id)
+		require.Nil(t, err)
+		require.Nil(t, w.HibernatedAt)
+		mockProvisioner.AssertNotCalled(t, "HibernateProject", mock.Anything, mock.Anything)
+	})
+
+	t.Run("HibernateInactiveWorkspaces", func(t *testing.T) {
+		mockProvisioner.On("HibernateProject", mock.Anything, &target).Return(nil).Once()
+
+		err := service.HibernateInactiveWorkspaces(ctx)
+		require.Nil(t, err)
+
+		w, err := workspaceStore.Find(ws.Id)
+		require.Nil(t, err)
+		require.NotNil(t, w.HibernatedAt)
+		mockProvisioner.AssertCalled(t, "HibernateProject", mock.Anything, &target)
+
+		workspaceEvents, err := eventStore.List(&events.Filter{Workspace: &ws.Id})
+		require.Nil(t, err)
+		require.Equal(t, events.EventTypeHibernated, workspaceEvents[len(workspaceEvents)-1].Type)
+	})
+
+	t.Run("StartProject wakes a hibernated workspace", func(t *testing.T) {
+		var containerRegistry *containerregistry.ContainerRegistry
+		containerRegistryService.On("FindByImageName", defaultProjectImage).Return(containerRegistry, containerregistry.ErrContainerRegistryNotFound)
+
+		mockProvisioner.On("WakeProject", mock.Anything, &target).Return(nil).Once()
+		mockProvisioner.On("StartProject", mock.Anything).Return(&provider.ProjectCreationTimings{}, nil)
+
+		err := service.StartProject(ctx, ws.Id, "project1")
+		require.Nil(t, err)
+
+		w, err := workspaceStore.Find(ws.Id)
+		require.Nil(t, err)
+		require.Nil(t, w.HibernatedAt)
+		mockProvisioner.AssertCalled(t, "WakeProject", mock.Anything, &target)
+	})
+
+	t.Run("HibernateInactiveWorkspaces removes workspaces hibernated for longer than the retention", func(t *testing.T) {
+		w, err := workspaceStore.Find(ws.Id)
+		require.Nil(t, err)
+		w.HibernatedAt = util.Pointer(time.Now().Add(-48 * time.Hour))
+		require.Nil(t, workspaceStore.Save(w))
+
+		_, err = secretService.Set(secrets.SetSecretParams{Name: "TOKEN", Value: "value", WorkspaceId: ws.Id})
+		require.Nil(t, err)
+
+		mockProvisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
+		apiKeyService.On("Revoke", mock.Anything).Return(nil)
+
+		err = service.HibernateInactiveWorkspaces(ctx)
+		require.Nil(t, err)
+
+		_, err = workspaceStore.Find(ws.Id)
+		require.NotNil(t, err)
+
+		workspaceSecrets, err := secretService.List(&ws.Id)
+		require.Nil(t, err)
+		require.Empty(t, workspaceSecrets)
+	})
+}
@@ -5,68 +5,108 @@ package workspaces
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
 )
 
 func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId string) error {
-	workspace, err := s.workspaceStore.Find(workspaceId)
+	return s.RemoveWorkspaceWithProgress(ctx, workspaceId, false, nil)
+}
+
+// ForceRemoveWorkspace ignores provider errors and makes sure the workspace is removed from storage.
+func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId string) error {
+	return s.RemoveWorkspaceWithProgress(ctx, workspaceId, true, nil)
+}
+
+// RemoveWorkspaceWithProgress removes the workspace and calls onProgress when each teardown step starts and ends.
+// With force, provider errors are logged and the workspace is removed from storage regardless.
+func (s *WorkspaceService) RemoveWorkspaceWithProgress(ctx context.Context, workspaceId string, force bool, onProgress func(workspace.TeardownProgress)) error {
+	ws, err := s.workspaceStore.Find(workspaceId)
 	if err != nil {
 		return ErrWorkspaceNotFound
 	}
 
-	log.Infof("Destroying workspace %s", workspace.Id)
+	log.Infof("Destroying workspace %s", ws.Id)
 
-	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
-	if err != nil {
+	report := teardownReporter(onProgress)
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &ws.Target})
+	if err != nil && !force {
 		return err
 	}
 
-	for _, project := range workspace.Projects {
+	for _, project := range ws.Projects {
 		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
+		err := report.run(workspace.TeardownStepDestroyProject, project.Name, func() error {
+			return s.provisioner.DestroyProject(project, target)
+		})
 		if err != nil {
-			return err
+			if !force {
+				return err
+			}
+			log.Error(err)
 		}
 	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
-	if err != nil {
-		return err
-	}
-
-	// Should not fail the whole operation if the API key cannot be revoked
-	err = s.apiKeyService.Revoke(workspace.Id)
+	err = report.run(workspace.TeardownStepDestroyWorkspace, "", func() error {
+		return s.provisioner.DestroyWorkspace(ws, target)
+	})
 	if err != nil {
+		if !force {
+			return err
+		}
 		log.Error(err)
 	}
 
-	for _, project := range workspace.Projects {
-		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", workspace.Id, project.Name))
+	// Should not fail the whole operation if the API keys cannot be revoked
+	_ = report.run(workspace.TeardownStepRevokeApiKeys, "", func() error {
+		errs := []error{s.apiKeyService.Revoke(ws.Id)}
+		for _, project := range ws.Projects {
+			errs = append(errs, s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", ws.Id, project.Name)))
+		}
+		err := errors.Join(errs...)
 		if err != nil {
-			// Should not fail the whole operation if the API key cannot be revoked
 			log.Error(err)
 		}
-		projectLogger := s.loggerFactory.CreateProjectLogger(workspace.Id, project.Name, logs.LogSourceServer)
-		err = projectLogger.Cleanup()
+		return err
+	})
+
+	// Should not fail the whole operation if the loggers cannot be cleaned up
+	_ = report.run(workspace.TeardownStepRemoveLogs, "", func() error {
+		errs := []error{}
+		for _, project := range ws.Projects {
+			projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, project.Name, logs.LogSourceServer)
+			errs = append(errs, projectLogger.Cleanup())
+		}
+		logger := s.loggerFactory.CreateWorkspaceLogger(ws.Id, logs.LogSourceServer)
+		errs = append(errs, logger.Cleanup())
+
+		err := errors.Join(errs...)
 		if err != nil {
-			// Should not fail the whole operation if the project logger cannot be cleaned up
 			log.Error(err)
 		}
-	}
+		return err
+	})
 
-	logger := s.loggerFactory.CreateWorkspaceLogger(workspace.Id, logs.LogSourceServer)
-	err = logger.Cleanup()
-	if err != nil {
-		// Should not fail the whole operation if the workspace logger cannot be cleaned up
-		log.Error(err)
-	}
+	_ = report.run(workspace.TeardownStepRemoveSnapshots, "", func() error {
+		s.removeSnapshots(ws, target, "")
+		return nil
+	})
 
-	err = s.workspaceStore.Delete(workspace)
+	err = report.run(workspace.TeardownStepDeleteWorkspace, "", func() error {
+		return s.workspaceStore.Delete(ws)
+	})
+	if err == nil {
+		s.recordEvent(ws, "", events.EventTypeRemoved)
+	}
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -74,7 +114,7 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 
 	clientId := telemetry.ClientId(ctx)
 
-	telemetryProps := telemetry.NewWorkspaceEventProps(ctx, workspace, target)
+	telemetryProps := telemetry.NewWorkspaceEventProps(ctx, ws, target)
 	event := telemetry.ServerEventWorkspaceDestroyed
 	if err != nil {
 		telemetryProps["error"] = err.Error()
@@ -88,66 +128,80 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 	return err
 }
 
-// ForceRemoveWorkspace ignores provider errors and makes sure the workspace is removed from storage.
-func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId string) error {
-	workspace, err := s.workspaceStore.Find(workspaceId)
-	if err != nil {
-		return ErrWorkspaceNotFound
-	}
+// teardownReporter reports the start and the result of the teardown steps. A nil reporter only runs the steps
+type teardownReporter func(workspace.TeardownProgress)
 
-	log.Infof("Destroying workspace %s", workspace.Id)
+func (r teardownReporter) run(step workspace.TeardownStep, projectName string, fn func() error) error {
+	if r == nil {
+		return fn()
+	}
 
-	target, _ := s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
+	r(workspace.TeardownProgress{Step: step, Status: workspace.TeardownStatusStarted, ProjectName: projectName})
 
-	for _, project := range workspace.Projects {
-		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
-		if err != nil {
-			log.Error(err)
-		}
+	err := fn()
+	if err != nil {
+		r(workspace.TeardownProgress{Step: step, Status: workspace.TeardownStatusFailed, ProjectName: projectName, Error: err.Error()})
+		return err
 	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
+	r(workspace.TeardownProgress{Step: step, Status: workspace.TeardownStatusCompleted, ProjectName: projectName})
+	return nil
+}
+
+// RemoveProject destroys a single project and removes it from its workspace.
+// With force, provider errors are logged and the project is removed from storage regardless.
+func (s *WorkspaceService) RemoveProject(ctx context.Context, workspaceId, projectName string, force bool) error {
+	workspace, err := s.workspaceStore.Find(workspaceId)
 	if err != nil {
-		log.Error(err)
+		return ErrWorkspaceNotFound
 	}
 
-	err = s.apiKeyService.Revoke(workspace.Id)
+	projectToRemove, err := workspace.GetProject(projectName)
 	if err != nil {
-		log.Error(err)
+		return ErrProjectNotFound
 	}
 
-	for _, project := range workspace.Projects {
-		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", workspace.Id, project.Name))
-		if err != nil {
-			log.Error(err)
-		}
-
-		projectLogger := s.loggerFactory.CreateProjectLogger(workspace.Id, project.Name, logs.LogSourceServer)
-		err = projectLogger.Cleanup()
-		if err != nil {
-			log.Error(err)
-		}
+	if len(workspace.Projects) == 1 {
+		return ErrLastProject
 	}
 
-	err = s.workspaceStore.Delete(workspace)
+	log.Infof("Destroying project %s in workspace %s", projectToRemove.Name, workspace.Id)
 
-	if !telemetry.TelemetryEnabled(ctx) {
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
+	if err != nil && !force {
 		return err
 	}
 
-	clientId := telemetry.ClientId(ctx)
+	err = s.provisioner.DestroyProject(projectToRemove, target)
+	if err != nil {
+		if !force {
+			return err
+		}
+		log.Error(err)
+	}
 
-	telemetryProps := telemetry.NewWorkspaceEventProps(ctx, workspace, target)
-	event := telemetry.ServerEventWorkspaceDestroyed
+	err = s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", workspace.Id, projectToRemove.Name))
 	if err != nil {
-		telemetryProps["error"] = err.Error()
-		event = telemetry.ServerEventWorkspaceDestroyError
+		// Should not fail the whole operation if the API key cannot be revoked
+		log.Error(err)
 	}
-	telemetryError := s.telemetryService.TrackServerEvent(event, clientId, telemetryProps)
-	if telemetryError != nil {
-		log.Trace(telemetryError)
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(workspace.Id, projectToRemove.Name, logs.LogSourceServer)
+	err = projectLogger.Cleanup()
+	if err != nil {
+		// Should not fail the whole operation if the project logger cannot be cleaned up
+		log.Error(err)
 	}
 
-	return err
+	s.removeSnapshots(workspace, target, projectToRemove.Name)
+
+	projects := []*project.Project{}
+	for _, p := range workspace.Projects {
+		if p.Name != projectToRemove.Name {
+			projects = append(projects, p)
+		}
+	}
+	workspace.Projects = projects
+
+	return s.workspaceStore.Save(workspace)
 }
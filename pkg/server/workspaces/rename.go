@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+func (s *WorkspaceService) RenameWorkspace(ctx context.Context, workspaceId string, newName string) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if !isValidWorkspaceName(newName) {
+		return nil, ErrInvalidWorkspaceName
+	}
+
+	if w.Name == newName {
+		return w, nil
+	}
+
+	existing, err := s.workspaceStore.Find(newName)
+	if err == nil && existing.Id != w.Id {
+		return nil, ErrWorkspaceAlreadyExists
+	}
+
+	w.Name = newName
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
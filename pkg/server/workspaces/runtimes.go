@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/runtimes"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// AddProjectRuntimes records the runtimes installed in the project so that they are installed again when the
+// project is recreated. Recorded versions of the same tool are replaced
+func (s *WorkspaceService) AddProjectRuntimes(ctx context.Context, workspaceId, projectName string, projectRuntimes []string) (*project.Project, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	added, err := runtimes.ParseAll(projectRuntimes)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Runtimes = runtimes.Merge(p.Runtimes, added)
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
@@ -19,7 +21,12 @@ import (
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/egress"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+	"github.com/daytonaio/daytona/pkg/workspace/failures"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/snapshot"
+	"github.com/daytonaio/daytona/pkg/workspace/timings"
 )
 
 type IWorkspaceService interface {
@@ -29,20 +36,51 @@ type IWorkspaceService interface {
 	GetProjectLogReader(workspaceId, projectName string) (io.Reader, error)
 	ListWorkspaces(ctx context.Context, verbose bool) ([]dto.WorkspaceDTO, error)
 	RemoveWorkspace(ctx context.Context, workspaceId string) error
+	RenameWorkspace(ctx context.Context, workspaceId string, newName string) (*workspace.Workspace, error)
+	UpdateWorkspaceSettings(ctx context.Context, workspaceId string, req dto.UpdateWorkspaceSettingsDTO) (*workspace.Workspace, error)
+	ShareWorkspace(ctx context.Context, workspaceId string, users []string) (*workspace.Workspace, error)
 	ForceRemoveWorkspace(ctx context.Context, workspaceId string) error
+	RemoveWorkspaceWithProgress(ctx context.Context, workspaceId string, force bool, onProgress func(workspace.TeardownProgress)) error
+	RemoveProject(ctx context.Context, workspaceId, projectName string, force bool) error
+	AddProjectRuntimes(ctx context.Context, workspaceId, projectName string, runtimes []string) (*project.Project, error)
+	CreateSnapshot(ctx context.Context, workspaceId, projectName, name string) (*snapshot.Snapshot, error)
+	ListSnapshots(ctx context.Context, workspaceId string) ([]*snapshot.Snapshot, error)
+	RestoreSnapshot(ctx context.Context, workspaceId, snapshotId string) error
+	ListCreationTimings(filter *timings.Filter) ([]*timings.CreationTiming, error)
+	EstimateWorkspaceCreation(ctx context.Context, req dto.CreateWorkspaceDTO) (*timings.CreationEstimate, error)
+	ListEvents(filter *events.Filter) ([]*events.Event, error)
+	GetFailureStats(since time.Time) (*failures.Stats, error)
+	ListEgressUsage(filter *egress.Filter) ([]*egress.Usage, error)
+	StartEgressCollector() error
+	HibernateInactiveWorkspaces(ctx context.Context) error
+	StartHibernationScheduler() error
+	UpdateSettings(settings Settings)
+	RecordEvent(workspaceId, projectName string, eventType events.EventType) error
+	GrantSshAccess(workspaceId, publicKey string) error
+	IsSshAccessGranted(workspaceId, publicKey string) bool
 	SetProjectState(workspaceId string, projectName string, state *project.ProjectState) (*workspace.Workspace, error)
 	StartProject(ctx context.Context, workspaceId string, projectName string) error
 	StartWorkspace(ctx context.Context, workspaceId string) error
 	StopProject(ctx context.Context, workspaceId string, projectName string) error
 	StopWorkspace(ctx context.Context, workspaceId string) error
+	UpgradeProjectConfig(ctx context.Context, workspaceId, projectName string) error
 }
 
 type targetStore interface {
 	Find(filter *provider.TargetFilter) (*provider.ProviderTarget, error)
 }
 
+type secretService interface {
+	DeleteWorkspaceSecrets(workspaceId string) error
+}
+
 type WorkspaceServiceConfig struct {
 	WorkspaceStore           workspace.Store
+	SnapshotStore            snapshot.Store
+	CreationTimingStore      timings.Store
+	EventStore               events.Store
+	FailureStore             failures.Store
+	EgressUsageStore         egress.Store
 	TargetStore              targetStore
 	ContainerRegistryService containerregistries.IContainerRegistryService
 	BuildService             builds.IBuildService
@@ -58,11 +96,24 @@ type WorkspaceServiceConfig struct {
 	LoggerFactory            logs.LoggerFactory
 	GitProviderService       gitproviders.IGitProviderService
 	TelemetryService         telemetry.TelemetryService
+	// Used to delete the secrets of workspaces removed by the hibernation retention
+	SecretService secretService
+	// Bytes a workspace may send per month before it is stopped. 0 disables the quota
+	EgressQuota uint64
+	// Time a workspace has to be stopped before it is hibernated. 0 disables hibernation
+	HibernateAfter time.Duration
+	// Time a hibernated workspace is kept before it is removed. 0 keeps hibernated workspaces
+	HibernationRetention time.Duration
 }
 
 func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 	return &WorkspaceService{
 		workspaceStore:           config.WorkspaceStore,
+		snapshotStore:            config.SnapshotStore,
+		creationTimingStore:      config.CreationTimingStore,
+		eventStore:               config.EventStore,
+		failureStore:             config.FailureStore,
+		egressUsageStore:         config.EgressUsageStore,
 		targetStore:              config.TargetStore,
 		containerRegistryService: config.ContainerRegistryService,
 		buildService:             config.BuildService,
@@ -70,19 +121,43 @@ func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 		serverApiUrl:             config.ServerApiUrl,
 		serverUrl:                config.ServerUrl,
 		serverVersion:            config.ServerVersion,
-		defaultProjectImage:      config.DefaultProjectImage,
-		defaultProjectUser:       config.DefaultProjectUser,
 		provisioner:              config.Provisioner,
 		loggerFactory:            config.LoggerFactory,
 		apiKeyService:            config.ApiKeyService,
 		gitProviderService:       config.GitProviderService,
 		telemetryService:         config.TelemetryService,
-		builderImage:             config.BuilderImage,
+		secretService:            config.SecretService,
+		settings: Settings{
+			DefaultProjectImage:  config.DefaultProjectImage,
+			DefaultProjectUser:   config.DefaultProjectUser,
+			BuilderImage:         config.BuilderImage,
+			EgressQuota:          config.EgressQuota,
+			HibernateAfter:       config.HibernateAfter,
+			HibernationRetention: config.HibernationRetention,
+		},
 	}
 }
 
+// Settings are the options of the workspace service that can be changed while the server is running
+type Settings struct {
+	DefaultProjectImage string
+	DefaultProjectUser  string
+	BuilderImage        string
+	// Bytes a workspace may send per month before it is stopped. 0 disables the quota
+	EgressQuota uint64
+	// Time a workspace has to be stopped before it is hibernated. 0 disables hibernation
+	HibernateAfter time.Duration
+	// Time a hibernated workspace is kept before it is removed. 0 keeps hibernated workspaces
+	HibernationRetention time.Duration
+}
+
 type WorkspaceService struct {
 	workspaceStore           workspace.Store
+	snapshotStore            snapshot.Store
+	creationTimingStore      timings.Store
+	eventStore               events.Store
+	failureStore             failures.Store
+	egressUsageStore         egress.Store
 	targetStore              targetStore
 	containerRegistryService containerregistries.IContainerRegistryService
 	buildService             builds.IBuildService
@@ -92,12 +167,30 @@ type WorkspaceService struct {
 	serverApiUrl             string
 	serverUrl                string
 	serverVersion            string
-	defaultProjectImage      string
-	defaultProjectUser       string
-	builderImage             string
 	loggerFactory            logs.LoggerFactory
 	gitProviderService       gitproviders.IGitProviderService
 	telemetryService         telemetry.TelemetryService
+	secretService            secretService
+	settings                 Settings
+	settingsMutex            sync.RWMutex
+	// Expiry of the SSH access grants by workspace and key fingerprint
+	sshAccess      map[string]time.Time
+	sshAccessMutex sync.Mutex
+}
+
+// UpdateSettings applies the settings to workspaces created, started and upgraded from now on
+func (s *WorkspaceService) UpdateSettings(settings Settings) {
+	s.settingsMutex.Lock()
+	defer s.settingsMutex.Unlock()
+
+	s.settings = settings
+}
+
+func (s *WorkspaceService) getSettings() Settings {
+	s.settingsMutex.RLock()
+	defer s.settingsMutex.RUnlock()
+
+	return s.settings
 }
 
 func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, state *project.ProjectState) (*workspace.Workspace, error) {
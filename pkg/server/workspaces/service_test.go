@@ -5,11 +5,16 @@ package workspaces_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	t_targets "github.com/daytonaio/daytona/internal/testing/provider/targets"
+	t_events "github.com/daytonaio/daytona/internal/testing/server/events"
+	t_failures "github.com/daytonaio/daytona/internal/testing/server/failures"
+	t_snapshots "github.com/daytonaio/daytona/internal/testing/server/snapshots"
+	t_timings "github.com/daytonaio/daytona/internal/testing/server/timings"
 	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
 	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
 	"github.com/daytonaio/daytona/internal/util"
@@ -23,7 +28,11 @@ import (
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
+	"github.com/daytonaio/daytona/pkg/workspace/failures"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/snapshot"
+	"github.com/daytonaio/daytona/pkg/workspace/timings"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -59,6 +68,7 @@ var createWorkspaceDto = dto.CreateWorkspaceDTO{
 	Name:   "test",
 	Id:     "test",
 	Target: target.Name,
+	Group:  "test-group",
 	Projects: []dto.CreateProjectDTO{
 		{
 			Name:                "project1",
@@ -97,6 +107,9 @@ func TestWorkspaceService(t *testing.T) {
 	ctx = context.WithValue(ctx, telemetry.CLIENT_ID_CONTEXT_KEY, "test")
 
 	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	snapshotStore := t_snapshots.NewInMemorySnapshotStore()
+	creationTimingStore := t_timings.NewInMemoryCreationTimingStore()
+	eventStore := t_events.NewInMemoryEventStore()
 
 	containerRegistryService := mocks.NewMockContainerRegistryService()
 
@@ -115,6 +128,10 @@ func TestWorkspaceService(t *testing.T) {
 
 	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 		WorkspaceStore:           workspaceStore,
+		SnapshotStore:            snapshotStore,
+		CreationTimingStore:      creationTimingStore,
+		EventStore:               eventStore,
+		FailureStore:             t_failures.NewInMemoryFailureStore(),
 		TargetStore:              targetStore,
 		ServerApiUrl:             serverApiUrl,
 		ServerUrl:                serverUrl,
@@ -171,7 +188,10 @@ func TestWorkspaceService(t *testing.T) {
 			GitProviderConfig:             &gitProviderConfig,
 			BuilderImage:                  defaultProjectImage,
 			BuilderImageContainerRegistry: containerRegistry,
-		}).Return(nil)
+		}).Return(&provider.ProjectCreationTimings{
+			Pull:  2 * time.Second,
+			Clone: 3 * time.Second,
+		}, nil)
 		mockProvisioner.On("StartProject", provisioner.ProjectParams{
 			Project:                       proj,
 			Target:                        &target,
@@ -179,7 +199,9 @@ func TestWorkspaceService(t *testing.T) {
 			GitProviderConfig:             &gitProviderConfig,
 			BuilderImage:                  defaultProjectImage,
 			BuilderImageContainerRegistry: containerRegistry,
-		}).Return(nil)
+		}).Return(&provider.ProjectCreationTimings{
+			Hooks: time.Second,
+		}, nil)
 
 		gitProviderService.On("GetConfig", "github").Return(&gitProviderConfig, nil)
 
@@ -191,6 +213,33 @@ func TestWorkspaceService(t *testing.T) {
 		workspaceEquals(t, createWorkspaceDto, workspace, defaultProjectImage)
 	})
 
+	t.Run("ListCreationTimings", func(t *testing.T) {
+		creationTimings, err := service.ListCreationTimings(&timings.Filter{WorkspaceId: &createWorkspaceDto.Id})
+
+		require.Nil(t, err)
+		require.Len(t, creationTimings, 1)
+		require.Equal(t, createWorkspaceDto.Projects[0].Name, creationTimings[0].ProjectName)
+		require.Equal(t, int64(2000), creationTimings[0].Pull)
+		require.Equal(t, int64(3000), creationTimings[0].Clone)
+		require.Equal(t, int64(0), creationTimings[0].Build)
+		require.Equal(t, int64(1000), creationTimings[0].Hooks)
+		require.GreaterOrEqual(t, creationTimings[0].Total, creationTimings[0].QueueWait)
+		require.Equal(t, defaultProjectImage, creationTimings[0].Image)
+	})
+
+	t.Run("EstimateWorkspaceCreation", func(t *testing.T) {
+		estimate, err := service.EstimateWorkspaceCreation(ctx, createWorkspaceDto)
+
+		require.Nil(t, err)
+		require.Len(t, estimate.Projects, 1)
+		require.True(t, estimate.Projects[0].ImageCached)
+		require.False(t, estimate.Projects[0].RequiresBuild)
+		require.Equal(t, 1, estimate.Projects[0].Samples)
+		require.Equal(t, int64(0), estimate.Projects[0].Pull)
+		require.Equal(t, int64(3000), estimate.Projects[0].Clone)
+		require.Equal(t, estimate.Projects[0].Total, estimate.Total)
+	})
+
 	t.Run("CreateWorkspace fails when workspace already exists", func(t *testing.T) {
 		_, err := service.CreateWorkspace(ctx, createWorkspaceDto)
 		require.NotNil(t, err)
@@ -206,6 +255,43 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, workspaces.ErrInvalidWorkspaceName, err)
 	})
 
+	t.Run("CreateWorkspace fails group name validation", func(t *testing.T) {
+		invalidWorkspaceRequest := createWorkspaceDto
+		invalidWorkspaceRequest.Name = "test-invalid-group"
+		invalidWorkspaceRequest.Group = "invalid group"
+
+		_, err := service.CreateWorkspace(ctx, invalidWorkspaceRequest)
+		require.NotNil(t, err)
+		require.Equal(t, workspaces.ErrInvalidGroupName, err)
+	})
+
+	t.Run("CreateWorkspace fails label validation", func(t *testing.T) {
+		invalidWorkspaceRequest := createWorkspaceDto
+		invalidWorkspaceRequest.Name = "test-invalid-label"
+		invalidWorkspaceRequest.Labels = map[string]string{"invalid key": "value"}
+
+		_, err := service.CreateWorkspace(ctx, invalidWorkspaceRequest)
+		require.NotNil(t, err)
+	})
+
+	t.Run("CreateWorkspace fails retries validation", func(t *testing.T) {
+		invalidWorkspaceRequest := createWorkspaceDto
+		invalidWorkspaceRequest.Name = "test-invalid-retries"
+		invalidWorkspaceRequest.Retries = workspaces.MaxCreateRetries + 1
+
+		_, err := service.CreateWorkspace(ctx, invalidWorkspaceRequest)
+		require.Equal(t, workspaces.ErrInvalidRetries, err)
+	})
+
+	t.Run("CreateWorkspace fails when the target does not satisfy the placement constraints", func(t *testing.T) {
+		invalidWorkspaceRequest := createWorkspaceDto
+		invalidWorkspaceRequest.Name = "test-placement"
+		invalidWorkspaceRequest.Placement = map[string]string{"gpu": "true"}
+
+		_, err := service.CreateWorkspace(ctx, invalidWorkspaceRequest)
+		require.Equal(t, workspaces.ErrPlacementNotSatisfied, err)
+	})
+
 	t.Run("GetWorkspace", func(t *testing.T) {
 		mockProvisioner.On("GetWorkspaceInfo", mock.Anything, mock.Anything, &target).Return(&workspaceInfo, nil)
 
@@ -251,9 +337,89 @@ func TestWorkspaceService(t *testing.T) {
 		workspaceDtoEquals(t, createWorkspaceDto, workspace, workspaceInfo, defaultProjectImage, verbose)
 	})
 
+	t.Run("RenameWorkspace", func(t *testing.T) {
+		w, err := service.RenameWorkspace(ctx, createWorkspaceDto.Id, "test-renamed")
+
+		require.Nil(t, err)
+		require.Equal(t, "test-renamed", w.Name)
+
+		w, err = service.RenameWorkspace(ctx, "test-renamed", createWorkspaceDto.Name)
+
+		require.Nil(t, err)
+		require.Equal(t, createWorkspaceDto.Name, w.Name)
+	})
+
+	t.Run("RenameWorkspace fails name validation", func(t *testing.T) {
+		_, err := service.RenameWorkspace(ctx, createWorkspaceDto.Id, "invalid name")
+		require.NotNil(t, err)
+		require.Equal(t, workspaces.ErrInvalidWorkspaceName, err)
+	})
+
+	t.Run("RenameWorkspace fails when workspace not found", func(t *testing.T) {
+		_, err := service.RenameWorkspace(ctx, "invalid-id", "test-renamed")
+		require.NotNil(t, err)
+		require.Equal(t, workspaces.ErrWorkspaceNotFound, err)
+	})
+
+	t.Run("UpdateWorkspaceSettings", func(t *testing.T) {
+		w, err := service.UpdateWorkspaceSettings(ctx, createWorkspaceDto.Id, dto.UpdateWorkspaceSettingsDTO{
+			Group:    "team",
+			Labels:   map[string]string{"env": "dev"},
+			AutoStop: 30,
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, "team", w.Group)
+		require.Equal(t, map[string]string{"env": "dev"}, w.Labels)
+		require.Equal(t, uint32(30), w.AutoStop)
+	})
+
+	t.Run("UpdateWorkspaceSettings fails group name validation", func(t *testing.T) {
+		_, err := service.UpdateWorkspaceSettings(ctx, createWorkspaceDto.Id, dto.UpdateWorkspaceSettingsDTO{
+			Group: "invalid group",
+		})
+		require.NotNil(t, err)
+		require.Equal(t, workspaces.ErrInvalidGroupName, err)
+	})
+
+	t.Run("ShareWorkspace", func(t *testing.T) {
+		apiKeyService.On("ListClientKeys").Return([]*apikey.ApiKey{
+			{Name: "alice", Type: apikey.ApiKeyTypeClient},
+			{Name: "invite", Type: apikey.ApiKeyTypeClient, WorkspaceId: createWorkspaceDto.Id},
+		}, nil)
+
+		w, err := service.ShareWorkspace(ctx, createWorkspaceDto.Id, []string{"alice", "alice"})
+
+		require.Nil(t, err)
+		require.Equal(t, []string{"alice"}, w.SharedWith)
+		require.True(t, w.IsAccessibleBy("alice"))
+	})
+
+	t.Run("ShareWorkspace fails when user not found", func(t *testing.T) {
+		_, err := service.ShareWorkspace(ctx, createWorkspaceDto.Id, []string{"invite"})
+		require.NotNil(t, err)
+		require.True(t, workspaces.IsUserNotFound(err))
+	})
+
+	t.Run("AddProjectRuntimes", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		_, err := service.AddProjectRuntimes(ctx, createWorkspaceDto.Id, projectName, []string{"node@18", "go@1.22"})
+		require.Nil(t, err)
+
+		p, err := service.AddProjectRuntimes(ctx, createWorkspaceDto.Id, projectName, []string{"node@20", "python"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"node@20", "go@1.22", "python@latest"}, p.Runtimes)
+	})
+
+	t.Run("AddProjectRuntimes fails with invalid runtime", func(t *testing.T) {
+		_, err := service.AddProjectRuntimes(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, []string{"node@"})
+		require.NotNil(t, err)
+	})
+
 	t.Run("StartWorkspace", func(t *testing.T) {
 		mockProvisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
-		mockProvisioner.On("StartProject", mock.Anything).Return(nil)
+		mockProvisioner.On("StartProject", mock.Anything).Return(&provider.ProjectCreationTimings{}, nil)
 
 		err := service.StartWorkspace(ctx, createWorkspaceDto.Id)
 
@@ -262,7 +428,7 @@ func TestWorkspaceService(t *testing.T) {
 
 	t.Run("StartProject", func(t *testing.T) {
 		mockProvisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
-		mockProvisioner.On("StartProject", mock.Anything).Return(nil)
+		mockProvisioner.On("StartProject", mock.Anything).Return(&provider.ProjectCreationTimings{}, nil)
 
 		err := service.StartProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
 
@@ -287,17 +453,154 @@ func TestWorkspaceService(t *testing.T) {
 		require.Nil(t, err)
 	})
 
+	t.Run("RecordEvent", func(t *testing.T) {
+		err := service.RecordEvent(createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, events.EventTypeSshConnected)
+		require.Nil(t, err)
+	})
+
+	t.Run("RecordEvent fails for lifecycle events", func(t *testing.T) {
+		err := service.RecordEvent(createWorkspaceDto.Id, "", events.EventTypeRemoved)
+		require.Equal(t, workspaces.ErrInvalidEventType, err)
+	})
+
+	t.Run("ListEvents", func(t *testing.T) {
+		workspaceEvents, err := service.ListEvents(&events.Filter{Workspace: &createWorkspaceDto.Name})
+		require.Nil(t, err)
+
+		eventTypes := []events.EventType{}
+		for _, e := range workspaceEvents {
+			eventTypes = append(eventTypes, e.Type)
+		}
+
+		require.Equal(t, []events.EventType{
+			events.EventTypeCreated,
+			events.EventTypeStarted,
+			events.EventTypeStarted,
+			events.EventTypeStopped,
+			events.EventTypeStopped,
+			events.EventTypeSshConnected,
+		}, eventTypes)
+		require.Equal(t, createWorkspaceDto.Projects[0].Name, workspaceEvents[2].ProjectName)
+	})
+
+	t.Run("CreateSnapshot", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+		mockProvisioner.On("CreateProjectSnapshot", mock.Anything, &target, mock.Anything).Return(nil)
+
+		sn, err := service.CreateSnapshot(ctx, createWorkspaceDto.Id, projectName, "before-upgrade")
+
+		require.Nil(t, err)
+		require.Equal(t, "before-upgrade", sn.Name)
+		require.Equal(t, projectName, sn.ProjectName)
+		require.Equal(t, snapshot.GetSnapshotImageName(createWorkspaceDto.Id, projectName, sn.Id), sn.Image)
+	})
+
+	t.Run("CreateSnapshot fails when snapshot already exists", func(t *testing.T) {
+		_, err := service.CreateSnapshot(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, "before-upgrade")
+		require.Equal(t, workspaces.ErrSnapshotAlreadyExists, err)
+	})
+
+	t.Run("CreateSnapshot fails name validation", func(t *testing.T) {
+		_, err := service.CreateSnapshot(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, "invalid name")
+		require.Equal(t, workspaces.ErrInvalidSnapshotName, err)
+	})
+
+	t.Run("CreateSnapshot fails when project not found", func(t *testing.T) {
+		_, err := service.CreateSnapshot(ctx, createWorkspaceDto.Id, "invalid-project", "")
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+
+	t.Run("ListSnapshots", func(t *testing.T) {
+		snapshots, err := service.ListSnapshots(ctx, createWorkspaceDto.Name)
+
+		require.Nil(t, err)
+		require.Len(t, snapshots, 1)
+		require.Equal(t, "before-upgrade", snapshots[0].Name)
+	})
+
+	t.Run("RestoreSnapshot", func(t *testing.T) {
+		mockProvisioner.On("RestoreProjectSnapshot", mock.Anything, &target, mock.Anything).Return(nil)
+
+		err := service.RestoreSnapshot(ctx, createWorkspaceDto.Id, "before-upgrade")
+
+		require.Nil(t, err)
+	})
+
+	t.Run("RestoreSnapshot fails when snapshot not found", func(t *testing.T) {
+		err := service.RestoreSnapshot(ctx, createWorkspaceDto.Id, "invalid-snapshot")
+		require.Equal(t, workspaces.ErrSnapshotNotFound, err)
+	})
+
+	t.Run("RemoveProject fails for the only project of a workspace", func(t *testing.T) {
+		err := service.RemoveProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, false)
+		require.Equal(t, workspaces.ErrLastProject, err)
+	})
+
+	t.Run("RemoveProject", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		ws.Projects = append(ws.Projects, &project.Project{Name: "project2", WorkspaceId: ws.Id, Target: ws.Target})
+		err = workspaceStore.Save(ws)
+		require.Nil(t, err)
+
+		sn := &snapshot.Snapshot{Id: "project2-snapshot", Name: "project2-snapshot", WorkspaceId: ws.Id, ProjectName: "project2", Image: "project2-snapshot-image"}
+		err = snapshotStore.Save(sn)
+		require.Nil(t, err)
+
+		mockProvisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
+		// Failing to delete the image is logged and the snapshot record is removed regardless
+		mockProvisioner.On("DeleteProjectSnapshot", mock.Anything, &target, sn.Image).Return(errors.New("image in use")).Once()
+		apiKeyService.On("Revoke", fmt.Sprintf("%s/%s", ws.Id, "project2")).Return(nil)
+
+		err = service.RemoveProject(ctx, ws.Id, "project2", false)
+		require.Nil(t, err)
+
+		mockProvisioner.AssertCalled(t, "DeleteProjectSnapshot", mock.Anything, &target, sn.Image)
+
+		projectName := "project2"
+		snapshots, err := snapshotStore.List(&snapshot.Filter{WorkspaceId: &ws.Id, ProjectName: &projectName})
+		require.Nil(t, err)
+		require.Empty(t, snapshots)
+
+		// Snapshots of the other projects are kept
+		snapshots, err = snapshotStore.List(&snapshot.Filter{WorkspaceId: &ws.Id})
+		require.Nil(t, err)
+		require.Len(t, snapshots, 1)
+
+		ws, err = workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Len(t, ws.Projects, 1)
+		require.Equal(t, createWorkspaceDto.Projects[0].Name, ws.Projects[0].Name)
+	})
+
+	t.Run("RemoveProject fails when project not found", func(t *testing.T) {
+		err := service.RemoveProject(ctx, createWorkspaceDto.Id, "invalid-project", false)
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+
 	t.Run("RemoveWorkspace", func(t *testing.T) {
+		snapshots, err := snapshotStore.List(&snapshot.Filter{WorkspaceId: &createWorkspaceDto.Id})
+		require.Nil(t, err)
+		require.Len(t, snapshots, 1)
+		snapshotImage := snapshots[0].Image
+
 		mockProvisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
 		mockProvisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("DeleteProjectSnapshot", mock.Anything, &target, snapshotImage).Return(nil).Once()
 		apiKeyService.On("Revoke", mock.Anything).Return(nil)
 
-		err := service.RemoveWorkspace(ctx, createWorkspaceDto.Id)
+		err = service.RemoveWorkspace(ctx, createWorkspaceDto.Id)
 
 		require.Nil(t, err)
+		mockProvisioner.AssertCalled(t, "DeleteProjectSnapshot", mock.Anything, &target, snapshotImage)
 
 		_, err = service.GetWorkspace(ctx, createWorkspaceDto.Id, true)
 		require.Equal(t, workspaces.ErrWorkspaceNotFound, err)
+
+		snapshots, err = snapshotStore.List(&snapshot.Filter{WorkspaceId: &createWorkspaceDto.Id})
+		require.Nil(t, err)
+		require.Empty(t, snapshots)
 	})
 
 	t.Run("ForceRemoveWorkspace", func(t *testing.T) {
@@ -316,6 +619,34 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, workspaces.ErrWorkspaceNotFound, err)
 	})
 
+	t.Run("RemoveWorkspaceWithProgress", func(t *testing.T) {
+		err := workspaceStore.Save(&workspace.Workspace{Id: createWorkspaceDto.Id, Target: target.Name})
+		require.Nil(t, err)
+
+		mockProvisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
+		apiKeyService.On("Revoke", mock.Anything).Return(nil)
+
+		steps := []workspace.TeardownStep{}
+		err = service.RemoveWorkspaceWithProgress(ctx, createWorkspaceDto.Id, false, func(progress workspace.TeardownProgress) {
+			require.NotEqual(t, workspace.TeardownStatusFailed, progress.Status)
+			if progress.Status == workspace.TeardownStatusCompleted {
+				steps = append(steps, progress.Step)
+			}
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, []workspace.TeardownStep{
+			workspace.TeardownStepDestroyWorkspace,
+			workspace.TeardownStepRevokeApiKeys,
+			workspace.TeardownStepRemoveLogs,
+			workspace.TeardownStepRemoveSnapshots,
+			workspace.TeardownStepDeleteWorkspace,
+		}, steps)
+
+		_, err = service.GetWorkspace(ctx, createWorkspaceDto.Id, true)
+		require.Equal(t, workspaces.ErrWorkspaceNotFound, err)
+	})
+
 	t.Run("SetProjectState", func(t *testing.T) {
 		ws, err := service.CreateWorkspace(ctx, createWorkspaceDto)
 		require.Nil(t, err)
@@ -342,12 +673,115 @@ func TestWorkspaceService(t *testing.T) {
 	})
 }
 
+func TestWorkspaceServiceCreateRollback(t *testing.T) {
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, telemetry.CLIENT_ID_CONTEXT_KEY, "test")
+
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	apiKeyService := mocks.NewMockApiKeyService()
+	gitProviderService := mocks.NewMockGitProviderService()
+	mockProvisioner := mocks.NewMockProvisioner()
+
+	wsLogsDir := t.TempDir()
+	buildLogsDir := t.TempDir()
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:           workspaceStore,
+		SnapshotStore:            t_snapshots.NewInMemorySnapshotStore(),
+		CreationTimingStore:      t_timings.NewInMemoryCreationTimingStore(),
+		EventStore:               t_events.NewInMemoryEventStore(),
+		FailureStore:             t_failures.NewInMemoryFailureStore(),
+		TargetStore:              targetStore,
+		ServerApiUrl:             serverApiUrl,
+		ServerUrl:                serverUrl,
+		ServerVersion:            serverVersion,
+		ContainerRegistryService: mocks.NewMockContainerRegistryService(),
+		ProjectConfigService:     mocks.NewMockProjectConfigService(),
+		DefaultProjectImage:      defaultProjectImage,
+		DefaultProjectUser:       defaultProjectUser,
+		BuilderImage:             defaultProjectImage,
+		ApiKeyService:            apiKeyService,
+		Provisioner:              mockProvisioner,
+		LoggerFactory:            logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir),
+		GitProviderService:       gitProviderService,
+	})
+
+	apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, createWorkspaceDto.Id).Return(createWorkspaceDto.Id, nil)
+	for _, project := range createWorkspaceDto.Projects {
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", createWorkspaceDto.Id, project.Name)).Return(project.Name, nil)
+	}
+	gitProviderService.On("GetLastCommitSha", createWorkspaceDto.Projects[0].Source.Repository).Return("123", nil)
+
+	mockProvisioner.On("CreateWorkspace", mock.Anything, &target).Return(errors.New("registry unavailable"))
+
+	t.Run("CreateWorkspace keeps the partially created workspace", func(t *testing.T) {
+		req := createWorkspaceDto
+		req.KeepPartial = true
+
+		_, err := service.CreateWorkspace(ctx, req)
+		require.EqualError(t, err, "registry unavailable")
+
+		_, err = workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		err = workspaceStore.Delete(&workspace.Workspace{Id: createWorkspaceDto.Id})
+		require.Nil(t, err)
+	})
+
+	t.Run("CreateWorkspace removes the partially created workspace", func(t *testing.T) {
+		mockProvisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
+		apiKeyService.On("Revoke", mock.Anything).Return(nil)
+
+		_, err := service.CreateWorkspace(ctx, createWorkspaceDto)
+		require.EqualError(t, err, "registry unavailable")
+
+		_, err = workspaceStore.Find(createWorkspaceDto.Id)
+		require.NotNil(t, err)
+	})
+
+	t.Run("GetFailureStats", func(t *testing.T) {
+		stats, err := service.GetFailureStats(time.Now().Add(-time.Hour))
+		require.Nil(t, err)
+		require.Equal(t, 2, stats.Total)
+		require.Len(t, stats.Categories, 1)
+		require.Equal(t, failures.CategoryOther, stats.Categories[0].Category)
+		require.Equal(t, map[string]int{target.ProviderInfo.Name: 2}, stats.Categories[0].Providers)
+	})
+
+	t.Run("CreateWorkspace stops retrying when the request is canceled", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		req := createWorkspaceDto
+		req.Retries = workspaces.MaxCreateRetries
+
+		_, err := service.CreateWorkspace(canceledCtx, req)
+		require.ErrorIs(t, err, context.Canceled)
+		require.ErrorContains(t, err, "registry unavailable")
+
+		_, err = workspaceStore.Find(createWorkspaceDto.Id)
+		require.NotNil(t, err)
+	})
+
+	t.Cleanup(func() {
+		apiKeyService.AssertExpectations(t)
+		mockProvisioner.AssertExpectations(t)
+	})
+}
+
 func workspaceEquals(t *testing.T, req dto.CreateWorkspaceDTO, workspace *workspace.Workspace, projectImage string) {
 	t.Helper()
 
 	require.Equal(t, req.Id, workspace.Id)
 	require.Equal(t, req.Name, workspace.Name)
 	require.Equal(t, req.Target, workspace.Target)
+	require.Equal(t, req.Group, workspace.Group)
 
 	for i, project := range workspace.Projects {
 		require.Equal(t, req.Projects[i].Name, project.Name)
@@ -366,6 +800,7 @@ func workspaceDtoEquals(t *testing.T, req dto.CreateWorkspaceDTO, workspace dto.
 	require.Equal(t, req.Id, workspace.Id)
 	require.Equal(t, req.Name, workspace.Name)
 	require.Equal(t, req.Target, workspace.Target)
+	require.Equal(t, req.Group, workspace.Group)
 
 	if verbose {
 		require.Equal(t, workspace.Info.Name, workspaceInfo.Name)
@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+func (s *WorkspaceService) UpdateWorkspaceSettings(ctx context.Context, workspaceId string, req dto.UpdateWorkspaceSettingsDTO) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if req.Group != "" && !isValidWorkspaceName(req.Group) {
+		return nil, ErrInvalidGroupName
+	}
+
+	err = workspace.ValidateLabels(req.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Group = req.Group
+	w.Labels = req.Labels
+	w.AutoStop = req.AutoStop
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// ShareWorkspace replaces the users the workspace is shared with. Users are identified by the names of their client API keys
+func (s *WorkspaceService) ShareWorkspace(ctx context.Context, workspaceId string, users []string) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	keys, err := s.apiKeyService.ListClientKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	sharedWith := []string{}
+	for _, user := range users {
+		if user == w.Owner || slices.Contains(sharedWith, user) {
+			continue
+		}
+
+		// Keys restricted to a workspace, e.g. from invites, don't identify users
+		found := slices.ContainsFunc(keys, func(key *apikey.ApiKey) bool {
+			return key.Name == user && key.WorkspaceId == ""
+		})
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrUserNotFound, user)
+		}
+
+		sharedWith = append(sharedWith, user)
+	}
+
+	w.SharedWith = sharedWith
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/snapshot"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *WorkspaceService) CreateSnapshot(ctx context.Context, workspaceId, projectName, name string) (*snapshot.Snapshot, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	if name == "" {
+		name = fmt.Sprintf("%s-%s", project.Name, time.Now().Format("20060102-150405"))
+	}
+
+	if !isValidWorkspaceName(name) {
+		return nil, ErrInvalidSnapshotName
+	}
+
+	_, err = s.snapshotStore.Find(&snapshot.Filter{
+		IdOrName:    &name,
+		WorkspaceId: &w.Id,
+	})
+	if err == nil {
+		return nil, ErrSnapshotAlreadyExists
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	id := stringid.TruncateID(stringid.GenerateRandomID())
+
+	sn := &snapshot.Snapshot{
+		Id:          id,
+		Name:        name,
+		WorkspaceId: w.Id,
+		ProjectName: project.Name,
+		Image:       snapshot.GetSnapshotImageName(w.Id, project.Name, id),
+		CreatedAt:   time.Now(),
+	}
+
+	err = s.provisioner.CreateProjectSnapshot(project, target, sn.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.snapshotStore.Save(sn)
+	if err != nil {
+		return nil, err
+	}
+
+	return sn, nil
+}
+
+func (s *WorkspaceService) ListSnapshots(ctx context.Context, workspaceId string) ([]*snapshot.Snapshot, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	return s.snapshotStore.List(&snapshot.Filter{WorkspaceId: &w.Id})
+}
+
+func (s *WorkspaceService) RestoreSnapshot(ctx context.Context, workspaceId, snapshotId string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	sn, err := s.snapshotStore.Find(&snapshot.Filter{
+		IdOrName:    &snapshotId,
+		WorkspaceId: &w.Id,
+	})
+	if err != nil {
+		return ErrSnapshotNotFound
+	}
+
+	project, err := w.GetProject(sn.ProjectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return err
+	}
+
+	wasRunning := project.State != nil && project.State.Uptime > 0
+
+	err = s.provisioner.RestoreProjectSnapshot(project, target, sn.Image)
+	if err != nil {
+		return err
+	}
+
+	if project.State != nil {
+		project.State.Uptime = 0
+		project.State.UpdatedAt = time.Now().Format(time.RFC1123)
+	}
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return err
+	}
+
+	if !wasRunning {
+		return nil
+	}
+
+	return s.StartProject(ctx, w.Id, project.Name)
+}
+
+// removeSnapshots deletes the snapshot images of the workspace, or only of the given project, and their records.
+// Images that cannot be deleted are logged and their records are removed regardless
+func (s *WorkspaceService) removeSnapshots(w *workspace.Workspace, target *provider.ProviderTarget, projectName string) {
+	filter := &snapshot.Filter{WorkspaceId: &w.Id}
+	if projectName != "" {
+		filter.ProjectName = &projectName
+	}
+
+	snapshots, err := s.snapshotStore.List(filter)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	for _, sn := range snapshots {
+		project, err := w.GetProject(sn.ProjectName)
+		if err != nil || target == nil {
+			log.Errorf("Failed to delete snapshot image %s: project %s or its target not found", sn.Image, sn.ProjectName)
+		} else {
+			err = s.provisioner.DeleteProjectSnapshot(project, target, sn.Image)
+			if err != nil {
+				log.Errorf("Failed to delete snapshot image %s: %v", sn.Image, err)
+			}
+		}
+
+		err = s.snapshotStore.Delete(sn)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Time a client has to connect to the SSH server of a project after its key was granted access.
+// Clients grant their key again before every connection
+const SSH_ACCESS_TTL = time.Minute
+
+// GrantSshAccess lets the public key, in the authorized_keys format, authenticate to the SSH servers of the workspace
+// projects for a short time. Callers must check that the user can access the workspace
+func (s *WorkspaceService) GrantSshAccess(workspaceId, publicKey string) error {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return ErrInvalidPublicKey
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	s.sshAccessMutex.Lock()
+	defer s.sshAccessMutex.Unlock()
+
+	if s.sshAccess == nil {
+		s.sshAccess = map[string]time.Time{}
+	}
+
+	now := time.Now()
+	for grant, expiresAt := range s.sshAccess {
+		if now.After(expiresAt) {
+			delete(s.sshAccess, grant)
+		}
+	}
+
+	s.sshAccess[sshAccessGrant(w.Id, key)] = now.Add(SSH_ACCESS_TTL)
+
+	return nil
+}
+
+// IsSshAccessGranted returns true if the public key was granted access to the workspace and the grant hasn't expired
+func (s *WorkspaceService) IsSshAccessGranted(workspaceId, publicKey string) bool {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return false
+	}
+
+	s.sshAccessMutex.Lock()
+	defer s.sshAccessMutex.Unlock()
+
+	expiresAt, ok := s.sshAccess[sshAccessGrant(workspaceId, key)]
+	return ok && time.Now().Before(expiresAt)
+}
+
+func sshAccessGrant(workspaceId string, key ssh.PublicKey) string {
+	return workspaceId + "/" + ssh.FingerprintSHA256(key)
+}
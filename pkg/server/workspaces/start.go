@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
@@ -15,6 +16,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
 
@@ -37,7 +39,10 @@ func (s *WorkspaceService) StartWorkspace(ctx context.Context, workspaceId strin
 
 	wsLogWriter := io.MultiWriter(&util.InfoLogWriter{}, workspaceLogger)
 
-	err = s.startWorkspace(ctx, w, target, wsLogWriter)
+	err = s.startWorkspace(ctx, w, target, wsLogWriter, nil)
+	if err == nil {
+		s.recordEvent(w, "", events.EventTypeStarted)
+	}
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -78,10 +83,28 @@ func (s *WorkspaceService) StartProject(ctx context.Context, workspaceId, projec
 	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
 	defer projectLogger.Close()
 
-	return s.startProject(ctx, project, target, projectLogger)
+	err = s.wakeWorkspace(w, target, projectLogger)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.startProject(ctx, project, target, projectLogger)
+	if err != nil {
+		return err
+	}
+
+	s.recordEvent(w, project.Name, events.EventTypeStarted)
+
+	return nil
 }
 
-func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Workspace, target *provider.ProviderTarget, wsLogWriter io.Writer) error {
+// Start timings are recorded for projects that have an entry in projectTimings
+func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Workspace, target *provider.ProviderTarget, wsLogWriter io.Writer, projectTimings creationTimings) error {
+	err := s.wakeWorkspace(ws, target, wsLogWriter)
+	if err != nil {
+		return err
+	}
+
 	wsLogWriter.Write([]byte("Starting workspace\n"))
 
 	ws.EnvVars = workspace.GetWorkspaceEnvVars(ws, workspace.WorkspaceEnvVarParams{
@@ -91,7 +114,7 @@ func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Wor
 		ClientId:      telemetry.ClientId(ctx),
 	}, telemetry.TelemetryEnabled(ctx))
 
-	err := s.provisioner.StartWorkspace(ws, target)
+	err = s.provisioner.StartWorkspace(ws, target)
 	if err != nil {
 		return err
 	}
@@ -100,10 +123,16 @@ func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Wor
 		projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, project.Name, logs.LogSourceServer)
 		defer projectLogger.Close()
 
-		err = s.startProject(ctx, project, target, projectLogger)
+		var providerTimings *provider.ProjectCreationTimings
+		startedAt := time.Now()
+		err = logs.RunStep(projectLogger, logs.StepStartProject, func() error {
+			providerTimings, err = s.startProject(ctx, project, target, projectLogger)
+			return err
+		})
 		if err != nil {
 			return err
 		}
+		projectTimings.addProviderTimings(project.Name, providerTimings, time.Since(startedAt))
 	}
 
 	wsLogWriter.Write([]byte(fmt.Sprintf("Workspace %s started\n", ws.Name)))
@@ -111,7 +140,7 @@ func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Wor
 	return nil
 }
 
-func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) (*provider.ProjectCreationTimings, error) {
 	logWriter.Write([]byte(fmt.Sprintf("Starting project %s\n", p.Name)))
 
 	projectToStart := *p
@@ -124,12 +153,13 @@ func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project,
 
 	cr, err := s.containerRegistryService.FindByImageName(p.Image)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
-		return err
+		return nil, err
 	}
 
-	builderCr, err := s.containerRegistryService.FindByImageName(s.builderImage)
+	builderImage := s.getSettings().BuilderImage
+	builderCr, err := s.containerRegistryService.FindByImageName(builderImage)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
-		return err
+		return nil, err
 	}
 
 	var gc *gitprovider.GitProviderConfig
@@ -137,23 +167,23 @@ func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project,
 	if p.GitProviderConfigId != nil {
 		gc, err = s.gitProviderService.GetConfig(*p.GitProviderConfigId)
 		if err != nil && !gitprovider.IsGitProviderNotFound(err) {
-			return err
+			return nil, err
 		}
 	}
 
-	err = s.provisioner.StartProject(provisioner.ProjectParams{
+	providerTimings, err := s.provisioner.StartProject(provisioner.ProjectParams{
 		Project:                       &projectToStart,
 		Target:                        target,
 		ContainerRegistry:             cr,
 		GitProviderConfig:             gc,
-		BuilderImage:                  s.builderImage,
+		BuilderImage:                  builderImage,
 		BuilderImageContainerRegistry: builderCr,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logWriter.Write([]byte(fmt.Sprintf("Project %s started\n", p.Name)))
 
-	return nil
+	return providerTimings, nil
 }
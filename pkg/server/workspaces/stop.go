@@ -9,6 +9,7 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace/events"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -39,6 +40,9 @@ func (s *WorkspaceService) StopWorkspace(ctx context.Context, workspaceId string
 	if err == nil {
 		err = s.workspaceStore.Save(workspace)
 	}
+	if err == nil {
+		s.recordEvent(workspace, "", events.EventTypeStopped)
+	}
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -86,5 +90,12 @@ func (s *WorkspaceService) StopProject(ctx context.Context, workspaceId, project
 		project.State.UpdatedAt = time.Now().Format(time.RFC1123)
 	}
 
-	return s.workspaceStore.Save(w)
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return err
+	}
+
+	s.recordEvent(w, project.Name, events.EventTypeStopped)
+
+	return nil
 }
@@ -0,0 +1,80 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/timings"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+// creationTimings holds the timings of the projects being created keyed by project name
+type creationTimings map[string]*timings.CreationTiming
+
+func (s *WorkspaceService) ListCreationTimings(filter *timings.Filter) ([]*timings.CreationTiming, error) {
+	return s.creationTimingStore.List(filter)
+}
+
+// queueWait is the time between the creation request and the start of the workspace provisioning and
+// provisioned the time until the workspace was provisioned. Both are shared by all projects of the workspace
+func newCreationTiming(ws *workspace.Workspace, p *project.Project, startedAt time.Time, queueWait, provisioned time.Duration) *timings.CreationTiming {
+	return &timings.CreationTiming{
+		Id:            stringid.TruncateID(stringid.GenerateRandomID()),
+		WorkspaceId:   ws.Id,
+		WorkspaceName: ws.Name,
+		ProjectName:   p.Name,
+		Target:        ws.Target,
+		RepositoryUrl: p.Repository.Url,
+		Image:         getProjectImage(p),
+		Prebuilt:      p.BuildConfig != nil && p.BuildConfig.CachedBuild != nil,
+		QueueWait:     queueWait.Milliseconds(),
+		Total:         provisioned.Milliseconds(),
+		CreatedAt:     startedAt,
+	}
+}
+
+// getProjectImage returns the image the project container is created from, if it is known before the build
+func getProjectImage(p *project.Project) string {
+	if p.BuildConfig == nil {
+		return p.Image
+	}
+	if p.BuildConfig.CachedBuild != nil {
+		return p.BuildConfig.CachedBuild.Image
+	}
+	return ""
+}
+
+// addProviderTimings adds the phases measured by the provider and the time spent on the project's own
+// create or start step, so the total does not include the time spent on other projects of the workspace
+func (t creationTimings) addProviderTimings(projectName string, providerTimings *provider.ProjectCreationTimings, elapsed time.Duration) {
+	timing, ok := t[projectName]
+	if !ok {
+		return
+	}
+
+	timing.Total += elapsed.Milliseconds()
+
+	if providerTimings == nil {
+		return
+	}
+
+	timing.Pull += providerTimings.Pull.Milliseconds()
+	timing.Clone += providerTimings.Clone.Milliseconds()
+	timing.Build += providerTimings.Build.Milliseconds()
+	timing.Hooks += providerTimings.Hooks.Milliseconds()
+}
+
+func (s *WorkspaceService) saveCreationTimings(t creationTimings) {
+	for _, timing := range t {
+		err := s.creationTimingStore.Save(timing)
+		if err != nil {
+			log.Errorf("failed to save creation timings for project %s: %s", timing.ProjectName, err)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+)
+
+// UpgradeProjectConfig rebuilds the project with the latest version of the project config it was created from.
+// The project is recreated from the image of the new version so changes that were not pushed are lost
+func (s *WorkspaceService) UpgradeProjectConfig(ctx context.Context, workspaceId, projectName string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if p.ProjectConfigName == "" {
+		return ErrNoProjectConfig
+	}
+
+	projectConfig, err := s.projectConfigService.Find(&config.ProjectConfigFilter{Name: &p.ProjectConfigName})
+	if err != nil {
+		return err
+	}
+
+	if projectConfig.Version <= p.ProjectConfigVersion {
+		return ErrProjectConfigUpToDate
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return err
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	logWriter := io.MultiWriter(&util.InfoLogWriter{}, projectLogger)
+	logWriter.Write([]byte(fmt.Sprintf("Upgrading project %s to version %d of project config %s\n", p.Name, projectConfig.Version, projectConfig.Name)))
+
+	err = s.provisioner.DestroyProject(p, target)
+	if err != nil {
+		return err
+	}
+
+	p.Image = projectConfig.Image
+	if p.Image == "" {
+		p.Image = s.getSettings().DefaultProjectImage
+	}
+
+	p.User = projectConfig.User
+	if p.User == "" {
+		p.User = s.getSettings().DefaultProjectUser
+	}
+
+	p.BuildConfig = projectConfig.BuildConfig
+	if p.BuildConfig != nil {
+		cachedBuild, err := s.getCachedBuildForProject(p, w.Owner)
+		if err == nil {
+			p.BuildConfig.CachedBuild = cachedBuild
+		}
+	}
+
+	// Environment variables set when the project was created are kept unless the project config overrides them
+	if p.EnvVars == nil {
+		p.EnvVars = map[string]string{}
+	}
+	for key, value := range projectConfig.EnvVars {
+		p.EnvVars[key] = value
+	}
+
+	p.ProjectConfigVersion = projectConfig.Version
+	p.State = nil
+
+	// The project is saved before it is created so that a failed upgrade can be retried with the new version
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.createProject(p, target, logWriter)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.startProject(ctx, p, target, logWriter)
+	if err != nil {
+		return err
+	}
+
+	logWriter.Write([]byte(fmt.Sprintf("Project %s upgraded\n", p.Name)))
+
+	return nil
+}
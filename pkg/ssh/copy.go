@@ -0,0 +1,264 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/sftp"
+)
+
+var ErrIsDirectory = errors.New("source is a directory")
+
+// CopyProgress is called with the number of bytes copied since the previous call
+type CopyProgress func(n int64)
+
+func (c *Client) NewSftpClient() (*sftp.Client, error) {
+	return sftp.NewClient(c.Client)
+}
+
+// Upload copies a local file or directory to the remote path. If the remote path is an existing
+// directory, the source is copied into it. Directories are only copied if recursive is set
+func Upload(client *sftp.Client, localPath, remotePath string, recursive bool, progress CopyProgress) error {
+	return copyPath(localFs{}, localPath, remoteFs{client}, remotePath, recursive, progress)
+}
+
+// Download copies a remote file or directory to the local path. If the local path is an existing
+// directory, the source is copied into it. Directories are only copied if recursive is set
+func Download(client *sftp.Client, remotePath, localPath string, recursive bool, progress CopyProgress) error {
+	return copyPath(remoteFs{client}, remotePath, localFs{}, localPath, recursive, progress)
+}
+
+// GetUploadSize returns the total size of the files that an upload of the local path copies
+func GetUploadSize(localPath string) (int64, error) {
+	return getSize(localFs{}, localPath)
+}
+
+// GetDownloadSize returns the total size of the files that a download of the remote path copies
+func GetDownloadSize(client *sftp.Client, remotePath string) (int64, error) {
+	return getSize(remoteFs{client}, remotePath)
+}
+
+type copyFs interface {
+	Stat(p string) (os.FileInfo, error)
+	ReadDir(p string) ([]os.FileInfo, error)
+	Open(p string) (io.ReadCloser, error)
+	Create(p string, mode os.FileMode) (io.WriteCloser, error)
+	MkdirAll(p string, mode os.FileMode) error
+	Join(elem ...string) string
+	Base(p string) string
+}
+
+func copyPath(srcFs copyFs, src string, dstFs copyFs, dst string, recursive bool, progress CopyProgress) error {
+	info, err := srcFs.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() && !recursive {
+		return fmt.Errorf("%w: %s", ErrIsDirectory, src)
+	}
+
+	dstInfo, err := dstFs.Stat(dst)
+	if err == nil && dstInfo.IsDir() {
+		dst = dstFs.Join(dst, srcFs.Base(src))
+	}
+
+	return copyEntry(srcFs, src, info, dstFs, dst, progress)
+}
+
+func copyEntry(srcFs copyFs, src string, info os.FileInfo, dstFs copyFs, dst string, progress CopyProgress) error {
+	if info.IsDir() {
+		err := dstFs.MkdirAll(dst, info.Mode().Perm())
+		if err != nil {
+			return err
+		}
+
+		entries, err := srcFs.ReadDir(src)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			entryInfo := entry
+			// Follow symlinks the same way the source path itself is followed
+			if entry.Mode()&os.ModeSymlink != 0 {
+				entryInfo, err = srcFs.Stat(srcFs.Join(src, entry.Name()))
+				if err != nil {
+					return err
+				}
+			}
+
+			err = copyEntry(srcFs, srcFs.Join(src, entry.Name()), entryInfo, dstFs, dstFs.Join(dst, entry.Name()), progress)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return copyFile(srcFs, src, dstFs, dst, info.Mode().Perm(), progress)
+}
+
+func copyFile(srcFs copyFs, src string, dstFs copyFs, dst string, mode os.FileMode, progress CopyProgress) error {
+	srcFile, err := srcFs.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	dstFile, err := dstFs.Create(dst, mode)
+	if err != nil {
+		return err
+	}
+
+	var writer io.Writer = dstFile
+	if progress != nil {
+		writer = &progressWriter{writer: dstFile, progress: progress}
+	}
+
+	_, err = io.Copy(writer, srcFile)
+	if err != nil {
+		dstFile.Close()
+		return err
+	}
+
+	return dstFile.Close()
+}
+
+func getSize(fs copyFs, p string) (int64, error) {
+	info, err := fs.Stat(p)
+	if err != nil {
+		return 0, err
+	}
+
+	if !info.IsDir() {
+		return info.Size(), nil
+	}
+
+	entries, err := fs.ReadDir(p)
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, entry := range entries {
+		entrySize, err := getSize(fs, fs.Join(p, entry.Name()))
+		if err != nil {
+			return 0, err
+		}
+		size += entrySize
+	}
+
+	return size, nil
+}
+
+type progressWriter struct {
+	writer   io.Writer
+	progress CopyProgress
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.progress(int64(n))
+	return n, err
+}
+
+type localFs struct{}
+
+func (localFs) Stat(p string) (os.FileInfo, error) {
+	return os.Stat(p)
+}
+
+func (localFs) ReadDir(p string) ([]os.FileInfo, error) {
+	entries, err := os.ReadDir(p)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := []os.FileInfo{}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+func (localFs) Open(p string) (io.ReadCloser, error) {
+	return os.Open(p)
+}
+
+func (localFs) Create(p string, mode os.FileMode) (io.WriteCloser, error) {
+	return os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+}
+
+func (localFs) MkdirAll(p string, mode os.FileMode) error {
+	return os.MkdirAll(p, mode)
+}
+
+func (localFs) Join(elem ...string) string {
+	return filepath.Join(elem...)
+}
+
+func (localFs) Base(p string) string {
+	return filepath.Base(p)
+}
+
+type remoteFs struct {
+	client *sftp.Client
+}
+
+func (fs remoteFs) Stat(p string) (os.FileInfo, error) {
+	return fs.client.Stat(p)
+}
+
+func (fs remoteFs) ReadDir(p string) ([]os.FileInfo, error) {
+	return fs.client.ReadDir(p)
+}
+
+func (fs remoteFs) Open(p string) (io.ReadCloser, error) {
+	return fs.client.Open(p)
+}
+
+func (fs remoteFs) Create(p string, mode os.FileMode) (io.WriteCloser, error) {
+	file, err := fs.client.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return nil, err
+	}
+
+	err = file.Chmod(mode)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (fs remoteFs) MkdirAll(p string, mode os.FileMode) error {
+	err := fs.client.MkdirAll(p)
+	if err != nil {
+		return err
+	}
+
+	return fs.client.Chmod(p, mode)
+}
+
+func (remoteFs) Join(elem ...string) string {
+	return path.Join(elem...)
+}
+
+func (remoteFs) Base(p string) string {
+	return path.Base(p)
+}
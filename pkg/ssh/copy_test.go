@@ -0,0 +1,111 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/require"
+)
+
+// newSftpClient returns a client of an SFTP server that serves the local filesystem
+func newSftpClient(t *testing.T) *sftp.Client {
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	server, err := sftp.NewServer(struct {
+		io.Reader
+		io.WriteCloser
+	}{serverReader, serverWriter})
+	require.Nil(t, err)
+	go server.Serve() // nolint:errcheck
+
+	client, err := sftp.NewClientPipe(clientReader, clientWriter)
+	require.Nil(t, err)
+
+	t.Cleanup(func() {
+		// Closing the server ends the stream that the client is reading from
+		server.Close()
+		client.Close()
+	})
+
+	return client
+}
+
+func writeFile(t *testing.T, p, content string) {
+	require.Nil(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.Nil(t, os.WriteFile(p, []byte(content), 0640))
+}
+
+func readFile(t *testing.T, p string) string {
+	content, err := os.ReadFile(p)
+	require.Nil(t, err)
+	return string(content)
+}
+
+func TestUpload(t *testing.T) {
+	client := newSftpClient(t)
+	src := t.TempDir()
+	dst := t.TempDir()
+
+	writeFile(t, filepath.Join(src, "project", "main.go"), "package main")
+	writeFile(t, filepath.Join(src, "project", "pkg", "util.go"), "package pkg")
+
+	t.Run("Upload file to new path", func(t *testing.T) {
+		var copied int64
+		err := ssh.Upload(client, filepath.Join(src, "project", "main.go"), filepath.Join(dst, "renamed.go"), false, func(n int64) {
+			copied += n
+		})
+		require.Nil(t, err)
+		require.Equal(t, "package main", readFile(t, filepath.Join(dst, "renamed.go")))
+		require.Equal(t, int64(len("package main")), copied)
+
+		info, err := os.Stat(filepath.Join(dst, "renamed.go"))
+		require.Nil(t, err)
+		require.Equal(t, os.FileMode(0640), info.Mode().Perm())
+	})
+
+	t.Run("Upload file into existing directory", func(t *testing.T) {
+		err := ssh.Upload(client, filepath.Join(src, "project", "main.go"), dst, false, nil)
+		require.Nil(t, err)
+		require.Equal(t, "package main", readFile(t, filepath.Join(dst, "main.go")))
+	})
+
+	t.Run("Upload directory requires recursive", func(t *testing.T) {
+		err := ssh.Upload(client, filepath.Join(src, "project"), dst, false, nil)
+		require.ErrorIs(t, err, ssh.ErrIsDirectory)
+	})
+
+	t.Run("Upload directory recursively", func(t *testing.T) {
+		size, err := ssh.GetUploadSize(filepath.Join(src, "project"))
+		require.Nil(t, err)
+		require.Equal(t, int64(len("package main")+len("package pkg")), size)
+
+		err = ssh.Upload(client, filepath.Join(src, "project"), dst, true, nil)
+		require.Nil(t, err)
+		require.Equal(t, "package main", readFile(t, filepath.Join(dst, "project", "main.go")))
+		require.Equal(t, "package pkg", readFile(t, filepath.Join(dst, "project", "pkg", "util.go")))
+	})
+}
+
+func TestDownload(t *testing.T) {
+	client := newSftpClient(t)
+	src := t.TempDir()
+	dst := t.TempDir()
+
+	writeFile(t, filepath.Join(src, "project", "README.md"), "# Project")
+
+	size, err := ssh.GetDownloadSize(client, filepath.Join(src, "project"))
+	require.Nil(t, err)
+	require.Equal(t, int64(len("# Project")), size)
+
+	err = ssh.Download(client, filepath.Join(src, "project"), filepath.Join(dst, "copy"), true, nil)
+	require.Nil(t, err)
+	require.Equal(t, "# Project", readFile(t, filepath.Join(dst, "copy", "README.md")))
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"io"
+	"net"
+	"os/exec"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// NewClientFromProxyCommand starts the proxy command and connects to the SSH server
+// through its stdin and stdout, the same way a ProxyCommand is used in an SSH config.
+// The host key is verified with the callback for the hostname on port 22 and the client authenticates with the signer
+func NewClientFromProxyCommand(cmd *exec.Cmd, username, hostname string, hostKeyCallback ssh.HostKeyCallback, signer ssh.Signer) (*Client, error) {
+	conn, err := newCommandConn(cmd, net.JoinHostPort(hostname, "22"))
+	if err != nil {
+		return nil, err
+	}
+
+	c, chans, reqs, err := ssh.NewClientConn(conn, conn.RemoteAddr().String(), &ssh.ClientConfig{
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         time.Second * 30,
+		User:            username,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+	})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &Client{
+		Client: ssh.NewClient(c, chans, reqs),
+	}, nil
+}
+
+type commandConn struct {
+	cmd     *exec.Cmd
+	address string
+	stdin   io.WriteCloser
+	stdout  io.ReadCloser
+}
+
+func newCommandConn(cmd *exec.Cmd, address string) (net.Conn, error) {
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	return &commandConn{cmd: cmd, address: address, stdin: stdin, stdout: stdout}, nil
+}
+
+func (c *commandConn) Read(b []byte) (int, error) {
+	return c.stdout.Read(b)
+}
+
+func (c *commandConn) Write(b []byte) (int, error) {
+	return c.stdin.Write(b)
+}
+
+func (c *commandConn) Close() error {
+	c.stdin.Close()
+	if c.cmd.Process != nil {
+		c.cmd.Process.Kill() // nolint:errcheck
+	}
+	return c.cmd.Wait()
+}
+
+func (c *commandConn) LocalAddr() net.Addr {
+	return commandAddr{}
+}
+
+// RemoteAddr returns the address the proxy command connects to so that host keys can be matched against it
+func (c *commandConn) RemoteAddr() net.Addr {
+	return commandAddr{name: c.address}
+}
+
+// Deadlines are not supported by pipes and are ignored
+func (c *commandConn) SetDeadline(t time.Time) error      { return nil }
+func (c *commandConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *commandConn) SetWriteDeadline(t time.Time) error { return nil }
+
+type commandAddr struct {
+	name string
+}
+
+func (a commandAddr) Network() string {
+	return "proxy-command"
+}
+
+func (a commandAddr) String() string {
+	return a.name
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"os"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/term"
+)
+
+// Shell runs the command in a new session attached to the standard streams. If the command is empty,
+// an interactive shell is started on a PTY sized to the local terminal
+func (c *Client) Shell(command string, forwardAgent bool) error {
+	session, err := c.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	if forwardAgent {
+		err = c.forwardAgent(session)
+		if err != nil {
+			return err
+		}
+	}
+
+	session.Stdin = os.Stdin
+	session.Stdout = os.Stdout
+	session.Stderr = os.Stderr
+
+	if command != "" {
+		return session.Run(command)
+	}
+
+	fd := int(os.Stdin.Fd())
+	if term.IsTerminal(fd) {
+		width, height, err := term.GetSize(int(os.Stdout.Fd()))
+		if err != nil {
+			width, height = 80, 24
+		}
+
+		termType := os.Getenv("TERM")
+		if termType == "" {
+			termType = "xterm-256color"
+		}
+
+		err = session.RequestPty(termType, height, width, ssh.TerminalModes{
+			ssh.ECHO:          1,
+			ssh.TTY_OP_ISPEED: 14400,
+			ssh.TTY_OP_OSPEED: 14400,
+		})
+		if err != nil {
+			return err
+		}
+
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		defer term.Restore(fd, state) // nolint:errcheck
+
+		stop := watchWindowSize(session, int(os.Stdout.Fd()))
+		defer stop()
+	}
+
+	err = session.Shell()
+	if err != nil {
+		return err
+	}
+
+	return session.Wait()
+}
+
+// forwardAgent forwards the local SSH agent to the session if one is running
+func (c *Client) forwardAgent(session *ssh.Session) error {
+	socket := os.Getenv("SSH_AUTH_SOCK")
+	if socket == "" {
+		return nil
+	}
+
+	err := agent.ForwardToRemote(c.Client, socket)
+	if err != nil {
+		return err
+	}
+
+	return agent.RequestAgentForwarding(session)
+}
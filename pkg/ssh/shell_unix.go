@@ -0,0 +1,36 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/term"
+)
+
+// watchWindowSize resizes the remote PTY whenever the local terminal is resized
+func watchWindowSize(session *ssh.Session, fd int) func() {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGWINCH)
+
+	go func() {
+		for range sigCh {
+			width, height, err := term.GetSize(fd)
+			if err != nil {
+				continue
+			}
+			session.WindowChange(height, width) // nolint:errcheck
+		}
+	}()
+
+	return func() {
+		signal.Stop(sigCh)
+		close(sigCh)
+	}
+}
@@ -0,0 +1,43 @@
+//go:build windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/term"
+)
+
+// watchWindowSize resizes the remote PTY whenever the local terminal is resized.
+// Windows has no resize signal so the size is polled
+func watchWindowSize(session *ssh.Session, fd int) func() {
+	done := make(chan struct{})
+
+	go func() {
+		width, height, _ := term.GetSize(fd)
+		ticker := time.NewTicker(500 * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				w, h, err := term.GetSize(fd)
+				if err != nil || (w == width && h == height) {
+					continue
+				}
+				width, height = w, h
+				session.WindowChange(height, width) // nolint:errcheck
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+	}
+}
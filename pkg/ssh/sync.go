@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/filesync"
+	"golang.org/x/crypto/ssh"
+)
+
+// SyncSession is a sync server running in the project over an SSH session
+type SyncSession struct {
+	*filesync.Client
+	session *ssh.Session
+	stderr  bytes.Buffer
+}
+
+// StartSync starts a sync server for the remote directory with the Daytona CLI in the project
+func (c *Client) StartSync(remoteDir string) (*SyncSession, error) {
+	session, err := c.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	syncSession := &SyncSession{
+		Client:  filesync.NewClient(stdout, stdin),
+		session: session,
+	}
+	session.Stderr = &syncSession.stderr
+
+	err = session.Start(fmt.Sprintf("daytona sync-server '%s'", strings.ReplaceAll(remoteDir, "'", `'\''`)))
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	return syncSession, nil
+}
+
+// Stderr returns the output of the sync server, used to explain why the connection was lost
+func (s *SyncSession) Stderr() string {
+	return strings.TrimSpace(s.stderr.String())
+}
+
+func (s *SyncSession) Close() error {
+	return s.session.Close()
+}
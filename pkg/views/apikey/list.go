@@ -36,7 +36,7 @@ func getRowFromRowData(apiKey apiclient.ApiKey) []string {
 	rowData := RowData{"", ""}
 
 	rowData.Name = apiKey.Name
-	rowData.Type = string(apiKey.Type)
+	rowData.Type = getApiKeyType(apiKey)
 
 	row := []string{
 		views.NameStyle.Render(rowData.Name),
@@ -52,7 +52,7 @@ func renderUnstyledList(apiKeyList []apiclient.ApiKey) {
 	for _, apiKey := range apiKeyList {
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key Name: "), apiKey.Name) + "\n\n"
 
-		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key Type: "), apiKey.Type) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key Type: "), getApiKeyType(apiKey)) + "\n\n"
 
 		if apiKey.Name != apiKeyList[len(apiKeyList)-1].Name {
 			output += views.SeparatorString + "\n\n"
@@ -61,3 +61,10 @@ func renderUnstyledList(apiKeyList []apiclient.ApiKey) {
 
 	fmt.Println(output)
 }
+
+func getApiKeyType(apiKey apiclient.ApiKey) string {
+	if apiKey.ReadOnly != nil && *apiKey.ReadOnly {
+		return fmt.Sprintf("%s (read-only)", apiKey.Type)
+	}
+	return string(apiKey.Type)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"golang.org/x/term"
 )
 
@@ -38,9 +39,9 @@ var DefaultHorizontalMargin = 1
 
 var TUITableMinimumWidth = 80
 
-var CheckmarkSymbol = lipgloss.NewStyle().Foreground(lipgloss.Color("42")).SetString("✓")
+var CheckmarkSymbol lipgloss.Style
 
-var SeparatorString = lipgloss.NewStyle().Foreground(LightGray).Render("===")
+var SeparatorString string
 
 var (
 	minimumWidth     = 40
@@ -49,15 +50,15 @@ var (
 )
 
 func RenderMainTitle(title string) {
-	fmt.Println(lipgloss.NewStyle().Foreground(Green).Bold(true).Padding(1, 0, 1, 0).Render(title))
+	fmt.Println(render(lipgloss.NewStyle().Foreground(Green).Bold(true).Padding(1, 0, 1, 0), title))
 }
 
 func RenderTip(message string) {
-	fmt.Println(lipgloss.NewStyle().Padding(0, 0, 1, 1).Render(message))
+	fmt.Println(render(lipgloss.NewStyle().Padding(0, 0, 1, 1), message))
 }
 
 func RenderInfoMessage(message string) {
-	fmt.Println(lipgloss.NewStyle().Padding(1, 0, 1, 1).Render(message))
+	fmt.Println(render(lipgloss.NewStyle().Padding(1, 0, 1, 1), message))
 }
 
 func RenderViewBuildLogsMessage(buildId string) {
@@ -65,15 +66,15 @@ func RenderViewBuildLogsMessage(buildId string) {
 }
 
 func RenderCreationInfoMessage(message string) {
-	fmt.Println(lipgloss.NewStyle().Foreground(Gray).Padding(1, 0, 1, 1).Render(message))
+	fmt.Println(render(lipgloss.NewStyle().Foreground(Gray).Padding(1, 0, 1, 1), message))
 }
 
 func RenderListLine(message string) {
-	fmt.Println(lipgloss.NewStyle().Padding(0, 0, 1, 1).Render(message))
+	fmt.Println(render(lipgloss.NewStyle().Padding(0, 0, 1, 1), message))
 }
 
 func RenderInfoMessageBold(message string) {
-	fmt.Println(lipgloss.NewStyle().Bold(true).Padding(1, 0, 1, 1).Render(message))
+	fmt.Println(render(lipgloss.NewStyle().Bold(true).Padding(1, 0, 1, 1), message))
 }
 
 func RenderBorderedMessage(message string) {
@@ -84,23 +85,23 @@ func GetListFooter(profileName string, padding *Padding) string {
 	style := lipgloss.NewStyle().Bold(true)
 	style = style.Padding(padding.Top, padding.Right, padding.Bottom, padding.Left)
 
-	return style.Render("\n\nActive profile: " + profileName)
+	return render(style, "\n\n"+i18n.T("Active profile: %s", profileName))
 }
 
 func GetStyledMainTitle(content string) string {
-	return lipgloss.NewStyle().Foreground(Dark).Background(Light).Padding(0, 1).Render(content)
+	return render(lipgloss.NewStyle().Foreground(Dark).Background(Light).Padding(0, 1), content)
 }
 
 func GetInfoMessage(message string) string {
-	return lipgloss.NewStyle().Padding(1, 0, 1, 1).Render(message)
+	return render(lipgloss.NewStyle().Padding(1, 0, 1, 1), message)
 }
 
 func GetBoldedInfoMessage(message string) string {
-	return lipgloss.NewStyle().Bold(true).Padding(1, 0, 1, 1).Render(message)
+	return render(lipgloss.NewStyle().Bold(true).Padding(1, 0, 1, 1), message)
 }
 
 func GetListLine(message string) string {
-	return lipgloss.NewStyle().Padding(0, 0, 1, 1).Render(message)
+	return render(lipgloss.NewStyle().Padding(0, 0, 1, 1), message)
 }
 
 func GetPropertyKey(key string) string {
@@ -109,19 +110,18 @@ func GetPropertyKey(key string) string {
 
 func GetBranchNameLabel(branch string) string {
 	if branch == "" {
-		return "Default branch"
+		return i18n.T("Default branch")
 	}
 	return branch
 }
 
 func GetBorderedMessage(message string) string {
-	return lipgloss.
+	return render(lipgloss.
 		NewStyle().
 		Margin(1, 0).
 		Padding(1, 2).
 		BorderForeground(LightGray).
-		Border(lipgloss.RoundedBorder()).
-		Render(message)
+		Border(lipgloss.RoundedBorder()), message)
 }
 
 func GetContainerBreakpointWidth(terminalWidth int) int {
@@ -137,6 +137,11 @@ func GetContainerBreakpointWidth(terminalWidth int) int {
 }
 
 func RenderContainerLayout(output string) {
+	if IsPlainOutput() {
+		fmt.Println(output)
+		return
+	}
+
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil {
 		fmt.Println(DocStyle.Render("Error: Unable to get terminal size"))
@@ -19,13 +19,27 @@ func Render(cfg *config.Config, showApiKeysFlag bool) {
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Default IDE: "), cfg.DefaultIdeId) + "\n\n"
 
-	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Telemetry Enabled: "), strconv.FormatBool(cfg.TelemetryEnabled)) + "\n\n"
+	activeProfile, err := cfg.GetActiveProfile()
+	if err == nil && activeProfile.DefaultIdeId != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Active Profile IDE: "), activeProfile.DefaultIdeId) + "\n\n"
+	}
+
+	if err == nil && activeProfile.DefaultTarget != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Active Profile Target: "), activeProfile.DefaultTarget) + "\n\n"
+	}
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Telemetry Enabled: "), strconv.FormatBool(config.TelemetryEnabled())) + "\n\n"
+
+	activeProfileId := cfg.ActiveProfileId
+	if err == nil {
+		activeProfileId = activeProfile.Id
+	}
 
-	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Active Profile: "), cfg.ActiveProfileId) + "\n\n"
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Active Profile: "), activeProfileId) + "\n\n"
 
 	output += fmt.Sprintf("%s %d", views.GetPropertyKey("Profiles: "), len(cfg.Profiles)) + "\n\n"
 
-	profiles, err := profile.ListProfiles(cfg.Profiles, cfg.ActiveProfileId, showApiKeysFlag)
+	profiles, err := profile.ListProfiles(cfg.Profiles, activeProfileId, showApiKeysFlag)
 	if err != nil {
 		fmt.Print(output)
 		return
@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+type CheckStatus string
+
+const (
+	CheckPassed  CheckStatus = "passed"
+	CheckWarning CheckStatus = "warning"
+	CheckFailed  CheckStatus = "failed"
+)
+
+type Check struct {
+	Name    string      `json:"name"`
+	Status  CheckStatus `json:"status"`
+	Message string      `json:"message"`
+	// What the user can do to resolve the warning or failure
+	Fix string `json:"fix,omitempty"`
+}
+
+func Render(checks []Check) {
+	warningStyle := lipgloss.NewStyle().Foreground(views.Orange)
+	failedStyle := lipgloss.NewStyle().Foreground(views.Red)
+	fixStyle := lipgloss.NewStyle().Foreground(views.Gray)
+
+	output := ""
+	passed := 0
+
+	for _, check := range checks {
+		switch check.Status {
+		case CheckPassed:
+			passed++
+			output += fmt.Sprintf("%s %s %s\n", views.CheckmarkSymbol, views.GetPropertyKey(check.Name+":"), check.Message)
+		case CheckWarning:
+			output += fmt.Sprintf("%s %s %s\n", warningStyle.Render("!"), views.GetPropertyKey(check.Name+":"), check.Message)
+		default:
+			output += fmt.Sprintf("%s %s %s\n", failedStyle.Render("✗"), views.GetPropertyKey(check.Name+":"), check.Message)
+		}
+
+		if check.Status != CheckPassed && check.Fix != "" {
+			output += fixStyle.Render(fmt.Sprintf("  Fix: %s", check.Fix)) + "\n"
+		}
+	}
+
+	output += fmt.Sprintf("\n%d of %d checks passed", passed, len(checks))
+
+	views.RenderContainerLayout(views.GetInfoMessage(output))
+}
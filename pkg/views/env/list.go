@@ -40,7 +40,7 @@ func List(envVars map[string]string) {
 		return
 	}
 
-	re := lipgloss.NewRenderer(os.Stdout)
+	re := views.NewRenderer()
 
 	headers := []string{"Key", "Value"}
 
@@ -242,6 +242,7 @@ func ProviderRequiresApiUrl(gitProviderId string) bool {
 		"github-enterprise-server",
 		"gitlab-self-managed",
 		"gitea",
+		"forgejo",
 		"bitbucket-server",
 		"azure-devops",
 		"aws-codecommit",
@@ -261,6 +262,8 @@ func getApiUrlDescription(gitProviderId string) string {
 		return "For example: https://github-host"
 	} else if gitProviderId == "gitea" {
 		return "For example: http://gitea-host"
+	} else if gitProviderId == "forgejo" {
+		return "For example: https://forgejo-host"
 	} else if gitProviderId == "gitness" {
 		return "For example: http://gitness-host/api/v1/"
 	} else if gitProviderId == "azure-devops" {
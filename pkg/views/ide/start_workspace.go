@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 )
 
@@ -17,7 +18,7 @@ func RunStartWorkspaceForm(workspaceName string) bool {
 	form := huh.NewForm(
 		huh.NewGroup(
 			huh.NewConfirm().
-				Title(fmt.Sprintf("The workspace %s is stopped, would you like to start it?", workspaceName)).
+				Title(i18n.T("The workspace %s is stopped, would you like to start it?", workspaceName)).
 				Value(&confirmCheck),
 		),
 	).WithTheme(views.GetCustomTheme())
@@ -28,7 +29,7 @@ func RunStartWorkspaceForm(workspaceName string) bool {
 	}
 
 	if !confirmCheck {
-		fmt.Println("Operation canceled.")
+		fmt.Println(i18n.T("Operation canceled."))
 	}
 
 	return confirmCheck
@@ -22,7 +22,6 @@ import (
 var ModelInstance model
 
 var (
-	titleStyle      = lipgloss.NewStyle().Foreground(views.Green).Bold(true)
 	paginationStyle = list.DefaultStyles().PaginationStyle.PaddingLeft(4)
 	helpStyle       = list.DefaultStyles().HelpStyle.PaddingLeft(4).PaddingBottom(1)
 	quitTextStyle   = lipgloss.NewStyle().Margin(1, 0, 2, 4)
@@ -132,7 +131,7 @@ func Render(ideList []config.Ide, choiceChan chan<- string) {
 	l.Title = views.GetStyledMainTitle("Choose Your Default IDE")
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(false)
-	l.Styles.Title = titleStyle
+	l.Styles.Title = lipgloss.NewStyle().Foreground(views.Green).Bold(true)
 	l.Styles.PaginationStyle = paginationStyle
 	l.Styles.HelpStyle = helpStyle
 
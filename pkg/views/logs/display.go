@@ -29,6 +29,11 @@ func DisplayLogs(logEntriesChan <-chan logs.LogEntry, index int) {
 }
 
 func DisplayLogEntry(logEntry logs.LogEntry, index int) {
+	// Steps are only rendered by progress views
+	if logEntry.Step != nil {
+		return
+	}
+
 	line := logEntry.Msg
 
 	prefixColor := getPrefixColor(index, logEntry.Source)
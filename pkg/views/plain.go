@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package views
+
+import (
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/term"
+)
+
+var plainOutput bool
+
+// EnablePlainOutput disables colors, padding and borders in all views. Tables are rendered as tab-separated
+// lines and spinners are replaced with a single line so that the output can be captured in logs
+func EnablePlainOutput() {
+	plainOutput = true
+	DisableColors()
+}
+
+// IsPlainOutput returns true if plain output was enabled or if the standard output is not a terminal,
+// e.g. when the output is piped to another command
+func IsPlainOutput() bool {
+	return plainOutput || !term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// render applies the style unless plain output is enabled
+func render(style lipgloss.Style, message string) string {
+	if IsPlainOutput() {
+		return message
+	}
+	return style.Render(message)
+}
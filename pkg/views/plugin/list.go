@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/plugin"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/util"
+)
+
+// ListPlugins renders the plugins and warns about the plugins that can not be invoked.
+// overridden contains the names of the plugins that are overridden by built-in commands
+func ListPlugins(plugins []plugin.Plugin, overridden []string) {
+	data := [][]string{}
+
+	for _, p := range plugins {
+		data = append(data, []string{
+			views.NameStyle.Render(p.Name),
+			views.DefaultRowDataStyle.Render(p.Path),
+		})
+	}
+
+	table := util.GetTableView(data, []string{
+		"Name", "Path",
+	}, nil, func() {
+		renderUnstyledList(plugins)
+	})
+
+	fmt.Println(table)
+
+	for _, name := range overridden {
+		views.RenderInfoMessage(fmt.Sprintf("Plugin '%s' is overridden by the built-in 'daytona %s' command and will never be invoked", name, name))
+	}
+
+	for _, p := range plugins {
+		for _, path := range p.Shadows {
+			views.RenderInfoMessage(fmt.Sprintf("%s is shadowed by %s, which comes first on PATH, and will never be invoked", path, p.Path))
+		}
+	}
+}
+
+func renderUnstyledList(plugins []plugin.Plugin) {
+	output := "\n"
+
+	for i, p := range plugins {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Plugin Name: "), p.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Plugin Path: "), p.Path) + "\n\n"
+
+		if i < len(plugins)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
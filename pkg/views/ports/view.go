@@ -0,0 +1,138 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+type Action string
+
+const (
+	ActionNone    Action = ""
+	ActionForward Action = "forward"
+	ActionOpen    Action = "open"
+	ActionRefresh Action = "refresh"
+)
+
+const helpLine = "↑/↓ select • f forward • o open in browser • r refresh • q quit"
+
+// ProjectPort is a port that is listening inside a project
+type ProjectPort struct {
+	ProjectName string `json:"projectName"`
+	Port        int32  `json:"port"`
+}
+
+func Render(workspaceName string, projectPorts []ProjectPort) {
+	if len(projectPorts) == 0 {
+		views_util.NotifyEmptyPortList(true)
+		return
+	}
+
+	fmt.Println(getPortsView(workspaceName, projectPorts, -1))
+}
+
+type model struct {
+	workspaceName string
+	projectPorts  []ProjectPort
+	cursor        int
+	action        Action
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			m.cursor = max(m.cursor-1, 0)
+		case "down", "j":
+			m.cursor = min(m.cursor+1, max(len(m.projectPorts)-1, 0))
+		case "f", "enter":
+			if len(m.projectPorts) > 0 {
+				m.action = ActionForward
+				return m, tea.Quit
+			}
+		case "o":
+			if len(m.projectPorts) > 0 {
+				m.action = ActionOpen
+				return m, tea.Quit
+			}
+		case "r":
+			m.action = ActionRefresh
+			return m, tea.Quit
+		case "q", "esc", "ctrl+c":
+			m.action = ActionNone
+			return m, tea.Quit
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) View() string {
+	return getPortsView(m.workspaceName, m.projectPorts, m.cursor) + "\n\n" + lipgloss.NewStyle().PaddingLeft(1).Foreground(views.Gray).Render(helpLine) + "\n"
+}
+
+// GetActionFromPrompt renders the listening ports and waits for a key press that selects a port or takes an action.
+// For the forward and open actions, the selected port is returned as well
+func GetActionFromPrompt(workspaceName string, projectPorts []ProjectPort) (Action, *ProjectPort, error) {
+	p, err := tea.NewProgram(model{workspaceName: workspaceName, projectPorts: projectPorts}).Run()
+	if err != nil {
+		return ActionNone, nil, err
+	}
+
+	m, ok := p.(model)
+	if !ok {
+		return ActionNone, nil, nil
+	}
+
+	if m.action != ActionForward && m.action != ActionOpen {
+		return m.action, nil, nil
+	}
+
+	return m.action, &m.projectPorts[m.cursor], nil
+}
+
+func getPortsView(workspaceName string, projectPorts []ProjectPort, cursor int) string {
+	output := views.GetStyledMainTitle(fmt.Sprintf("Ports of %s", workspaceName)) + "\n"
+
+	data := [][]string{}
+	for i, projectPort := range projectPorts {
+		nameStyle := views.NameStyle
+		if i == cursor {
+			nameStyle = views.ActiveStyle
+		}
+
+		data = append(data, []string{
+			nameStyle.Render(projectPort.ProjectName),
+			views.DefaultRowDataStyle.Render(fmt.Sprint(projectPort.Port)),
+		})
+	}
+
+	t := table.New().
+		Headers("Project", "Port").
+		Rows(data...).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		BorderHeader(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle.PaddingBottom(0)
+		})
+
+	output += "\n" + t.String()
+
+	return lipgloss.NewStyle().PaddingLeft(1).Render(output)
+}
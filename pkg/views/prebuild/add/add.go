@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -77,7 +78,7 @@ func PrebuildCreationView(prebuildAddView *PrebuildAddView, editing bool) {
 		prebuildAddView.RunBuildOnAdd = true
 
 		formFields = append(formFields, huh.NewConfirm().
-			Title("Run the build once on submit?").
+			Title(i18n.T("Run the build once on submit?")).
 			Value(&prebuildAddView.RunBuildOnAdd))
 	}
 
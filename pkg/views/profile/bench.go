@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+type benchMetric struct {
+	name   string
+	unit   string
+	value  func(config.BenchResult) float64
+	higher bool
+}
+
+var benchMetrics = []benchMetric{
+	{name: "Latency", unit: "ms", value: func(r config.BenchResult) float64 { return r.LatencyMs }},
+	{name: "Latency (max)", unit: "ms", value: func(r config.BenchResult) float64 { return r.LatencyMaxMs }},
+	{name: "Download", unit: "Mbit/s", value: func(r config.BenchResult) float64 { return r.DownloadMbps }, higher: true},
+	{name: "Upload", unit: "Mbit/s", value: func(r config.BenchResult) float64 { return r.UploadMbps }, higher: true},
+	{name: "Stream", unit: "Mbit/s", value: func(r config.BenchResult) float64 { return r.StreamMbps }, higher: true},
+	{name: "SSH", unit: "Mbit/s", value: func(r config.BenchResult) float64 { return r.SshMbps }, higher: true},
+}
+
+// RenderBenchResult shows the result next to the median of the previous results so that slow links stand out
+func RenderBenchResult(result config.BenchResult, previous []config.BenchResult) {
+	data := [][]string{}
+	for _, metric := range benchMetrics {
+		value := metric.value(result)
+		if value == 0 {
+			continue
+		}
+
+		median := getMedian(previous, metric.value)
+
+		change, worse := formatBenchChange(value, median, metric.higher)
+		changeStyle := views.DefaultRowDataStyle
+		if worse {
+			changeStyle = views.InactiveStyle
+		}
+
+		data = append(data, []string{
+			views.NameStyle.Render(metric.name),
+			views.DefaultRowDataStyle.Render(formatBenchValue(value, metric.unit)),
+			views.DefaultRowDataStyle.Render(formatBenchValue(median, metric.unit)),
+			changeStyle.Render(change),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{"Metric", "Result", fmt.Sprintf("Median of %d previous", len(previous)), "Change"}, nil, func() {
+		for _, row := range data {
+			fmt.Printf("%s %s\n", views.GetPropertyKey(row[0]+":"), row[1])
+		}
+	})
+
+	fmt.Println(table)
+}
+
+// RenderBenchHistory shows the results of the previous benchmarks, oldest first
+func RenderBenchHistory(history []config.BenchResult) {
+	data := [][]string{}
+	for _, result := range history {
+		row := []string{views.NameStyle.Render(result.Time.Local().Format("2006-01-02 15:04"))}
+		for _, metric := range benchMetrics {
+			row = append(row, views.DefaultRowDataStyle.Render(formatBenchValue(metric.value(result), metric.unit)))
+		}
+		data = append(data, row)
+	}
+
+	headers := []string{"Time"}
+	for _, metric := range benchMetrics {
+		headers = append(headers, metric.name)
+	}
+
+	table := views_util.GetTableView(data, headers, nil, func() {
+		for _, row := range data {
+			fmt.Println(strings.Join(row, "  "))
+		}
+	})
+
+	fmt.Println(table)
+}
+
+func formatBenchValue(value float64, unit string) string {
+	if value == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.1f %s", value, unit)
+}
+
+// formatBenchChange returns the change compared to the median and whether it is more than 20% for the worse
+func formatBenchChange(value, median float64, higherIsBetter bool) (string, bool) {
+	if median == 0 {
+		return "-", false
+	}
+
+	change := (value - median) / median * 100
+	worse := (higherIsBetter && change < -20) || (!higherIsBetter && change > 20)
+
+	return fmt.Sprintf("%+.0f%%", change), worse
+}
+
+func getMedian(results []config.BenchResult, value func(config.BenchResult) float64) float64 {
+	values := []float64{}
+	for _, result := range results {
+		if v := value(result); v != 0 {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) == 0 {
+		return 0
+	}
+
+	slices.Sort(values)
+	return values[len(values)/2]
+}
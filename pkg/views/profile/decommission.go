@@ -0,0 +1,93 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+type DecommissionItemKind string
+
+const (
+	DecommissionItemWorkspace DecommissionItemKind = "Workspace"
+	DecommissionItemPrebuild  DecommissionItemKind = "Prebuild"
+)
+
+// DecommissionItem is a server-side resource that can be destroyed when decommissioning a profile
+type DecommissionItem struct {
+	Kind        DecommissionItemKind
+	Id          string
+	Name        string
+	Description string
+	// Name of the resource the item belongs to, e.g. the project config of a prebuild
+	Parent string
+}
+
+func (i DecommissionItem) String() string {
+	label := fmt.Sprintf("%s: %s", i.Kind, i.Name)
+	if i.Description != "" {
+		label += fmt.Sprintf(" (%s)", i.Description)
+	}
+	return label
+}
+
+// SelectDecommissionItems lets the user pick the resources to destroy. All resources are selected by default
+func SelectDecommissionItems(profileName string, items []DecommissionItem) ([]DecommissionItem, error) {
+	options := []huh.Option[int]{}
+	selected := []int{}
+	for i, item := range items {
+		options = append(options, huh.NewOption(item.String(), i).Selected(true))
+		selected = append(selected, i)
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewMultiSelect[int]().
+				Title(fmt.Sprintf("Select the resources of profile %s to destroy", profileName)).
+				Description("Project volumes are destroyed together with their workspace").
+				Options(options...).
+				Value(&selected),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err := form.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []DecommissionItem{}
+	for _, i := range selected {
+		result = append(result, items[i])
+	}
+
+	return result, nil
+}
+
+func ConfirmDecommission(profileName string, items []DecommissionItem) (bool, error) {
+	description := i18n.T("The profile, its SSH config entries and pinned encryption keys will be removed from this machine.")
+	if len(items) > 0 {
+		description = i18n.T("%d resource(s) will be destroyed on the server. %s", len(items), description)
+	}
+
+	confirmed := false
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(i18n.T("Decommission profile %s?", profileName)).
+				Description(description).
+				Value(&confirmed),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err := form.Run()
+	if err != nil {
+		return false, err
+	}
+
+	return confirmed, nil
+}
@@ -0,0 +1,241 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/constants"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/huh"
+)
+
+const (
+	AuthMethodSshConfig    = "ssh-config"
+	AuthMethodIdentityFile = "identity-file"
+)
+
+type ProfileEditView struct {
+	ProfileName  string
+	ApiHostname  string
+	ApiPort      string
+	ApiKey       string
+	JumpHostname string
+	JumpPort     string
+	JumpUser     string
+	AuthMethod   string
+	IdentityFile string
+
+	apiUrl *url.URL
+}
+
+// NewProfileEditView splits the server API URL and the jump host of the profile into the fields of the edit form
+func NewProfileEditView(profile config.Profile) (*ProfileEditView, error) {
+	apiUrl, err := url.Parse(profile.Api.Url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server API URL %s: %w", profile.Api.Url, err)
+	}
+
+	view := &ProfileEditView{
+		ProfileName:  profile.Name,
+		ApiHostname:  apiUrl.Hostname(),
+		ApiPort:      apiUrl.Port(),
+		ApiKey:       profile.Api.Key,
+		AuthMethod:   AuthMethodSshConfig,
+		IdentityFile: profile.ProxyJumpIdentityFile,
+		apiUrl:       apiUrl,
+	}
+
+	if profile.ProxyJumpIdentityFile != "" {
+		view.AuthMethod = AuthMethodIdentityFile
+	}
+
+	if profile.ProxyJump != "" {
+		jumpHost := profile.ProxyJump
+		if i := strings.LastIndex(jumpHost, "@"); i != -1 {
+			view.JumpUser = jumpHost[:i]
+			jumpHost = jumpHost[i+1:]
+		}
+
+		view.JumpHostname = jumpHost
+		if host, port, err := net.SplitHostPort(jumpHost); err == nil {
+			view.JumpHostname = host
+			view.JumpPort = port
+		}
+	}
+
+	return view, nil
+}
+
+// ApiUrl returns the server API URL with the edited hostname and port. The scheme and path are kept
+func (v *ProfileEditView) ApiUrl() string {
+	apiUrl := *v.apiUrl
+	apiUrl.Host = v.ApiHostname
+	if v.ApiPort != "" {
+		apiUrl.Host = net.JoinHostPort(v.ApiHostname, v.ApiPort)
+	}
+
+	return apiUrl.String()
+}
+
+// ProxyJump returns the jump host in the [user@]host[:port] format or an empty string if no jump host is set
+func (v *ProfileEditView) ProxyJump() string {
+	if v.JumpHostname == "" {
+		return ""
+	}
+
+	jumpHost := v.JumpHostname
+	if v.JumpPort != "" {
+		jumpHost = net.JoinHostPort(v.JumpHostname, v.JumpPort)
+	}
+
+	if v.JumpUser != "" {
+		jumpHost = fmt.Sprintf("%s@%s", v.JumpUser, jumpHost)
+	}
+
+	return jumpHost
+}
+
+// ProxyJumpIdentityFile returns the identity file if it is used to authenticate with the jump host
+func (v *ProfileEditView) ProxyJumpIdentityFile() string {
+	if v.JumpHostname == "" || v.AuthMethod != AuthMethodIdentityFile {
+		return ""
+	}
+
+	return v.IdentityFile
+}
+
+func ProfileEditFormView(c *config.Config, profileId string, editView *ProfileEditView) error {
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Profile name").
+				Value(&editView.ProfileName).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("profile name can not be blank")
+					}
+					if match, _ := regexp.MatchString(constants.PROFILE_NAME_VALIDATION, str); !match {
+						return errors.New("only letters, digits, dashes, underscores and periods are allowed")
+					}
+
+					for _, profile := range c.Profiles {
+						if profile.Id != profileId && strings.EqualFold(profile.Name, str) {
+							return errors.New("profile name already exists")
+						}
+					}
+
+					return nil
+				}),
+			huh.NewInput().
+				Title("Server hostname").
+				Value(&editView.ApiHostname).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("server hostname can not be blank")
+					}
+					return nil
+				}),
+			huh.NewInput().
+				Title("Server port").
+				Description("Leave empty to use the default port of the server API URL scheme").
+				Value(&editView.ApiPort).
+				Validate(validatePort),
+			huh.NewInput().
+				Title("Server API Key").
+				EchoMode(huh.EchoModePassword).
+				Value(&editView.ApiKey).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("server API Key can not be blank")
+					}
+					return nil
+				}),
+		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Jump host").
+				Description("Bastion host used to reach the server. Leave empty to connect directly").
+				Value(&editView.JumpHostname),
+			huh.NewInput().
+				Title("Jump host user").
+				Description("Leave empty to use the user from your SSH config").
+				Value(&editView.JumpUser),
+			huh.NewInput().
+				Title("Jump host port").
+				Value(&editView.JumpPort).
+				Validate(validatePort),
+			huh.NewSelect[string]().
+				Title("Jump host authentication").
+				Options(
+					huh.NewOption("SSH config and agent", AuthMethodSshConfig),
+					huh.NewOption("Identity file", AuthMethodIdentityFile),
+				).
+				Value(&editView.AuthMethod),
+		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Identity file").
+				Description("Private key used to authenticate with the jump host").
+				Value(&editView.IdentityFile).
+				Validate(validateIdentityFile),
+		).WithHideFunc(func() bool {
+			return editView.JumpHostname == "" || editView.AuthMethod != AuthMethodIdentityFile
+		}),
+	).WithTheme(views.GetCustomTheme())
+
+	return form.Run()
+}
+
+func validatePort(str string) error {
+	if str == "" {
+		return nil
+	}
+
+	port, err := strconv.Atoi(str)
+	if err != nil || port < 1 || port > 65535 {
+		return errors.New("port must be a number between 1 and 65535")
+	}
+
+	return nil
+}
+
+func validateIdentityFile(str string) error {
+	if str == "" {
+		return errors.New("identity file can not be blank")
+	}
+
+	path := str
+	if strings.HasPrefix(path, "~/") {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return err
+		}
+		path = filepath.Join(homeDir, path[2:])
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.New("identity file does not exist")
+		}
+		return err
+	}
+
+	if info.IsDir() {
+		return errors.New("identity file can not be a directory")
+	}
+
+	return nil
+}
@@ -20,6 +20,17 @@ type rowData struct {
 }
 
 func ListProfiles(profileList []config.Profile, activeProfileId string, showApiKeysFlag bool) (string, error) {
+	headers, data := GetTable(profileList, activeProfileId, showApiKeysFlag)
+
+	table := views_util.GetTableView(data, headers, nil, func() {
+		renderUnstyledList(profileList, activeProfileId, showApiKeysFlag)
+	})
+
+	return table + "\n", nil
+}
+
+// GetTable returns the headers and rows of the profile list table, e.g. for the csv format
+func GetTable(profileList []config.Profile, activeProfileId string, showApiKeysFlag bool) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Status", "API URL"}
 	if showApiKeysFlag {
 		headers = append(headers, "API Key")
@@ -31,11 +42,7 @@ func ListProfiles(profileList []config.Profile, activeProfileId string, showApiK
 		data = append(data, getRowFromData(&profile, activeProfileId, showApiKeysFlag))
 	}
 
-	table := views_util.GetTableView(data, headers, nil, func() {
-		renderUnstyledList(profileList, activeProfileId, showApiKeysFlag)
-	})
-
-	return table + "\n", nil
+	return headers, data
 }
 
 func getRowFromData(profile *config.Profile, activeProfileId string, showApiKeysFlag bool) []string {
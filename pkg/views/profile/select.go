@@ -4,6 +4,8 @@
 package profile
 
 import (
+	"slices"
+
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/daytonaio/daytona/cmd/daytona/config"
@@ -14,8 +16,12 @@ var NewProfileId = "+ New Profile"
 
 func GetProfileFromPrompt(profiles []config.Profile, activeProfileName string, withNewProfile bool) (*config.Profile, error) {
 	var items []list.Item
+	cursorIndex := 0
 
-	for _, p := range profiles {
+	for i, p := range profiles {
+		if p.Id == activeProfileName {
+			cursorIndex = i
+		}
 		items = append(items, item{
 			profile: p,
 		})
@@ -31,8 +37,12 @@ func GetProfileFromPrompt(profiles []config.Profile, activeProfileName string, w
 		})
 	}
 
-	l := views.GetStyledSelectList(items)
-	m := model{list: l}
+	l := views.GetStyledSelectList(items, views.SelectionListOptions{CursorIndex: cursorIndex, IsPaginationDisabled: true})
+	if withNewProfile {
+		l.Filter = filterWithNewProfile
+	}
+
+	m := model{list: l, startFiltering: len(profiles) > 1}
 	m.list.Title = views.GetStyledMainTitle("Choose a Profile")
 
 	p, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
@@ -46,3 +56,15 @@ func GetProfileFromPrompt(profiles []config.Profile, activeProfileName string, w
 
 	return nil, nil
 }
+
+// filterWithNewProfile fuzzy matches the profiles and keeps the new profile entry in the results
+func filterWithNewProfile(term string, targets []string) []list.Rank {
+	ranks := list.DefaultFilter(term, targets)
+
+	newProfileIdx := len(targets) - 1
+	if newProfileIdx < 0 || slices.ContainsFunc(ranks, func(r list.Rank) bool { return r.Index == newProfileIdx }) {
+		return ranks
+	}
+
+	return append(ranks, list.Rank{Index: newProfileIdx})
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 )
 
@@ -14,8 +15,8 @@ func SwitchToDefaultPrompt(profileSwitchCheck *bool) {
 	form := huh.NewForm(
 		huh.NewGroup(
 			huh.NewConfirm().
-				Title("Switch to the default Daytona profile?").
-				Description("This action is allowed only on the default (local) profile.").
+				Title(i18n.T("Switch to the default Daytona profile?")).
+				Description(i18n.T("This action is allowed only on the default (local) profile.")).
 				Value(profileSwitchCheck),
 		),
 	).WithTheme(views.GetCustomTheme())
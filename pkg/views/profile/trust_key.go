@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"errors"
+	"os"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/views"
+	"golang.org/x/term"
+)
+
+// TrustKeyPrompt asks the user to verify the fingerprint of a project key received through the Daytona Server
+// before it is pinned. The Daytona Agent logs the fingerprints of its keys when it starts, so they can be compared
+// without trusting the server, e.g. through the provider console
+func TrustKeyPrompt(keyName, projectName, fingerprint string) (bool, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return false, errors.New(i18n.T("the fingerprint can only be verified in an interactive terminal"))
+	}
+
+	trusted := false
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(i18n.T("Trust the %s of project %s?", keyName, projectName)).
+				Description(i18n.T("Fingerprint: %s\nCompare it with the fingerprint logged by the Daytona Agent of the project when it started.", fingerprint)).
+				Value(&trusted),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err := form.Run()
+	if err != nil {
+		return false, err
+	}
+
+	return trusted, nil
+}
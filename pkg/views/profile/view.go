@@ -24,14 +24,21 @@ func (i item) Description() string {
 	}
 	return ""
 }
-func (i item) FilterValue() string { return i.profile.Name }
+func (i item) FilterValue() string { return i.profile.Name + " " + i.profile.Api.Url }
 
 type model struct {
 	list   list.Model
 	choice *config.Profile
+	// Shows the filter input when the prompt opens so that typing filters the profiles right away
+	startFiltering bool
 }
 
 func (m model) Init() tea.Cmd {
+	if m.startFiltering {
+		return func() tea.Msg {
+			return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}}
+		}
+	}
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 )
 
@@ -14,8 +15,8 @@ func ConfirmPrompt(confirmCheck *bool) {
 	form := huh.NewForm(
 		huh.NewGroup(
 			huh.NewConfirm().
-				Title("Are you sure you want to clear all the data from Daytona?").
-				Description("This action is irreversible.").
+				Title(i18n.T("Are you sure you want to clear all the data from Daytona?")).
+				Description(i18n.T("This action is irreversible.")).
 				Value(confirmCheck),
 		),
 	).WithTheme(views.GetCustomTheme())
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 )
 
@@ -14,8 +15,8 @@ func DefaultProfileNoticePrompt(confirmCheck *bool) {
 	form := huh.NewForm(
 		huh.NewGroup(
 			huh.NewConfirm().
-				Title("Non-default profile detected").
-				Description("Purging Daytona will only remove local data. Remote server data will be kept in tact. Do you wish to continue?").
+				Title(i18n.T("Non-default profile detected")).
+				Description(i18n.T("Purging Daytona will only remove local data. Remote server data will be kept in tact. Do you wish to continue?")).
 				Value(confirmCheck),
 		),
 	).WithTheme(views.GetCustomTheme())
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 )
 
@@ -14,10 +15,10 @@ func ServerStoppedPrompt(serverStoppedCheck *bool) {
 	form := huh.NewForm(
 		huh.NewGroup(
 			huh.NewConfirm().
-				Title("Please stop the Daytona Server before continuing").
-				Description("Purging all data requires the Daytona Server to be stopped.").
-				Affirmative("Continue").
-				Negative("Abort").
+				Title(i18n.T("Please stop the Daytona Server before continuing")).
+				Description(i18n.T("Purging all data requires the Daytona Server to be stopped.")).
+				Affirmative(i18n.T("Continue")).
+				Negative(i18n.T("Abort")).
 				Value(serverStoppedCheck),
 		),
 	).WithTheme(views.GetCustomTheme())
@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func RenderEgressUsage(usages []apiclient.EgressUsage) {
+	if len(usages) == 0 {
+		views_util.NotifyEmptyEgressUsageList(true)
+		return
+	}
+
+	var total int64
+	data := [][]string{}
+	for _, u := range usages {
+		total += int64(u.Bytes)
+		data = append(data, []string{u.WorkspaceName, u.ProjectName, u.Month, views_util.FormatBytes(int64(u.Bytes))})
+	}
+
+	output := views.GetStyledMainTitle(i18n.T("Egress usage")) + "\n\n"
+	output += views.GetPropertyKey(i18n.T("Total sent: ")) + views.NameStyle.Render(views_util.FormatBytes(total)) + "\n"
+
+	fmt.Println(lipgloss.NewStyle().PaddingLeft(1).Render(output))
+
+	styledData := [][]string{}
+	for _, row := range data {
+		styledRow := []string{views.NameStyle.Render(row[0])}
+		for _, cell := range row[1:] {
+			styledRow = append(styledRow, views.DefaultRowDataStyle.Render(cell))
+		}
+		styledData = append(styledData, styledRow)
+	}
+
+	table := views_util.GetTableView(styledData, []string{
+		"Workspace", "Project", "Month", "Sent",
+	}, nil, func() {
+		renderUnstyledEgressUsage(data)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledEgressUsage(data [][]string) {
+	output := "\n"
+
+	for _, row := range data {
+		output += fmt.Sprintf("%s %s sent in %s", views.GetPropertyKey(row[0]+"/"+row[1]+":"), row[3], row[2]) + "\n\n"
+	}
+
+	fmt.Println(output)
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+type phase struct {
+	name     string
+	duration func(t apiclient.CreationTiming) int64
+}
+
+var phases = []phase{
+	{"Queue wait", func(t apiclient.CreationTiming) int64 { return int64(t.QueueWait) }},
+	{"Pull", func(t apiclient.CreationTiming) int64 { return int64(t.Pull) }},
+	{"Clone", func(t apiclient.CreationTiming) int64 { return int64(t.Clone) }},
+	{"Build", func(t apiclient.CreationTiming) int64 { return int64(t.Build) }},
+	{"Hooks", func(t apiclient.CreationTiming) int64 { return int64(t.Hooks) }},
+	{"Other", getOther},
+}
+
+func RenderTimings(creationTimings []apiclient.CreationTiming) {
+	if len(creationTimings) == 0 {
+		views_util.NotifyEmptyCreationTimingList(true)
+		return
+	}
+
+	var totalDuration int64
+	for _, t := range creationTimings {
+		totalDuration += int64(t.Total)
+	}
+
+	data := [][]string{}
+	for _, p := range phases {
+		data = append(data, getPhaseRow(p.name, creationTimings, p.duration, totalDuration))
+	}
+	data = append(data, getPhaseRow("Total", creationTimings, func(t apiclient.CreationTiming) int64 { return int64(t.Total) }, totalDuration))
+
+	output := views.GetStyledMainTitle(i18n.T("Creation timings")) + "\n\n"
+	output += views.GetPropertyKey(i18n.T("Project creations: ")) + views.NameStyle.Render(fmt.Sprintf("%d", len(creationTimings))) + "\n"
+
+	fmt.Println(lipgloss.NewStyle().PaddingLeft(1).Render(output))
+
+	styledData := [][]string{}
+	for _, row := range data {
+		styledRow := []string{views.NameStyle.Render(row[0])}
+		for _, cell := range row[1:] {
+			styledRow = append(styledRow, views.DefaultRowDataStyle.Render(cell))
+		}
+		styledData = append(styledData, styledRow)
+	}
+
+	table := views_util.GetTableView(styledData, []string{
+		"Phase", "Avg", "P50", "P95", "Max", "Share",
+	}, nil, func() {
+		renderUnstyledTimings(data)
+	})
+
+	fmt.Println(table)
+}
+
+// RenderCreationBreakdown prints a one line summary of where the creation time of each project went
+func RenderCreationBreakdown(creationTimings []apiclient.CreationTiming) {
+	if len(creationTimings) == 0 {
+		return
+	}
+
+	output := ""
+	for _, t := range creationTimings {
+		parts := []string{}
+		for _, p := range phases {
+			d := p.duration(t)
+			if d <= 0 {
+				continue
+			}
+			parts = append(parts, fmt.Sprintf("%s %s", strings.ToLower(p.name), formatDuration(d)))
+		}
+
+		line := i18n.T("Project %s created in %s", t.ProjectName, formatDuration(int64(t.Total)))
+		if len(parts) > 0 {
+			line += fmt.Sprintf(" (%s)", strings.Join(parts, " · "))
+		}
+		output += line + "\n"
+	}
+
+	views.RenderCreationInfoMessage(strings.TrimSuffix(output, "\n"))
+}
+
+func getPhaseRow(name string, creationTimings []apiclient.CreationTiming, duration func(t apiclient.CreationTiming) int64, totalDuration int64) []string {
+	durations := []int64{}
+	var sum int64
+	for _, t := range creationTimings {
+		d := duration(t)
+		durations = append(durations, d)
+		sum += d
+	}
+
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+
+	share := "-"
+	if totalDuration > 0 {
+		share = fmt.Sprintf("%.0f%%", float64(sum)/float64(totalDuration)*100)
+	}
+
+	return []string{
+		name,
+		formatDuration(sum / int64(len(durations))),
+		formatDuration(getPercentile(durations, 50)),
+		formatDuration(getPercentile(durations, 95)),
+		formatDuration(durations[len(durations)-1]),
+		share,
+	}
+}
+
+func renderUnstyledTimings(data [][]string) {
+	output := "\n"
+
+	for _, row := range data {
+		output += fmt.Sprintf("%s avg %s, p50 %s, p95 %s, max %s, share %s", views.GetPropertyKey(row[0]+":"), row[1], row[2], row[3], row[4], row[5]) + "\n\n"
+	}
+
+	fmt.Println(output)
+}
+
+// Nearest-rank percentile of sorted durations
+func getPercentile(sorted []int64, percentile int) int64 {
+	rank := (percentile*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// Time not covered by any of the measured phases, e.g. container creation
+func getOther(t apiclient.CreationTiming) int64 {
+	other := int64(t.Total) - int64(t.QueueWait) - int64(t.Pull) - int64(t.Clone) - int64(t.Build) - int64(t.Hooks)
+	if other < 0 {
+		return 0
+	}
+
+	return other
+}
+
+func formatDuration(ms int64) string {
+	d := time.Duration(ms) * time.Millisecond
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+
+	return d.Round(100 * time.Millisecond).String()
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+type RowData struct {
+	Name      string
+	Scope     string
+	InjectAs  string
+	UpdatedAt string
+}
+
+// ListSecrets renders the secret list. workspaceNames maps workspace IDs to names for display
+func ListSecrets(secretList []apiclient.Secret, workspaceNames map[string]string) {
+	if len(secretList) == 0 {
+		views_util.NotifyEmptySecretList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, s := range secretList {
+		rowData := getRowData(s, workspaceNames)
+		data = append(data, []string{
+			views.NameStyle.Render(rowData.Name + views_util.AdditionalPropertyPadding),
+			views.DefaultRowDataStyle.Render(rowData.Scope),
+			views.DefaultRowDataStyle.Render(rowData.InjectAs),
+			views.DefaultRowDataStyle.Render(rowData.UpdatedAt),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "Scope", "Injected As", "Updated",
+	}, nil, func() {
+		renderUnstyledList(secretList, workspaceNames)
+	})
+
+	fmt.Println(table)
+}
+
+func getRowData(s apiclient.Secret, workspaceNames map[string]string) RowData {
+	rowData := RowData{
+		Name:      s.Name,
+		Scope:     "Profile",
+		InjectAs:  "Environment variable",
+		UpdatedAt: util.FormatTimestamp(s.UpdatedAt),
+	}
+
+	if s.WorkspaceId != nil && *s.WorkspaceId != "" {
+		rowData.Scope = *s.WorkspaceId
+		if name, ok := workspaceNames[*s.WorkspaceId]; ok {
+			rowData.Scope = name
+		}
+		if s.GetProjectName() != "" {
+			rowData.Scope += "/" + s.GetProjectName()
+		}
+	}
+
+	if s.FilePath != nil && *s.FilePath != "" {
+		rowData.InjectAs = *s.FilePath
+		if s.GetFileMode() != "" {
+			rowData.InjectAs += fmt.Sprintf(" (%s)", s.GetFileMode())
+		}
+	}
+
+	return rowData
+}
+
+func renderUnstyledList(secretList []apiclient.Secret, workspaceNames map[string]string) {
+	output := "\n"
+
+	for i, s := range secretList {
+		rowData := getRowData(s, workspaceNames)
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), rowData.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Scope: "), rowData.Scope) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Injected As: "), rowData.InjectAs) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Updated: "), rowData.UpdatedAt) + "\n\n"
+
+		if i < len(secretList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
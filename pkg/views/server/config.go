@@ -61,6 +61,24 @@ func RenderConfig(config *server.Config) {
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Build Image Namespace: "), config.BuildImageNamespace) + "\n\n"
 
+	if config.DockerContext != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Docker Context: "), config.DockerContext) + "\n\n"
+	} else if config.DockerHost != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Docker Host: "), config.DockerHost) + "\n\n"
+	}
+
+	if config.EgressQuotaMb > 0 {
+		output += fmt.Sprintf("%s %d MB", views.GetPropertyKey("Egress Quota: "), config.EgressQuotaMb) + "\n\n"
+	}
+
+	if config.HibernateAfterMinutes > 0 {
+		output += fmt.Sprintf("%s %d minutes", views.GetPropertyKey("Hibernate After: "), config.HibernateAfterMinutes) + "\n\n"
+	}
+
+	if config.HibernationRetentionDays > 0 {
+		output += fmt.Sprintf("%s %d days", views.GetPropertyKey("Hibernation Retention: "), config.HibernationRetentionDays) + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Providers Dir: "), config.ProvidersDir) + "\n\n"
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Registry URL: "), config.RegistryUrl) + "\n\n"
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func RenderConfigChanges(changes []apiclient.ConfigChange) {
+	if len(changes) == 0 {
+		views.RenderInfoMessage("Server configuration reloaded. No changes found")
+		return
+	}
+
+	data := [][]string{}
+	restartRequired := false
+	for _, change := range changes {
+		applied := "yes"
+		if change.RequiresRestart {
+			applied = "after restart"
+			restartRequired = true
+		}
+
+		data = append(data, []string{
+			views.NameStyle.Render(change.Field),
+			views.DefaultRowDataStyle.Render(change.OldValue),
+			views.DefaultRowDataStyle.Render(change.NewValue),
+			views.DefaultRowDataStyle.Render(applied),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{"Field", "Old Value", "New Value", "Applied"}, nil, func() {
+		renderUnstyledConfigChanges(changes)
+	})
+
+	fmt.Println(table)
+
+	if restartRequired {
+		views.RenderInfoMessage("Server configuration reloaded. Restart the server with 'daytona server restart' to apply all changes")
+	} else {
+		views.RenderInfoMessage("Server configuration reloaded")
+	}
+}
+
+func renderUnstyledConfigChanges(changes []apiclient.ConfigChange) {
+	for _, change := range changes {
+		fmt.Printf("%s %s\n", views.GetPropertyKey("Field:"), change.Field)
+		fmt.Printf("%s %s\n", views.GetPropertyKey("Old Value:"), change.OldValue)
+		fmt.Printf("%s %s\n", views.GetPropertyKey("New Value:"), change.NewValue)
+		fmt.Printf("%s %t\n\n", views.GetPropertyKey("Requires Restart:"), change.RequiresRestart)
+	}
+}
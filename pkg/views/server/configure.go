@@ -57,6 +57,9 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 	logFileMaxSize := strconv.Itoa(int(m.config.LogFile.MaxSize))
 	logFileMaxBackups := strconv.Itoa(int(m.config.LogFile.MaxBackups))
 	logFileMaxAge := strconv.Itoa(int(m.config.LogFile.MaxAge))
+	egressQuotaMb := strconv.Itoa(int(m.config.GetEgressQuotaMb()))
+	hibernateAfterMinutes := strconv.Itoa(int(m.config.GetHibernateAfterMinutes()))
+	hibernationRetentionDays := strconv.Itoa(int(m.config.GetHibernationRetentionDays()))
 
 	return huh.NewForm(
 		huh.NewGroup(
@@ -100,6 +103,62 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 				Description("Namespace to be used when tagging and pushing build images").
 				Value(m.config.BuildImageNamespace),
 		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Docker Host").
+				Description("Docker daemon to run builds and containers on, e.g. tcp://10.0.0.5:2376. Leave empty to use the local daemon").
+				Value(m.config.DockerHost),
+			huh.NewInput().
+				Title("Docker Context").
+				Description("Docker CLI context to use instead of the Docker host").
+				Value(m.config.DockerContext).
+				Validate(func(s string) error {
+					if s != "" && m.config.DockerHost != nil && *m.config.DockerHost != "" {
+						return errors.New("only one of Docker host and Docker context can be set")
+					}
+					return nil
+				}),
+		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Egress Quota").
+				Description("Outbound traffic in MB a workspace may send per month before it is stopped. Use 0 to disable the quota").
+				Value(&egressQuotaMb).
+				Validate(func(s string) error {
+					quota, err := strconv.Atoi(s)
+					if err != nil || quota < 0 {
+						return errors.New("quota must be a non-negative number")
+					}
+					m.config.SetEgressQuotaMb(int32(quota))
+					return nil
+				}),
+		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Hibernate After").
+				Description("Minutes a workspace has to be stopped before its project data is archived to disk. Use 0 to disable hibernation").
+				Value(&hibernateAfterMinutes).
+				Validate(func(s string) error {
+					minutes, err := strconv.Atoi(s)
+					if err != nil || minutes < 0 {
+						return errors.New("minutes must be a non-negative number")
+					}
+					m.config.SetHibernateAfterMinutes(int32(minutes))
+					return nil
+				}),
+			huh.NewInput().
+				Title("Hibernation Retention").
+				Description("Days a hibernated workspace is kept before it is removed. Use 0 to keep hibernated workspaces").
+				Value(&hibernationRetentionDays).
+				Validate(func(s string) error {
+					days, err := strconv.Atoi(s)
+					if err != nil || days < 0 {
+						return errors.New("days must be a non-negative number")
+					}
+					m.config.SetHibernationRetentionDays(int32(days))
+					return nil
+				}),
+		),
 		huh.NewGroup(
 			huh.NewInput().
 				Title("Local Builder Registry Port").
@@ -7,16 +7,17 @@ import (
 	"log"
 
 	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 )
 
 func ConfirmPrompt(confirmCheck *bool) {
-	views.RenderInfoMessageBold("This commands registers and starts the Daytona Server daemon.\nFor running the Server in the current terminal session use 'daytona serve'.")
+	views.RenderInfoMessageBold(i18n.T("This commands registers and starts the Daytona Server daemon.\nFor running the Server in the current terminal session use 'daytona serve'."))
 
 	form := huh.NewForm(
 		huh.NewGroup(
 			huh.NewConfirm().
-				Title(" Do you want to continue?").
+				Title(i18n.T(" Do you want to continue?")).
 				Value(confirmCheck),
 		),
 	).WithTheme(views.GetCustomTheme())
@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func RenderFailureStats(stats *apiclient.FailureStats) {
+	since := stats.Since
+	if t, err := time.Parse(time.RFC3339, stats.Since); err == nil {
+		since = t.Format(time.DateTime)
+	}
+
+	if stats.Total == 0 {
+		views.RenderInfoMessage(fmt.Sprintf("No provisioning failures since %s", since))
+		return
+	}
+
+	data := [][]string{}
+	for _, category := range stats.Categories {
+		data = append(data, []string{
+			views.NameStyle.Render(string(category.Category)),
+			views.DefaultRowDataStyle.Render(fmt.Sprintf("%d (%d%%)", category.Count, category.Count*100/stats.Total)),
+			views.DefaultRowDataStyle.Render(formatProviderCounts(category.Providers)),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(category.LastOccurred)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{"Category", "Failures", "Providers", "Last Occurred"}, nil, func() {
+		renderUnstyledFailureStats(stats)
+	})
+
+	fmt.Println(table)
+	views.RenderInfoMessage(fmt.Sprintf("%d provisioning failures since %s", stats.Total, since))
+}
+
+func renderUnstyledFailureStats(stats *apiclient.FailureStats) {
+	for _, category := range stats.Categories {
+		fmt.Printf("%s %s\n", views.GetPropertyKey("Category:"), category.Category)
+		fmt.Printf("%s %d\n", views.GetPropertyKey("Failures:"), category.Count)
+		fmt.Printf("%s %s\n", views.GetPropertyKey("Providers:"), formatProviderCounts(category.Providers))
+		fmt.Printf("%s %s\n\n", views.GetPropertyKey("Last Occurred:"), util.FormatTimestamp(category.LastOccurred))
+	}
+}
+
+func formatProviderCounts(providers map[string]int32) string {
+	counts := []string{}
+	for _, provider := range slices.Sorted(maps.Keys(providers)) {
+		counts = append(counts, fmt.Sprintf("%s: %d", provider, providers[provider]))
+	}
+	return strings.Join(counts, ", ")
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+// HostFeature is a workspace feature that depends on a capability of the server host
+type HostFeature struct {
+	Name      string
+	Supported bool
+	// Why the feature is not supported
+	Reason string
+}
+
+func GetHostFeatures(hostInfo *apiclient.HostInfo) []HostFeature {
+	features := []HostFeature{
+		{Name: "Checkpoint and restore (pause/resume)", Supported: hostInfo.Criu, Reason: "CRIU is not installed"},
+		{Name: "Docker in Docker", Supported: hostInfo.UserNamespaces && hostInfo.CgroupsV2, Reason: "requires unprivileged user namespaces and cgroups v2"},
+		{Name: "GPU workspaces", Supported: hostInfo.Gpus > 0, Reason: "no NVIDIA GPUs found"},
+		{Name: "Nested virtualization", Supported: hostInfo.NestedVirtualization, Reason: "/dev/kvm is not available"},
+	}
+
+	if hostInfo.Os != "linux" {
+		for i := range features {
+			features[i].Supported = false
+			features[i].Reason = "only supported on Linux hosts"
+		}
+	}
+
+	return features
+}
+
+// RenderHostInfo lists the host details and grays out the features the host can't support
+func RenderHostInfo(hostInfo *apiclient.HostInfo) {
+	output := fmt.Sprintf("%s %s/%s\n", views.GetPropertyKey("Host:"), hostInfo.Os, hostInfo.Arch)
+	if hostInfo.KernelVersion != nil {
+		output += fmt.Sprintf("%s %s\n", views.GetPropertyKey("Kernel:"), *hostInfo.KernelVersion)
+	}
+	output += fmt.Sprintf("%s %s\n", views.GetPropertyKey("cgroups v2:"), formatAvailable(hostInfo.CgroupsV2))
+	output += fmt.Sprintf("%s %s\n", views.GetPropertyKey("User namespaces:"), formatAvailable(hostInfo.UserNamespaces))
+	output += fmt.Sprintf("%s %d\n", views.GetPropertyKey("GPUs:"), hostInfo.Gpus)
+	output += fmt.Sprintf("%s %s\n", views.GetPropertyKey("Docker:"), formatAvailable(hostInfo.Docker))
+
+	output += "\n"
+
+	grayStyle := lipgloss.NewStyle().Foreground(views.Gray)
+	for _, feature := range GetHostFeatures(hostInfo) {
+		if feature.Supported {
+			output += fmt.Sprintf("%s %s\n", views.CheckmarkSymbol, feature.Name)
+		} else {
+			output += grayStyle.Render(fmt.Sprintf("✗ %s (%s)", feature.Name, feature.Reason)) + "\n"
+		}
+	}
+
+	views.RenderContainerLayout(views.GetInfoMessage(output))
+}
+
+func formatAvailable(available bool) string {
+	if available {
+		return "available"
+	}
+	return "not available"
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package setup
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+const (
+	SetupLocalServer   = "local"
+	SetupRemoteServer  = "remote"
+	SetupInstallServer = "install"
+	SetupSkip          = "skip"
+)
+
+// GetSetupMethodFromPrompt asks how the CLI should connect to a Daytona Server on the first run
+func GetSetupMethodFromPrompt() (string, error) {
+	method := SetupLocalServer
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Welcome to Daytona! How do you want to get started?").
+				Description("Workspaces are managed by a Daytona Server. You can change this later with 'daytona profile'").
+				Options(
+					huh.NewOption("Run the Daytona Server on this machine", SetupLocalServer),
+					huh.NewOption("Connect to an existing Daytona Server", SetupRemoteServer),
+					huh.NewOption("Install the Daytona Server on a remote machine over SSH", SetupInstallServer),
+					huh.NewOption("Skip for now", SetupSkip),
+				).
+				Value(&method),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	return method, form.Run()
+}
+
+// GetSshDestinationFromPrompt asks for the machine the Daytona Server is installed on
+func GetSshDestinationFromPrompt() (string, error) {
+	var destination string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("SSH destination").
+				Description("The machine needs Docker and passwordless sudo to install the Daytona Server, e.g. user@example.com or a host alias from your SSH config").
+				Value(&destination).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("SSH destination can not be blank")
+					}
+					if strings.ContainsAny(str, " \t") || strings.HasPrefix(str, "-") {
+						return errors.New("invalid SSH destination")
+					}
+					return nil
+				}),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	return destination, form.Run()
+}
+
+// ConfirmCreateWorkspace asks if the first workspace should be created after the setup
+func ConfirmCreateWorkspace() (bool, error) {
+	confirmed := true
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(i18n.T("Create your first workspace now?")).
+				Value(&confirmed),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	return confirmed, form.Run()
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListSnapshots(snapshotList []apiclient.Snapshot) {
+	if len(snapshotList) == 0 {
+		views_util.NotifyEmptySnapshotList(true)
+		return
+	}
+
+	SortSnapshots(&snapshotList)
+
+	data := [][]string{}
+
+	for _, s := range snapshotList {
+		data = append(data, getRowFromSnapshot(s))
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "ID", "Project", "Created",
+	}, nil, func() {
+		renderUnstyledList(snapshotList)
+	})
+
+	fmt.Println(table)
+}
+
+// Newest snapshots first
+func SortSnapshots(snapshotList *[]apiclient.Snapshot) {
+	sort.Slice(*snapshotList, func(i, j int) bool {
+		return (*snapshotList)[i].CreatedAt > (*snapshotList)[j].CreatedAt
+	})
+}
+
+func renderUnstyledList(snapshotList []apiclient.Snapshot) {
+	output := "\n"
+
+	for _, s := range snapshotList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), s.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), s.Id) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), s.ProjectName) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), util.FormatTimestamp(s.CreatedAt)) + "\n\n"
+
+		if s.Id != snapshotList[len(snapshotList)-1].Id {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getRowFromSnapshot(s apiclient.Snapshot) []string {
+	return []string{
+		views.NameStyle.Render(s.Name + views_util.AdditionalPropertyPadding),
+		views.DefaultRowDataStyle.Render(s.Id),
+		views.DefaultRowDataStyle.Render(s.ProjectName),
+		views.DefaultRowDataStyle.Render(util.FormatTimestamp(s.CreatedAt)),
+	}
+}
@@ -5,7 +5,6 @@ package views
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/huh"
@@ -17,18 +16,19 @@ var (
 	ListNavigationRenderText = "+ Load more.."
 )
 
+// Colors of the active theme, set with SetTheme
 var (
-	Green       = lipgloss.AdaptiveColor{Light: "#23cc71", Dark: "#23cc71"}
-	Blue        = lipgloss.AdaptiveColor{Light: "#017ffe", Dark: "#017ffe"}
-	Yellow      = lipgloss.AdaptiveColor{Light: "#d4ed2d", Dark: "#d4ed2d"}
-	Cyan        = lipgloss.AdaptiveColor{Light: "#3ef7e5", Dark: "#3ef7e5"}
-	DimmedGreen = lipgloss.AdaptiveColor{Light: "#7be0a9", Dark: "#7be0a9"}
-	Orange      = lipgloss.AdaptiveColor{Light: "#e3881b", Dark: "#e3881b"}
-	Light       = lipgloss.AdaptiveColor{Light: "#000", Dark: "#fff"}
-	Dark        = lipgloss.AdaptiveColor{Light: "#fff", Dark: "#000"}
-	Gray        = lipgloss.AdaptiveColor{Light: "243", Dark: "243"}
-	LightGray   = lipgloss.AdaptiveColor{Light: "#828282", Dark: "#828282"}
-	Red         = lipgloss.AdaptiveColor{Light: "#FF4672", Dark: "#ED567A"}
+	Green       lipgloss.AdaptiveColor
+	Blue        lipgloss.AdaptiveColor
+	Yellow      lipgloss.AdaptiveColor
+	Cyan        lipgloss.AdaptiveColor
+	DimmedGreen lipgloss.AdaptiveColor
+	Orange      lipgloss.AdaptiveColor
+	Light       lipgloss.AdaptiveColor
+	Dark        lipgloss.AdaptiveColor
+	Gray        lipgloss.AdaptiveColor
+	LightGray   lipgloss.AdaptiveColor
+	Red         lipgloss.AdaptiveColor
 )
 
 var (
@@ -39,16 +39,31 @@ var (
 					PaddingTop(1).
 					Margin(1, 0)
 
-	NameStyle           = lipgloss.NewStyle().Foreground(Light)
-	ActiveStyle         = lipgloss.NewStyle().Foreground(Green)
-	InactiveStyle       = lipgloss.NewStyle().Foreground(Orange)
-	DefaultRowDataStyle = lipgloss.NewStyle().Foreground(Gray)
-	BaseCellStyle       = lipgloss.NewRenderer(os.Stdout).NewStyle().Padding(0, 4, 1, 0)
-	TableHeaderStyle    = BaseCellStyle.Foreground(LightGray).Bold(false).Padding(0).MarginRight(4)
+	NameStyle           lipgloss.Style
+	ActiveStyle         lipgloss.Style
+	InactiveStyle       lipgloss.Style
+	DefaultRowDataStyle lipgloss.Style
+	BaseCellStyle       lipgloss.Style
+	TableHeaderStyle    lipgloss.Style
 )
 
-var LogPrefixColors = []lipgloss.AdaptiveColor{
-	Blue, Orange, Cyan, Yellow,
+var LogPrefixColors []lipgloss.AdaptiveColor
+
+// setStyles rebuilds the shared styles from the colors of the active theme
+func setStyles() {
+	NameStyle = lipgloss.NewStyle().Foreground(Light)
+	ActiveStyle = lipgloss.NewStyle().Foreground(Green)
+	InactiveStyle = lipgloss.NewStyle().Foreground(Orange)
+	DefaultRowDataStyle = lipgloss.NewStyle().Foreground(Gray)
+	BaseCellStyle = NewRenderer().NewStyle().Padding(0, 4, 1, 0)
+	TableHeaderStyle = BaseCellStyle.Foreground(LightGray).Bold(false).Padding(0).MarginRight(4)
+
+	LogPrefixColors = []lipgloss.AdaptiveColor{
+		Blue, Orange, Cyan, Yellow,
+	}
+
+	CheckmarkSymbol = lipgloss.NewStyle().Foreground(Green).SetString("✓")
+	SeparatorString = lipgloss.NewStyle().Foreground(LightGray).Render("===")
 }
 
 type SelectionListOptions struct {
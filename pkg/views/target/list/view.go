@@ -18,6 +18,7 @@ type rowData struct {
 	Provider  string
 	IsDefault string
 	Options   string
+	Labels    string
 }
 
 func ListTargets(targetList []apiclient.ProviderTarget) {
@@ -35,7 +36,7 @@ func ListTargets(targetList []apiclient.ProviderTarget) {
 	}
 
 	table := util.GetTableView(data, []string{
-		"Target", "Provider", "Default", "Options",
+		"Target", "Provider", "Default", "Options", "Labels",
 	}, nil, func() {
 		renderUnstyledList(targetList)
 	})
@@ -50,6 +51,7 @@ func getRowFromRowData(target *apiclient.ProviderTarget) []string {
 	data.Target = target.Name
 	data.Provider = target.ProviderInfo.Name
 	data.Options = target.Options
+	data.Labels = views_util.FormatLabels(target.Labels)
 
 	if target.IsDefault {
 		isDefault = views.ActiveStyle.Render("Yes")
@@ -62,6 +64,7 @@ func getRowFromRowData(target *apiclient.ProviderTarget) []string {
 		views.DefaultRowDataStyle.Render(data.Provider),
 		isDefault,
 		views.DefaultRowDataStyle.Render(data.Options),
+		views.DefaultRowDataStyle.Render(data.Labels),
 	}
 
 	return row
@@ -89,6 +92,10 @@ func renderUnstyledList(targetList []apiclient.ProviderTarget) {
 
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target Options: "), target.Options) + "\n\n"
 
+		if len(target.Labels) > 0 {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Labels: "), views_util.FormatLabels(target.Labels)) + "\n\n"
+		}
+
 		if target.Name != targetList[len(targetList)-1].Name {
 			output += views.SeparatorString + "\n\n"
 		}
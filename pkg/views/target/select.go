@@ -22,6 +22,7 @@ type TargetView struct {
 	Options      string
 	IsDefault    bool
 	ProviderInfo ProviderInfo
+	Labels       map[string]string
 }
 
 type ProviderInfo struct {
@@ -101,5 +102,6 @@ func GetTargetViewFromTarget(target apiclient.ProviderTarget) TargetView {
 			Name:    target.ProviderInfo.Name,
 			Version: target.ProviderInfo.Version,
 		},
+		Labels: target.Labels,
 	}
 }
@@ -0,0 +1,188 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package views
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Theme is the color palette used by all views
+type Theme struct {
+	Green       lipgloss.AdaptiveColor
+	Blue        lipgloss.AdaptiveColor
+	Yellow      lipgloss.AdaptiveColor
+	Cyan        lipgloss.AdaptiveColor
+	DimmedGreen lipgloss.AdaptiveColor
+	Orange      lipgloss.AdaptiveColor
+	Light       lipgloss.AdaptiveColor
+	Dark        lipgloss.AdaptiveColor
+	Gray        lipgloss.AdaptiveColor
+	LightGray   lipgloss.AdaptiveColor
+	Red         lipgloss.AdaptiveColor
+}
+
+const (
+	DefaultThemeName = "default"
+	LightThemeName   = "light"
+	DarkThemeName    = "dark"
+	// The custom theme is the default theme with the colors set in the config overridden
+	CustomThemeName = "custom"
+)
+
+// The default theme adapts to the background color of the terminal
+var defaultTheme = Theme{
+	Green:       lipgloss.AdaptiveColor{Light: "#23cc71", Dark: "#23cc71"},
+	Blue:        lipgloss.AdaptiveColor{Light: "#017ffe", Dark: "#017ffe"},
+	Yellow:      lipgloss.AdaptiveColor{Light: "#d4ed2d", Dark: "#d4ed2d"},
+	Cyan:        lipgloss.AdaptiveColor{Light: "#3ef7e5", Dark: "#3ef7e5"},
+	DimmedGreen: lipgloss.AdaptiveColor{Light: "#7be0a9", Dark: "#7be0a9"},
+	Orange:      lipgloss.AdaptiveColor{Light: "#e3881b", Dark: "#e3881b"},
+	Light:       lipgloss.AdaptiveColor{Light: "#000", Dark: "#fff"},
+	Dark:        lipgloss.AdaptiveColor{Light: "#fff", Dark: "#000"},
+	Gray:        lipgloss.AdaptiveColor{Light: "243", Dark: "243"},
+	LightGray:   lipgloss.AdaptiveColor{Light: "#828282", Dark: "#828282"},
+	Red:         lipgloss.AdaptiveColor{Light: "#FF4672", Dark: "#ED567A"},
+}
+
+// The light and dark themes don't depend on background detection, which doesn't work in every terminal
+var themes = map[string]Theme{
+	DefaultThemeName: defaultTheme,
+	LightThemeName: {
+		Green:       fixedColor("#168c4c"),
+		Blue:        fixedColor("#0060c7"),
+		Yellow:      fixedColor("#8a7a00"),
+		Cyan:        fixedColor("#00827a"),
+		DimmedGreen: fixedColor("#4a9f70"),
+		Orange:      fixedColor("#b35f00"),
+		Light:       fixedColor("#000"),
+		Dark:        fixedColor("#fff"),
+		Gray:        fixedColor("240"),
+		LightGray:   fixedColor("#5e5e5e"),
+		Red:         fixedColor("#d6204e"),
+	},
+	DarkThemeName: {
+		Green:       fixedColor("#23cc71"),
+		Blue:        fixedColor("#4da3ff"),
+		Yellow:      fixedColor("#d4ed2d"),
+		Cyan:        fixedColor("#3ef7e5"),
+		DimmedGreen: fixedColor("#7be0a9"),
+		Orange:      fixedColor("#e3881b"),
+		Light:       fixedColor("#fff"),
+		Dark:        fixedColor("#000"),
+		Gray:        fixedColor("245"),
+		LightGray:   fixedColor("#a0a0a0"),
+		Red:         fixedColor("#ED567A"),
+	},
+}
+
+var colorsDisabled bool
+
+func init() {
+	SetTheme(defaultTheme)
+}
+
+func GetThemeNames() []string {
+	return []string{DefaultThemeName, LightThemeName, DarkThemeName, CustomThemeName}
+}
+
+// GetTheme returns the theme with the given name. The custom colors are only used by the custom theme
+// and are keyed by the lowercase color name (e.g. green, lightgray)
+func GetTheme(name string, customColors map[string]string) (Theme, error) {
+	if name == CustomThemeName {
+		return newCustomTheme(customColors)
+	}
+
+	theme, ok := themes[name]
+	if !ok {
+		return Theme{}, fmt.Errorf("theme %s not found. Available themes: %s", name, strings.Join(GetThemeNames(), ", "))
+	}
+
+	return theme, nil
+}
+
+// SetTheme sets the colors and rebuilds the shared styles. Needs to be called before any view is rendered
+func SetTheme(theme Theme) {
+	Green = theme.Green
+	Blue = theme.Blue
+	Yellow = theme.Yellow
+	Cyan = theme.Cyan
+	DimmedGreen = theme.DimmedGreen
+	Orange = theme.Orange
+	Light = theme.Light
+	Dark = theme.Dark
+	Gray = theme.Gray
+	LightGray = theme.LightGray
+	Red = theme.Red
+
+	setStyles()
+}
+
+// DisableColors makes all views render text without colors, e.g. when NO_COLOR is set
+func DisableColors() {
+	colorsDisabled = true
+	lipgloss.SetColorProfile(termenv.Ascii)
+	setStyles()
+}
+
+// NewRenderer returns a renderer for the standard output that respects disabled colors
+func NewRenderer() *lipgloss.Renderer {
+	re := lipgloss.NewRenderer(os.Stdout)
+	if colorsDisabled {
+		re.SetColorProfile(termenv.Ascii)
+	}
+	return re
+}
+
+func newCustomTheme(colors map[string]string) (Theme, error) {
+	theme := defaultTheme
+
+	for name, value := range colors {
+		var color *lipgloss.AdaptiveColor
+		switch strings.ToLower(name) {
+		case "green":
+			color = &theme.Green
+		case "blue":
+			color = &theme.Blue
+		case "yellow":
+			color = &theme.Yellow
+		case "cyan":
+			color = &theme.Cyan
+		case "dimmedgreen":
+			color = &theme.DimmedGreen
+		case "orange":
+			color = &theme.Orange
+		case "light":
+			color = &theme.Light
+		case "dark":
+			color = &theme.Dark
+		case "gray":
+			color = &theme.Gray
+		case "lightgray":
+			color = &theme.LightGray
+		case "red":
+			color = &theme.Red
+		default:
+			return Theme{}, fmt.Errorf("invalid theme color %s", name)
+		}
+
+		*color = fixedColor(value)
+	}
+
+	return theme, nil
+}
+
+func fixedColor(color string) lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{Light: color, Dark: color}
+}
+
+// IsValidThemeName checks if the name is one of the available themes
+func IsValidThemeName(name string) bool {
+	return slices.Contains(GetThemeNames(), name)
+}
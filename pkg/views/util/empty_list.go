@@ -3,88 +3,126 @@
 
 package util
 
-import "github.com/daytonaio/daytona/pkg/views"
+import (
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/views"
+)
 
 func NotifyEmptyProviderList(tip bool) {
-	views.RenderInfoMessageBold("No providers found")
+	views.RenderInfoMessageBold(i18n.T("No providers found"))
 	if tip {
-		views.RenderTip("Use 'daytona provider install' to install a provider")
+		views.RenderTip(i18n.T("Use 'daytona provider install' to install a provider"))
 	}
 }
 
 func NotifyEmptyGitProviderList(tip bool) {
-	views.RenderInfoMessageBold("No Git providers found")
+	views.RenderInfoMessageBold(i18n.T("No Git providers found"))
 	if tip {
-		views.RenderTip("Use 'daytona git-provider add' to add a Git provider")
+		views.RenderTip(i18n.T("Use 'daytona git-provider add' to add a Git provider"))
 	}
 }
 
 func NotifyEmptyTargetList(tip bool) {
-	views.RenderInfoMessageBold("No targets found")
+	views.RenderInfoMessageBold(i18n.T("No targets found"))
 	if tip {
-		views.RenderTip("Use 'daytona target set' to add a target")
+		views.RenderTip(i18n.T("Use 'daytona target set' to add a target"))
 	}
 }
 
 func NotifyEmptyProjectConfigList(tip bool) {
-	views.RenderInfoMessageBold("No project configs found")
+	views.RenderInfoMessageBold(i18n.T("No project configs found"))
 	if tip {
-		views.RenderTip("Use 'daytona project-config add' to add a project config")
+		views.RenderTip(i18n.T("Use 'daytona project-config add' to add a project config"))
 	}
 }
 
 func NotifyEmptyWorkspaceList(tip bool) {
-	views.RenderInfoMessageBold("No workspaces found")
+	views.RenderInfoMessageBold(i18n.T("No workspaces found"))
 	if tip {
-		views.RenderTip("Use 'daytona create' to create a workspace")
+		views.RenderTip(i18n.T("Use 'daytona create' to create a workspace"))
 	}
 }
 
 func NotifyEmptyContainerRegistryList(tip bool) {
-	views.RenderInfoMessageBold("No container registries found")
+	views.RenderInfoMessageBold(i18n.T("No container registries found"))
 	if tip {
-		views.RenderTip("Use 'daytona container-registry add' to add a container registry")
+		views.RenderTip(i18n.T("Use 'daytona container-registry add' to add a container registry"))
 	}
 }
 
 func NotifyEmptyProfileList(tip bool) {
-	views.RenderInfoMessageBold("No profiles found")
+	views.RenderInfoMessageBold(i18n.T("No profiles found"))
 	if tip {
-		views.RenderTip("Use 'daytona profile add' to add a profile")
+		views.RenderTip(i18n.T("Use 'daytona profile add' to add a profile"))
 	}
 }
 
 func NotifyEmptyPrebuildList(tip bool) {
-	views.RenderInfoMessageBold("No prebuilds found")
+	views.RenderInfoMessageBold(i18n.T("No prebuilds found"))
 	if tip {
-		views.RenderTip("Use 'daytona prebuild add' to add a prebuild")
+		views.RenderTip(i18n.T("Use 'daytona prebuild add' to add a prebuild"))
 	}
 }
 
 func NotifyEmptyApiKeyList(tip bool) {
-	views.RenderInfoMessageBold("No API keys found")
+	views.RenderInfoMessageBold(i18n.T("No API keys found"))
 	if tip {
-		views.RenderTip("Use 'daytona api-key new' to create an API key")
+		views.RenderTip(i18n.T("Use 'daytona api-key new' to create an API key"))
 	}
 }
 
 func NotifyEmptyBuildList(tip bool) {
-	views.RenderInfoMessageBold("No builds found")
+	views.RenderInfoMessageBold(i18n.T("No builds found"))
 	if tip {
-		views.RenderTip("Use 'daytona build run' to run a build or 'daytona prebuild add' to configure a prebuild rule")
+		views.RenderTip(i18n.T("Use 'daytona build run' to run a build or 'daytona prebuild add' to configure a prebuild rule"))
 	}
 }
 
 func NotifyEmptyEnvVarList(tip bool) {
-	views.RenderInfoMessageBold("No environment variables found")
+	views.RenderInfoMessageBold(i18n.T("No environment variables found"))
 	if tip {
-		views.RenderTip("Use 'daytona env set' to set environment variables")
+		views.RenderTip(i18n.T("Use 'daytona env set' to set environment variables"))
 	}
 }
 
 func NotifyEmptyServerLogList(tip bool) {
-	views.RenderInfoMessageBold("No server log files found")
+	views.RenderInfoMessageBold(i18n.T("No server log files found"))
 	if tip {
-		views.RenderTip("Use 'daytona serve' in order to create server log files")
+		views.RenderTip(i18n.T("Use 'daytona serve' in order to create server log files"))
+	}
+}
+
+func NotifyEmptySnapshotList(tip bool) {
+	views.RenderInfoMessageBold(i18n.T("No snapshots found"))
+	if tip {
+		views.RenderTip(i18n.T("Use 'daytona snapshot create' to checkpoint a workspace project"))
+	}
+}
+
+func NotifyEmptyCreationTimingList(tip bool) {
+	views.RenderInfoMessageBold(i18n.T("No creation timings found"))
+	if tip {
+		views.RenderTip(i18n.T("Timings are recorded for every workspace created with 'daytona create'"))
+	}
+}
+
+func NotifyEmptyEgressUsageList(tip bool) {
+	views.RenderInfoMessageBold(i18n.T("No egress usage found"))
+	if tip {
+		views.RenderTip(i18n.T("Egress is sampled every minute while workspaces are running"))
+	}
+}
+
+func NotifyEmptySecretList(tip bool) {
+	views.RenderInfoMessageBold(i18n.T("No secrets found"))
+	if tip {
+		views.RenderTip(i18n.T("Use 'daytona secret set' to add a secret"))
+	}
+}
+
+func NotifyEmptyPortList(tip bool) {
+	views.RenderInfoMessageBold(i18n.T("No listening ports found"))
+	if tip {
+		views.RenderTip(i18n.T("Ports are listed once a process in the project starts listening on them"))
 	}
 }
@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/daytonaio/daytona/pkg/views"
+	"golang.org/x/term"
+)
+
+type progressMsg int64
+
+type progressDoneMsg struct {
+	err error
+}
+
+type progressModel struct {
+	progress progress.Model
+	message  string
+	total    int64
+	current  int64
+	done     bool
+	err      error
+}
+
+func (m progressModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m progressModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case progressMsg:
+		m.current += int64(msg)
+		return m, nil
+	case progressDoneMsg:
+		m.done = true
+		m.err = msg.err
+		return m, tea.Quit
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.err = fmt.Errorf("operation cancelled")
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.progress.Width = min(msg.Width-4, 80)
+	}
+
+	return m, nil
+}
+
+func (m progressModel) View() string {
+	percent := 1.0
+	if m.total > 0 {
+		percent = float64(m.current) / float64(m.total)
+	}
+
+	return views.GetInfoMessage(fmt.Sprintf("%s %s %s/%s", m.message, m.progress.ViewAs(percent), FormatBytes(m.current), FormatBytes(m.total))) + "\n"
+}
+
+// WithProgressBar runs the function and renders a progress bar that advances by the amounts passed to
+// the report callback until the total is reached. Only the function runs if stdout is not a terminal
+func WithProgressBar(message string, total int64, fn func(report func(n int64)) error) error {
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		return fn(func(int64) {})
+	}
+
+	bar := progress.New(progress.WithSolidFill(views.Green.Dark), progress.WithoutPercentage())
+	bar.Width = 40
+
+	p := tea.NewProgram(progressModel{progress: bar, message: message, total: total})
+
+	go func() {
+		err := fn(func(n int64) {
+			p.Send(progressMsg(n))
+		})
+		p.Send(progressDoneMsg{err: err})
+	}()
+
+	m, err := p.Run()
+	if err != nil {
+		return err
+	}
+
+	return m.(progressModel).err
+}
+
+// FormatBytes returns the size in a human readable format (e.g. 1.5 MB)
+func FormatBytes(size int64) string {
+	const unit = 1000
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "kMGTPE"[exp])
+}
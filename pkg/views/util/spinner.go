@@ -10,6 +10,7 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 	log "github.com/sirupsen/logrus"
 )
@@ -57,12 +58,22 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func WithSpinner(message string, fn func() error) error {
+	if views.IsPlainOutput() {
+		fmt.Printf("%s...\n", message)
+		return fn()
+	}
+
 	p := start(message, false)
 	defer stop(p)
 	return fn()
 }
 
 func WithInlineSpinner(message string, fn func() error) error {
+	if views.IsPlainOutput() {
+		fmt.Printf("%s...\n", message)
+		return fn()
+	}
+
 	p := start(message, true)
 	defer stop(p)
 	return fn()
@@ -81,7 +92,7 @@ func start(message string, inline bool) *tea.Program {
 			os.Exit(1)
 		}
 		if isAborted {
-			fmt.Println("Operation cancelled")
+			fmt.Println(i18n.T("Operation cancelled"))
 			os.Exit(1)
 		}
 	}()
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
@@ -22,7 +23,11 @@ var ArbitrarySpace = 10
 
 // Gets the table view string or falls back to an unstyled view for lower terminal widths
 func GetTableView(data [][]string, headers []string, footer *string, fallbackRender func()) string {
-	re := lipgloss.NewRenderer(os.Stdout)
+	if views.IsPlainOutput() {
+		return getPlainTableView(data, headers)
+	}
+
+	re := views.NewRenderer()
 
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil {
@@ -60,14 +65,34 @@ func GetTableView(data [][]string, headers []string, footer *string, fallbackRen
 	return views.BaseTableStyle.Render(table)
 }
 
+// getPlainTableView renders the table as tab-separated lines without styling
+func getPlainTableView(data [][]string, headers []string) string {
+	lines := []string{getPlainTableRow(headers)}
+	for _, row := range data {
+		lines = append(lines, getPlainTableRow(row))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func getPlainTableRow(row []string) string {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		cells[i] = strings.TrimSpace(ansiRegex.ReplaceAllString(cell, ""))
+	}
+
+	return strings.Join(cells, "\t")
+}
+
+var ansiRegex = regexp.MustCompile("\x1b\\[[0-9;]*[a-zA-Z]")
+
 func getMinimumWidth(data [][]string) int {
 	width := 0
 	widestRow := 0
 	for _, row := range data {
 		for _, cell := range row {
 			// Remove ANSI escape codes
-			regex := regexp.MustCompile("\x1b\\[[0-9;]*[a-zA-Z]")
-			strippedCell := regex.ReplaceAllString(cell, "")
+			strippedCell := ansiRegex.ReplaceAllString(cell, "")
 			width += len(strippedCell)
 			if width > widestRow {
 				widestRow = width
@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package bulk
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+// Result is the outcome of a lifecycle action on a single workspace
+type Result struct {
+	Workspace string
+	Duration  time.Duration
+	Error     error
+}
+
+func RenderResults(action string, results []Result) {
+	failed := 0
+	data := [][]string{}
+	for _, result := range results {
+		status := "OK"
+		message := ""
+		if result.Error != nil {
+			failed++
+			status = "FAILED"
+			message = result.Error.Error()
+		}
+		data = append(data, []string{result.Workspace, status, result.Duration.Round(100 * time.Millisecond).String(), message})
+	}
+
+	output := views.GetStyledMainTitle(action) + "\n\n"
+	output += views.GetPropertyKey(i18n.T("Succeeded: ")) + views.NameStyle.Render(fmt.Sprintf("%d", len(results)-failed)) + "\n"
+	output += views.GetPropertyKey(i18n.T("Failed: ")) + views.NameStyle.Render(fmt.Sprintf("%d", failed)) + "\n"
+
+	fmt.Println(lipgloss.NewStyle().PaddingLeft(1).Render(output))
+
+	styledData := [][]string{}
+	for _, row := range data {
+		status := views.ActiveStyle.Render(row[1])
+		if row[1] != "OK" {
+			status = views.InactiveStyle.Render(row[1])
+		}
+		styledData = append(styledData, []string{
+			views.NameStyle.Render(row[0]),
+			status,
+			views.DefaultRowDataStyle.Render(row[2]),
+			views.DefaultRowDataStyle.Render(row[3]),
+		})
+	}
+
+	table := views_util.GetTableView(styledData, []string{
+		"Workspace", "Result", "Duration", "Error",
+	}, nil, func() {
+		renderUnstyledResults(data)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledResults(data [][]string) {
+	output := "\n"
+
+	for _, row := range data {
+		output += fmt.Sprintf("%s %s (%s)", views.GetPropertyKey(row[0]+":"), row[1], row[2])
+		if row[3] != "" {
+			output += " " + row[3]
+		}
+		output += "\n\n"
+	}
+
+	fmt.Println(output)
+}
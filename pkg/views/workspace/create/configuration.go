@@ -11,7 +11,7 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
-	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -23,7 +23,7 @@ const (
 	DEVCONTAINER_FILEPATH = ".devcontainer/devcontainer.json"
 )
 
-var configurationHelpLine = lipgloss.NewStyle().Foreground(views.Gray).Render("enter: next  f10: advanced configuration")
+const configurationHelpLine = "enter: next  f10: advanced configuration"
 
 type ProjectConfigurationData struct {
 	BuildChoice          string
@@ -144,23 +144,23 @@ func RunProjectConfiguration(projectList *[]apiclient.CreateProjectDTO, defaults
 func validateDevcontainerFilename(filename string) error {
 	baseName := filepath.Base(filename)
 	if baseName != "devcontainer.json" && baseName != ".devcontainer.json" {
-		return errors.New("filename must be devcontainer.json or .devcontainer.json")
+		return errors.New(i18n.T("filename must be devcontainer.json or .devcontainer.json"))
 	}
 	return nil
 }
 
 func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData) *huh.Form {
 	buildOptions := []huh.Option[string]{
-		{Key: "Automatic", Value: string(views_util.AUTOMATIC)},
+		{Key: i18n.T("Automatic"), Value: string(views_util.AUTOMATIC)},
 		{Key: "Devcontainer", Value: string(views_util.DEVCONTAINER)},
-		{Key: "Custom image", Value: string(views_util.CUSTOMIMAGE)},
-		{Key: "None", Value: string(views_util.NONE)},
+		{Key: i18n.T("Custom image"), Value: string(views_util.CUSTOMIMAGE)},
+		{Key: i18n.T("None"), Value: string(views_util.NONE)},
 	}
 
 	form := huh.NewForm(
 		huh.NewGroup(
 			huh.NewSelect[string]().
-				Title("Choose a build configuration").
+				Title(i18n.T("Choose a build configuration")).
 				Options(
 					buildOptions...,
 				).
@@ -168,17 +168,17 @@ func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData)
 		).WithHeight(8),
 		huh.NewGroup(
 			huh.NewInput().
-				Title("Custom container image").
+				Title(i18n.T("Custom container image")).
 				Value(&projectConfiguration.Image),
 			huh.NewInput().
-				Title("Container user").
+				Title(i18n.T("Container user")).
 				Value(&projectConfiguration.User),
 		).WithHeight(5).WithHideFunc(func() bool {
 			return projectConfiguration.BuildChoice != string(views_util.CUSTOMIMAGE)
 		}),
 		huh.NewGroup(
 			huh.NewInput().
-				Title("Devcontainer file path").
+				Title(i18n.T("Devcontainer file path")).
 				Value(&projectConfiguration.DevcontainerFilePath).Validate(validateDevcontainerFilename),
 		).WithHeight(5).WithHideFunc(func() bool {
 			return projectConfiguration.BuildChoice != string(views_util.DEVCONTAINER)
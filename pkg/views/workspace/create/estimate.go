@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+// RenderCreationEstimate prints the estimated creation time of the workspace with the cache state of each project,
+// and suggests a prebuild for projects that are built
+func RenderCreationEstimate(estimate apiclient.CreationEstimate, targetName string) {
+	lines := []string{}
+	tips := []string{}
+	hasHistory := false
+
+	for _, p := range estimate.Projects {
+		if p.Samples == 0 {
+			lines = append(lines, fmt.Sprintf("%s: %s", p.ProjectName, i18n.T("no previous creations to estimate from")))
+		} else {
+			hasHistory = true
+			lines = append(lines, fmt.Sprintf("%s: ~%s (%s)", p.ProjectName, formatEstimate(int64(p.Total)), strings.Join(getCacheState(p), ", ")))
+		}
+
+		if p.RequiresBuild {
+			tips = append(tips, i18n.T("Project %s is built from its devcontainer configuration. Add a prebuild with 'daytona prebuild add' to skip the build", p.ProjectName))
+		}
+	}
+
+	title := i18n.T("Estimated creation time on %s: ~%s", targetName, formatEstimate(int64(estimate.Total)))
+	if !hasHistory {
+		title = i18n.T("Estimated creation time on %s: unknown", targetName)
+	}
+
+	output := views.GetPropertyKey(title) + "\n" + lipgloss.NewStyle().Foreground(views.Gray).Render(strings.Join(lines, "\n"))
+	if len(tips) > 0 {
+		output += "\n\n" + strings.Join(tips, "\n")
+	}
+
+	views.RenderInfoMessage(output)
+}
+
+func getCacheState(p apiclient.ProjectCreationEstimate) []string {
+	state := []string{}
+
+	switch {
+	case p.PrebuildAvailable:
+		state = append(state, i18n.T("prebuild available"))
+	case p.RequiresBuild:
+		state = append(state, i18n.T("build ~%s", formatEstimate(int64(p.Build))))
+	}
+
+	if p.ImageCached {
+		state = append(state, i18n.T("image cached"))
+	} else if !p.RequiresBuild {
+		state = append(state, i18n.T("pull ~%s", formatEstimate(int64(p.Pull))))
+	}
+
+	return append(state, i18n.T("based on %d creations", p.Samples))
+}
+
+func formatEstimate(ms int64) string {
+	d := time.Duration(ms) * time.Millisecond
+	if d < time.Minute {
+		return d.Round(time.Second).String()
+	}
+
+	return d.Round(10 * time.Second).String()
+}
@@ -0,0 +1,263 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+type progressStep struct {
+	name        string
+	projectName string
+	title       string
+	state       logs.StepState
+	// Last line logged while the step was running
+	lastLine string
+}
+
+type logEntryMsg logs.LogEntry
+
+type progressDoneMsg struct {
+	err error
+}
+
+type progressModel struct {
+	spinner  spinner.Model
+	steps    []*progressStep
+	width    int
+	quitting bool
+	aborted  bool
+}
+
+// Progress reports the steps of a workspace creation or start
+type Progress interface {
+	HandleLogEntry(logEntry logs.LogEntry)
+	SetStep(name string, state logs.StepState)
+	Stop(err error)
+}
+
+// ProgressView renders the steps of a workspace creation with a spinner next to the running steps
+type ProgressView struct {
+	program *tea.Program
+	done    chan struct{}
+}
+
+func NewProgressView(projectNames []string) *ProgressView {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(views.Green)
+
+	return &ProgressView{
+		program: tea.NewProgram(progressModel{spinner: s, steps: getCreateSteps(projectNames)}),
+		done:    make(chan struct{}),
+	}
+}
+
+func getCreateSteps(projectNames []string) []*progressStep {
+	steps := []*progressStep{
+		{name: logs.StepProvisionWorkspace, title: "Provisioning workspace"},
+	}
+
+	for _, projectName := range projectNames {
+		steps = append(steps, &progressStep{
+			name:        logs.StepCreateProject,
+			projectName: projectName,
+			title:       fmt.Sprintf("Cloning repository and pulling image (%s)", projectName),
+		})
+	}
+
+	steps = append(steps, getStartSteps(projectNames)...)
+
+	return append(steps, &progressStep{name: logs.StepSshReady, title: "Waiting for SSH"})
+}
+
+func getStartSteps(projectNames []string) []*progressStep {
+	var steps []*progressStep
+
+	for _, projectName := range projectNames {
+		steps = append(steps, &progressStep{
+			name:        logs.StepStartProject,
+			projectName: projectName,
+			title:       fmt.Sprintf("Starting container (%s)", projectName),
+		})
+	}
+
+	return steps
+}
+
+func (v *ProgressView) Start() {
+	go func() {
+		defer close(v.done)
+
+		m, err := v.program.Run()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		if m.(progressModel).aborted {
+			fmt.Println(i18n.T("Operation cancelled"))
+			os.Exit(1)
+		}
+	}()
+}
+
+// HandleLogEntry updates the state of the step from a step entry and shows other entries under the running step
+func (v *ProgressView) HandleLogEntry(logEntry logs.LogEntry) {
+	v.program.Send(logEntryMsg(logEntry))
+}
+
+// SetStep updates the state of a workspace step that is reported by the client
+func (v *ProgressView) SetStep(name string, state logs.StepState) {
+	v.HandleLogEntry(logs.LogEntry{Step: &logs.Step{Name: name, State: state}})
+}
+
+// Stop renders the final state of the steps and releases the terminal. Steps that are still
+// running are marked as failed if err is not nil
+func (v *ProgressView) Stop(err error) {
+	v.program.Send(progressDoneMsg{err: err})
+	<-v.done
+}
+
+func (m progressModel) Init() tea.Cmd {
+	return m.spinner.Tick
+}
+
+func (m progressModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case logEntryMsg:
+		m.handleLogEntry(logs.LogEntry(msg))
+		return m, nil
+	case progressDoneMsg:
+		if msg.err != nil {
+			for _, step := range m.steps {
+				if step.state == logs.StepStateStarted {
+					step.state = logs.StepStateFailed
+				}
+			}
+		}
+		m.quitting = true
+		return m, tea.Quit
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		return m, nil
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.aborted = true
+			m.quitting = true
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return m, cmd
+}
+
+func (m progressModel) handleLogEntry(logEntry logs.LogEntry) {
+	var projectName string
+	if logEntry.ProjectName != nil {
+		projectName = *logEntry.ProjectName
+	}
+
+	if logEntry.Step != nil {
+		step := m.findStep(logEntry.Step.Name, projectName)
+		if step != nil {
+			step.state = logEntry.Step.State
+			step.lastLine = ""
+		}
+		return
+	}
+
+	line := getLastLine(logEntry.Msg)
+	if line == "" {
+		return
+	}
+
+	for _, step := range m.steps {
+		if step.state == logs.StepStateStarted && step.projectName == projectName {
+			step.lastLine = line
+		}
+	}
+}
+
+func (m progressModel) findStep(name, projectName string) *progressStep {
+	for _, step := range m.steps {
+		if step.name == name && step.projectName == projectName {
+			return step
+		}
+	}
+	return nil
+}
+
+func (m progressModel) View() string {
+	lineStyle := lipgloss.NewStyle().Foreground(views.Gray)
+
+	var sb strings.Builder
+	sb.WriteString("\n")
+
+	for _, step := range m.steps {
+		var marker string
+		switch step.state {
+		case logs.StepStateStarted:
+			marker = m.spinner.View()
+			if m.quitting {
+				marker = lipgloss.NewStyle().Foreground(views.Gray).Render("-")
+			}
+		case logs.StepStateDone:
+			marker = lipgloss.NewStyle().Foreground(views.Green).Render("✓")
+		case logs.StepStateFailed:
+			marker = lipgloss.NewStyle().Foreground(views.Red).Render("✗")
+		default:
+			marker = lipgloss.NewStyle().Foreground(views.Gray).Render("○")
+		}
+
+		title := step.title
+		if step.state == "" {
+			title = lipgloss.NewStyle().Foreground(views.Gray).Render(title)
+		}
+
+		sb.WriteString(fmt.Sprintf(" %s %s\n", marker, title))
+
+		if step.state == logs.StepStateStarted && step.lastLine != "" && !m.quitting {
+			line := step.lastLine
+			if m.width > 6 {
+				line = ansi.Truncate(line, m.width-6, "…")
+			}
+			sb.WriteString(fmt.Sprintf("     %s\n", lineStyle.Render(line)))
+		}
+	}
+
+	return sb.String()
+}
+
+// getLastLine returns the last non-empty line of a log message without control sequences
+func getLastLine(msg string) string {
+	lines := strings.Split(ansi.Strip(msg), "\n")
+
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := lines[i]
+		// Progress bars overwrite the line with carriage returns
+		if idx := strings.LastIndex(strings.TrimRight(line, "\r"), "\r"); idx >= 0 {
+			line = line[idx+1:]
+		}
+
+		line = strings.TrimSpace(line)
+		if line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+)
+
+// StepCompleted is the step of the last event if the operation succeeded
+const StepCompleted = "completed"
+
+// ProgressEvent is a single line of the JSON progress output. Step state changes carry the
+// step title as the message, log lines carry the line and the running step of the project
+type ProgressEvent struct {
+	Workspace string         `json:"workspace"`
+	Step      string         `json:"step"`
+	Project   string         `json:"project,omitempty"`
+	State     logs.StepState `json:"state,omitempty"`
+	Percent   int            `json:"percent"`
+	Message   string         `json:"message,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// JsonProgressView prints the progress as newline-delimited JSON events so that wrapping tools
+// can render their own progress
+type JsonProgressView struct {
+	workspaceName string
+	steps         []*progressStep
+	encoder       *json.Encoder
+	mutex         sync.Mutex
+}
+
+func NewJsonProgressView(workspaceName string, projectNames []string) *JsonProgressView {
+	return newJsonProgressView(os.Stdout, workspaceName, getCreateSteps(projectNames))
+}
+
+func NewStartJsonProgressView(workspaceName string, projectNames []string) *JsonProgressView {
+	return newJsonProgressView(os.Stdout, workspaceName, getStartSteps(projectNames))
+}
+
+func newJsonProgressView(w io.Writer, workspaceName string, steps []*progressStep) *JsonProgressView {
+	return &JsonProgressView{
+		workspaceName: workspaceName,
+		steps:         steps,
+		encoder:       json.NewEncoder(w),
+	}
+}
+
+func (v *JsonProgressView) HandleLogEntry(logEntry logs.LogEntry) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	var projectName string
+	if logEntry.ProjectName != nil {
+		projectName = *logEntry.ProjectName
+	}
+
+	if logEntry.Step != nil {
+		step := v.findStep(logEntry.Step.Name, projectName)
+		if step == nil || step.state == logEntry.Step.State {
+			return
+		}
+
+		step.state = logEntry.Step.State
+		v.write(ProgressEvent{Step: step.name, Project: step.projectName, State: step.state, Message: step.title})
+		return
+	}
+
+	line := getLastLine(logEntry.Msg)
+	if line == "" {
+		return
+	}
+
+	event := ProgressEvent{Project: projectName, Message: line}
+	for _, step := range v.steps {
+		if step.state == logs.StepStateStarted && step.projectName == projectName {
+			event.Step = step.name
+			break
+		}
+	}
+
+	v.write(event)
+}
+
+func (v *JsonProgressView) SetStep(name string, state logs.StepState) {
+	v.HandleLogEntry(logs.LogEntry{Step: &logs.Step{Name: name, State: state}})
+}
+
+// Stop marks the running steps as failed if err is not nil and prints the final event
+func (v *JsonProgressView) Stop(err error) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if err == nil {
+		v.write(ProgressEvent{Step: StepCompleted, State: logs.StepStateDone, Percent: 100})
+		return
+	}
+
+	failed := false
+	for _, step := range v.steps {
+		if step.state == logs.StepStateStarted {
+			step.state = logs.StepStateFailed
+			failed = true
+			v.write(ProgressEvent{Step: step.name, Project: step.projectName, State: step.state, Message: step.title, Error: err.Error()})
+		}
+	}
+
+	if !failed {
+		v.write(ProgressEvent{State: logs.StepStateFailed, Error: err.Error()})
+	}
+}
+
+func (v *JsonProgressView) findStep(name, projectName string) *progressStep {
+	for _, step := range v.steps {
+		if step.name == name && step.projectName == projectName {
+			return step
+		}
+	}
+	return nil
+}
+
+// write prints the event with the workspace name and the share of finished steps
+func (v *JsonProgressView) write(event ProgressEvent) {
+	event.Workspace = v.workspaceName
+
+	if event.Percent == 0 && len(v.steps) > 0 {
+		finished := 0
+		for _, step := range v.steps {
+			if step.state == logs.StepStateDone {
+				finished++
+			}
+		}
+		event.Percent = finished * 100 / len(v.steps)
+	}
+
+	v.encoder.Encode(event) // nolint:errcheck
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+// SettingSource describes where the value of a workspace setting came from
+type SettingSource string
+
+const (
+	SettingSourceFlag          SettingSource = "flag"
+	SettingSourceFile          SettingSource = "definition file"
+	SettingSourceEnv           SettingSource = "environment"
+	SettingSourceProfile       SettingSource = "profile default"
+	SettingSourceConfig        SettingSource = "config default"
+	SettingSourceDefaultTarget SettingSource = "default target"
+	SettingSourcePlacement     SettingSource = "placement"
+	SettingSourceWizard        SettingSource = "wizard"
+	SettingSourceSuggested     SettingSource = "suggested"
+	SettingSourceEdited        SettingSource = "edited"
+	SettingSourceNone          SettingSource = "not set"
+)
+
+const submitReviewOption = "submit"
+
+type Setting struct {
+	Label  string
+	Value  *string
+	Source SettingSource
+	// Options limit the value to one of the options. Settings without options are edited as text
+	Options []string
+	// Validate is called with the edited value
+	Validate func(string) error
+	ReadOnly bool
+}
+
+// RunSettingsReview shows the settings of a workspace with the source of each value and lets the user
+// override them before the workspace is created. Edited values are written to the settings
+func RunSettingsReview(settings []Setting) error {
+	for {
+		var choice string
+
+		options := []huh.Option[string]{huh.NewOption(i18n.T("Create the workspace"), submitReviewOption)}
+		readOnly := []string{}
+		labelWidth := getLabelWidth(settings)
+
+		for i, setting := range settings {
+			if setting.ReadOnly {
+				readOnly = append(readOnly, renderSetting(setting, labelWidth))
+				continue
+			}
+			options = append(options, huh.NewOption(renderSetting(setting, labelWidth), fmt.Sprint(i)))
+		}
+
+		description := i18n.T("Select a setting to override it")
+		if len(readOnly) > 0 {
+			description = strings.Join(readOnly, "\n") + "\n\n" + description
+		}
+
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewSelect[string]().
+					Title(i18n.T("Review the workspace settings")).
+					Description(description).
+					Options(options...).
+					Value(&choice),
+			),
+		).WithTheme(views.GetCustomTheme())
+
+		err := form.Run()
+		if err != nil {
+			if err == huh.ErrUserAborted {
+				return common.ErrCtrlCAbort
+			}
+			return err
+		}
+
+		if choice == submitReviewOption {
+			fmt.Println(RenderSettingsReview(settings))
+			return nil
+		}
+
+		var index int
+		_, err = fmt.Sscan(choice, &index)
+		if err != nil {
+			return err
+		}
+
+		err = editSetting(&settings[index])
+		if err != nil {
+			if err == huh.ErrUserAborted {
+				return common.ErrCtrlCAbort
+			}
+			return err
+		}
+	}
+}
+
+// RenderSettingsReview returns the settings with the source of each value (value ← source)
+func RenderSettingsReview(settings []Setting) string {
+	labelWidth := getLabelWidth(settings)
+
+	lines := []string{}
+	for _, setting := range settings {
+		lines = append(lines, renderSetting(setting, labelWidth))
+	}
+
+	return lipgloss.NewStyle().PaddingLeft(2).Render(strings.Join(lines, "\n")) + "\n"
+}
+
+func editSetting(setting *Setting) error {
+	value := *setting.Value
+
+	var field huh.Field
+	if len(setting.Options) > 0 {
+		field = huh.NewSelect[string]().
+			Title(setting.Label).
+			Options(huh.NewOptions(setting.Options...)...).
+			Value(&value)
+	} else {
+		input := huh.NewInput().
+			Title(setting.Label).
+			Value(&value)
+		if setting.Validate != nil {
+			input = input.Validate(setting.Validate)
+		}
+		field = input
+	}
+
+	err := huh.NewForm(huh.NewGroup(field)).WithTheme(views.GetCustomTheme()).Run()
+	if err != nil {
+		return err
+	}
+
+	value = strings.TrimSpace(value)
+	if value == *setting.Value {
+		return nil
+	}
+
+	*setting.Value = value
+	setting.Source = SettingSourceEdited
+	if value == "" {
+		setting.Source = SettingSourceNone
+	}
+
+	return nil
+}
+
+func renderSetting(setting Setting, labelWidth int) string {
+	value := *setting.Value
+	if value == "" {
+		value = "-"
+	}
+
+	label := lipgloss.NewStyle().Width(labelWidth).Render(setting.Label)
+	source := lipgloss.NewStyle().Foreground(views.Gray).Render("← " + string(setting.Source))
+
+	if setting.Source == SettingSourceEdited {
+		source = lipgloss.NewStyle().Foreground(views.Green).Render("← " + string(setting.Source))
+	}
+
+	return fmt.Sprintf("%s %s  %s", label, value, source)
+}
+
+func getLabelWidth(settings []Setting) int {
+	width := 0
+	for _, setting := range settings {
+		width = max(width, len(setting.Label)+1)
+	}
+	return width
+}
@@ -12,6 +12,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
 	util "github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -65,7 +66,7 @@ func RunSubmissionForm(config SubmissionFormConfig, pcImport *bool) error {
 	}
 
 	if userCancelled {
-		return errors.New("user cancelled")
+		return errors.New(i18n.T("user cancelled"))
 	}
 
 	if !configureCheck {
@@ -73,7 +74,7 @@ func RunSubmissionForm(config SubmissionFormConfig, pcImport *bool) error {
 	}
 
 	if config.Defaults.Image == nil || config.Defaults.ImageUser == nil {
-		return errors.New("default project entries are not set")
+		return errors.New(i18n.T("default project entries are not set"))
 	}
 
 	var err error
@@ -88,9 +89,9 @@ func RunSubmissionForm(config SubmissionFormConfig, pcImport *bool) error {
 func RenderSummary(name string, projectList []apiclient.CreateProjectDTO, defaults *views_util.ProjectConfigDefaults, nameLabel string) (string, error) {
 	var output string
 	if name == "" {
-		output = views.GetStyledMainTitle("SUMMARY")
+		output = views.GetStyledMainTitle(i18n.T("SUMMARY"))
 	} else {
-		output = views.GetStyledMainTitle(fmt.Sprintf("SUMMARY - %s %s", nameLabel, name))
+		output = views.GetStyledMainTitle(i18n.T("SUMMARY - %s %s", nameLabel, name))
 	}
 
 	output += "\n\n"
@@ -174,7 +175,7 @@ func NewSummaryModel(config SubmissionFormConfig, pcImport *bool) SummaryModel {
 		m.form = huh.NewForm(
 			huh.NewGroup(
 				huh.NewInput().
-					Title(fmt.Sprintf("%s name", config.NameLabel)).
+					Title(i18n.T("%s name", config.NameLabel)).
 					Value(config.ChosenName).
 					Key("name").
 					Validate(func(str string) error {
@@ -184,7 +185,7 @@ func NewSummaryModel(config SubmissionFormConfig, pcImport *bool) SummaryModel {
 						}
 						for _, name := range config.ExistingNames {
 							if name == result {
-								return errors.New("name already exists")
+								return errors.New(i18n.T("name already exists"))
 							}
 						}
 						*config.ChosenName = result
@@ -196,7 +197,7 @@ func NewSummaryModel(config SubmissionFormConfig, pcImport *bool) SummaryModel {
 		m.form = huh.NewForm(
 			huh.NewGroup(
 				huh.NewConfirm().
-					Title("Is the above information correct?").
+					Title(i18n.T("Is the above information correct?")).
 					Value(pcImport),
 			),
 		).WithShowHelp(false).WithTheme(views.GetCustomTheme())
@@ -248,7 +249,7 @@ func (m SummaryModel) View() string {
 		return ""
 	}
 
-	view := m.form.WithHeight(5).View() + "\n" + configurationHelpLine
+	view := m.form.WithHeight(5).View() + "\n" + lipgloss.NewStyle().Foreground(views.Gray).Render(configurationHelpLine)
 
 	if len(m.projectList) > 1 || len(m.projectList) == 1 && ProjectsConfigurationChanged {
 		summary, err := RenderSummary(m.name, m.projectList, m.defaults, m.nameLabel)
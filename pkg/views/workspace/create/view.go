@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -67,10 +68,10 @@ func GetRepositoryFromUrlInput(multiProject bool, projectOrder int, apiClient *a
 	m.lg = lipgloss.DefaultRenderer()
 	m.styles = NewStyles(m.lg)
 
-	title := "Git repository"
+	title := i18n.T("Git repository")
 
 	if multiProject {
-		title = getOrderNumberString(projectOrder) + " project repository"
+		title = i18n.T("%s project repository", i18n.T(getOrderNumberString(projectOrder)))
 	}
 
 	var initialRepoUrl string
@@ -91,7 +92,7 @@ func GetRepositoryFromUrlInput(multiProject bool, projectOrder int, apiClient *a
 			previousRepoUrl = str
 			previousError = nil
 
-			err := views_util.WithInlineSpinner("Validating", func() error {
+			err := views_util.WithInlineSpinner(i18n.T("Validating"), func() error {
 				var err error
 				repo, err = validateRepoUrl(str, apiClient)
 				return err
@@ -134,7 +135,7 @@ func RunAddMoreProjectsForm() (bool, error) {
 
 	confirmInput :=
 		huh.NewConfirm().
-			Title("Add another project?").
+			Title(i18n.T("Add another project?")).
 			Value(&addMore)
 
 	m.form = huh.NewForm(
@@ -196,7 +197,7 @@ func validateRepoUrl(repoUrl string, apiClient *apiclient.APIClient) (*apiclient
 		Url: result,
 	}).Execute()
 	if err != nil {
-		wrappedErr := "Failed to fetch repository information. Please check the URL and try again."
+		wrappedErr := i18n.T("Failed to fetch repository information. Please check the URL and try again.")
 		return nil, errors.New(views_util.WrapText(wrappedErr, views_util.GetTerminalWidth()))
 	}
 
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+func ListEvents(eventList []apiclient.WorkspaceEvent) {
+	if len(eventList) == 0 {
+		views.RenderInfoMessage("No events found")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, e := range eventList {
+		data = append(data, []string{
+			views.DefaultRowDataStyle.Render(formatTime(e.CreatedAt)),
+			views.NameStyle.Render(e.WorkspaceName + views_util.AdditionalPropertyPadding),
+			views.DefaultRowDataStyle.Render(e.GetProjectName()),
+			getEventTypeStyle(e.Type).Render(string(e.Type)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Time", "Workspace", "Project", "Event",
+	}, nil, func() {
+		for _, e := range eventList {
+			RenderEvent(e)
+		}
+	})
+
+	fmt.Println(table)
+}
+
+// RenderEvent prints the event on a single line
+func RenderEvent(e apiclient.WorkspaceEvent) {
+	target := e.WorkspaceName
+	if e.GetProjectName() != "" {
+		target = fmt.Sprintf("%s/%s", e.WorkspaceName, e.GetProjectName())
+	}
+
+	fmt.Printf("%s  %s  %s\n", views.DefaultRowDataStyle.Render(formatTime(e.CreatedAt)), getEventTypeStyle(e.Type).Render(fmt.Sprintf("%-13s", e.Type)), target)
+}
+
+func formatTime(createdAt string) string {
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return createdAt
+	}
+
+	return t.Local().Format(timeFormat)
+}
+
+func getEventTypeStyle(eventType apiclient.WorkspaceEventType) lipgloss.Style {
+	switch eventType {
+	case apiclient.EventTypeStarted, apiclient.EventTypeCreated:
+		return views.ActiveStyle
+	case apiclient.EventTypeStopped, apiclient.EventTypeRemoved, apiclient.EventTypeHibernated:
+		return views.InactiveStyle
+	default:
+		return views.NameStyle
+	}
+}
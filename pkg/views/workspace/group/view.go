@@ -0,0 +1,212 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package group
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+type Action string
+
+const (
+	ActionNone     Action = ""
+	ActionStartAll Action = "start"
+	ActionStopAll  Action = "stop"
+	ActionRefresh  Action = "refresh"
+)
+
+const helpLine = "s start all • x stop all • r refresh • q quit"
+
+type Summary struct {
+	Workspaces      int
+	Projects        int
+	RunningProjects int
+	// Sum of the uptime of all running projects in seconds
+	TotalUptime int32
+	Usage       Usage
+}
+
+// Resource usage reported by the providers of the projects in a group
+type Usage struct {
+	// Sum of the average CPU usage of the projects in percent of a single CPU
+	CpuUsage float32
+	// Sum of the memory used by the projects in bytes
+	MemoryUsage int64
+}
+
+func GetSummary(workspaceList []apiclient.WorkspaceDTO) Summary {
+	summary := Summary{Workspaces: len(workspaceList)}
+
+	for _, workspace := range workspaceList {
+		for _, project := range workspace.Projects {
+			summary.Projects++
+			if project.State != nil && project.State.Uptime > 0 {
+				summary.RunningProjects++
+				summary.TotalUptime += project.State.Uptime
+			}
+		}
+
+		usage := GetWorkspaceUsage(workspace)
+		summary.Usage.CpuUsage += usage.CpuUsage
+		summary.Usage.MemoryUsage += usage.MemoryUsage
+	}
+
+	return summary
+}
+
+// GetWorkspaceUsage sums up the resource usage of the projects of a workspace.
+// Usage is only available if the workspace was fetched with provider info
+func GetWorkspaceUsage(workspace apiclient.WorkspaceDTO) Usage {
+	usage := Usage{}
+	if workspace.Info == nil {
+		return usage
+	}
+
+	for _, projectInfo := range workspace.Info.Projects {
+		usage.CpuUsage += projectInfo.GetCpuUsage()
+		usage.MemoryUsage += projectInfo.GetMemoryUsage()
+	}
+
+	return usage
+}
+
+func Render(groupName string, workspaceList []apiclient.WorkspaceDTO) {
+	fmt.Println(getStatusView(groupName, workspaceList))
+}
+
+type model struct {
+	groupName     string
+	workspaceList []apiclient.WorkspaceDTO
+	action        Action
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "s":
+			m.action = ActionStartAll
+			return m, tea.Quit
+		case "x":
+			m.action = ActionStopAll
+			return m, tea.Quit
+		case "r":
+			m.action = ActionRefresh
+			return m, tea.Quit
+		case "q", "esc", "ctrl+c":
+			m.action = ActionNone
+			return m, tea.Quit
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) View() string {
+	return getStatusView(m.groupName, m.workspaceList) + "\n\n" + lipgloss.NewStyle().PaddingLeft(1).Foreground(views.Gray).Render(helpLine) + "\n"
+}
+
+// GetActionFromPrompt renders the group status and waits for a single key press
+func GetActionFromPrompt(groupName string, workspaceList []apiclient.WorkspaceDTO) (Action, error) {
+	m, err := tea.NewProgram(model{groupName: groupName, workspaceList: workspaceList}).Run()
+	if err != nil {
+		return ActionNone, err
+	}
+
+	if m, ok := m.(model); ok {
+		return m.action, nil
+	}
+
+	return ActionNone, nil
+}
+
+func getStatusView(groupName string, workspaceList []apiclient.WorkspaceDTO) string {
+	summary := GetSummary(workspaceList)
+
+	output := views.GetStyledMainTitle(fmt.Sprintf("Group %s", groupName)) + "\n\n"
+	output += getSummaryLine("Workspaces", fmt.Sprintf("%d", summary.Workspaces))
+	output += getSummaryLine("Running", fmt.Sprintf("%d/%d projects", summary.RunningProjects, summary.Projects))
+	if summary.TotalUptime > 0 {
+		output += getSummaryLine("Total uptime", util.FormatUptime(summary.TotalUptime))
+	}
+	if summary.RunningProjects > 0 {
+		output += getSummaryLine("CPU", formatCpuUsage(summary.Usage.CpuUsage))
+		output += getSummaryLine("Memory", views_util.FormatBytes(summary.Usage.MemoryUsage))
+	}
+
+	data := [][]string{}
+	for _, workspace := range workspaceList {
+		running := 0
+		var uptime int32
+		for _, project := range workspace.Projects {
+			if project.State != nil && project.State.Uptime > 0 {
+				running++
+				if project.State.Uptime > uptime {
+					uptime = project.State.Uptime
+				}
+			}
+		}
+
+		state := views.InactiveStyle.Render("STOPPED")
+		if running > 0 {
+			state = views.ActiveStyle.Render("RUNNING")
+			if running < len(workspace.Projects) {
+				state = views.ActiveStyle.Render("PARTIAL")
+			}
+			state = fmt.Sprintf("%s %s", state, views.DefaultRowDataStyle.Render(fmt.Sprintf("(%s)", util.FormatUptime(uptime))))
+		}
+
+		cpu, memory := "-", "-"
+		if running > 0 && workspace.Info != nil {
+			usage := GetWorkspaceUsage(workspace)
+			cpu = formatCpuUsage(usage.CpuUsage)
+			memory = views_util.FormatBytes(usage.MemoryUsage)
+		}
+
+		data = append(data, []string{
+			views.NameStyle.Render(workspace.Name),
+			views.DefaultRowDataStyle.Render(workspace.Target),
+			views.DefaultRowDataStyle.Render(fmt.Sprintf("%d/%d", running, len(workspace.Projects))),
+			views.DefaultRowDataStyle.Render(cpu),
+			views.DefaultRowDataStyle.Render(memory),
+			state,
+		})
+	}
+
+	t := table.New().
+		Headers("Workspace", "Target", "Projects", "CPU", "Memory", "Status").
+		Rows(data...).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		BorderHeader(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle.PaddingBottom(0)
+		})
+
+	output += "\n" + t.String()
+
+	return lipgloss.NewStyle().PaddingLeft(1).Render(output)
+}
+
+func formatCpuUsage(cpuUsage float32) string {
+	return fmt.Sprintf("%.1f%%", cpuUsage)
+}
+
+func getSummaryLine(key, value string) string {
+	return views.GetPropertyKey(fmt.Sprintf("%-16s", key)) + views.NameStyle.Bold(true).Render(value) + "\n"
+}
@@ -9,8 +9,10 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
 	"golang.org/x/term"
 )
 
@@ -41,6 +43,26 @@ func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
 
 	output += getInfoLine("ID", workspace.Id) + "\n"
 
+	if workspace.Group != nil && *workspace.Group != "" {
+		output += getInfoLine("Group", *workspace.Group) + "\n"
+	}
+
+	if len(workspace.Labels) > 0 {
+		output += getInfoLine("Labels", views_util.FormatLabels(workspace.Labels)) + "\n"
+	}
+
+	if workspace.Owner != nil && *workspace.Owner != "" {
+		output += getInfoLine("Owner", *workspace.Owner) + "\n"
+	}
+
+	if len(workspace.SharedWith) > 0 {
+		output += getInfoLine("Shared with", strings.Join(workspace.SharedWith, ", ")) + "\n"
+	}
+
+	if workspace.Origin != nil {
+		output += getInfoLine("Definition", getDefinitionValue(workspace.Origin)) + "\n"
+	}
+
 	if isCreationView {
 		output += getInfoLine("Editor", ide) + "\n"
 	}
@@ -104,7 +126,17 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 	if !isCreationView {
 		output += getInfoLine("Target", project.Target) + "\n"
 	}
+	if project.Resources != nil {
+		output += getInfoLine("Resources", getResourcesValue(project.Resources)) + "\n"
+	}
+	if len(project.Runtimes) > 0 {
+		output += getInfoLine("Runtimes", strings.Join(project.Runtimes, ", ")) + "\n"
+	}
 	output += getInfoLine("Repository", repositoryUrl)
+	if !isCreationView && project.Repository.Sha != "" {
+		output += "\n"
+		output += getInfoLine("Source", getProjectSourceValue(project))
+	}
 
 	if !isCreationView {
 		output += "\n"
@@ -127,7 +159,16 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 		if !isCreationView {
 			output += getInfoLine("Target", project.Target)
 		}
+		if project.Resources != nil {
+			output += getInfoLine("Resources", getResourcesValue(project.Resources))
+		}
+		if len(project.Runtimes) > 0 {
+			output += getInfoLine("Runtimes", strings.Join(project.Runtimes, ", "))
+		}
 		output += getInfoLine("Repository", project.Repository.Url)
+		if !isCreationView && project.Repository.Sha != "" {
+			output += getInfoLine("Source", getProjectSourceValue(&project))
+		}
 		if project.Name != projects[len(projects)-1].Name {
 			output += "\n"
 		}
@@ -135,6 +176,60 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 	return output
 }
 
+// getDefinitionValue returns the definition file and commit a workspace was created from and whether
+// the file changed since if it exists on this machine
+func getDefinitionValue(origin *apiclient.WorkspaceOrigin) string {
+	value := origin.DefinitionFile
+	if origin.DefinitionCommit != nil && *origin.DefinitionCommit != "" {
+		value += fmt.Sprintf(" @ %s", views_util.GetShortSha(*origin.DefinitionCommit))
+	}
+
+	sha256, err := config.GetWorkspaceDefinitionSha256(origin.DefinitionFile)
+	if err == nil && sha256 != origin.DefinitionSha256 {
+		value += " (modified since creation)"
+	}
+
+	return value
+}
+
+// getProjectSourceValue returns the commit, devcontainer and project config a project was created from
+func getProjectSourceValue(project *apiclient.Project) string {
+	sources := []string{fmt.Sprintf("commit %s", views_util.GetShortSha(project.Repository.Sha))}
+
+	if project.BuildConfig != nil && project.BuildConfig.Devcontainer != nil {
+		sources = append(sources, fmt.Sprintf("devcontainer %s", project.BuildConfig.Devcontainer.FilePath))
+	}
+
+	if project.ProjectConfigName != nil && *project.ProjectConfigName != "" {
+		source := fmt.Sprintf("project config %s", *project.ProjectConfigName)
+		if project.ProjectConfigVersion != nil && *project.ProjectConfigVersion > 0 {
+			source += fmt.Sprintf(" (v%d)", *project.ProjectConfigVersion)
+		}
+		sources = append(sources, source)
+	}
+
+	return strings.Join(sources, ", ")
+}
+
+func getResourcesValue(resources *apiclient.ResourceLimits) string {
+	limits := []string{}
+	if resources.Cpus != nil && *resources.Cpus > 0 {
+		limits = append(limits, fmt.Sprintf("%g CPU", *resources.Cpus))
+	}
+	if resources.Memory != nil && *resources.Memory > 0 {
+		limits = append(limits, fmt.Sprintf("%d MiB memory", *resources.Memory))
+	}
+	if resources.Disk != nil && *resources.Disk > 0 {
+		limits = append(limits, fmt.Sprintf("%d GiB disk", *resources.Disk))
+	}
+
+	if len(limits) == 0 {
+		return "Unlimited"
+	}
+
+	return strings.Join(limits, ", ")
+}
+
 func getInfoLine(key, value string) string {
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
 }
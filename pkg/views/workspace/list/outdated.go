@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+// NotifyOutdatedWorkspaces lets the user know which workspaces have projects created from an older version of their project config
+func NotifyOutdatedWorkspaces(workspaceNames []string) {
+	if len(workspaceNames) == 0 {
+		return
+	}
+
+	sort.Strings(workspaceNames)
+
+	views.RenderInfoMessageBold(i18n.T("A newer project config version is available for: %s", strings.Join(workspaceNames, ", ")))
+	views.RenderTip(i18n.T("Use 'daytona upgrade-template [WORKSPACE]' to rebuild the projects with the latest version"))
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+// ProfileWorkspaceList is the result of listing the workspaces of a single profile
+type ProfileWorkspaceList struct {
+	ProfileName   string                   `json:"profile"`
+	WorkspaceList []apiclient.WorkspaceDTO `json:"workspaces"`
+	Error         string                   `json:"error,omitempty"`
+}
+
+// ListProfileWorkspaces renders the workspaces of multiple profiles in a single table with a profile column.
+// Profiles whose server could not be reached are rendered as a row with the error
+func ListProfileWorkspaces(profileLists []ProfileWorkspaceList, verbose bool) {
+	// The profile name is only shown in the first row of each profile
+	headers, data := getProfileWorkspacesTable(profileLists, verbose, false)
+	if len(data) == 0 {
+		views_util.NotifyEmptyWorkspaceList(true)
+		return
+	}
+
+	table := views_util.GetTableView(data, headers, nil, func() {
+		renderUnstyledProfileList(profileLists)
+	})
+
+	fmt.Println(table)
+}
+
+// GetProfileWorkspacesTable returns the headers and rows of the table of the workspaces of multiple profiles,
+// e.g. for the csv format. The profile name is set in every row
+func GetProfileWorkspacesTable(profileLists []ProfileWorkspaceList, verbose bool) ([]string, [][]string) {
+	return getProfileWorkspacesTable(profileLists, verbose, true)
+}
+
+func getProfileWorkspacesTable(profileLists []ProfileWorkspaceList, verbose, profileNameInEveryRow bool) ([]string, [][]string) {
+	data := [][]string{}
+	profileColumn := []string{}
+	showLabels := false
+
+	for _, profileList := range profileLists {
+		if profileList.Error != "" {
+			row := make([]string, len(tableHeaders))
+			row[0] = views.InactiveStyle.Render("unreachable")
+			row[3] = views.DefaultRowDataStyle.Render(profileList.Error)
+			data = append(data, row)
+			profileColumn = append(profileColumn, views.NameStyle.Render(profileList.ProfileName))
+			continue
+		}
+
+		SortWorkspaces(&profileList.WorkspaceList, verbose)
+		if hasLabels(profileList.WorkspaceList) {
+			showLabels = true
+		}
+
+		for i, row := range getTableData(profileList.WorkspaceList, false, tableRowOptions{}) {
+			profileName := ""
+			if i == 0 || profileNameInEveryRow {
+				profileName = views.NameStyle.Render(profileList.ProfileName)
+			}
+			data = append(data, row)
+			profileColumn = append(profileColumn, profileName)
+		}
+	}
+
+	headers, data := trimTableColumns(tableHeaders, data, verbose, showLabels)
+
+	headers = append([]string{"Profile"}, headers...)
+	for i := range data {
+		data[i] = append([]string{profileColumn[i]}, data[i]...)
+	}
+
+	return headers, data
+}
+
+func renderUnstyledProfileList(profileLists []ProfileWorkspaceList) {
+	for _, profileList := range profileLists {
+		fmt.Printf("%s %s\n\n", views.GetPropertyKey("Profile:"), profileList.ProfileName)
+
+		if profileList.Error != "" {
+			fmt.Printf("%s %s\n\n", views.GetPropertyKey("Error:"), profileList.Error)
+		} else {
+			renderUnstyledList(profileList.WorkspaceList)
+		}
+
+		fmt.Printf("\n%s\n\n", views.SeparatorString)
+	}
+}
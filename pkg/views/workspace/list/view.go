@@ -5,6 +5,7 @@ package list
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/charmbracelet/lipgloss"
@@ -20,8 +21,26 @@ type RowData struct {
 	Repository string
 	Target     string
 	Status     string
+	Labels     string
+	Commit     string
 	Created    string
 	Branch     string
+	// Stopped rows of hibernated workspaces are rendered as hibernated
+	Hibernated bool
+	// Highlighted rows are rendered in a different color, e.g. when their state changed
+	Highlighted bool
+	Selected    bool
+	// PendingState replaces the rendered state while an action on the workspace is in progress
+	PendingState string
+}
+
+// tableRowOptions change how the rows of the table are rendered, e.g. in the watch view
+type tableRowOptions struct {
+	// Keys of the rows to highlight
+	Highlighted map[string]bool
+	// Rendered states by row key that replace the fetched states
+	PendingStates       map[string]string
+	SelectedWorkspaceId string
 }
 
 func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders bool, verbose bool, activeProfileName string) {
@@ -32,8 +51,33 @@ func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders
 
 	SortWorkspaces(&workspaceList, verbose)
 
-	headers := []string{"Workspace", "Repository", "Target", "Status", "Created", "Branch"}
+	footer := lipgloss.NewStyle().Foreground(views.LightGray).Render(views.GetListFooter(activeProfileName, &views.Padding{}))
+
+	table := getTableView(workspaceList, specifyGitProviders, verbose, &footer, tableRowOptions{}, func() {
+		renderUnstyledList(workspaceList)
+	})
+
+	fmt.Println(table)
+}
+
+var tableHeaders = []string{"Workspace", "Repository", "Target", "Status", "Labels", "Commit", "Created", "Branch"}
+
+// getTableView renders the workspace list table
+func getTableView(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders, verbose bool, footer *string, options tableRowOptions, fallbackRender func()) string {
+	data := getTableData(workspaceList, specifyGitProviders, options)
+	headers, data := trimTableColumns(tableHeaders, data, verbose, hasLabels(workspaceList))
+
+	return views_util.GetTableView(data, headers, footer, fallbackRender)
+}
+
+// GetTable returns the headers and rows of the workspace list table, e.g. for the csv format
+func GetTable(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders, verbose bool) ([]string, [][]string) {
+	SortWorkspaces(&workspaceList, verbose)
+	data := getTableData(workspaceList, specifyGitProviders, tableRowOptions{})
+	return trimTableColumns(tableHeaders, data, verbose, hasLabels(workspaceList))
+}
 
+func getTableData(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders bool, options tableRowOptions) [][]string {
 	data := [][]string{}
 
 	for _, workspace := range workspaceList {
@@ -41,27 +85,39 @@ func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders
 		var row []string
 
 		if len(workspace.Projects) == 1 {
+			rowKey := getRowKey(workspace.Id, workspace.Projects[0].Name)
 			rowData = getWorkspaceTableRowData(workspace, specifyGitProviders)
+			rowData.Highlighted = options.Highlighted[rowKey]
+			rowData.PendingState = options.PendingStates[rowKey]
+			rowData.Selected = options.SelectedWorkspaceId == workspace.Id
 			row = getRowFromRowData(*rowData, false)
 			data = append(data, row)
 		} else {
-			row = getRowFromRowData(RowData{Name: workspace.Name}, true)
+			row = getRowFromRowData(RowData{Name: fmt.Sprintf("%s (%d projects)", workspace.Name, len(workspace.Projects)), Status: getAutoStopStatus(workspace), Labels: views_util.FormatLabels(workspace.Labels), Selected: options.SelectedWorkspaceId == workspace.Id}, true)
 			data = append(data, row)
 			for _, project := range workspace.Projects {
 				rowData = getProjectTableRowData(workspace, project, specifyGitProviders)
 				if rowData == nil {
 					continue
 				}
+				rowKey := getRowKey(workspace.Id, project.Name)
+				rowData.Highlighted = options.Highlighted[rowKey]
+				rowData.PendingState = options.PendingStates[rowKey]
 				row = getRowFromRowData(*rowData, false)
 				data = append(data, row)
 			}
 		}
 	}
 
+	return data
+}
+
+// trimTableColumns hides the columns that are only shown in verbose mode and the labels column if there are no labels
+func trimTableColumns(headers []string, data [][]string, verbose, showLabels bool) ([]string, [][]string) {
 	if !verbose {
-		headers = headers[:len(headers)-2]
+		headers = headers[:len(headers)-3]
 		for value := range data {
-			data[value] = data[value][:len(data[value])-2]
+			data[value] = data[value][:len(data[value])-3]
 		}
 	} else {
 		// Temporarily hiding the branch column
@@ -71,13 +127,14 @@ func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders
 		}
 	}
 
-	footer := lipgloss.NewStyle().Foreground(views.LightGray).Render(views.GetListFooter(activeProfileName, &views.Padding{}))
-
-	table := views_util.GetTableView(data, headers, &footer, func() {
-		renderUnstyledList(workspaceList)
-	})
+	if !showLabels {
+		headers = slices.Delete(slices.Clone(headers), labelsColumn, labelsColumn+1)
+		for value := range data {
+			data[value] = slices.Delete(data[value], labelsColumn, labelsColumn+1)
+		}
+	}
 
-	fmt.Println(table)
+	return headers, data
 }
 
 func renderUnstyledList(workspaceList []apiclient.WorkspaceDTO) {
@@ -91,23 +148,52 @@ func renderUnstyledList(workspaceList []apiclient.WorkspaceDTO) {
 	}
 }
 
+// Index of the labels column which is hidden if no workspace has labels
+const labelsColumn = 4
+
+func hasLabels(workspaceList []apiclient.WorkspaceDTO) bool {
+	for _, workspace := range workspaceList {
+		if len(workspace.Labels) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getRowFromRowData(rowData RowData, isMultiProjectAccordion bool) []string {
 	var state string
-	if rowData.Status == "" {
+	if rowData.Status == "" && rowData.Hibernated {
+		state = views.InactiveStyle.Render("HIBERNATED")
+	} else if rowData.Status == "" {
 		state = views.InactiveStyle.Render("STOPPED")
 	} else {
 		state = views.ActiveStyle.Render("RUNNING")
 	}
 
 	if isMultiProjectAccordion {
-		return []string{rowData.Name, "", "", "", "", ""}
+		name := rowData.Name
+		if rowData.Selected {
+			name = lipgloss.NewStyle().Reverse(true).Render(name)
+		}
+		return []string{name, "", "", views.DefaultRowDataStyle.Render(rowData.Status), views.DefaultRowDataStyle.Render(rowData.Labels), "", "", ""}
+	}
+
+	nameStyle := views.NameStyle
+	if rowData.Highlighted {
+		nameStyle = lipgloss.NewStyle().Foreground(views.Yellow).Bold(true)
+	}
+	if rowData.Selected {
+		nameStyle = nameStyle.Reverse(true)
 	}
 
 	row := []string{
-		views.NameStyle.Render(rowData.Name),
+		nameStyle.Render(rowData.Name),
 		views.DefaultRowDataStyle.Render(rowData.Repository),
 		views.DefaultRowDataStyle.Render(rowData.Target),
 		state,
+		views.DefaultRowDataStyle.Render(rowData.Labels),
+		views.DefaultRowDataStyle.Render(rowData.Commit),
 		views.DefaultRowDataStyle.Render(rowData.Created),
 		views.DefaultRowDataStyle.Render(views.GetBranchNameLabel(rowData.Branch)),
 	}
@@ -116,6 +202,10 @@ func getRowFromRowData(rowData RowData, isMultiProjectAccordion bool) []string {
 		row[3] = fmt.Sprintf("%s %s", state, views.DefaultRowDataStyle.Render(fmt.Sprintf("(%s)", rowData.Status)))
 	}
 
+	if rowData.PendingState != "" {
+		row[3] = rowData.PendingState
+	}
+
 	return row
 }
 
@@ -147,32 +237,40 @@ func SortWorkspaces(workspaceList *[]apiclient.WorkspaceDTO, verbose bool) {
 }
 
 func getWorkspaceTableRowData(workspace apiclient.WorkspaceDTO, specifyGitProviders bool) *RowData {
-	rowData := RowData{"", "", "", "", "", ""}
+	rowData := RowData{}
 	rowData.Name = workspace.Name + views_util.AdditionalPropertyPadding
 	if len(workspace.Projects) > 0 {
 		rowData.Repository = util.GetRepositorySlugFromUrl(workspace.Projects[0].Repository.Url, specifyGitProviders)
 		rowData.Branch = workspace.Projects[0].Repository.Branch
+		rowData.Commit = views_util.GetShortSha(workspace.Projects[0].Repository.Sha)
 	}
 
 	rowData.Target = workspace.Target + views_util.AdditionalPropertyPadding
+	rowData.Labels = views_util.FormatLabels(workspace.Labels)
+	rowData.Hibernated = workspace.HibernatedAt != nil
 
 	if workspace.Info != nil && workspace.Info.Projects != nil && len(workspace.Info.Projects) > 0 {
 		rowData.Created = util.FormatTimestamp(workspace.Info.Projects[0].Created)
 	}
 	if len(workspace.Projects) > 0 && workspace.Projects[0].State != nil && workspace.Projects[0].State.Uptime > 0 {
 		rowData.Status = util.FormatUptime(workspace.Projects[0].State.Uptime)
+		if autoStopStatus := getAutoStopStatus(workspace); autoStopStatus != "" {
+			rowData.Status = fmt.Sprintf("%s, %s", rowData.Status, autoStopStatus)
+		}
 	}
 	return &rowData
 }
 
 func getProjectTableRowData(workspaceDTO apiclient.WorkspaceDTO, project apiclient.Project, specifyGitProviders bool) *RowData {
-	rowData := RowData{"", "", "", "", "", ""}
+	rowData := RowData{}
 	rowData.Name = " └ " + project.Name
 
 	rowData.Repository = util.GetRepositorySlugFromUrl(project.Repository.Url, specifyGitProviders)
 	rowData.Branch = project.Repository.Branch
+	rowData.Commit = views_util.GetShortSha(project.Repository.Sha)
 
 	rowData.Target = project.Target + views_util.AdditionalPropertyPadding
+	rowData.Hibernated = workspaceDTO.HibernatedAt != nil
 
 	if project.State != nil && project.State.Uptime > 0 {
 		rowData.Status = util.FormatUptime(project.State.Uptime)
@@ -191,3 +289,31 @@ func getProjectTableRowData(workspaceDTO apiclient.WorkspaceDTO, project apiclie
 
 	return &rowData
 }
+
+// getAutoStopStatus returns the time left until the workspace is stopped for inactivity. Returns an empty
+// string if the workspace has no auto-stop timeout, isn't running or has an open SSH connection
+func getAutoStopStatus(workspace apiclient.WorkspaceDTO) string {
+	if workspace.AutoStop == nil || *workspace.AutoStop <= 0 {
+		return ""
+	}
+
+	var idleTime *int32
+	for _, project := range workspace.Projects {
+		if project.State == nil || project.State.Uptime == 0 {
+			continue
+		}
+		if project.State.IdleTime == nil || *project.State.IdleTime == 0 {
+			return ""
+		}
+		if idleTime == nil || *project.State.IdleTime < *idleTime {
+			idleTime = project.State.IdleTime
+		}
+	}
+
+	if idleTime == nil {
+		return ""
+	}
+
+	timeLeft := max(*workspace.AutoStop*60-*idleTime, 0)
+	return fmt.Sprintf("stops in %s", util.FormatUptime(timeLeft))
+}
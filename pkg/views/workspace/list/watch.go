@@ -0,0 +1,320 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+type WatchParams struct {
+	// Returns the current workspace list, called on every refresh
+	FetchWorkspaces     func() ([]apiclient.WorkspaceDTO, error)
+	Interval            time.Duration
+	SpecifyGitProviders bool
+	Verbose             bool
+	ActiveProfileName   string
+	// Start and stop the workspace selected in the list. Actions are disabled if not set
+	StartWorkspace func(workspaceId string) error
+	StopWorkspace  func(workspaceId string) error
+}
+
+type workspacesMsg struct {
+	workspaceList []apiclient.WorkspaceDTO
+	err           error
+}
+
+type actionDoneMsg struct {
+	workspaceId string
+	err         error
+}
+
+// pendingAction is a start or stop of a workspace that is shown optimistically until a refresh
+// confirms the expected state
+type pendingAction struct {
+	verb          string
+	expectedState string
+	// Time when the request finished, zero while it's in progress
+	doneAt time.Time
+}
+
+type watchModel struct {
+	params        WatchParams
+	workspaceList []apiclient.WorkspaceDTO
+	// Project states from the previous refresh by row key
+	states          map[string]string
+	highlightedRows map[string]bool
+	lastUpdated     time.Time
+	err             error
+	// Number of consecutive failed refreshes, used to back off while the server is unreachable
+	failures  int
+	nextFetch time.Duration
+	cursor    int
+	// Pending actions by workspace ID
+	pending   map[string]*pendingAction
+	spinner   spinner.Model
+	actionErr error
+}
+
+const maxWatchInterval = time.Minute
+
+// Time after a finished action until the row shows the fetched state even if it doesn't match the expected state
+const reconcileTimeout = time.Minute
+
+// WatchWorkspaces renders the workspace list and refreshes it until the user quits.
+// Rows whose state changed since the previous refresh are highlighted
+func WatchWorkspaces(params WatchParams) error {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(views.Green)
+
+	_, err := tea.NewProgram(watchModel{params: params, pending: map[string]*pendingAction{}, spinner: s}).Run()
+	return err
+}
+
+func (m watchModel) Init() tea.Cmd {
+	return m.fetch
+}
+
+func (m watchModel) fetch() tea.Msg {
+	workspaceList, err := m.params.FetchWorkspaces()
+	return workspacesMsg{workspaceList: workspaceList, err: err}
+}
+
+func (m watchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(m.cursor-1, 0)
+		case "down", "j":
+			m.cursor = min(m.cursor+1, max(len(m.workspaceList)-1, 0))
+		case "s":
+			if m.params.StartWorkspace != nil {
+				return m.runAction("start", "RUNNING", m.params.StartWorkspace)
+			}
+		case "x":
+			if m.params.StopWorkspace != nil {
+				return m.runAction("stop", "STOPPED", m.params.StopWorkspace)
+			}
+		}
+	case spinner.TickMsg:
+		if len(m.pending) == 0 {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	case actionDoneMsg:
+		action, ok := m.pending[msg.workspaceId]
+		if !ok {
+			return m, nil
+		}
+		if msg.err != nil {
+			// Revert the optimistic state
+			delete(m.pending, msg.workspaceId)
+			m.actionErr = fmt.Errorf("failed to %s workspace: %w", action.verb, msg.err)
+			return m, nil
+		}
+		action.doneAt = time.Now()
+	case workspacesMsg:
+		m.err = msg.err
+		m.nextFetch = m.params.Interval
+		if msg.err != nil {
+			m.nextFetch = util.GetJitteredBackoff(m.params.Interval, maxWatchInterval, m.failures)
+			m.failures++
+		} else {
+			m.failures = 0
+			states := getProjectStates(msg.workspaceList)
+			m.highlightedRows = getChangedRows(m.states, states)
+			m.states = states
+			m.workspaceList = msg.workspaceList
+			m.lastUpdated = time.Now()
+
+			// Sort by name so that rows don't move between refreshes
+			sort.SliceStable(m.workspaceList, func(i, j int) bool {
+				return m.workspaceList[i].Name < m.workspaceList[j].Name
+			})
+
+			m.cursor = min(m.cursor, max(len(m.workspaceList)-1, 0))
+			m.reconcilePending(states)
+		}
+
+		return m, tea.Tick(m.nextFetch, func(time.Time) tea.Msg {
+			return m.fetch()
+		})
+	}
+
+	return m, nil
+}
+
+func (m watchModel) View() string {
+	if m.states == nil && m.err == nil {
+		return "\n Loading workspaces...\n"
+	}
+
+	var output string
+
+	if len(m.workspaceList) == 0 {
+		output = lipgloss.NewStyle().Margin(1, 0).PaddingLeft(4).Render("No workspaces found") + "\n"
+	} else {
+		footer := lipgloss.NewStyle().Foreground(views.LightGray).Render(views.GetListFooter(m.params.ActiveProfileName, &views.Padding{}))
+		options := tableRowOptions{
+			Highlighted:   m.highlightedRows,
+			PendingStates: m.getPendingStates(),
+		}
+		if m.params.StartWorkspace != nil || m.params.StopWorkspace != nil {
+			options.SelectedWorkspaceId = m.workspaceList[m.cursor].Id
+		}
+		output = getTableView(m.workspaceList, m.params.SpecifyGitProviders, m.params.Verbose, &footer, options, func() {})
+		if output == "" {
+			output = "\n Terminal is too narrow to display the workspace list\n"
+		}
+	}
+
+	keys := "q quit"
+	if m.params.StartWorkspace != nil || m.params.StopWorkspace != nil {
+		keys = "↑/↓ select • s start • x stop • " + keys
+	}
+
+	statusLine := fmt.Sprintf("Refreshing every %s • %s", m.params.Interval, keys)
+	if m.err != nil {
+		statusLine = fmt.Sprintf("Retrying in %s • %s", m.nextFetch.Round(time.Second), keys)
+	}
+	if !m.lastUpdated.IsZero() {
+		statusLine = fmt.Sprintf("Updated at %s • %s", m.lastUpdated.Format(time.TimeOnly), statusLine)
+	}
+	if m.err != nil {
+		statusLine = views.InactiveStyle.Render(fmt.Sprintf("Failed to refresh: %s", m.err)) + "\n" + statusLine
+	}
+	if m.actionErr != nil {
+		statusLine = views.InactiveStyle.Render(m.actionErr.Error()) + "\n" + statusLine
+	}
+
+	return output + lipgloss.NewStyle().PaddingLeft(4).Foreground(views.Gray).Render(statusLine) + "\n"
+}
+
+// runAction marks the selected workspace with the expected state right away and sends the request in the background.
+// The row shows a spinner until a refresh confirms the state
+func (m watchModel) runAction(verb, expectedState string, action func(workspaceId string) error) (tea.Model, tea.Cmd) {
+	if len(m.workspaceList) == 0 {
+		return m, nil
+	}
+
+	workspaceId := m.workspaceList[m.cursor].Id
+	if _, ok := m.pending[workspaceId]; ok {
+		return m, nil
+	}
+
+	m.actionErr = nil
+	m.pending[workspaceId] = &pendingAction{verb: verb, expectedState: expectedState}
+
+	cmds := []tea.Cmd{func() tea.Msg {
+		return actionDoneMsg{workspaceId: workspaceId, err: action(workspaceId)}
+	}}
+	if len(m.pending) == 1 {
+		cmds = append(cmds, m.spinner.Tick)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// reconcilePending removes the pending actions of finished requests once the fetched state of all projects
+// matches the expected state, or the fetched state wasn't reached in time
+func (m watchModel) reconcilePending(states map[string]string) {
+	for _, workspace := range m.workspaceList {
+		action, ok := m.pending[workspace.Id]
+		if !ok || action.doneAt.IsZero() {
+			continue
+		}
+
+		reached := true
+		for _, project := range workspace.Projects {
+			if states[getRowKey(workspace.Id, project.Name)] != action.expectedState {
+				reached = false
+			}
+		}
+
+		if reached || time.Since(action.doneAt) > reconcileTimeout {
+			delete(m.pending, workspace.Id)
+		}
+	}
+
+	// Workspaces that were removed in the meantime
+	for workspaceId := range m.pending {
+		if !slices.ContainsFunc(m.workspaceList, func(w apiclient.WorkspaceDTO) bool { return w.Id == workspaceId }) {
+			delete(m.pending, workspaceId)
+		}
+	}
+}
+
+func (m watchModel) getPendingStates() map[string]string {
+	pendingStates := map[string]string{}
+
+	for _, workspace := range m.workspaceList {
+		action, ok := m.pending[workspace.Id]
+		if !ok {
+			continue
+		}
+
+		style := views.ActiveStyle
+		if action.expectedState == "STOPPED" {
+			style = views.InactiveStyle
+		}
+
+		for _, project := range workspace.Projects {
+			pendingStates[getRowKey(workspace.Id, project.Name)] = fmt.Sprintf("%s%s", m.spinner.View(), style.Render(action.expectedState))
+		}
+	}
+
+	return pendingStates
+}
+
+func getRowKey(workspaceId, projectName string) string {
+	return fmt.Sprintf("%s/%s", workspaceId, projectName)
+}
+
+func getProjectStates(workspaceList []apiclient.WorkspaceDTO) map[string]string {
+	states := map[string]string{}
+
+	for _, workspace := range workspaceList {
+		for _, project := range workspace.Projects {
+			state := "STOPPED"
+			if project.State != nil && project.State.Uptime > 0 {
+				state = "RUNNING"
+			}
+			states[getRowKey(workspace.Id, project.Name)] = state
+		}
+	}
+
+	return states
+}
+
+// getChangedRows returns the rows whose state differs from the previous refresh. Nothing is
+// highlighted on the first refresh
+func getChangedRows(previous, current map[string]string) map[string]bool {
+	changed := map[string]bool{}
+	if previous == nil {
+		return changed
+	}
+
+	for key, state := range current {
+		if previous[key] != state {
+			changed[key] = true
+		}
+	}
+
+	return changed
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 
@@ -32,9 +33,9 @@ func selectBranchPrompt(branches []apiclient.GitBranch, projectOrder int, select
 
 	l := views.GetStyledSelectList(items, selectionListOptions)
 
-	title := "Choose a Branch"
+	title := i18n.T("Choose a Branch")
 	if projectOrder > 1 {
-		title += fmt.Sprintf(" (Project #%d)", projectOrder)
+		title += i18n.T(" (Project #%d)", projectOrder)
 	}
 	l.Title = views.GetStyledMainTitle(title)
 	l.Styles.Title = titleStyle
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 
 	"github.com/charmbracelet/bubbles/list"
@@ -28,7 +29,7 @@ func selectCheckoutPrompt(checkoutOptions []CheckoutOption, projectOrder int, pa
 	items := []list.Item{}
 
 	for _, checkoutOption := range checkoutOptions {
-		newItem := item[string]{id: checkoutOption.Id, title: checkoutOption.Title, choiceProperty: checkoutOption.Id}
+		newItem := item[string]{id: checkoutOption.Id, title: i18n.T(checkoutOption.Title), choiceProperty: checkoutOption.Id}
 		items = append(items, newItem)
 	}
 
@@ -37,9 +38,9 @@ func selectCheckoutPrompt(checkoutOptions []CheckoutOption, projectOrder int, pa
 	}
 	l := views.GetStyledSelectList(items, listOptions)
 
-	title := "Cloning Options"
+	title := i18n.T("Cloning Options")
 	if projectOrder > 1 {
-		title += fmt.Sprintf(" (Project #%d)", projectOrder)
+		title += i18n.T(" (Project #%d)", projectOrder)
 	}
 	l.Title = views.GetStyledMainTitle(title)
 	l.Styles.Title = titleStyle
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/views"
 
 	"github.com/charmbracelet/bubbles/list"
@@ -36,9 +37,9 @@ func selectGitProviderPrompt(gitProviders []gitprovider_view.GitProviderView, pr
 
 	l := views.GetStyledSelectList(items)
 
-	title := "Choose a Git Provider"
+	title := i18n.T("Choose a Git Provider")
 	if projectOrder > 1 {
-		title += fmt.Sprintf(" (Project #%d)", projectOrder)
+		title += i18n.T(" (Project #%d)", projectOrder)
 	}
 	l.Title = views.GetStyledMainTitle(title)
 	l.Styles.Title = titleStyle
@@ -9,6 +9,7 @@ import (
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 )
@@ -34,9 +35,9 @@ func selectNamespacePrompt(namespaces []apiclient.GitNamespace, projectOrder int
 
 	l := views.GetStyledSelectList(items, selectionListOptions)
 
-	title := "Choose a Namespace"
+	title := i18n.T("Choose a Namespace")
 	if projectOrder > 1 {
-		title += fmt.Sprintf(" (Project #%d)", projectOrder)
+		title += i18n.T(" (Project #%d)", projectOrder)
 	}
 	l.Title = views.GetStyledMainTitle(title)
 	l.Styles.Title = titleStyle
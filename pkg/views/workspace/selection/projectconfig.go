@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 
@@ -72,7 +73,7 @@ func selectProjectConfigPrompt(projectConfigs []apiclient.ProjectConfig, project
 
 	title := "Select a Project Config To " + actionVerb
 	if projectOrder > 1 {
-		title += fmt.Sprintf(" (Project #%d)", projectOrder)
+		title += i18n.T(" (Project #%d)", projectOrder)
 	}
 	l.Title = views.GetStyledMainTitle(title)
 	l.Styles.Title = titleStyle
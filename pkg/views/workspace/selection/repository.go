@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 
@@ -34,9 +35,9 @@ func selectRepositoryPrompt(repositories []apiclient.GitRepository, projectOrder
 
 	l := views.GetStyledSelectList(items, selectionListOptions)
 
-	title := "Choose a Repository"
+	title := i18n.T("Choose a Repository")
 	if projectOrder > 1 {
-		title += fmt.Sprintf(" (Project #%d)", projectOrder)
+		title += i18n.T(" (Project #%d)", projectOrder)
 	}
 	l.Title = views.GetStyledMainTitle(title)
 	l.Styles.Title = titleStyle
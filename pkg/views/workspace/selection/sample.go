@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/daytonaio/daytona/internal/i18n"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 
@@ -25,7 +26,7 @@ func selectSamplePrompt(samples []apiclient.Sample, choiceChan chan<- *apiclient
 
 	l := views.GetStyledSelectList(items)
 
-	title := "Choose a Sample"
+	title := i18n.T("Choose a Sample")
 	l.Title = views.GetStyledMainTitle(title)
 	l.Styles.Title = titleStyle
 	m := model[apiclient.Sample]{list: l}
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package selection
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	list_view "github.com/daytonaio/daytona/pkg/views/snapshot/list"
+)
+
+func GetSnapshotFromPrompt(snapshots []apiclient.Snapshot, actionVerb string) *apiclient.Snapshot {
+	choiceChan := make(chan *apiclient.Snapshot)
+	go selectSnapshotPrompt(snapshots, actionVerb, choiceChan)
+	return <-choiceChan
+}
+
+func selectSnapshotPrompt(snapshots []apiclient.Snapshot, actionVerb string, choiceChan chan<- *apiclient.Snapshot) {
+	list_view.SortSnapshots(&snapshots)
+
+	items := []list.Item{}
+
+	for _, s := range snapshots {
+		newItem := item[apiclient.Snapshot]{
+			title:          s.Name,
+			id:             s.Id,
+			desc:           fmt.Sprintf("project %s, created %s", s.ProjectName, util.FormatTimestamp(s.CreatedAt)),
+			choiceProperty: s,
+		}
+		items = append(items, newItem)
+	}
+
+	d := list.NewDefaultDelegate()
+
+	d.Styles.SelectedTitle = lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder(), false, false, false, true).
+		BorderForeground(views.Green).
+		Foreground(views.Green).
+		Bold(true).
+		Padding(0, 0, 0, 1)
+
+	d.Styles.SelectedDesc = d.Styles.SelectedTitle.Foreground(views.DimmedGreen)
+
+	l := list.New(items, d, 0, 0)
+
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(views.Green)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(views.Green)
+
+	l.FilterInput.PromptStyle = lipgloss.NewStyle().Foreground(views.Green)
+	l.FilterInput.TextStyle = lipgloss.NewStyle().Foreground(views.Green)
+
+	l.Title = views.GetStyledMainTitle("Select a Snapshot To " + actionVerb)
+	l.Styles.Title = titleStyle
+
+	m := model[apiclient.Snapshot]{list: l}
+
+	p, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+
+	if m, ok := p.(model[apiclient.Snapshot]); ok && m.choice != nil {
+		choiceChan <- m.choice
+	} else {
+		choiceChan <- nil
+	}
+}
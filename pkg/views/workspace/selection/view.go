@@ -43,12 +43,20 @@ type item[T any] struct {
 	isMarked                                     bool
 	isMultipleSelect                             bool
 	action                                       string
+
+	// Text the list filter matches against, defaults to the title
+	filterValue string
 }
 
 func (i item[T]) Title() string       { return i.title }
 func (i item[T]) Id() string          { return i.id }
 func (i item[T]) Description() string { return i.desc }
-func (i item[T]) FilterValue() string { return i.title }
+func (i item[T]) FilterValue() string {
+	if i.filterValue != "" {
+		return i.filterValue
+	}
+	return i.title
+}
 func (i item[T]) CreatedTime() string { return i.createdTime }
 func (i item[T]) Uptime() string      { return i.uptime }
 func (i item[T]) Target() string      { return i.target }
@@ -59,9 +67,16 @@ type model[T any] struct {
 	choices         []*T
 	footer          string
 	initialWidthSet bool
+	// Shows the filter input when the prompt opens so that typing filters the list right away
+	startFiltering bool
 }
 
 func (m model[T]) Init() tea.Cmd {
+	if m.startFiltering {
+		return func() tea.Msg {
+			return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}}
+		}
+	}
 	return nil
 }
 
@@ -98,11 +113,25 @@ func (m model[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			m.choices = choices
 			return m, tea.Quit
+
+		// The filter input would otherwise take the paging keys while filtering
+		case "pgup":
+			if m.list.FilterState() == list.Filtering {
+				m.list.PrevPage()
+				return m, nil
+			}
+
+		case "pgdown":
+			if m.list.FilterState() == list.Filtering {
+				m.list.NextPage()
+				return m, nil
+			}
 		}
 
 	case tea.WindowSizeMsg:
 		h, v := views.DocStyle.GetFrameSize()
-		m.list.SetSize(msg.Width-h, msg.Height-v)
+		// The footer is rendered below the list so the list pages have to fit in the remaining height
+		m.list.SetSize(msg.Width-h, msg.Height-v-lipgloss.Height(m.getFooter()))
 	}
 
 	var cmd tea.Cmd
@@ -110,21 +139,27 @@ func (m model[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
-func (m model[T]) View() string {
-	if m.footer == "" {
-		c, err := config.GetConfig()
-		if err != nil {
-			log.Fatal(err)
-		}
+func (m model[T]) getFooter() string {
+	if m.footer != "" {
+		return m.footer
+	}
 
-		activeProfile, err := c.GetActiveProfile()
-		if err != nil {
-			log.Fatal(err)
-		}
+	c, err := config.GetConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		m.footer = views.GetListFooter(activeProfile.Name, views.DefaultListFooterPadding)
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		log.Fatal(err)
 	}
 
+	return views.GetListFooter(activeProfile.Name, views.DefaultListFooterPadding)
+}
+
+func (m model[T]) View() string {
+	m.footer = m.getFooter()
+
 	terminalWidth, terminalHeight, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil {
 		return ""
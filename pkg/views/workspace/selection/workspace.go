@@ -25,11 +25,17 @@ func generateWorkspaceList(workspaces []apiclient.WorkspaceDTO, isMultipleSelect
 	// Populate items with titles and descriptions from workspaces.
 	for _, workspace := range workspaces {
 		var projectsInfo []string
+		// Workspaces can be filtered by name, project names and repositories
+		filterValues := []string{workspace.Name}
 
 		if len(workspace.Projects) == 0 {
 			continue
 		}
 
+		for _, project := range workspace.Projects {
+			filterValues = append(filterValues, project.Name, util.GetRepositorySlugFromUrl(project.Repository.Url, true))
+		}
+
 		if len(workspace.Projects) == 1 {
 			projectsInfo = append(projectsInfo, util.GetRepositorySlugFromUrl(workspace.Projects[0].Repository.Url, true))
 		} else {
@@ -52,13 +58,19 @@ func generateWorkspaceList(workspaces []apiclient.WorkspaceDTO, isMultipleSelect
 			}
 		}
 
+		desc := strings.Join(projectsInfo, ", ")
+		if len(workspace.Projects) > 1 {
+			desc = fmt.Sprintf("%d projects: %s", len(workspace.Projects), desc)
+		}
+
 		newItem := item[apiclient.WorkspaceDTO]{
 			title:          workspace.Name,
 			id:             workspace.Id,
-			desc:           strings.Join(projectsInfo, ", "),
+			desc:           desc,
 			createdTime:    createdTime,
 			uptime:         uptime,
 			target:         workspace.Target,
+			filterValue:    strings.Join(filterValues, " "),
 			choiceProperty: workspace,
 		}
 
@@ -87,7 +99,7 @@ func getWorkspaceProgramEssentials(modelTitle string, actionVerb string, workspa
 	l.FilterInput.PromptStyle = lipgloss.NewStyle().Foreground(views.Green)
 	l.FilterInput.TextStyle = lipgloss.NewStyle().Foreground(views.Green)
 
-	m := model[apiclient.WorkspaceDTO]{list: l}
+	m := model[apiclient.WorkspaceDTO]{list: l, startFiltering: !isMultipleSelect && len(items) > 1}
 
 	m.list.Title = views.GetStyledMainTitle(modelTitle + actionVerb)
 	m.list.Styles.Title = lipgloss.NewStyle().Foreground(views.Green).Bold(true)
@@ -125,7 +137,7 @@ func GetWorkspaceFromPrompt(workspaces []apiclient.WorkspaceDTO, actionVerb stri
 func selectWorkspacesFromPrompt(workspaces []apiclient.WorkspaceDTO, actionVerb string, choiceChan chan<- []*apiclient.WorkspaceDTO) {
 	list_view.SortWorkspaces(&workspaces, true)
 
-	footerText := lipgloss.NewStyle().Bold(true).PaddingLeft(2).Render(fmt.Sprintf("\n\nPress 'x' to mark workspace.\nPress 'enter' to %s the current/marked workspaces.", actionVerb))
+	footerText := lipgloss.NewStyle().Bold(true).PaddingLeft(2).Render(fmt.Sprintf("\n\nPress '/' to filter the workspaces.\nPress 'x' to mark workspace.\nPress 'enter' to %s the current/marked workspaces.", actionVerb))
 	p := getWorkspaceProgramEssentials("Select Workspaces To ", actionVerb, workspaces, footerText, true)
 
 	m, ok := p.(model[apiclient.WorkspaceDTO])
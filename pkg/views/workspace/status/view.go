@@ -0,0 +1,140 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+type Health string
+
+const (
+	HealthHealthy  Health = "healthy"
+	HealthDegraded Health = "degraded"
+	HealthStopped  Health = "stopped"
+)
+
+var Healths = []Health{HealthHealthy, HealthDegraded, HealthStopped}
+
+type ProjectState string
+
+const (
+	ProjectStateRunning      ProjectState = "running"
+	ProjectStateUnresponsive ProjectState = "unresponsive"
+	ProjectStateStopped      ProjectState = "stopped"
+)
+
+// Agents report the project state every few seconds. Running projects that have not
+// reported for longer than this are considered unresponsive
+const StaleStateThreshold = time.Minute
+
+type ProjectStatus struct {
+	Name   string       `json:"name"`
+	State  ProjectState `json:"state"`
+	Uptime int32        `json:"uptime"`
+	// Time of the last state report of the project agent
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+type WorkspaceStatus struct {
+	Id       string          `json:"id"`
+	Name     string          `json:"name"`
+	Health   Health          `json:"health"`
+	Projects []ProjectStatus `json:"projects"`
+}
+
+// GetWorkspaceStatus returns the health of a workspace. A workspace is healthy if all of its
+// projects are running and responsive, stopped if none of them are and degraded otherwise
+func GetWorkspaceStatus(workspace apiclient.WorkspaceDTO, now time.Time) WorkspaceStatus {
+	status := WorkspaceStatus{
+		Id:       workspace.Id,
+		Name:     workspace.Name,
+		Projects: []ProjectStatus{},
+	}
+
+	running, stopped := 0, 0
+	for _, project := range workspace.Projects {
+		projectStatus := getProjectStatus(project, now)
+		switch projectStatus.State {
+		case ProjectStateRunning:
+			running++
+		case ProjectStateStopped:
+			stopped++
+		}
+		status.Projects = append(status.Projects, projectStatus)
+	}
+
+	switch {
+	case len(workspace.Projects) > 0 && running == len(workspace.Projects):
+		status.Health = HealthHealthy
+	case stopped == len(workspace.Projects):
+		status.Health = HealthStopped
+	default:
+		status.Health = HealthDegraded
+	}
+
+	return status
+}
+
+func getProjectStatus(project apiclient.Project, now time.Time) ProjectStatus {
+	status := ProjectStatus{
+		Name:  project.Name,
+		State: ProjectStateStopped,
+	}
+
+	if project.State == nil || project.State.Uptime == 0 {
+		return status
+	}
+
+	status.Uptime = project.State.Uptime
+	status.UpdatedAt = project.State.UpdatedAt
+	status.State = ProjectStateRunning
+
+	updatedAt, err := time.Parse(time.RFC1123, project.State.UpdatedAt)
+	if err != nil || now.Sub(updatedAt) > StaleStateThreshold {
+		status.State = ProjectStateUnresponsive
+	}
+
+	return status
+}
+
+func Render(status WorkspaceStatus) {
+	output := views.GetStyledMainTitle(fmt.Sprintf("Workspace %s", status.Name)) + "\n\n"
+	output += getPropertyLine("Health", renderHealth(status.Health)) + "\n"
+
+	for _, project := range status.Projects {
+		state := views.InactiveStyle.Render(string(project.State))
+		switch project.State {
+		case ProjectStateRunning:
+			state = views.ActiveStyle.Render(string(project.State))
+			state += views.DefaultRowDataStyle.Render(fmt.Sprintf(" (%s)", util.FormatUptime(project.Uptime)))
+		case ProjectStateUnresponsive:
+			state = views.DefaultRowDataStyle.Render(fmt.Sprintf("%s (last report %s)", project.State, project.UpdatedAt))
+		}
+		output += getPropertyLine(project.Name, state)
+	}
+
+	fmt.Println(lipgloss.NewStyle().PaddingLeft(1).Render(output))
+}
+
+func renderHealth(health Health) string {
+	switch health {
+	case HealthHealthy:
+		return views.ActiveStyle.Render(string(health))
+	case HealthStopped:
+		return views.InactiveStyle.Render(string(health))
+	}
+
+	return views.NameStyle.Bold(true).Render(string(health))
+}
+
+func getPropertyLine(key, value string) string {
+	return views.GetPropertyKey(fmt.Sprintf("%-16s", key)) + value + "\n"
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package teardown
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/i18n"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// RenderProgress prints a line when a teardown step starts and when it fails
+func RenderProgress(progress workspace.TeardownProgress) {
+	description := getStepDescription(progress)
+	if description == "" {
+		return
+	}
+
+	switch progress.Status {
+	case workspace.TeardownStatusStarted:
+		fmt.Println(views.DefaultRowDataStyle.Render("- " + description))
+	case workspace.TeardownStatusFailed:
+		fmt.Println(lipgloss.NewStyle().Foreground(views.Red).Render(fmt.Sprintf("✗ %s: %s", description, progress.Error)))
+	}
+}
+
+func getStepDescription(progress workspace.TeardownProgress) string {
+	switch progress.Step {
+	case workspace.TeardownStepDestroyProject:
+		return i18n.T("Stopping and removing the container and volumes of project %s", progress.ProjectName)
+	case workspace.TeardownStepDestroyWorkspace:
+		return i18n.T("Removing the workspace network and files")
+	case workspace.TeardownStepRevokeApiKeys:
+		return i18n.T("Revoking API keys")
+	case workspace.TeardownStepRemoveLogs:
+		return i18n.T("Removing logs")
+	case workspace.TeardownStepRemoveSnapshots:
+		return i18n.T("Removing snapshots")
+	case workspace.TeardownStepDeleteWorkspace:
+		return i18n.T("Deleting the workspace")
+	}
+
+	return ""
+}
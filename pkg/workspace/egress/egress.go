@@ -0,0 +1,45 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package egress
+
+import "time"
+
+// Format of the month usage is accounted in
+const MonthFormat = "2006-01"
+
+// Outbound network traffic of a project during a calendar month
+type Usage struct {
+	WorkspaceId   string `json:"workspaceId" validate:"required"`
+	WorkspaceName string `json:"workspaceName" validate:"required"`
+	ProjectName   string `json:"projectName" validate:"required"`
+	// Month in the YYYY-MM format
+	Month string `json:"month" validate:"required"`
+	Bytes uint64 `json:"bytes" validate:"required" format:"int64"`
+	// Last egress counter reported by the provider. Counters start from zero when a project is restarted
+	LastCounter uint64    `json:"lastCounter" validate:"required" format:"int64"`
+	UpdatedAt   time.Time `json:"updatedAt" validate:"required"`
+} // @name EgressUsage
+
+// Add accounts the traffic sent since the last reported counter
+func (u *Usage) Add(counter uint64) {
+	if counter >= u.LastCounter {
+		u.Bytes += counter - u.LastCounter
+	} else {
+		// The project was restarted between two samples
+		u.Bytes += counter
+	}
+
+	u.LastCounter = counter
+	u.UpdatedAt = time.Now()
+}
+
+// WorkspaceTotals returns the bytes sent by each workspace keyed by workspace ID
+func WorkspaceTotals(usages []*Usage) map[string]uint64 {
+	totals := map[string]uint64{}
+	for _, u := range usages {
+		totals[u.WorkspaceId] += u.Bytes
+	}
+
+	return totals
+}
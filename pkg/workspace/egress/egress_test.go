@@ -0,0 +1,33 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package egress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdd(t *testing.T) {
+	usage := &Usage{}
+
+	usage.Add(100)
+	usage.Add(250)
+	require.Equal(t, uint64(250), usage.Bytes)
+
+	// Restarted project reports a counter that started from zero
+	usage.Add(40)
+	require.Equal(t, uint64(290), usage.Bytes)
+	require.Equal(t, uint64(40), usage.LastCounter)
+}
+
+func TestWorkspaceTotals(t *testing.T) {
+	usages := []*Usage{
+		{WorkspaceId: "1", ProjectName: "a", Bytes: 100},
+		{WorkspaceId: "1", ProjectName: "b", Bytes: 50},
+		{WorkspaceId: "2", ProjectName: "a", Bytes: 10},
+	}
+
+	require.Equal(t, map[string]uint64{"1": 150, "2": 10}, WorkspaceTotals(usages))
+}
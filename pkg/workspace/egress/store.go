@@ -0,0 +1,15 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package egress
+
+type Store interface {
+	List(filter *Filter) ([]*Usage, error)
+	Save(usage *Usage) error
+}
+
+type Filter struct {
+	WorkspaceId *string
+	ProjectName *string
+	Month       *string
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import "time"
+
+type EventType string // @name WorkspaceEventType
+
+const (
+	EventTypeCreated             EventType = "created"
+	EventTypeStarted             EventType = "started"
+	EventTypeStopped             EventType = "stopped"
+	EventTypeSshConnected        EventType = "ssh-connected"
+	EventTypeRemoved             EventType = "removed"
+	EventTypeEgressQuotaExceeded EventType = "egress-quota-exceeded"
+	EventTypeHibernated          EventType = "hibernated"
+	EventTypeWoken               EventType = "woken"
+)
+
+// Lifecycle event of a workspace or one of its projects
+type Event struct {
+	Id            string `json:"id" validate:"required"`
+	WorkspaceId   string `json:"workspaceId" validate:"required"`
+	WorkspaceName string `json:"workspaceName" validate:"required"`
+	// Empty if the event concerns the whole workspace
+	ProjectName string    `json:"projectName,omitempty" validate:"optional"`
+	Type        EventType `json:"type" validate:"required"`
+	CreatedAt   time.Time `json:"createdAt" validate:"required"`
+} // @name WorkspaceEvent
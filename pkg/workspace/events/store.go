@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import "time"
+
+type Store interface {
+	List(filter *Filter) ([]*Event, error)
+	Save(event *Event) error
+}
+
+type Filter struct {
+	// Workspace ID or name. Events of removed workspaces are kept so the name can still be used
+	Workspace *string
+	// Only include events created after this time
+	Since *time.Time
+}
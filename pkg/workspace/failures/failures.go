@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package failures
+
+import (
+	"strings"
+	"time"
+)
+
+// Category of a provisioning failure, derived from the error message
+type Category string // @name FailureCategory
+
+const (
+	CategoryImagePull Category = "image-pull"
+	CategoryCloneAuth Category = "clone-auth"
+	CategoryOOM       Category = "oom"
+	CategoryDisk      Category = "disk"
+	CategoryNetwork   Category = "network"
+	CategoryOther     Category = "other"
+)
+
+// Failure of a provisioning attempt. Only the category and the provider are recorded so that
+// failures can't be traced back to workspaces or users
+type Failure struct {
+	Id        string    `json:"id" validate:"required"`
+	Category  Category  `json:"category" validate:"required"`
+	Provider  string    `json:"provider" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name ProvisioningFailure
+
+// Patterns of the error messages by category. Categories are matched in order
+var categoryPatterns = []struct {
+	category Category
+	patterns []string
+}{
+	{CategoryOOM, []string{"out of memory", "oomkilled", "oom-kill", "cannot allocate memory", "exit code 137"}},
+	{CategoryDisk, []string{"no space left on device", "disk quota exceeded", "insufficient disk", "not enough disk"}},
+	{CategoryCloneAuth, []string{"authentication required", "authentication failed", "could not read username", "invalid username or password", "permission denied (publickey)", "repository not found"}},
+	{CategoryImagePull, []string{"pull access denied", "manifest unknown", "error pulling image", "failed to pull", "toomanyrequests", "image not found", "no such image"}},
+	{CategoryNetwork, []string{"connection refused", "i/o timeout", "no such host", "tls handshake timeout", "connection reset by peer", "network is unreachable"}},
+}
+
+// Categorize returns the category of a provisioning error
+func Categorize(err error) Category {
+	message := strings.ToLower(err.Error())
+
+	for _, c := range categoryPatterns {
+		for _, pattern := range c.patterns {
+			if strings.Contains(message, pattern) {
+				return c.category
+			}
+		}
+	}
+
+	return CategoryOther
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package failures
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategorize(t *testing.T) {
+	tests := []struct {
+		err      string
+		expected Category
+	}{
+		{"Error response from daemon: pull access denied for foo/bar, repository does not exist", CategoryImagePull},
+		{"failed to clone repository: authentication required", CategoryCloneAuth},
+		{"container exited with exit code 137 (OOMKilled)", CategoryOOM},
+		{"write /workspaces/project/.git/index: no space left on device", CategoryDisk},
+		{"dial tcp 10.0.0.1:2375: connect: connection refused", CategoryNetwork},
+		{"something unexpected happened", CategoryOther},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, Categorize(errors.New(tt.err)), tt.err)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	now := time.Now()
+	failureList := []*Failure{
+		{Id: "1", Category: CategoryImagePull, Provider: "docker-provider", CreatedAt: now.Add(-2 * time.Hour)},
+		{Id: "2", Category: CategoryOOM, Provider: "docker-provider", CreatedAt: now.Add(-time.Hour)},
+		{Id: "3", Category: CategoryImagePull, Provider: "aws-provider", CreatedAt: now},
+	}
+
+	stats := Aggregate(failureList, now.Add(-24*time.Hour))
+
+	require.Equal(t, 3, stats.Total)
+	require.Len(t, stats.Categories, 2)
+	require.Equal(t, CategoryImagePull, stats.Categories[0].Category)
+	require.Equal(t, 2, stats.Categories[0].Count)
+	require.Equal(t, map[string]int{"docker-provider": 1, "aws-provider": 1}, stats.Categories[0].Providers)
+	require.Equal(t, now, stats.Categories[0].LastOccurred)
+	require.Equal(t, CategoryOOM, stats.Categories[1].Category)
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package failures
+
+import (
+	"sort"
+	"time"
+)
+
+type CategoryStats struct {
+	Category Category `json:"category" validate:"required"`
+	Count    int      `json:"count" validate:"required"`
+	// Number of failures by provider
+	Providers    map[string]int `json:"providers" validate:"required"`
+	LastOccurred time.Time      `json:"lastOccurred" validate:"required"`
+} // @name FailureCategoryStats
+
+type Stats struct {
+	Since time.Time `json:"since" validate:"required"`
+	Total int       `json:"total" validate:"required"`
+	// Categories sorted by the number of failures
+	Categories []CategoryStats `json:"categories" validate:"required"`
+} // @name FailureStats
+
+// Aggregate counts the failures by category
+func Aggregate(failureList []*Failure, since time.Time) *Stats {
+	stats := &Stats{Since: since, Total: len(failureList), Categories: []CategoryStats{}}
+	index := map[Category]int{}
+
+	for _, f := range failureList {
+		i, ok := index[f.Category]
+		if !ok {
+			i = len(stats.Categories)
+			index[f.Category] = i
+			stats.Categories = append(stats.Categories, CategoryStats{Category: f.Category, Providers: map[string]int{}})
+		}
+
+		c := &stats.Categories[i]
+		c.Count++
+		c.Providers[f.Provider]++
+		if f.CreatedAt.After(c.LastOccurred) {
+			c.LastOccurred = f.CreatedAt
+		}
+	}
+
+	sort.SliceStable(stats.Categories, func(i, j int) bool {
+		if stats.Categories[i].Count == stats.Categories[j].Count {
+			return stats.Categories[i].Category < stats.Categories[j].Category
+		}
+		return stats.Categories[i].Count > stats.Categories[j].Count
+	})
+
+	return stats
+}
@@ -0,0 +1,16 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package failures
+
+import "time"
+
+type Store interface {
+	List(filter *Filter) ([]*Failure, error)
+	Save(failure *Failure) error
+}
+
+type Filter struct {
+	// Only include failures created after this time
+	Since *time.Time
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var isValidLabelKey = regexp.MustCompile(`^[a-zA-Z0-9-_./]+$`).MatchString
+
+// ParseLabels parses labels in the key=value format
+func ParseLabels(labels []string) (map[string]string, error) {
+	result := map[string]string{}
+
+	for _, label := range labels {
+		key, value, found := strings.Cut(label, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid label format: %s. Expected key=value", label)
+		}
+
+		if !isValidLabelKey(key) {
+			return nil, fmt.Errorf("invalid label key: %s. Only [a-zA-Z0-9-_./] are allowed", key)
+		}
+
+		result[key] = value
+	}
+
+	return result, nil
+}
+
+// ValidateLabels returns an error if any of the label keys is not valid
+func ValidateLabels(labels map[string]string) error {
+	for key := range labels {
+		if !isValidLabelKey(key) {
+			return fmt.Errorf("invalid label key: %s. Only [a-zA-Z0-9-_./] are allowed", key)
+		}
+	}
+
+	return nil
+}
+
+// MatchLabels returns true if the labels contain all of the selector labels
+func MatchLabels(labels map[string]string, selector map[string]string) bool {
+	for key, value := range selector {
+		if v, ok := labels[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
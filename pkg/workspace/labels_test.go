@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels([]string{"team=payments", "env=", "app.io/tier=backend=1"})
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"team": "payments", "env": "", "app.io/tier": "backend=1"}, labels)
+
+	_, err = ParseLabels([]string{"team"})
+	require.NotNil(t, err)
+
+	_, err = ParseLabels([]string{"my team=payments"})
+	require.NotNil(t, err)
+}
+
+func TestMatchLabels(t *testing.T) {
+	labels := map[string]string{"team": "payments", "env": "dev"}
+
+	require.True(t, MatchLabels(labels, nil))
+	require.True(t, MatchLabels(labels, map[string]string{"team": "payments"}))
+	require.True(t, MatchLabels(labels, map[string]string{"team": "payments", "env": "dev"}))
+	require.False(t, MatchLabels(labels, map[string]string{"team": "billing"}))
+	require.False(t, MatchLabels(labels, map[string]string{"owner": "payments"}))
+	require.False(t, MatchLabels(nil, map[string]string{"team": "payments"}))
+}
+
+func TestValidateLabels(t *testing.T) {
+	require.Nil(t, ValidateLabels(map[string]string{"team": "payments", "app.io/tier": "backend"}))
+	require.NotNil(t, ValidateLabels(map[string]string{"my team": "payments"}))
+}
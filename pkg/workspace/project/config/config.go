@@ -19,6 +19,10 @@ type ProjectConfig struct {
 	IsDefault           bool                     `json:"default" validate:"required"`
 	Prebuilds           []*PrebuildConfig        `json:"prebuilds" validate:"optional"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	// Ports forwarded to the client machine while an SSH or IDE connection to the project is open
+	ForwardPorts []uint16 `json:"forwardPorts,omitempty" validate:"optional"`
+	// Incremented every time the image, user, build configuration, repository or environment variables change
+	Version uint32 `json:"version" validate:"optional"`
 } // @name ProjectConfig
 
 func (pc *ProjectConfig) SetPrebuild(p *PrebuildConfig) error {
@@ -23,6 +23,18 @@ type Project struct {
 	Target              string                     `json:"target" validate:"required"`
 	State               *ProjectState              `json:"state,omitempty" validate:"optional"`
 	GitProviderConfigId *string                    `json:"gitProviderConfigId,omitempty" validate:"optional"`
+	Resources           *ResourceLimits            `json:"resources,omitempty" validate:"optional"`
+	// Name and version of the project config the project was created from
+	ProjectConfigName    string `json:"projectConfigName,omitempty" validate:"optional"`
+	ProjectConfigVersion uint32 `json:"projectConfigVersion,omitempty" validate:"optional"`
+	// Shell commands run once after the project container is created, in order
+	OnCreateCommands []string `json:"onCreateCommands,omitempty" validate:"optional"`
+	// Shell commands run every time the project is started, in order
+	PostStartCommands []string `json:"postStartCommands,omitempty" validate:"optional"`
+	// Ports of the project that are meant to be forwarded to clients
+	ForwardPorts []uint16 `json:"forwardPorts,omitempty" validate:"optional"`
+	// Runtimes installed in the project with daytona install, in the tool@version format
+	Runtimes []string `json:"runtimes,omitempty" validate:"optional"`
 } // @name Project
 
 type ProjectInfo struct {
@@ -31,12 +43,22 @@ type ProjectInfo struct {
 	IsRunning        bool   `json:"isRunning" validate:"required"`
 	ProviderMetadata string `json:"providerMetadata,omitempty" validate:"optional"`
 	WorkspaceId      string `json:"workspaceId" validate:"required"`
+	// Bytes sent by the project since it was last started. Zero if the provider does not report it
+	EgressBytes uint64 `json:"egressBytes,omitempty" validate:"optional" format:"int64"`
+	// Average CPU usage of the project since it was last started in percent of a single CPU. Zero if the provider does not report it
+	CpuUsage float64 `json:"cpuUsage,omitempty" validate:"optional"`
+	// Memory used by the project in bytes. Zero if the provider does not report it
+	MemoryUsage uint64 `json:"memoryUsage,omitempty" validate:"optional" format:"int64"`
 } // @name ProjectInfo
 
 type ProjectState struct {
 	UpdatedAt string     `json:"updatedAt" validate:"required"`
 	Uptime    uint64     `json:"uptime" validate:"required"`
 	GitStatus *GitStatus `json:"gitStatus" validate:"optional"`
+	// Seconds since the last SSH session of the project was closed. Zero while a session is open
+	IdleTime uint64 `json:"idleTime,omitempty" validate:"optional"`
+	// Public key of the SSH server of the project in the known_hosts format, e.g. "ssh-ed25519 AAAA..."
+	HostKey string `json:"hostKey,omitempty" validate:"optional"`
 } // @name ProjectState
 
 type GitStatus struct {
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResourceLimits are the maximum resources a project container can use. Zero values mean no limit
+type ResourceLimits struct {
+	// Number of CPUs, fractions are allowed
+	Cpus float32 `json:"cpus,omitempty" validate:"optional"`
+	// Memory in MiB
+	Memory uint32 `json:"memory,omitempty" validate:"optional"`
+	// Size of the container filesystem in GiB
+	Disk uint32 `json:"disk,omitempty" validate:"optional"`
+} // @name ResourceLimits
+
+func (r *ResourceLimits) IsEmpty() bool {
+	return r == nil || (r.Cpus == 0 && r.Memory == 0 && r.Disk == 0)
+}
+
+func (r *ResourceLimits) String() string {
+	if r.IsEmpty() {
+		return "unlimited"
+	}
+
+	limits := []string{}
+	if r.Cpus > 0 {
+		limits = append(limits, fmt.Sprintf("%g CPU", r.Cpus))
+	}
+	if r.Memory > 0 {
+		limits = append(limits, fmt.Sprintf("%d MiB memory", r.Memory))
+	}
+	if r.Disk > 0 {
+		limits = append(limits, fmt.Sprintf("%d GiB disk", r.Disk))
+	}
+
+	return strings.Join(limits, ", ")
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+type SelectorOperator string
+
+const (
+	SelectorOperatorEquals    SelectorOperator = "="
+	SelectorOperatorNotEquals SelectorOperator = "!="
+	SelectorOperatorMatches   SelectorOperator = "~"
+	SelectorOperatorNotMatch  SelectorOperator = "!~"
+)
+
+// Keys of the selector requirements that refer to workspace fields instead of labels
+const (
+	SelectorKeyName   = "name"
+	SelectorKeyGroup  = "group"
+	SelectorKeyTarget = "target"
+	SelectorKeyRepo   = "repo"
+)
+
+type SelectorRequirement struct {
+	Key      string
+	Operator SelectorOperator
+	// Glob pattern for the ~ and !~ operators
+	Value string
+}
+
+// Selector matches workspaces that meet all of its requirements
+type Selector []SelectorRequirement
+
+// SelectorFields are the workspace fields a selector is matched against
+type SelectorFields struct {
+	Name   string
+	Group  string
+	Target string
+	// Names of the project repositories
+	Repositories []string
+	Labels       map[string]string
+}
+
+// ParseSelector parses a comma separated list of requirements, e.g. "repo~payments-*,env!=prod".
+// The name, group, target and repo keys refer to the workspace fields, all other keys to labels
+func ParseSelector(expression string) (Selector, error) {
+	selector := Selector{}
+
+	for _, term := range strings.Split(expression, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		requirement, err := parseSelectorRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+
+		selector = append(selector, requirement)
+	}
+
+	if len(selector) == 0 {
+		return nil, fmt.Errorf("selector can not be empty")
+	}
+
+	return selector, nil
+}
+
+func parseSelectorRequirement(term string) (SelectorRequirement, error) {
+	i := strings.IndexAny(term, "=~")
+	if i <= 0 {
+		return SelectorRequirement{}, fmt.Errorf("invalid selector requirement: %s. Expected key=value, key!=value, key~pattern or key!~pattern", term)
+	}
+
+	requirement := SelectorRequirement{
+		Key:      term[:i],
+		Operator: SelectorOperator(term[i : i+1]),
+		Value:    term[i+1:],
+	}
+
+	if strings.HasSuffix(requirement.Key, "!") {
+		requirement.Key = strings.TrimSuffix(requirement.Key, "!")
+		requirement.Operator = "!" + requirement.Operator
+	}
+
+	if !isValidLabelKey(requirement.Key) {
+		return SelectorRequirement{}, fmt.Errorf("invalid selector key: %s. Only [a-zA-Z0-9-_./] are allowed", requirement.Key)
+	}
+
+	if requirement.Operator == SelectorOperatorMatches || requirement.Operator == SelectorOperatorNotMatch {
+		if _, err := path.Match(requirement.Value, ""); err != nil {
+			return SelectorRequirement{}, fmt.Errorf("invalid selector pattern: %s", requirement.Value)
+		}
+	}
+
+	return requirement, nil
+}
+
+// Match returns true if the fields meet all requirements of the selector
+func (s Selector) Match(fields SelectorFields) bool {
+	for _, requirement := range s {
+		if !requirement.match(fields.getValues(requirement.Key)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r SelectorRequirement) match(values []string) bool {
+	switch r.Operator {
+	case SelectorOperatorEquals:
+		return slices.Contains(values, r.Value)
+	case SelectorOperatorNotEquals:
+		return !slices.Contains(values, r.Value)
+	case SelectorOperatorMatches:
+		return slices.ContainsFunc(values, r.matchPattern)
+	case SelectorOperatorNotMatch:
+		return !slices.ContainsFunc(values, r.matchPattern)
+	}
+
+	return false
+}
+
+func (r SelectorRequirement) matchPattern(value string) bool {
+	matched, _ := path.Match(r.Value, value)
+	return matched
+}
+
+func (f SelectorFields) getValues(key string) []string {
+	switch key {
+	case SelectorKeyName:
+		return []string{f.Name}
+	case SelectorKeyGroup:
+		return []string{f.Group}
+	case SelectorKeyTarget:
+		return []string{f.Target}
+	case SelectorKeyRepo:
+		return f.Repositories
+	}
+
+	if value, ok := f.Labels[key]; ok {
+		return []string{value}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSelector(t *testing.T) {
+	selector, err := ParseSelector("repo~payments-*, env!=prod,team=payments,name!~tmp-*")
+	require.Nil(t, err)
+	require.Equal(t, Selector{
+		{Key: "repo", Operator: SelectorOperatorMatches, Value: "payments-*"},
+		{Key: "env", Operator: SelectorOperatorNotEquals, Value: "prod"},
+		{Key: "team", Operator: SelectorOperatorEquals, Value: "payments"},
+		{Key: "name", Operator: SelectorOperatorNotMatch, Value: "tmp-*"},
+	}, selector)
+
+	_, err = ParseSelector("")
+	require.NotNil(t, err)
+
+	_, err = ParseSelector("team")
+	require.NotNil(t, err)
+
+	_, err = ParseSelector("=payments")
+	require.NotNil(t, err)
+
+	_, err = ParseSelector("my team=payments")
+	require.NotNil(t, err)
+
+	_, err = ParseSelector("repo~[payments")
+	require.NotNil(t, err)
+}
+
+func TestSelectorMatch(t *testing.T) {
+	fields := SelectorFields{
+		Name:         "payments-dev",
+		Group:        "payments",
+		Target:       "local",
+		Repositories: []string{"payments-api", "payments-web"},
+		Labels:       map[string]string{"team": "payments", "env": "dev"},
+	}
+
+	tests := map[string]bool{
+		"repo~payments-*":             true,
+		"repo=payments-web":           true,
+		"repo~billing-*":              false,
+		"repo!~billing-*":             true,
+		"name=payments-dev,env=dev":   true,
+		"name=payments-dev,env=prod":  false,
+		"group=payments,target=local": true,
+		"team!=billing":               true,
+		"owner!=payments":             true,
+		"owner=payments":              false,
+		"owner~*":                     false,
+	}
+
+	for expression, expected := range tests {
+		selector, err := ParseSelector(expression)
+		require.Nil(t, err)
+		require.Equal(t, expected, selector.Match(fields), expression)
+	}
+}
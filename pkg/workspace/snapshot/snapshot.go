@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Snapshot struct {
+	Id          string `json:"id" validate:"required"`
+	Name        string `json:"name" validate:"required"`
+	WorkspaceId string `json:"workspaceId" validate:"required"`
+	ProjectName string `json:"projectName" validate:"required"`
+	// Image the project container filesystem was committed to
+	Image     string    `json:"image" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name Snapshot
+
+// GetSnapshotImageName returns the local image reference a project snapshot is committed to
+func GetSnapshotImageName(workspaceId, projectName, snapshotId string) string {
+	return fmt.Sprintf("daytona-snapshot-%s-%s:%s", strings.ToLower(workspaceId), strings.ToLower(projectName), strings.ToLower(snapshotId))
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import "errors"
+
+type Store interface {
+	List(filter *Filter) ([]*Snapshot, error)
+	Find(filter *Filter) (*Snapshot, error)
+	Save(snapshot *Snapshot) error
+	Delete(snapshot *Snapshot) error
+}
+
+type Filter struct {
+	IdOrName    *string
+	WorkspaceId *string
+	ProjectName *string
+}
+
+var (
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+)
+
+func IsSnapshotNotFound(err error) bool {
+	return err.Error() == ErrSnapshotNotFound.Error()
+}
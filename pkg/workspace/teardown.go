@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+// TeardownStep is a step of the removal of a workspace
+type TeardownStep string
+
+const (
+	// The provider stops and removes the project container and its volumes
+	TeardownStepDestroyProject TeardownStep = "destroy-project"
+	// The provider removes the workspace network and directory
+	TeardownStepDestroyWorkspace TeardownStep = "destroy-workspace"
+	TeardownStepRevokeApiKeys    TeardownStep = "revoke-api-keys"
+	TeardownStepRemoveLogs       TeardownStep = "remove-logs"
+	TeardownStepRemoveSnapshots  TeardownStep = "remove-snapshots"
+	TeardownStepDeleteWorkspace  TeardownStep = "delete-workspace"
+	// Sent last with the result of the removal
+	TeardownStepDone TeardownStep = "done"
+)
+
+type TeardownStatus string
+
+const (
+	TeardownStatusStarted   TeardownStatus = "started"
+	TeardownStatusCompleted TeardownStatus = "completed"
+	TeardownStatusFailed    TeardownStatus = "failed"
+)
+
+// TeardownProgress is streamed to the client while a workspace is removed
+type TeardownProgress struct {
+	Step   TeardownStep   `json:"step"`
+	Status TeardownStatus `json:"status"`
+	// Set for the steps of a single project
+	ProjectName string `json:"projectName,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package timings
+
+import "slices"
+
+// Number of the most recent creations on a target that estimates are based on
+const EstimateHistorySize = 50
+
+// CreationEstimate is the expected duration of a workspace creation, based on previous creations on the same target
+type CreationEstimate struct {
+	// Expected duration in milliseconds. Projects are created one after another so their durations add up
+	Total    int64                     `json:"total" validate:"required"`
+	Projects []ProjectCreationEstimate `json:"projects" validate:"required"`
+} // @name CreationEstimate
+
+// Expected durations of the phases of a project creation in milliseconds
+type ProjectCreationEstimate struct {
+	ProjectName string `json:"projectName" validate:"required"`
+	Pull        int64  `json:"pull" validate:"required"`
+	Clone       int64  `json:"clone" validate:"required"`
+	Build       int64  `json:"build" validate:"required"`
+	Hooks       int64  `json:"hooks" validate:"required"`
+	Total       int64  `json:"total" validate:"required"`
+	// True if a published build matches the project configuration, so the project is not built again
+	PrebuildAvailable bool `json:"prebuildAvailable" validate:"required"`
+	// True if the project is built from its devcontainer configuration
+	RequiresBuild bool `json:"requiresBuild" validate:"required"`
+	// True if a previous creation on the target used the image, so it is likely not pulled again
+	ImageCached bool `json:"imageCached" validate:"required"`
+	// Number of previous creations the estimate is based on. Durations are 0 if there are none
+	Samples int `json:"samples" validate:"required"`
+} // @name ProjectCreationEstimate
+
+// ProjectEstimateParams describes a project that is about to be created
+type ProjectEstimateParams struct {
+	ProjectName   string
+	RepositoryUrl string
+	// Image the project container is created from. Empty if the project requires a build
+	Image             string
+	PrebuildAvailable bool
+	RequiresBuild     bool
+}
+
+// EstimateProject estimates the creation of a project from the previous creations on the target.
+// Creations of the same repository are preferred since the clone, build and hook durations depend on the repository
+func EstimateProject(params ProjectEstimateParams, history []*CreationTiming) ProjectCreationEstimate {
+	estimate := ProjectCreationEstimate{
+		ProjectName:       params.ProjectName,
+		PrebuildAvailable: params.PrebuildAvailable,
+		RequiresBuild:     params.RequiresBuild,
+	}
+
+	if params.Image != "" {
+		estimate.ImageCached = slices.ContainsFunc(history, func(t *CreationTiming) bool {
+			return t.Image == params.Image
+		})
+	}
+
+	if len(history) == 0 {
+		return estimate
+	}
+
+	samples := []*CreationTiming{}
+	for _, t := range history {
+		if t.RepositoryUrl != "" && t.RepositoryUrl == params.RepositoryUrl {
+			samples = append(samples, t)
+		}
+	}
+	if len(samples) == 0 {
+		samples = history
+	}
+	estimate.Samples = len(samples)
+
+	// Pulls of cached images take no time, so they are left out of the pull estimate
+	if !estimate.ImageCached {
+		estimate.Pull = median(history, func(t *CreationTiming) int64 { return t.Pull }, true)
+	}
+
+	if params.RequiresBuild {
+		estimate.Build = median(samples, func(t *CreationTiming) int64 { return t.Build }, true)
+		if estimate.Build == 0 {
+			estimate.Build = median(history, func(t *CreationTiming) int64 { return t.Build }, true)
+		}
+	}
+
+	estimate.Clone = median(samples, func(t *CreationTiming) int64 { return t.Clone }, false)
+	estimate.Hooks = median(samples, func(t *CreationTiming) int64 { return t.Hooks }, false)
+
+	// Time not covered by the phases, e.g. container creation. The queue wait depends on the
+	// other projects of the workspace, so it is not part of the estimate
+	other := median(samples, func(t *CreationTiming) int64 {
+		return max(t.Total-t.QueueWait-t.Pull-t.Clone-t.Build-t.Hooks, 0)
+	}, false)
+
+	estimate.Total = estimate.Pull + estimate.Clone + estimate.Build + estimate.Hooks + other
+
+	return estimate
+}
+
+// median returns the median of the durations of the timings. With skipZero, zero durations are ignored
+func median(timings []*CreationTiming, duration func(t *CreationTiming) int64, skipZero bool) int64 {
+	durations := []int64{}
+	for _, t := range timings {
+		d := duration(t)
+		if skipZero && d <= 0 {
+			continue
+		}
+		durations = append(durations, d)
+	}
+
+	if len(durations) == 0 {
+		return 0
+	}
+
+	slices.Sort(durations)
+	return durations[(len(durations)-1)/2]
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package timings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var history = []*CreationTiming{
+	{RepositoryUrl: "https://github.com/daytonaio/daytona", Image: "daytonaio/workspace-project", Pull: 20000, Clone: 3000, Hooks: 1000, Total: 26000},
+	{RepositoryUrl: "https://github.com/daytonaio/daytona", Image: "daytonaio/workspace-project", Clone: 5000, Hooks: 3000, Total: 10000},
+	{RepositoryUrl: "https://github.com/daytonaio/daytona", Clone: 4000, Build: 60000, Hooks: 2000, Total: 68000},
+	{RepositoryUrl: "https://github.com/daytonaio/docs", Image: "node:20", QueueWait: 10000, Pull: 10000, Clone: 1000, Build: 0, Hooks: 500, Total: 22500},
+}
+
+func TestEstimateProject(t *testing.T) {
+	estimate := EstimateProject(ProjectEstimateParams{
+		ProjectName:   "daytona",
+		RepositoryUrl: "https://github.com/daytonaio/daytona",
+		Image:         "daytonaio/workspace-project",
+	}, history)
+
+	require.Equal(t, ProjectCreationEstimate{
+		ProjectName: "daytona",
+		Clone:       4000,
+		Hooks:       2000,
+		Total:       8000,
+		ImageCached: true,
+		Samples:     3,
+	}, estimate)
+}
+
+func TestEstimateProjectWithBuild(t *testing.T) {
+	estimate := EstimateProject(ProjectEstimateParams{
+		ProjectName:   "docs",
+		RepositoryUrl: "https://github.com/daytonaio/docs",
+		RequiresBuild: true,
+	}, history)
+
+	// The docs repository was never built, so the build of another repository is used
+	require.Equal(t, int64(60000), estimate.Build)
+	require.Equal(t, int64(10000), estimate.Pull)
+	require.False(t, estimate.ImageCached)
+	require.Equal(t, 1, estimate.Samples)
+	require.Equal(t, int64(10000+1000+60000+500+1000), estimate.Total)
+}
+
+func TestEstimateProjectWithoutHistory(t *testing.T) {
+	estimate := EstimateProject(ProjectEstimateParams{ProjectName: "daytona", Image: "ubuntu"}, nil)
+
+	require.Equal(t, ProjectCreationEstimate{ProjectName: "daytona"}, estimate)
+}
@@ -0,0 +1,14 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package timings
+
+type Store interface {
+	List(filter *Filter) ([]*CreationTiming, error)
+	Save(timing *CreationTiming) error
+}
+
+type Filter struct {
+	WorkspaceId *string
+	Target      *string
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package timings
+
+import "time"
+
+// Durations of the phases of a project creation in milliseconds
+type CreationTiming struct {
+	Id            string `json:"id" validate:"required"`
+	WorkspaceId   string `json:"workspaceId" validate:"required"`
+	WorkspaceName string `json:"workspaceName" validate:"required"`
+	ProjectName   string `json:"projectName" validate:"required"`
+	Target        string `json:"target" validate:"required"`
+	RepositoryUrl string `json:"repositoryUrl,omitempty"`
+	// Image the project was created from. Empty for projects built from a devcontainer without a prebuild
+	Image string `json:"image,omitempty"`
+	// True if the project was created from a prebuild instead of being built
+	Prebuilt bool `json:"prebuilt,omitempty"`
+	// Time between the creation request and the start of the workspace provisioning
+	QueueWait int64 `json:"queueWait" validate:"required"`
+	Pull      int64 `json:"pull" validate:"required"`
+	Clone     int64 `json:"clone" validate:"required"`
+	Build     int64 `json:"build" validate:"required"`
+	// Time spent running the lifecycle hooks that block the project creation and start
+	Hooks     int64     `json:"hooks" validate:"required"`
+	Total     int64     `json:"total" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name CreationTiming
@@ -5,6 +5,8 @@ package workspace
 
 import (
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
@@ -14,10 +16,33 @@ type Workspace struct {
 	Name     string             `json:"name" validate:"required"`
 	Projects []*project.Project `json:"projects" validate:"required"`
 	Target   string             `json:"target" validate:"required"`
-	ApiKey   string             `json:"-"`
-	EnvVars  map[string]string  `json:"-"`
+	Group    string             `json:"group,omitempty" validate:"optional"`
+	Labels   map[string]string  `json:"labels,omitempty" validate:"optional"`
+	// Minutes without SSH activity after which the workspace is stopped. Zero disables auto-stop
+	AutoStop uint32           `json:"autoStop,omitempty" validate:"optional"`
+	Origin   *WorkspaceOrigin `json:"origin,omitempty" validate:"optional"`
+	// Time the project data of the stopped workspace was archived to disk. Nil if the workspace is not hibernated
+	HibernatedAt *time.Time `json:"hibernatedAt,omitempty" validate:"optional"`
+	// Name of the API key of the user that created the workspace. Workspaces without an owner were created
+	// before ownership was tracked and are accessible by all users
+	Owner string `json:"owner,omitempty" validate:"optional"`
+	// Users that can view the workspace and connect to it
+	SharedWith []string          `json:"sharedWith,omitempty" validate:"optional"`
+	ApiKey     string            `json:"-"`
+	EnvVars    map[string]string `json:"-"`
 } // @name Workspace
 
+// WorkspaceOrigin identifies the version of the workspace definition file a workspace was created from, if any.
+// The commits the projects were created from are recorded in their repositories
+type WorkspaceOrigin struct {
+	// Absolute path of the definition file on the client that created the workspace
+	DefinitionFile string `json:"definitionFile" validate:"required"`
+	// SHA-256 of the definition file contents
+	DefinitionSha256 string `json:"definitionSha256" validate:"required"`
+	// Commit of the git repository that contains the definition file, if any
+	DefinitionCommit string `json:"definitionCommit,omitempty" validate:"optional"`
+} // @name WorkspaceOrigin
+
 type WorkspaceInfo struct {
 	Name             string                 `json:"name" validate:"required"`
 	Projects         []*project.ProjectInfo `json:"projects" validate:"required"`
@@ -33,6 +58,16 @@ func (w *Workspace) GetProject(projectName string) (*project.Project, error) {
 	return nil, errors.New("project not found")
 }
 
+// IsOwnedBy returns true if the user owns the workspace or the workspace has no owner
+func (w *Workspace) IsOwnedBy(user string) bool {
+	return w.Owner == "" || w.Owner == user
+}
+
+// IsAccessibleBy returns true if the user owns the workspace or it is shared with them
+func (w *Workspace) IsAccessibleBy(user string) bool {
+	return w.IsOwnedBy(user) || slices.Contains(w.SharedWith, user)
+}
+
 type WorkspaceEnvVarParams struct {
 	ApiUrl        string
 	ServerUrl     string